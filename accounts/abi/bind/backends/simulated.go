@@ -748,7 +748,7 @@ func (fb *filterBackend) ChainDb() pistdb.Database { return fb.db }
 func (fb *filterBackend) EventMux() *event.TypeMux { panic("not supported") }
 
 func (fb *filterBackend) HeaderByNumber(ctx context.Context, block rpc.BlockNumber) (*types.Header, error) {
-	if block == rpc.LatestBlockNumber {
+	if block == rpc.LatestBlockNumber || block == rpc.FinalizedBlockNumber || block == rpc.SafeBlockNumber {
 		return fb.bc.CurrentHeader(), nil
 	}
 	return fb.bc.GetHeaderByNumber(uint64(block.Int64())), nil
@@ -24,6 +24,7 @@ import (
 	"git.taiyue.io/pist/go-pist/common"
 	"git.taiyue.io/pist/go-pist/core/types"
 	"git.taiyue.io/pist/go-pist/event"
+	"git.taiyue.io/pist/go-pist/signer/core/apitypes"
 )
 
 // Account represents an Ethereum account located at a specific location defined
@@ -115,6 +116,19 @@ type Wallet interface {
 
 	SignTx_Payment(account Account, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
 
+	// SignTypedData requests the wallet to sign the given EIP-712 typed data, the
+	// signed hash being keccak256("\x19\x01" ‖ domainSeparator ‖ hashStruct(message)).
+	// The produced signature is in the [R || S || V] format where V is 0 or 1.
+	//
+	// It looks up the account specified either solely via its address contained within,
+	// or optionally with the aid of any location metadata from the embedded URL field.
+	//
+	// If the wallet requires additional authentication to sign the request, an
+	// AuthNeededError instance will be returned and the user may retry via
+	// SignTypedDataWithPassphrase, or by other means (e.g. unlock the account in a
+	// keystore).
+	SignTypedData(account Account, typedData apitypes.TypedData) ([]byte, error)
+
 	// SignHashWithPassphrase requests the wallet to sign the given hash with the
 	// given passphrase as extra authentication information.
 	//
@@ -128,6 +142,15 @@ type Wallet interface {
 	// It looks up the account specified either solely via its address contained within,
 	// or optionally with the aid of any location metadata from the embedded URL field.
 	SignTxWithPassphrase(account Account, passphrase string, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+
+	// SignTxWithPassphrase_Payment requests the wallet to sign the given transaction
+	// as its payer, with the given passphrase as extra authentication information.
+	// The transaction is expected to be already signed by its sender.
+	SignTxWithPassphrase_Payment(account Account, passphrase string, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+
+	// SignTypedDataWithPassphrase requests the wallet to sign the given EIP-712
+	// typed data, with the given passphrase as extra authentication information.
+	SignTypedDataWithPassphrase(account Account, passphrase string, typedData apitypes.TypedData) ([]byte, error)
 }
 
 // Backend is a "wallet provider" that may contain a batch of accounts they can
@@ -29,8 +29,10 @@ import (
 	"git.taiyue.io/pist/go-pist/event"
 	"git.taiyue.io/pist/go-pist/internal/pistapi"
 	"git.taiyue.io/pist/go-pist/log"
+	"git.taiyue.io/pist/go-pist/rlp"
 	"git.taiyue.io/pist/go-pist/rpc"
 	"git.taiyue.io/pist/go-pist/signer/core"
+	"git.taiyue.io/pist/go-pist/signer/core/apitypes"
 )
 
 type ExternalBackend struct {
@@ -143,36 +145,21 @@ func (api *ExternalSigner) Derive(path accounts.DerivationPath, pin bool) (accou
 	return accounts.Account{}, fmt.Errorf("operation not supported on external signers")
 }
 
-func (api *ExternalSigner) SelfDerive(bases []accounts.DerivationPath, chain pistchain.ChainStateReader) {
+func (api *ExternalSigner) SelfDerive(base accounts.DerivationPath, chain pistchain.ChainStateReader) {
 	log.Error("operation SelfDerive not supported on external signers")
 }
 
-func (api *ExternalSigner) signHash(account accounts.Account, hash []byte) ([]byte, error) {
+// SignHash is not supported, the external signer only signs text with the
+// signed message prefix.
+func (api *ExternalSigner) SignHash(account accounts.Account, hash []byte) ([]byte, error) {
 	return []byte{}, fmt.Errorf("operation not supported on external signers")
 }
 
-// SignData signs keccak256(data). The mimetype parameter describes the type of data being signed
-func (api *ExternalSigner) SignData(account accounts.Account, mimeType string, data []byte) ([]byte, error) {
-	var res hexutil.Bytes
-	var signAddress = common.NewMixedcaseAddress(account.Address)
-	if err := api.client.Call(&res, "account_signData",
-		mimeType,
-		&signAddress, // Need to use the pointer here, because of how MarshalJSON is defined
-		hexutil.Encode(data)); err != nil {
-		return nil, err
-	}
-	// If V is on 27/28-form, convert to to 0/1 for Clique
-	if mimeType == accounts.MimetypeClique && (res[64] == 27 || res[64] == 28) {
-		res[64] -= 27 // Transform V from 27/28 to 0/1 for Clique use
-	}
-	return res, nil
-}
-
+// SignText signs keccak256 of the text prefixed with the signed message header.
 func (api *ExternalSigner) SignText(account accounts.Account, text []byte) ([]byte, error) {
 	var res hexutil.Bytes
 	var signAddress = common.NewMixedcaseAddress(account.Address)
-	if err := api.client.Call(&res, "account_signData",
-		accounts.MimetypeTextPlain,
+	if err := api.client.Call(&res, "account_sign",
 		&signAddress, // Need to use the pointer here, because of how MarshalJSON is defined
 		hexutil.Encode(text)); err != nil {
 		return nil, err
@@ -197,12 +184,57 @@ func (api *ExternalSigner) SignTx(account accounts.Account, tx *types.Transactio
 		To:       to,
 		From:     common.NewMixedcaseAddress(account.Address),
 	}
+	if payer := tx.Payer(); payer != nil {
+		p := common.NewMixedcaseAddress(*payer)
+		args.Payment = &p
+	}
+	if fee := tx.Fee(); fee != nil {
+		args.Fee = (*hexutil.Big)(fee)
+	}
 	if err := api.client.Call(&res, "account_signTransaction", args); err != nil {
 		return nil, err
 	}
 	return res.Tx, nil
 }
 
+// SignTx_Payment forwards the transaction, signed by its sender, to the external
+// signer to be signed by the account as the payer of its gas.
+func (api *ExternalSigner) SignTx_Payment(account accounts.Account, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	if payer := tx.Payer(); payer == nil || *payer != account.Address {
+		return nil, fmt.Errorf("account %s is not the payer of the transaction", account.Address.Hex())
+	}
+	raw, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		return nil, err
+	}
+	res := pistapi.SignTransactionResult{}
+	if err := api.client.Call(&res, "account_signPayment", hexutil.Bytes(raw)); err != nil {
+		return nil, err
+	}
+	return res.Tx, nil
+}
+
+// SignTypedData forwards the EIP-712 typed data to the external signer, which
+// verifies it against its chain ID before signing.
+func (api *ExternalSigner) SignTypedData(account accounts.Account, typedData apitypes.TypedData) ([]byte, error) {
+	var res hexutil.Bytes
+	var signAddress = common.NewMixedcaseAddress(account.Address)
+	if err := api.client.Call(&res, "account_signTypedData",
+		&signAddress, // Need to use the pointer here, because of how MarshalJSON is defined
+		typedData); err != nil {
+		return nil, err
+	}
+	if len(res) != 65 || (res[64] != 27 && res[64] != 28) {
+		return nil, fmt.Errorf("invalid typed data signature from external signer")
+	}
+	res[64] -= 27 // Transform V from 27/28 to 0/1 as other wallets return
+	return res, nil
+}
+
+func (api *ExternalSigner) SignHashWithPassphrase(account accounts.Account, passphrase string, hash []byte) ([]byte, error) {
+	return []byte{}, fmt.Errorf("password-operations not supported on external signers")
+}
+
 func (api *ExternalSigner) SignTextWithPassphrase(account accounts.Account, passphrase string, text []byte) ([]byte, error) {
 	return []byte{}, fmt.Errorf("password-operations not supported on external signers")
 }
@@ -210,10 +242,15 @@ func (api *ExternalSigner) SignTextWithPassphrase(account accounts.Account, pass
 func (api *ExternalSigner) SignTxWithPassphrase(account accounts.Account, passphrase string, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
 	return nil, fmt.Errorf("password-operations not supported on external signers")
 }
-func (api *ExternalSigner) SignDataWithPassphrase(account accounts.Account, passphrase, mimeType string, data []byte) ([]byte, error) {
+
+func (api *ExternalSigner) SignTxWithPassphrase_Payment(account accounts.Account, passphrase string, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
 	return nil, fmt.Errorf("password-operations not supported on external signers")
 }
 
+func (api *ExternalSigner) SignTypedDataWithPassphrase(account accounts.Account, passphrase string, typedData apitypes.TypedData) ([]byte, error) {
+	return []byte{}, fmt.Errorf("password-operations not supported on external signers")
+}
+
 func (api *ExternalSigner) listAccounts() ([]common.Address, error) {
 	var res []common.Address
 	if err := api.client.Call(&res, "account_list"); err != nil {
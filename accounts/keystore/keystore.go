@@ -319,6 +319,18 @@ func (ks *KeyStore) SignTxWithPassphrase(a accounts.Account, passphrase string,
 	return types.SignTx(tx, types.NewTIP1Signer(chainID), key.PrivateKey)
 }
 
+// SignTxWithPassphrase_Payment signs the transaction as its payer if the private
+// key matching the given address can be decrypted with the given passphrase.
+func (ks *KeyStore) SignTxWithPassphrase_Payment(a accounts.Account, passphrase string, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	_, key, err := ks.getDecryptedKey(a, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	defer zeroKey(key.PrivateKey)
+
+	return types.SignTx_Payment(tx, types.NewTIP1Signer(chainID), key.PrivateKey)
+}
+
 // Unlock unlocks the given account indefinitely.
 func (ks *KeyStore) Unlock(a accounts.Account, passphrase string) error {
 	return ks.TimedUnlock(a, passphrase, 0)
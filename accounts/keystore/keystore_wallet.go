@@ -22,6 +22,7 @@ import (
 	ethereum "git.taiyue.io/pist/go-pist"
 	"git.taiyue.io/pist/go-pist/accounts"
 	"git.taiyue.io/pist/go-pist/core/types"
+	"git.taiyue.io/pist/go-pist/signer/core/apitypes"
 )
 
 // keystoreWallet implements the accounts.Wallet interface for the original
@@ -122,6 +123,16 @@ func (w *keystoreWallet) SignTx_Payment(account accounts.Account, tx *types.Tran
 	return w.keystore.SignTx_Payment(account, tx, chainID)
 }
 
+// SignTypedData implements accounts.Wallet, attempting to sign the hash of the
+// given EIP-712 typed data with the given account.
+func (w *keystoreWallet) SignTypedData(account accounts.Account, typedData apitypes.TypedData) ([]byte, error) {
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+	return w.SignHash(account, hash)
+}
+
 // SignHashWithPassphrase implements accounts.Wallet, attempting to sign the
 // given hash with the given account using passphrase as extra authentication.
 func (w *keystoreWallet) SignHashWithPassphrase(account accounts.Account, passphrase string, hash []byte) ([]byte, error) {
@@ -149,3 +160,29 @@ func (w *keystoreWallet) SignTxWithPassphrase(account accounts.Account, passphra
 	// Account seems valid, request the keystore to sign
 	return w.keystore.SignTxWithPassphrase(account, passphrase, tx, chainID)
 }
+
+// SignTxWithPassphrase_Payment implements accounts.Wallet, attempting to sign the
+// given transaction as its payer with the given account using passphrase as extra
+// authentication.
+func (w *keystoreWallet) SignTxWithPassphrase_Payment(account accounts.Account, passphrase string, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	// Make sure the requested account is contained within
+	if account.Address != w.account.Address {
+		return nil, accounts.ErrUnknownAccount
+	}
+	if account.URL != (accounts.URL{}) && account.URL != w.account.URL {
+		return nil, accounts.ErrUnknownAccount
+	}
+	// Account seems valid, request the keystore to sign
+	return w.keystore.SignTxWithPassphrase_Payment(account, passphrase, tx, chainID)
+}
+
+// SignTypedDataWithPassphrase implements accounts.Wallet, attempting to sign the
+// hash of the given EIP-712 typed data with the given account using passphrase
+// as extra authentication.
+func (w *keystoreWallet) SignTypedDataWithPassphrase(account accounts.Account, passphrase string, typedData apitypes.TypedData) ([]byte, error) {
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+	return w.SignHashWithPassphrase(account, passphrase, hash)
+}
@@ -32,6 +32,7 @@ import (
 	"git.taiyue.io/pist/go-pist/common"
 	"git.taiyue.io/pist/go-pist/common/hexutil"
 	"git.taiyue.io/pist/go-pist/core/types"
+	"git.taiyue.io/pist/go-pist/crypto"
 	"git.taiyue.io/pist/go-pist/log"
 	"git.taiyue.io/pist/go-pist/rlp"
 )
@@ -51,11 +52,13 @@ const (
 	ledgerOpRetrieveAddress  ledgerOpcode = 0x02 // Returns the public key and Ethereum address for a given BIP 32 path
 	ledgerOpSignTransaction  ledgerOpcode = 0x04 // Signs an Ethereum transaction after having the user validate the parameters
 	ledgerOpGetConfiguration ledgerOpcode = 0x06 // Returns specific wallet application configuration
+	ledgerOpSignTypedMessage ledgerOpcode = 0x0c // Signs an EIP-712 typed message after having the user validate the hashes
 
 	ledgerP1DirectlyFetchAddress    ledgerParam1 = 0x00 // Return address directly from the wallet
 	ledgerP1InitTransactionData     ledgerParam1 = 0x00 // First transaction data block for signing
 	ledgerP1ContTransactionData     ledgerParam1 = 0x80 // Subsequent transaction data block for signing
 	ledgerP2DiscardAddressChainCode ledgerParam2 = 0x00 // Do not return the chain code along with the address
+	ledgerP2HashedTypedMessage      ledgerParam2 = 0x00 // Sign the typed message from its domain and message hashes
 )
 
 // errLedgerReplyInvalidHeader is the error message returned by a Ledger data exchange
@@ -67,10 +70,25 @@ var errLedgerReplyInvalidHeader = errors.New("ledger: invalid reply header")
 // when a response does arrive, but it does not contain the expected data.
 var errLedgerInvalidVersionReply = errors.New("ledger: invalid version reply")
 
+// errLedgerPayerMismatch is the error message returned by a payer signature if
+// it doesn't recover the payer of the transaction, which happens if the Ethereum
+// app signed something else than the payload it was sent.
+var errLedgerPayerMismatch = errors.New("ledger: payer signature doesn't recover the payer")
+
+// errLedgerBlindSigning is the error message returned by a payer signature if
+// the user didn't allow the Ethereum app to sign data it can't display. The app
+// has no payment flow, the payment payload is signed blindly.
+var errLedgerBlindSigning = errors.New("ledger: payer signature is blind signing, enable contract data in the Ethereum app settings to allow it")
+
+// ledgerFlagArbitraryData is the configuration flag of the Ethereum app set if
+// the user enabled signing data the app can't display.
+const ledgerFlagArbitraryData = 0x01
+
 // ledgerDriver implements the communication with a Ledger hardware wallet.
 type ledgerDriver struct {
 	device  io.ReadWriter // USB device connection to communicate through
 	version [3]byte       // Current version of the Ledger firmware (zero if app is offline)
+	flags   byte          // Configuration flags of the Ethereum app
 	browser bool          // Flag whether the Ledger is in browser mode (reply channel mismatch)
 	failure error         // Any failure that would make the device unusable
 	log     log.Logger    // Contextual logger to tag the ledger with its id
@@ -129,7 +147,7 @@ func (w *ledgerDriver) Open(device io.ReadWriter, passphrase string) error {
 // Close implements usbwallet.driver, cleaning up and metadata maintained within
 // the Ledger driver.
 func (w *ledgerDriver) Close() error {
-	w.browser, w.version = false, [3]byte{}
+	w.browser, w.version, w.flags = false, [3]byte{}, 0
 	return nil
 }
 
@@ -168,6 +186,47 @@ func (w *ledgerDriver) SignTx(path accounts.DerivationPath, tx *types.Transactio
 	return w.ledgerSign(path, tx, chainID)
 }
 
+// SignTxPayment implements usbwallet.driver, sending the payment payload of the
+// transaction to the Ledger and waiting for the user to confirm or deny paying
+// its gas.
+//
+// Note, the Ethereum app has no payment flow and the payload doesn't have the
+// layout of a plain transaction: the app shows it parsed as one, so the fields
+// the user confirms don't describe the payment. This is blind signing, it is
+// refused unless the user enabled contract data in the app settings.
+func (w *ledgerDriver) SignTxPayment(path accounts.DerivationPath, tx *types.Transaction, chainID *big.Int) (common.Address, *types.Transaction, error) {
+	// If the Ethereum app doesn't run, abort
+	if w.offline() {
+		return common.Address{}, nil, accounts.ErrWalletClosed
+	}
+	// The payment hash is always replay protected
+	if chainID == nil {
+		return common.Address{}, nil, errors.New("ledger: payer signature requires a chain ID")
+	}
+	if w.version[0] <= 1 && w.version[1] <= 0 && w.version[2] <= 2 {
+		return common.Address{}, nil, fmt.Errorf("Ledger v%d.%d.%d doesn't support signing this transaction, please update to v1.0.3 at least", w.version[0], w.version[1], w.version[2])
+	}
+	if w.flags&ledgerFlagArbitraryData == 0 {
+		return common.Address{}, nil, errLedgerBlindSigning
+	}
+	return w.ledgerSignPayment(path, tx, chainID)
+}
+
+// SignTypedMessage implements usbwallet.driver, sending the EIP-712 domain and
+// message hashes to the Ledger and waiting for the user to confirm or deny the
+// signature.
+func (w *ledgerDriver) SignTypedMessage(path accounts.DerivationPath, domainHash []byte, messageHash []byte) ([]byte, error) {
+	// If the Ethereum app doesn't run, abort
+	if w.offline() {
+		return nil, accounts.ErrWalletClosed
+	}
+	// Ensure the wallet is capable of signing typed messages
+	if w.version[0] < 1 || (w.version[0] == 1 && w.version[1] < 5) {
+		return nil, fmt.Errorf("Ledger v%d.%d.%d doesn't support signing typed messages, please update to v1.5.0 at least", w.version[0], w.version[1], w.version[2])
+	}
+	return w.ledgerSignTypedMessage(path, domainHash, messageHash)
+}
+
 // ledgerVersion retrieves the current version of the Ethereum wallet app running
 // on the Ledger wallet.
 //
@@ -194,9 +253,10 @@ func (w *ledgerDriver) ledgerVersion() ([3]byte, error) {
 	if len(reply) != 4 {
 		return [3]byte{}, errLedgerInvalidVersionReply
 	}
-	// Cache the version for future reference
+	// Cache the version and the flags for future reference
 	var version [3]byte
 	copy(version[:], reply[1:])
+	w.flags = reply[0]
 	return version, nil
 }
 
@@ -296,12 +356,6 @@ func (w *ledgerDriver) ledgerDerive(derivationPath []uint32) (common.Address, er
 //   signature R | 32 bytes
 //   signature S | 32 bytes
 func (w *ledgerDriver) ledgerSign(derivationPath []uint32, tx *types.Transaction, chainID *big.Int) (common.Address, *types.Transaction, error) {
-	// Flatten the derivation path into the Ledger request
-	path := make([]byte, 1+4*len(derivationPath))
-	path[0] = byte(len(derivationPath))
-	for i, component := range derivationPath {
-		binary.BigEndian.PutUint32(path[1+4*i:], component)
-	}
 	// Create the transaction RLP based on whether legacy or EIP155 signing was requested
 	var (
 		txrlp []byte
@@ -316,12 +370,125 @@ func (w *ledgerDriver) ledgerSign(derivationPath []uint32, tx *types.Transaction
 			return common.Address{}, nil, err
 		}
 	}
-	payload := append(path, txrlp...)
+	signature, err := w.ledgerSignPayload(derivationPath, txrlp)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	// Create the correct signer and signature transform based on the chain ID
+	var signer types.Signer = types.NewTIP1Signer(chainID)
+	signature[64] -= byte(chainID.Uint64()*2 + 35)
+
+	signed, err := tx.WithSignature(signer, signature)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	sender, err := types.Sender(signer, signed)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	return sender, signed, nil
+}
+
+// ledgerSignPayment sends the payment payload of the transaction to the Ledger
+// wallet through the transaction signing flow, which the user confirms blindly.
+// The device signs the hash of the whole payload, which is the payment hash of
+// the transaction. As the device derives V from the fields it expects of a
+// plain transaction, the recovery id is found again by recovering the payer.
+func (w *ledgerDriver) ledgerSignPayment(derivationPath []uint32, tx *types.Transaction, chainID *big.Int) (common.Address, *types.Transaction, error) {
+	signer := types.NewTIP1Signer(chainID)
+	payload, err := signer.PaymentRLP(tx)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	signature, err := w.ledgerSignPayload(derivationPath, payload)
+	if err != nil {
+		return common.Address{}, nil, fmt.Errorf("ledger: payer signature refused: %v", err)
+	}
+	hash := signer.Hash_Payment(tx)
+	for v := byte(0); v < 2; v++ {
+		signature[64] = v
+		pubkey, err := crypto.SigToPub(hash[:], signature)
+		if err != nil || crypto.PubkeyToAddress(*pubkey) != *tx.Payer() {
+			continue
+		}
+		signed, err := tx.WithSignature_Payment(signer, signature)
+		if err != nil {
+			return common.Address{}, nil, err
+		}
+		return *tx.Payer(), signed, nil
+	}
+	return common.Address{}, nil, errLedgerPayerMismatch
+}
+
+// ledgerSignTypedMessage sends the EIP-712 hashes to the Ledger wallet, and waits
+// for the user to confirm or deny the signature.
+//
+// The typed message signing protocol is defined as follows:
+//
+//   CLA | INS | P1 | P2 | Lc  | Le
+//   ----+-----+----+----+-----+---
+//    E0 | 0C  | 00 | 00 | variable | variable
+//
+// Where the input is:
+//
+//   Description                                      | Length
+//   -------------------------------------------------+----------
+//   Number of BIP 32 derivations to perform (max 10) | 1 byte
+//   First derivation index (big endian)              | 4 bytes
+//   ...                                              | 4 bytes
+//   Last derivation index (big endian)               | 4 bytes
+//   Domain separator hash                            | 32 bytes
+//   Message hash                                     | 32 bytes
+//
+// And the output data is:
+//
+//   Description | Length
+//   ------------+---------
+//   signature V | 1 byte
+//   signature R | 32 bytes
+//   signature S | 32 bytes
+func (w *ledgerDriver) ledgerSignTypedMessage(derivationPath []uint32, domainHash []byte, messageHash []byte) ([]byte, error) {
+	// Flatten the derivation path and the hashes into the Ledger request
+	payload := make([]byte, 1+4*len(derivationPath), 1+4*len(derivationPath)+64)
+	payload[0] = byte(len(derivationPath))
+	for i, component := range derivationPath {
+		binary.BigEndian.PutUint32(payload[1+4*i:], component)
+	}
+	payload = append(payload, domainHash...)
+	payload = append(payload, messageHash...)
+
+	// Send the request and wait for the response
+	reply, err := w.ledgerExchange(ledgerOpSignTypedMessage, 0, ledgerP2HashedTypedMessage, payload)
+	if err != nil {
+		return nil, err
+	}
+	// Extract the Ethereum signature and do a sanity validation
+	if len(reply) != 65 {
+		return nil, errors.New("reply lacks signature")
+	}
+	// Transform V from 27/28 to 0/1 as the device signs with the legacy offset
+	signature := append(reply[1:], reply[0]-27)
+	return signature, nil
+}
+
+// ledgerSignPayload streams the RLP payload to sign to the Ledger wallet in
+// chunks, after the derivation path of the signing key. It returns the signature
+// in the [R || S || V] format, with V as computed by the device.
+func (w *ledgerDriver) ledgerSignPayload(derivationPath []uint32, rlpPayload []byte) ([]byte, error) {
+	// Flatten the derivation path into the Ledger request
+	path := make([]byte, 1+4*len(derivationPath))
+	path[0] = byte(len(derivationPath))
+	for i, component := range derivationPath {
+		binary.BigEndian.PutUint32(path[1+4*i:], component)
+	}
+	payload := append(path, rlpPayload...)
 
 	// Send the request and wait for the response
 	var (
 		op    = ledgerP1InitTransactionData
 		reply []byte
+		err   error
 	)
 	for len(payload) > 0 {
 		// Calculate the size of the next data chunk
@@ -332,7 +499,7 @@ func (w *ledgerDriver) ledgerSign(derivationPath []uint32, tx *types.Transaction
 		// Send the chunk over, ensuring it's processed correctly
 		reply, err = w.ledgerExchange(ledgerOpSignTransaction, op, 0, payload[:chunk])
 		if err != nil {
-			return common.Address{}, nil, err
+			return nil, err
 		}
 		// Shift the payload and ensure subsequent chunks are marked as such
 		payload = payload[chunk:]
@@ -340,23 +507,9 @@ func (w *ledgerDriver) ledgerSign(derivationPath []uint32, tx *types.Transaction
 	}
 	// Extract the Ethereum signature and do a sanity validation
 	if len(reply) != 65 {
-		return common.Address{}, nil, errors.New("reply lacks signature")
+		return nil, errors.New("reply lacks signature")
 	}
-	signature := append(reply[1:], reply[0])
-
-	// Create the correct signer and signature transform based on the chain ID
-	var signer types.Signer = types.NewTIP1Signer(chainID)
-	signature[64] -= byte(chainID.Uint64()*2 + 35)
-
-	signed, err := tx.WithSignature(signer, signature)
-	if err != nil {
-		return common.Address{}, nil, err
-	}
-	sender, err := types.Sender(signer, signed)
-	if err != nil {
-		return common.Address{}, nil, err
-	}
-	return sender, signed, nil
+	return append(reply[1:], reply[0]), nil
 }
 
 // ledgerExchange performs a data exchange with the Ledger wallet, sending it a
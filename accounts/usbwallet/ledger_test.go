@@ -0,0 +1,123 @@
+package usbwallet
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/binary"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"git.taiyue.io/pist/go-pist/accounts"
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/core/types"
+	"git.taiyue.io/pist/go-pist/crypto"
+	"git.taiyue.io/pist/go-pist/log"
+)
+
+// ledgerMock emulates the Ethereum app of a Ledger over the USB transport, it
+// signs the hash of the payloads streamed to it with key.
+type ledgerMock struct {
+	key   *ecdsa.PrivateKey
+	flags byte
+
+	apdu    []byte // APDU being received
+	length  int    // length of the APDU being received
+	payload []byte // transaction payload streamed so far
+	signs   int    // transaction chunks received
+	reply   bytes.Buffer
+}
+
+func (m *ledgerMock) Write(chunk []byte) (int, error) {
+	data := chunk[5:]
+	if chunk[3] == 0 && chunk[4] == 0 {
+		m.apdu, m.length = nil, int(binary.BigEndian.Uint16(data))
+		data = data[2:]
+	}
+	if left := m.length - len(m.apdu); len(data) > left {
+		data = data[:left]
+	}
+	m.apdu = append(m.apdu, data...)
+	if len(m.apdu) == m.length {
+		m.respond(ledgerOpcode(m.apdu[1]), ledgerParam1(m.apdu[2]), m.apdu[5:])
+	}
+	return len(chunk), nil
+}
+
+func (m *ledgerMock) Read(buf []byte) (int, error) {
+	return m.reply.Read(buf)
+}
+
+func (m *ledgerMock) respond(op ledgerOpcode, p1 ledgerParam1, data []byte) {
+	var reply []byte
+	switch op {
+	case ledgerOpRetrieveAddress:
+		pubkey := crypto.FromECDSAPub(&m.key.PublicKey)
+		addr := []byte(hex.EncodeToString(crypto.PubkeyToAddress(m.key.PublicKey).Bytes()))
+		reply = append(append(append([]byte{byte(len(pubkey))}, pubkey...), byte(len(addr))), addr...)
+	case ledgerOpGetConfiguration:
+		reply = []byte{m.flags, 1, 2, 0}
+	case ledgerOpSignTransaction:
+		if p1 == ledgerP1InitTransactionData {
+			m.payload = append([]byte{}, data[1+4*int(data[0]):]...)
+		} else {
+			m.payload = append(m.payload, data...)
+		}
+		m.signs++
+		sig, _ := crypto.Sign(crypto.Keccak256(m.payload), m.key)
+		reply = append([]byte{sig[64]}, sig[:64]...)
+	}
+	reply = append(reply, 0x90, 0x00)
+	for i := 0; len(reply) > 0; i++ {
+		chunk := []byte{0x01, 0x01, 0x05, byte(i >> 8), byte(i)}
+		if i == 0 {
+			chunk = append(chunk, byte(len(reply)>>8), byte(len(reply)))
+		}
+		n := 64 - len(chunk)
+		if n > len(reply) {
+			n = len(reply)
+		}
+		chunk, reply = append(chunk, reply[:n]...), reply[n:]
+		m.reply.Write(append(chunk, make([]byte, 64-len(chunk))...))
+	}
+}
+
+func TestLedgerSignPayment(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	sender, _ := crypto.GenerateKey()
+	payer := crypto.PubkeyToAddress(key.PublicKey)
+	chainID := big.NewInt(1)
+	signer := types.NewTIP1Signer(chainID)
+	tx, err := types.SignTx(types.NewTransaction_Payment(0, common.Address{0x01}, big.NewInt(1), big.NewInt(0), 21000, big.NewInt(1), nil, payer), signer, sender)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The payment is signed blindly, it's refused until the user allows it
+	device := &ledgerMock{key: key}
+	driver := newLedgerDriver(log.Root()).(*ledgerDriver)
+	if err := driver.Open(device, ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := driver.SignTxPayment(accounts.DefaultBaseDerivationPath, tx, chainID); err != errLedgerBlindSigning {
+		t.Fatalf("expected blind signing error, got %v", err)
+	}
+	if device.signs != 0 {
+		t.Fatal("payment payload sent to the device")
+	}
+
+	device = &ledgerMock{key: key, flags: ledgerFlagArbitraryData}
+	if err := driver.Open(device, ""); err != nil {
+		t.Fatal(err)
+	}
+	addr, signed, err := driver.SignTxPayment(accounts.DefaultBaseDerivationPath, tx, chainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if addr != payer {
+		t.Fatalf("payer mismatch: have %x, want %x", addr, payer)
+	}
+	if have, err := types.Payer(signer, signed); err != nil || have != payer {
+		t.Fatalf("payer signature mismatch: have %x (%v), want %x", have, err, payer)
+	}
+}
@@ -41,6 +41,14 @@ import (
 // encoded passphrase.
 var ErrTrezorPINNeeded = errors.New("trezor: pin needed")
 
+// errTrezorPayerUnsupported is returned when a Trezor is asked to pay the gas of
+// a transaction, which its firmware can't sign.
+var errTrezorPayerUnsupported = errors.New("trezor: firmware doesn't support signing as the payer of a transaction")
+
+// errTrezorTypedDataUnsupported is returned when a Trezor is asked to sign EIP-712
+// typed data, which its protocol can't carry.
+var errTrezorTypedDataUnsupported = errors.New("trezor: firmware protocol doesn't support signing typed data")
+
 // errTrezorReplyInvalidHeader is the error message returned by a Trezor data exchange
 // if the device replies with a mismatching header. This usually means the device
 // is in browser mode.
@@ -159,6 +167,26 @@ func (w *trezorDriver) SignTx(path accounts.DerivationPath, tx *types.Transactio
 	return w.trezorSign(path, tx, chainID)
 }
 
+// SignTxPayment implements usbwallet.driver. The Trezor firmware builds the
+// signed payload from the fields of a plain transaction and only signs prefixed
+// messages, so it can't sign the payment hash of a transaction.
+func (w *trezorDriver) SignTxPayment(path accounts.DerivationPath, tx *types.Transaction, chainID *big.Int) (common.Address, *types.Transaction, error) {
+	if w.device == nil {
+		return common.Address{}, nil, accounts.ErrWalletClosed
+	}
+	return common.Address{}, nil, errTrezorPayerUnsupported
+}
+
+// SignTypedMessage implements usbwallet.driver. The supported Trezor protocol has
+// no typed data messages and only signs prefixed messages, so it can't sign the
+// EIP-712 hash.
+func (w *trezorDriver) SignTypedMessage(path accounts.DerivationPath, domainHash []byte, messageHash []byte) ([]byte, error) {
+	if w.device == nil {
+		return nil, accounts.ErrWalletClosed
+	}
+	return nil, errTrezorTypedDataUnsupported
+}
+
 // trezorDerive sends a derivation request to the Trezor device and returns the
 // Ethereum address located on that path.
 func (w *trezorDriver) trezorDerive(derivationPath []uint32) (common.Address, error) {
@@ -29,7 +29,9 @@ import (
 	"git.taiyue.io/pist/go-pist/accounts"
 	"git.taiyue.io/pist/go-pist/common"
 	"git.taiyue.io/pist/go-pist/core/types"
+	"git.taiyue.io/pist/go-pist/crypto"
 	"git.taiyue.io/pist/go-pist/log"
+	"git.taiyue.io/pist/go-pist/signer/core/apitypes"
 	"github.com/karalabe/hid"
 )
 
@@ -66,6 +68,15 @@ type driver interface {
 	// SignTx sends the transaction to the USB device and waits for the user to confirm
 	// or deny the transaction.
 	SignTx(path accounts.DerivationPath, tx *types.Transaction, chainID *big.Int) (common.Address, *types.Transaction, error)
+
+	// SignTxPayment sends the transaction to the USB device and waits for the user
+	// to confirm or deny paying its gas, it returns the payer of the signature.
+	SignTxPayment(path accounts.DerivationPath, tx *types.Transaction, chainID *big.Int) (common.Address, *types.Transaction, error)
+
+	// SignTypedMessage sends the EIP-712 domain separator and message hash to the
+	// USB device and waits for the user to confirm or deny the signature, which
+	// is returned in the [R || S || V] format where V is 0 or 1.
+	SignTypedMessage(path accounts.DerivationPath, domainHash []byte, messageHash []byte) ([]byte, error)
 }
 
 // wallet represents the common functionality shared by all USB hardware
@@ -509,17 +520,90 @@ func (w *wallet) SignHash(account accounts.Account, hash []byte) ([]byte, error)
 // too old to sign EIP-155 transactions, but such is requested nonetheless, an error
 // will be returned opposed to silently signing in Homestead mode.
 func (w *wallet) SignTx(account accounts.Account, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return w.signTx(account, func(path accounts.DerivationPath) (common.Address, *types.Transaction, error) {
+		return w.driver.SignTx(path, tx, chainID)
+	})
+}
+
+// SignTx_Payment implements accounts.Wallet. It sends the transaction over to the
+// hardware wallet to request the user to confirm paying its gas, the account has
+// to be the payer set in the transaction. It returns an error if the device can't
+// sign as the payer, a Ledger only signs the payment blindly once the user
+// allowed it.
+func (w *wallet) SignTx_Payment(account accounts.Account, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	if payer := tx.Payer(); payer == nil || *payer != account.Address {
+		return nil, fmt.Errorf("account %s is not the payer of the transaction", account.Address.Hex())
+	}
+	return w.signTx(account, func(path accounts.DerivationPath) (common.Address, *types.Transaction, error) {
+		return w.driver.SignTxPayment(path, tx, chainID)
+	})
+}
+
+// signTx requests a signature of the account from the device with sign, and
+// verifies the signer to avoid hardware fault surprises.
+func (w *wallet) signTx(account accounts.Account, sign func(path accounts.DerivationPath) (common.Address, *types.Transaction, error)) (*types.Transaction, error) {
+	var signed *types.Transaction
+	err := w.request(account, func(path accounts.DerivationPath) error {
+		signer, tx, err := sign(path)
+		if err != nil {
+			return err
+		}
+		if signer != account.Address {
+			return fmt.Errorf("signer mismatch: expected %s, got %s", account.Address.Hex(), signer.Hex())
+		}
+		signed = tx
+		return nil
+	})
+	return signed, err
+}
+
+// SignTypedData implements accounts.Wallet. It sends the domain separator and
+// message hash of the EIP-712 typed data over to the device to request a
+// confirmation from the user, and verifies the signer of the returned signature.
+func (w *wallet) SignTypedData(account accounts.Account, typedData apitypes.TypedData) ([]byte, error) {
+	hash, rawData, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+	// The pre-image is "\x19\x01" ‖ domainSeparator ‖ hashStruct(message)
+	domainHash, messageHash := rawData[2:34], rawData[34:66]
+
+	var signature []byte
+	err = w.request(account, func(path accounts.DerivationPath) error {
+		sig, err := w.driver.SignTypedMessage(path, domainHash, messageHash)
+		if err != nil {
+			return err
+		}
+		pubkey, err := crypto.SigToPub(hash, sig)
+		if err != nil {
+			return err
+		}
+		if signer := crypto.PubkeyToAddress(*pubkey); signer != account.Address {
+			return fmt.Errorf("signer mismatch: expected %s, got %s", account.Address.Hex(), signer.Hex())
+		}
+		signature = sig
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return signature, nil
+}
+
+// request runs the device request of the account exclusively, while the wallet
+// is open and the user confirmation is pending.
+func (w *wallet) request(account accounts.Account, run func(path accounts.DerivationPath) error) error {
 	w.stateLock.RLock() // Comms have own mutex, this is for the state fields
 	defer w.stateLock.RUnlock()
 
 	// If the wallet is closed, abort
 	if w.device == nil {
-		return nil, accounts.ErrWalletClosed
+		return accounts.ErrWalletClosed
 	}
 	// Make sure the requested account is contained within
 	path, ok := w.paths[account.Address]
 	if !ok {
-		return nil, accounts.ErrUnknownAccount
+		return accounts.ErrUnknownAccount
 	}
 	// All infos gathered and metadata checks out, request signing
 	<-w.commsLock
@@ -536,19 +620,8 @@ func (w *wallet) SignTx(account accounts.Account, tx *types.Transaction, chainID
 		w.hub.commsPend--
 		w.hub.commsLock.Unlock()
 	}()
-	// Sign the transaction and verify the sender to avoid hardware fault surprises
-	sender, signed, err := w.driver.SignTx(path, tx, chainID)
-	if err != nil {
-		return nil, err
-	}
-	if sender != account.Address {
-		return nil, fmt.Errorf("signer mismatch: expected %s, got %s", account.Address.Hex(), sender.Hex())
-	}
-	return signed, nil
-}
-
-func (w *wallet) SignTx_Payment(account accounts.Account, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
-	return nil, nil
+	// Sign and verify the signer to avoid hardware fault surprises
+	return run(path)
 }
 
 // SignHashWithPassphrase implements accounts.Wallet, however signing arbitrary
@@ -564,3 +637,17 @@ func (w *wallet) SignHashWithPassphrase(account accounts.Account, passphrase str
 func (w *wallet) SignTxWithPassphrase(account accounts.Account, passphrase string, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
 	return w.SignTx(account, tx, chainID)
 }
+
+// SignTxWithPassphrase_Payment implements accounts.Wallet, attempting to sign the
+// given transaction as its payer with the given account. Since USB wallets don't
+// rely on passphrases, these are silently ignored.
+func (w *wallet) SignTxWithPassphrase_Payment(account accounts.Account, passphrase string, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return w.SignTx_Payment(account, tx, chainID)
+}
+
+// SignTypedDataWithPassphrase implements accounts.Wallet, attempting to sign the
+// given EIP-712 typed data with the given account. Since USB wallets don't rely
+// on passphrases, these are silently ignored.
+func (w *wallet) SignTypedDataWithPassphrase(account accounts.Account, passphrase string, typedData apitypes.TypedData) ([]byte, error) {
+	return w.SignTypedData(account, typedData)
+}
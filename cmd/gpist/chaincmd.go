@@ -375,7 +375,7 @@ func copyDb(ctx *cli.Context) error {
 	syncMode := *utils.GlobalTextMarshaler(ctx, utils.SyncModeFlag.Name).(*downloader.SyncMode)
 
 	var syncBloom *trie.SyncBloom
-	if syncMode == downloader.FastSync {
+	if syncMode == downloader.FastSync || syncMode == downloader.SnapSync {
 		syncBloom = trie.NewSyncBloom(uint64(ctx.GlobalInt(utils.CacheFlag.Name)/2), chainDb)
 	}
 	dl := downloader.New(0, chainDb, syncBloom, new(event.TypeMux), chain, nil, nil)
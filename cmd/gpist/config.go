@@ -14,9 +14,11 @@ import (
 	"git.taiyue.io/pist/go-pist/cmd/utils"
 	"git.taiyue.io/pist/go-pist/crypto"
 	"git.taiyue.io/pist/go-pist/dashboard"
+	"git.taiyue.io/pist/go-pist/graphql"
 	"git.taiyue.io/pist/go-pist/node"
 	"git.taiyue.io/pist/go-pist/params"
 	"git.taiyue.io/pist/go-pist/pist"
+	"git.taiyue.io/pist/go-pist/sponsor"
 	"github.com/naoina/toml"
 )
 
@@ -63,6 +65,8 @@ type gethConfig struct {
 	Node      node.Config
 	Piststats piststatsConfig
 	Dashboard dashboard.Config
+	Sponsor   sponsor.Config
+	GraphQL   graphql.Config
 }
 
 func loadConfig(file string, cfg *gethConfig) error {
@@ -96,6 +100,8 @@ func makeConfigNode(ctx *cli.Context) (*node.Node, gethConfig) {
 		Pist:      pist.DefaultConfig,
 		Node:      defaultNodeConfig(),
 		Dashboard: dashboard.DefaultConfig,
+		Sponsor:   sponsor.DefaultConfig,
+		GraphQL:   graphql.DefaultConfig,
 	}
 	if ctx.GlobalBool(utils.SingleNodeFlag.Name) {
 		// set pistconfig
@@ -130,6 +136,8 @@ func makeConfigNode(ctx *cli.Context) (*node.Node, gethConfig) {
 	}
 
 	utils.SetDashboardConfig(ctx, &cfg.Dashboard)
+	utils.SetSponsorConfig(ctx, &cfg.Sponsor)
+	utils.SetGraphQLConfig(ctx, &cfg.GraphQL)
 
 	return stack, cfg
 }
@@ -147,6 +155,12 @@ func makeFullNode(ctx *cli.Context) *node.Node {
 	if cfg.Piststats.URL != "" {
 		utils.RegisterPistStatsService(stack, cfg.Piststats.URL)
 	}
+	if ctx.GlobalBool(utils.SponsorEnabledFlag.Name) {
+		utils.RegisterSponsorService(stack, &cfg.Sponsor)
+	}
+	if ctx.GlobalBool(utils.GraphQLEnabledFlag.Name) {
+		utils.RegisterGraphQLService(stack, &cfg.GraphQL)
+	}
 	return stack
 }
 
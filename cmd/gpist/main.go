@@ -43,12 +43,27 @@ var (
 		utils.BootnodesFlag,
 		utils.BootnodesV5Flag,
 		utils.DataDirFlag,
+		utils.AncientFlag,
 		utils.KeyStoreDirFlag,
 		utils.NoUSBFlag,
 		utils.DashboardEnabledFlag,
 		utils.DashboardAddrFlag,
 		utils.DashboardPortFlag,
 		utils.DashboardRefreshFlag,
+		utils.SponsorEnabledFlag,
+		utils.SponsorPayerFlag,
+		utils.SponsorContractsFlag,
+		utils.SponsorQuotaFlag,
+		utils.SponsorGasPriceFlag,
+		utils.GraphQLEnabledFlag,
+		utils.GraphQLListenAddrFlag,
+		utils.GraphQLPortFlag,
+		utils.GraphQLCORSDomainFlag,
+		utils.GraphQLVirtualHostsFlag,
+		utils.GraphQLPageSizeFlag,
+		utils.GraphQLBlockRangeFlag,
+		utils.GraphQLDepthFlag,
+		utils.GraphQLComplexityFlag,
 
 		utils.TxPoolNoLocalsFlag,
 		utils.TxPoolJournalFlag,
@@ -145,6 +160,8 @@ func init() {
 		copydbCommand,
 		removedbCommand,
 		dumpCommand,
+		// See walcmd.go:
+		walCommand,
 		// See monitorcmd.go:
 		monitorCommand,
 		// See accountcmd.go:
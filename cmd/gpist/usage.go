@@ -53,6 +53,7 @@ var AppHelpFlagGropist = []flagGroup{
 		Flags: []cli.Flag{
 			configFileFlag,
 			utils.DataDirFlag,
+			utils.AncientFlag,
 			utils.KeyStoreDirFlag,
 			utils.NoUSBFlag,
 			utils.NetworkIdFlag,
@@ -66,6 +67,30 @@ var AppHelpFlagGropist = []flagGroup{
 			utils.LightKDFFlag,
 		},
 	},
+	{
+		Name: "GAS SPONSORSHIP",
+		Flags: []cli.Flag{
+			utils.SponsorEnabledFlag,
+			utils.SponsorPayerFlag,
+			utils.SponsorContractsFlag,
+			utils.SponsorQuotaFlag,
+			utils.SponsorGasPriceFlag,
+		},
+	},
+	{
+		Name: "GRAPHQL",
+		Flags: []cli.Flag{
+			utils.GraphQLEnabledFlag,
+			utils.GraphQLListenAddrFlag,
+			utils.GraphQLPortFlag,
+			utils.GraphQLCORSDomainFlag,
+			utils.GraphQLVirtualHostsFlag,
+			utils.GraphQLPageSizeFlag,
+			utils.GraphQLBlockRangeFlag,
+			utils.GraphQLDepthFlag,
+			utils.GraphQLComplexityFlag,
+		},
+	},
 	//{Name: "DEVELOPER CHAIN",
 	//	Flags: []cli.Flag{
 	//		utils.DeveloperFlag,
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"fmt"
+
+	"git.taiyue.io/pist/go-pist/cmd/utils"
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/consensus/tbft"
+	"git.taiyue.io/pist/go-pist/console"
+	"git.taiyue.io/pist/go-pist/log"
+	"git.taiyue.io/pist/go-pist/params"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var (
+	walCommitteeFlag = cli.Uint64Flag{
+		Name:  "committee",
+		Usage: "Id of the committee whose consensus write-ahead log is used",
+	}
+	walHeightFlag = cli.Uint64Flag{
+		Name:  "height",
+		Usage: "Last block height kept in the consensus write-ahead log (default: drop the corrupted tail only)",
+	}
+
+	walCommand = cli.Command{
+		Name:     "wal",
+		Usage:    "Manage the tbft consensus write-ahead log",
+		Category: "BLOCKCHAIN COMMANDS",
+		Description: `
+The committee nodes log every consensus message and timeout before acting on it,
+and replay the log of the current height when they restart.`,
+		Subcommands: []cli.Command{
+			{
+				Name:   "inspect",
+				Usage:  "Print the records of a consensus write-ahead log",
+				Action: utils.MigrateFlags(inspectWAL),
+				Flags: []cli.Flag{
+					utils.DataDirFlag,
+					walCommitteeFlag,
+				},
+				Description: `
+    gpist wal inspect --committee <id>
+
+Prints every record of the write-ahead log of the committee as one json object
+per line, oldest first.`,
+			},
+			{
+				Name:   "truncate",
+				Usage:  "Drop the tail of a consensus write-ahead log",
+				Action: utils.MigrateFlags(truncateWAL),
+				Flags: []cli.Flag{
+					utils.DataDirFlag,
+					walCommitteeFlag,
+					walHeightFlag,
+				},
+				Description: `
+    gpist wal truncate --committee <id> [--height <number>]
+
+Drops every record written after the end of the given height, so the node won't
+replay them on its next start. Without a height only the records from the first
+corrupted one on are dropped, which is what a crash in the middle of a write
+leaves behind. The node must not be running.`,
+			},
+		},
+	}
+)
+
+// walFile returns the path of the write-ahead log of the committee selected
+// on the command line.
+func walFile(ctx *cli.Context) string {
+	if !ctx.IsSet(walCommitteeFlag.Name) {
+		utils.Fatalf("The committee id is required (--%s)", walCommitteeFlag.Name)
+	}
+	stack, _ := makeConfigNode(ctx)
+	root := stack.ResolvePath(params.DefaultTBFTDir)
+	if root == "" {
+		utils.Fatalf("An ephemeral node keeps no consensus write-ahead log")
+	}
+	cfg := params.DefaultConsensusConfig()
+	cfg.RootDir = root
+	file := cfg.CommitteeWalFile(ctx.Uint64(walCommitteeFlag.Name))
+	if !common.FileExist(file) {
+		utils.Fatalf("Consensus write-ahead log %s doesn't exist", file)
+	}
+	return file
+}
+
+func inspectWAL(ctx *cli.Context) error {
+	var (
+		file    = walFile(ctx)
+		records = 0
+		heights = 0
+	)
+	err := tbft.IterateWAL(file, func(msg *tbft.TimedWALMessage) error {
+		data, err := tbft.WALMessageJSON(msg)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+		records++
+		if _, ok := msg.Msg.(tbft.EndHeightMessage); ok {
+			heights++
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error("Reading consensus write-ahead log stopped", "file", file, "records", records, "err", err)
+	}
+	log.Info("Inspected consensus write-ahead log", "file", file, "records", records, "heights", heights)
+	return err
+}
+
+func truncateWAL(ctx *cli.Context) error {
+	file := walFile(ctx)
+
+	fmt.Println(file)
+	confirm, err := console.Stdin.PromptConfirm("Truncate this write-ahead log?")
+	switch {
+	case err != nil:
+		utils.Fatalf("%v", err)
+	case !confirm:
+		log.Warn("Write-ahead log truncation aborted")
+		return nil
+	}
+
+	var kept int
+	if ctx.IsSet(walHeightFlag.Name) {
+		kept, err = tbft.TruncateWAL(file, ctx.Uint64(walHeightFlag.Name))
+	} else {
+		kept, err = tbft.RepairWAL(file)
+	}
+	if err != nil {
+		utils.Fatalf("Failed to truncate write-ahead log: %v", err)
+	}
+	log.Info("Truncated consensus write-ahead log", "file", file, "kept", kept)
+	return nil
+}
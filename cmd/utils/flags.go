@@ -45,6 +45,7 @@ import (
 	"git.taiyue.io/pist/go-pist/core/vm"
 	"git.taiyue.io/pist/go-pist/crypto"
 	"git.taiyue.io/pist/go-pist/dashboard"
+	"git.taiyue.io/pist/go-pist/graphql"
 	"git.taiyue.io/pist/go-pist/log"
 	"git.taiyue.io/pist/go-pist/metrics"
 	"git.taiyue.io/pist/go-pist/metrics/influxdb"
@@ -59,6 +60,7 @@ import (
 	"git.taiyue.io/pist/go-pist/pist/gasprice"
 	"git.taiyue.io/pist/go-pist/pistdb"
 	"git.taiyue.io/pist/go-pist/piststats"
+	"git.taiyue.io/pist/go-pist/sponsor"
 	"gopkg.in/urfave/cli.v1"
 )
 
@@ -128,6 +130,10 @@ var (
 		Usage: "Data directory for the databases and keystore",
 		Value: DirectoryString{node.DefaultDataDir()},
 	}
+	AncientFlag = DirectoryFlag{
+		Name:  "datadir.ancient",
+		Usage: "Data directory for ancient chain segments (default = inside chaindata)",
+	}
 	KeyStoreDirFlag = DirectoryFlag{
 		Name:  "keystore",
 		Usage: "Directory for the keystore (default = inside the datadir)",
@@ -199,7 +205,7 @@ var (
 	defaultSyncMode = pist.DefaultConfig.SyncMode
 	SyncModeFlag    = TextMarshalerFlag{
 		Name:  "syncmode",
-		Usage: `Blockchain sync mode ("full", or "snapshot")`,
+		Usage: `Blockchain sync mode ("full", "fast" or "snap")`,
 		Value: &defaultSyncMode,
 	}
 	GCModeFlag = cli.StringFlag{
@@ -240,6 +246,74 @@ var (
 		Usage: "Dashboard metrics collection refresh rate",
 		Value: dashboard.DefaultConfig.Refresh,
 	}
+	// Gas sponsorship settings
+	SponsorEnabledFlag = cli.BoolFlag{
+		Name:  "sponsor",
+		Usage: "Enable the gas sponsorship service (sponsor RPC namespace)",
+	}
+	SponsorPayerFlag = cli.StringFlag{
+		Name:  "sponsor.payer",
+		Usage: "Keystore account paying the gas of the sponsored transactions, it has to be unlocked",
+	}
+	SponsorContractsFlag = cli.StringFlag{
+		Name:  "sponsor.contracts",
+		Usage: "Comma separated list of the contracts called by the sponsored transactions (default = any)",
+	}
+	SponsorQuotaFlag = cli.Uint64Flag{
+		Name:  "sponsor.quota",
+		Usage: "Gas limit sponsored per sender and day (0 = unlimited)",
+		Value: sponsor.DefaultConfig.DailyGasQuota,
+	}
+	SponsorGasPriceFlag = BigFlag{
+		Name:  "sponsor.gasprice",
+		Usage: "Maximum gas price of the sponsored transactions",
+		Value: sponsor.DefaultConfig.MaxGasPrice,
+	}
+	// GraphQL settings
+	GraphQLEnabledFlag = cli.BoolFlag{
+		Name:  "graphql",
+		Usage: "Enable the GraphQL endpoint over the chain, committee and staking data",
+	}
+	GraphQLListenAddrFlag = cli.StringFlag{
+		Name:  "graphql.addr",
+		Usage: "GraphQL server listening interface",
+		Value: graphql.DefaultConfig.Host,
+	}
+	GraphQLPortFlag = cli.IntFlag{
+		Name:  "graphql.port",
+		Usage: "GraphQL server listening port",
+		Value: graphql.DefaultConfig.Port,
+	}
+	GraphQLCORSDomainFlag = cli.StringFlag{
+		Name:  "graphql.corsdomain",
+		Usage: "Comma separated list of domains from which to accept cross origin requests (browser enforced)",
+		Value: "",
+	}
+	GraphQLVirtualHostsFlag = cli.StringFlag{
+		Name:  "graphql.vhosts",
+		Usage: "Comma separated list of virtual hostnames from which to accept requests (server enforced). Accepts '*' wildcard.",
+		Value: strings.Join(graphql.DefaultConfig.VirtualHosts, ","),
+	}
+	GraphQLPageSizeFlag = cli.IntFlag{
+		Name:  "graphql.pagesize",
+		Usage: "Maximum number of items a GraphQL list returns at once (0 = unlimited)",
+		Value: graphql.DefaultConfig.MaxPageSize,
+	}
+	GraphQLBlockRangeFlag = cli.Uint64Flag{
+		Name:  "graphql.blockrange",
+		Usage: "Maximum number of blocks a GraphQL logs query searches (0 = unlimited)",
+		Value: graphql.DefaultConfig.MaxBlockRange,
+	}
+	GraphQLDepthFlag = cli.IntFlag{
+		Name:  "graphql.depth",
+		Usage: "Maximum nesting depth of the fields of a GraphQL query (0 = unlimited)",
+		Value: graphql.DefaultConfig.MaxDepth,
+	}
+	GraphQLComplexityFlag = cli.IntFlag{
+		Name:  "graphql.complexity",
+		Usage: "Maximum number of fields a GraphQL query resolves (0 = unlimited)",
+		Value: graphql.DefaultConfig.MaxComplexity,
+	}
 	// Transaction pool settings
 	TxPoolNoLocalsFlag = cli.BoolFlag{
 		Name:  "txpool.nolocals",
@@ -1040,6 +1114,9 @@ func SetPistchainConfig(ctx *cli.Context, stack *node.Node, cfg *pist.Config) {
 		cfg.DatabaseCache = ctx.GlobalInt(CacheFlag.Name) * ctx.GlobalInt(CacheDatabaseFlag.Name) / 100
 	}
 	cfg.DatabaseHandles = makeDatabaseHandles()
+	if ctx.GlobalIsSet(AncientFlag.Name) {
+		cfg.DatabaseFreezer = ctx.GlobalString(AncientFlag.Name)
+	}
 
 	if gcmode := ctx.GlobalString(GCModeFlag.Name); gcmode != "full" && gcmode != "archive" {
 		Fatalf("--%s must be either 'full' or 'archive'", GCModeFlag.Name)
@@ -1105,6 +1182,89 @@ func SetDashboardConfig(ctx *cli.Context, cfg *dashboard.Config) {
 	cfg.Refresh = ctx.GlobalDuration(DashboardRefreshFlag.Name)
 }
 
+// SetSponsorConfig applies gas sponsorship related command line flags to the config.
+func SetSponsorConfig(ctx *cli.Context, cfg *sponsor.Config) {
+	if ctx.GlobalIsSet(SponsorPayerFlag.Name) {
+		payer := ctx.GlobalString(SponsorPayerFlag.Name)
+		if !common.IsHexAddress(payer) {
+			Fatalf("Invalid sponsor payer address %q", payer)
+		}
+		cfg.Payer = common.HexToAddress(payer)
+	}
+	if ctx.GlobalIsSet(SponsorContractsFlag.Name) {
+		cfg.Contracts = nil
+		for _, contract := range splitAndTrim(ctx.GlobalString(SponsorContractsFlag.Name)) {
+			if !common.IsHexAddress(contract) {
+				Fatalf("Invalid sponsored contract address %q", contract)
+			}
+			cfg.Contracts = append(cfg.Contracts, common.HexToAddress(contract))
+		}
+	}
+	if ctx.GlobalIsSet(SponsorQuotaFlag.Name) {
+		cfg.DailyGasQuota = ctx.GlobalUint64(SponsorQuotaFlag.Name)
+	}
+	if ctx.GlobalIsSet(SponsorGasPriceFlag.Name) {
+		cfg.MaxGasPrice = GlobalBig(ctx, SponsorGasPriceFlag.Name)
+	}
+}
+
+// SetGraphQLConfig applies GraphQL related command line flags to the config.
+func SetGraphQLConfig(ctx *cli.Context, cfg *graphql.Config) {
+	if ctx.GlobalIsSet(GraphQLListenAddrFlag.Name) {
+		cfg.Host = ctx.GlobalString(GraphQLListenAddrFlag.Name)
+	}
+	if ctx.GlobalIsSet(GraphQLPortFlag.Name) {
+		cfg.Port = ctx.GlobalInt(GraphQLPortFlag.Name)
+	}
+	if ctx.GlobalIsSet(GraphQLCORSDomainFlag.Name) {
+		cfg.Cors = splitAndTrim(ctx.GlobalString(GraphQLCORSDomainFlag.Name))
+	}
+	if ctx.GlobalIsSet(GraphQLVirtualHostsFlag.Name) {
+		cfg.VirtualHosts = splitAndTrim(ctx.GlobalString(GraphQLVirtualHostsFlag.Name))
+	}
+	if ctx.GlobalIsSet(GraphQLPageSizeFlag.Name) {
+		cfg.MaxPageSize = ctx.GlobalInt(GraphQLPageSizeFlag.Name)
+	}
+	if ctx.GlobalIsSet(GraphQLBlockRangeFlag.Name) {
+		cfg.MaxBlockRange = ctx.GlobalUint64(GraphQLBlockRangeFlag.Name)
+	}
+	if ctx.GlobalIsSet(GraphQLDepthFlag.Name) {
+		cfg.MaxDepth = ctx.GlobalInt(GraphQLDepthFlag.Name)
+	}
+	if ctx.GlobalIsSet(GraphQLComplexityFlag.Name) {
+		cfg.MaxComplexity = ctx.GlobalInt(GraphQLComplexityFlag.Name)
+	}
+}
+
+// RegisterGraphQLService adds the GraphQL endpoint to the stack.
+func RegisterGraphQLService(stack *node.Node, cfg *graphql.Config) {
+	if err := stack.Register(func(ctx *node.ServiceContext) (node.Service, error) {
+		var pistServ *pist.Pistchain
+		if err := ctx.Service(&pistServ); err != nil {
+			return nil, fmt.Errorf("GraphQL requires a full node: %v", err)
+		}
+		return graphql.New(cfg, pistServ.APIBackend, ctx.RPCAuth)
+	}); err != nil {
+		Fatalf("Failed to register the GraphQL service: %v", err)
+	}
+}
+
+// RegisterSponsorService adds the gas sponsorship service to the stack.
+func RegisterSponsorService(stack *node.Node, cfg *sponsor.Config) {
+	if err := stack.Register(func(ctx *node.ServiceContext) (node.Service, error) {
+		var pistServ *pist.Pistchain
+		ctx.Service(&pistServ)
+
+		db, err := ctx.OpenDatabase("sponsor", 16, 16)
+		if err != nil {
+			return nil, err
+		}
+		return sponsor.New(cfg, pistServ, db)
+	}); err != nil {
+		Fatalf("Failed to register the gas sponsorship service: %v", err)
+	}
+}
+
 // RegisterPistService adds an Pistchain client to the stack.
 func RegisterPistService(stack *node.Node, cfg *pist.Config) {
 	var err error
@@ -1181,7 +1341,7 @@ func MakeChainDatabase(ctx *cli.Context, stack *node.Node) pistdb.Database {
 	/*if ctx.GlobalBool(LightModeFlag.Name) {
 		name = "lightchaindata"
 	}*/
-	chainDb, err := stack.OpenDatabase(name, cache, handles)
+	chainDb, err := stack.OpenDatabaseWithFreezer(name, cache, handles, ctx.GlobalString(AncientFlag.Name), "")
 	if err != nil {
 		Fatalf("Could not open database: %v", err)
 	}
@@ -86,11 +86,11 @@ type Engine interface {
 	// rules of a particular engine. The changes are executed inline.
 	Prepare(chain ChainReader, header *types.Header) error
 	// Finalize runs any post-transaction state modifications (e.g. block rewards)
-	// and assembles the final block.
+	// and assembles the final block carrying the signs committing its parent.
 	// Note: The block header and state database might be updated to reflect any
 	// consensus rules that happen at finalization (e.g. block rewards).
 	Finalize(chain ChainReader, header *types.Header, state *state.StateDB, txs []*types.Transaction,
-		receipts []*types.Receipt, feeAmount *big.Int) (*types.Block, *types.ChainReward, error)
+		receipts []*types.Receipt, parentSigns []*types.PbftSign, feeAmount *big.Int) (*types.Block, *types.ChainReward, error)
 
 	FinalizeCommittee(block *types.Block) error
 
@@ -128,7 +128,7 @@ func makeImpawInitState(state *state.StateDB) bool {
 	stateAddress := types.StakingAddress
 	key := common.BytesToHash(stateAddress[:])
 	obj := state.GetPOSState(stateAddress, key)
-	if len(obj) == 0 {
+	if len(obj) == 0 && !vm.IsImpawnSharded(state, stateAddress) {
 		i := vm.NewImpawnImpl()
 		i.Save(state, stateAddress)
 		state.SetNonce(stateAddress, 1)
@@ -196,7 +196,7 @@ func NewFakeElection() *Election {
 	var priKeys []*ecdsa.PrivateKey
 	var members []*types.CommitteeMember
 
-	for i := 0; i < params.MinimumCommitteeNumber; i++ {
+	for i := 0; i < params.GetGoverned().MinimumCommitteeNumber; i++ {
 		priKey, err := crypto.GenerateKey()
 		priKeys = append(priKeys, priKey)
 		if err != nil {
@@ -29,6 +29,7 @@ import (
 	"git.taiyue.io/pist/go-pist/core/state"
 	"git.taiyue.io/pist/go-pist/core/types"
 	"git.taiyue.io/pist/go-pist/core/vm"
+	"git.taiyue.io/pist/go-pist/crypto"
 	"git.taiyue.io/pist/go-pist/log"
 	"git.taiyue.io/pist/go-pist/params"
 )
@@ -302,30 +303,56 @@ func (m *Minerva) Prepare(chain consensus.ChainReader, header *types.Header) err
 // Finalize implements consensus.Engine, accumulating the block fruit and uncle rewards,
 // setting the final state and assembling the block.
 func (m *Minerva) Finalize(chain consensus.ChainReader, header *types.Header, state *state.StateDB,
-	txs []*types.Transaction, receipts []*types.Receipt, feeAmount *big.Int) (*types.Block, *types.ChainReward, error) {
+	txs []*types.Transaction, receipts []*types.Receipt, parentSigns []*types.PbftSign, feeAmount *big.Int) (*types.Block, *types.ChainReward, error) {
 
 	if header.Number.Uint64() == 1 {
 		consensus.OnceInitImpawnState(state)
 	}
+	if chain != nil && header.Reward == 0 && chain.Config().IsStakingTrie(header.Number) {
+		if err := vm.MigrateImpawnState(state, types.StakingAddress); err != nil {
+			log.Error("Finalize Error", "MigrateImpawnState", err.Error())
+			return nil, nil, err
+		}
+	}
 	var infos *types.ChainReward
 	var err error
-	if header.Reward == 0 {
-		infos, err = accumulateRewardsFast(state, header.Number.Uint64(), header.Time.Uint64())
-		if err != nil {
-			log.Error("Finalize Error", "accumulateRewardsFast", err.Error())
+	if chain != nil && chain.Config().IsReward(header.Number) {
+		if header.Reward == 0 {
+			infos, err = m.accumulateRewardsPolicy(chain, state, header, parentSigns, feeAmount)
+			if err != nil {
+				log.Error("Finalize Error", "accumulateRewardsPolicy", err.Error())
+				return nil, nil, err
+			}
+		}
+	} else {
+		if header.Reward == 0 {
+			infos, err = accumulateRewardsFast(state, header.Number.Uint64(), header.Time.Uint64(), big.NewInt(0))
+			if err != nil {
+				log.Error("Finalize Error", "accumulateRewardsFast", err.Error())
+				return nil, nil, err
+			}
+			infos.Fee = feeAmount
+		}
+
+		if err := m.finalizeFastGas(state, header.Number, header.Hash(), feeAmount); err != nil {
 			return nil, nil, err
 		}
 	}
 
-	if err := m.finalizeFastGas(state, header.Number, header.Hash(), feeAmount); err != nil {
+	// the epochs and the parameters follow the governance state of the block
+	gov, err := vm.LoadGovernance(state)
+	if err != nil {
 		return nil, nil, err
 	}
+	if chain != nil && chain.Config().IsSlashing(header.Number) {
+		m.finalizeDowntime(chain, state, header, parentSigns, gov)
+	}
 
-	if err := m.finalizeValidators(chain, state, header.Number); err != nil {
+	if err := m.finalizeValidators(chain, state, header, parentSigns, gov); err != nil {
 		return nil, nil, err
 	}
 	header.Root = state.IntermediateRoot(true)
-	return types.NewBlock(header, txs, receipts, nil, nil), infos, nil
+	return types.NewBlockWithParentSigns(header, txs, receipts, nil, parentSigns), infos, nil
 }
 
 // FinalizeCommittee upddate current committee state
@@ -357,8 +384,8 @@ func (m *Minerva) finalizeFastGas(state *state.StateDB, fastNumber *big.Int, fas
 }
 
 // gas allocation
-func (m *Minerva) finalizeValidators(chain consensus.ChainReader, state *state.StateDB, fastNumber *big.Int) error {
-
+func (m *Minerva) finalizeValidators(chain consensus.ChainReader, state *state.StateDB, header *types.Header, parentSigns []*types.PbftSign, gov *vm.Governance) error {
+	fastNumber := header.Number
 	next := new(big.Int).Add(fastNumber, big1)
 	// init the first epoch in the fork
 	first := types.GetFirstEpoch()
@@ -366,6 +393,7 @@ func (m *Minerva) finalizeValidators(chain consensus.ChainReader, state *state.S
 	if first.BeginHeight == next.Uint64() {
 		i := vm.NewImpawnImpl()
 		error := i.Load(state, types.StakingAddress)
+		i.SetSlashing(chain != nil && chain.Config().IsSlashing(fastNumber))
 		if es, err := i.DoElections(first.EpochID, next.Uint64()); err != nil {
 			return err
 		} else {
@@ -377,11 +405,23 @@ func (m *Minerva) finalizeValidators(chain consensus.ChainReader, state *state.S
 		i.Save(state, types.StakingAddress)
 		log.Info("init in first forked,", "height", next, "epoch:", first.EpochID)
 	}
-	epoch := types.GetEpochFromHeight(fastNumber.Uint64())
-	if fastNumber.Uint64() == epoch.EndHeight-params.ElectionPoint {
+	epoch := gov.EpochFromHeight(fastNumber.Uint64())
+	point, _ := gov.ParamAt("ElectionPoint", epoch.EpochID)
+	if fastNumber.Uint64() == epoch.EndHeight-point.Uint64() {
+		var (
+			es  []*vm.StakingAccount
+			err error
+		)
 		i := vm.NewImpawnImpl()
 		error := i.Load(state, types.StakingAddress)
-		if es, err := i.DoElections(epoch.EpochID+1, fastNumber.Uint64()); err != nil {
+		i.SetSlashing(chain != nil && chain.Config().IsSlashing(fastNumber))
+		if chain != nil && chain.Config().IsRandomElection(fastNumber) {
+			seed := m.electionSeed(state, header, parentSigns, epoch.EpochID+1)
+			es, err = i.DoRandomElections(epoch.EpochID+1, fastNumber.Uint64(), seed)
+		} else {
+			es, err = i.DoElections(epoch.EpochID+1, fastNumber.Uint64())
+		}
+		if err != nil {
 			return err
 		} else {
 			log.Info("Do validators election", "height", fastNumber, "epoch:", epoch.EpochID+1, "len:", len(es), "err", error)
@@ -393,6 +433,13 @@ func (m *Minerva) finalizeValidators(chain consensus.ChainReader, state *state.S
 		i := vm.NewImpawnImpl()
 		err := i.Load(state, types.StakingAddress)
 		log.Info("Force new epoch", "height", fastNumber, "err", err)
+		// the parameter changes approved in the epoch take effect from the next one
+		if chain != nil && chain.Config().IsGovernance(fastNumber) {
+			if closed := gov.Tally(i, fastNumber.Uint64()); len(closed) > 0 {
+				gov.Save(state)
+			}
+		}
+		i.RestakeRewards(state, fastNumber.Uint64())
 		if err := i.Shift(epoch.EpochID + 1); err != nil {
 			return err
 		}
@@ -402,14 +449,76 @@ func (m *Minerva) finalizeValidators(chain consensus.ChainReader, state *state.S
 	return nil
 }
 
+// electionSeed derives the seed of the random election of epochid from the
+// signs committing the parent block, which the block carries and ValidateBody
+// verified, and stores it, chaining the seeds of the successive elections.
+func (m *Minerva) electionSeed(state *state.StateDB, header *types.Header, parentSigns []*types.PbftSign, epochid uint64) common.Hash {
+	seed := vm.ElectionSeed(vm.GetElectionSeed(state), epochid, parentSigns)
+	vm.SetElectionSeed(state, seed)
+	log.Info("Random election seed", "number", header.Number, "epoch", epochid, "signs", len(parentSigns), "seed", seed)
+	return seed
+}
+
+// finalizeDowntime records the validators missing from the signs committing the
+// parent block, which the block carries, and jails at the end of the epoch the
+// validators that signed too few of its blocks. The blocks switching committee
+// members carry no signs and aren't counted. The staking state is only written
+// for the validators missing a sign and at the end of the epoch.
+func (m *Minerva) finalizeDowntime(chain consensus.ChainReader, state *state.StateDB, header *types.Header, parentSigns []*types.PbftSign, gov *vm.Governance) {
+	if chain == nil || header.Number.Sign() == 0 {
+		return
+	}
+	record := header.Reward == 0 && chain.Config().IsParentSigns(header.Number)
+	end := gov.EpochFromHeight(header.Number.Uint64()).EndHeight == header.Number.Uint64()
+	if !record && !end {
+		return
+	}
+	i := vm.NewImpawnImpl()
+	if err := i.Load(state, types.StakingAddress); err != nil {
+		return
+	}
+	if record {
+		i.RecordSigns(header.Number.Uint64()-1, signerBases(parentSigns))
+	}
+	if !end {
+		i.SaveDowntime(state, types.StakingAddress)
+		return
+	}
+	records, amounts := i.JailDowntime(header.Number.Uint64())
+	if len(records) == 0 {
+		i.SaveDowntime(state, types.StakingAddress)
+		return
+	}
+	vm.BurnSlashed(state, amounts)
+	for _, record := range records {
+		log.Info("Slash validator for downtime", "number", header.Number, "address", record.Address, "amount", record.Amount, "jailed until", record.JailedUntil)
+	}
+	i.Save(state, types.StakingAddress)
+}
+
+// signerBases returns the addresses of the keys agreeing in signs, the signs
+// are checked against the committee along with the block carrying them.
+func signerBases(signs []*types.PbftSign) []common.Address {
+	var bases []common.Address
+	for _, sign := range signs {
+		if sign.Result != types.VoteAgree {
+			continue
+		}
+		pubkey, err := crypto.SigToPub(sign.HashWithNoSign().Bytes(), sign.Sign)
+		if err != nil {
+			continue
+		}
+		bases = append(bases, crypto.PubkeyToAddress(*pubkey))
+	}
+	return bases
+}
+
 //LogPrint log debug
 func LogPrint(info string, addr common.Address, amount *big.Int) {
 	log.Debug("[Consensus AddBalance]", "info", info, "CoinBase:", addr.String(), "amount", amount)
 }
 
-func accumulateRewardsFast(stateDB *state.StateDB, fast, btime uint64) (*types.ChainReward, error) {
-	committeeCoin := GetBlockReward(fast)
-
+func accumulateRewardsFast(stateDB *state.StateDB, fast, btime uint64, committeeCoin *big.Int) (*types.ChainReward, error) {
 	impawn := vm.NewImpawnImpl()
 	impawn.Load(stateDB, types.StakingAddress)
 	defer impawn.Save(stateDB, types.StakingAddress)
@@ -419,7 +528,11 @@ func accumulateRewardsFast(stateDB *state.StateDB, fast, btime uint64) (*types.C
 		return nil, err
 	}
 	for _, v := range infos {
-		for _, vv := range v.Items {
+		for k, vv := range v.Items {
+			// the first item is the validator, the delegators may keep their rewards
+			if k > 0 && impawn.AccrueReward(v.Items[0].Address, vv.Address, vv.Amount) {
+				continue
+			}
 			stateDB.AddBalance(vv.Address, vv.Amount)
 			LogPrint("committee:", vv.Address, vv.Amount)
 		}
@@ -429,6 +542,101 @@ func accumulateRewardsFast(stateDB *state.StateDB, fast, btime uint64) (*types.C
 }
 
 //GetBlockReward Reward for block allocation
-func GetBlockReward(num uint64) *big.Int {
-	return big.NewInt(0)
+func GetBlockReward(config *params.ChainConfig, num *big.Int) *big.Int {
+	if !config.IsReward(num) || config.Reward.BlockReward == nil {
+		return big.NewInt(0)
+	}
+	return new(big.Int).Set(config.Reward.BlockReward)
+}
+
+// accumulateRewardsPolicy pays the fees and the block reward by the reward policy
+// of the chain. The committee share goes to the members agreeing on the parent
+// block, the block carries their signs and its committee root commits to them.
+func (m *Minerva) accumulateRewardsPolicy(chain consensus.ChainReader, stateDB *state.StateDB,
+	header *types.Header, parentSigns []*types.PbftSign, feeAmount *big.Int) (*types.ChainReward, error) {
+	config := chain.Config()
+	blockReward := GetBlockReward(config, header.Number)
+	fee := big.NewInt(0)
+	if feeAmount != nil {
+		fee.Set(feeAmount)
+	}
+	members, err := m.parentSigners(parentSigns)
+	if err != nil {
+		return nil, err
+	}
+	shares, delegators := splitReward(config.Reward, new(big.Int).Add(fee, blockReward), header.Proposer, members)
+
+	infos, err := accumulateRewardsFast(stateDB, header.Number.Uint64(), header.Time.Uint64(), delegators)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range shares {
+		stateDB.AddBalance(v.Address, v.Amount)
+		LogPrint("reward policy:", v.Address, v.Amount)
+	}
+	infos.Fee, infos.BlockReward, infos.Shares = fee, blockReward, shares
+	return infos, nil
+}
+
+// parentSigners returns the coinbases of the committee members agreeing on the
+// parent block, each once and in the order of the signs.
+func (m *Minerva) parentSigners(signs []*types.PbftSign) ([]common.Address, error) {
+	var agree []*types.PbftSign
+	for _, sign := range signs {
+		if sign.Result == types.VoteAgree {
+			agree = append(agree, sign)
+		}
+	}
+	if len(agree) == 0 {
+		return nil, nil
+	}
+	signers, errs := m.election.VerifySigns(agree)
+	var (
+		members []common.Address
+		seen    = make(map[common.Address]bool)
+	)
+	for i, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+		if member := signers[i]; member != nil && !seen[member.Coinbase] {
+			seen[member.Coinbase] = true
+			members = append(members, member.Coinbase)
+		}
+	}
+	return members, nil
+}
+
+// splitReward splits total by the rates of policy, it returns the shares of the
+// proposer, the committee members and the treasury, and the amount left to the
+// stakers.
+// The shares nobody can take and the remainders of the divisions go to the
+// treasury, so that the shares always add up to total.
+func splitReward(policy *params.RewardConfig, total *big.Int, proposer common.Address, members []common.Address) ([]*types.RewardShare, *big.Int) {
+	part := func(rate uint64) *big.Int {
+		return new(big.Int).Quo(new(big.Int).Mul(total, new(big.Int).SetUint64(rate)), types.Base)
+	}
+	var (
+		shares     []*types.RewardShare
+		delegators = part(policy.Delegators)
+		treasury   = new(big.Int).Sub(total, delegators)
+	)
+	if amount := part(policy.Proposer); amount.Sign() > 0 && proposer != (common.Address{}) {
+		shares = append(shares, &types.RewardShare{Kind: types.RewardShareProposer, Address: proposer, Amount: amount})
+		treasury.Sub(treasury, amount)
+	}
+	if committee := part(policy.Committee); committee.Sign() > 0 && len(members) > 0 {
+		each := new(big.Int).Quo(committee, big.NewInt(int64(len(members))))
+		for _, addr := range members {
+			if each.Sign() <= 0 {
+				break
+			}
+			shares = append(shares, &types.RewardShare{Kind: types.RewardShareCommittee, Address: addr, Amount: new(big.Int).Set(each)})
+			treasury.Sub(treasury, each)
+		}
+	}
+	if treasury.Sign() > 0 {
+		shares = append(shares, &types.RewardShare{Kind: types.RewardShareTreasury, Address: policy.TreasuryAddress, Amount: treasury})
+	}
+	return shares, delegators
 }
@@ -19,7 +19,10 @@ package minerva
 import (
 	"encoding/json"
 	"fmt"
+	"git.taiyue.io/pist/go-pist/common"
 	"git.taiyue.io/pist/go-pist/common/math"
+	"git.taiyue.io/pist/go-pist/core/types"
+	"git.taiyue.io/pist/go-pist/params"
 	"math/big"
 	"testing"
 	"time"
@@ -85,3 +88,89 @@ func TestTime(t *testing.T) {
 	}
 	fmt.Println("finish")
 }
+
+func TestSplitReward(t *testing.T) {
+	policy := &params.RewardConfig{
+		Block:           big.NewInt(0),
+		Proposer:        1000,
+		Committee:       3000,
+		Delegators:      5000,
+		Treasury:        1000,
+		TreasuryAddress: common.Address{0xff},
+	}
+	total := big.NewInt(1000003)
+	proposer := common.Address{0x01}
+	signers := []common.Address{{0x02}, {0x03}, {0x04}}
+
+	shares, delegators := splitReward(policy, total, proposer, signers)
+	if delegators.Cmp(big.NewInt(500001)) != 0 {
+		t.Fatalf("delegators share mismatch: have %v", delegators)
+	}
+	sum := new(big.Int).Set(delegators)
+	got := make(map[common.Address]*big.Int)
+	for _, v := range shares {
+		sum.Add(sum, v.Amount)
+		got[v.Address] = v.Amount
+	}
+	if sum.Cmp(total) != 0 {
+		t.Fatalf("shares add up to %v, want %v", sum, total)
+	}
+	if got[proposer].Cmp(big.NewInt(100000)) != 0 || got[signers[0]].Cmp(big.NewInt(100000)) != 0 {
+		t.Fatalf("shares mismatch: %v", got)
+	}
+	// the committee share goes to the treasury without any signer
+	shares, _ = splitReward(policy, total, proposer, nil)
+	if len(shares) != 2 || shares[1].Kind != types.RewardShareTreasury || shares[1].Amount.Cmp(big.NewInt(400002)) != 0 {
+		t.Fatalf("treasury share mismatch: %v", shares[len(shares)-1].Amount)
+	}
+}
+
+func TestParentSigners(t *testing.T) {
+	m := NewFaker()
+	members := m.election.(*fakeElection).members
+	block := types.NewBlock(&types.Header{Number: big.NewInt(10)}, nil, nil, nil, nil)
+	signs, err := m.election.GenerateFakeSigns(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the members against the parent and the repeated signs get nothing
+	signs = signs[:5]
+	signs[4].Result = types.VoteAgreeAgainst
+	signs = append(signs, signs[0])
+
+	signers, err := m.parentSigners(signs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(signers) != 4 {
+		t.Fatalf("signers mismatch: have %d, want 4", len(signers))
+	}
+	for i, addr := range signers {
+		if addr != members[i].Coinbase {
+			t.Errorf("signer %d mismatch: have %x, want %x", i, addr, members[i].Coinbase)
+		}
+	}
+	if signers, err := m.parentSigners(nil); err != nil || len(signers) != 0 {
+		t.Fatalf("signers of no sign: %v %v", signers, err)
+	}
+}
+
+func TestSignerBases(t *testing.T) {
+	m := NewFaker()
+	members := m.election.(*fakeElection).members
+	block := types.NewBlock(&types.Header{Number: big.NewInt(10)}, nil, nil, nil, nil)
+	signs, err := m.election.GenerateFakeSigns(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signs[0].Result = types.VoteAgreeAgainst
+	bases := signerBases(signs)
+	if len(bases) != len(members)-1 {
+		t.Fatalf("signers mismatch: have %d, want %d", len(bases), len(members)-1)
+	}
+	for i, addr := range bases {
+		if addr != members[i+1].CommitteeBase {
+			t.Errorf("signer %d mismatch: have %x, want %x", i, addr, members[i+1].CommitteeBase)
+		}
+	}
+}
@@ -101,7 +101,10 @@ func GetCommittee(cnt int) []map[string]interface{} {
 
 func TestOutGenesisJson(t *testing.T) {
 
-	b, _ := ioutil.ReadFile("E:\\pistchain\\src\\github.com\\pistchain\\pist\\cmd\\gpist\\genesis.json")
+	b, err := ioutil.ReadFile("E:\\pistchain\\src\\github.com\\pistchain\\pist\\cmd\\gpist\\genesis.json")
+	if err != nil {
+		t.Skip("no genesis to split:", err)
+	}
 	out := make(map[string]interface{})
 	json.Unmarshal(b, &out)
 	for i := 1; i <= 52; i++ {
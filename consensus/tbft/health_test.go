@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math/big"
+	"os"
 	"path/filepath"
 	"sync"
 	"sync/atomic"
@@ -371,6 +372,9 @@ func TestRlpBlock(t *testing.T) {
 }
 
 func TestPbftRunForHealth(t *testing.T) {
+	root := testRoot(t)
+	defer os.RemoveAll(root)
+
 	log.OpenLogDebug(3)
 	IDCacheInit()
 	start := make(chan int)
@@ -395,6 +399,7 @@ func TestPbftRunForHealth(t *testing.T) {
 	con1 := new(config.ConsensusConfig)
 	*con1 = *config1.Consensus
 	con1.WalPath = filepath.Join("data", "cs.wal1", "wal")
+	con1.RootDir = root
 	*config1.Consensus = *con1
 
 	n1, _ := NewNode(config1, "1", pr1, agent1)
@@ -411,6 +416,7 @@ func TestPbftRunForHealth(t *testing.T) {
 	con2 := new(config.ConsensusConfig)
 	*con2 = *config2.Consensus
 	con2.WalPath = filepath.Join("data", "cs.wal2", "wal")
+	con2.RootDir = root
 	*config2.Consensus = *con2
 
 	n2, _ := NewNode(config2, "1", pr2, agent2)
@@ -427,6 +433,7 @@ func TestPbftRunForHealth(t *testing.T) {
 	con3 := new(config.ConsensusConfig)
 	*con3 = *config3.Consensus
 	con3.WalPath = filepath.Join("data", "cs.wal3", "wal")
+	con3.RootDir = root
 	*config3.Consensus = *con3
 
 	n3, _ := NewNode(config3, "1", pr3, agent3)
@@ -443,6 +450,7 @@ func TestPbftRunForHealth(t *testing.T) {
 	con4 := new(config.ConsensusConfig)
 	*con4 = *config4.Consensus
 	con4.WalPath = filepath.Join("data", "cs.wal4", "wal")
+	con4.RootDir = root
 	*config4.Consensus = *con4
 
 	n4, _ := NewNode(config4, "1", pr4, agent4)
@@ -518,6 +526,9 @@ func CloseStart(c chan int) {
 }
 
 func TestRunPbftChange1(t *testing.T) {
+	root := testRoot(t)
+	defer os.RemoveAll(root)
+
 	log.OpenLogDebug(3)
 	IDCacheInit()
 	start := make(chan int)
@@ -540,6 +551,7 @@ func TestRunPbftChange1(t *testing.T) {
 	con1 := new(config.ConsensusConfig)
 	*con1 = *config1.Consensus
 	con1.WalPath = filepath.Join("data", "cs.wal1", "wal")
+	con1.RootDir = root
 	*config1.Consensus = *con1
 
 	n1, _ := NewNode(config1, "1", pr1, agent1)
@@ -605,6 +617,9 @@ func TestRunPbftChange1(t *testing.T) {
 }
 
 func TestRunPbftChange2(t *testing.T) {
+	root := testRoot(t)
+	defer os.RemoveAll(root)
+
 	log.OpenLogDebug(3)
 	IDCacheInit()
 	start := make(chan int)
@@ -627,6 +642,7 @@ func TestRunPbftChange2(t *testing.T) {
 	con2 := new(config.ConsensusConfig)
 	*con2 = *config2.Consensus
 	con2.WalPath = filepath.Join("data", "cs.wal2", "wal")
+	con2.RootDir = root
 	*config2.Consensus = *con2
 
 	n2, _ := NewNode(config2, "1", pr2, agent2)
@@ -692,6 +708,9 @@ func TestRunPbftChange2(t *testing.T) {
 }
 
 func TestRunPbftChange3(t *testing.T) {
+	root := testRoot(t)
+	defer os.RemoveAll(root)
+
 	log.OpenLogDebug(3)
 	IDCacheInit()
 	start := make(chan int)
@@ -714,6 +733,7 @@ func TestRunPbftChange3(t *testing.T) {
 	con3 := new(config.ConsensusConfig)
 	*con3 = *config3.Consensus
 	con3.WalPath = filepath.Join("data", "cs.wal3", "wal")
+	con3.RootDir = root
 	*config3.Consensus = *con3
 
 	n3, _ := NewNode(config3, "1", pr3, agent3)
@@ -779,6 +799,8 @@ func TestRunPbftChange3(t *testing.T) {
 }
 
 func TestRunPbftChange4(t *testing.T) {
+	root := testRoot(t)
+	defer os.RemoveAll(root)
 
 	log.OpenLogDebug(3)
 	IDCacheInit()
@@ -802,6 +824,7 @@ func TestRunPbftChange4(t *testing.T) {
 	con4 := new(config.ConsensusConfig)
 	*con4 = *config4.Consensus
 	con4.WalPath = filepath.Join("data", "cs.wal4", "wal")
+	con4.RootDir = root
 	*config4.Consensus = *con4
 
 	n4, _ := NewNode(config4, "1", pr4, agent4)
@@ -869,6 +892,9 @@ func TestRunPbftChange4(t *testing.T) {
 var Tbft5Start = big.NewInt(9)
 
 func TestRunPbftChange5(t *testing.T) {
+	root := testRoot(t)
+	defer os.RemoveAll(root)
+
 	log.OpenLogDebug(3)
 	IDCacheInit()
 	start := make(chan int)
@@ -891,6 +917,7 @@ func TestRunPbftChange5(t *testing.T) {
 	con4 := new(config.ConsensusConfig)
 	*con4 = *config5.Consensus
 	con4.WalPath = filepath.Join("data", "cs.wal4", "wal")
+	con4.RootDir = root
 	*config5.Consensus = *con4
 
 	n4, _ := NewNode(config5, "1", pr5, agent5)
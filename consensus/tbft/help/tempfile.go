@@ -120,6 +120,10 @@ func WriteFileAtomic(filename string, data []byte, perm os.FileMode) (err error)
 	} else if n < len(data) {
 		return io.ErrShortWrite
 	}
+	// Make sure the data hit the disk before the rename makes it visible.
+	if err := f.Sync(); err != nil {
+		return err
+	}
 	// Close the file before renaming it, otherwise it will cause "The process
 	// cannot access the file because it is being used by another process." on windows.
 	f.Close()
@@ -66,7 +66,7 @@ func newNodeService(p2pcfg *cfg.P2PConfig, cscfg *cfg.ConsensusConfig, state *tt
 	store *ttypes.BlockStore, cid uint64) *service {
 	return &service{
 		sw:             tp2p.NewSwitch(p2pcfg, state),
-		consensusState: NewConsensusState(cscfg, state, store),
+		consensusState: NewConsensusState(cscfg, state, store, WithWALFile(cscfg.CommitteeWalFile(cid))),
 		// nodeTable:      make(map[p2p.ID]*nodeInfo),
 		lock:       new(sync.Mutex),
 		updateChan: make(chan bool, 2),
@@ -123,12 +123,19 @@ func (s *service) start(cid *big.Int, node *Node) error {
 		log.New("p2p", "self"))
 	s.sw.AddListener(l)
 
-	privValidator := ttypes.NewPrivValidator(*node.priv)
+	stateFile, err := node.config.Consensus.CommitteePrivValidatorStateFile(cid.Uint64())
+	if err != nil {
+		return err
+	}
+	privValidator, err := ttypes.NewFilePrivValidator(*node.priv, stateFile)
+	if err != nil {
+		return err
+	}
 	s.consensusState.SetPrivValidator(privValidator)
 	s.sa.SetPrivValidator(privValidator)
 	// Start the switch (the P2P server).
 	help.CheckAndPrintError(s.healthMgr.OnStart())
-	err := s.sw.Start()
+	err = s.sw.Start()
 	if err != nil {
 		return err
 	}
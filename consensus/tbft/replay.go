@@ -0,0 +1,110 @@
+package tbft
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+
+	"git.taiyue.io/pist/go-pist/log"
+)
+
+//-----------------------------------------
+// Recover from failure during consensus
+// by replaying messages from the WAL
+//-----------------------------------------
+
+// Unmarshal and apply a single message to the consensus state as if it were
+// received in receiveRoutine.
+// NOTE: receiveRoutine should not be running.
+func (cs *ConsensusState) readReplayMessage(msg *TimedWALMessage) error {
+	// Skip meta messages which exist for demarcating boundaries.
+	if _, ok := msg.Msg.(EndHeightMessage); ok {
+		return nil
+	}
+
+	switch m := msg.Msg.(type) {
+	case msgInfo:
+		peerID := m.PeerID
+		if peerID == "" {
+			peerID = "local"
+		}
+		log.Debug("Replay: msg", "height", cs.Height, "round", cs.Round, "peer", peerID, "type", reflect.TypeOf(m.Msg))
+		cs.handleMsg(m)
+	case timeoutInfo:
+		log.Debug("Replay: timeout", "height", m.Height, "round", m.Round, "step", m.Step, "dur", m.Duration)
+		cs.handleTimeout(m, cs.RoundState)
+	default:
+		return fmt.Errorf("replay: Unknown TimedWALMessage type: %v", reflect.TypeOf(msg.Msg))
+	}
+	return nil
+}
+
+// Replay only those messages since the last block. `timeoutRoutine` should
+// run concurrently to read off tickChan.
+// The heights the node caught up through block sync while it was down are
+// not in the WAL, in that case there is nothing to replay.
+func (cs *ConsensusState) catchupReplay(csHeight uint64) error {
+	// Ensure that #ENDHEIGHT for this height doesn't exist.
+	// NOTE: This is just a sanity check, we can crash after writing #ENDHEIGHT
+	// but before the agent has stored the block.
+	gr, found, err := cs.wal.SearchForEndHeight(csHeight, &WALSearchOptions{IgnoreDataCorruptionErrors: true})
+	if err != nil {
+		return err
+	}
+	if gr != nil {
+		if err := gr.Close(); err != nil {
+			return err
+		}
+	}
+	if found {
+		log.Warn("WAL already contains #ENDHEIGHT of the current height, skip replay", "height", csHeight)
+		return nil
+	}
+
+	// Search for last height marker.
+	//
+	// Ignore data corruption errors in previous heights because we only care about last height
+	endHeight := csHeight - 1
+	gr, found, err = cs.wal.SearchForEndHeight(endHeight, &WALSearchOptions{IgnoreDataCorruptionErrors: true})
+	if err == io.EOF {
+		log.Error("Replay: wal.group.Search returned EOF", "#ENDHEIGHT", endHeight)
+	} else if err != nil {
+		return err
+	}
+	if !found {
+		log.Info("Nothing to replay from consensus WAL", "height", csHeight, "#ENDHEIGHT", endHeight)
+		return nil
+	}
+	defer gr.Close() // nolint: errcheck
+
+	log.Info("Catchup by replaying consensus messages", "height", csHeight)
+
+	var (
+		msg      *TimedWALMessage
+		replayed int
+		dec      = NewWALDecoder(gr)
+	)
+	for {
+		msg, err = dec.Decode()
+		if err == io.EOF {
+			break
+		} else if IsDataCorruptionError(err) {
+			// A record torn by a crash in the middle of a write was never
+			// synced, so it was never acted upon. Stop at the last good one.
+			log.Warn("Replay: data has been corrupted in last height of consensus WAL", "err", err, "height", csHeight, "replayed", replayed)
+			break
+		} else if err != nil {
+			return err
+		}
+
+		// NOTE: since the priv key is set when the msgs are received
+		// it will attempt to eg double sign but we can just ignore it
+		// since the votes will be replayed and we'll get to the next step
+		if err := cs.readReplayMessage(msg); err != nil {
+			return err
+		}
+		replayed++
+	}
+	log.Info("Replay: Done", "height", cs.Height, "round", cs.Round, "step", cs.Step, "replayed", replayed)
+	return nil
+}
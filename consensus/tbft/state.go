@@ -88,6 +88,12 @@ type ConsensusState struct {
 	// and to notify external subscribers, eg. through a websocket
 	eventBus *ttypes.EventBus
 
+	// a Write-Ahead Log ensures we can recover from any kind of crash
+	// and helps us avoid signing conflicting votes
+	wal          WAL
+	walFile      string
+	doWALCatchup bool // determines if we even try to do the catchup
+
 	// for tests where we want to limit the number of transitions the state makes
 	nSteps int
 
@@ -110,6 +116,12 @@ type ConsensusState struct {
 // CSOption sets an optional parameter on the ConsensusState.
 type CSOption func(*ConsensusState)
 
+// WithWALFile sets the file the ConsensusState keeps its write-ahead log in.
+// Without it nothing is logged and there is nothing to replay on restart.
+func WithWALFile(walFile string) CSOption {
+	return func(cs *ConsensusState) { cs.walFile = walFile }
+}
+
 // NewConsensusState returns a new ConsensusState.
 func NewConsensusState(
 	config *cfg.ConsensusConfig,
@@ -128,6 +140,8 @@ func NewConsensusState(
 		state:            state,
 		evsw:             ttypes.NewEventSwitch(),
 		svs:              make([]*ttypes.SwitchValidator, 0, 0),
+		wal:              nilWAL{},
+		doWALCatchup:     true,
 	}
 	// set function defaults (may be overwritten before calling Start)
 	cs.decideProposal = cs.defaultDecideProposal
@@ -224,6 +238,16 @@ func (cs *ConsensusState) OnStart() error {
 	if err := cs.evsw.Start(); err != nil {
 		return err
 	}
+	// we may set the WAL in testing before calling Start,
+	// so only OpenWAL if its still the nilWAL
+	if _, ok := cs.wal.(nilWAL); ok && cs.walFile != "" {
+		wal, err := cs.OpenWAL(cs.walFile)
+		if err != nil {
+			log.Error("Error loading ConsensusState wal", "err", err)
+			return err
+		}
+		cs.wal = wal
+	}
 	// we need the timeoutRoutine for replay so
 	// we don't block on the tick chan.
 	// NOTE: we will get a build up of garbage go routines
@@ -236,6 +260,15 @@ func (cs *ConsensusState) OnStart() error {
 		return err
 	}
 	cs.updateToState(cs.state)
+	// we may have lost some votes if the process crashed
+	// reload from consensus log to catchup
+	if cs.doWALCatchup {
+		if err := cs.catchupReplay(cs.Height); err != nil {
+			log.Error("Error on catchup replay. Proceeding to start ConsensusState anyway", "err", err)
+			// NOTE: if we ever do return an error here,
+			// make sure to stop the timeoutTicker
+		}
+	}
 	// now start the receiveRoutine
 	go cs.receiveRoutine(0)
 
@@ -277,6 +310,19 @@ func (cs *ConsensusState) Wait() {
 	<-cs.done
 }
 
+// OpenWAL opens a file to log all consensus messages and timeouts for deterministic accountability
+func (cs *ConsensusState) OpenWAL(walFile string) (WAL, error) {
+	wal, err := NewWAL(walFile)
+	if err != nil {
+		log.Error("Failed to open WAL for consensus state", "wal", walFile, "err", err)
+		return nil, err
+	}
+	if err := wal.Start(); err != nil {
+		return nil, err
+	}
+	return wal, nil
+}
+
 //------------------------------------------------------------
 // Public interface for passing messages into the consensus state, possibly causing a state transition.
 // If peerID == "", the msg is considered internal.
@@ -378,9 +424,10 @@ func (cs *ConsensusState) updateStateForSync() {
 	oldH := cs.Height
 	newH := cs.state.GetLastBlockHeight() + 1
 	if oldH != newH {
+		// heights fetched by block sync never reach finalizeCommit, mark the
+		// boundary so a restart replays from the new height on.
+		cs.writeEndHeight(newH - 1)
 		cs.updateToState(cs.state)
-		log.Debug("Reset privValidator", "height", cs.Height)
-		cs.state.PrivReset()
 		sleepDuration := time.Duration(1) * time.Millisecond
 		cs.timeoutTicker.ScheduleTimeout(timeoutInfo{sleepDuration, cs.Height, uint(0), ttypes.RoundStepNewHeight, 1})
 	}
@@ -502,10 +549,12 @@ func (cs *ConsensusState) validatorUpdate(msg *ValidatorUpdateMessage) {
 	}
 	help.CheckAndPrintError(cs.state.UpdateValidator(msg.vset, true))
 	cs.updateToState(cs.state)
-	cs.state.PrivReset()
 	cs.state.SetEndHeight(msg.eHeight)
 	cs.state.SetBeginHeight(msg.uHeight)
 	newHeight := cs.Height
+	if newHeight != oldHeight {
+		cs.writeEndHeight(newHeight - 1)
+	}
 
 	if newHeight == oldHeight && round > 0 {
 		log.Trace("ValidatorUpdate,has same height in current consensus", "oldHeight", oldHeight, "newHeight", newHeight)
@@ -532,6 +581,10 @@ func (cs *ConsensusState) receiveRoutine(maxSteps int) {
 		// NOTE: the internalMsgQueue may have signed messages from our
 		// priv_val that haven't hit the WAL, but its ok because
 		// priv_val tracks LastSig
+
+		// close wal now that we're done writing to it
+		help.CheckAndPrintError(cs.wal.Stop())
+		cs.wal.Wait()
 		log.Debug("Exit receiveRoutine")
 		close(cs.done)
 	}
@@ -564,13 +617,18 @@ func (cs *ConsensusState) receiveRoutine(maxSteps int) {
 
 		select {
 		case mi = <-cs.peerMsgQueue:
+			cs.writeMsgInfo(mi, false)
 			// handles proposals, block parts, votes
 			// may generate internal events (votes, complete proposals, 2/3 majorities)
 			cs.handleMsg(mi)
 		case mi = <-cs.internalMsgQueue:
+			cs.writeMsgInfo(mi, true)
 			// handles proposals, block parts, votes
 			cs.handleMsg(mi)
 		case ti := <-cs.timeoutTicker.Chan(): // tockChan:
+			if err := cs.wal.Write(ti); err != nil {
+				log.Error("Error writing timeout to consensus wal", "err", err)
+			}
 			// if the timeout is relevant to the rs
 			// go to the next step
 			cs.handleTimeout(ti, rs)
@@ -585,6 +643,32 @@ func (cs *ConsensusState) receiveRoutine(maxSteps int) {
 	}
 }
 
+// writeMsgInfo logs a message before it is handled. Our own proposals and
+// votes are synced to disk before they take effect, so that a restart never
+// signs something different for the same height and round.
+func (cs *ConsensusState) writeMsgInfo(mi msgInfo, sync bool) {
+	// committee updates are delivered again by the agent after a restart
+	if _, ok := mi.Msg.(*ValidatorUpdateMessage); ok {
+		return
+	}
+	if !sync {
+		if err := cs.wal.Write(mi); err != nil {
+			log.Error("Error writing msg to consensus wal", "err", err)
+		}
+		return
+	}
+	if err := cs.wal.WriteSync(mi); err != nil {
+		help.PanicSanity(fmt.Sprintf("Failed to write %v msg to consensus wal due to %v. Check your FS and restart the node", mi, err))
+	}
+}
+
+// writeEndHeight marks in the wal that the given height is done with.
+func (cs *ConsensusState) writeEndHeight(height uint64) {
+	if err := cs.wal.WriteSync(EndHeightMessage{height}); err != nil {
+		log.Error("Error writing end height to consensus wal", "height", height, "err", err)
+	}
+}
+
 // state transitions on complete-proposal, 2/3-any, 2/3-one
 func (cs *ConsensusState) handleMsg(mi msgInfo) {
 	cs.mtx.Lock()
@@ -1309,6 +1393,9 @@ func (cs *ConsensusState) finalizeCommit(height uint64) {
 		log.Debug("Error on ApplyBlock. Did the application crash? Please restart gpist", "err", err)
 		return
 	}
+	// Write EndHeightMessage{} for this height, implying that the agent has
+	// received the block. A restart replays the messages after it.
+	cs.writeEndHeight(height)
 	// Save to blockStore.
 	if cs.blockStore.MaxBlockHeight() < block.NumberU64() {
 		// NOTE: the seenCommit is local justification to commit this block,
@@ -1457,6 +1544,7 @@ func (cs *ConsensusState) tryAddVote(vote *ttypes.Vote, peerID string) error {
 				return err
 			}
 			log.Debug("Found conflicting vote.", "height", vote.Height, "round", vote.Round, "type", vote.Type)
+			cs.reportConflictingVote(vote)
 			return err
 		}
 		// Probably an invalid signature / Bad peer.
@@ -1471,6 +1559,28 @@ func (cs *ConsensusState) tryAddVote(vote *ttypes.Vote, peerID string) error {
 
 //-----------------------------------------------------------------------------
 
+// reportConflictingVote logs the evidence of a validator that signed vote after
+// another one of the same step, anyone can submit it to the staking contract to
+// slash the validator.
+func (cs *ConsensusState) reportConflictingVote(vote *ttypes.Vote) {
+	var voteSet *ttypes.VoteSet
+	switch {
+	case vote.Height+1 == cs.Height:
+		voteSet = cs.LastCommit
+	case vote.Type == ttypes.VoteTypePrevote:
+		voteSet = cs.Votes.Prevotes(int(vote.Round))
+	default:
+		voteSet = cs.Votes.Precommits(int(vote.Round))
+	}
+	existing := voteSet.GetByAddress(vote.ValidatorAddress)
+	if existing == nil || existing.BlockID.Equals(vote.BlockID) {
+		return
+	}
+	ev := ttypes.NewDuplicateVoteEvidence(cs.state.GetChainID(), existing, vote)
+	log.Warn("Found double signing validator", "height", vote.Height, "round", vote.Round, "type", vote.Type,
+		"validator", hexutil.Encode(vote.ValidatorAddress), "evidence", hexutil.Encode(ev.Bytes()))
+}
+
 func (cs *ConsensusState) addVote(vote *ttypes.Vote, peerID string) (added bool, err error) {
 	log.Debug("addVote", "voteHeight", vote.Height, "voteType", vote.Type, "valIndex", vote.ValidatorIndex, "csHeight", cs.Height)
 	// A precommit for the previous height?
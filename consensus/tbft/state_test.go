@@ -2,8 +2,13 @@ package tbft
 
 import (
 	"fmt"
-	"git.taiyue.io/pist/go-pist/consensus/tbft/help"
+	"io/ioutil"
+	"os"
 	"testing"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/consensus/tbft/help"
+	ttypes "git.taiyue.io/pist/go-pist/consensus/tbft/types"
 )
 
 type Test struct {
@@ -32,3 +37,31 @@ func TestChanDate(t *testing.T) {
 	test.A = 2
 	fmt.Println(<-c)
 }
+
+// TestValidatorUpdateKeepsLastSigned checks that a committee update at the
+// height being voted on doesn't let the validator sign a conflicting vote.
+func TestValidatorUpdateKeepsLastSigned(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tbft-state")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	n := newReplayTestNode(t, dir)
+	defer n.cs.wal.Stop()
+	n.cs.enterNewRound(n.cs.Height, 0)
+	n.process()
+	height := n.cs.Height
+	if n.cs.Votes.Prevotes(0).GetByAddress(n.cs.privValidator.GetAddress()) == nil {
+		t.Fatal("no prevote signed")
+	}
+
+	n.cs.validatorUpdate(&ValidatorUpdateMessage{vset: n.vset.Copy(), uHeight: height})
+	if n.cs.Height != height || n.cs.Round != 0 {
+		t.Fatalf("update moved to %d/%d, want %d/0", n.cs.Height, n.cs.Round, height)
+	}
+	hash := common.Hash{0x01}
+	if _, err := n.cs.signVote(ttypes.VoteTypePrevote, hash[:], ttypes.PartSetHeader{}); err == nil {
+		t.Fatal("conflicting prevote signed after validator update")
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"crypto/ecdsa"
 	"encoding/hex"
 	"fmt"
+	"io/ioutil"
 	"math/big"
+	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -181,12 +183,15 @@ func GetPubKey(priv *ecdsa.PrivateKey) *ecdsa.PublicKey {
 }
 
 func TestPbftRunForOne(t *testing.T) {
+	root := testRoot(t)
+	defer os.RemoveAll(root)
+
 	//log.OpenLogDebug(4)
 	IDCacheInit()
 	start := make(chan int)
 	pr := getPrivateKey(0)
 	agent1 := NewPbftAgent("Agent1")
-	n, _ := NewNode(config.DefaultConfig(), "1", pr, agent1)
+	n, _ := NewNode(config.DefaultConfig().SetRoot(root), "1", pr, agent1)
 	n.Start()
 	c1 := new(types.CommitteeInfo)
 	c1.Id = big.NewInt(1)
@@ -200,7 +205,19 @@ func TestPbftRunForOne(t *testing.T) {
 	<-start
 }
 
+// testRoot returns the directory of the files the nodes of a test write.
+func testRoot(t *testing.T) string {
+	root, err := ioutil.TempDir("", "tbft")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return root
+}
+
 func TestPbftRunFor2(t *testing.T) {
+	root := testRoot(t)
+	defer os.RemoveAll(root)
+
 	//log.OpenLogDebug(3)
 	IDCacheInit()
 	start := make(chan int)
@@ -221,6 +238,7 @@ func TestPbftRunFor2(t *testing.T) {
 	con1 := new(config.ConsensusConfig)
 	*con1 = *config1.Consensus
 	con1.WalPath = filepath.Join("data", "cs.wal1", "wal")
+	con1.RootDir = root
 	*config1.Consensus = *con1
 
 	n1, _ := NewNode(config1, "1", pr1, agent1)
@@ -237,6 +255,7 @@ func TestPbftRunFor2(t *testing.T) {
 	con2 := new(config.ConsensusConfig)
 	*con2 = *config2.Consensus
 	con2.WalPath = filepath.Join("data", "cs.wal2", "wal")
+	con2.RootDir = root
 	*config2.Consensus = *con2
 
 	n2, _ := NewNode(config2, "1", pr2, agent2)
@@ -270,6 +289,9 @@ func TestPbftRunFor2(t *testing.T) {
 }
 
 func TestPbftRunFor4(t *testing.T) {
+	root := testRoot(t)
+	defer os.RemoveAll(root)
+
 	log.OpenLogDebug(3)
 	IDCacheInit()
 	start := make(chan int)
@@ -294,6 +316,7 @@ func TestPbftRunFor4(t *testing.T) {
 	con1 := new(config.ConsensusConfig)
 	*con1 = *config1.Consensus
 	con1.WalPath = filepath.Join("data", "cs.wal1", "wal")
+	con1.RootDir = root
 	*config1.Consensus = *con1
 
 	n1, _ := NewNode(config1, "1", pr1, agent1)
@@ -310,6 +333,7 @@ func TestPbftRunFor4(t *testing.T) {
 	con2 := new(config.ConsensusConfig)
 	*con2 = *config2.Consensus
 	con2.WalPath = filepath.Join("data", "cs.wal2", "wal")
+	con2.RootDir = root
 	*config2.Consensus = *con2
 
 	n2, _ := NewNode(config2, "1", pr2, agent2)
@@ -326,6 +350,7 @@ func TestPbftRunFor4(t *testing.T) {
 	con3 := new(config.ConsensusConfig)
 	*con3 = *config3.Consensus
 	con3.WalPath = filepath.Join("data", "cs.wal3", "wal")
+	con3.RootDir = root
 	*config3.Consensus = *con3
 
 	n3, _ := NewNode(config3, "1", pr3, agent3)
@@ -342,6 +367,7 @@ func TestPbftRunFor4(t *testing.T) {
 	con4 := new(config.ConsensusConfig)
 	*con4 = *config4.Consensus
 	con4.WalPath = filepath.Join("data", "cs.wal4", "wal")
+	con4.RootDir = root
 	*config4.Consensus = *con4
 
 	n4, _ := NewNode(config4, "1", pr4, agent4)
@@ -402,6 +428,9 @@ func TestPbftRunFor4(t *testing.T) {
 }
 
 func TestPbftRunFor4AndChange(t *testing.T) {
+	root := testRoot(t)
+	defer os.RemoveAll(root)
+
 	//log.OpenLogDebug(3)
 	IDCacheInit()
 	start := make(chan int)
@@ -426,6 +455,7 @@ func TestPbftRunFor4AndChange(t *testing.T) {
 	con1 := new(config.ConsensusConfig)
 	*con1 = *config1.Consensus
 	con1.WalPath = filepath.Join("data", "cs.wal1", "wal")
+	con1.RootDir = root
 	*config1.Consensus = *con1
 
 	n1, _ := NewNode(config1, "1", pr1, agent1)
@@ -442,6 +472,7 @@ func TestPbftRunFor4AndChange(t *testing.T) {
 	con2 := new(config.ConsensusConfig)
 	*con2 = *config2.Consensus
 	con2.WalPath = filepath.Join("data", "cs.wal2", "wal")
+	con2.RootDir = root
 	*config2.Consensus = *con2
 
 	n2, _ := NewNode(config2, "1", pr2, agent2)
@@ -458,6 +489,7 @@ func TestPbftRunFor4AndChange(t *testing.T) {
 	con3 := new(config.ConsensusConfig)
 	*con3 = *config3.Consensus
 	con3.WalPath = filepath.Join("data", "cs.wal3", "wal")
+	con3.RootDir = root
 	*config3.Consensus = *con3
 
 	n3, _ := NewNode(config3, "1", pr3, agent3)
@@ -474,6 +506,7 @@ func TestPbftRunFor4AndChange(t *testing.T) {
 	con4 := new(config.ConsensusConfig)
 	*con4 = *config4.Consensus
 	con4.WalPath = filepath.Join("data", "cs.wal4", "wal")
+	con4.RootDir = root
 	*config4.Consensus = *con4
 
 	n4, _ := NewNode(config4, "1", pr4, agent4)
@@ -551,6 +584,9 @@ func TestPbftRunFor4AndChange(t *testing.T) {
 }
 
 func TestPbftRunFor5(t *testing.T) {
+	root := testRoot(t)
+	defer os.RemoveAll(root)
+
 	//log.OpenLogDebug(4)
 	IDCacheInit()
 
@@ -578,6 +614,7 @@ func TestPbftRunFor5(t *testing.T) {
 	con1 := new(config.ConsensusConfig)
 	*con1 = *config1.Consensus
 	con1.WalPath = filepath.Join("data", "cs.wal1", "wal")
+	con1.RootDir = root
 	*config1.Consensus = *con1
 
 	n1, _ := NewNode(config1, "1", pr1, agent1)
@@ -594,6 +631,7 @@ func TestPbftRunFor5(t *testing.T) {
 	con2 := new(config.ConsensusConfig)
 	*con2 = *config2.Consensus
 	con2.WalPath = filepath.Join("data", "cs.wal2", "wal")
+	con2.RootDir = root
 	*config2.Consensus = *con2
 
 	n2, _ := NewNode(config2, "1", pr2, agent2)
@@ -610,6 +648,7 @@ func TestPbftRunFor5(t *testing.T) {
 	con3 := new(config.ConsensusConfig)
 	*con3 = *config3.Consensus
 	con3.WalPath = filepath.Join("data", "cs.wal3", "wal")
+	con3.RootDir = root
 	*config3.Consensus = *con3
 
 	n3, _ := NewNode(config3, "1", pr3, agent3)
@@ -626,6 +665,7 @@ func TestPbftRunFor5(t *testing.T) {
 	con4 := new(config.ConsensusConfig)
 	*con4 = *config4.Consensus
 	con4.WalPath = filepath.Join("data", "cs.wal4", "wal")
+	con4.RootDir = root
 	*config4.Consensus = *con4
 
 	n4, _ := NewNode(config4, "1", pr4, agent4)
@@ -642,6 +682,7 @@ func TestPbftRunFor5(t *testing.T) {
 	con5 := new(config.ConsensusConfig)
 	*con5 = *config5.Consensus
 	con5.WalPath = filepath.Join("data", "cs.wal5", "wal")
+	con5.RootDir = root
 	*config5.Consensus = *con5
 
 	n5, _ := NewNode(config5, "1", pr5, agent5)
@@ -705,6 +746,9 @@ func TestPbftRunFor5(t *testing.T) {
 }
 
 func TestRunPbft1(t *testing.T) {
+	root := testRoot(t)
+	defer os.RemoveAll(root)
+
 	log.OpenLogDebug(3)
 	IDCacheInit()
 	start := make(chan int)
@@ -726,6 +770,7 @@ func TestRunPbft1(t *testing.T) {
 	con1 := new(config.ConsensusConfig)
 	*con1 = *config1.Consensus
 	con1.WalPath = filepath.Join("data", "cs.wal1", "wal")
+	con1.RootDir = root
 	*config1.Consensus = *con1
 
 	n1, _ := NewNode(config1, "1", pr1, agent1)
@@ -781,6 +826,9 @@ func TestRunPbft1(t *testing.T) {
 }
 
 func TestRunPbft2(t *testing.T) {
+	root := testRoot(t)
+	defer os.RemoveAll(root)
+
 	//log.OpenLogDebug(3)
 	IDCacheInit()
 	start := make(chan int)
@@ -802,6 +850,7 @@ func TestRunPbft2(t *testing.T) {
 	con2 := new(config.ConsensusConfig)
 	*con2 = *config2.Consensus
 	con2.WalPath = filepath.Join("data", "cs.wal2", "wal")
+	con2.RootDir = root
 	*config2.Consensus = *con2
 
 	n2, _ := NewNode(config2, "1", pr2, agent2)
@@ -863,6 +912,9 @@ func getAddr() common.Address {
 }
 
 func TestRunPbft3(t *testing.T) {
+	root := testRoot(t)
+	defer os.RemoveAll(root)
+
 	log.OpenLogDebug(3)
 	IDCacheInit()
 	start := make(chan int)
@@ -884,6 +936,7 @@ func TestRunPbft3(t *testing.T) {
 	con3 := new(config.ConsensusConfig)
 	*con3 = *config3.Consensus
 	con3.WalPath = filepath.Join("data", "cs.wal3", "wal")
+	con3.RootDir = root
 	*config3.Consensus = *con3
 
 	n3, _ := NewNode(config3, "1", pr3, agent3)
@@ -939,6 +992,9 @@ func TestRunPbft3(t *testing.T) {
 }
 
 func TestRunPbft4(t *testing.T) {
+	root := testRoot(t)
+	defer os.RemoveAll(root)
+
 	//log.OpenLogDebug(3)
 	IDCacheInit()
 	start := make(chan int)
@@ -960,6 +1016,7 @@ func TestRunPbft4(t *testing.T) {
 	con4 := new(config.ConsensusConfig)
 	*con4 = *config4.Consensus
 	con4.WalPath = filepath.Join("data", "cs.wal4", "wal")
+	con4.RootDir = root
 	*config4.Consensus = *con4
 
 	n4, _ := NewNode(config4, "1", pr4, agent4)
@@ -1180,6 +1237,9 @@ func TestPrivKey(t *testing.T) {
 }
 
 func TestPutNodes(t *testing.T) {
+	root := testRoot(t)
+	defer os.RemoveAll(root)
+
 	IDCacheInit()
 	start := make(chan int)
 	pr1, _ := crypto.HexToECDSA("2ee9b9082e3eb19378d478f450e0e818e94cf7e3bf13ad5dd657ef2a35fbb0a8")
@@ -1199,6 +1259,7 @@ func TestPutNodes(t *testing.T) {
 	con1 := new(config.ConsensusConfig)
 	*con1 = *config1.Consensus
 	con1.WalPath = filepath.Join("data", "cs.wal1", "wal")
+	con1.RootDir = root
 	*config1.Consensus = *con1
 
 	n1, _ := NewNode(config1, "1", pr1, agent1)
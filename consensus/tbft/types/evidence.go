@@ -0,0 +1,108 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/consensus/tbft/help"
+	ctypes "git.taiyue.io/pist/go-pist/core/types"
+	tcrypto "git.taiyue.io/pist/go-pist/crypto"
+)
+
+var (
+	//ErrEvidenceNotConflicting is Error votes of the evidence are not in conflict
+	ErrEvidenceNotConflicting = errors.New("votes are not conflicting")
+	//ErrEvidenceOtherValidator is Error votes of the evidence are signed by different keys
+	ErrEvidenceOtherValidator = errors.New("votes of different validators")
+)
+
+func init() {
+	ctypes.RegisterEvidence(ctypes.EvidenceDuplicateVote, DecodeDuplicateVoteEvidence)
+}
+
+// DuplicateVoteEvidence contains two votes a validator signed for different
+// blocks at the same height, round and step, which an honest one never does.
+type DuplicateVoteEvidence struct {
+	ChainID string
+	VoteA   *Vote
+	VoteB   *Vote
+}
+
+// NewDuplicateVoteEvidence creates the evidence of two conflicting votes, the
+// votes are ordered so that the same pair always makes the same evidence.
+func NewDuplicateVoteEvidence(chainID string, vote1, vote2 *Vote) *DuplicateVoteEvidence {
+	voteA, voteB := vote1, vote2
+	if bytes.Compare(vote1.SignBytes(chainID), vote2.SignBytes(chainID)) > 0 {
+		voteA, voteB = vote2, vote1
+	}
+	return &DuplicateVoteEvidence{
+		ChainID: chainID,
+		VoteA:   voteA,
+		VoteB:   voteB,
+	}
+}
+
+// DecodeDuplicateVoteEvidence decodes the evidence submitted to the staking
+// contract.
+func DecodeDuplicateVoteEvidence(data []byte) (ctypes.Evidence, error) {
+	ev := new(DuplicateVoteEvidence)
+	if err := cdc.UnmarshalBinaryBare(data, ev); err != nil {
+		return nil, err
+	}
+	if ev.VoteA == nil || ev.VoteB == nil {
+		return nil, ErrVoteNil
+	}
+	return NewDuplicateVoteEvidence(ev.ChainID, ev.VoteA, ev.VoteB), nil
+}
+
+// Bytes returns the wire format of the evidence.
+func (ev *DuplicateVoteEvidence) Bytes() []byte {
+	return cdc.MustMarshalBinaryBare(ev)
+}
+
+// Kind implements ctypes.Evidence.
+func (ev *DuplicateVoteEvidence) Kind() uint8 {
+	return ctypes.EvidenceDuplicateVote
+}
+
+// Height implements ctypes.Evidence.
+func (ev *DuplicateVoteEvidence) Height() uint64 {
+	return ev.VoteA.Height
+}
+
+// Hash implements ctypes.Evidence, it only covers the signed part of the votes.
+func (ev *DuplicateVoteEvidence) Hash() common.Hash {
+	return help.RlpHash([]interface{}{ev.VoteA.SignBytes(ev.ChainID), ev.VoteB.SignBytes(ev.ChainID)})
+}
+
+// Verify implements ctypes.Evidence, it returns the public key of the validator
+// that signed both votes.
+func (ev *DuplicateVoteEvidence) Verify() ([]byte, error) {
+	a, b := ev.VoteA, ev.VoteB
+	if a.Height != b.Height || a.Round != b.Round || a.Type != b.Type {
+		return nil, ErrVoteUnexpectedStep
+	}
+	if a.BlockID.Equals(b.BlockID) {
+		return nil, ErrEvidenceNotConflicting
+	}
+	if !bytes.Equal(a.ValidatorAddress, b.ValidatorAddress) {
+		return nil, ErrEvidenceOtherValidator
+	}
+	pubA, err := tcrypto.SigToPub(a.SignBytes(ev.ChainID), a.Signature)
+	if err != nil {
+		return nil, ErrVoteInvalidSignature
+	}
+	pubB, err := tcrypto.SigToPub(b.SignBytes(ev.ChainID), b.Signature)
+	if err != nil {
+		return nil, ErrVoteInvalidSignature
+	}
+	pkA, pkB := tcrypto.FromECDSAPub(pubA), tcrypto.FromECDSAPub(pubB)
+	if !bytes.Equal(pkA, pkB) {
+		return nil, ErrEvidenceOtherValidator
+	}
+	if addr := tcrypto.PubkeyToAddress(*pubA); !bytes.Equal(addr[:], a.ValidatorAddress) {
+		return nil, ErrVoteInvalidValidatorAddress
+	}
+	return pkA, nil
+}
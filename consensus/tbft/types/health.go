@@ -408,7 +408,7 @@ func (h *HealthMgr) isShiftSV() (bool, int) {
 			cnt++
 		}
 	}
-	return cnt > params.MinimumCommitteeNumber, cnt
+	return cnt > params.GetGoverned().MinimumCommitteeNumber, cnt
 }
 
 //switchResult handle the sv after consensus and the result removed from self
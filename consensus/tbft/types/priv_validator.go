@@ -5,11 +5,15 @@ import (
 	"crypto/ecdsa"
 	"errors"
 	"fmt"
-	"git.taiyue.io/pist/go-pist/consensus/tbft/metrics"
+	"io/ioutil"
 	"math/big"
+	"os"
+	"path/filepath"
 	"sync"
 	"time"
 
+	"git.taiyue.io/pist/go-pist/consensus/tbft/metrics"
+
 	"git.taiyue.io/pist/go-pist/common"
 	tcrypto "git.taiyue.io/pist/go-pist/consensus/tbft/crypto"
 	"git.taiyue.io/pist/go-pist/consensus/tbft/help"
@@ -57,7 +61,18 @@ type privValidator struct {
 	LastSignature []byte        `json:"last_signature,omitempty"` // so we dont lose signatures XXX Why would we lose signatures?
 	LastSignBytes help.HexBytes `json:"last_signbytes,omitempty"` // so we dont lose signatures XXX Why would we lose signatures?
 
-	mtx sync.Mutex
+	filePath string // where the last-signed state is persisted, empty to keep it in memory only
+	mtx      sync.Mutex
+}
+
+// privValidatorState is the last-signed state of a privValidator as stored on disk.
+type privValidatorState struct {
+	Address       help.Address  `json:"address"`
+	LastHeight    uint64        `json:"last_height"`
+	LastRound     uint          `json:"last_round"`
+	LastStep      uint8         `json:"last_step"`
+	LastSignature []byte        `json:"last_signature,omitempty"`
+	LastSignBytes help.HexBytes `json:"last_signbytes,omitempty"`
 }
 
 //KeepBlockSign is block's sign
@@ -75,24 +90,71 @@ func NewPrivValidator(priv ecdsa.PrivateKey) PrivValidator {
 	}
 }
 
-func (Validator *privValidator) Reset() {
-	var sig []byte
-	Validator.LastHeight = 0
-	Validator.LastRound = 0
-	Validator.LastStep = 0
-	Validator.LastSignature = sig
-	Validator.LastSignBytes = nil
+// NewFilePrivValidator returns a new private Validator which persists its last-signed
+// state to filePath before releasing any signature. The state left by a previous
+// run is loaded, so a restarted node refuses to sign conflicting votes or proposals.
+func NewFilePrivValidator(priv ecdsa.PrivateKey, filePath string) (PrivValidator, error) {
+	pv := &privValidator{
+		PrivKey:  tcrypto.PrivKeyTrue(priv),
+		LastStep: stepNone,
+		filePath: filePath,
+	}
+	if filePath == "" {
+		return pv, nil
+	}
+	if err := help.EnsureDir(filepath.Dir(filePath), 0700); err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return pv, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var state privValidatorState
+	if err := cdc.UnmarshalJSON(data, &state); err != nil {
+		return nil, fmt.Errorf("error reading privValidator state from %s: %v", filePath, err)
+	}
+	if !bytes.Equal(state.Address, pv.GetAddress()) {
+		return nil, fmt.Errorf("privValidator state %s belongs to %v, not %v", filePath, state.Address, pv.GetAddress())
+	}
+	pv.LastHeight = state.LastHeight
+	pv.LastRound = state.LastRound
+	pv.LastStep = state.LastStep
+	pv.LastSignature = state.LastSignature
+	pv.LastSignBytes = state.LastSignBytes
+	log.Info("Loaded privValidator state", "file", filePath, "height", pv.LastHeight, "round", pv.LastRound, "step", pv.LastStep)
+	return pv, nil
 }
 
-// Persist height/round/step and signature
+// Persist height/round/step and signature. The state is written to disk and
+// synced before it is updated in memory, so no signature is released unless
+// it survives a crash.
 func (Validator *privValidator) saveSigned(height uint64, round int, step uint8,
-	signBytes []byte, sig []byte) {
-
+	signBytes []byte, sig []byte) error {
+
+	if Validator.filePath != "" {
+		data, err := cdc.MarshalJSON(&privValidatorState{
+			Address:       Validator.GetAddress(),
+			LastHeight:    height,
+			LastRound:     uint(round),
+			LastStep:      step,
+			LastSignature: sig,
+			LastSignBytes: signBytes,
+		})
+		if err != nil {
+			return err
+		}
+		if err := help.WriteFileAtomic(Validator.filePath, data, 0600); err != nil {
+			return fmt.Errorf("error saving privValidator state: %v", err)
+		}
+	}
 	Validator.LastHeight = height
 	Validator.LastRound = uint(round)
 	Validator.LastStep = step
 	Validator.LastSignature = sig
 	Validator.LastSignBytes = signBytes
+	return nil
 }
 
 func (Validator *privValidator) GetAddress() help.Address {
@@ -147,7 +209,9 @@ func (Validator *privValidator) signVote(chainID string, vote *Vote) error {
 	if err != nil {
 		return err
 	}
-	Validator.saveSigned(height, int(round), step, signBytes, sig)
+	if err := Validator.saveSigned(height, int(round), step, signBytes, sig); err != nil {
+		return err
+	}
 	vote.Signature = sig
 	return nil
 }
@@ -197,7 +261,9 @@ func (Validator *privValidator) signProposal(chainID string, proposal *Proposal)
 	if err != nil {
 		return err
 	}
-	Validator.saveSigned(height, round, step, signBytes, sig)
+	if err := Validator.saveSigned(height, round, step, signBytes, sig); err != nil {
+		return err
+	}
 	proposal.Signature = sig
 	return nil
 }
@@ -234,16 +300,18 @@ func (Validator *privValidator) checkHRS(height uint64, round int, step uint8) (
 // returns true if the only difference in the votes is their timestamp.
 func checkVotesOnlyDifferByTimestamp(lastSignBytes, newSignBytes []byte) (time.Time, bool) {
 	var lastVote, newVote CanonicalJSONVote
+	// Sign bytes are hashes of the canonical json, in that case nothing
+	// tells the timestamp apart and the data is treated as conflicting.
 	if err := cdc.UnmarshalJSON(lastSignBytes, &lastVote); err != nil {
-		panic(fmt.Sprintf("LastSignBytes cannot be unmarshalled into vote: %v", err))
+		return time.Time{}, false
 	}
 	if err := cdc.UnmarshalJSON(newSignBytes, &newVote); err != nil {
-		panic(fmt.Sprintf("signBytes cannot be unmarshalled into vote: %v", err))
+		return time.Time{}, false
 	}
 
 	lastTime, err := time.Parse(TimeFormat, lastVote.Timestamp)
 	if err != nil {
-		panic(err)
+		return time.Time{}, false
 	}
 
 	// set the times to the same value and check equality
@@ -260,16 +328,18 @@ func checkVotesOnlyDifferByTimestamp(lastSignBytes, newSignBytes []byte) (time.T
 // returns true if the only difference in the proposals is their timestamp
 func checkProposalsOnlyDifferByTimestamp(lastSignBytes, newSignBytes []byte) (time.Time, bool) {
 	var lastProposal, newProposal CanonicalJSONProposal
+	// Sign bytes are hashes of the canonical json, in that case nothing
+	// tells the timestamp apart and the data is treated as conflicting.
 	if err := cdc.UnmarshalJSON(lastSignBytes, &lastProposal); err != nil {
-		panic(fmt.Sprintf("LastSignBytes cannot be unmarshalled into proposal: %v", err))
+		return time.Time{}, false
 	}
 	if err := cdc.UnmarshalJSON(newSignBytes, &newProposal); err != nil {
-		panic(fmt.Sprintf("signBytes cannot be unmarshalled into proposal: %v", err))
+		return time.Time{}, false
 	}
 
 	lastTime, err := time.Parse(TimeFormat, lastProposal.Timestamp)
 	if err != nil {
-		panic(err)
+		return time.Time{}, false
 	}
 
 	// set the times to the same value and check equality
@@ -324,7 +394,6 @@ type StateAgent interface {
 	GetPubKey() tcrypto.PubKey
 	SignVote(chainID string, vote *Vote) error
 	SignProposal(chainID string, proposal *Proposal) error
-	PrivReset()
 }
 
 //StateAgentImpl agent state struct
@@ -393,11 +462,6 @@ func MakeBlockFromPartSet(reader *PartSet) (*ctypes.Block, error) {
 	return nil, errors.New("not complete")
 }
 
-//PrivReset reset PrivValidator
-func (state *StateAgentImpl) PrivReset() {
-	state.Priv.Reset()
-}
-
 // HasPeerID judge the peerid whether in validators
 func (state *StateAgentImpl) HasPeerID(id string) error {
 	if state.ids == nil {
@@ -564,7 +628,7 @@ func (state *StateAgentImpl) SignVote(chainID string, vote *Vote) error {
 
 //SignProposal sign of proposal msg
 func (state *StateAgentImpl) SignProposal(chainID string, proposal *Proposal) error {
-	return state.Priv.signProposal(chainID, proposal)
+	return state.Priv.SignProposal(chainID, proposal)
 }
 
 //Broadcast is agent Broadcast block
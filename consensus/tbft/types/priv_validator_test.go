@@ -0,0 +1,70 @@
+package types
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"git.taiyue.io/pist/go-pist/crypto"
+)
+
+func newTestVote(height uint64, round uint, hash byte) *Vote {
+	return &Vote{
+		Height:    height,
+		Round:     round,
+		Timestamp: time.Now().UTC(),
+		Type:      VoteTypePrevote,
+		BlockID:   BlockID{Hash: bytes.Repeat([]byte{hash}, 32)},
+	}
+}
+
+func TestFilePrivValidatorRestart(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tbft-privval")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	key, _ := crypto.GenerateKey()
+	file := filepath.Join(dir, "1", "priv_validator_state.json")
+	pv, err := NewFilePrivValidator(*key, file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vote := newTestVote(10, 2, 0x01)
+	if err := pv.SignVote("test", vote); err != nil {
+		t.Fatal(err)
+	}
+
+	// A restarted validator must remember what it signed.
+	pv, err = NewFilePrivValidator(*key, file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	same := newTestVote(10, 2, 0x01)
+	same.Timestamp = vote.Timestamp
+	if err := pv.SignVote("test", same); err != nil {
+		t.Fatalf("re-signing identical vote failed: %v", err)
+	}
+	if !bytes.Equal(same.Signature, vote.Signature) {
+		t.Fatal("identical vote did not get the cached signature")
+	}
+	if err := pv.SignVote("test", newTestVote(10, 2, 0x02)); err == nil {
+		t.Fatal("conflicting vote was signed after restart")
+	}
+	if err := pv.SignVote("test", newTestVote(9, 0, 0x01)); err == nil {
+		t.Fatal("vote of an older height was signed after restart")
+	}
+	if err := pv.SignVote("test", newTestVote(10, 3, 0x02)); err != nil {
+		t.Fatalf("vote of a later round refused: %v", err)
+	}
+
+	// The state belongs to a single key.
+	other, _ := crypto.GenerateKey()
+	if _, err := NewFilePrivValidator(*other, file); err == nil {
+		t.Fatal("state of another validator was loaded")
+	}
+}
@@ -0,0 +1,487 @@
+package tbft
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"git.taiyue.io/pist/go-pist/consensus/tbft/help"
+	auto "git.taiyue.io/pist/go-pist/consensus/tbft/help/autofile"
+	"git.taiyue.io/pist/go-pist/log"
+	"github.com/tendermint/go-amino"
+)
+
+const (
+	// maxMsgSizeBytes must be greater than ttypes.BlockPartSizeBytes + a few bytes
+	maxMsgSizeBytes = 1024 * 1024 // 1MB
+
+	// walHeaderSize is the size of crc32 checksum and length prefix of every record
+	walHeaderSize = 8
+)
+
+//--------------------------------------------------------
+// types and functions for savings consensus messages
+
+// TimedWALMessage wraps WALMessage and adds Time for debugging purposes.
+type TimedWALMessage struct {
+	Time time.Time  `json:"time"`
+	Msg  WALMessage `json:"msg"`
+}
+
+// EndHeightMessage marks the end of the given height inside WAL.
+type EndHeightMessage struct {
+	Height uint64 `json:"height"`
+}
+
+// WALMessage is a message which can be written to the WAL
+type WALMessage interface{}
+
+// RegisterWALMessages is register all wal message
+func RegisterWALMessages(cdc *amino.Codec) {
+	cdc.RegisterInterface((*WALMessage)(nil), nil)
+	cdc.RegisterConcrete(EndHeightMessage{}, "true/wal/EndHeightMessage", nil)
+	cdc.RegisterConcrete(msgInfo{}, "true/wal/MsgInfo", nil)
+	cdc.RegisterConcrete(timeoutInfo{}, "true/wal/TimeoutInfo", nil)
+}
+
+//--------------------------------------------------------
+// Simple write-ahead logger
+
+// WAL is an interface for any write-ahead logger.
+type WAL interface {
+	Write(WALMessage) error
+	WriteSync(WALMessage) error
+	FlushAndSync() error
+
+	SearchForEndHeight(height uint64, options *WALSearchOptions) (rd io.ReadCloser, found bool, err error)
+
+	// service methods
+	Start() error
+	Stop() error
+	Wait()
+}
+
+// Write ahead logger writes msgs to disk before they are processed.
+// Can be used for crash-recovery and deterministic replay
+type baseWAL struct {
+	help.BaseService
+
+	group *auto.Group
+
+	enc *WALEncoder
+}
+
+// NewWAL returns a new write-ahead logger based on `baseWAL`, which implements
+// WAL. Writes are buffered, WriteSync and Stop flush and fsync them to disk.
+func NewWAL(walFile string) (*baseWAL, error) {
+	err := help.EnsureDir(filepath.Dir(walFile), 0700)
+	if err != nil {
+		return nil, fmt.Errorf("failed to ensure WAL directory is in place: %v", err)
+	}
+
+	group, err := auto.OpenGroup(walFile)
+	if err != nil {
+		return nil, err
+	}
+	wal := &baseWAL{
+		group: group,
+		enc:   NewWALEncoder(group),
+	}
+	wal.BaseService = *help.NewBaseService("baseWAL", wal)
+	return wal, nil
+}
+
+// Group returns the underlying group of files of the WAL.
+func (wal *baseWAL) Group() *auto.Group {
+	return wal.group
+}
+
+// OnStart implements help.Service.
+func (wal *baseWAL) OnStart() error {
+	size, err := wal.group.Head.Size()
+	if err != nil {
+		return err
+	} else if size == 0 && wal.group.MaxIndex() == 0 {
+		// a fresh WAL, nothing to replay before the first height
+		if err := wal.WriteSync(EndHeightMessage{0}); err != nil {
+			return err
+		}
+	}
+	return wal.group.Start()
+}
+
+// OnStop implements help.Service. It flushes and syncs the head of the group
+// before closing it.
+func (wal *baseWAL) OnStop() {
+	if err := wal.FlushAndSync(); err != nil {
+		log.Error("error on flush data to disk", "err", err)
+	}
+	help.CheckAndPrintError(wal.group.Stop())
+	wal.group.Close()
+}
+
+// Write is called in newStep and for each receive on the
+// peerMsgQueue and the timeoutTicker.
+// NOTE: does not call fsync()
+func (wal *baseWAL) Write(msg WALMessage) error {
+	if wal == nil {
+		return nil
+	}
+	if err := wal.enc.Encode(&TimedWALMessage{time.Now(), msg}); err != nil {
+		log.Error("Error writing msg to consensus wal. WARNING: recover may not be possible for the current height",
+			"err", err, "msg", msg)
+		return err
+	}
+	return nil
+}
+
+// WriteSync is called when we receive a msg from ourselves
+// so that we write to disk before sending signed messages.
+// NOTE: calls fsync()
+func (wal *baseWAL) WriteSync(msg WALMessage) error {
+	if wal == nil {
+		return nil
+	}
+	if err := wal.Write(msg); err != nil {
+		return err
+	}
+	if err := wal.FlushAndSync(); err != nil {
+		log.Error("WriteSync failed to flush consensus wal. WARNING: may result in creating alternative proposals / votes for the current height iff the node restarted",
+			"err", err)
+		return err
+	}
+	return nil
+}
+
+// FlushAndSync flushes and fsync's the underlying group's data to disk.
+// See auto#FlushAndSync
+func (wal *baseWAL) FlushAndSync() error {
+	return wal.group.Flush()
+}
+
+// WALSearchOptions are optional arguments to SearchForEndHeight.
+type WALSearchOptions struct {
+	// IgnoreDataCorruptionErrors set to true will result in skipping data corruption errors.
+	IgnoreDataCorruptionErrors bool
+}
+
+// SearchForEndHeight searches for the EndHeightMessage with the given height
+// and returns an auto.GroupReader, whenever it was found or not and an error.
+// Group reader will be nil if found equals false.
+//
+// CONTRACT: caller must close group reader.
+func (wal *baseWAL) SearchForEndHeight(height uint64, options *WALSearchOptions) (rd io.ReadCloser, found bool, err error) {
+	var (
+		msg             *TimedWALMessage
+		gr              *auto.GroupReader
+		lastHeightFound = int64(-1)
+	)
+	// NOTE: starting from the last file in the group because we're usually
+	// searching for the last height. See replay.go
+	min, max := wal.group.MinIndex(), wal.group.MaxIndex()
+	log.Info("Searching for height in consensus wal", "height", height, "min", min, "max", max)
+	for index := max; index >= min; index-- {
+		gr, err = wal.group.NewReader(index)
+		if err != nil {
+			return nil, false, err
+		}
+
+		dec := NewWALDecoder(gr)
+		for {
+			msg, err = dec.Decode()
+			if err == io.EOF {
+				// OPTIMISATION: no need to look for height in older files if we've seen h < height
+				if lastHeightFound > 0 && uint64(lastHeightFound) < height {
+					gr.Close()
+					return nil, false, nil
+				}
+				// check next file
+				break
+			}
+			if options.IgnoreDataCorruptionErrors && IsDataCorruptionError(err) {
+				log.Error("Corrupted entry. Skipping...", "err", err)
+				// do nothing
+				continue
+			} else if err != nil {
+				gr.Close()
+				return nil, false, err
+			}
+
+			if m, ok := msg.Msg.(EndHeightMessage); ok {
+				lastHeightFound = int64(m.Height)
+				if m.Height == height { // found
+					log.Info("Found end height in consensus wal", "height", height, "index", index)
+					return gr, true, nil
+				}
+			}
+		}
+		gr.Close()
+	}
+
+	return nil, false, nil
+}
+
+///////////////////////////////////////////////////////////////////////////////
+
+// A WALEncoder writes custom-encoded WAL messages to an output stream.
+//
+// Format: 4 bytes CRC sum + 4 bytes length + arbitrary-length value (go-amino encoded)
+type WALEncoder struct {
+	wr io.Writer
+}
+
+// NewWALEncoder returns a new encoder that writes to wr.
+func NewWALEncoder(wr io.Writer) *WALEncoder {
+	return &WALEncoder{wr}
+}
+
+// Encode writes the custom encoding of v to the stream.
+func (enc *WALEncoder) Encode(v *TimedWALMessage) error {
+	data, err := cdc.MarshalBinaryBare(v)
+	if err != nil {
+		return err
+	}
+
+	crc := crc32.Checksum(data, crc32c)
+	length := uint32(len(data))
+	if length > maxMsgSizeBytes {
+		return fmt.Errorf("msg is too big: %d bytes, max: %d bytes", length, maxMsgSizeBytes)
+	}
+	totalLength := walHeaderSize + int(length)
+
+	msg := make([]byte, totalLength)
+	binary.BigEndian.PutUint32(msg[0:4], crc)
+	binary.BigEndian.PutUint32(msg[4:8], length)
+	copy(msg[walHeaderSize:], data)
+
+	_, err = enc.wr.Write(msg)
+	return err
+}
+
+///////////////////////////////////////////////////////////////////////////////
+
+// IsDataCorruptionError returns true if data has been corrupted inside WAL.
+func IsDataCorruptionError(err error) bool {
+	_, ok := err.(DataCorruptionError)
+	return ok
+}
+
+// DataCorruptionError is an error that occures if data on disk was corrupted.
+type DataCorruptionError struct {
+	cause error
+}
+
+func (e DataCorruptionError) Error() string {
+	return fmt.Sprintf("DataCorruptionError[%v]", e.cause)
+}
+
+// Cause returns the underlying error of the corruption.
+func (e DataCorruptionError) Cause() error {
+	return e.cause
+}
+
+// A WALDecoder reads and decodes custom-encoded WAL messages from an input
+// stream. See WALEncoder for the format used.
+//
+// It will also compare the checksums and make sure data size is equal to the
+// length from the header. If that is not the case, error will be returned.
+type WALDecoder struct {
+	rd io.Reader
+}
+
+// NewWALDecoder returns a new decoder that reads from rd.
+func NewWALDecoder(rd io.Reader) *WALDecoder {
+	return &WALDecoder{rd}
+}
+
+// Decode reads the next custom-encoded value from its reader and returns it.
+// A record cut short by a crash in the middle of a write is reported as a
+// DataCorruptionError, so it can be told apart from a clean end of the log.
+func (dec *WALDecoder) Decode() (*TimedWALMessage, error) {
+	b := make([]byte, walHeaderSize)
+
+	n, err := io.ReadFull(dec.rd, b)
+	if err == io.EOF {
+		return nil, err
+	}
+	if err == io.ErrUnexpectedEOF {
+		return nil, DataCorruptionError{fmt.Errorf("truncated header (read: %d, wanted: %d)", n, walHeaderSize)}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header: %v", err)
+	}
+	crc := binary.BigEndian.Uint32(b[0:4])
+	length := binary.BigEndian.Uint32(b[4:8])
+
+	if length > maxMsgSizeBytes {
+		return nil, DataCorruptionError{fmt.Errorf("length %d exceeded maximum possible value of %d bytes", length, maxMsgSizeBytes)}
+	}
+
+	data := make([]byte, length)
+	n, err = io.ReadFull(dec.rd, data)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return nil, DataCorruptionError{fmt.Errorf("truncated data (read: %d, wanted: %d)", n, length)}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read data: %v (read: %d, wanted: %d)", err, n, length)
+	}
+
+	// check checksum before decoding data
+	actualCRC := crc32.Checksum(data, crc32c)
+	if actualCRC != crc {
+		return nil, DataCorruptionError{fmt.Errorf("checksums do not match: read: %v, actual: %v", crc, actualCRC)}
+	}
+
+	var res = new(TimedWALMessage)
+	err = cdc.UnmarshalBinaryBare(data, res)
+	if err != nil {
+		return nil, DataCorruptionError{fmt.Errorf("failed to decode data: %v", err)}
+	}
+
+	return res, err
+}
+
+var crc32c = crc32.MakeTable(crc32.Castagnoli)
+
+//--------------------------------------------------------
+
+type nilWAL struct{}
+
+func (nilWAL) Write(m WALMessage) error     { return nil }
+func (nilWAL) WriteSync(m WALMessage) error { return nil }
+func (nilWAL) FlushAndSync() error          { return nil }
+func (nilWAL) SearchForEndHeight(height uint64, options *WALSearchOptions) (rd io.ReadCloser, found bool, err error) {
+	return nil, false, nil
+}
+func (nilWAL) Start() error { return nil }
+func (nilWAL) Stop() error  { return nil }
+func (nilWAL) Wait()        {}
+
+//--------------------------------------------------------
+// offline tooling, used by the gpist wal command
+
+// errStopIteration is returned by an IterateWAL callback to end the walk early
+var errStopIteration = errors.New("stop iteration")
+
+// IterateWAL decodes every record of the WAL whose head file is walFile,
+// oldest first, and calls fn for each of them. It stops at the first error
+// returned by fn or by the decoder. The WAL must not be in use by a node.
+func IterateWAL(walFile string, fn func(msg *TimedWALMessage) error) error {
+	if _, err := os.Stat(walFile); err != nil {
+		return err
+	}
+	group, err := auto.OpenGroup(walFile)
+	if err != nil {
+		return err
+	}
+	defer group.Close()
+
+	gr, err := group.NewReader(group.MinIndex())
+	if err != nil {
+		return err
+	}
+	defer gr.Close()
+
+	dec := NewWALDecoder(gr)
+	for {
+		msg, err := dec.Decode()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := fn(msg); err != nil {
+			if err == errStopIteration {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// WALMessageJSON returns the json encoding of a WAL record.
+func WALMessageJSON(msg *TimedWALMessage) ([]byte, error) {
+	return cdc.MarshalJSON(msg)
+}
+
+// TruncateWAL drops every record that was written after the end of the given
+// height. It returns the number of records kept.
+func TruncateWAL(walFile string, height uint64) (int, error) {
+	found := false
+	kept, err := rewriteWAL(walFile, func(msg *TimedWALMessage) bool {
+		if m, ok := msg.Msg.(EndHeightMessage); ok && m.Height == height {
+			found = true
+			return true
+		}
+		return false
+	})
+	if err == nil && !found {
+		err = fmt.Errorf("#ENDHEIGHT %d not found in wal %s", height, walFile)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return kept, nil
+}
+
+// RepairWAL drops the first corrupted record of the WAL and everything after
+// it, which is what a crash in the middle of a write leaves behind. It returns
+// the number of records kept.
+func RepairWAL(walFile string) (int, error) {
+	return rewriteWAL(walFile, func(*TimedWALMessage) bool { return false })
+}
+
+// rewriteWAL copies the valid records of the WAL into a single new head file
+// until last reports true or a corrupted record is met, and then replaces the
+// whole group with it.
+func rewriteWAL(walFile string, last func(msg *TimedWALMessage) bool) (int, error) {
+	tmpFile := walFile + ".rewrite"
+	f, err := os.OpenFile(tmpFile, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return 0, err
+	}
+	var (
+		enc  = NewWALEncoder(f)
+		kept = 0
+	)
+	err = IterateWAL(walFile, func(msg *TimedWALMessage) error {
+		if err := enc.Encode(msg); err != nil {
+			return err
+		}
+		kept++
+		if last(msg) {
+			return errStopIteration
+		}
+		return nil
+	})
+	if IsDataCorruptionError(err) {
+		log.Warn("Dropping corrupted tail of consensus wal", "file", walFile, "kept", kept, "err", err)
+		err = nil
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(tmpFile)
+		return 0, err
+	}
+	rotated, err := filepath.Glob(walFile + ".[0-9][0-9][0-9]*")
+	if err != nil {
+		return 0, err
+	}
+	for _, file := range rotated {
+		if err := os.Remove(file); err != nil {
+			return 0, err
+		}
+	}
+	return kept, os.Rename(tmpFile, walFile)
+}
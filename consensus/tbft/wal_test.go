@@ -0,0 +1,281 @@
+package tbft
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	tcrypto "git.taiyue.io/pist/go-pist/consensus/tbft/crypto"
+	ttypes "git.taiyue.io/pist/go-pist/consensus/tbft/types"
+	"git.taiyue.io/pist/go-pist/core/types"
+	config "git.taiyue.io/pist/go-pist/params"
+)
+
+func writeTestWAL(t *testing.T, file string, heights uint64) {
+	f, err := os.Create(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	enc := NewWALEncoder(f)
+	for h := uint64(1); h <= heights; h++ {
+		msgs := []WALMessage{
+			timeoutInfo{Height: h, Round: 0},
+			EndHeightMessage{Height: h},
+		}
+		for _, m := range msgs {
+			if err := enc.Encode(&TimedWALMessage{Time: time.Now().Round(0).UTC(), Msg: m}); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+}
+
+func TestWALEncoderDecoder(t *testing.T) {
+	msgs := []TimedWALMessage{
+		{Time: time.Now().Round(0).UTC(), Msg: EndHeightMessage{Height: 1}},
+		{Time: time.Now().Round(0).UTC(), Msg: timeoutInfo{Height: 2, Round: 1}},
+	}
+	b := new(bytes.Buffer)
+	enc := NewWALEncoder(b)
+	for i := range msgs {
+		if err := enc.Encode(&msgs[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	dec := NewWALDecoder(bytes.NewReader(b.Bytes()))
+	for i := range msgs {
+		msg, err := dec.Decode()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !msg.Time.Equal(msgs[i].Time) || msg.Msg != msgs[i].Msg {
+			t.Fatalf("record %d mismatch: have %v, want %v", i, msg, msgs[i])
+		}
+	}
+	if _, err := dec.Decode(); err != io.EOF {
+		t.Fatalf("expected EOF, got %v", err)
+	}
+
+	// A torn last record must be reported as corruption, not as EOF.
+	torn := b.Bytes()[:b.Len()-1]
+	dec = NewWALDecoder(bytes.NewReader(torn))
+	if _, err := dec.Decode(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dec.Decode(); !IsDataCorruptionError(err) {
+		t.Fatalf("expected data corruption error, got %v", err)
+	}
+}
+
+func TestTruncateWAL(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tbft-wal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "wal")
+	writeTestWAL(t, file, 5)
+
+	kept, err := TruncateWAL(file, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kept != 6 {
+		t.Fatalf("kept records mismatch: have %d, want %d", kept, 6)
+	}
+	var last WALMessage
+	if err := IterateWAL(file, func(msg *TimedWALMessage) error {
+		last = msg.Msg
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if last != (EndHeightMessage{Height: 3}) {
+		t.Fatalf("last record mismatch: have %v", last)
+	}
+	if _, err := TruncateWAL(file, 4); err == nil {
+		t.Fatal("truncating to a missing height should fail")
+	}
+}
+
+func TestRepairWAL(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tbft-wal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "wal")
+	writeTestWAL(t, file, 2)
+	f, err := os.OpenFile(file, os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Write([]byte{0x01, 0x02, 0x03})
+	f.Close()
+
+	kept, err := RepairWAL(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kept != 4 {
+		t.Fatalf("kept records mismatch: have %d, want %d", kept, 4)
+	}
+	if err := IterateWAL(file, func(*TimedWALMessage) error { return nil }); err != nil {
+		t.Fatalf("repaired wal still unreadable: %v", err)
+	}
+}
+
+// replayTestNode runs the consensus state of a validator of a two member
+// committee by hand, handling the messages the receive routine would.
+type replayTestNode struct {
+	cs      *ConsensusState
+	privs   []*ecdsa.PrivateKey
+	vset    *ttypes.ValidatorSet
+	chainID string
+}
+
+func newReplayTestNode(t *testing.T, dir string) *replayTestNode {
+	IDCacheInit()
+	privs := []*ecdsa.PrivateKey{getPrivateKey(0), getPrivateKey(1)}
+	var (
+		vals []*ttypes.Validator
+		cm   = &types.CommitteeInfo{Id: big.NewInt(1), StartHeight: big.NewInt(1)}
+	)
+	for _, priv := range privs {
+		vals = append(vals, ttypes.NewValidator(tcrypto.PubKeyTrue(*GetPubKey(priv)), 1))
+		cm.Members = append(cm.Members, &types.CommitteeMember{Publickey: GetPub(priv), Flag: types.StateUsedFlag})
+	}
+	vset := ttypes.NewValidatorSet(vals)
+	state := ttypes.NewStateAgent(NewPbftAgent("Agent1"), "9999", vset, 1, cm.Id.Uint64())
+
+	cs := NewConsensusState(config.TestConsensusConfig(), state, ttypes.NewBlockStore(), WithWALFile(filepath.Join(dir, "wal")))
+	cs.SetCommitteeInfo(cm)
+	priv, err := ttypes.NewFilePrivValidator(*privs[0], filepath.Join(dir, "priv_validator_state.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cs.SetPrivValidator(priv)
+	state.SetPrivValidator(priv)
+
+	wal, err := cs.OpenWAL(cs.walFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cs.wal = wal
+	return &replayTestNode{cs: cs, privs: privs, vset: vset, chainID: state.GetChainID()}
+}
+
+// process handles the messages queued by the node itself, logging them first.
+func (n *replayTestNode) process() {
+	for {
+		select {
+		case mi := <-n.cs.internalMsgQueue:
+			n.cs.writeMsgInfo(mi, true)
+			n.cs.handleMsg(mi)
+		default:
+			return
+		}
+	}
+}
+
+// receive handles a message of a peer, logging it first.
+func (n *replayTestNode) receive(msg ConsensusMessage) {
+	mi := msgInfo{Msg: msg, PeerID: "peer"}
+	n.cs.writeMsgInfo(mi, false)
+	n.cs.handleMsg(mi)
+	n.process()
+}
+
+// vote returns the agreeing vote of the other member of the committee.
+func (n *replayTestNode) vote(t *testing.T, typeB byte, hash []byte, header ttypes.PartSetHeader) *ttypes.Vote {
+	priv := ttypes.NewPrivValidator(*n.privs[1])
+	valIndex, _ := n.vset.GetByAddress(priv.GetAddress())
+	vote := &ttypes.Vote{
+		ValidatorAddress: priv.GetAddress(),
+		ValidatorIndex:   uint(valIndex),
+		Height:           n.cs.Height,
+		Round:            n.cs.Round,
+		Timestamp:        time.Now().UTC(),
+		Type:             typeB,
+		Result:           types.VoteAgree,
+		BlockID:          ttypes.BlockID{Hash: hash, PartsHeader: header},
+	}
+	if err := priv.SignVote(n.chainID, vote); err != nil {
+		t.Fatal(err)
+	}
+	return vote
+}
+
+func TestWALCatchupReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tbft-replay")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// Run the first round up to the precommits: the node proposes or gets the
+	// proposal of its peer, both prevote the block and the node locks it.
+	n := newReplayTestNode(t, dir)
+	n.cs.enterNewRound(n.cs.Height, 0)
+	n.process()
+	if n.cs.Proposal == nil {
+		block, _ := n.cs.state.MakeBlock(nil)
+		parts, _ := n.cs.state.MakePartSet(ttypes.BlockPartSizeBytes, block)
+		proposal := ttypes.NewProposal(n.cs.Height, int(n.cs.Round), parts.Header(), ^uint(0), ttypes.BlockID{})
+		if err := ttypes.NewPrivValidator(*n.privs[1]).SignProposal(n.chainID, proposal); err != nil {
+			t.Fatal(err)
+		}
+		n.receive(&ProposalMessage{proposal})
+		for i := uint(0); i < parts.Total(); i++ {
+			n.receive(&BlockPartMessage{n.cs.Height, n.cs.Round, parts.GetPart(i)})
+		}
+	}
+	if n.cs.ProposalBlock == nil {
+		t.Fatal("no proposal block")
+	}
+	hash := n.cs.ProposalBlock.Hash()
+	n.receive(&VoteMessage{n.vote(t, ttypes.VoteTypePrevote, hash[:], n.cs.ProposalBlockParts.Header())})
+	if n.cs.LockedBlock == nil || n.cs.Step != ttypes.RoundStepPrecommit {
+		t.Fatalf("round not locked: step %v", n.cs.Step)
+	}
+	want := n.cs.GetRoundState()
+
+	// Crash, then restart from the wal and the stored privValidator state.
+	n.cs.wal.Stop()
+	n.cs.wal.Wait()
+	n = newReplayTestNode(t, dir)
+	if err := n.cs.catchupReplay(n.cs.Height); err != nil {
+		t.Fatal(err)
+	}
+	defer n.cs.wal.Stop()
+
+	have := n.cs.GetRoundState()
+	if have.Height != want.Height || have.Round != want.Round || have.Step != want.Step {
+		t.Fatalf("replayed to %d/%d/%v, want %d/%d/%v", have.Height, have.Round, have.Step, want.Height, want.Round, want.Step)
+	}
+	if have.Proposal == nil || have.Proposal.String() != want.Proposal.String() {
+		t.Fatalf("proposal mismatch: have %v, want %v", have.Proposal, want.Proposal)
+	}
+	if have.LockedBlock == nil || have.LockedBlock.Hash() != want.LockedBlock.Hash() || have.LockedRound != want.LockedRound {
+		t.Fatalf("locked block mismatch: have %v, want %v", have.LockedBlock, want.LockedBlock)
+	}
+	for _, typeB := range []byte{ttypes.VoteTypePrevote, ttypes.VoteTypePrecommit} {
+		haveVotes := have.Votes.Prevotes(int(have.Round))
+		wantVotes := want.Votes.Prevotes(int(want.Round))
+		if typeB == ttypes.VoteTypePrecommit {
+			haveVotes, wantVotes = have.Votes.Precommits(int(have.Round)), want.Votes.Precommits(int(want.Round))
+		}
+		if haveVotes.BitArray().String() != wantVotes.BitArray().String() {
+			t.Fatalf("votes of type %d mismatch: have %v, want %v", typeB, haveVotes.BitArray(), wantVotes.BitArray())
+		}
+	}
+}
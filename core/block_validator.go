@@ -18,6 +18,7 @@ package core
 
 import (
 	"fmt"
+	"math/big"
 
 	"git.taiyue.io/pist/go-pist/log"
 	"git.taiyue.io/pist/go-pist/consensus"
@@ -68,10 +69,22 @@ func (fv *BlockValidator) ValidateBody(block *types.Block, validateSign bool) er
 		return fmt.Errorf("transaction root hash mismatch: have %x, want %x", hash, header.TxHash)
 	}
 
-	if hash := types.RlpHash(block.SwitchInfos()); hash != header.CommitteeHash {
+	if hash := types.CalcCommitteeHash(block.SwitchInfos(), block.ParentSigns()); hash != header.CommitteeHash {
 		return fmt.Errorf("SwitchInfos root hash mismatch: have %x, want %x", hash, header.TxHash)
 	}
 
+	// The signs committing the parent are complete once the block is proposed,
+	// they are checked even when the signs of the block itself aren't yet.
+	if fv.config.IsParentSigns(block.Number()) && block.Header().Reward == 0 {
+		parent := new(big.Int).Sub(block.Number(), big.NewInt(1))
+		if err := fv.bc.engine.VerifySigns(parent, block.ParentHash(), block.ParentSigns()); err != nil {
+			log.Info("Fast VerifySigns of parent Err", "number", block.NumberU64(), "signs", len(block.ParentSigns()))
+			return err
+		}
+	} else if len(block.ParentSigns()) != 0 {
+		return ErrUnexpectedParentSigns
+	}
+
 	if validateSign {
 		if err := fv.bc.engine.VerifySigns(block.Number(), block.Hash(), block.Signs()); err != nil {
 			log.Info("Fast VerifySigns Err", "number", block.NumberU64(), "signs", block.Signs())
@@ -50,6 +50,10 @@ var (
 	blockValidationTimer = metrics.NewRegisteredTimer("chain/validation", nil)
 	blockExecutionTimer  = metrics.NewRegisteredTimer("chain/execution", nil)
 	blockWriteTimer      = metrics.NewRegisteredTimer("chain/write", nil)
+	blockVerifyTimer     = metrics.NewRegisteredTimer("chain/verification", nil)
+
+	blockTxsMeter = metrics.NewRegisteredMeter("chain/txs", nil)
+	blockGasMeter = metrics.NewRegisteredMeter("chain/gas", nil)
 
 	ErrNoGenesis = errors.New("Genesis not found in chain")
 )
@@ -113,6 +117,8 @@ type BlockChain struct {
 	chainHeadFeed    event.Feed
 	logsFeed         event.Feed
 	RewardNumberFeed event.Feed
+	blockProcFeed    event.Feed
+	blockStatsFeed   event.Feed
 	scope            event.SubscriptionScope
 	genesisBlock     *types.Block
 
@@ -265,6 +271,9 @@ func (bc *BlockChain) loadLastState() error {
 	// Everything seems to be fine, set as the head block
 	bc.currentBlock.Store(currentBlock)
 
+	// Restore the consensus parameters changed by the governance
+	bc.applyGovernance(currentBlock)
+
 	// Restore the last known head header
 	currentHeader := currentBlock.Header()
 	if head := rawdb.ReadHeadHeaderHash(bc.db); head != (common.Hash{}) {
@@ -380,6 +389,7 @@ func (bc *BlockChain) FastSyncCommitHead(hash common.Hash) error {
 	bc.chainmu.Lock()
 	bc.currentBlock.Store(block)
 	bc.chainmu.Unlock()
+	bc.applyGovernance(block)
 
 	log.Info("Committed new head block", "number", block.Number(), "hash", hash)
 	return nil
@@ -563,6 +573,18 @@ func (bc *BlockChain) ExportN(w io.Writer, first uint64, last uint64) error {
 	return nil
 }
 
+// applyGovernance makes the node follow the parameters approved by the
+// governance in the state of its new head block, see vm.ApplyGovernance.
+func (bc *BlockChain) applyGovernance(head *types.Block) {
+	statedb, err := state.New(head.Root(), bc.stateCache)
+	if err != nil {
+		return
+	}
+	if gov, err := vm.LoadGovernance(statedb); err == nil {
+		vm.ApplyGovernance(gov, head.NumberU64())
+	}
+}
+
 // insert injects a new head block into the current block chain. This method
 // assumes that the block is indeed a true head. It will also reset the head
 // header and the head fast sync block to this very same block if they are older
@@ -579,6 +601,7 @@ func (bc *BlockChain) insert(block *types.Block) {
 	bc.hc.SetCurrentHeader(block.Header())
 	rawdb.WriteHeadFastBlockHash(bc.db, block.Hash())
 	bc.currentFastBlock.Store(block)
+	bc.applyGovernance(block)
 
 	if block.NumberU64() >= bc.CurrentLastBlock().NumberU64() {
 		bc.isFallback = false
@@ -828,6 +851,7 @@ func (bc *BlockChain) Rollback(chain []common.Hash) {
 			newBlock := bc.GetBlock(currentBlock.ParentHash(), currentBlock.NumberU64()-1)
 			bc.currentBlock.Store(newBlock)
 			rawdb.WriteHeadBlockHash(bc.db, newBlock.Hash())
+			bc.applyGovernance(newBlock)
 		}
 	}
 }
@@ -1116,7 +1140,11 @@ func (bc *BlockChain) InsertChain(chain types.Blocks) (int, error) {
 				chain[i-1].Hash().Bytes()[:4], i, chain[i].NumberU64(), chain[i].Hash().Bytes()[:4], chain[i].ParentHash().Bytes()[:4])
 		}
 	}
-	// Pre-checks passed, start the full block imports
+	// Pre-checks passed, start the full block imports. The processing is reported
+	// out of the chain lock, for a slow subscriber not to hold it
+	bc.blockProcFeed.Send(true)
+	defer bc.blockProcFeed.Send(false)
+
 	bc.wg.Add(1)
 	bc.chainmu.Lock()
 	n, events, logs, err := bc.insertChain(chain, true)
@@ -1231,7 +1259,7 @@ func (bc *BlockChain) insertChain(chain types.Blocks, verifySeals bool) (int, []
 		}
 		// Process block using the parent state as reference point.
 		t0 := time.Now()
-		receipts, logs, usedGas, _, err := bc.processor.Process(block, state, bc.vmConfig)
+		receipts, logs, usedGas, infos, err := bc.processor.Process(block, state, bc.vmConfig)
 		t1 := time.Now()
 		if err != nil {
 			bc.reportBlock(block, receipts, err)
@@ -1251,10 +1279,25 @@ func (bc *BlockChain) insertChain(chain types.Blocks, verifySeals bool) (int, []
 		if err != nil {
 			return it.index, events, coalescedLogs, err
 		}
+		if infos != nil {
+			bc.WriteRewardInfos(infos)
+		}
 		blockInsertTimer.UpdateSince(start)
+		blockVerifyTimer.Update(it.waited)
 		blockExecutionTimer.Update(t1.Sub(t0))
 		blockValidationTimer.Update(t2.Sub(t1))
 		blockWriteTimer.Update(t3.Sub(t2))
+		blockTxsMeter.Mark(int64(len(block.Transactions())))
+		blockGasMeter.Mark(int64(usedGas))
+
+		events = append(events, types.BlockStatsEvent{
+			Block:    block,
+			Verify:   it.waited,
+			Execute:  t1.Sub(t0),
+			Validate: t2.Sub(t1),
+			Commit:   t3.Sub(t2),
+			GasUsed:  usedGas,
+		})
 		switch status {
 		case CanonStatTy:
 			log.Debug("Inserted new fast block", "number", block.Number(), "hash", block.Hash(),
@@ -1538,6 +1581,8 @@ func (bc *BlockChain) postChainEvents(events []interface{}, logs []*types.Log) {
 			bc.chainHeadFeed.Send(ev)
 		case types.FastChainSideEvent:
 			bc.chainSideFeed.Send(ev)
+		case types.BlockStatsEvent:
+			bc.blockStatsFeed.Send(ev)
 
 		}
 	}
@@ -1750,16 +1795,23 @@ func (bc *BlockChain) stateGcBodyAndReceipt(gcNumber uint64) {
 	rawdb.WriteStateGcBR(bc.db, gcNumber+blockDeleteOnce)
 }
 
-// SetCommitteeInfo write committee info in rawdb for light client
+// SetCommitteeInfo is not implemented, the light clients follow the committees
+// with light.CommitteeChain instead, see pistclient.LightClient.
 func (bc *BlockChain) SetCommitteeInfo(hash common.Hash, number uint64, infos []*types.CommitteeMember) {
 }
 
 // SubscribeBlockProcessingEvent registers a subscription of bool where true means
 // block processing has started while false means it has stopped.
 func (bc *BlockChain) SubscribeBlockProcessingEvent(ch chan<- bool) event.Subscription {
-	// return bc.scope.Track(bc.blockProcFeed.Subscribe(ch))
-	return nil
+	return bc.scope.Track(bc.blockProcFeed.Subscribe(ch))
 }
+
+// SubscribeBlockStatsEvent registers a subscription of types.BlockStatsEvent,
+// posted for every block inserted in the chain.
+func (bc *BlockChain) SubscribeBlockStatsEvent(ch chan<- types.BlockStatsEvent) event.Subscription {
+	return bc.scope.Track(bc.blockStatsFeed.Subscribe(ch))
+}
+
 func (bc *BlockChain) GetRewardInfos(number uint64) *types.ChainReward {
 	infos := rawdb.ReadRewardInfo(bc.db, number)
 	if infos == nil {
@@ -84,6 +84,7 @@ type insertIterator struct {
 	results   <-chan error
 	index     int
 	validator Validator
+	waited    time.Duration // Time waited on the verification of the current block
 }
 
 // newInsertIterator creates a new iterator based on the given blocks, which are
@@ -105,6 +106,10 @@ func (it *insertIterator) next() (*types.Block, error) {
 		return nil, nil
 	}
 	it.index++
+
+	start := time.Now()
+	defer func() { it.waited = time.Since(start) }()
+
 	if err := <-it.results; err != nil {
 		return it.chain[it.index], err
 	}
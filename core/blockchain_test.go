@@ -30,6 +30,7 @@ import (
 	"math/big"
 	"sync"
 	"testing"
+	"time"
 )
 
 // So we can deterministically seed different blockchains
@@ -65,6 +66,54 @@ func TestInsertBlock(t *testing.T) {
 	t.Log("this block hash:", thast)
 }
 
+// Tests the processing of an import is reported around it, out of the chain
+// lock, and the stats of every block inserted.
+func TestBlockProcessingEvents(t *testing.T) {
+	_, blockchain, err := newCanonical(ethash.NewFaker(), 0, true)
+	if err != nil {
+		t.Fatalf("failed to create pristine chain: %v", err)
+	}
+	defer blockchain.Stop()
+
+	procCh := make(chan bool)
+	procSub := blockchain.SubscribeBlockProcessingEvent(procCh)
+	defer procSub.Unsubscribe()
+
+	statsCh := make(chan types.BlockStatsEvent, 3)
+	statsSub := blockchain.SubscribeBlockStatsEvent(statsCh)
+	defer statsSub.Unsubscribe()
+
+	blocks := makeBlockChain(blockchain.CurrentBlock(), 3, blockchain.engine, blockchain.db, canonicalSeed)
+	errc := make(chan error, 1)
+	go func() {
+		_, err := blockchain.InsertChain(blocks)
+		errc <- err
+	}()
+	if importing := <-procCh; !importing {
+		t.Fatal("import end reported first")
+	}
+	// The end isn't read yet, the blocks are inserted and the lock released
+	for i, block := range blocks {
+		select {
+		case ev := <-statsCh:
+			if ev.Block.Hash() != block.Hash() {
+				t.Fatalf("stats %d: block mismatch: have %x, want %x", i, ev.Block.Hash(), block.Hash())
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("stats %d not posted", i)
+		}
+	}
+	blockchain.chainmu.Lock()
+	blockchain.chainmu.Unlock()
+
+	if importing := <-procCh; importing {
+		t.Fatal("import end not reported")
+	}
+	if err := <-errc; err != nil {
+		t.Fatalf("failed to insert blocks: %v", err)
+	}
+}
+
 // testBlockChainImport tries to process a chain of blocks, writing them into
 // the database if successful.
 func testBlockChainImport(chain types.Blocks, blockchain *BlockChain) error {
@@ -186,6 +186,14 @@ func (b *BlockGen) GetStateDB() *state.StateDB {
 	return b.statedb
 }
 
+// parentSigns returns the signs of the parent the generated block carries.
+func (b *BlockGen) parentSigns() []*types.PbftSign {
+	if !b.config.IsParentSigns(b.header.Number) {
+		return nil
+	}
+	return b.parent.Signs()
+}
+
 // GenerateChain creates a chain of n blocks. The first block's
 // parent will be the provided parent. db is used to store
 // intermediate states and should contain the parent's state trie.
@@ -217,7 +225,7 @@ func GenerateChain(config *params.ChainConfig, parent *types.Block, engine conse
 		}
 
 		if b.engine != nil {
-			block,_, err := b.engine.Finalize(chainreader, b.header, statedb, b.txs, b.receipts, b.feeAmout)
+			block,_, err := b.engine.Finalize(chainreader, b.header, statedb, b.txs, b.receipts, b.parentSigns(), b.feeAmout)
 			if err != nil {
 				fmt.Println(" err ", err.Error())
 			}
@@ -265,7 +273,7 @@ func GenerateChainWithReward(config *params.ChainConfig, parent *types.Block, en
 		}
 
 		if b.engine != nil {
-			block,_, _ := b.engine.Finalize(chainreader, b.header, statedb, b.txs, b.receipts, new(big.Int))
+			block,_, _ := b.engine.Finalize(chainreader, b.header, statedb, b.txs, b.receipts, b.parentSigns(), new(big.Int))
 
 			sign, err := b.engine.GetElection().GenerateFakeSigns(block)
 			block.SetSign(sign)
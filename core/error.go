@@ -38,6 +38,10 @@ var (
 
 	ErrIsFallback = errors.New("block and state is falling back")
 
+	// ErrUnexpectedParentSigns is returned if a block carries the signs of its
+	// parent before the fork requiring them or while switching the committee.
+	ErrUnexpectedParentSigns = errors.New("unexpected parent signs")
+
 	// ErrInsufficientFundsForTransfer is returned if the transaction sender doesn't
 	// have enough funds for transfer(topmost call only).
 	ErrInsufficientFundsForTransfer = errors.New("insufficient funds for transfer")
@@ -211,6 +211,13 @@ func gatherForks(config *params.ChainConfig) []uint64 {
 	for i := 0; i < kind.NumField(); i++ {
 		// Fetch the next field and skip non-fork rules
 		field := kind.Field(i)
+		// The TIP forks are scheduled by their fast number
+		if field.Type == reflect.TypeOf(new(params.BlockConfig)) {
+			if rule := conf.Field(i).Interface().(*params.BlockConfig); rule != nil && rule.FastNumber != nil {
+				forks = append(forks, rule.FastNumber.Uint64())
+			}
+			continue
+		}
 		if !strings.HasSuffix(field.Name, "Block") {
 			continue
 		}
@@ -223,6 +230,10 @@ func gatherForks(config *params.ChainConfig) []uint64 {
 			forks = append(forks, rule.Uint64())
 		}
 	}
+	// The reward policy keeps its fork height in its own config
+	if config.Reward != nil && config.Reward.Block != nil {
+		forks = append(forks, config.Reward.Block.Uint64())
+	}
 	// Sort the fork block numbers to permit chronological XOR
 	for i := 0; i < len(forks); i++ {
 		for j := i + 1; j < len(forks); j++ {
@@ -0,0 +1,28 @@
+package forkid
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/params"
+)
+
+func TestGatherForksReward(t *testing.T) {
+	config := &params.ChainConfig{
+		ChainID:       big.NewInt(1),
+		YoloV1Block:   big.NewInt(0),
+		SlashingBlock: big.NewInt(100),
+		Reward:        &params.RewardConfig{Block: big.NewInt(50)},
+	}
+	if forks := gatherForks(config); !reflect.DeepEqual(forks, []uint64{50, 100}) {
+		t.Fatalf("forks mismatch: have %v, want [50 100]", forks)
+	}
+	// Nodes on different reward policies don't share a fork id
+	other := *config
+	other.Reward = &params.RewardConfig{Block: big.NewInt(60)}
+	if newID(config, common.Hash{}, 200) == newID(&other, common.Hash{}, 200) {
+		t.Fatal("fork id ignores the reward policy")
+	}
+}
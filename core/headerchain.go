@@ -400,6 +400,13 @@ func (fhc *HeaderChain) SetHead(head uint64, delFn FastDeleteCallback) {
 	}
 	batch.Write()
 
+	// Frozen blocks above the new head can't be deleted one by one
+	if frozen, err := fhc.chainDb.Ancients(); err == nil && frozen > head+1 {
+		if err := fhc.chainDb.TruncateAncients(head + 1); err != nil {
+			log.Crit("Failed to truncate ancient data", "number", head, "err", err)
+		}
+	}
+
 	// Clear out any stale content from the caches
 	fhc.headerCache.Purge()
 	fhc.tdCache.Purge()
@@ -22,13 +22,51 @@ import (
 	"git.taiyue.io/pist/go-pist/common"
 	"git.taiyue.io/pist/go-pist/core/types"
 	"git.taiyue.io/pist/go-pist/log"
+	"git.taiyue.io/pist/go-pist/pistdb"
 	"git.taiyue.io/pist/go-pist/rlp"
 	"math/big"
 )
 
+// readAncient retrieves the blob of the given kind and number from the ancient
+// store behind db. It returns nil if db has no ancient store or the block isn't
+// frozen.
+func readAncient(db DatabaseReader, kind string, number uint64) []byte {
+	if adb, ok := db.(pistdb.AncientReader); ok {
+		data, _ := adb.Ancient(kind, number)
+		return data
+	}
+	return nil
+}
+
+// readCanonicalAncient is like readAncient, but only returns the blob if the
+// frozen block of that number has the given hash.
+func readCanonicalAncient(db DatabaseReader, kind string, hash common.Hash, number uint64) []byte {
+	if common.BytesToHash(readAncient(db, pistdb.FreezerHashTable, number)) != hash {
+		return nil
+	}
+	return readAncient(db, kind, number)
+}
+
+// hasCanonicalAncient reports whether the ancient store behind db holds the
+// blob of the given kind for the block with the given hash and number.
+func hasCanonicalAncient(db DatabaseReader, kind string, hash common.Hash, number uint64) bool {
+	adb, ok := db.(pistdb.AncientReader)
+	if !ok {
+		return false
+	}
+	if common.BytesToHash(readAncient(db, pistdb.FreezerHashTable, number)) != hash {
+		return false
+	}
+	has, err := adb.HasAncient(kind, number)
+	return has && err == nil
+}
+
 // ReadCanonicalHash retrieves the hash assigned to a canonical block number.
 func ReadCanonicalHash(db DatabaseReader, number uint64) common.Hash {
 	data, _ := db.Get(headerHashKey(number))
+	if len(data) == 0 {
+		data = readAncient(db, pistdb.FreezerHashTable, number)
+	}
 	if len(data) == 0 {
 		return common.Hash{}
 	}
@@ -175,16 +213,44 @@ func WriteFastTrieProgress(db DatabaseWriter, count uint64) {
 	}
 }
 
+// ReadSnapSyncProgress retrieves the encoded progress of the snap sync of the
+// state ranges, to resume it across restarts.
+func ReadSnapSyncProgress(db DatabaseReader) []byte {
+	data, _ := db.Get(snapSyncProgressKey)
+	return data
+}
+
+// WriteSnapSyncProgress stores the encoded progress of the snap sync of the
+// state ranges.
+func WriteSnapSyncProgress(db DatabaseWriter, progress []byte) {
+	if err := db.Put(snapSyncProgressKey, progress); err != nil {
+		log.Crit("Failed to store snap sync progress", "err", err)
+	}
+}
+
+// DeleteSnapSyncProgress removes the progress of the snap sync of the state
+// ranges.
+func DeleteSnapSyncProgress(db DatabaseDeleter) {
+	if err := db.Delete(snapSyncProgressKey); err != nil {
+		log.Crit("Failed to delete snap sync progress", "err", err)
+	}
+}
+
 // ReadHeaderRLP retrieves a block header in its raw RLP database encoding.
 func ReadHeaderRLP(db DatabaseReader, hash common.Hash, number uint64) rlp.RawValue {
 	data, _ := db.Get(headerKey(number, hash))
+	if len(data) == 0 {
+		// The freezer moves the data before deleting it, so a block missing
+		// from the key-value store is found in the ancient one.
+		data = readCanonicalAncient(db, pistdb.FreezerHeaderTable, hash, number)
+	}
 	return data
 }
 
 // HasHeader verifies the existence of a block header corresponding to the hash.
 func HasHeader(db DatabaseReader, hash common.Hash, number uint64) bool {
 	if has, err := db.Has(headerKey(number, hash)); !has || err != nil {
-		return false
+		return hasCanonicalAncient(db, pistdb.FreezerHeaderTable, hash, number)
 	}
 	return true
 }
@@ -228,6 +294,14 @@ func WriteHeader(db DatabaseWriter, header *types.Header) {
 	}
 }
 
+// deleteHeaderWithoutNumber removes only the block header but does not remove
+// the hash to number mapping.
+func deleteHeaderWithoutNumber(db DatabaseDeleter, hash common.Hash, number uint64) {
+	if err := db.Delete(headerKey(number, hash)); err != nil {
+		log.Crit("Failed to delete header", "err", err)
+	}
+}
+
 // DeleteHeader removes all block header data associated with a hash.
 func DeleteHeader(db DatabaseDeleter, hash common.Hash, number uint64) {
 	if err := db.Delete(headerKey(number, hash)); err != nil {
@@ -241,6 +315,9 @@ func DeleteHeader(db DatabaseDeleter, hash common.Hash, number uint64) {
 // ReadBodyRLP retrieves the block body (transactions and uncles) in RLP encoding.
 func ReadBodyRLP(db DatabaseReader, hash common.Hash, number uint64) rlp.RawValue {
 	data, _ := db.Get(blockBodyKey(number, hash))
+	if len(data) == 0 {
+		data = readCanonicalAncient(db, pistdb.FreezerBodiesTable, hash, number)
+	}
 	return data
 }
 
@@ -254,7 +331,7 @@ func WriteBodyRLP(db DatabaseWriter, hash common.Hash, number uint64, rlp rlp.Ra
 // HasBody verifies the existence of a block body corresponding to the hash.
 func HasBody(db DatabaseReader, hash common.Hash, number uint64) bool {
 	if has, err := db.Has(blockBodyKey(number, hash)); !has || err != nil {
-		return false
+		return hasCanonicalAncient(db, pistdb.FreezerBodiesTable, hash, number)
 	}
 	return true
 }
@@ -318,15 +395,24 @@ func WriteTd(db DatabaseWriter, hash common.Hash, number uint64, td *big.Int) {
 // to a block.
 func HasReceipts(db DatabaseReader, hash common.Hash, number uint64) bool {
 	if has, err := db.Has(blockReceiptsKey(number, hash)); !has || err != nil {
-		return false
+		return hasCanonicalAncient(db, pistdb.FreezerReceiptTable, hash, number)
 	}
 	return true
 }
 
+// ReadReceiptsRLP retrieves all the transaction receipts belonging to a block in RLP encoding.
+func ReadReceiptsRLP(db DatabaseReader, hash common.Hash, number uint64) rlp.RawValue {
+	data, _ := db.Get(blockReceiptsKey(number, hash))
+	if len(data) == 0 {
+		data = readCanonicalAncient(db, pistdb.FreezerReceiptTable, hash, number)
+	}
+	return data
+}
+
 // ReadReceipts retrieves all the transaction receipts belonging to a block.
 func ReadReceipts(db DatabaseReader, hash common.Hash, number uint64) types.Receipts {
 	// Retrieve the flattened receipt slice
-	data, _ := db.Get(blockReceiptsKey(number, hash))
+	data := ReadReceiptsRLP(db, hash, number)
 	if len(data) == 0 {
 		return nil
 	}
@@ -396,7 +482,7 @@ func ReadBlock(db DatabaseReader, hash common.Hash, number uint64) *types.Block
 	if body == nil {
 		return nil
 	}
-	return types.NewBlockWithHeader(header).WithBody(body.Transactions, body.Signs, body.Infos)
+	return types.NewBlockWithHeader(header).WithBody(body.Transactions, body.Signs, body.Infos, body.ParentSigns)
 }
 
 // ReadSnapBlock retrieves an snap block corresponding to the hash, assembling it
@@ -426,6 +512,15 @@ func DeleteBlock(db DatabaseDeleter, hash common.Hash, number uint64) {
 	DeleteBody(db, hash, number)
 }
 
+// DeleteBlockWithoutNumber removes all block data associated with a hash, except
+// the hash to number mapping.
+func DeleteBlockWithoutNumber(db DatabaseDeleter, hash common.Hash, number uint64) {
+	DeleteReceipts(db, hash, number)
+	deleteHeaderWithoutNumber(db, hash, number)
+	DeleteBody(db, hash, number)
+	DeleteCommitteeInfo(db, hash, number)
+}
+
 // ReadHeaderRLP retrieves a block header in its raw RLP database encoding.
 func ReadBlockRewardRLP(db DatabaseReader, number uint64) rlp.RawValue {
 	data, _ := db.Get(blockRewardKey(number))
@@ -497,7 +592,7 @@ func FindCommonAncestor(db DatabaseReader, a, b *types.Header) *types.Header {
 
 // ReadTd retrieves a block's total difficulty corresponding to the hash.
 func ReadCommitteeInfo(db DatabaseReader, hash common.Hash, number uint64) []*types.CommitteeMember {
-	data, _ := db.Get(headerCIKey(number, hash))
+	data := ReadCommitteeInfoRLP(db, hash, number)
 	if len(data) == 0 {
 		return nil
 	}
@@ -509,6 +604,15 @@ func ReadCommitteeInfo(db DatabaseReader, hash common.Hash, number uint64) []*ty
 	return infos
 }
 
+// ReadCommitteeInfoRLP retrieves the committee info of a block in RLP encoding.
+func ReadCommitteeInfoRLP(db DatabaseReader, hash common.Hash, number uint64) rlp.RawValue {
+	data, _ := db.Get(headerCIKey(number, hash))
+	if len(data) == 0 {
+		data = readCanonicalAncient(db, pistdb.FreezerCommitteeTable, hash, number)
+	}
+	return data
+}
+
 // WriteTd stores the total difficulty of a block into the database.
 func WriteCommitteeInfo(db DatabaseWriter, hash common.Hash, number uint64, info []*types.CommitteeMember) {
 	data, err := rlp.EncodeToBytes(info)
@@ -519,6 +623,14 @@ func WriteCommitteeInfo(db DatabaseWriter, hash common.Hash, number uint64, info
 		log.Crit("Failed to store block total difficulty", "err", err)
 	}
 }
+
+// DeleteCommitteeInfo removes the committee info of a block.
+func DeleteCommitteeInfo(db DatabaseDeleter, hash common.Hash, number uint64) {
+	if err := db.Delete(headerCIKey(number, hash)); err != nil {
+		log.Crit("Failed to delete committee info", "err", err)
+	}
+}
+
 func WriteRewardInfo(db DatabaseWriter, snailHeight uint64, infos *types.ChainReward) {
 	data, err := rlp.EncodeToBytes(infos)
 	if err != nil {
@@ -0,0 +1,191 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package rawdb
+
+import (
+	"sync"
+	"time"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/log"
+	"git.taiyue.io/pist/go-pist/pistdb"
+)
+
+const (
+	// freezerRecheckInterval is the frequency to check the key-value database for
+	// chain progression that might permit new blocks to be frozen into immutable
+	// storage.
+	freezerRecheckInterval = time.Minute
+
+	// freezerBatchLimit is the maximum number of blocks to freeze in one batch
+	// before doing an fsync and deleting it from the key-value store.
+	freezerBatchLimit = 30000
+)
+
+// ChainFreezer moves the canonical blocks that are older than a threshold out
+// of the key-value store of a database into its ancient store. Blocks are final
+// once the committee commits them, the threshold only keeps the recent ones,
+// which are the most read and the only ones SetHead may rewind, in LevelDB.
+type ChainFreezer struct {
+	db        pistdb.Database
+	threshold uint64
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewChainFreezer creates a freezer for the ancient store of db, which keeps the
+// latest threshold blocks in the key-value store.
+func NewChainFreezer(db pistdb.Database, threshold uint64) *ChainFreezer {
+	return &ChainFreezer{
+		db:        db,
+		threshold: threshold,
+		quit:      make(chan struct{}),
+	}
+}
+
+// Start starts migrating the ancient blocks in the background.
+func (f *ChainFreezer) Start() {
+	f.wg.Add(1)
+	go f.freeze()
+}
+
+// Stop stops the migration and waits for the batch in progress to finish.
+func (f *ChainFreezer) Stop() {
+	close(f.quit)
+	f.wg.Wait()
+}
+
+// freeze is a background thread that periodically checks the blockchain for any
+// import progress and moves ancient data from the fast database into the freezer.
+//
+// This functionality is deliberately broken off from block importing to avoid
+// incurring additional data shuffling delays on block propagation.
+func (f *ChainFreezer) freeze() {
+	defer f.wg.Done()
+
+	backoff := false
+	for {
+		if backoff {
+			select {
+			case <-time.NewTimer(freezerRecheckInterval).C:
+			case <-f.quit:
+				return
+			}
+		}
+		select {
+		case <-f.quit:
+			return
+		default:
+		}
+		backoff = true
+
+		// Retrieve the freezing threshold.
+		hash := ReadHeadBlockHash(f.db)
+		if hash == (common.Hash{}) {
+			log.Debug("Current full block hash unavailable") // new chain, empty database
+			continue
+		}
+		number := ReadHeaderNumber(f.db, hash)
+		frozen, err := f.db.Ancients()
+		switch {
+		case err != nil:
+			log.Error("Ancient store unavailable", "err", err)
+			return
+
+		case number == nil:
+			log.Error("Current full block number unavailable", "hash", hash)
+			continue
+
+		case *number < f.threshold:
+			log.Debug("Current full block not old enough", "number", *number, "hash", hash, "delay", f.threshold)
+			continue
+
+		case *number-f.threshold <= frozen:
+			log.Debug("Ancient blocks frozen already", "number", *number, "hash", hash, "frozen", frozen)
+			continue
+		}
+		// Seems we have data ready to be frozen, process in usable batches
+		limit := *number - f.threshold
+		if limit-frozen > freezerBatchLimit {
+			limit = frozen + freezerBatchLimit
+		}
+		var (
+			start    = time.Now()
+			first    = frozen
+			ancients = make([]common.Hash, 0, limit-frozen)
+		)
+		for frozen < limit {
+			// Retrieves all the components of the canonical block
+			hash := ReadCanonicalHash(f.db, frozen)
+			if hash == (common.Hash{}) {
+				log.Error("Canonical hash missing, can't freeze", "number", frozen)
+				break
+			}
+			header := ReadHeaderRLP(f.db, hash, frozen)
+			if len(header) == 0 {
+				log.Error("Block header missing, can't freeze", "number", frozen, "hash", hash)
+				break
+			}
+			body := ReadBodyRLP(f.db, hash, frozen)
+			if len(body) == 0 {
+				log.Error("Block body missing, can't freeze", "number", frozen, "hash", hash)
+				break
+			}
+			receipts := ReadReceiptsRLP(f.db, hash, frozen)
+			if len(receipts) == 0 {
+				log.Error("Block receipts missing, can't freeze", "number", frozen, "hash", hash)
+				break
+			}
+			// Most blocks don't carry a committee info, an empty blob stands for it
+			committee := ReadCommitteeInfoRLP(f.db, hash, frozen)
+
+			// Inject all the components into the relevant data tables
+			if err := f.db.AppendAncient(frozen, hash[:], header, body, receipts, committee); err != nil {
+				break
+			}
+			frozen++
+			ancients = append(ancients, hash)
+		}
+		// Batch of blocks have been frozen, flush them before wiping from leveldb
+		if err := f.db.Sync(); err != nil {
+			log.Crit("Failed to flush frozen tables", "err", err)
+		}
+		// Wipe out all data from the active database, the genesis stays around
+		// for the checks done when opening the chain
+		batch := f.db.NewBatch()
+		for i := 0; i < len(ancients); i++ {
+			if first+uint64(i) == 0 {
+				continue
+			}
+			DeleteBlockWithoutNumber(batch, ancients[i], first+uint64(i))
+			DeleteCanonicalHash(batch, first+uint64(i))
+		}
+		if err := batch.Write(); err != nil {
+			log.Crit("Failed to delete frozen canonical blocks", "err", err)
+		}
+		// Log something friendly for the user
+		if n := len(ancients); n > 0 {
+			log.Info("Deep froze chain segment", "blocks", n, "elapsed", common.PrettyDuration(time.Since(start)), "number", frozen-1, "hash", ancients[n-1])
+		}
+
+		// Avoid database thrashing with tiny writes
+		if frozen-first >= freezerBatchLimit {
+			backoff = false
+		}
+	}
+}
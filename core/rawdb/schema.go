@@ -44,6 +44,9 @@ var (
 	// fastTrieProgressKey tracks the number of trie entries imported during fast sync.
 	fastTrieProgressKey = []byte("TrieSync")
 
+	// snapSyncProgressKey tracks the state ranges imported during snap sync.
+	snapSyncProgressKey = []byte("SnapSyncProgress")
+
 	// stateGcBodyReceiptKey tracks the number of body and receipt entries delete during state sync.
 	stateGcBodyReceiptKey = []byte("LastState")
 
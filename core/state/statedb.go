@@ -55,7 +55,9 @@ func (n *proofList) Put(key []byte, value []byte) error {
 	return nil
 }
 
-func lockedKey(addr common.Address) (h common.Hash) {
+// LockedKey returns the key of the locked balance of addr in the storage of the
+// staking address.
+func LockedKey(addr common.Address) (h common.Hash) {
 	base := append(common.BytesToHash(addr[:]).Bytes(), lockedPosition.Bytes()...)
 	return crypto.Keccak256Hash(base)
 }
@@ -306,7 +308,7 @@ func (self *StateDB) GetPOSState(a common.Address, b common.Hash) []byte {
 }
 
 func (self *StateDB) GetPOSLocked(addr common.Address) *big.Int {
-	key := lockedKey(addr)
+	key := LockedKey(addr)
 	return self.GetState(types.StakingAddress, key).Big()
 }
 
@@ -422,7 +424,7 @@ func (self *StateDB) SetPOSState(addr common.Address, key common.Hash, value []b
 }
 
 func (self *StateDB) SetPOSLocked(addr common.Address, value *big.Int) {
-	key := lockedKey(addr)
+	key := LockedKey(addr)
 	self.SetState(types.StakingAddress, key, common.BigToHash(value))
 }
 
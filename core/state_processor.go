@@ -87,7 +87,7 @@ func (fp *StateProcessor) Process(block *types.Block, statedb *state.StateDB,
 	}
 	t1 := time.Now()
 	// Finalize the block, applying any consensus engine specific extras (e.g. block rewards)
-	_, infos, err := fp.engine.Finalize(fp.bc, header, statedb, block.Transactions(), receipts, feeAmount)
+	_, infos, err := fp.engine.Finalize(fp.bc, header, statedb, block.Transactions(), receipts, block.ParentSigns(), feeAmount)
 	if err != nil {
 		return nil, nil, 0, nil, err
 	}
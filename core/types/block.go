@@ -82,6 +82,16 @@ func CalcSignHash(signs []*PbftSign) common.Hash {
 	return rlpHash(signs)
 }
 
+// CalcCommitteeHash returns the committee root of a block carrying the given
+// switch infos and the signs committing its parent. A block without parent
+// signs keeps the root of its switch infos alone.
+func CalcCommitteeHash(infos []*CommitteeMember, parentSigns []*PbftSign) common.Hash {
+	if len(parentSigns) == 0 {
+		return rlpHash(infos)
+	}
+	return rlpHash([]interface{}{infos, parentSigns})
+}
+
 type Blocks []*Block
 
 type BlockBy func(b1, b2 *Block) bool
@@ -165,6 +175,7 @@ type Body struct {
 	Transactions []*Transaction
 	Signs        []*PbftSign
 	Infos        []*CommitteeMember
+	ParentSigns  []*PbftSign `rlp:"tail"`
 }
 
 // BlockReward
@@ -180,6 +191,8 @@ type Block struct {
 
 	signs PbftSigns
 	infos CommitteeMembers
+	// parentSigns are the signs committing the parent block
+	parentSigns PbftSigns
 	// caches
 	hash atomic.Value
 	size atomic.Value
@@ -234,6 +247,19 @@ func NewBlock(header *Header, txs []*Transaction, receipts []*Receipt, signs []*
 	return b
 }
 
+// NewBlockWithParentSigns creates a new fast block as NewBlock does, carrying
+// the signs committing its parent. The committee root of the header commits
+// both the switch infos and the parent signs.
+func NewBlockWithParentSigns(header *Header, txs []*Transaction, receipts []*Receipt, infos []*CommitteeMember, parentSigns []*PbftSign) *Block {
+	b := NewBlock(header, txs, receipts, nil, infos)
+	if len(parentSigns) != 0 {
+		b.parentSigns = make(PbftSigns, len(parentSigns))
+		copy(b.parentSigns, parentSigns)
+	}
+	b.header.CommitteeHash = CalcCommitteeHash(b.infos, b.parentSigns)
+	return b
+}
+
 // SetLeaderSign keep the sign on the head for proposal
 func (b *Body) SetLeaderSign(sign *PbftSign) {
 	signP := *sign
@@ -285,10 +311,11 @@ func CopyHeader(h *Header) *Header {
 
 // "external" block encoding. used for pist protocol, etc.
 type extblock struct {
-	Header *Header
-	Txs    []*Transaction
-	Signs  []*PbftSign
-	Infos  []*CommitteeMember
+	Header      *Header
+	Txs         []*Transaction
+	Signs       []*PbftSign
+	Infos       []*CommitteeMember
+	ParentSigns []*PbftSign `rlp:"tail"`
 }
 
 // DecodeRLP decodes the pistchain
@@ -298,7 +325,7 @@ func (b *Block) DecodeRLP(s *rlp.Stream) error {
 	if err := s.Decode(&eb); err != nil {
 		return err
 	}
-	b.header, b.transactions, b.signs, b.infos = eb.Header, eb.Txs, eb.Signs, eb.Infos
+	b.header, b.transactions, b.signs, b.infos, b.parentSigns = eb.Header, eb.Txs, eb.Signs, eb.Infos, eb.ParentSigns
 	b.size.Store(common.StorageSize(rlp.ListSize(size)))
 	return nil
 }
@@ -306,10 +333,11 @@ func (b *Block) DecodeRLP(s *rlp.Stream) error {
 // EncodeRLP serializes b into the pistchain RLP block format.
 func (b *Block) EncodeRLP(w io.Writer) error {
 	return rlp.Encode(w, extblock{
-		Header: b.header,
-		Txs:    b.transactions,
-		Signs:  b.signs,
-		Infos:  b.infos,
+		Header:      b.header,
+		Txs:         b.transactions,
+		Signs:       b.signs,
+		Infos:       b.infos,
+		ParentSigns: b.parentSigns,
 	})
 }
 
@@ -341,9 +369,10 @@ func (b *Block) Signs() []*PbftSign              { return b.signs }
 func (b *Block) Header() *Header                 { return CopyHeader(b.header) }
 func (b *Block) CommitteeHash() common.Hash      { return b.header.CommitteeHash }
 func (b *Block) SwitchInfos() []*CommitteeMember { return b.infos }
+func (b *Block) ParentSigns() []*PbftSign        { return b.parentSigns }
 
 // Body returns the non-header content of the block.
-func (b *Block) Body() *Body { return &Body{b.transactions, b.signs, b.infos} }
+func (b *Block) Body() *Body { return &Body{b.transactions, b.signs, b.infos, b.parentSigns} }
 
 func (b *Block) AppendSign(sign *PbftSign) {
 	signP := CopyPbftSign(sign)
@@ -418,7 +447,7 @@ func (b *Block) WithSeal(header *Header) *Block {
 }
 
 // WithBody returns a new block with the given transaction contents.
-func (b *Block) WithBody(transactions []*Transaction, signs []*PbftSign, infos []*CommitteeMember) *Block {
+func (b *Block) WithBody(transactions []*Transaction, signs []*PbftSign, infos []*CommitteeMember, parentSigns []*PbftSign) *Block {
 	block := &Block{
 		header:       CopyHeader(b.header),
 		transactions: make([]*Transaction, len(transactions)),
@@ -429,6 +458,10 @@ func (b *Block) WithBody(transactions []*Transaction, signs []*PbftSign, infos [
 	copy(block.transactions, transactions)
 	copy(block.signs, signs)
 	copy(block.infos, infos)
+	if len(parentSigns) != 0 {
+		block.parentSigns = make([]*PbftSign, len(parentSigns))
+		copy(block.parentSigns, parentSigns)
+	}
 	b.header.CommitteeHash = rlpHash(b.infos)
 
 	return block
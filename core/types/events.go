@@ -19,6 +19,7 @@ package types
 import (
 	"git.taiyue.io/pist/go-pist/common"
 	"math/big"
+	"time"
 )
 
 // NewTxsEvent is posted when a batch of transactions enter the transaction pool.
@@ -50,6 +51,17 @@ type FastChainSideEvent struct {
 
 type FastChainHeadEvent struct{ Block *Block }
 
+// BlockStatsEvent is posted when a block has been inserted in the chain, with
+// the time spent in each stage of its processing.
+type BlockStatsEvent struct {
+	Block    *Block
+	Verify   time.Duration // Wait on the parallel header verification, and body validation
+	Execute  time.Duration // Transaction execution
+	Validate time.Duration // State validation
+	Commit   time.Duration // Block and state write
+	GasUsed  uint64
+}
+
 type ElectionEvent struct {
 	Option           uint
 	CommitteeID      *big.Int
@@ -0,0 +1,193 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/common/hexutil"
+	"git.taiyue.io/pist/go-pist/crypto"
+	"git.taiyue.io/pist/go-pist/rlp"
+)
+
+func init() {
+	RegisterEvidence(EvidenceDuplicateSign, DecodeDuplicateSignEvidence)
+}
+
+// Kinds of the misbehaviour a validator is slashed for.
+const (
+	// EvidenceDuplicateVote is two conflicting tbft votes signed by one key
+	EvidenceDuplicateVote uint8 = iota + 1
+	// EvidenceDowntime is the absence of a committee member from the block signs
+	EvidenceDowntime
+	// EvidenceDuplicateSign is two PbftSigns agreeing on different blocks of one
+	// height signed by one key
+	EvidenceDuplicateSign
+)
+
+var (
+	ErrUnknownEvidence   = errors.New("unknown evidence kind")
+	ErrInvalidEvidence   = errors.New("invalid evidence")
+	ErrEvidenceTooOld    = errors.New("evidence is too old")
+	ErrEvidenceFuture    = errors.New("evidence of a future height")
+	ErrDuplicateEvidence = errors.New("evidence already submitted")
+	ErrValidatorJailed   = errors.New("validator is jailed")
+
+	ErrSignsNotConflicting = errors.New("signs are not conflicting")
+	ErrSignsOtherSigner    = errors.New("signs of different keys")
+)
+
+// Evidence is a proof of the misbehaviour of a validator, it is checked
+// without any chain state so that every node reaches the same verdict.
+type Evidence interface {
+	Kind() uint8
+	Height() uint64
+	Hash() common.Hash
+	// Verify checks the evidence and returns the public key of the offender.
+	Verify() ([]byte, error)
+}
+
+// EvidenceDecoder decodes the wire format of an evidence kind.
+type EvidenceDecoder func(data []byte) (Evidence, error)
+
+var evidenceDecoders = make(map[uint8]EvidenceDecoder)
+
+// RegisterEvidence installs the decoder of an evidence kind, it is called by
+// the consensus engine that defines the signed messages of that kind.
+func RegisterEvidence(kind uint8, decoder EvidenceDecoder) {
+	if _, ok := evidenceDecoders[kind]; ok {
+		panic(fmt.Sprintf("evidence kind %d registered twice", kind))
+	}
+	evidenceDecoders[kind] = decoder
+}
+
+// DecodeEvidence decodes an evidence submitted to the staking contract.
+func DecodeEvidence(kind uint8, data []byte) (Evidence, error) {
+	decoder, ok := evidenceDecoders[kind]
+	if !ok {
+		return nil, ErrUnknownEvidence
+	}
+	ev, err := decoder(data)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %v", ErrInvalidEvidence, err)
+	}
+	return ev, nil
+}
+
+// DuplicateSignEvidence contains two PbftSigns a committee member made agreeing
+// on different blocks of the same height, which an honest one never does.
+type DuplicateSignEvidence struct {
+	SignA *PbftSign
+	SignB *PbftSign
+}
+
+// NewDuplicateSignEvidence creates the evidence of two conflicting signs, the
+// signs are ordered so that the same pair always makes the same evidence.
+func NewDuplicateSignEvidence(sign1, sign2 *PbftSign) *DuplicateSignEvidence {
+	signA, signB := sign1, sign2
+	if hashA, hashB := sign1.HashWithNoSign(), sign2.HashWithNoSign(); bytes.Compare(hashA[:], hashB[:]) > 0 {
+		signA, signB = sign2, sign1
+	}
+	return &DuplicateSignEvidence{SignA: signA, SignB: signB}
+}
+
+// DecodeDuplicateSignEvidence decodes the evidence submitted to the staking
+// contract.
+func DecodeDuplicateSignEvidence(data []byte) (Evidence, error) {
+	ev := new(DuplicateSignEvidence)
+	if err := rlp.DecodeBytes(data, ev); err != nil {
+		return nil, err
+	}
+	if ev.SignA == nil || ev.SignB == nil || ev.SignA.FastHeight == nil || ev.SignB.FastHeight == nil {
+		return nil, ErrInvalidEvidence
+	}
+	return NewDuplicateSignEvidence(ev.SignA, ev.SignB), nil
+}
+
+// Bytes returns the wire format of the evidence.
+func (ev *DuplicateSignEvidence) Bytes() []byte {
+	data, _ := rlp.EncodeToBytes(ev)
+	return data
+}
+
+// Kind implements Evidence.
+func (ev *DuplicateSignEvidence) Kind() uint8 {
+	return EvidenceDuplicateSign
+}
+
+// Height implements Evidence.
+func (ev *DuplicateSignEvidence) Height() uint64 {
+	return ev.SignA.FastHeight.Uint64()
+}
+
+// Hash implements Evidence, it only covers the signed part of the signs.
+func (ev *DuplicateSignEvidence) Hash() common.Hash {
+	return rlpHash([]interface{}{ev.SignA.HashWithNoSign(), ev.SignB.HashWithNoSign()})
+}
+
+// Verify implements Evidence, it returns the public key of the committee member
+// that made both signs.
+func (ev *DuplicateSignEvidence) Verify() ([]byte, error) {
+	a, b := ev.SignA, ev.SignB
+	if a.FastHeight.Cmp(b.FastHeight) != 0 || a.Result != VoteAgree || b.Result != VoteAgree || a.FastHash == b.FastHash {
+		return nil, ErrSignsNotConflicting
+	}
+	pubA, err := crypto.SigToPub(a.HashWithNoSign().Bytes(), a.Sign)
+	if err != nil {
+		return nil, err
+	}
+	pubB, err := crypto.SigToPub(b.HashWithNoSign().Bytes(), b.Sign)
+	if err != nil {
+		return nil, err
+	}
+	pkA, pkB := crypto.FromECDSAPub(pubA), crypto.FromECDSAPub(pubB)
+	if !bytes.Equal(pkA, pkB) {
+		return nil, ErrSignsOtherSigner
+	}
+	return pkA, nil
+}
+
+// SlashRecord is kept in the staking state for every punished validator.
+type SlashRecord struct {
+	Address        common.Address // staking account of the validator
+	Kind           uint8
+	EvidenceHash   common.Hash
+	EvidenceHeight uint64
+	Height         uint64   // block of the slashing
+	Amount         *big.Int // stake taken from the validator and its delegators
+	Reward         *big.Int // part of Amount paid to the reporter
+	Reporter       common.Address
+	JailedUntil    uint64 // last epoch the validator is kept out of the elections
+}
+
+func (r *SlashRecord) clone() *SlashRecord {
+	tmp := *r
+	tmp.Amount, tmp.Reward = new(big.Int).Set(r.Amount), new(big.Int).Set(r.Reward)
+	return &tmp
+}
+
+// CloneSlashRecords returns a deep copy of records.
+func CloneSlashRecords(records []*SlashRecord) []*SlashRecord {
+	res := make([]*SlashRecord, 0, len(records))
+	for _, v := range records {
+		res = append(res, v.clone())
+	}
+	return res
+}
+
+// ToMap formats the record for the RPC.
+func (r *SlashRecord) ToMap() map[string]interface{} {
+	item := make(map[string]interface{})
+	item["address"] = r.Address
+	item["kind"] = r.Kind
+	item["evidenceHash"] = r.EvidenceHash
+	item["evidenceHeight"] = r.EvidenceHeight
+	item["height"] = r.Height
+	item["amount"] = (*hexutil.Big)(r.Amount)
+	item["reward"] = (*hexutil.Big)(r.Reward)
+	item["reporter"] = r.Reporter
+	item["jailedUntil"] = r.JailedUntil
+	return item
+}
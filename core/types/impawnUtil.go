@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sync"
 
 	"git.taiyue.io/pist/go-pist/common"
 	"git.taiyue.io/pist/go-pist/common/hexutil"
@@ -23,6 +24,9 @@ var (
 	StakingAddress    = common.BytesToAddress([]byte("truestaking"))
 	MixEpochCount     = 2
 	FoundationAddress = common.HexToAddress("0xDA79B1C2645750c655D848e04c27E2cD9d263C48")
+	// GovernanceAddress is defined as Address('truegovernance')
+	// i.e. contractAddress = 0x00000000000074727565676f7665726e616e6365
+	GovernanceAddress = common.BytesToAddress([]byte("truegovernance"))
 )
 
 var (
@@ -172,10 +176,46 @@ type TimedChainReward struct {
 	Reward *ChainReward
 }
 
+// Receivers of the reward policy apart from the stakers.
+const (
+	RewardShareProposer uint8 = iota + 1
+	RewardShareCommittee
+	RewardShareTreasury
+)
+
+// RewardShare is a part of the fees and block reward paid by the reward policy.
+type RewardShare struct {
+	Kind    uint8          `json:"kind"`
+	Address common.Address `json:"address"`
+	Amount  *big.Int       `json:"amount"`
+}
+
+func (s *RewardShare) clone() *RewardShare {
+	return &RewardShare{
+		Kind:    s.Kind,
+		Address: s.Address,
+		Amount:  new(big.Int).Set(s.Amount),
+	}
+}
+
+// FetchShares returns the shares paid to addr.
+func FetchShares(shares []*RewardShare, addr common.Address) []*RewardShare {
+	items := make([]*RewardShare, 0, 0)
+	for _, v := range shares {
+		if v.Address == addr {
+			items = append(items, v)
+		}
+	}
+	return items
+}
+
 type ChainReward struct {
 	Height        uint64
 	St            uint64
 	CommitteeBase []*SARewardInfos `json:"committeeReward"`
+	Fee           *big.Int         `json:"fee"`         // fees of the block
+	BlockReward   *big.Int         `json:"blockReward"` // minted by the reward policy
+	Shares        []*RewardShare   `json:"shares"`      // paid by the reward policy apart from the stakers
 }
 
 func CloneChainReward(reward *ChainReward) *ChainReward {
@@ -184,6 +224,15 @@ func CloneChainReward(reward *ChainReward) *ChainReward {
 	for _, v := range reward.CommitteeBase {
 		res.CommitteeBase = append(res.CommitteeBase, v.clone())
 	}
+	if reward.Fee != nil {
+		res.Fee = new(big.Int).Set(reward.Fee)
+	}
+	if reward.BlockReward != nil {
+		res.BlockReward = new(big.Int).Set(reward.BlockReward)
+	}
+	for _, v := range reward.Shares {
+		res.Shares = append(res.Shares, v.clone())
+	}
 	return &res
 }
 
@@ -318,6 +367,35 @@ func toReward(val *big.Float) *big.Int {
 func ToPist(val *big.Int) *big.Float {
 	return new(big.Float).Quo(new(big.Float).SetInt(val), fbaseUnit)
 }
+
+// EpochLength is the length of the epochs from EpochID on, as approved by the
+// governance.
+type EpochLength struct {
+	EpochID uint64
+	Length  uint64
+}
+
+var (
+	epochLengths   []EpochLength // sorted by epoch id, params.NewEpochLength before the first one
+	epochLengthsMu sync.RWMutex
+)
+
+// SetEpochLengths replaces the schedule of the epoch lengths followed by the
+// node, the one of its head. The state transitions use the schedule of the
+// state they apply to, see EpochFromHeight.
+func SetEpochLengths(lengths []EpochLength) {
+	epochLengthsMu.Lock()
+	defer epochLengthsMu.Unlock()
+	epochLengths = append([]EpochLength(nil), lengths...)
+}
+
+// GetEpochLengths returns the schedule of the epoch lengths.
+func GetEpochLengths() []EpochLength {
+	epochLengthsMu.RLock()
+	defer epochLengthsMu.RUnlock()
+	return append([]EpochLength(nil), epochLengths...)
+}
+
 func GetFirstEpoch() *EpochIDInfo {
 	return &EpochIDInfo{
 		EpochID:     params.FirstNewEpochID,
@@ -333,6 +411,12 @@ func GetPreFirstEpoch() *EpochIDInfo {
 	}
 }
 func GetEpochFromHeight(hh uint64) *EpochIDInfo {
+	return EpochFromHeight(GetEpochLengths(), hh)
+}
+
+// EpochFromHeight returns the epoch of the height hh with the epoch lengths
+// schedule lengths.
+func EpochFromHeight(lengths []EpochLength, hh uint64) *EpochIDInfo {
 	if hh <= params.DposForkPoint {
 		return GetPreFirstEpoch()
 	}
@@ -340,15 +424,31 @@ func GetEpochFromHeight(hh uint64) *EpochIDInfo {
 	if hh <= first.EndHeight {
 		return first
 	}
-	var eid uint64
-	if (hh-first.EndHeight)%params.NewEpochLength == 0 {
-		eid = (hh-first.EndHeight)/params.NewEpochLength + first.EpochID
+	// walk the length changes up to the one of the epoch of hh
+	eid, end, length := first.EpochID, first.EndHeight, params.NewEpochLength
+	for _, change := range lengths {
+		if change.EpochID <= eid {
+			continue
+		}
+		begin := end + (change.EpochID-eid-1)*length + 1
+		if hh < begin {
+			break
+		}
+		eid, end, length = change.EpochID-1, begin-1, change.Length
+	}
+	if (hh-end)%length == 0 {
+		eid = (hh-end)/length + eid
 	} else {
-		eid = (hh-first.EndHeight)/params.NewEpochLength + first.EpochID + 1
+		eid = (hh-end)/length + eid + 1
 	}
-	return GetEpochFromID(eid)
+	return EpochFromID(lengths, eid)
 }
 func GetEpochFromID(eid uint64) *EpochIDInfo {
+	return EpochFromID(GetEpochLengths(), eid)
+}
+
+// EpochFromID returns the epoch eid with the epoch lengths schedule lengths.
+func EpochFromID(lengths []EpochLength, eid uint64) *EpochIDInfo {
 	preFirst := GetPreFirstEpoch()
 	if preFirst.EpochID == eid {
 		return preFirst
@@ -357,10 +457,21 @@ func GetEpochFromID(eid uint64) *EpochIDInfo {
 	if first.EpochID >= eid {
 		return first
 	}
+	id, end, length := first.EpochID, first.EndHeight, params.NewEpochLength
+	for _, change := range lengths {
+		if change.EpochID <= id {
+			continue
+		}
+		if change.EpochID > eid {
+			break
+		}
+		end += (change.EpochID - id - 1) * length
+		id, length = change.EpochID-1, change.Length
+	}
 	return &EpochIDInfo{
 		EpochID:     eid,
-		BeginHeight: first.EndHeight + (eid-first.EpochID-1)*params.NewEpochLength + 1,
-		EndHeight:   first.EndHeight + (eid-first.EpochID)*params.NewEpochLength,
+		BeginHeight: end + (eid-id-1)*length + 1,
+		EndHeight:   end + (eid-id)*length,
 	}
 }
 func GetEpochFromRange(begin, end uint64) []*EpochIDInfo {
@@ -26,6 +26,7 @@ import (
 	"git.taiyue.io/pist/go-pist/crypto"
 	"git.taiyue.io/pist/go-pist/log"
 	"git.taiyue.io/pist/go-pist/params"
+	"git.taiyue.io/pist/go-pist/rlp"
 )
 
 var (
@@ -227,7 +228,20 @@ func (s TIP1Signer) Hash(tx *Transaction) common.Hash {
 }
 
 func (s TIP1Signer) Hash_Payment(tx *Transaction) common.Hash {
-	return rlpHash([]interface{}{
+	return rlpHash(s.paymentFields(tx))
+}
+
+// PaymentRLP returns the RLP encoding of the payload signed by the payer, the
+// hash of which is Hash_Payment. It's sent to the signers that hash the payload
+// themselves, like hardware wallets.
+func (s TIP1Signer) PaymentRLP(tx *Transaction) ([]byte, error) {
+	return rlp.EncodeToBytes(s.paymentFields(tx))
+}
+
+// paymentFields returns the fields of the transaction signed by the payer, they
+// include the signature of the sender.
+func (s TIP1Signer) paymentFields(tx *Transaction) []interface{} {
+	return []interface{}{
 		tx.data.AccountNonce,
 		tx.data.Price,
 		tx.data.GasLimit,
@@ -240,7 +254,7 @@ func (s TIP1Signer) Hash_Payment(tx *Transaction) common.Hash {
 		tx.data.R,
 		tx.data.S,
 		s.chainId, uint(0), uint(0),
-	})
+	}
 }
 
 /*
@@ -102,6 +102,12 @@ var PrecompiledContractsYoloPos = map[common.Address]PrecompiledContract{
 	types.StakingAddress:              &staking{},
 }
 
+// PrecompiledContractsGovernance contains the pre-compiled contracts added to
+// the other sets from the governance fork on.
+var PrecompiledContractsGovernance = map[common.Address]PrecompiledContract{
+	types.GovernanceAddress: &governance{},
+}
+
 // RunPrecompiledContract runs and evaluates the output of a precompiled contract.
 // It returns
 // - the returned bytes,
@@ -971,7 +977,7 @@ func (c *staking) RequiredGas(evm *EVM, input []byte) uint64 {
 	if err != nil {
 		return baseGas
 	}
-	if gas, ok := StakingGas[string(method.Name)]; ok {
+	if gas, ok := stakingGas(evm.StateDB, string(method.Name), evm.BlockNumber.Uint64()); ok {
 		return gas
 	} else {
 		return baseGas
@@ -981,3 +987,22 @@ func (c *staking) RequiredGas(evm *EVM, input []byte) uint64 {
 func (c *staking) Run(evm *EVM, contract *Contract, input []byte) (ret []byte, err error) {
 	return RunStaking(evm, contract, input)
 }
+
+type governance struct{}
+
+func (c *governance) RequiredGas(evm *EVM, input []byte) uint64 {
+	var baseGas uint64 = 21000
+
+	method, err := abiGovernance.MethodById(input)
+	if err != nil {
+		return baseGas
+	}
+	if gas, ok := GovernanceGas[method.Name]; ok {
+		return gas
+	}
+	return baseGas
+}
+
+func (c *governance) Run(evm *EVM, contract *Contract, input []byte) (ret []byte, err error) {
+	return RunGovernance(evm, contract, input)
+}
@@ -0,0 +1,234 @@
+package vm
+
+import (
+	"errors"
+	"math/big"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/core/types"
+	"git.taiyue.io/pist/go-pist/crypto"
+	"git.taiyue.io/pist/go-pist/log"
+	"git.taiyue.io/pist/go-pist/params"
+)
+
+var (
+	ErrValidatorNotJailed = errors.New("validator is not jailed")
+	ErrUnjailTooEarly     = errors.New("validator jail period not over")
+)
+
+// downtimeItem counts the blocks of an epoch a validator elected for it didn't
+// sign. Every block carries the signs committing its parent, which its header
+// commits to through the committee hash, so every node records the same
+// downtime. A validator jailed for downtime has no count until it unjails.
+type downtimeItem struct {
+	Address common.Address
+	Epoch   uint64 // epoch Missed is counted in
+	Missed  uint64 // blocks of the epoch it didn't sign
+	Jailed  bool
+}
+
+// downtimeCount is the number of blocks of an epoch whose signs were recorded,
+// the blocks switching committee members carry none.
+type downtimeCount struct {
+	Epoch   uint64
+	Counted uint64
+}
+
+var downtimeCountKey = impawnEntryKey("downtime-count")
+
+func downtimeKey(addr common.Address) common.Hash {
+	return impawnEntryKey("downtime", addr[:])
+}
+
+func (d *downtimeItem) empty() bool {
+	return d.Missed == 0 && !d.Jailed
+}
+
+// getDowntime returns the downtime record of addr, read from the state the first
+// time.
+func (i *ImpawnImpl) getDowntime(addr common.Address) *downtimeItem {
+	item, ok := i.downtime[addr]
+	if !ok {
+		item = new(downtimeItem)
+		if !i.readEntry(downtimeKey(addr), item) {
+			item = &downtimeItem{Address: addr}
+		}
+		i.downtime[addr] = item
+	}
+	return item
+}
+
+// getDowntimeCount returns the number of blocks recorded in the current epoch,
+// read from the state the first time.
+func (i *ImpawnImpl) getDowntimeCount() *downtimeCount {
+	if i.downtimeCount == nil {
+		i.downtimeCount = new(downtimeCount)
+		i.readEntry(downtimeCountKey, i.downtimeCount)
+	}
+	if i.downtimeCount.Epoch != i.curEpochID {
+		*i.downtimeCount = downtimeCount{Epoch: i.curEpochID}
+	}
+	return i.downtimeCount
+}
+
+// isJailed reports whether addr is kept out of the elections of epochid, either
+// for the jail period of a slashing or until it unjails itself.
+func (i *ImpawnImpl) isJailed(addr common.Address, epochid uint64) bool {
+	if epochid <= i.getSlashState(addr).JailedUntil {
+		return true
+	}
+	return i.getDowntime(addr).Jailed
+}
+
+// jailForDowntime keeps addr out of the elections until it sends an unjail
+// transaction.
+func (i *ImpawnImpl) jailForDowntime(addr common.Address) {
+	*i.getDowntime(addr) = downtimeItem{Address: addr, Jailed: true}
+}
+
+// voteBase returns the address of the vote key of sa, which its signs are
+// recovered to.
+func voteBase(sa *StakingAccount) (common.Address, bool) {
+	pubkey, err := crypto.UnmarshalPubkey(sa.Votepubkey)
+	if err != nil {
+		return common.Address{}, false
+	}
+	return crypto.PubkeyToAddress(*pubkey), true
+}
+
+// RecordSigns counts the block at height as missed for the validators elected
+// for the current epoch whose vote key isn't among signers, the addresses of the
+// keys agreeing on the block. The blocks of another epoch than the current one
+// are ignored.
+func (i *ImpawnImpl) RecordSigns(height uint64, signers []common.Address) {
+	if i.epochFromHeight(height).EpochID != i.curEpochID {
+		return
+	}
+	signed := make(map[common.Address]bool)
+	for _, addr := range signers {
+		signed[addr] = true
+	}
+	i.getDowntimeCount().Counted++
+	for _, sa := range i.getElections3(i.curEpochID) {
+		if base, ok := voteBase(sa); ok && signed[base] {
+			continue
+		}
+		item := i.getDowntime(sa.Unit.GetRewardAddress())
+		if item.Jailed {
+			continue
+		}
+		if item.Epoch != i.curEpochID {
+			item.Epoch, item.Missed = i.curEpochID, 0
+		}
+		item.Missed++
+	}
+}
+
+// JailDowntime closes the downtime records of the current epoch, which ends at
+// height. The validators elected for it that signed less than
+// params.DowntimeMinSignedRate of the blocks recorded are slashed and jailed
+// until they unjail.
+func (i *ImpawnImpl) JailDowntime(height uint64) ([]*types.SlashRecord, map[common.Address]*big.Int) {
+	var (
+		records []*types.SlashRecord
+		amounts = make(map[common.Address]*big.Int)
+		epoch   = i.epochFromID(i.curEpochID)
+		count   = i.getDowntimeCount()
+		counted = count.Counted
+	)
+	count.Counted = 0
+	for _, sa := range i.getElections3(i.curEpochID) {
+		addr := sa.Unit.GetRewardAddress()
+		item := i.getDowntime(addr)
+		if item.Jailed || item.Missed == 0 {
+			continue
+		}
+		missed := item.Missed
+		if item.Epoch != i.curEpochID {
+			missed = 0
+		}
+		*item = downtimeItem{Address: addr}
+		if missed > counted {
+			missed = counted
+		}
+		if (counted-missed)*types.Base.Uint64() >= params.DowntimeMinSignedRate*counted || i.isJailed(addr, i.curEpochID) {
+			continue
+		}
+		record, cut := i.slash(height, epoch.BeginHeight, sa, types.EvidenceDowntime, params.SlashDowntimeRate, params.JailDowntimeEpochs)
+		record.EvidenceHash = types.RlpHash([]interface{}{addr, i.curEpochID})
+		record.EvidenceHeight = epoch.BeginHeight
+		log.Info("Jail validator for downtime", "address", addr, "epoch", i.curEpochID, "missed", missed, "blocks", counted)
+		i.jailForDowntime(addr)
+
+		records = append(records, record)
+		for k, v := range cut {
+			if amounts[k] == nil {
+				amounts[k] = new(big.Int)
+			}
+			amounts[k].Add(amounts[k], v)
+		}
+	}
+	return records, amounts
+}
+
+// Unjail lets the validator addr, jailed for downtime, take part in the
+// elections again once its jail period is over.
+func (i *ImpawnImpl) Unjail(addr common.Address) error {
+	item := i.getDowntime(addr)
+	if !item.Jailed {
+		if i.isJailed(addr, i.curEpochID) {
+			return ErrUnjailTooEarly
+		}
+		return ErrValidatorNotJailed
+	}
+	if i.curEpochID <= i.getSlashState(addr).JailedUntil {
+		return ErrUnjailTooEarly
+	}
+	item.Jailed = false
+	log.Info("Unjail validator", "address", addr, "epoch", i.curEpochID)
+	return nil
+}
+
+// IsJailed reports whether addr is kept out of the next elections.
+func (i *ImpawnImpl) IsJailed(addr common.Address) bool {
+	return i.isJailed(addr, i.curEpochID+1)
+}
+
+// NeedsUnjail reports whether addr was jailed for downtime and has to send an
+// unjail transaction to be elected again.
+func (i *ImpawnImpl) NeedsUnjail(addr common.Address) bool {
+	return i.getDowntime(addr).Jailed
+}
+
+// GetDowntime returns the blocks of the current epoch addr didn't sign and the
+// blocks of the epoch recorded so far.
+func (i *ImpawnImpl) GetDowntime(addr common.Address) (missed, counted uint64) {
+	if item := i.getDowntime(addr); item.Epoch == i.curEpochID {
+		missed = item.Missed
+	}
+	return missed, i.getDowntimeCount().Counted
+}
+
+// SaveDowntime stores the downtime records read alone, it is called for every
+// block recording the signs of its parent while the rest of the staking state
+// is left untouched.
+func (i *ImpawnImpl) SaveDowntime(state StateDB, preAddress common.Address) {
+	if count := i.downtimeCount; count != nil {
+		if count.Counted == 0 {
+			writeEntry(state, preAddress, downtimeCountKey, nil)
+		} else {
+			writeEntry(state, preAddress, downtimeCountKey, count)
+		}
+	}
+	var addrs []common.Address
+	for k := range i.downtime {
+		addrs = append(addrs, k)
+	}
+	for _, addr := range sortAddresses(addrs) {
+		if v := i.downtime[addr]; v.empty() {
+			writeEntry(state, preAddress, downtimeKey(addr), nil)
+		} else {
+			writeEntry(state, preAddress, downtimeKey(addr), v)
+		}
+	}
+}
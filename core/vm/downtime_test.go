@@ -0,0 +1,101 @@
+package vm
+
+import (
+	"math/big"
+	"testing"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/core/state"
+	"git.taiyue.io/pist/go-pist/core/types"
+	"git.taiyue.io/pist/go-pist/params"
+	"git.taiyue.io/pist/go-pist/pistdb"
+)
+
+func TestDowntimeJailing(t *testing.T) {
+	impl := NewImpawnImpl()
+	addrs := insertValidators(t, impl, 20000, 20000)
+	amount := new(big.Int).Mul(big.NewInt(20000), big.NewInt(1e18))
+	if _, err := impl.DoElections(1, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := impl.Shift(1); err != nil {
+		t.Fatal(err)
+	}
+
+	// The second validator signs two of the six blocks recorded, the first one
+	// misses the last one
+	epoch := types.GetEpochFromID(1)
+	impl.RecordSigns(epoch.BeginHeight-1, nil)
+	if missed, counted := impl.GetDowntime(addrs[0]); missed != 0 || counted != 0 {
+		t.Fatalf("signs of another epoch recorded: %d/%d", missed, counted)
+	}
+	for n := uint64(0); n < 6; n++ {
+		switch {
+		case n < 2:
+			impl.RecordSigns(epoch.BeginHeight+n, addrs)
+		case n == 5:
+			impl.RecordSigns(epoch.BeginHeight+n, nil)
+		default:
+			impl.RecordSigns(epoch.BeginHeight+n, addrs[:1])
+		}
+	}
+	if missed, counted := impl.GetDowntime(addrs[1]); missed != 4 || counted != 6 {
+		t.Fatalf("downtime mismatch: have %d/%d, want 4/6", missed, counted)
+	}
+
+	records, amounts := impl.JailDowntime(epoch.EndHeight)
+	if len(records) != 1 || records[0].Address != addrs[1] || records[0].Kind != types.EvidenceDowntime {
+		t.Fatalf("downtime records mismatch: %v", records)
+	}
+	want := new(big.Int).Quo(new(big.Int).Mul(amount, new(big.Int).SetUint64(params.SlashDowntimeRate)), types.Base)
+	if amounts[addrs[1]].Cmp(want) != 0 || len(amounts) != 1 {
+		t.Fatalf("slashed amounts mismatch: have %v, want %v", amounts, want)
+	}
+	if impl.IsJailed(addrs[0]) || !impl.IsJailed(addrs[1]) || !impl.NeedsUnjail(addrs[1]) {
+		t.Fatal("jail status mismatch")
+	}
+	// The records are cleared for the next blocks, a jailed validator isn't counted
+	for _, addr := range addrs {
+		if missed, counted := impl.GetDowntime(addr); missed != 0 || counted != 0 {
+			t.Fatalf("downtime of %x not cleared: %d/%d", addr, missed, counted)
+		}
+	}
+	impl.RecordSigns(epoch.EndHeight, nil)
+
+	// The jail and the records survive a reload
+	db := pistdb.NewMemDatabase()
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(db))
+	impl.Save(statedb, types.StakingAddress)
+	impl = NewImpawnImpl()
+	if err := impl.Load(statedb, types.StakingAddress); err != nil {
+		t.Fatal(err)
+	}
+	if !impl.NeedsUnjail(addrs[1]) {
+		t.Fatal("downtime jail lost on reload")
+	}
+	if missed, counted := impl.GetDowntime(addrs[0]); missed != 1 || counted != 1 {
+		t.Fatalf("reloaded downtime mismatch: have %d/%d, want 1/1", missed, counted)
+	}
+	if missed, _ := impl.GetDowntime(addrs[1]); missed != 0 {
+		t.Fatalf("jailed validator counted: %d", missed)
+	}
+
+	// The validator is kept out after its jail period until it unjails
+	if err := impl.Unjail(addrs[0]); err != ErrValidatorNotJailed {
+		t.Fatalf("expected not jailed error, got %v", err)
+	}
+	if err := impl.Unjail(addrs[1]); err != ErrUnjailTooEarly {
+		t.Fatalf("expected too early error, got %v", err)
+	}
+	impl.SetCurrentEpoch(2 + params.JailDowntimeEpochs)
+	impl.shiftSlashing(impl.curEpochID, impl.GetStakingAccountsByEpoch(1))
+	if !impl.IsJailed(addrs[1]) {
+		t.Fatal("validator released without unjailing")
+	}
+	if err := impl.Unjail(addrs[1]); err != nil {
+		t.Fatalf("failed to unjail: %v", err)
+	}
+	if impl.IsJailed(addrs[1]) || impl.NeedsUnjail(addrs[1]) {
+		t.Fatal("validator still jailed after unjailing")
+	}
+}
@@ -0,0 +1,90 @@
+package vm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math/big"
+	"sort"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/core/types"
+	"git.taiyue.io/pist/go-pist/crypto"
+)
+
+// electionSeedKey is the key of the seed of the last random election in the
+// POS storage of the staking address.
+var electionSeedKey = common.BytesToHash([]byte("electionSeed"))
+
+// ElectionSeed derives the seed of the election of epochid from the seed of
+// the previous election and the committee signs of the parent of the electing
+// block, which the block carries and commits to, so any node recomputes it from
+// the chain. Only the agreeing signatures are mixed in, in the order of their
+// bytes. The signatures are deterministic (RFC 6979), but the seed isn't
+// unbiasable: a member can withhold its vote and the proposer of the electing
+// block picks which subset of more than 2/3 of the signs it includes, trying
+// the subsets and keeping the one electing the committee it prefers. A block
+// carrying no signs leaves the seed to the previous one and epochid.
+func ElectionSeed(prev common.Hash, epochid uint64, signs []*types.PbftSign) common.Hash {
+	var sigs [][]byte
+	for _, sign := range signs {
+		if sign.Result == types.VoteAgree && len(sign.Sign) > 0 {
+			sigs = append(sigs, sign.Sign)
+		}
+	}
+	sort.Slice(sigs, func(m, n int) bool {
+		return bytes.Compare(sigs[m], sigs[n]) < 0
+	})
+	var id [8]byte
+	binary.BigEndian.PutUint64(id[:], epochid)
+	return crypto.Keccak256Hash(append([][]byte{prev[:], id[:]}, sigs...)...)
+}
+
+// GetElectionSeed returns the seed of the last random election.
+func GetElectionSeed(state StateDB) common.Hash {
+	return common.BytesToHash(state.GetPOSState(types.StakingAddress, electionSeedKey))
+}
+
+// SetElectionSeed stores the seed of a random election.
+func SetElectionSeed(state StateDB, seed common.Hash) {
+	state.SetPOSState(types.StakingAddress, electionSeedKey, seed[:])
+}
+
+// sampleByStake draws count accounts out of sas without replacement, each draw
+// picking an account with a probability proportional to its valid stake at
+// height. The draws are taken from seed, so every node elects the same ones.
+func sampleByStake(sas []*StakingAccount, height uint64, count int, seed common.Hash) []*StakingAccount {
+	candidates := make([]*StakingAccount, len(sas))
+	copy(candidates, sas)
+	sort.Slice(candidates, func(m, n int) bool {
+		return bytes.Compare(candidates[m].Unit.Address[:], candidates[n].Unit.Address[:]) < 0
+	})
+	var (
+		stakes = make([]*big.Int, len(candidates))
+		total  = new(big.Int)
+	)
+	for k, sa := range candidates {
+		stakes[k] = sa.getValidStaking(height)
+		total.Add(total, stakes[k])
+	}
+	var (
+		chosen []*StakingAccount
+		round  [8]byte
+	)
+	for n := uint64(0); len(chosen) < count && total.Sign() > 0; n++ {
+		binary.BigEndian.PutUint64(round[:], n)
+		draw := new(big.Int).SetBytes(crypto.Keccak256(seed[:], round[:]))
+		draw.Mod(draw, total)
+		for k := range candidates {
+			if draw.Cmp(stakes[k]) >= 0 {
+				draw.Sub(draw, stakes[k])
+				continue
+			}
+			chosen = append(chosen, candidates[k])
+			total.Sub(total, stakes[k])
+			candidates = append(candidates[:k], candidates[k+1:]...)
+			stakes = append(stakes[:k], stakes[k+1:]...)
+			break
+		}
+	}
+	return chosen
+}
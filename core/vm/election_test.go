@@ -0,0 +1,72 @@
+package vm
+
+import (
+	"math/big"
+	"testing"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/core/types"
+	"git.taiyue.io/pist/go-pist/crypto"
+	"git.taiyue.io/pist/go-pist/params"
+)
+
+func TestRandomElections(t *testing.T) {
+	defer func(count int) { params.CountInEpoch = count }(params.CountInEpoch)
+	params.CountInEpoch = 2
+
+	impl := NewImpawnImpl()
+	// the last validator holds 9/12 of the stake
+	addrs := insertValidators(t, impl, 10000, 10000, 10000, 90000)
+	seed := ElectionSeed(common.Hash{}, 1, nil)
+	committee, err := impl.DoRandomElections(1, 0, seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(committee) != 2 || committee[0] == committee[1] {
+		t.Fatalf("committee mismatch: %v", committee)
+	}
+	// the same seed elects the same committee
+	again := sampleByStake(impl.accounts[0], 0, 2, seed)
+	for k := range committee {
+		if again[k].Unit.Address != committee[k].Unit.Address {
+			t.Fatalf("election %d mismatch: have %x, want %x", k, again[k].Unit.Address, committee[k].Unit.Address)
+		}
+	}
+	// the draws follow the stake
+	hits := 0
+	for n := uint64(0); n < 1000; n++ {
+		if sampleByStake(impl.accounts[0], 0, 1, ElectionSeed(seed, n, nil))[0].Unit.Address == addrs[3] {
+			hits++
+		}
+	}
+	if hits < 650 || hits > 850 {
+		t.Fatalf("stake weighting mismatch: largest validator drawn %d times out of 1000", hits)
+	}
+	if all := sampleByStake(impl.accounts[0], 0, 10, seed); len(all) != 4 {
+		t.Fatalf("expected every candidate elected, have %d", len(all))
+	}
+}
+
+func TestElectionSeed(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	signs := []*types.PbftSign{
+		{FastHeight: big.NewInt(1), FastHash: common.Hash{1}, Result: types.VoteAgree},
+		{FastHeight: big.NewInt(1), FastHash: common.Hash{2}, Result: types.VoteAgree},
+		{FastHeight: big.NewInt(1), FastHash: common.Hash{3}, Result: types.VoteAgreeAgainst},
+	}
+	for _, sign := range signs {
+		sign.Sign, _ = crypto.Sign(sign.HashWithNoSign().Bytes(), key)
+	}
+	seed := ElectionSeed(common.Hash{1}, 2, signs)
+	// the order of the signs doesn't matter, the rejections are left out
+	if have := ElectionSeed(common.Hash{1}, 2, []*types.PbftSign{signs[1], signs[0]}); have != seed {
+		t.Fatalf("seed depends on the sign order: have %x, want %x", have, seed)
+	}
+	if ElectionSeed(common.Hash{2}, 2, signs) == seed || ElectionSeed(common.Hash{1}, 3, signs) == seed {
+		t.Fatal("seed doesn't depend on the previous seed and the epoch")
+	}
+	// the included subset of the signs changes the seed
+	if ElectionSeed(common.Hash{1}, 2, signs[:1]) == seed {
+		t.Fatal("seed doesn't depend on the signs")
+	}
+}
@@ -44,10 +44,28 @@ type (
 
 func (evm *EVM) precompile(addr common.Address) (PrecompiledContract, bool) {
 	var precompiles map[common.Address]PrecompiledContract
-	precompiles = PrecompiledContractsYoloPos
+	switch {
+	case evm.chainRules.IsYoloV1:
+		precompiles = PrecompiledContractsYoloPos
+	default:
+		precompiles = PrecompiledContractsPoS
+	}
+
+	if p, ok := precompiles[addr]; ok {
+		return p, true
+	}
+	if evm.chainRules.IsGovernance {
+		p, ok := PrecompiledContractsGovernance[addr]
+		return p, ok
+	}
+	return nil, false
+}
 
-	p, ok := precompiles[addr]
-	return p, ok
+// IsPrecompile reports whether addr is a precompiled contract under the rules
+// of the current block.
+func (evm *EVM) IsPrecompile(addr common.Address) bool {
+	_, ok := evm.precompile(addr)
+	return ok
 }
 
 // run runs the given contract and takes care of running precompiles with a fallback to the byte code interpreter.
@@ -136,7 +154,7 @@ func NewEVM(ctx Context, statedb StateDB, chainConfig *params.ChainConfig, vmCon
 		StateDB:      statedb,
 		vmConfig:     vmConfig,
 		chainConfig:  chainConfig,
-		chainRules:   chainConfig.Rules(),
+		chainRules:   chainConfig.Rules(ctx.BlockNumber),
 		interpreters: make([]Interpreter, 0, 1),
 	}
 
@@ -6,7 +6,6 @@ import (
 	"git.taiyue.io/pist/go-pist/common"
 	"git.taiyue.io/pist/go-pist/common/hexutil"
 	"git.taiyue.io/pist/go-pist/core/types"
-	"git.taiyue.io/pist/go-pist/params"
 	"git.taiyue.io/pist/go-pist/rlp"
 	"io"
 	"math/big"
@@ -65,19 +64,20 @@ func (i *ImpawnImpl) GetAllStakingAccountRPC(height uint64) map[string]interface
 	var attrs []map[string]interface{}
 	count := 0
 	countCommittee := 0
+	inEpoch := int(i.param("CountInEpoch").Int64())
 	for index, sa := range sas {
 		attr := make(map[string]interface{})
 		attr["id"] = index
 		attr["unit"] = unitDisplay(sa.Unit)
 		attr["votePubKey"] = hexutil.Bytes(sa.Votepubkey)
 		attr["fee"] = sa.Fee.Uint64()
-		if countCommittee <= params.CountInEpoch && isCommitteeMember(i, sa.Unit.Address) {
+		if countCommittee <= inEpoch && isCommitteeMember(i, sa.Unit.Address) {
 			attr["committee"] = true
 			countCommittee++
 		} else {
 			attr["committee"] = false
 		}
-		attr["delegation"] = daSDisplay(sa.Delegation, height)
+		attr["delegation"] = i.daSDisplay(sa.Delegation, height)
 		if sa.Modify != nil {
 			ai := make(map[string]interface{})
 			if sa.Modify.Fee != nil {
@@ -90,6 +90,9 @@ func (i *ImpawnImpl) GetAllStakingAccountRPC(height uint64) map[string]interface
 		}
 		attr["staking"] = weiToTrue(sa.getAllStaking(height))
 		attr["validStaking"] = weiToTrue(sa.getValidStaking(height))
+		attr["jailedUntil"] = i.GetJailedUntil(sa.Unit.Address)
+		attr["jailed"] = i.IsJailed(sa.Unit.Address)
+		i.infoDisplay(attr, sa.Unit.Address)
 		attrs = append(attrs, attr)
 		count = count + len(sa.Delegation)
 	}
@@ -333,6 +336,36 @@ func (c *CancelableAsset) UnmarshalJSON(input []byte) error {
 	return nil
 }
 
+// GetStakingAccountsByEpoch returns the staking accounts of epochid, nil if the
+// state no longer or not yet holds them.
+func (i *ImpawnImpl) GetStakingAccountsByEpoch(epochid uint64) SAImpawns {
+	accounts, _ := i.epochAccounts(epochid)
+	return accounts
+}
+
+// GetAllStaking returns the amount staked on the validator, by itself and its
+// delegations, up to height hh.
+func (s *StakingAccount) GetAllStaking(hh uint64) *big.Int {
+	return s.getAllStaking(hh)
+}
+
+// GetValidStaking returns the amount staked on the validator counted in the
+// elections at height hh.
+func (s *StakingAccount) GetValidStaking(hh uint64) *big.Int {
+	return s.getValidStaking(hh)
+}
+
+// GetAllStaking returns the amount delegated up to height hh.
+func (d *DelegationAccount) GetAllStaking(hh uint64) *big.Int {
+	return d.getAllStaking(hh)
+}
+
+// GetValidStaking returns the amount of the delegation counted in the elections
+// at height hh.
+func (d *DelegationAccount) GetValidStaking(hh uint64) *big.Int {
+	return d.getValidStaking(hh)
+}
+
 func (i *ImpawnImpl) GetStakingAccountRPC(height uint64, address common.Address) map[string]interface{} {
 	sas := i.GetAllStakingAccount()
 	sa := sas.getSA(address)
@@ -344,7 +377,7 @@ func (i *ImpawnImpl) GetStakingAccountRPC(height uint64, address common.Address)
 	attr["votePubKey"] = hexutil.Bytes(sa.Votepubkey)
 	attr["fee"] = sa.Fee.Uint64()
 	attr["committee"] = isCommitteeMember(i, sa.Unit.Address)
-	attr["delegation"] = daSDisplay(sa.Delegation, height)
+	attr["delegation"] = i.daSDisplay(sa.Delegation, height)
 	if sa.Modify != nil {
 		ai := make(map[string]interface{})
 		if sa.Modify.Fee != nil {
@@ -357,9 +390,39 @@ func (i *ImpawnImpl) GetStakingAccountRPC(height uint64, address common.Address)
 	}
 	attr["staking"] = weiToTrue(sa.getAllStaking(height))
 	attr["validStaking"] = weiToTrue(sa.getValidStaking(height))
+	attr["jailedUntil"] = i.GetJailedUntil(sa.Unit.Address)
+	attr["jailed"] = i.IsJailed(sa.Unit.Address)
+	attr["needUnjail"] = i.NeedsUnjail(sa.Unit.Address)
+	missed, counted := i.GetDowntime(sa.Unit.Address)
+	attr["missedBlocks"], attr["countedBlocks"] = missed, counted
+	i.infoDisplay(attr, sa.Unit.Address)
 	return attr
 }
 
+// GetSlashRecordsRPC returns the slashing of the validator addr, or of all the
+// staking accounts of the current epoch if addr is nil.
+func (i *ImpawnImpl) GetSlashRecordsRPC(addr *common.Address) []map[string]interface{} {
+	var addrs []common.Address
+	if addr != nil {
+		addrs = append(addrs, *addr)
+	} else {
+		seen := make(map[common.Address]bool)
+		for _, sa := range i.GetAllStakingAccount() {
+			if a := sa.Unit.GetRewardAddress(); !seen[a] {
+				seen[a] = true
+				addrs = append(addrs, a)
+			}
+		}
+	}
+	attrs := make([]map[string]interface{}, 0)
+	for _, a := range addrs {
+		for _, v := range i.GetSlashRecords(a) {
+			attrs = append(attrs, v.ToMap())
+		}
+	}
+	return attrs
+}
+
 func isCommitteeMember(i *ImpawnImpl, address common.Address) bool {
 	sas := i.getElections3(i.curEpochID)
 	if sas == nil {
@@ -373,7 +436,7 @@ func isCommitteeMember(i *ImpawnImpl, address common.Address) bool {
 	return true
 }
 
-func daSDisplay(das []*DelegationAccount, height uint64) []map[string]interface{} {
+func (i *ImpawnImpl) daSDisplay(das []*DelegationAccount, height uint64) []map[string]interface{} {
 	var attrs []map[string]interface{}
 	for _, da := range das {
 		attr := make(map[string]interface{})
@@ -381,6 +444,9 @@ func daSDisplay(das []*DelegationAccount, height uint64) []map[string]interface{
 		attr["delegate"] = weiToTrue(da.getAllStaking(height))
 		attr["validDelegate"] = weiToTrue(da.getValidStaking(height))
 		attr["unit"] = unitDisplay(da.Unit)
+		attr["rewardMode"] = i.GetRewardMode(da.SaAddress, da.Unit.Address)
+		attr["pendingReward"] = weiToTrue(i.GetPendingReward(da.SaAddress, da.Unit.Address))
+		attr["redelegations"] = i.redelegationDisplay(da.SaAddress, da.Unit.Address)
 		attrs = append(attrs, attr)
 	}
 	return attrs
@@ -0,0 +1,682 @@
+package vm
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"git.taiyue.io/pist/go-pist/accounts/abi"
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/common/hexutil"
+	"git.taiyue.io/pist/go-pist/core/types"
+	"git.taiyue.io/pist/go-pist/log"
+	"git.taiyue.io/pist/go-pist/params"
+	"git.taiyue.io/pist/go-pist/rlp"
+)
+
+// governanceKey is the key of the governance state in the POS storage of the
+// governance address.
+var governanceKey = common.BytesToHash([]byte("governance"))
+
+var (
+	ErrUnknownParam     = errors.New("unknown governance parameter")
+	ErrParamOutOfRange  = errors.New("governance parameter out of range")
+	ErrNotValidator     = errors.New("not a validator of the current epoch")
+	ErrTooManyProposals = errors.New("too many proposals open for votes")
+	ErrProposalNotFound = errors.New("proposal not found")
+	ErrProposalClosed   = errors.New("proposal closed")
+)
+
+// GovernanceGas defines all method gas
+var GovernanceGas = map[string]uint64{
+	"propose": 2400000,
+	"vote":    1500000,
+}
+
+// Status of a proposal
+const (
+	ProposalVoting uint8 = iota
+	ProposalApproved
+	ProposalRejected
+)
+
+// Governance contract ABI
+var abiGovernance abi.ABI
+
+func init() {
+	abiGovernance, _ = abi.JSON(strings.NewReader(GovernanceABIJSON))
+	for name := range StakingGas {
+		governables["StakingGas."+name] = &governable{
+			min: big.NewInt(21000),
+			max: big.NewInt(10000000),
+			get: func(name string) func(params.Governed) *big.Int {
+				return func(params.Governed) *big.Int { return new(big.Int).SetUint64(StakingGas[name]) }
+			}(name),
+		}
+	}
+}
+
+// governable is a parameter the validators can change and the range of its values.
+// The state transitions read the values from the governance state, the setter
+// updates the value the node follows its head with, see params.Governed. The
+// parameters without a setter have no such value, the StakingGas ones are only
+// read from the state and NewEpochLength is kept in the epoch length schedule.
+type governable struct {
+	min, max *big.Int
+	get      func(params.Governed) *big.Int
+	set      func(*params.Governed, *big.Int)
+}
+
+var governables = map[string]*governable{
+	"CountInEpoch": {
+		min: big.NewInt(20),
+		max: big.NewInt(100),
+		get: func(g params.Governed) *big.Int { return big.NewInt(int64(g.CountInEpoch)) },
+		set: func(g *params.Governed, v *big.Int) { g.CountInEpoch = int(v.Int64()) },
+	},
+	"ElectionMinLimitForStaking": {
+		min: new(big.Int).Mul(big.NewInt(1), big.NewInt(1e18)),
+		max: new(big.Int).Mul(big.NewInt(10000000), big.NewInt(1e18)),
+		get: func(g params.Governed) *big.Int { return new(big.Int).Set(g.ElectionMinLimitForStaking) },
+		set: func(g *params.Governed, v *big.Int) { g.ElectionMinLimitForStaking = new(big.Int).Set(v) },
+	},
+	"NewEpochLength": {
+		min: big.NewInt(10000),
+		max: big.NewInt(1000000),
+		get: func(params.Governed) *big.Int { return new(big.Int).SetUint64(params.NewEpochLength) },
+	},
+	"ElectionPoint": {
+		min: big.NewInt(50),
+		max: big.NewInt(5000),
+		get: func(g params.Governed) *big.Int { return new(big.Int).SetUint64(g.ElectionPoint) },
+		set: func(g *params.Governed, v *big.Int) { g.ElectionPoint = v.Uint64() },
+	},
+	"MinimumCommitteeNumber": {
+		min: big.NewInt(4),
+		max: big.NewInt(20),
+		get: func(g params.Governed) *big.Int { return big.NewInt(int64(g.MinimumCommitteeNumber)) },
+		set: func(g *params.Governed, v *big.Int) { g.MinimumCommitteeNumber = int(v.Int64()) },
+	},
+}
+
+// defaultParam returns the value of the parameter name before any governance
+// change, its compile-time value.
+func defaultParam(name string) *big.Int {
+	return governables[name].get(params.DefaultGoverned())
+}
+
+// GovernanceVote is the vote of a validator on a proposal.
+type GovernanceVote struct {
+	Voter   common.Address
+	Approve bool
+}
+
+// Proposal is a parameter change submitted by a validator.
+type Proposal struct {
+	ID       uint64
+	Proposer common.Address
+	Name     string
+	Value    *big.Int
+	Epoch    uint64 // epoch the proposal was submitted in
+	Status   uint8
+	Closed   uint64            // epoch the proposal was approved or rejected at the end of
+	Votes    []*GovernanceVote // sorted by voter
+}
+
+// ToMap returns the proposal for the RPC.
+func (p *Proposal) ToMap() map[string]interface{} {
+	attr := make(map[string]interface{})
+	attr["id"] = p.ID
+	attr["proposer"] = p.Proposer
+	attr["name"] = p.Name
+	attr["value"] = (*hexutil.Big)(p.Value)
+	attr["epoch"] = p.Epoch
+	switch p.Status {
+	case ProposalApproved:
+		attr["status"] = "approved"
+		attr["closed"] = p.Closed
+	case ProposalRejected:
+		attr["status"] = "rejected"
+		attr["closed"] = p.Closed
+	default:
+		attr["status"] = "voting"
+	}
+	votes := make([]map[string]interface{}, 0, len(p.Votes))
+	for _, v := range p.Votes {
+		votes = append(votes, map[string]interface{}{"voter": v.Voter, "approve": v.Approve})
+	}
+	attr["votes"] = votes
+	return attr
+}
+
+// GovernanceParam is the value of a parameter approved by the validators, it
+// is active from the epoch Epoch on.
+type GovernanceParam struct {
+	Name  string
+	Value *big.Int
+	Epoch uint64
+}
+
+// Governance is the state of the governance contract, the proposals and the
+// parameter values approved so far.
+type Governance struct {
+	NextID    uint64
+	Proposals []*Proposal        // sorted by id
+	Params    []*GovernanceParam // sorted by name and epoch
+	Lengths   []types.EpochLength
+}
+
+// LoadGovernance reads the governance state, which is empty until the first proposal.
+func LoadGovernance(state StateDB) (*Governance, error) {
+	g := &Governance{NextID: 1}
+	data := state.GetPOSState(types.GovernanceAddress, governanceKey)
+	if len(data) == 0 {
+		return g, nil
+	}
+	if err := rlp.DecodeBytes(data, g); err != nil {
+		log.Error("Invalid governance state RLP", "err", err)
+		return nil, errors.New(fmt.Sprintf("Invalid governance state RLP %s", err.Error()))
+	}
+	return g, nil
+}
+
+// Save stores the governance state.
+func (g *Governance) Save(state StateDB) {
+	data, err := rlp.EncodeToBytes(g)
+	if err != nil {
+		log.Crit("Failed to RLP encode governance state", "err", err)
+	}
+	state.SetPOSState(types.GovernanceAddress, governanceKey, data)
+}
+
+func (g *Governance) getProposal(id uint64) *Proposal {
+	for _, p := range g.Proposals {
+		if p.ID == id {
+			return p
+		}
+	}
+	return nil
+}
+
+// ParamAt returns the value of the parameter name active in the epoch epochid.
+func (g *Governance) ParamAt(name string, epochid uint64) (*big.Int, error) {
+	if _, ok := governables[name]; !ok {
+		return nil, ErrUnknownParam
+	}
+	var value *big.Int
+	for _, v := range g.Params {
+		if v.Name == name && v.Epoch <= epochid {
+			value = v.Value
+		}
+	}
+	if value == nil {
+		value = defaultParam(name)
+	}
+	return new(big.Int).Set(value), nil
+}
+
+// EpochFromHeight returns the epoch of the height hh with the epoch lengths
+// approved by the governance.
+func (g *Governance) EpochFromHeight(hh uint64) *types.EpochIDInfo {
+	return types.EpochFromHeight(g.Lengths, hh)
+}
+
+// EpochFromID returns the epoch eid with the epoch lengths approved by the
+// governance.
+func (g *Governance) EpochFromID(eid uint64) *types.EpochIDInfo {
+	return types.EpochFromID(g.Lengths, eid)
+}
+
+// setParam approves value for the parameter name from the epoch after epochid.
+// The value active in epochid is kept for the state of its last block, the
+// older ones are dropped.
+func (g *Governance) setParam(name string, value *big.Int, epochid uint64) {
+	var (
+		kept    []*GovernanceParam
+		current *GovernanceParam
+	)
+	for _, v := range g.Params {
+		if v.Name != name {
+			kept = append(kept, v)
+		} else if v.Epoch <= epochid {
+			current = v
+		}
+	}
+	if current != nil {
+		kept = append(kept, current)
+	}
+	kept = append(kept, &GovernanceParam{Name: name, Value: new(big.Int).Set(value), Epoch: epochid + 1})
+	sort.SliceStable(kept, func(a, b int) bool {
+		if kept[a].Name != kept[b].Name {
+			return kept[a].Name < kept[b].Name
+		}
+		return kept[a].Epoch < kept[b].Epoch
+	})
+	g.Params = kept
+	if name != "NewEpochLength" {
+		return
+	}
+	// The committee of the next epoch is prepared with its heights at its
+	// election, a new length applies to the epoch after it.
+	length := types.EpochLength{EpochID: epochid + 2, Length: value.Uint64()}
+	if n := len(g.Lengths); n > 0 && g.Lengths[n-1].EpochID == length.EpochID {
+		g.Lengths[n-1] = length
+	} else {
+		g.Lengths = append(g.Lengths, length)
+	}
+}
+
+// Propose opens a proposal to change the parameter name to value, submitted
+// by a validator of the current epoch.
+func (g *Governance) Propose(impawn *ImpawnImpl, proposer common.Address, name string, value *big.Int) (*Proposal, error) {
+	param, ok := governables[name]
+	if !ok {
+		return nil, ErrUnknownParam
+	}
+	if value == nil || value.Cmp(param.min) < 0 || value.Cmp(param.max) > 0 {
+		return nil, ErrParamOutOfRange
+	}
+	if !isCommitteeMember(impawn, proposer) {
+		return nil, ErrNotValidator
+	}
+	open := 0
+	for _, p := range g.Proposals {
+		if p.Status == ProposalVoting {
+			open++
+		}
+	}
+	if open >= params.GovernanceMaxProposals {
+		return nil, ErrTooManyProposals
+	}
+	p := &Proposal{
+		ID:       g.NextID,
+		Proposer: proposer,
+		Name:     name,
+		Value:    new(big.Int).Set(value),
+		Epoch:    impawn.getCurrentEpoch(),
+		Status:   ProposalVoting,
+	}
+	g.NextID++
+	g.Proposals = append(g.Proposals, p)
+	return p, nil
+}
+
+// Vote records the vote of a validator of the current epoch on the proposal
+// id, a new vote replaces the previous one of the validator.
+func (g *Governance) Vote(impawn *ImpawnImpl, voter common.Address, id uint64, approve bool) error {
+	p := g.getProposal(id)
+	if p == nil {
+		return ErrProposalNotFound
+	}
+	if p.Status != ProposalVoting {
+		return ErrProposalClosed
+	}
+	if !isCommitteeMember(impawn, voter) {
+		return ErrNotValidator
+	}
+	pos := sort.Search(len(p.Votes), func(n int) bool {
+		return bytes.Compare(p.Votes[n].Voter[:], voter[:]) >= 0
+	})
+	if pos < len(p.Votes) && p.Votes[pos].Voter == voter {
+		p.Votes[pos].Approve = approve
+		return nil
+	}
+	p.Votes = append(p.Votes, nil)
+	copy(p.Votes[pos+1:], p.Votes[pos:])
+	p.Votes[pos] = &GovernanceVote{Voter: voter, Approve: approve}
+	return nil
+}
+
+// Tally closes the proposals at the end of the current epoch of impawn. The
+// votes are weighted by the valid stake, delegations included, of the validators
+// of the epoch. A proposal approved by params.GovernanceApproveRate of the stake
+// takes effect from the next epoch, it is rejected once it can't reach it or
+// after params.GovernanceVotingEpochs epochs.
+func (g *Governance) Tally(impawn *ImpawnImpl, height uint64) []*Proposal {
+	epochid := impawn.getCurrentEpoch()
+	stakes := make(map[common.Address]*big.Int)
+	total := new(big.Int)
+	for _, sa := range impawn.getElections3(epochid) {
+		stake := sa.getValidStaking(height)
+		stakes[sa.Unit.GetRewardAddress()] = stake
+		total.Add(total, stake)
+	}
+	var (
+		rate    = new(big.Int).SetUint64(params.GovernanceApproveRate)
+		reverse = new(big.Int).Sub(types.Base, rate)
+		closed  []*Proposal
+	)
+	for _, p := range g.Proposals {
+		if p.Status != ProposalVoting {
+			continue
+		}
+		yes, no := new(big.Int), new(big.Int)
+		for _, v := range p.Votes {
+			if stake, ok := stakes[v.Voter]; ok {
+				if v.Approve {
+					yes.Add(yes, stake)
+				} else {
+					no.Add(no, stake)
+				}
+			}
+		}
+		need := new(big.Int).Mul(rate, total)
+		switch {
+		case total.Sign() > 0 && new(big.Int).Mul(yes, types.Base).Cmp(need) >= 0:
+			p.Status = ProposalApproved
+			g.setParam(p.Name, p.Value, epochid)
+		case total.Sign() > 0 && new(big.Int).Mul(no, types.Base).Cmp(new(big.Int).Mul(reverse, total)) > 0,
+			epochid+1 >= p.Epoch+params.GovernanceVotingEpochs:
+			p.Status = ProposalRejected
+		default:
+			continue
+		}
+		p.Closed = epochid
+		closed = append(closed, p)
+		log.Info("Close governance proposal", "id", p.ID, "name", p.Name, "value", p.Value, "approved", p.Status == ProposalApproved, "yes", yes, "no", no, "total", total)
+	}
+	proposals := g.Proposals[:0]
+	for _, p := range g.Proposals {
+		if p.Status == ProposalVoting || p.Closed+params.GovernanceHistoryEpochs > epochid {
+			proposals = append(proposals, p)
+		}
+	}
+	g.Proposals = proposals
+	return closed
+}
+
+// ApplyGovernance sets the parameters the node follows its head with to the
+// values of the governance state g of the head block at height, and to their
+// compile-time values otherwise. It is called when the head changes, the state
+// transitions read the values from the state they apply to instead.
+func ApplyGovernance(g *Governance, height uint64) {
+	var (
+		epochid = g.EpochFromHeight(height).EpochID
+		values  = params.DefaultGoverned()
+	)
+	for name, param := range governables {
+		if param.set == nil {
+			continue
+		}
+		value, _ := g.ParamAt(name, epochid)
+		param.set(&values, value)
+	}
+	params.SetGoverned(&values)
+	types.SetEpochLengths(g.Lengths)
+}
+
+// governanceParam returns the value of the parameter name active in the epoch
+// epochid of state, and the one the node follows if state is nil.
+func governanceParam(state StateDB, name string, epochid uint64) *big.Int {
+	if state == nil {
+		return governables[name].get(params.GetGoverned())
+	}
+	if len(state.GetPOSState(types.GovernanceAddress, governanceKey)) == 0 {
+		return new(big.Int).Set(defaultParam(name))
+	}
+	g, err := LoadGovernance(state)
+	if err != nil {
+		return new(big.Int).Set(defaultParam(name))
+	}
+	value, _ := g.ParamAt(name, epochid)
+	return value
+}
+
+// stakingGas returns the gas of the staking method name at height, as approved
+// by the governance in state or from the StakingGas table.
+func stakingGas(state StateDB, name string, height uint64) (uint64, bool) {
+	gas, ok := StakingGas[name]
+	if !ok {
+		return 0, false
+	}
+	if len(state.GetPOSState(types.GovernanceAddress, governanceKey)) == 0 {
+		return gas, true
+	}
+	g, err := LoadGovernance(state)
+	if err != nil {
+		return gas, true
+	}
+	value, err := g.ParamAt("StakingGas."+name, g.EpochFromHeight(height).EpochID)
+	if err != nil {
+		return gas, true
+	}
+	return value.Uint64(), true
+}
+
+// GetParamsRPC returns the value of every parameter active at height.
+func (g *Governance) GetParamsRPC(height uint64) map[string]interface{} {
+	epochid := g.EpochFromHeight(height).EpochID
+	attrs := make(map[string]interface{})
+	for name := range governables {
+		value, _ := g.ParamAt(name, epochid)
+		attrs[name] = (*hexutil.Big)(value)
+	}
+	return attrs
+}
+
+// GetProposalsRPC returns the open proposals and the recently closed ones.
+func (g *Governance) GetProposalsRPC() []map[string]interface{} {
+	attrs := make([]map[string]interface{}, 0, len(g.Proposals))
+	for _, p := range g.Proposals {
+		attrs = append(attrs, p.ToMap())
+	}
+	return attrs
+}
+
+// RunGovernance execute pistchain governance contract
+func RunGovernance(evm *EVM, contract *Contract, input []byte) (ret []byte, err error) {
+	method, err := abiGovernance.MethodById(input)
+	if err != nil {
+		log.Error("No method found")
+		return nil, ErrExecutionReverted
+	}
+
+	data := input[4:]
+
+	switch method.Name {
+	case "propose":
+		ret, err = propose(evm, contract, data)
+	case "vote":
+		ret, err = vote(evm, contract, data)
+	default:
+		log.Warn("Governance call fallback function")
+		err = ErrStakingInvalidInput
+	}
+
+	if err != nil {
+		log.Warn("Governance error code", "code", err)
+		err = ErrExecutionReverted
+	}
+
+	return ret, err
+}
+
+// propose opens a parameter change proposal by a validator
+func propose(evm *EVM, contract *Contract, input []byte) (ret []byte, err error) {
+	args := struct {
+		Name  string
+		Value *big.Int
+	}{}
+
+	method, _ := abiGovernance.Methods["propose"]
+	err = method.Inputs.Unpack(&args, input)
+	if err != nil {
+		log.Error("Unpack propose error", "err", err)
+		return nil, ErrStakingInvalidInput
+	}
+	from := contract.caller.Address()
+
+	impawn := NewImpawnImpl()
+	err = impawn.Load(evm.StateDB, types.StakingAddress)
+	if err != nil {
+		log.Error("Staking load error", "error", err)
+		return nil, err
+	}
+	gov, err := LoadGovernance(evm.StateDB)
+	if err != nil {
+		return nil, err
+	}
+	p, err := gov.Propose(impawn, from, args.Name, args.Value)
+	if err != nil {
+		log.Error("Governance propose error", "address", from, "name", args.Name, "value", args.Value, "err", err)
+		return nil, err
+	}
+	gov.Save(evm.StateDB)
+
+	event := abiGovernance.Events["Propose"]
+	logData, err := event.Inputs.PackNonIndexed(args.Name, args.Value)
+	if err != nil {
+		log.Error("Pack governance log error", "error", err)
+		return nil, err
+	}
+	topics := []common.Hash{
+		event.ID,
+		common.BytesToHash(from[:]),
+		common.BigToHash(new(big.Int).SetUint64(p.ID)),
+	}
+	logN(evm, contract, topics, logData)
+	return method.Outputs.Pack(p.ID)
+}
+
+// vote casts the vote of a validator on a proposal
+func vote(evm *EVM, contract *Contract, input []byte) (ret []byte, err error) {
+	args := struct {
+		Id      uint64
+		Approve bool
+	}{}
+
+	method, _ := abiGovernance.Methods["vote"]
+	err = method.Inputs.Unpack(&args, input)
+	if err != nil {
+		log.Error("Unpack vote error", "err", err)
+		return nil, ErrStakingInvalidInput
+	}
+	from := contract.caller.Address()
+
+	impawn := NewImpawnImpl()
+	err = impawn.Load(evm.StateDB, types.StakingAddress)
+	if err != nil {
+		log.Error("Staking load error", "error", err)
+		return nil, err
+	}
+	gov, err := LoadGovernance(evm.StateDB)
+	if err != nil {
+		return nil, err
+	}
+	if err = gov.Vote(impawn, from, args.Id, args.Approve); err != nil {
+		log.Error("Governance vote error", "address", from, "id", args.Id, "err", err)
+		return nil, err
+	}
+	gov.Save(evm.StateDB)
+
+	event := abiGovernance.Events["Vote"]
+	logData, err := event.Inputs.PackNonIndexed(args.Approve)
+	if err != nil {
+		log.Error("Pack governance log error", "error", err)
+		return nil, err
+	}
+	topics := []common.Hash{
+		event.ID,
+		common.BytesToHash(from[:]),
+		common.BigToHash(new(big.Int).SetUint64(args.Id)),
+	}
+	logN(evm, contract, topics, logData)
+	return nil, nil
+}
+
+// GovernanceABIJSON is the ABI of the governance contract
+const GovernanceABIJSON = `
+[
+  {
+    "name": "Propose",
+    "inputs": [
+      {
+        "type": "address",
+        "name": "proposer",
+        "indexed": true
+      },
+      {
+        "type": "uint64",
+        "name": "id",
+        "indexed": true
+      },
+      {
+        "type": "string",
+        "name": "name",
+        "indexed": false
+      },
+      {
+        "type": "uint256",
+        "name": "value",
+        "indexed": false
+      }
+    ],
+    "anonymous": false,
+    "type": "event"
+  },
+  {
+    "name": "Vote",
+    "inputs": [
+      {
+        "type": "address",
+        "name": "voter",
+        "indexed": true
+      },
+      {
+        "type": "uint64",
+        "name": "id",
+        "indexed": true
+      },
+      {
+        "type": "bool",
+        "name": "approve",
+        "indexed": false
+      }
+    ],
+    "anonymous": false,
+    "type": "event"
+  },
+  {
+    "name": "propose",
+    "outputs": [
+      {
+        "type": "uint64",
+        "name": "id"
+      }
+    ],
+    "inputs": [
+      {
+        "type": "string",
+        "name": "name"
+      },
+      {
+        "type": "uint256",
+        "name": "value"
+      }
+    ],
+    "constant": false,
+    "payable": false,
+    "type": "function"
+  },
+  {
+    "name": "vote",
+    "outputs": [],
+    "inputs": [
+      {
+        "type": "uint64",
+        "name": "id"
+      },
+      {
+        "type": "bool",
+        "name": "approve"
+      }
+    ],
+    "constant": false,
+    "payable": false,
+    "type": "function"
+  }
+]
+`
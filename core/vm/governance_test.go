@@ -0,0 +1,121 @@
+package vm
+
+import (
+	"math/big"
+	"testing"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/core/state"
+	"git.taiyue.io/pist/go-pist/core/types"
+	"git.taiyue.io/pist/go-pist/params"
+	"git.taiyue.io/pist/go-pist/pistdb"
+)
+
+func TestGovernanceProposals(t *testing.T) {
+	defer func() {
+		params.SetGoverned(nil)
+		types.SetEpochLengths(nil)
+	}()
+
+	impl := NewImpawnImpl()
+	// the first validator holds less than a third of the stake
+	addrs := insertValidators(t, impl, 20000, 30000, 30000)
+	if _, err := impl.DoElections(1, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := impl.Shift(1); err != nil {
+		t.Fatal(err)
+	}
+
+	gov := &Governance{NextID: 1}
+	if _, err := gov.Propose(impl, addrs[0], "MaxRedeemHeight", big.NewInt(1)); err != ErrUnknownParam {
+		t.Fatalf("expected unknown parameter error, got %v", err)
+	}
+	if _, err := gov.Propose(impl, addrs[0], "CountInEpoch", big.NewInt(1)); err != ErrParamOutOfRange {
+		t.Fatalf("expected out of range error, got %v", err)
+	}
+	if _, err := gov.Propose(impl, common.Address{1}, "CountInEpoch", big.NewInt(30)); err != ErrNotValidator {
+		t.Fatalf("expected not validator error, got %v", err)
+	}
+	count, err := gov.Propose(impl, addrs[0], "CountInEpoch", big.NewInt(30))
+	if err != nil {
+		t.Fatal(err)
+	}
+	length, _ := gov.Propose(impl, addrs[0], "NewEpochLength", big.NewInt(50000))
+	gas, _ := gov.Propose(impl, addrs[0], "StakingGas.deposit", big.NewInt(1000000))
+
+	// the first two validators hold 5/8 of the stake, less than needed
+	for _, addr := range addrs[:2] {
+		for _, p := range []*Proposal{count, length, gas} {
+			if err := gov.Vote(impl, addr, p.ID, true); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	gov.Vote(impl, addrs[2], gas.ID, false)
+	if closed := gov.Tally(impl, 0); len(closed) != 1 || closed[0] != gas || gas.Status != ProposalRejected {
+		t.Fatalf("closed proposals mismatch: %v", closed)
+	}
+	if err := gov.Vote(impl, addrs[2], gas.ID, true); err != ErrProposalClosed {
+		t.Fatalf("expected closed proposal error, got %v", err)
+	}
+	gov.Vote(impl, addrs[2], count.ID, true)
+	gov.Vote(impl, addrs[2], length.ID, true)
+	if closed := gov.Tally(impl, 0); len(closed) != 2 || count.Status != ProposalApproved || length.Status != ProposalApproved {
+		t.Fatalf("closed proposals mismatch: %v", closed)
+	}
+
+	// The changes survive a reload and take effect when applied
+	db := pistdb.NewMemDatabase()
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(db))
+	gov.Save(statedb)
+	if gov, err = LoadGovernance(statedb); err != nil {
+		t.Fatal(err)
+	}
+	if value, _ := gov.ParamAt("CountInEpoch", 2); value.Int64() != 30 {
+		t.Fatalf("parameter mismatch: have %v, want 30", value)
+	}
+	// the state transitions of the current epoch keep the previous value
+	if value := governanceParam(statedb, "CountInEpoch", 1); value.Int64() != int64(params.CountInEpoch) {
+		t.Fatalf("parameter of the current epoch changed: %v", value)
+	}
+	if gas, _ := stakingGas(statedb, "deposit", types.GetEpochFromID(2).BeginHeight); gas != StakingGas["deposit"] {
+		t.Fatalf("rejected gas change applied: %d", gas)
+	}
+	before := types.GetEpochFromID(3)
+	if epoch := gov.EpochFromID(3); epoch.BeginHeight != before.BeginHeight || epoch.EndHeight != before.BeginHeight+50000-1 {
+		t.Fatalf("governance epoch 3 mismatch: have %d-%d", epoch.BeginHeight, epoch.EndHeight)
+	}
+	ApplyGovernance(gov, types.GetEpochFromID(1).EndHeight)
+	if params.GetGoverned().CountInEpoch == 30 {
+		t.Fatal("parameter applied before its epoch")
+	}
+	ApplyGovernance(gov, types.GetEpochFromID(2).BeginHeight)
+	if count := params.GetGoverned().CountInEpoch; count != 30 {
+		t.Fatalf("followed parameter mismatch: have %d, want 30", count)
+	}
+	if params.CountInEpoch == 30 {
+		t.Fatal("compile-time parameter changed")
+	}
+	// the length changes from the epoch after the next one
+	if epoch := types.GetEpochFromID(3); epoch.BeginHeight != before.BeginHeight || epoch.EndHeight != before.BeginHeight+50000-1 {
+		t.Fatalf("epoch 3 mismatch: have %d-%d", epoch.BeginHeight, epoch.EndHeight)
+	}
+	if epoch := types.GetEpochFromHeight(before.BeginHeight + 50000); epoch.EpochID != 4 {
+		t.Fatalf("epoch from height mismatch: have %d, want 4", epoch.EpochID)
+	}
+	if epoch := types.GetEpochFromHeight(before.BeginHeight - 1); epoch.EpochID != 2 {
+		t.Fatalf("past epoch changed: have %d, want 2", epoch.EpochID)
+	}
+}
+
+func TestGovernancePrecompileFork(t *testing.T) {
+	evm := &EVM{chainRules: params.Rules{IsYoloV1: true}}
+	if evm.IsPrecompile(types.GovernanceAddress) || !evm.IsPrecompile(types.StakingAddress) {
+		t.Fatal("governance precompile registered before the fork")
+	}
+	evm.chainRules.IsGovernance = true
+	if !evm.IsPrecompile(types.GovernanceAddress) {
+		t.Fatal("governance precompile missing after the fork")
+	}
+}
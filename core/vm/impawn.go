@@ -573,16 +573,73 @@ type ImpawnImpl struct {
 	accounts   map[uint64]SAImpawns // key is epoch id,value is SA set
 	curEpochID uint64               // the new epochid of the current state
 	lastReward uint64               // the curnent reward height block
+
+	// the side tables keep the entries read from the state or changed, see
+	// impawnEntryKey
+	slashes  map[common.Address]*slashState // slashing of the validators, see slashing.go
+	slashing bool                           // the jailed validators are left out of the elections
+
+	downtime      map[common.Address]*downtimeItem // downtime of the validators, see downtime.go
+	downtimeCount *downtimeCount                   // blocks of the epoch recorded, nil until read
+
+	infos   map[common.Address]*validatorInfo   // descriptions and fee histories, see validatorinfo.go
+	rewards map[delegationKey]*delegationReward // reward modes of the delegations, see rewardmode.go
+
+	redelegations map[common.Address][]*Redelegation // redelegations of the delegators, see redelegation.go
+	redelegators  map[common.Address]struct{}        // delegators with redelegations, nil until read
+
+	db         StateDB        // state the staking state is read from on demand, nil if built in memory
+	preAddress common.Address // address holding the staking state in db
+	err        error          // first error reading the state on demand
+
+	lengths []types.EpochLength // epoch lengths approved by the governance of db
+
+	sharded  bool                        // the state is stored slot by slot, see impawn_store.go
+	unloaded map[uint64]struct{}         // epochs of the sharded state not read yet
+	slots    map[common.Hash]common.Hash // hash of every slot of the epochs read as stored
 }
 
 func NewImpawnImpl() *ImpawnImpl {
 	pre := types.GetPreFirstEpoch()
-	return &ImpawnImpl{
+	i := &ImpawnImpl{
 		curEpochID: pre.EpochID,
 		lastReward: 0,
 		accounts:   make(map[uint64]SAImpawns),
+		lengths:    types.GetEpochLengths(),
 	}
+	i.clearEntries()
+	return i
+}
+
+// epochFromHeight returns the epoch of the height hh with the epoch lengths of
+// the state the staking state was loaded from.
+func (i *ImpawnImpl) epochFromHeight(hh uint64) *types.EpochIDInfo {
+	return types.EpochFromHeight(i.lengths, hh)
 }
+
+// epochFromID returns the epoch eid with the epoch lengths of the state the
+// staking state was loaded from.
+func (i *ImpawnImpl) epochFromID(eid uint64) *types.EpochIDInfo {
+	return types.EpochFromID(i.lengths, eid)
+}
+
+// param returns the value of the governance parameter name active in the
+// current epoch of the state the staking state was loaded from.
+func (i *ImpawnImpl) param(name string) *big.Int {
+	return governanceParam(i.db, name, i.curEpochID)
+}
+
+// clearEntries drops the side table entries, they are read again on demand.
+func (i *ImpawnImpl) clearEntries() {
+	i.slashes = make(map[common.Address]*slashState)
+	i.downtime = make(map[common.Address]*downtimeItem)
+	i.downtimeCount = nil
+	i.infos = make(map[common.Address]*validatorInfo)
+	i.rewards = make(map[delegationKey]*delegationReward)
+	i.redelegations = make(map[common.Address][]*Redelegation)
+	i.redelegators = nil
+}
+
 func CloneImpawnImpl(ori *ImpawnImpl) *ImpawnImpl {
 	if ori == nil {
 		return nil
@@ -591,6 +648,52 @@ func CloneImpawnImpl(ori *ImpawnImpl) *ImpawnImpl {
 		curEpochID: ori.curEpochID,
 		lastReward: ori.lastReward,
 		accounts:   make(map[uint64]SAImpawns),
+		slashing:   ori.slashing,
+		db:         ori.db,
+		preAddress: ori.preAddress,
+		err:        ori.err,
+		lengths:    ori.lengths,
+	}
+	tmp.clearEntries()
+	for k, v := range ori.slashes {
+		tmp.slashes[k] = v.clone()
+	}
+	for k, v := range ori.downtime {
+		item := *v
+		tmp.downtime[k] = &item
+	}
+	if ori.downtimeCount != nil {
+		count := *ori.downtimeCount
+		tmp.downtimeCount = &count
+	}
+	for k, v := range ori.infos {
+		tmp.infos[k] = v.clone()
+	}
+	for k, v := range ori.rewards {
+		tmp.rewards[k] = v.clone()
+	}
+	for k, v := range ori.redelegations {
+		var rs []*Redelegation
+		for _, r := range v {
+			rs = append(rs, r.clone())
+		}
+		tmp.redelegations[k] = rs
+	}
+	if ori.redelegators != nil {
+		tmp.redelegators = make(map[common.Address]struct{}, len(ori.redelegators))
+		for k := range ori.redelegators {
+			tmp.redelegators[k] = struct{}{}
+		}
+	}
+	if ori.sharded {
+		tmp.sharded, tmp.slots = true, make(map[common.Hash]common.Hash, len(ori.slots))
+		for k, v := range ori.slots {
+			tmp.slots[k] = v
+		}
+		tmp.unloaded = make(map[uint64]struct{}, len(ori.unloaded))
+		for k := range ori.unloaded {
+			tmp.unloaded[k] = struct{}{}
+		}
 	}
 	for k, val := range ori.accounts {
 		items := SAImpawns{}
@@ -613,7 +716,7 @@ func (i *ImpawnImpl) SetCurrentEpoch(eid uint64) {
 }
 func (i *ImpawnImpl) getMinEpochID() uint64 {
 	eid := i.curEpochID
-	for k, _ := range i.accounts {
+	for _, k := range i.epochIDs() {
 		if eid > k {
 			eid = k
 		}
@@ -621,17 +724,12 @@ func (i *ImpawnImpl) getMinEpochID() uint64 {
 	return eid
 }
 func (i *ImpawnImpl) isInCurrentEpoch(hh uint64) bool {
-	return i.curEpochID == types.GetEpochFromHeight(hh).EpochID
+	return i.curEpochID == i.epochFromHeight(hh).EpochID
 }
 func (i *ImpawnImpl) getCurrentEpochInfo() []*types.EpochIDInfo {
 	var epochs []*types.EpochIDInfo
-	var eids []float64
-	for k, _ := range i.accounts {
-		eids = append(eids, float64(k))
-	}
-	sort.Float64s(eids)
-	for _, v := range eids {
-		e := types.GetEpochFromID(uint64(v))
+	for _, v := range i.epochIDs() {
+		e := i.epochFromID(v)
 		if e != nil {
 			epochs = append(epochs, e)
 		}
@@ -639,7 +737,8 @@ func (i *ImpawnImpl) getCurrentEpochInfo() []*types.EpochIDInfo {
 	return epochs
 }
 func (i *ImpawnImpl) repeatPK(addr common.Address, pk []byte) bool {
-	for _, v := range i.accounts {
+	for _, eid := range i.epochIDs() {
+		v, _ := i.epochAccounts(eid)
 		for _, vv := range v {
 			if !bytes.Equal(addr.Bytes(), vv.Unit.Address.Bytes()) && bytes.Equal(pk, vv.Votepubkey) {
 				return true
@@ -649,7 +748,7 @@ func (i *ImpawnImpl) repeatPK(addr common.Address, pk []byte) bool {
 	return false
 }
 func (i *ImpawnImpl) GetStakingAccount(epochid uint64, addr common.Address) (*StakingAccount, error) {
-	if v, ok := i.accounts[epochid]; !ok {
+	if v, ok := i.epochAccounts(epochid); !ok {
 		return nil, types.ErrInvalidStaking
 	} else {
 		for _, val := range v {
@@ -669,7 +768,7 @@ func (i *ImpawnImpl) getDAfromSA(sa *StakingAccount, addr common.Address) (*Dele
 	return nil, nil
 }
 func (i *ImpawnImpl) getElections(epochid uint64) []common.Address {
-	if accounts, ok := i.accounts[epochid]; !ok {
+	if accounts, ok := i.epochAccounts(epochid); !ok {
 		return nil
 	} else {
 		var addrs []common.Address
@@ -682,7 +781,7 @@ func (i *ImpawnImpl) getElections(epochid uint64) []common.Address {
 	}
 }
 func (i *ImpawnImpl) getElections2(epochid uint64) []*StakingAccount {
-	if accounts, ok := i.accounts[epochid]; !ok {
+	if accounts, ok := i.epochAccounts(epochid); !ok {
 		return nil
 	} else {
 		var sas []*StakingAccount
@@ -702,7 +801,7 @@ func (i *ImpawnImpl) getElections3(epochid uint64) []*StakingAccount {
 	return i.getElections2(eid)
 }
 func (i *ImpawnImpl) fetchAccountsInEpoch(epochid uint64, addrs []*StakingAccount) []*StakingAccount {
-	if accounts, ok := i.accounts[epochid]; !ok {
+	if accounts, ok := i.epochAccounts(epochid); !ok {
 		return addrs
 	} else {
 		find := func(addrs []*StakingAccount, addr common.Address) bool {
@@ -772,7 +871,7 @@ func (i *ImpawnImpl) calcRewardInSa(target uint64, sa *StakingAccount, allReward
 	return items, nil
 }
 func (i *ImpawnImpl) calcReward(target uint64, allAmount *big.Int, einfo *types.EpochIDInfo) ([]*types.SARewardInfos, error) {
-	if _, ok := i.accounts[einfo.EpochID]; !ok {
+	if _, ok := i.epochAccounts(einfo.EpochID); !ok {
 		return nil, types.ErrInvalidParam
 	} else {
 		sas := i.getElections3(einfo.EpochID)
@@ -817,7 +916,7 @@ func (i *ImpawnImpl) calcReward(target uint64, allAmount *big.Int, einfo *types.
 	}
 }
 func (i *ImpawnImpl) reward(height uint64, allAmount *big.Int) ([]*types.SARewardInfos, error) {
-	einfo := types.GetEpochFromHeight(height)
+	einfo := i.epochFromHeight(height)
 	return i.calcReward(height, allAmount, einfo)
 }
 
@@ -826,12 +925,12 @@ func (i *ImpawnImpl) reward(height uint64, allAmount *big.Int) ([]*types.SARewar
 /////////////////////////////////////////////////////////////////////////////////
 // move the accounts from prev to next epoch and keeps the prev account still here
 func (i *ImpawnImpl) move(prev, next uint64) error {
-	nextEpoch := types.GetEpochFromID(next)
+	nextEpoch := i.epochFromID(next)
 	if nextEpoch == nil {
 		return types.ErrOverEpochID
 	}
-	prevInfos, ok := i.accounts[prev]
-	nextInfos, ok2 := i.accounts[next]
+	prevInfos, ok := i.epochAccounts(prev)
+	nextInfos, ok2 := i.epochAccounts(next)
 	if !ok {
 		return errors.New(fmt.Sprintln("the epoch is nil", prev, "err:", types.ErrNotMatchEpochInfo))
 	}
@@ -855,11 +954,26 @@ func (i *ImpawnImpl) move(prev, next uint64) error {
 
 // DoElections called by consensus while it closer the end of epoch,have 500~1000 fast block
 func (i *ImpawnImpl) DoElections(epochid, height uint64) ([]*StakingAccount, error) {
+	return i.doElections(epochid, height, nil)
+}
+
+// DoRandomElections is DoElections sampling the committee with a probability
+// proportional to the valid stake of the accounts, see sampleByStake.
+func (i *ImpawnImpl) DoRandomElections(epochid, height uint64, seed common.Hash) ([]*StakingAccount, error) {
+	return i.doElections(epochid, height, &seed)
+}
+
+func (i *ImpawnImpl) doElections(epochid, height uint64, seed *common.Hash) ([]*StakingAccount, error) {
 	if epochid < params.FirstNewEpochID && epochid != i.getCurrentEpoch()+1 {
 		return nil, types.ErrOverEpochID
 	}
-	cur := types.GetEpochFromID(i.curEpochID)
-	if cur.EndHeight != height+params.ElectionPoint && i.curEpochID >= params.FirstNewEpochID {
+	var (
+		cur      = i.epochFromID(i.curEpochID)
+		point    = i.param("ElectionPoint").Uint64()
+		minLimit = i.param("ElectionMinLimitForStaking")
+		count    = int(i.param("CountInEpoch").Int64())
+	)
+	if cur.EndHeight != height+point && i.curEpochID >= params.FirstNewEpochID {
 		return nil, types.ErrNotElectionTime
 	}
 	// e := types.GetEpochFromID(epochid)
@@ -867,20 +981,33 @@ func (i *ImpawnImpl) DoElections(epochid, height uint64) ([]*StakingAccount, err
 	if eid >= params.FirstNewEpochID {
 		eid = eid - 1
 	}
-	if val, ok := i.accounts[eid]; ok {
+	if val, ok := i.epochAccounts(eid); ok {
 		val.sort(height, true)
 		var ee []*StakingAccount
 		for _, v := range val {
+			if i.slashing && i.isJailed(v.Unit.GetRewardAddress(), epochid) {
+				continue
+			}
 			validStaking := v.getValidStakingOnly(height)
-			if validStaking.Cmp(params.ElectionMinLimitForStaking) < 0 {
+			if validStaking.Cmp(minLimit) < 0 {
+				continue
+			}
+			if seed != nil {
+				ee = append(ee, v)
 				continue
 			}
 			v.Committee = true
 			ee = append(ee, v)
-			if len(ee) >= params.CountInEpoch {
+			if len(ee) >= count {
 				break
 			}
 		}
+		if seed != nil {
+			ee = sampleByStake(ee, height, count, *seed)
+			for _, v := range ee {
+				v.Committee = true
+			}
+		}
 		return ee, nil
 	} else {
 		return nil, types.ErrMatchEpochID
@@ -891,11 +1018,11 @@ func (i *ImpawnImpl) DoElections(epochid, height uint64) ([]*StakingAccount, err
 // it will be save the whole state in the current epoch end block after it called by consensus
 func (i *ImpawnImpl) Shift(epochid uint64) error {
 	lastReward := i.lastReward
-	minEpoch := types.GetEpochFromHeight(lastReward)
+	minEpoch := i.epochFromHeight(lastReward)
 	min := i.getMinEpochID()
 	// fmt.Println("*** move min:", min, "minEpoch:", minEpoch.EpochID, "lastReward:", i.lastReward)
 	for ii := min; minEpoch.EpochID > 1 && ii < minEpoch.EpochID-1; ii++ {
-		delete(i.accounts, ii)
+		i.dropEpoch(ii)
 		// fmt.Println("delete epoch:", ii)
 	}
 
@@ -904,7 +1031,13 @@ func (i *ImpawnImpl) Shift(epochid uint64) error {
 	}
 	i.SetCurrentEpoch(epochid)
 	prev := epochid - 1
-	return i.move(prev, epochid)
+	prevAccounts, _ := i.epochAccounts(prev)
+	i.shiftSlashing(epochid, prevAccounts)
+	if err := i.move(prev, epochid); err != nil {
+		return err
+	}
+	i.shiftRedelegations(prev, epochid)
+	return nil
 }
 
 // CancelSAccount cancel amount of asset for staking account,it will be work in next epoch
@@ -912,7 +1045,7 @@ func (i *ImpawnImpl) CancelSAccount(curHeight uint64, addr common.Address, amoun
 	if amount.Sign() <= 0 || curHeight <= 0 {
 		return types.ErrInvalidParam
 	}
-	curEpoch := types.GetEpochFromHeight(curHeight)
+	curEpoch := i.epochFromHeight(curHeight)
 	if curEpoch == nil || curEpoch.EpochID != i.curEpochID {
 		return types.ErrInvalidParam
 	}
@@ -930,7 +1063,7 @@ func (i *ImpawnImpl) CancelDAccount(curHeight uint64, addrSA, addrDA common.Addr
 	if amount.Sign() <= 0 || curHeight <= 0 {
 		return types.ErrInvalidParam
 	}
-	curEpoch := types.GetEpochFromHeight(curHeight)
+	curEpoch := i.epochFromHeight(curHeight)
 	if curEpoch == nil || curEpoch.EpochID != i.curEpochID {
 		return types.ErrInvalidParam
 	}
@@ -956,7 +1089,7 @@ func (i *ImpawnImpl) RedeemSAccount(curHeight uint64, addr common.Address, amoun
 	if amount.Sign() <= 0 || curHeight <= 0 {
 		return types.ErrInvalidParam
 	}
-	curEpoch := types.GetEpochFromHeight(curHeight)
+	curEpoch := i.epochFromHeight(curHeight)
 	if curEpoch == nil || curEpoch.EpochID != i.curEpochID {
 		return types.ErrInvalidParam
 	}
@@ -972,7 +1105,7 @@ func (i *ImpawnImpl) RedeemDAccount(curHeight uint64, addrSA, addrDA common.Addr
 	if amount.Sign() <= 0 || curHeight <= 0 {
 		return types.ErrInvalidParam
 	}
-	curEpoch := types.GetEpochFromHeight(curHeight)
+	curEpoch := i.epochFromHeight(curHeight)
 	if curEpoch == nil || curEpoch.EpochID != i.curEpochID {
 		return types.ErrInvalidParam
 	}
@@ -995,7 +1128,7 @@ func (i *ImpawnImpl) insertDAccount(height uint64, da *DelegationAccount) error
 	if da == nil {
 		return types.ErrInvalidParam
 	}
-	epochInfo := types.GetEpochFromHeight(height)
+	epochInfo := i.epochFromHeight(height)
 	if epochInfo == nil || epochInfo.EpochID > i.getCurrentEpoch() {
 		return types.ErrOverEpochID
 	}
@@ -1043,12 +1176,12 @@ func (i *ImpawnImpl) insertSAccount(height uint64, sa *StakingAccount) error {
 	if sa == nil {
 		return types.ErrInvalidParam
 	}
-	epochInfo := types.GetEpochFromHeight(height)
+	epochInfo := i.epochFromHeight(height)
 	if epochInfo == nil || epochInfo.EpochID > i.getCurrentEpoch() {
 		log.Error("insertSAccount", "eid", epochInfo.EpochID, "height", height, "eid2", i.getCurrentEpoch())
 		return types.ErrOverEpochID
 	}
-	if val, ok := i.accounts[epochInfo.EpochID]; !ok {
+	if val, ok := i.epochAccounts(epochInfo.EpochID); !ok {
 		var accounts []*StakingAccount
 		accounts = append(accounts, sa)
 		i.accounts[epochInfo.EpochID] = SAImpawns(accounts)
@@ -1105,7 +1238,7 @@ func (i *ImpawnImpl) AppendSAAmount(height uint64, addr common.Address, val *big
 	if val.Sign() <= 0 || height < 0 {
 		return types.ErrInvalidParam
 	}
-	epochInfo := types.GetEpochFromHeight(height)
+	epochInfo := i.epochFromHeight(height)
 	if epochInfo.EpochID > i.getCurrentEpoch() {
 		log.Debug("insertSAccount", "eid", epochInfo.EpochID, "height", height, "eid2", i.getCurrentEpoch())
 		return types.ErrOverEpochID
@@ -1121,7 +1254,7 @@ func (i *ImpawnImpl) UpdateSAFee(height uint64, addr common.Address, fee *big.In
 	if height < 0 || fee.Sign() < 0 || fee.Cmp(types.Base) > 0 {
 		return types.ErrInvalidParam
 	}
-	epochInfo := types.GetEpochFromHeight(height)
+	epochInfo := i.epochFromHeight(height)
 	if epochInfo.EpochID > i.getCurrentEpoch() {
 		log.Info("UpdateSAFee", "eid", epochInfo.EpochID, "height", height, "eid2", i.getCurrentEpoch())
 		return types.ErrOverEpochID
@@ -1144,7 +1277,7 @@ func (i *ImpawnImpl) UpdateSAPK(height uint64, addr common.Address, pk []byte) e
 		log.Error("UpdateSAPK repeat pk", "addr", addr, "pk", pk)
 		return types.ErrRepeatPk
 	}
-	epochInfo := types.GetEpochFromHeight(height)
+	epochInfo := i.epochFromHeight(height)
 	if epochInfo.EpochID > i.getCurrentEpoch() {
 		log.Info("UpdateSAPK", "eid", epochInfo.EpochID, "height", height, "eid2", i.getCurrentEpoch())
 		return types.ErrOverEpochID
@@ -1167,7 +1300,7 @@ func (i *ImpawnImpl) Reward(height uint64, allAmount *big.Int) ([]*types.SARewar
 /////////////////////////////////////////////////////////////////////////////////
 // GetStakings return all staking accounts of the current epoch
 func (i *ImpawnImpl) GetAllStakingAccount() SAImpawns {
-	if val, ok := i.accounts[i.curEpochID]; ok {
+	if val, ok := i.epochAccounts(i.curEpochID); ok {
 		return val
 	} else {
 		return nil
@@ -1206,8 +1339,8 @@ func (i *ImpawnImpl) GetAllCancelableAsset(addr common.Address) map[common.Addre
 }
 func (i *ImpawnImpl) getAsset(addr common.Address, epoch uint64, op uint8) (map[common.Address]*types.StakingValue, map[common.Address]*big.Int) {
 	epochid := epoch
-	end := types.GetEpochFromID(epochid).EndHeight
-	if val, ok := i.accounts[epochid]; ok {
+	end := i.epochFromID(epochid).EndHeight
+	if val, ok := i.epochAccounts(epochid); ok {
 		res := make(map[common.Address]*types.StakingValue)
 		res2 := make(map[common.Address]*big.Int)
 		for _, v := range val {
@@ -1279,6 +1412,13 @@ func (i *ImpawnImpl) GetRoot() common.Hash {
 	return common.Hash{}
 }
 func (i *ImpawnImpl) Save(state StateDB, preAddress common.Address) error {
+	if i.sharded {
+		if err := i.saveSharded(state, preAddress); err != nil {
+			return err
+		}
+		i.saveEntries(state, preAddress)
+		return nil
+	}
 	key := common.BytesToHash(preAddress[:])
 	data, err := rlp.EncodeToBytes(i)
 
@@ -1287,13 +1427,34 @@ func (i *ImpawnImpl) Save(state StateDB, preAddress common.Address) error {
 	}
 	hash := types.RlpHash(data)
 	state.SetPOSState(preAddress, key, data)
+	i.saveEntries(state, preAddress)
 	tmp := CloneImpawnImpl(i)
 	if tmp != nil {
 		IC.Cache.Add(hash, tmp)
 	}
 	return err
 }
+
+// saveEntries writes back the side table entries that changed.
+func (i *ImpawnImpl) saveEntries(state StateDB, preAddress common.Address) {
+	i.saveSlashing(state, preAddress)
+	i.SaveDowntime(state, preAddress)
+	i.saveValidatorInfos(state, preAddress)
+	i.saveDelegationRewards(state, preAddress)
+	i.saveRedelegations(state, preAddress)
+}
+
 func (i *ImpawnImpl) Load(state StateDB, preAddress common.Address) error {
+	i.db, i.preAddress, i.err = state, preAddress, nil
+	i.clearEntries()
+	gov, err := LoadGovernance(state)
+	if err != nil {
+		return err
+	}
+	i.lengths = gov.Lengths
+	if IsImpawnSharded(state, preAddress) {
+		return i.loadSharded(state, preAddress)
+	}
 	key := common.BytesToHash(preAddress[:])
 	data := state.GetPOSState(preAddress, key)
 	lenght := len(data)
@@ -1319,6 +1480,7 @@ func (i *ImpawnImpl) Load(state StateDB, preAddress common.Address) error {
 	}
 	// log.Info("-----Load impawn---","len:",lenght,"count:",temp.Counts(),"cache",cache)
 	i.curEpochID, i.accounts, i.lastReward = temp.curEpochID, temp.accounts, temp.lastReward
+	i.sharded, i.unloaded, i.slots = false, nil, nil
 	return nil
 }
 
@@ -1346,8 +1508,8 @@ func GetValidatorsByEpoch(state StateDB, eid, hh uint64) []*types.CommitteeMembe
 	err := i.Load(state, types.StakingAddress)
 	accs := i.getElections3(eid)
 	first := types.GetFirstEpoch()
-	if hh == first.EndHeight-params.ElectionPoint {
-		fmt.Println("****** accounts len:", len(i.accounts), "election:", len(accs), " err ", err)
+	if hh == first.EndHeight-i.param("ElectionPoint").Uint64() {
+		log.Debug("Validators of the first election", "epochs", len(i.epochIDs()), "elected", len(accs), "err", err)
 	}
 	var vv []*types.CommitteeMember
 	for _, v := range accs {
@@ -1364,7 +1526,8 @@ func GetValidatorsByEpoch(state StateDB, eid, hh uint64) []*types.CommitteeMembe
 }
 func (i *ImpawnImpl) Counts() int {
 	pos := 0
-	for _, val := range i.accounts {
+	for _, eid := range i.epochIDs() {
+		val, _ := i.epochAccounts(eid)
 		for _, vv := range val {
 			pos = pos + len(vv.Delegation)
 		}
@@ -1378,8 +1541,9 @@ func (i *ImpawnImpl) Summay() *types.ImpawnSummay {
 		Infos:      make([]*types.SummayEpochInfo, 0, 0),
 	}
 	sumAccount := 0
-	for k, val := range i.accounts {
-		info := types.GetEpochFromID(k)
+	for _, k := range i.epochIDs() {
+		val, _ := i.epochAccounts(k)
+		info := i.epochFromID(k)
 		item := &types.SummayEpochInfo{
 			EpochID:     info.EpochID,
 			BeginHeight: info.BeginHeight,
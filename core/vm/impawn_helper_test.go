@@ -0,0 +1,26 @@
+package vm
+
+import (
+	"math/big"
+	"testing"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/crypto"
+)
+
+// insertValidators registers a validator of epoch 0 with a fee of 50 for each
+// of the stakes, in whole tokens, and returns their addresses. The vote key of
+// a validator is the key of its address.
+func insertValidators(t *testing.T, impl *ImpawnImpl, stakes ...int64) []common.Address {
+	var addrs []common.Address
+	for _, stake := range stakes {
+		key, _ := crypto.GenerateKey()
+		addr := crypto.PubkeyToAddress(key.PublicKey)
+		value := new(big.Int).Mul(big.NewInt(stake), big.NewInt(1e18))
+		if err := impl.InsertSAccount2(0, addr, crypto.FromECDSAPub(&key.PublicKey), value, big.NewInt(50), true); err != nil {
+			t.Fatal(err)
+		}
+		addrs = append(addrs, addr)
+	}
+	return addrs
+}
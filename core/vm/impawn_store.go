@@ -0,0 +1,465 @@
+package vm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/crypto"
+	"git.taiyue.io/pist/go-pist/log"
+	"git.taiyue.io/pist/go-pist/params"
+	"git.taiyue.io/pist/go-pist/rlp"
+	"golang.org/x/crypto/sha3"
+)
+
+// The sharded layout keeps the staking state slot by slot in the POS storage of
+// the staking address instead of one blob, so that a block only rewrites the
+// accounts it changed and one account can be proven on its own:
+//
+//	impawnMetaKey                  -> current epoch, last reward and the epochs held
+//	impawnEpochKey(eid)            -> staking account addresses of the epoch in order
+//	impawnSAKey(eid, sa)           -> staking account without its delegations
+//	impawnDAKey(eid, sa, da)       -> delegation account
+//
+// The epochs are read on demand and an epoch is decoded from its slots only if
+// its digest misses the cache. The side tables of the staking state keep an
+// entry per slot as well, see impawnEntryKey.
+var impawnMetaKey = common.BytesToHash([]byte("impawn-meta"))
+
+func impawnEpochKey(eid uint64) common.Hash {
+	return crypto.Keccak256Hash([]byte("impawn-epoch"), encodeEpochID(eid))
+}
+
+func impawnSAKey(eid uint64, sa common.Address) common.Hash {
+	return crypto.Keccak256Hash([]byte("impawn-sa"), encodeEpochID(eid), sa[:])
+}
+
+func impawnDAKey(eid uint64, sa, da common.Address) common.Hash {
+	return crypto.Keccak256Hash([]byte("impawn-da"), encodeEpochID(eid), sa[:], da[:])
+}
+
+func encodeEpochID(eid uint64) []byte {
+	enc := make([]byte, 8)
+	binary.BigEndian.PutUint64(enc, eid)
+	return enc
+}
+
+// "external" sharded staking state encodings.
+type extImpawnMeta struct {
+	CurEpochID uint64
+	LastReward uint64
+	Epochs     []uint64
+}
+
+type extImpawnEpoch struct {
+	Accounts []common.Address
+	Digest   common.Hash // hash of all the slots of the epoch
+}
+
+type extStakingAccount struct {
+	Unit       *impawnUnit
+	Votepubkey []byte
+	Fee        *big.Int
+	Committee  bool
+	Modify     *AlterableInfo
+	Delegators []common.Address
+}
+
+// impawnEpochEntry is a decoded epoch in the cache with the hashes of its slots.
+type impawnEpochEntry struct {
+	accounts SAImpawns
+	slots    map[common.Hash]common.Hash
+}
+
+func (e *impawnEpochEntry) clone() *impawnEpochEntry {
+	res := &impawnEpochEntry{slots: make(map[common.Hash]common.Hash, len(e.slots))}
+	for _, v := range e.accounts {
+		res.accounts = append(res.accounts, v.clone())
+	}
+	for k, v := range e.slots {
+		res.slots[k] = v
+	}
+	return res
+}
+
+// IsImpawnSharded reports whether the staking state of preAddress has been
+// migrated to the sharded layout.
+func IsImpawnSharded(state StateDB, preAddress common.Address) bool {
+	return len(state.GetPOSState(preAddress, impawnMetaKey)) != 0
+}
+
+// MigrateImpawnState moves the staking state of preAddress from the blob to the
+// sharded layout, it is called once at the staking trie fork.
+func MigrateImpawnState(state StateDB, preAddress common.Address) error {
+	if IsImpawnSharded(state, preAddress) || len(state.GetPOSState(preAddress, common.BytesToHash(preAddress[:]))) == 0 {
+		return nil
+	}
+	i := NewImpawnImpl()
+	if err := i.Load(state, preAddress); err != nil {
+		return err
+	}
+	i.sharded = true
+	if err := i.Save(state, preAddress); err != nil {
+		return err
+	}
+	state.SetPOSState(preAddress, common.BytesToHash(preAddress[:]), nil)
+	log.Info("Migrate staking state to the sharded layout", "epoch", i.curEpochID, "accounts", i.Counts())
+	return nil
+}
+
+type impawnSlot struct {
+	key  common.Hash
+	data []byte
+}
+
+// encodeEpoch returns the slots of the accounts of epoch eid in order.
+func encodeEpoch(eid uint64, accounts SAImpawns) ([]*impawnSlot, *extImpawnEpoch, error) {
+	var (
+		slots []*impawnSlot
+		index = &extImpawnEpoch{}
+		hw    = sha3.NewLegacyKeccak256()
+	)
+	put := func(key common.Hash, val interface{}) error {
+		data, err := rlp.EncodeToBytes(val)
+		if err != nil {
+			return err
+		}
+		slots = append(slots, &impawnSlot{key: key, data: data})
+		hw.Write(key[:])
+		hw.Write(data)
+		return nil
+	}
+	for _, sa := range accounts {
+		saAddr := sa.Unit.Address
+		ext := &extStakingAccount{
+			Unit:       sa.Unit,
+			Votepubkey: sa.Votepubkey,
+			Fee:        sa.Fee,
+			Committee:  sa.Committee,
+			Modify:     sa.Modify,
+		}
+		for _, da := range sa.Delegation {
+			ext.Delegators = append(ext.Delegators, da.Unit.Address)
+			if err := put(impawnDAKey(eid, saAddr, da.Unit.Address), da); err != nil {
+				return nil, nil, err
+			}
+		}
+		if err := put(impawnSAKey(eid, saAddr), ext); err != nil {
+			return nil, nil, err
+		}
+		index.Accounts = append(index.Accounts, saAddr)
+	}
+	hw.Sum(index.Digest[:0])
+	return slots, index, nil
+}
+
+// loadEpoch decodes the accounts of epoch eid from their slots.
+func loadEpoch(state StateDB, preAddress common.Address, eid uint64, index *extImpawnEpoch) (*impawnEpochEntry, error) {
+	entry := &impawnEpochEntry{slots: make(map[common.Hash]common.Hash)}
+	get := func(key common.Hash, val interface{}) error {
+		data := state.GetPOSState(preAddress, key)
+		if len(data) == 0 {
+			return fmt.Errorf("missing staking slot %x", key)
+		}
+		entry.slots[key] = crypto.Keccak256Hash(data)
+		return rlp.DecodeBytes(data, val)
+	}
+	for _, saAddr := range index.Accounts {
+		var ext extStakingAccount
+		if err := get(impawnSAKey(eid, saAddr), &ext); err != nil {
+			return nil, err
+		}
+		sa := &StakingAccount{
+			Unit:       ext.Unit,
+			Votepubkey: ext.Votepubkey,
+			Fee:        ext.Fee,
+			Committee:  ext.Committee,
+			Modify:     ext.Modify,
+			Delegation: make([]*DelegationAccount, 0, len(ext.Delegators)),
+		}
+		for _, daAddr := range ext.Delegators {
+			da := new(DelegationAccount)
+			if err := get(impawnDAKey(eid, saAddr, daAddr), da); err != nil {
+				return nil, err
+			}
+			sa.Delegation = append(sa.Delegation, da)
+		}
+		entry.accounts = append(entry.accounts, sa)
+	}
+	return entry, nil
+}
+
+func (i *ImpawnImpl) loadSharded(state StateDB, preAddress common.Address) error {
+	var meta extImpawnMeta
+	if err := rlp.DecodeBytes(state.GetPOSState(preAddress, impawnMetaKey), &meta); err != nil {
+		log.Error("Invalid staking meta RLP", "err", err)
+		return errors.New(fmt.Sprintf("Invalid staking meta RLP %s", err.Error()))
+	}
+	i.curEpochID, i.accounts, i.lastReward = meta.CurEpochID, make(map[uint64]SAImpawns), meta.LastReward
+	i.sharded, i.slots, i.unloaded = true, make(map[common.Hash]common.Hash), make(map[uint64]struct{})
+	for _, eid := range meta.Epochs {
+		i.unloaded[eid] = struct{}{}
+	}
+	return nil
+}
+
+// loadEpochAccounts reads the accounts of epoch eid from its slots, an epoch
+// whose digest is in the cache isn't decoded again.
+func (i *ImpawnImpl) loadEpochAccounts(eid uint64) error {
+	key := impawnEpochKey(eid)
+	data := i.db.GetPOSState(i.preAddress, key)
+	var index extImpawnEpoch
+	if err := rlp.DecodeBytes(data, &index); err != nil {
+		log.Error("Invalid staking epoch RLP", "epoch", eid, "err", err)
+		return errors.New(fmt.Sprintf("Invalid staking epoch RLP %s", err.Error()))
+	}
+	var entry *impawnEpochEntry
+	if cc, ok := IC.Cache.Get(index.Digest); ok {
+		entry, _ = cc.(*impawnEpochEntry)
+	}
+	if entry != nil {
+		entry = entry.clone()
+	} else {
+		var err error
+		if entry, err = loadEpoch(i.db, i.preAddress, eid, &index); err != nil {
+			log.Error("Invalid staking epoch", "epoch", eid, "err", err)
+			return err
+		}
+		IC.Cache.Add(index.Digest, entry.clone())
+	}
+	i.accounts[eid] = entry.accounts
+	i.slots[key] = crypto.Keccak256Hash(data)
+	for k, v := range entry.slots {
+		i.slots[k] = v
+	}
+	return nil
+}
+
+// epochAccounts returns the accounts of epoch eid, the sharded state reads them
+// the first time they're used. An epoch that can't be read is left empty, the
+// error is kept and returned by Save.
+func (i *ImpawnImpl) epochAccounts(eid uint64) (SAImpawns, bool) {
+	if _, ok := i.unloaded[eid]; ok {
+		delete(i.unloaded, eid)
+		if err := i.loadEpochAccounts(eid); err != nil {
+			if i.err == nil {
+				i.err = err
+			}
+			i.accounts[eid] = SAImpawns{}
+		}
+	}
+	sas, ok := i.accounts[eid]
+	return sas, ok
+}
+
+// epochIDs returns the epochs held by the staking state in order, read or not.
+func (i *ImpawnImpl) epochIDs() []uint64 {
+	var eids []uint64
+	for eid := range i.accounts {
+		eids = append(eids, eid)
+	}
+	for eid := range i.unloaded {
+		eids = append(eids, eid)
+	}
+	sort.Slice(eids, func(m, n int) bool { return eids[m] < eids[n] })
+	return eids
+}
+
+// dropEpoch removes the accounts of epoch eid, it is read first so that Save
+// knows the slots to clear.
+func (i *ImpawnImpl) dropEpoch(eid uint64) {
+	if _, ok := i.epochAccounts(eid); ok {
+		delete(i.accounts, eid)
+	}
+}
+
+// Error returns the first error met reading the staking state on demand.
+func (i *ImpawnImpl) Error() error {
+	return i.err
+}
+
+// saveSharded writes back the slots of the epochs read that changed since they
+// were loaded and clears the slots of the accounts gone, the epochs not read
+// are left alone.
+func (i *ImpawnImpl) saveSharded(state StateDB, preAddress common.Address) error {
+	if i.err != nil {
+		return i.err
+	}
+	meta := extImpawnMeta{CurEpochID: i.curEpochID, LastReward: i.lastReward, Epochs: i.epochIDs()}
+
+	slots := make(map[common.Hash]common.Hash)
+	write := func(key common.Hash, data []byte) {
+		hash := crypto.Keccak256Hash(data)
+		if old, ok := i.slots[key]; !ok || old != hash {
+			state.SetPOSState(preAddress, key, data)
+		}
+		slots[key] = hash
+	}
+	for _, eid := range meta.Epochs {
+		accounts, ok := i.accounts[eid]
+		if !ok {
+			continue
+		}
+		items, index, err := encodeEpoch(eid, accounts)
+		if err != nil {
+			log.Crit("Failed to RLP encode staking epoch", "epoch", eid, "err", err)
+		}
+		entry := &impawnEpochEntry{slots: make(map[common.Hash]common.Hash, len(items))}
+		for _, v := range items {
+			write(v.key, v.data)
+			entry.slots[v.key] = slots[v.key]
+		}
+		for _, v := range accounts {
+			entry.accounts = append(entry.accounts, v.clone())
+		}
+		IC.Cache.Add(index.Digest, entry)
+
+		data, err := rlp.EncodeToBytes(index)
+		if err != nil {
+			log.Crit("Failed to RLP encode staking epoch", "epoch", eid, "err", err)
+		}
+		write(impawnEpochKey(eid), data)
+	}
+	// the slots are deleted in order to keep the journal deterministic
+	var gone []common.Hash
+	for key := range i.slots {
+		if _, ok := slots[key]; !ok {
+			gone = append(gone, key)
+		}
+	}
+	sort.Slice(gone, func(m, n int) bool { return bytes.Compare(gone[m][:], gone[n][:]) < 0 })
+	for _, key := range gone {
+		state.SetPOSState(preAddress, key, nil)
+	}
+	data, err := rlp.EncodeToBytes(&meta)
+	if err != nil {
+		log.Crit("Failed to RLP encode staking meta", "err", err)
+	}
+	if !bytes.Equal(state.GetPOSState(preAddress, impawnMetaKey), data) {
+		state.SetPOSState(preAddress, impawnMetaKey, data)
+	}
+	i.slots = slots
+	return nil
+}
+
+// impawnEntryKey returns the slot of the entry of a side table of the staking
+// state, the slashing, signing records, validator infos, reward modes and
+// redelegations keep one entry per validator or delegation.
+func impawnEntryKey(table string, keys ...[]byte) common.Hash {
+	return crypto.Keccak256Hash(append([][]byte{[]byte(table)}, keys...)...)
+}
+
+// readEntry decodes the side table entry at key into val, it reports false if
+// there is none or the staking state wasn't loaded from a state.
+func (i *ImpawnImpl) readEntry(key common.Hash, val interface{}) bool {
+	if i.db == nil {
+		return false
+	}
+	data := i.db.GetPOSState(i.preAddress, key)
+	if len(data) == 0 {
+		return false
+	}
+	if err := rlp.DecodeBytes(data, val); err != nil {
+		log.Error("Invalid staking entry RLP", "key", key, "err", err)
+		if i.err == nil {
+			i.err = errors.New(fmt.Sprintf("Invalid staking entry RLP %s", err.Error()))
+		}
+		return false
+	}
+	return true
+}
+
+// writeEntry stores the side table entry val at key if it changed, a nil val
+// clears the slot.
+func writeEntry(state StateDB, preAddress common.Address, key common.Hash, val interface{}) {
+	var data []byte
+	if val != nil {
+		enc, err := rlp.EncodeToBytes(val)
+		if err != nil {
+			log.Crit("Failed to RLP encode staking entry", "key", key, "err", err)
+		}
+		data = enc
+	}
+	if !bytes.Equal(state.GetPOSState(preAddress, key), data) {
+		state.SetPOSState(preAddress, key, data)
+	}
+}
+
+// sortAddresses sorts addrs in place and returns them.
+func sortAddresses(addrs []common.Address) []common.Address {
+	sort.Slice(addrs, func(m, n int) bool { return bytes.Compare(addrs[m][:], addrs[n][:]) < 0 })
+	return addrs
+}
+
+// StakingSlotKeys returns the keys of the POS slots of preAddress that hold the
+// staking records of addr in the current epoch, as a validator and as a
+// delegator, together with the slots indexing them. Before the staking trie
+// fork the whole staking state is kept in one slot.
+func StakingSlotKeys(state StateDB, preAddress, addr common.Address) ([]common.Hash, error) {
+	if !IsImpawnSharded(state, preAddress) {
+		return []common.Hash{common.BytesToHash(preAddress[:])}, nil
+	}
+	i := NewImpawnImpl()
+	if err := i.Load(state, preAddress); err != nil {
+		return nil, err
+	}
+	eid := i.curEpochID
+	keys := []common.Hash{impawnMetaKey, impawnEpochKey(eid)}
+	accounts, _ := i.epochAccounts(eid)
+	for _, sa := range accounts {
+		saAddr := sa.Unit.Address
+		if saAddr == addr {
+			keys = append(keys, impawnSAKey(eid, saAddr))
+		}
+		for _, da := range sa.Delegation {
+			if da.Unit.Address == addr {
+				keys = append(keys, impawnDAKey(eid, saAddr, addr))
+			}
+		}
+	}
+	return keys, nil
+}
+
+// StakingElectionKeys returns the keys of the POS slots of preAddress the
+// committee elected for epochid is read from. The slots are read bypassing the
+// cache, so that a proof recorded while reading them holds them all.
+func StakingElectionKeys(state StateDB, preAddress common.Address, epochid uint64) ([]common.Hash, error) {
+	if !IsImpawnSharded(state, preAddress) {
+		return []common.Hash{common.BytesToHash(preAddress[:])}, nil
+	}
+	var meta extImpawnMeta
+	if err := rlp.DecodeBytes(state.GetPOSState(preAddress, impawnMetaKey), &meta); err != nil {
+		return nil, err
+	}
+	eid := epochid
+	if eid >= params.FirstNewEpochID {
+		eid = eid - 1
+	}
+	keys := []common.Hash{impawnMetaKey}
+	for _, v := range meta.Epochs {
+		if v != eid {
+			continue
+		}
+		key := impawnEpochKey(eid)
+		var index extImpawnEpoch
+		if err := rlp.DecodeBytes(state.GetPOSState(preAddress, key), &index); err != nil {
+			return nil, err
+		}
+		entry, err := loadEpoch(state, preAddress, eid, &index)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+		for k := range entry.slots {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys[2:], func(m, n int) bool {
+			return bytes.Compare(keys[2+m][:], keys[2+n][:]) < 0
+		})
+	}
+	return keys, nil
+}
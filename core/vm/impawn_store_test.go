@@ -0,0 +1,198 @@
+package vm
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/core/state"
+	"git.taiyue.io/pist/go-pist/core/types"
+	"git.taiyue.io/pist/go-pist/crypto"
+	"git.taiyue.io/pist/go-pist/pistdb"
+	"git.taiyue.io/pist/go-pist/trie"
+)
+
+// countingStateDB records the POS slots written.
+type countingStateDB struct {
+	*state.StateDB
+	written map[common.Hash]bool
+}
+
+func (db *countingStateDB) SetPOSState(addr common.Address, key common.Hash, value []byte) {
+	db.written[key] = true
+	db.StateDB.SetPOSState(addr, key, value)
+}
+
+func TestImpawnShardedLayout(t *testing.T) {
+	impl := NewImpawnImpl()
+	amount := new(big.Int).Mul(big.NewInt(20000), big.NewInt(1e18))
+	sas := insertValidators(t, impl, 20000, 20000, 20000)
+	delegators := []common.Address{{0x01}, {0x02}}
+	for _, da := range delegators {
+		if err := impl.InsertDAccount2(0, sas[0], da, amount); err != nil {
+			t.Fatal(err)
+		}
+	}
+	db, _ := state.New(common.Hash{}, state.NewDatabase(pistdb.NewMemDatabase()))
+	impl.Save(db, types.StakingAddress)
+
+	if err := MigrateImpawnState(db, types.StakingAddress); err != nil {
+		t.Fatal(err)
+	}
+	if !IsImpawnSharded(db, types.StakingAddress) {
+		t.Fatal("state not migrated")
+	}
+	if blob := db.GetPOSState(types.StakingAddress, common.BytesToHash(types.StakingAddress[:])); len(blob) != 0 {
+		t.Fatal("blob left after the migration")
+	}
+	loaded := NewImpawnImpl()
+	if err := loaded.Load(db, types.StakingAddress); err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Counts() != impl.Counts() || loaded.curEpochID != impl.curEpochID {
+		t.Fatalf("loaded state mismatch: have %d accounts, want %d", loaded.Counts(), impl.Counts())
+	}
+	for _, addr := range sas {
+		want, _ := impl.GetStakingAccount(impl.curEpochID, addr)
+		have, err := loaded.GetStakingAccount(loaded.curEpochID, addr)
+		if err != nil || !reflect.DeepEqual(have.getAllStaking(10), want.getAllStaking(10)) {
+			t.Fatalf("staking account %x mismatch", addr)
+		}
+	}
+
+	// Only the changed delegation and the epoch index are written back
+	counting := &countingStateDB{StateDB: db, written: make(map[common.Hash]bool)}
+	if err := loaded.InsertDAccount2(0, sas[0], delegators[1], amount); err != nil {
+		t.Fatal(err)
+	}
+	loaded.Save(counting, types.StakingAddress)
+	eid := loaded.curEpochID
+	want := map[common.Hash]bool{
+		impawnDAKey(eid, sas[0], delegators[1]): true,
+		impawnEpochKey(eid):                     true,
+	}
+	if !reflect.DeepEqual(counting.written, want) {
+		t.Fatalf("written slots mismatch: have %d, want %d", len(counting.written), len(want))
+	}
+
+	// The slots of a dropped delegation are cleared
+	sa, _ := loaded.GetStakingAccount(eid, sas[0])
+	sa.Delegation = sa.Delegation[:1]
+	loaded.Save(db, types.StakingAddress)
+	if data := db.GetPOSState(types.StakingAddress, impawnDAKey(eid, sas[0], delegators[1])); len(data) != 0 {
+		t.Fatal("slot of the dropped delegation left")
+	}
+	reloaded := NewImpawnImpl()
+	if err := reloaded.Load(db, types.StakingAddress); err != nil {
+		t.Fatal(err)
+	}
+	if reloaded.Counts() != impl.Counts()-1 {
+		t.Fatalf("reloaded accounts mismatch: have %d, want %d", reloaded.Counts(), impl.Counts()-1)
+	}
+}
+
+func TestStakingSlotKeys(t *testing.T) {
+	impl := NewImpawnImpl()
+	amount := new(big.Int).Mul(big.NewInt(20000), big.NewInt(1e18))
+	sa := insertValidators(t, impl, 20000)[0]
+	da := common.Address{0x01}
+	if err := impl.InsertDAccount2(0, sa, da, amount); err != nil {
+		t.Fatal(err)
+	}
+	db, _ := state.New(common.Hash{}, state.NewDatabase(pistdb.NewMemDatabase()))
+	impl.Save(db, types.StakingAddress)
+	if keys, _ := StakingSlotKeys(db, types.StakingAddress, sa); len(keys) != 1 || keys[0] != common.BytesToHash(types.StakingAddress[:]) {
+		t.Fatalf("blob key mismatch: have %v", keys)
+	}
+	if err := MigrateImpawnState(db, types.StakingAddress); err != nil {
+		t.Fatal(err)
+	}
+	eid := impl.curEpochID
+	for addr, want := range map[common.Address][]common.Hash{
+		sa:                {impawnMetaKey, impawnEpochKey(eid), impawnSAKey(eid, sa)},
+		da:                {impawnMetaKey, impawnEpochKey(eid), impawnDAKey(eid, sa, da)},
+		common.Address{2}: {impawnMetaKey, impawnEpochKey(eid)},
+	} {
+		keys, err := StakingSlotKeys(db, types.StakingAddress, addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(keys, want) {
+			t.Fatalf("keys of %x mismatch: have %v, want %v", addr, keys, want)
+		}
+	}
+
+	// The slots are proven against the storage root of the staking address
+	root := db.StorageTrie(types.StakingAddress).Hash()
+	keys, _ := StakingSlotKeys(db, types.StakingAddress, da)
+	for _, key := range keys {
+		proof, err := db.GetStorageProof(types.StakingAddress, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		proofDb := pistdb.NewMemDatabase()
+		for _, node := range proof {
+			proofDb.Put(crypto.Keccak256(node), node)
+		}
+		value, _, err := trie.VerifyProof(root, crypto.Keccak256(key[:]), proofDb)
+		if err != nil {
+			t.Fatalf("proof of %x: %v", key, err)
+		}
+		if !reflect.DeepEqual(value, db.GetPOSState(types.StakingAddress, key)) {
+			t.Fatalf("proven value of %x mismatch", key)
+		}
+	}
+}
+
+func TestImpawnLoadOnDemand(t *testing.T) {
+	impl := NewImpawnImpl()
+	sa := insertValidators(t, impl, 20000)[0]
+	if _, err := impl.DoElections(1, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := impl.Shift(1); err != nil {
+		t.Fatal(err)
+	}
+	db, _ := state.New(common.Hash{}, state.NewDatabase(pistdb.NewMemDatabase()))
+	impl.sharded = true
+	impl.Save(db, types.StakingAddress)
+
+	loaded := NewImpawnImpl()
+	if err := loaded.Load(db, types.StakingAddress); err != nil {
+		t.Fatal(err)
+	}
+	if len(loaded.unloaded) != 2 {
+		t.Fatalf("epochs read on load: %d left", len(loaded.unloaded))
+	}
+	if _, err := loaded.GetStakingAccount(1, sa); err != nil {
+		t.Fatal("staking account of the current epoch missing")
+	}
+	if _, ok := loaded.unloaded[0]; !ok {
+		t.Fatal("epoch not used was read")
+	}
+
+	// Only the slot of the changed description is written back
+	counting := &countingStateDB{StateDB: db, written: make(map[common.Hash]bool)}
+	desc := &Description{Name: "validator"}
+	if err := loaded.SetDescription(types.GetEpochFromID(1).BeginHeight, sa, desc); err != nil {
+		t.Fatal(err)
+	}
+	if err := loaded.Save(counting, types.StakingAddress); err != nil {
+		t.Fatal(err)
+	}
+	want := map[common.Hash]bool{validatorInfoKey(sa): true}
+	if !reflect.DeepEqual(counting.written, want) {
+		t.Fatalf("written slots mismatch: have %d, want %d", len(counting.written), len(want))
+	}
+	reloaded := NewImpawnImpl()
+	if err := reloaded.Load(db, types.StakingAddress); err != nil {
+		t.Fatal(err)
+	}
+	if reloaded.Counts() != impl.Counts() {
+		t.Fatalf("accounts mismatch: have %d, want %d", reloaded.Counts(), impl.Counts())
+	}
+	if have := reloaded.GetDescription(sa); !reflect.DeepEqual(have, desc) {
+		t.Fatalf("description mismatch: have %v, want %v", have, desc)
+	}
+}
@@ -0,0 +1,299 @@
+package vm
+
+import (
+	"errors"
+	"math/big"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/core/types"
+	"git.taiyue.io/pist/go-pist/log"
+	"git.taiyue.io/pist/go-pist/params"
+)
+
+// redelegatorsKey is the key of the index of the delegators with redelegations
+// within the redeem period, the redelegations of a delegator are kept in the
+// slot of redelegationKey.
+var redelegatorsKey = impawnEntryKey("redelegators")
+
+func redelegationKey(addr common.Address) common.Hash {
+	return impawnEntryKey("redelegation", addr[:])
+}
+
+var (
+	ErrRedelegateSelf       = errors.New("redelegation to the same validator")
+	ErrRedelegationHop      = errors.New("redelegation of stake redelegated within the redeem period")
+	ErrTooManyRedelegations = errors.New("too many redelegations within the redeem period")
+)
+
+// Redelegation moves Amount of the delegation of Delegator from the staking
+// account From to To at the beginning of the epoch after Epoch. Until the end
+// of the redeem period the moved stake answers for the misbehaviours of From
+// committed before Height.
+type Redelegation struct {
+	Delegator common.Address
+	From      common.Address
+	To        common.Address
+	Amount    *big.Int
+	Epoch     uint64
+	Height    uint64
+	Moved     bool
+}
+
+// mature reports whether the redeem period of the redelegation is over at
+// target with the epoch lengths schedule lengths, the same period as for an
+// undelegation of the stake.
+func (r *Redelegation) mature(lengths []types.EpochLength, target uint64) bool {
+	e := types.EpochFromID(lengths, r.Epoch+1)
+	return target > e.BeginHeight+params.MaxRedeemHeight
+}
+
+func (r *Redelegation) clone() *Redelegation {
+	tmp := *r
+	tmp.Amount = new(big.Int).Set(r.Amount)
+	return &tmp
+}
+
+// Redelegate moves amount of the delegation of addr to from to the staking
+// account to, from the next epoch on. The stake keeps earning with from until
+// then, it can't be redelegated again from to before the redeem period ends.
+func (i *ImpawnImpl) Redelegate(height uint64, from, to, addr common.Address, amount *big.Int) error {
+	if amount.Sign() <= 0 || height <= 0 {
+		return types.ErrInvalidParam
+	}
+	if from == to {
+		return ErrRedelegateSelf
+	}
+	if to == addr {
+		return types.ErrDelegationSelf
+	}
+	curEpoch := i.epochFromHeight(height)
+	if curEpoch == nil || curEpoch.EpochID != i.curEpochID {
+		return types.ErrInvalidParam
+	}
+	sa, err := i.GetStakingAccount(curEpoch.EpochID, from)
+	if err != nil {
+		return err
+	}
+	da := sa.getDA(addr)
+	if da == nil {
+		return types.ErrNotDelegation
+	}
+	if _, err := i.GetStakingAccount(curEpoch.EpochID, to); err != nil {
+		return err
+	}
+	if i.isJailed(to, curEpoch.EpochID) {
+		return types.ErrValidatorJailed
+	}
+	entries := 0
+	for _, r := range i.GetRedelegations(addr) {
+		if r.mature(i.lengths, height) {
+			continue
+		}
+		if r.To == from {
+			return ErrRedelegationHop
+		}
+		if r.From == from && r.To == to {
+			entries++
+		}
+	}
+	if entries >= params.MaxRedelegationEntries {
+		return ErrTooManyRedelegations
+	}
+	// the stake leaves from as an undelegation, which is given to to at the shift
+	if err := da.stopStakingInfo(amount, new(big.Int).SetUint64(height)); err != nil {
+		return err
+	}
+	i.getRedelegators()[addr] = struct{}{}
+	i.redelegations[addr] = append(i.redelegations[addr], &Redelegation{
+		Delegator: addr,
+		From:      from,
+		To:        to,
+		Amount:    new(big.Int).Set(amount),
+		Epoch:     curEpoch.EpochID,
+		Height:    height,
+	})
+	return nil
+}
+
+// shiftRedelegations moves the stake redelegated in prev to the accounts of
+// next, the stake leaving an account that is gone stays an undelegation of the
+// source. The redelegations past their redeem period are dropped.
+func (i *ImpawnImpl) shiftRedelegations(prev, next uint64) {
+	nextEpoch := i.epochFromID(next)
+	for _, addr := range i.sortedRedelegators() {
+		var keep []*Redelegation
+		for _, r := range i.GetRedelegations(addr) {
+			if r.mature(i.lengths, nextEpoch.BeginHeight) {
+				continue
+			}
+			if r.Moved || r.Epoch != prev {
+				keep = append(keep, r)
+				continue
+			}
+			src, err := i.GetStakingAccount(next, r.From)
+			if err != nil {
+				continue
+			}
+			da := src.getDA(r.Delegator)
+			if da == nil {
+				continue
+			}
+			redeem := da.Unit.getRedeemItem(prev)
+			if redeem == nil {
+				continue
+			}
+			if _, err := i.GetStakingAccount(next, r.To); err != nil {
+				log.Warn("Redelegation target gone", "delegator", r.Delegator, "from", r.From, "to", r.To, "amount", r.Amount)
+				continue
+			}
+			if redeem.Amount.Cmp(r.Amount) < 0 {
+				r.Amount = new(big.Int).Set(redeem.Amount)
+			}
+			redeem.Amount = new(big.Int).Sub(redeem.Amount, r.Amount)
+			if r.Amount.Sign() > 0 {
+				if err := i.InsertDAccount2(nextEpoch.BeginHeight, r.To, r.Delegator, r.Amount); err != nil {
+					log.Error("Redelegation insert error", "delegator", r.Delegator, "to", r.To, "amount", r.Amount, "err", err)
+					redeem.Amount = new(big.Int).Add(redeem.Amount, r.Amount)
+					continue
+				}
+			}
+			r.Moved = true
+			keep = append(keep, r)
+		}
+		i.redelegations[addr] = keep
+	}
+}
+
+// slashRedelegations applies the slashing of the validator addr for a
+// misbehaviour at infraction to the stake redelegated from it. The stake not
+// moved yet is a part of the source delegation and was scaled down with it, the
+// moved stake is taken from the target delegation if it left after infraction.
+func (i *ImpawnImpl) slashRedelegations(addr common.Address, infraction uint64, rate *big.Int, amounts map[common.Address]*big.Int) {
+	var rs []*Redelegation
+	for _, delegator := range i.sortedRedelegators() {
+		rs = append(rs, i.GetRedelegations(delegator)...)
+	}
+	for _, r := range rs {
+		if r.From != addr {
+			continue
+		}
+		cut := new(big.Int).Quo(new(big.Int).Mul(r.Amount, rate), types.Base)
+		if !r.Moved {
+			r.Amount = new(big.Int).Sub(r.Amount, cut)
+			continue
+		}
+		if r.Height < infraction || cut.Sign() <= 0 {
+			continue
+		}
+		sa, err := i.GetStakingAccount(i.curEpochID, r.To)
+		if err != nil {
+			continue
+		}
+		da := sa.getDA(r.Delegator)
+		if da == nil {
+			continue
+		}
+		cut = da.Unit.take(cut)
+		r.Amount = new(big.Int).Sub(r.Amount, cut)
+		if cut.Sign() > 0 {
+			if amounts[r.Delegator] == nil {
+				amounts[r.Delegator] = new(big.Int)
+			}
+			amounts[r.Delegator].Add(amounts[r.Delegator], cut)
+		}
+	}
+}
+
+// take removes up to amount of the staking value of the unit, the latest first,
+// and returns the amount removed.
+func (s *impawnUnit) take(amount *big.Int) *big.Int {
+	left := new(big.Int).Set(amount)
+	for k := len(s.Value) - 1; k >= 0 && left.Sign() > 0; k-- {
+		v := s.Value[k]
+		c := left
+		if v.Amount.Cmp(c) < 0 {
+			c = v.Amount
+		}
+		v.Amount = new(big.Int).Sub(v.Amount, c)
+		left = new(big.Int).Sub(left, c)
+	}
+	return new(big.Int).Sub(amount, left)
+}
+
+// GetRedelegations returns the redelegations of addr within the redeem period.
+func (i *ImpawnImpl) GetRedelegations(addr common.Address) []*Redelegation {
+	rs, ok := i.redelegations[addr]
+	if !ok {
+		if !i.readEntry(redelegationKey(addr), &rs) {
+			rs = nil
+		}
+		i.redelegations[addr] = rs
+	}
+	return rs
+}
+
+// getRedelegators returns the index of the delegators with redelegations, read
+// from the state the first time.
+func (i *ImpawnImpl) getRedelegators() map[common.Address]struct{} {
+	if i.redelegators == nil {
+		var addrs []common.Address
+		i.readEntry(redelegatorsKey, &addrs)
+		i.redelegators = make(map[common.Address]struct{}, len(addrs))
+		for _, addr := range addrs {
+			i.redelegators[addr] = struct{}{}
+		}
+	}
+	return i.redelegators
+}
+
+func (i *ImpawnImpl) sortedRedelegators() []common.Address {
+	var addrs []common.Address
+	for k := range i.getRedelegators() {
+		addrs = append(addrs, k)
+	}
+	return sortAddresses(addrs)
+}
+
+func (i *ImpawnImpl) saveRedelegations(state StateDB, preAddress common.Address) {
+	var addrs []common.Address
+	for k := range i.redelegations {
+		addrs = append(addrs, k)
+	}
+	for _, addr := range sortAddresses(addrs) {
+		if rs := i.redelegations[addr]; len(rs) == 0 {
+			if i.redelegators != nil {
+				delete(i.redelegators, addr)
+			}
+			writeEntry(state, preAddress, redelegationKey(addr), nil)
+		} else {
+			writeEntry(state, preAddress, redelegationKey(addr), rs)
+		}
+	}
+	if i.redelegators == nil {
+		return
+	}
+	if index := i.sortedRedelegators(); len(index) == 0 {
+		writeEntry(state, preAddress, redelegatorsKey, nil)
+	} else {
+		writeEntry(state, preAddress, redelegatorsKey, index)
+	}
+}
+
+// redelegationDisplay returns the redelegations of addr leaving the staking
+// account holder for the RPC.
+func (i *ImpawnImpl) redelegationDisplay(holder, addr common.Address) []map[string]interface{} {
+	attrs := make([]map[string]interface{}, 0)
+	for _, r := range i.GetRedelegations(addr) {
+		if r.From != holder {
+			continue
+		}
+		attrs = append(attrs, map[string]interface{}{
+			"to":     r.To,
+			"amount": weiToTrue(r.Amount),
+			"epoch":  r.Epoch,
+			"height": r.Height,
+			"moved":  r.Moved,
+		})
+	}
+	return attrs
+}
@@ -0,0 +1,104 @@
+package vm
+
+import (
+	"math/big"
+	"testing"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/core/state"
+	"git.taiyue.io/pist/go-pist/core/types"
+	"git.taiyue.io/pist/go-pist/params"
+	"git.taiyue.io/pist/go-pist/pistdb"
+)
+
+func TestRedelegate(t *testing.T) {
+	impl := NewImpawnImpl()
+	vals := insertValidators(t, impl, 20000, 20000, 20000)
+	delegator := common.Address{1}
+	amount := new(big.Int).Mul(big.NewInt(1000), big.NewInt(1e18))
+	half := new(big.Int).Div(amount, big.NewInt(2))
+	if err := impl.InsertDAccount2(0, vals[0], delegator, amount); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := impl.DoElections(1, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := impl.Shift(1); err != nil {
+		t.Fatal(err)
+	}
+	height := types.GetEpochFromID(1).BeginHeight + 1
+
+	if err := impl.Redelegate(height, vals[0], vals[0], delegator, half); err != ErrRedelegateSelf {
+		t.Fatalf("expected self redelegation error, got %v", err)
+	}
+	if err := impl.Redelegate(height, vals[0], vals[1], delegator, new(big.Int).Add(amount, big.NewInt(1))); err != types.ErrAmountOver {
+		t.Fatalf("expected amount error, got %v", err)
+	}
+	if err := impl.Redelegate(height, vals[0], vals[1], delegator, half); err != nil {
+		t.Fatal(err)
+	}
+	// the stake leaves the source at once, it joins the target at the next epoch
+	src, _ := impl.GetStakingAccount(1, vals[0])
+	if have := src.getDA(delegator).getValidStaking(height); have.Cmp(half) != 0 {
+		t.Fatalf("source stake mismatch: have %v, want %v", have, half)
+	}
+	if err := impl.Shift(2); err != nil {
+		t.Fatal(err)
+	}
+	next := types.GetEpochFromID(2)
+	height = next.BeginHeight + 1
+	dst, _ := impl.GetStakingAccount(2, vals[1])
+	if da := dst.getDA(delegator); da == nil || da.getValidStaking(height).Cmp(half) != 0 {
+		t.Fatalf("redelegated stake missing from the target: %v", da)
+	}
+	src, _ = impl.GetStakingAccount(2, vals[0])
+	if have := src.getDA(delegator).Unit.getValidRedeem(next.EndHeight + params.MaxRedeemHeight); have.Sign() != 0 {
+		t.Fatalf("redelegated stake redeemable from the source: %v", have)
+	}
+
+	// The moved stake can't hop again, nor go to a jailed validator
+	if err := impl.Redelegate(height, vals[1], vals[2], delegator, big.NewInt(1)); err != ErrRedelegationHop {
+		t.Fatalf("expected hop error, got %v", err)
+	}
+	impl.getSlashState(vals[2]).JailedUntil = 3
+	if err := impl.Redelegate(height, vals[0], vals[2], delegator, big.NewInt(1)); err != types.ErrValidatorJailed {
+		t.Fatalf("expected jailed target error, got %v", err)
+	}
+	defer func(entries int) { params.MaxRedelegationEntries = entries }(params.MaxRedelegationEntries)
+	params.MaxRedelegationEntries = 1
+	if err := impl.Redelegate(height, vals[0], vals[1], delegator, big.NewInt(1)); err != ErrTooManyRedelegations {
+		t.Fatalf("expected entries error, got %v", err)
+	}
+
+	// The redelegations survive a reload
+	db := pistdb.NewMemDatabase()
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(db))
+	impl.Save(statedb, types.StakingAddress)
+	impl = NewImpawnImpl()
+	if err := impl.Load(statedb, types.StakingAddress); err != nil {
+		t.Fatal(err)
+	}
+	if rs := impl.GetRedelegations(delegator); len(rs) != 1 || !rs[0].Moved {
+		t.Fatalf("redelegations mismatch: %v", rs)
+	}
+
+	// A misbehaviour of the source after the redelegation leaves the moved stake alone
+	rate := uint64(1000)
+	src, _ = impl.GetStakingAccount(2, vals[0])
+	_, amounts := impl.slash(height, height, src, types.EvidenceDuplicateVote, rate, 1)
+	cut := new(big.Int).Quo(new(big.Int).Mul(half, new(big.Int).SetUint64(rate)), types.Base)
+	if amounts[delegator].Cmp(cut) != 0 {
+		t.Fatalf("slashed amount mismatch: have %v, want %v", amounts[delegator], cut)
+	}
+	// one before it slashes the moved stake too
+	left := new(big.Int).Sub(half, cut)
+	_, amounts = impl.slash(height, 1, src, types.EvidenceDuplicateVote, rate, 1)
+	leftCut := new(big.Int).Quo(new(big.Int).Mul(left, new(big.Int).SetUint64(rate)), types.Base)
+	if want := new(big.Int).Add(leftCut, cut); amounts[delegator].Cmp(want) != 0 {
+		t.Fatalf("slashed amount mismatch: have %v, want %v", amounts[delegator], want)
+	}
+	dst, _ = impl.GetStakingAccount(2, vals[1])
+	if have := dst.getDA(delegator).getAllStaking(height); have.Cmp(left) != 0 {
+		t.Fatalf("target stake mismatch: have %v, want %v", have, left)
+	}
+}
@@ -0,0 +1,183 @@
+package vm
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"sort"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/core/types"
+	"git.taiyue.io/pist/go-pist/log"
+)
+
+// Reward modes of a delegation.
+const (
+	RewardPay     uint8 = iota // the rewards are paid to the delegator every block
+	RewardRestake              // the rewards are added to the delegation at the end of the epoch
+	RewardAccrue               // the rewards are kept until the delegator claims them
+)
+
+var (
+	ErrInvalidRewardMode = errors.New("invalid reward mode")
+	ErrNoPendingReward   = errors.New("no pending reward")
+)
+
+type delegationKey struct {
+	holder, delegator common.Address
+}
+
+// delegationReward is the reward mode of the delegation of Delegator to the
+// staking account Holder, with the rewards not paid yet.
+type delegationReward struct {
+	Holder    common.Address
+	Delegator common.Address
+	Mode      uint8
+	Pending   *big.Int
+}
+
+func (d *delegationReward) clone() *delegationReward {
+	return &delegationReward{
+		Holder:    d.Holder,
+		Delegator: d.Delegator,
+		Mode:      d.Mode,
+		Pending:   new(big.Int).Set(d.Pending),
+	}
+}
+
+func delegationRewardKey(holder, delegator common.Address) common.Hash {
+	return impawnEntryKey("delegationReward", holder[:], delegator[:])
+}
+
+// empty reports whether the entry is left without any use, its slot is cleared.
+func (d *delegationReward) empty() bool {
+	return d.Mode == RewardPay && d.Pending.Sign() == 0
+}
+
+// getReward returns the reward mode of the delegation of key, read from the
+// state the first time.
+func (i *ImpawnImpl) getReward(key delegationKey) *delegationReward {
+	d, ok := i.rewards[key]
+	if !ok {
+		d = new(delegationReward)
+		if !i.readEntry(delegationRewardKey(key.holder, key.delegator), d) {
+			d = &delegationReward{Holder: key.holder, Delegator: key.delegator, Pending: new(big.Int)}
+		}
+		i.rewards[key] = d
+	}
+	return d
+}
+
+// SetRewardMode sets how the rewards of the delegation of addr to holder are paid,
+// the rewards pending stay claimable whatever the mode.
+func (i *ImpawnImpl) SetRewardMode(height uint64, holder, addr common.Address, mode uint8) error {
+	if mode > RewardAccrue {
+		return ErrInvalidRewardMode
+	}
+	epochInfo := i.epochFromHeight(height)
+	if epochInfo.EpochID > i.getCurrentEpoch() {
+		return types.ErrOverEpochID
+	}
+	sa, err := i.GetStakingAccount(epochInfo.EpochID, holder)
+	if err != nil {
+		return err
+	}
+	if sa.getDA(addr) == nil {
+		return types.ErrNotDelegation
+	}
+	i.getReward(delegationKey{holder, addr}).Mode = mode
+	return nil
+}
+
+// GetRewardMode returns the reward mode of the delegation of addr to holder.
+func (i *ImpawnImpl) GetRewardMode(holder, addr common.Address) uint8 {
+	return i.getReward(delegationKey{holder, addr}).Mode
+}
+
+// GetPendingReward returns the rewards of the delegation of addr to holder not
+// paid yet.
+func (i *ImpawnImpl) GetPendingReward(holder, addr common.Address) *big.Int {
+	return new(big.Int).Set(i.getReward(delegationKey{holder, addr}).Pending)
+}
+
+// AccrueReward keeps the reward of the delegation of addr to holder when it
+// isn't paid every block, it returns false if the reward is to be paid.
+func (i *ImpawnImpl) AccrueReward(holder, addr common.Address, amount *big.Int) bool {
+	d := i.getReward(delegationKey{holder, addr})
+	if d.Mode == RewardPay {
+		return false
+	}
+	d.Pending.Add(d.Pending, amount)
+	return true
+}
+
+// ClaimReward takes the pending rewards of the delegation of addr to holder, the
+// caller pays them.
+func (i *ImpawnImpl) ClaimReward(holder, addr common.Address) (*big.Int, error) {
+	d := i.getReward(delegationKey{holder, addr})
+	if d.Pending.Sign() == 0 {
+		return nil, ErrNoPendingReward
+	}
+	amount := d.Pending
+	d.Pending = new(big.Int)
+	return amount, nil
+}
+
+// RestakeRewards adds the pending rewards of the restaking delegations to their
+// stake at height, the end of the epoch. The rewards are paid to the delegators
+// and locked as by a delegate call, the rewards of a delegation fully redeemed
+// stay pending to be claimed.
+func (i *ImpawnImpl) RestakeRewards(state StateDB, height uint64) {
+	epochInfo := i.epochFromHeight(height)
+	var keys []delegationKey
+	for _, sa := range i.GetStakingAccountsByEpoch(epochInfo.EpochID) {
+		for _, da := range sa.Delegation {
+			keys = append(keys, delegationKey{sa.Unit.Address, da.Unit.Address})
+		}
+	}
+	for _, key := range sortDelegationKeys(keys) {
+		d := i.getReward(key)
+		if d.Mode != RewardRestake || d.Pending.Sign() == 0 {
+			continue
+		}
+		sa, err := i.GetStakingAccount(epochInfo.EpochID, d.Holder)
+		if err != nil {
+			continue
+		}
+		if da := sa.getDA(d.Delegator); da == nil || da.getAllStaking(height).Sign() <= 0 {
+			continue
+		}
+		if err := i.InsertDAccount2(height, d.Holder, d.Delegator, d.Pending); err != nil {
+			log.Error("Restake reward", "holder", d.Holder, "delegator", d.Delegator, "amount", d.Pending, "err", err)
+			continue
+		}
+		state.AddBalance(d.Delegator, d.Pending)
+		addLockedBalance(state, d.Delegator, d.Pending)
+		log.Debug("Restake reward", "holder", d.Holder, "delegator", d.Delegator, "amount", d.Pending)
+		d.Pending = new(big.Int)
+	}
+}
+
+func sortDelegationKeys(keys []delegationKey) []delegationKey {
+	sort.Slice(keys, func(m, n int) bool {
+		if c := bytes.Compare(keys[m].holder[:], keys[n].holder[:]); c != 0 {
+			return c < 0
+		}
+		return bytes.Compare(keys[m].delegator[:], keys[n].delegator[:]) < 0
+	})
+	return keys
+}
+
+func (i *ImpawnImpl) saveDelegationRewards(state StateDB, preAddress common.Address) {
+	var keys []delegationKey
+	for k := range i.rewards {
+		keys = append(keys, k)
+	}
+	for _, key := range sortDelegationKeys(keys) {
+		if v := i.rewards[key]; v.empty() {
+			writeEntry(state, preAddress, delegationRewardKey(key.holder, key.delegator), nil)
+		} else {
+			writeEntry(state, preAddress, delegationRewardKey(key.holder, key.delegator), v)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package vm
+
+import (
+	"math/big"
+	"testing"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/core/state"
+	"git.taiyue.io/pist/go-pist/core/types"
+	"git.taiyue.io/pist/go-pist/pistdb"
+)
+
+func TestDelegationRewardModes(t *testing.T) {
+	impl := NewImpawnImpl()
+	holder := insertValidators(t, impl, 20000)[0]
+	amount := new(big.Int).Mul(big.NewInt(20000), big.NewInt(1e18))
+	restaker, accruer := common.Address{1}, common.Address{2}
+	for _, addr := range []common.Address{restaker, accruer} {
+		if err := impl.InsertDAccount2(0, holder, addr, amount); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := impl.DoElections(1, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := impl.Shift(1); err != nil {
+		t.Fatal(err)
+	}
+	epoch := types.GetEpochFromID(1)
+	height := epoch.BeginHeight
+
+	if err := impl.SetRewardMode(height, holder, restaker, RewardAccrue+1); err != ErrInvalidRewardMode {
+		t.Fatalf("expected invalid mode error, got %v", err)
+	}
+	if err := impl.SetRewardMode(height, holder, common.Address{3}, RewardAccrue); err != types.ErrNotDelegation {
+		t.Fatalf("expected no delegation error, got %v", err)
+	}
+	if err := impl.SetRewardMode(height, holder, restaker, RewardRestake); err != nil {
+		t.Fatal(err)
+	}
+	if err := impl.SetRewardMode(height, holder, accruer, RewardAccrue); err != nil {
+		t.Fatal(err)
+	}
+	reward := big.NewInt(1e18)
+	for _, addr := range []common.Address{restaker, accruer} {
+		if !impl.AccrueReward(holder, addr, reward) {
+			t.Fatalf("reward of %x paid", addr)
+		}
+	}
+	if impl.AccrueReward(holder, common.Address{3}, reward) {
+		t.Fatal("reward of a paid delegation kept")
+	}
+
+	// The modes and the rewards survive a reload
+	db := pistdb.NewMemDatabase()
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(db))
+	impl.Save(statedb, types.StakingAddress)
+	impl = NewImpawnImpl()
+	if err := impl.Load(statedb, types.StakingAddress); err != nil {
+		t.Fatal(err)
+	}
+	if mode := impl.GetRewardMode(holder, accruer); mode != RewardAccrue {
+		t.Fatalf("reward mode mismatch: have %d, want %d", mode, RewardAccrue)
+	}
+
+	// The restaked reward is added to the delegation, the accrued one waits
+	impl.RestakeRewards(statedb, epoch.EndHeight)
+	sa, _ := impl.GetStakingAccount(1, holder)
+	want := new(big.Int).Add(amount, reward)
+	if have := sa.getDA(restaker).getAllStaking(epoch.EndHeight); have.Cmp(want) != 0 {
+		t.Fatalf("restaked delegation mismatch: have %v, want %v", have, want)
+	}
+	if statedb.GetBalance(restaker).Cmp(reward) != 0 || statedb.GetPOSLocked(restaker).Cmp(reward) != 0 {
+		t.Fatalf("restaked reward not locked: balance %v, locked %v", statedb.GetBalance(restaker), statedb.GetPOSLocked(restaker))
+	}
+	if have := impl.GetPendingReward(holder, restaker); have.Sign() != 0 {
+		t.Fatalf("restaked reward still pending: %v", have)
+	}
+	if have := impl.GetPendingReward(holder, accruer); have.Cmp(reward) != 0 {
+		t.Fatalf("pending reward mismatch: have %v, want %v", have, reward)
+	}
+
+	// Switching back to paid rewards keeps the pending ones claimable
+	if err := impl.SetRewardMode(height, holder, accruer, RewardPay); err != nil {
+		t.Fatal(err)
+	}
+	claimed, err := impl.ClaimReward(holder, accruer)
+	if err != nil || claimed.Cmp(reward) != 0 {
+		t.Fatalf("claim mismatch: have %v (%v), want %v", claimed, err, reward)
+	}
+	if _, err := impl.ClaimReward(holder, accruer); err != ErrNoPendingReward {
+		t.Fatalf("expected no pending reward error, got %v", err)
+	}
+	impl.Save(statedb, types.StakingAddress)
+	if data := statedb.GetPOSState(types.StakingAddress, delegationRewardKey(holder, accruer)); len(data) != 0 {
+		t.Fatal("paid delegation without pending rewards kept")
+	}
+}
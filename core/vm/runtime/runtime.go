@@ -52,7 +52,9 @@ type Config struct {
 func setDefaults(cfg *Config) {
 	if cfg.ChainConfig == nil {
 		cfg.ChainConfig = &params.ChainConfig{
-			ChainID: big.NewInt(1),
+			ChainID:       big.NewInt(1),
+			YoloV1Block:   new(big.Int),
+			SlashingBlock: new(big.Int),
 		}
 	}
 
@@ -0,0 +1,250 @@
+package vm
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/core/types"
+	"git.taiyue.io/pist/go-pist/log"
+	"git.taiyue.io/pist/go-pist/params"
+)
+
+// slashState is the slashing of a validator, kept in its own slot apart from
+// the accounts: the last epoch it is kept out of the elections and the records
+// still jailing it or matching an evidence young enough to be submitted.
+type slashState struct {
+	Address     common.Address
+	JailedUntil uint64
+	Records     []*types.SlashRecord
+}
+
+func slashingKey(addr common.Address) common.Hash {
+	return impawnEntryKey("slashing", addr[:])
+}
+
+func (s *slashState) clone() *slashState {
+	return &slashState{Address: s.Address, JailedUntil: s.JailedUntil, Records: types.CloneSlashRecords(s.Records)}
+}
+
+func (s *slashState) empty() bool {
+	return s.JailedUntil == 0 && len(s.Records) == 0
+}
+
+// getSlashState returns the slashing of addr, read from the state the first time.
+func (i *ImpawnImpl) getSlashState(addr common.Address) *slashState {
+	s, ok := i.slashes[addr]
+	if !ok {
+		s = new(slashState)
+		if !i.readEntry(slashingKey(addr), s) {
+			s = &slashState{Address: addr}
+		}
+		i.slashes[addr] = s
+	}
+	return s
+}
+
+// slash takes rate/Base of the staking and of the pending redemptions of the unit
+// and returns the amount taken. The redemption of the current epoch is a part of
+// the staking value, it is only scaled down with it.
+func (s *impawnUnit) slash(epochid uint64, rate *big.Int) *big.Int {
+	all, cut := big.NewInt(0), big.NewInt(0)
+	for _, v := range s.Value {
+		c := new(big.Int).Quo(new(big.Int).Mul(v.Amount, rate), types.Base)
+		v.Amount = new(big.Int).Sub(v.Amount, c)
+		all, cut = all.Add(all, v.Amount), cut.Add(cut, c)
+	}
+	for _, v := range s.RedeemInof {
+		if v.Amount.Sign() <= 0 {
+			continue
+		}
+		c := new(big.Int).Quo(new(big.Int).Mul(v.Amount, rate), types.Base)
+		v.Amount = new(big.Int).Sub(v.Amount, c)
+		if v.EpochID == epochid {
+			if v.Amount.Cmp(all) > 0 {
+				v.Amount = new(big.Int).Set(all)
+			}
+		} else {
+			cut = cut.Add(cut, c)
+		}
+	}
+	return cut
+}
+
+// slash takes rate/Base of the stake of the account and of its delegators, the
+// result is keyed by the owner of the stake.
+func (s *StakingAccount) slash(epochid uint64, rate *big.Int) map[common.Address]*big.Int {
+	res := make(map[common.Address]*big.Int)
+	if cut := s.Unit.slash(epochid, rate); cut.Sign() > 0 {
+		res[s.Unit.GetRewardAddress()] = cut
+	}
+	for _, v := range s.Delegation {
+		if cut := v.Unit.slash(epochid, rate); cut.Sign() > 0 {
+			res[v.Unit.GetRewardAddress()] = cut
+		}
+	}
+	return res
+}
+
+// isJailedFor reports whether addr is still jailed for a misbehaviour of kind,
+// an offence is only punished once while the validator is kept out.
+func (i *ImpawnImpl) isJailedFor(addr common.Address, kind uint8, epochid uint64) bool {
+	for _, v := range i.getSlashState(addr).Records {
+		if v.Kind == kind && epochid <= v.JailedUntil {
+			return true
+		}
+	}
+	return false
+}
+
+func (i *ImpawnImpl) hasEvidence(addr common.Address, hash common.Hash) bool {
+	for _, v := range i.getSlashState(addr).Records {
+		if v.EvidenceHash == hash {
+			return true
+		}
+	}
+	return false
+}
+
+func (i *ImpawnImpl) getSAByPk(epochid uint64, pk []byte) *StakingAccount {
+	accounts, _ := i.epochAccounts(epochid)
+	for _, v := range accounts {
+		if bytes.Equal(v.Votepubkey, pk) {
+			return v
+		}
+	}
+	return nil
+}
+
+// slash punishes sa with the rate of its stake, and of the stake redelegated from
+// it after infraction, and keeps it out of the elections for the next epochs,
+// the record is stored without a reporter.
+func (i *ImpawnImpl) slash(height, infraction uint64, sa *StakingAccount, kind uint8, rate, epochs uint64) (*types.SlashRecord, map[common.Address]*big.Int) {
+	amounts := sa.slash(i.curEpochID, new(big.Int).SetUint64(rate))
+	i.slashRedelegations(sa.Unit.GetRewardAddress(), infraction, new(big.Int).SetUint64(rate), amounts)
+	all := big.NewInt(0)
+	for _, v := range amounts {
+		all = all.Add(all, v)
+	}
+	addr := sa.Unit.GetRewardAddress()
+	state := i.getSlashState(addr)
+	if until := i.curEpochID + epochs; until > state.JailedUntil {
+		state.JailedUntil = until
+	}
+	record := &types.SlashRecord{
+		Address:     addr,
+		Kind:        kind,
+		Height:      height,
+		Amount:      all,
+		Reward:      big.NewInt(0),
+		JailedUntil: state.JailedUntil,
+	}
+	state.Records = append(state.Records, record)
+	log.Info("Slash validator", "address", addr, "kind", kind, "height", height, "amount", all, "jailed until", record.JailedUntil)
+	return record, amounts
+}
+
+// SlashByEvidence verifies an evidence submitted by reporter and punishes the
+// validator that signed it. It returns the stake taken from every owner, the
+// reporter reward is a part of it.
+func (i *ImpawnImpl) SlashByEvidence(height uint64, ev types.Evidence, reporter common.Address) (*types.SlashRecord, map[common.Address]*big.Int, error) {
+	curEpoch := i.epochFromHeight(height)
+	if curEpoch == nil || curEpoch.EpochID != i.curEpochID {
+		return nil, nil, types.ErrInvalidParam
+	}
+	if ev.Height() > height {
+		return nil, nil, types.ErrEvidenceFuture
+	}
+	if e := i.epochFromHeight(ev.Height()); e.EpochID+params.MaxEvidenceAgeEpochs < i.curEpochID {
+		return nil, nil, types.ErrEvidenceTooOld
+	}
+	var rate, epochs uint64
+	switch ev.Kind() {
+	case types.EvidenceDuplicateVote, types.EvidenceDuplicateSign:
+		rate, epochs = params.SlashDuplicateVoteRate, params.JailDuplicateVoteEpochs
+	default:
+		return nil, nil, types.ErrUnknownEvidence
+	}
+	pk, err := ev.Verify()
+	if err != nil {
+		return nil, nil, errors.New(fmt.Sprint(types.ErrInvalidEvidence, " ", err))
+	}
+	sa := i.getSAByPk(i.curEpochID, pk)
+	if sa == nil {
+		return nil, nil, types.ErrInvalidStaking
+	}
+	if i.hasEvidence(sa.Unit.GetRewardAddress(), ev.Hash()) {
+		return nil, nil, types.ErrDuplicateEvidence
+	}
+	if i.isJailedFor(sa.Unit.GetRewardAddress(), ev.Kind(), i.curEpochID) {
+		return nil, nil, types.ErrValidatorJailed
+	}
+	record, amounts := i.slash(height, ev.Height(), sa, ev.Kind(), rate, epochs)
+	record.EvidenceHash, record.EvidenceHeight = ev.Hash(), ev.Height()
+	record.Reporter = reporter
+	record.Reward = new(big.Int).Quo(new(big.Int).Mul(record.Amount, new(big.Int).SetUint64(params.SlashReporterRate)), types.Base)
+	return record, amounts, nil
+}
+
+// SetSlashing enables the slashing rules in the elections, the validators
+// jailed are left out of them from the slashing fork on.
+func (i *ImpawnImpl) SetSlashing(enabled bool) {
+	i.slashing = enabled
+}
+
+// GetSlashRecords returns the slashing of the validator addr still jailing it
+// or within the age of an evidence of its misbehaviour.
+func (i *ImpawnImpl) GetSlashRecords(addr common.Address) []*types.SlashRecord {
+	return i.getSlashState(addr).Records
+}
+
+// GetJailedUntil returns the last epoch of the jail period of addr, 0 if it was
+// never jailed. A validator jailed for downtime also needs to unjail itself.
+func (i *ImpawnImpl) GetJailedUntil(addr common.Address) uint64 {
+	return i.getSlashState(addr).JailedUntil
+}
+
+// shiftSlashing clears the expired jails of the validators of accounts and
+// drops the records that neither jail their validator nor match an evidence
+// young enough to be submitted, so that the records don't grow with the history
+// of the chain. A validator slashed is still staking while its records matter.
+func (i *ImpawnImpl) shiftSlashing(epochid uint64, accounts SAImpawns) {
+	for _, sa := range accounts {
+		state := i.getSlashState(sa.Unit.GetRewardAddress())
+		if state.JailedUntil < epochid {
+			state.JailedUntil = 0
+		}
+		var keep []*types.SlashRecord
+		for _, v := range state.Records {
+			e := i.epochFromHeight(v.EvidenceHeight)
+			if epochid <= v.JailedUntil || e.EpochID+params.MaxEvidenceAgeEpochs >= epochid {
+				keep = append(keep, v)
+			}
+		}
+		state.Records = keep
+	}
+}
+
+// BurnSlashed takes the slashed stake out of the balances of its owners.
+func BurnSlashed(db StateDB, amounts map[common.Address]*big.Int) {
+	for addr, amount := range amounts {
+		db.SubBalance(addr, amount)
+		subLockedBalance(db, addr, amount)
+	}
+}
+
+func (i *ImpawnImpl) saveSlashing(state StateDB, preAddress common.Address) {
+	var addrs []common.Address
+	for k := range i.slashes {
+		addrs = append(addrs, k)
+	}
+	for _, addr := range sortAddresses(addrs) {
+		if v := i.slashes[addr]; v.empty() {
+			writeEntry(state, preAddress, slashingKey(addr), nil)
+		} else {
+			writeEntry(state, preAddress, slashingKey(addr), v)
+		}
+	}
+}
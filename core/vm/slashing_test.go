@@ -0,0 +1,178 @@
+package vm
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/core/state"
+	"git.taiyue.io/pist/go-pist/core/types"
+	"git.taiyue.io/pist/go-pist/crypto"
+	"git.taiyue.io/pist/go-pist/params"
+	"git.taiyue.io/pist/go-pist/pistdb"
+)
+
+type testEvidence struct {
+	height uint64
+	pk     []byte
+	hash   common.Hash
+	err    error
+}
+
+func (e *testEvidence) Kind() uint8             { return types.EvidenceDuplicateVote }
+func (e *testEvidence) Height() uint64          { return e.height }
+func (e *testEvidence) Hash() common.Hash       { return e.hash }
+func (e *testEvidence) Verify() ([]byte, error) { return e.pk, e.err }
+
+func TestSlashByEvidence(t *testing.T) {
+	impl := NewImpawnImpl()
+	addrs := insertValidators(t, impl, 20000, 20000)
+	amount := new(big.Int).Mul(big.NewInt(20000), big.NewInt(1e18))
+	var pks [][]byte
+	for _, addr := range addrs {
+		sa, _ := impl.GetStakingAccount(0, addr)
+		pks = append(pks, sa.Votepubkey)
+	}
+	delegator := common.Address{0x01}
+	if err := impl.InsertDAccount2(0, addrs[0], delegator, amount); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := impl.DoElections(1, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := impl.Shift(1); err != nil {
+		t.Fatal(err)
+	}
+
+	reporter := common.Address{0x02}
+	ev := &testEvidence{height: 5, pk: pks[0], hash: common.Hash{0x01}}
+	if _, _, err := impl.SlashByEvidence(10, &testEvidence{height: 5, pk: pks[0], err: errors.New("bad")}, reporter); err == nil {
+		t.Fatal("invalid evidence accepted")
+	}
+	if _, _, err := impl.SlashByEvidence(4, ev, reporter); err != types.ErrEvidenceFuture {
+		t.Fatalf("expected future evidence error, got %v", err)
+	}
+	record, amounts, err := impl.SlashByEvidence(10, ev, reporter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := new(big.Int).Quo(new(big.Int).Mul(amount, new(big.Int).SetUint64(params.SlashDuplicateVoteRate)), types.Base)
+	if amounts[addrs[0]].Cmp(want) != 0 || amounts[delegator].Cmp(want) != 0 {
+		t.Fatalf("slashed amounts mismatch: have %v, want %v each", amounts, want)
+	}
+	if record.Amount.Cmp(new(big.Int).Mul(want, big.NewInt(2))) != 0 || record.Reporter != reporter || record.Reward.Sign() <= 0 {
+		t.Fatalf("record mismatch: %+v", record)
+	}
+	sa, _ := impl.GetStakingAccount(1, addrs[0])
+	if left := sa.getAllStaking(10); left.Cmp(new(big.Int).Mul(new(big.Int).Sub(amount, want), big.NewInt(2))) != 0 {
+		t.Fatalf("stake left mismatch: have %v", left)
+	}
+	if _, _, err := impl.SlashByEvidence(10, ev, reporter); err != types.ErrDuplicateEvidence {
+		t.Fatalf("expected duplicate evidence error, got %v", err)
+	}
+	if _, _, err := impl.SlashByEvidence(10, &testEvidence{height: 6, pk: pks[0], hash: common.Hash{0x02}}, reporter); err != types.ErrValidatorJailed {
+		t.Fatalf("expected jailed error, got %v", err)
+	}
+
+	// The state survives a reload and the jailed validator misses the election.
+	db := pistdb.NewMemDatabase()
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(db))
+	impl.Save(statedb, types.StakingAddress)
+	impl = NewImpawnImpl()
+	if err := impl.Load(statedb, types.StakingAddress); err != nil {
+		t.Fatal(err)
+	}
+	if until := impl.GetJailedUntil(addrs[0]); until != 1+params.JailDuplicateVoteEpochs {
+		t.Fatalf("jail mismatch: have %d", until)
+	}
+	if len(impl.GetSlashRecords(addrs[0])) != 1 {
+		t.Fatalf("records mismatch: have %d", len(impl.GetSlashRecords(addrs[0])))
+	}
+	first := types.GetFirstEpoch()
+	committee, err := impl.DoElections(2, first.EndHeight-params.ElectionPoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(committee) != 2 {
+		t.Fatalf("jails applied before the slashing fork: %d members", len(committee))
+	}
+	impl.SetSlashing(true)
+	committee, err = impl.DoElections(2, first.EndHeight-params.ElectionPoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(committee) != 1 || committee[0].Unit.Address != addrs[1] {
+		t.Fatalf("jailed validator elected: %d members", len(committee))
+	}
+
+	// The record is dropped once the jail is over and the evidence too old.
+	impl.shiftSlashing(1+params.JailDuplicateVoteEpochs, impl.GetAllStakingAccount())
+	if len(impl.GetSlashRecords(addrs[0])) != 1 {
+		t.Fatalf("record dropped within the jail: have %d", len(impl.GetSlashRecords(addrs[0])))
+	}
+	impl.shiftSlashing(2+params.JailDuplicateVoteEpochs, impl.GetAllStakingAccount())
+	if len(impl.GetSlashRecords(addrs[0])) != 0 || impl.GetJailedUntil(addrs[0]) != 0 {
+		t.Fatalf("expired slashing kept: %d records", len(impl.GetSlashRecords(addrs[0])))
+	}
+}
+
+func TestSlashByDuplicateSign(t *testing.T) {
+	impl := NewImpawnImpl()
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	amount := new(big.Int).Mul(big.NewInt(20000), big.NewInt(1e18))
+	if err := impl.InsertSAccount2(0, addr, crypto.FromECDSAPub(&key.PublicKey), amount, big.NewInt(50), true); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := impl.DoElections(1, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := impl.Shift(1); err != nil {
+		t.Fatal(err)
+	}
+
+	sign := func(key *ecdsa.PrivateKey, hash common.Hash, result uint32) *types.PbftSign {
+		s := &types.PbftSign{FastHeight: big.NewInt(5), FastHash: hash, Result: result}
+		s.Sign, _ = crypto.Sign(s.HashWithNoSign().Bytes(), key)
+		return s
+	}
+	other, _ := crypto.GenerateKey()
+	tests := []struct {
+		a, b *types.PbftSign
+		err  error
+	}{
+		{sign(key, common.Hash{0x01}, types.VoteAgree), sign(key, common.Hash{0x01}, types.VoteAgree), types.ErrSignsNotConflicting},
+		{sign(key, common.Hash{0x01}, types.VoteAgree), sign(key, common.Hash{0x02}, types.VoteAgreeAgainst), types.ErrSignsNotConflicting},
+		{sign(key, common.Hash{0x01}, types.VoteAgree), sign(other, common.Hash{0x02}, types.VoteAgree), types.ErrSignsOtherSigner},
+	}
+	for i, tt := range tests {
+		ev, err := types.DecodeEvidence(types.EvidenceDuplicateSign, types.NewDuplicateSignEvidence(tt.a, tt.b).Bytes())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := ev.Verify(); err != tt.err {
+			t.Errorf("test %d: verify error mismatch: have %v, want %v", i, err, tt.err)
+		}
+	}
+
+	// Two signs agreeing on different blocks of a height slash their signer, in
+	// whichever order they are reported
+	a, b := sign(key, common.Hash{0x01}, types.VoteAgree), sign(key, common.Hash{0x02}, types.VoteAgree)
+	ev, err := types.DecodeEvidence(types.EvidenceDuplicateSign, types.NewDuplicateSignEvidence(a, b).Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ev.Hash() != types.NewDuplicateSignEvidence(b, a).Hash() || ev.Height() != 5 {
+		t.Fatalf("evidence mismatch: hash %x height %d", ev.Hash(), ev.Height())
+	}
+	record, amounts, err := impl.SlashByEvidence(10, ev, common.Address{0x02})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := new(big.Int).Quo(new(big.Int).Mul(amount, new(big.Int).SetUint64(params.SlashDuplicateVoteRate)), types.Base)
+	if record.Kind != types.EvidenceDuplicateSign || amounts[addr].Cmp(want) != 0 {
+		t.Fatalf("slashing mismatch: kind %d, amounts %v, want %v", record.Kind, amounts, want)
+	}
+}
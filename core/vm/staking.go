@@ -40,6 +40,12 @@ var StakingGas = map[string]uint64{
 	"delegate":         1500000,
 	"undelegate":       1500000,
 	"withdrawDelegate": 1620000,
+	"slash":            3000000,
+	"unjail":           1500000,
+	"setDescription":   1500000,
+	"setRewardMode":    1500000,
+	"claimReward":      1620000,
+	"redelegate":       2400000,
 }
 
 // Staking contract ABI
@@ -51,6 +57,20 @@ func init() {
 	abiStaking, _ = abi.JSON(strings.NewReader(StakeABIJSON))
 }
 
+// UnpackStakingInput decodes the input of a call to the staking contract into
+// the name of the method and its arguments by name.
+func UnpackStakingInput(input []byte) (string, map[string]interface{}, error) {
+	method, err := abiStaking.MethodById(input)
+	if err != nil {
+		return "", nil, err
+	}
+	args := make(map[string]interface{})
+	if err := method.Inputs.UnpackIntoMap(args, input[4:]); err != nil {
+		return method.Name, nil, err
+	}
+	return method.Name, args, nil
+}
+
 // RunStaking execute pistchain staking contract
 func RunStaking(evm *EVM, contract *Contract, input []byte) (ret []byte, err error) {
 	var method *abi.Method
@@ -89,6 +109,18 @@ func RunStaking(evm *EVM, contract *Contract, input []byte) (ret []byte, err err
 		ret, err = undelegate(evm, contract, data)
 	case "withdrawDelegate":
 		ret, err = withdrawDelegate(evm, contract, data)
+	case "slash":
+		ret, err = slash(evm, contract, data)
+	case "unjail":
+		ret, err = unjail(evm, contract, data)
+	case "setDescription":
+		ret, err = setDescription(evm, contract, data)
+	case "setRewardMode":
+		ret, err = setRewardMode(evm, contract, data)
+	case "claimReward":
+		ret, err = claimReward(evm, contract, data)
+	case "redelegate":
+		ret, err = redelegate(evm, contract, data)
 	default:
 		log.Warn("Staking call fallback function")
 		err = ErrStakingInvalidInput
@@ -264,7 +296,11 @@ func setFeeRate(evm *EVM, contract *Contract, input []byte) (ret []byte, err err
 		return nil, err
 	}
 
-	err = impawn.UpdateSAFee(evm.Context.BlockNumber.Uint64(), from, fee)
+	if evm.chainRules.IsValidatorInfo {
+		err = impawn.ChangeSAFee(evm.Context.BlockNumber.Uint64(), from, fee)
+	} else {
+		err = impawn.UpdateSAFee(evm.Context.BlockNumber.Uint64(), from, fee)
+	}
 	if err != nil {
 		log.Error("Staking fee", "address", contract.caller.Address(), "error", err)
 		return nil, err
@@ -290,6 +326,54 @@ func setFeeRate(evm *EVM, contract *Contract, input []byte) (ret []byte, err err
 	return nil, nil
 }
 
+// setDescription sets the public information of a validator
+func setDescription(evm *EVM, contract *Contract, input []byte) (ret []byte, err error) {
+	if !evm.chainRules.IsValidatorInfo {
+		log.Error("Staking set description before the validator info fork", "number", evm.BlockNumber)
+		return nil, ErrStakingInvalidInput
+	}
+	desc := new(Description)
+	method, _ := abiStaking.Methods["setDescription"]
+	err = method.Inputs.Unpack(desc, input)
+	if err != nil {
+		log.Error("Unpack set description error", "err", err)
+		return nil, ErrStakingInvalidInput
+	}
+	from := contract.caller.Address()
+
+	impawn := NewImpawnImpl()
+	err = impawn.Load(evm.StateDB, types.StakingAddress)
+	if err != nil {
+		log.Error("Staking load error", "error", err)
+		return nil, err
+	}
+
+	err = impawn.SetDescription(evm.Context.BlockNumber.Uint64(), from, desc)
+	if err != nil {
+		log.Error("Staking set description", "address", from, "error", err)
+		return nil, err
+	}
+
+	err = impawn.Save(evm.StateDB, types.StakingAddress)
+	if err != nil {
+		log.Error("Staking save state error", "error", err)
+		return nil, err
+	}
+
+	event := abiStaking.Events["SetDescription"]
+	logData, err := event.Inputs.PackNonIndexed(desc.Name, desc.Website)
+	if err != nil {
+		log.Error("Pack staking log error", "error", err)
+		return nil, err
+	}
+	topics := []common.Hash{
+		event.ID,
+		common.BytesToHash(from[:]),
+	}
+	logN(evm, contract, topics, logData)
+	return nil, nil
+}
+
 func setPubkey(evm *EVM, contract *Contract, input []byte) (ret []byte, err error) {
 	var pubkey []byte
 
@@ -602,6 +686,272 @@ func withdrawDelegate(evm *EVM, contract *Contract, input []byte) (ret []byte, e
 	return nil, nil
 }
 
+// redelegate moves a part of the delegation of the caller from a validator to
+// another one at the next epoch, the stake stays locked while it moves
+func redelegate(evm *EVM, contract *Contract, input []byte) (ret []byte, err error) {
+	if !evm.chainRules.IsRedelegation {
+		log.Error("Staking redelegate before the redelegation fork", "number", evm.BlockNumber)
+		return nil, ErrStakingInvalidInput
+	}
+	args := struct {
+		From  common.Address
+		To    common.Address
+		Value *big.Int
+	}{}
+	from := contract.caller.Address()
+
+	method, _ := abiStaking.Methods["redelegate"]
+	err = method.Inputs.Unpack(&args, input)
+	if err != nil {
+		log.Error("Unpack redelegate input error", "err", err)
+		return nil, ErrStakingInvalidInput
+	}
+
+	impawn := NewImpawnImpl()
+	err = impawn.Load(evm.StateDB, types.StakingAddress)
+	if err != nil {
+		log.Error("Staking load error", "error", err)
+		return nil, err
+	}
+
+	log.Info("Staking redelegate", "number", evm.Context.BlockNumber.Uint64(), "address", from, "from", args.From, "to", args.To, "value", args.Value)
+
+	err = impawn.Redelegate(evm.Context.BlockNumber.Uint64(), args.From, args.To, from, args.Value)
+	if err != nil {
+		log.Error("Staking redelegate error", "address", from, "from", args.From, "to", args.To, "value", args.Value, "err", err)
+		return nil, err
+	}
+
+	err = impawn.Save(evm.StateDB, types.StakingAddress)
+	if err != nil {
+		log.Error("Staking save state error", "error", err)
+		return nil, err
+	}
+
+	event := abiStaking.Events["Redelegate"]
+	logData, err := event.Inputs.PackNonIndexed(args.Value)
+	if err != nil {
+		log.Error("Pack staking log error", "error", err)
+		return nil, err
+	}
+	topics := []common.Hash{
+		event.ID,
+		common.BytesToHash(from[:]),
+		common.BytesToHash(args.From[:]),
+		common.BytesToHash(args.To[:]),
+	}
+	logN(evm, contract, topics, logData)
+	return nil, nil
+}
+
+// slash punishes the validator of a misbehaviour evidence, anyone can submit it
+// and gets a part of the slashed stake
+func slash(evm *EVM, contract *Contract, input []byte) (ret []byte, err error) {
+	if !evm.chainRules.IsSlashing {
+		log.Error("Staking slash before the slashing fork", "number", evm.BlockNumber)
+		return nil, ErrStakingInvalidInput
+	}
+	args := struct {
+		Kind     uint8
+		Evidence []byte
+	}{}
+	from := contract.caller.Address()
+
+	method, _ := abiStaking.Methods["slash"]
+	err = method.Inputs.Unpack(&args, input)
+	if err != nil {
+		log.Error("Unpack slash input error", "err", err)
+		return nil, ErrStakingInvalidInput
+	}
+	ev, err := types.DecodeEvidence(args.Kind, args.Evidence)
+	if err != nil {
+		log.Error("Staking slash decode evidence error", "kind", args.Kind, "err", err)
+		return nil, err
+	}
+
+	impawn := NewImpawnImpl()
+	err = impawn.Load(evm.StateDB, types.StakingAddress)
+	if err != nil {
+		log.Error("Staking load error", "error", err)
+		return nil, err
+	}
+
+	record, amounts, err := impawn.SlashByEvidence(evm.Context.BlockNumber.Uint64(), ev, from)
+	if err != nil {
+		log.Error("Staking slash error", "reporter", from, "kind", args.Kind, "evidence", ev.Hash(), "err", err)
+		return nil, err
+	}
+
+	err = impawn.Save(evm.StateDB, types.StakingAddress)
+	if err != nil {
+		log.Error("Staking save state error", "error", err)
+		return nil, err
+	}
+	BurnSlashed(evm.StateDB, amounts)
+	evm.StateDB.AddBalance(from, record.Reward)
+
+	event := abiStaking.Events["Slash"]
+	logData, err := event.Inputs.PackNonIndexed(record.Kind, record.Amount, record.Reward, record.JailedUntil)
+	if err != nil {
+		log.Error("Pack staking log error", "error", err)
+		return nil, err
+	}
+	topics := []common.Hash{
+		event.ID,
+		common.BytesToHash(record.Address[:]),
+		common.BytesToHash(from[:]),
+	}
+	logN(evm, contract, topics, logData)
+	return nil, nil
+}
+
+// unjail lets the caller, jailed for downtime, take part in the elections again
+func unjail(evm *EVM, contract *Contract, input []byte) (ret []byte, err error) {
+	if !evm.chainRules.IsSlashing {
+		log.Error("Staking unjail before the slashing fork", "number", evm.BlockNumber)
+		return nil, ErrStakingInvalidInput
+	}
+	from := contract.caller.Address()
+
+	impawn := NewImpawnImpl()
+	err = impawn.Load(evm.StateDB, types.StakingAddress)
+	if err != nil {
+		log.Error("Staking load error", "error", err)
+		return nil, err
+	}
+
+	err = impawn.Unjail(from)
+	if err != nil {
+		log.Error("Staking unjail error", "address", from, "err", err)
+		return nil, err
+	}
+
+	err = impawn.Save(evm.StateDB, types.StakingAddress)
+	if err != nil {
+		log.Error("Staking save state error", "error", err)
+		return nil, err
+	}
+
+	event := abiStaking.Events["Unjail"]
+	logData, err := event.Inputs.PackNonIndexed(evm.Context.BlockNumber)
+	if err != nil {
+		log.Error("Pack staking log error", "error", err)
+		return nil, err
+	}
+	topics := []common.Hash{
+		event.ID,
+		common.BytesToHash(from[:]),
+	}
+	logN(evm, contract, topics, logData)
+	return nil, nil
+}
+
+// setRewardMode sets how the rewards of the delegation of the caller to holder
+// are paid: every block, restaked at the end of the epoch or kept to be claimed
+func setRewardMode(evm *EVM, contract *Contract, input []byte) (ret []byte, err error) {
+	if !evm.chainRules.IsRewardMode {
+		log.Error("Staking set reward mode before the reward mode fork", "number", evm.BlockNumber)
+		return nil, ErrStakingInvalidInput
+	}
+	args := struct {
+		Holder common.Address
+		Mode   uint8
+	}{}
+	from := contract.caller.Address()
+
+	method, _ := abiStaking.Methods["setRewardMode"]
+	err = method.Inputs.Unpack(&args, input)
+	if err != nil {
+		log.Error("Unpack set reward mode input error", "err", err)
+		return nil, ErrStakingInvalidInput
+	}
+
+	impawn := NewImpawnImpl()
+	err = impawn.Load(evm.StateDB, types.StakingAddress)
+	if err != nil {
+		log.Error("Staking load error", "error", err)
+		return nil, err
+	}
+
+	err = impawn.SetRewardMode(evm.Context.BlockNumber.Uint64(), args.Holder, from, args.Mode)
+	if err != nil {
+		log.Error("Staking set reward mode error", "address", from, "holder", args.Holder, "mode", args.Mode, "err", err)
+		return nil, err
+	}
+
+	err = impawn.Save(evm.StateDB, types.StakingAddress)
+	if err != nil {
+		log.Error("Staking save state error", "error", err)
+		return nil, err
+	}
+
+	event := abiStaking.Events["SetRewardMode"]
+	logData, err := event.Inputs.PackNonIndexed(args.Mode)
+	if err != nil {
+		log.Error("Pack staking log error", "error", err)
+		return nil, err
+	}
+	topics := []common.Hash{
+		event.ID,
+		common.BytesToHash(from[:]),
+		common.BytesToHash(args.Holder[:]),
+	}
+	logN(evm, contract, topics, logData)
+	return nil, nil
+}
+
+// claimReward pays the caller the rewards of its delegation to holder kept
+// until now
+func claimReward(evm *EVM, contract *Contract, input []byte) (ret []byte, err error) {
+	if !evm.chainRules.IsRewardMode {
+		log.Error("Staking claim reward before the reward mode fork", "number", evm.BlockNumber)
+		return nil, ErrStakingInvalidInput
+	}
+	var holder common.Address
+	from := contract.caller.Address()
+
+	method, _ := abiStaking.Methods["claimReward"]
+	err = method.Inputs.Unpack(&holder, input)
+	if err != nil {
+		log.Error("Unpack claim reward input error", "err", err)
+		return nil, ErrStakingInvalidInput
+	}
+
+	impawn := NewImpawnImpl()
+	err = impawn.Load(evm.StateDB, types.StakingAddress)
+	if err != nil {
+		log.Error("Staking load error", "error", err)
+		return nil, err
+	}
+
+	amount, err := impawn.ClaimReward(holder, from)
+	if err != nil {
+		log.Error("Staking claim reward error", "address", from, "holder", holder, "err", err)
+		return nil, err
+	}
+
+	err = impawn.Save(evm.StateDB, types.StakingAddress)
+	if err != nil {
+		log.Error("Staking save state error", "error", err)
+		return nil, err
+	}
+	evm.StateDB.AddBalance(from, amount)
+
+	event := abiStaking.Events["ClaimReward"]
+	logData, err := event.Inputs.PackNonIndexed(amount)
+	if err != nil {
+		log.Error("Pack staking log error", "error", err)
+		return nil, err
+	}
+	topics := []common.Hash{
+		event.ID,
+		common.BytesToHash(from[:]),
+		common.BytesToHash(holder[:]),
+	}
+	logN(evm, contract, topics, logData)
+	return nil, nil
+}
+
 func getLocked(evm *EVM, contract *Contract, input []byte) (ret []byte, err error) {
 	var depositAddr common.Address
 
@@ -891,6 +1241,153 @@ const StakeABIJSON = `
     "anonymous": false,
     "type": "event"
   },
+  {
+    "name": "Slash",
+    "inputs": [
+      {
+        "type": "address",
+        "name": "validator",
+        "indexed": true
+      },
+      {
+        "type": "address",
+        "name": "reporter",
+        "indexed": true
+      },
+      {
+        "type": "uint8",
+        "name": "kind",
+        "indexed": false
+      },
+      {
+        "type": "uint256",
+        "name": "amount",
+        "indexed": false
+      },
+      {
+        "type": "uint256",
+        "name": "reward",
+        "indexed": false
+      },
+      {
+        "type": "uint64",
+        "name": "jailedUntil",
+        "indexed": false
+      }
+    ],
+    "anonymous": false,
+    "type": "event"
+  },
+  {
+    "name": "SetDescription",
+    "inputs": [
+      {
+        "type": "address",
+        "name": "from",
+        "indexed": true
+      },
+      {
+        "type": "string",
+        "name": "name",
+        "indexed": false
+      },
+      {
+        "type": "string",
+        "name": "website",
+        "indexed": false
+      }
+    ],
+    "anonymous": false,
+    "type": "event"
+  },
+  {
+    "name": "SetRewardMode",
+    "inputs": [
+      {
+        "type": "address",
+        "name": "from",
+        "indexed": true
+      },
+      {
+        "type": "address",
+        "name": "holder",
+        "indexed": true
+      },
+      {
+        "type": "uint8",
+        "name": "mode",
+        "indexed": false
+      }
+    ],
+    "anonymous": false,
+    "type": "event"
+  },
+  {
+    "name": "ClaimReward",
+    "inputs": [
+      {
+        "type": "address",
+        "name": "from",
+        "indexed": true
+      },
+      {
+        "type": "address",
+        "name": "holder",
+        "indexed": true
+      },
+      {
+        "type": "uint256",
+        "name": "value",
+        "indexed": false
+      }
+    ],
+    "anonymous": false,
+    "type": "event"
+  },
+  {
+    "name": "Redelegate",
+    "inputs": [
+      {
+        "type": "address",
+        "name": "from",
+        "indexed": true
+      },
+      {
+        "type": "address",
+        "name": "source",
+        "indexed": true
+      },
+      {
+        "type": "address",
+        "name": "target",
+        "indexed": true
+      },
+      {
+        "type": "uint256",
+        "name": "value",
+        "indexed": false
+      }
+    ],
+    "anonymous": false,
+    "type": "event"
+  },
+  {
+    "name": "Unjail",
+    "inputs": [
+      {
+        "type": "address",
+        "name": "validator",
+        "indexed": true
+      },
+      {
+        "type": "uint256",
+        "name": "height",
+        "indexed": false
+      }
+    ],
+    "anonymous": false,
+    "type": "event"
+  },
   {
     "name": "deposit",
     "outputs": [],
@@ -1111,6 +1608,107 @@ const StakeABIJSON = `
     "constant": false,
     "payable": false,
     "type": "function"
+  },
+  {
+    "name": "slash",
+    "outputs": [],
+    "inputs": [
+      {
+        "type": "uint8",
+        "name": "kind"
+      },
+      {
+        "type": "bytes",
+        "name": "evidence"
+      }
+    ],
+    "constant": false,
+    "payable": false,
+    "type": "function"
+  },
+  {
+    "name": "unjail",
+    "outputs": [],
+    "inputs": [],
+    "constant": false,
+    "payable": false,
+    "type": "function"
+  },
+  {
+    "name": "setDescription",
+    "outputs": [],
+    "inputs": [
+      {
+        "type": "string",
+        "name": "name"
+      },
+      {
+        "type": "string",
+        "name": "website"
+      },
+      {
+        "type": "string",
+        "name": "contact"
+      },
+      {
+        "type": "string",
+        "name": "details"
+      }
+    ],
+    "constant": false,
+    "payable": false,
+    "type": "function"
+  },
+  {
+    "name": "setRewardMode",
+    "outputs": [],
+    "inputs": [
+      {
+        "type": "address",
+        "name": "holder"
+      },
+      {
+        "type": "uint8",
+        "name": "mode"
+      }
+    ],
+    "constant": false,
+    "payable": false,
+    "type": "function"
+  },
+  {
+    "name": "claimReward",
+    "outputs": [],
+    "inputs": [
+      {
+        "type": "address",
+        "name": "holder"
+      }
+    ],
+    "constant": false,
+    "payable": false,
+    "type": "function"
+  },
+  {
+    "name": "redelegate",
+    "outputs": [],
+    "inputs": [
+      {
+        "type": "address",
+        "name": "from"
+      },
+      {
+        "type": "address",
+        "name": "to"
+      },
+      {
+        "type": "uint256",
+        "name": "value"
+      }
+    ],
+    "constant": false,
+    "payable": false,
+    "type": "function"
   }
 ]
 `
@@ -0,0 +1,172 @@
+package vm
+
+import (
+	"errors"
+	"math/big"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/core/types"
+	"git.taiyue.io/pist/go-pist/params"
+)
+
+var (
+	ErrDescriptionTooLong = errors.New("validator description too long")
+	ErrFeeChangeTooLarge  = errors.New("fee change over the limit of an epoch")
+)
+
+// Description is the public information a validator gives to the delegators.
+type Description struct {
+	Name    string
+	Website string
+	Contact string
+	Details string
+}
+
+func (d *Description) validate() error {
+	if len(d.Name) > params.MaxDescriptionName || len(d.Website) > params.MaxDescriptionWebsite ||
+		len(d.Contact) > params.MaxDescriptionContact || len(d.Details) > params.MaxDescriptionDetails {
+		return ErrDescriptionTooLong
+	}
+	return nil
+}
+
+// FeeChange is a fee set by a validator, which applies from Epoch on.
+type FeeChange struct {
+	Epoch  uint64
+	Height uint64
+	Fee    *big.Int
+}
+
+// validatorInfo is the description and the last fee changes of a validator.
+type validatorInfo struct {
+	Address     common.Address
+	Description *Description
+	Fees        []*FeeChange // oldest first, up to params.MaxFeeHistory
+}
+
+func (v *validatorInfo) clone() *validatorInfo {
+	info := &validatorInfo{Address: v.Address}
+	if v.Description != nil {
+		desc := *v.Description
+		info.Description = &desc
+	}
+	for _, fee := range v.Fees {
+		info.Fees = append(info.Fees, &FeeChange{Epoch: fee.Epoch, Height: fee.Height, Fee: new(big.Int).Set(fee.Fee)})
+	}
+	return info
+}
+
+func validatorInfoKey(addr common.Address) common.Hash {
+	return impawnEntryKey("validatorInfo", addr[:])
+}
+
+func (v *validatorInfo) empty() bool {
+	return v.Description == nil && len(v.Fees) == 0
+}
+
+// getInfo returns the description and fee history of addr, read from the state
+// the first time.
+func (i *ImpawnImpl) getInfo(addr common.Address) *validatorInfo {
+	info, ok := i.infos[addr]
+	if !ok {
+		info = new(validatorInfo)
+		if !i.readEntry(validatorInfoKey(addr), info) {
+			info = &validatorInfo{Address: addr}
+		}
+		i.infos[addr] = info
+	}
+	return info
+}
+
+// SetDescription replaces the description of the staking account addr.
+func (i *ImpawnImpl) SetDescription(height uint64, addr common.Address, desc *Description) error {
+	if err := desc.validate(); err != nil {
+		return err
+	}
+	epochInfo := i.epochFromHeight(height)
+	if epochInfo.EpochID > i.getCurrentEpoch() {
+		return types.ErrOverEpochID
+	}
+	if _, err := i.GetStakingAccount(epochInfo.EpochID, addr); err != nil {
+		return err
+	}
+	i.getInfo(addr).Description = desc
+	return nil
+}
+
+// ChangeSAFee is UpdateSAFee keeping the new fee within params.MaxFeeChangePerEpoch
+// of the fee of the current epoch, the change is recorded in the fee history.
+func (i *ImpawnImpl) ChangeSAFee(height uint64, addr common.Address, fee *big.Int) error {
+	epochInfo := i.epochFromHeight(height)
+	sa, err := i.GetStakingAccount(epochInfo.EpochID, addr)
+	if err != nil {
+		return err
+	}
+	if new(big.Int).Abs(new(big.Int).Sub(fee, sa.Fee)).Cmp(new(big.Int).SetUint64(params.MaxFeeChangePerEpoch)) > 0 {
+		return ErrFeeChangeTooLarge
+	}
+	if err := i.UpdateSAFee(height, addr, fee); err != nil {
+		return err
+	}
+	if sa.Modify == nil || sa.Modify.Fee == nil || sa.Modify.Fee.Cmp(fee) != 0 {
+		return nil
+	}
+	// the fee applies from the next epoch, a later change in the epoch replaces it
+	info := i.getInfo(addr)
+	change := &FeeChange{Epoch: epochInfo.EpochID + 1, Height: height, Fee: new(big.Int).Set(fee)}
+	if n := len(info.Fees); n > 0 && info.Fees[n-1].Epoch == change.Epoch {
+		info.Fees[n-1] = change
+	} else {
+		info.Fees = append(info.Fees, change)
+	}
+	if len(info.Fees) > params.MaxFeeHistory {
+		info.Fees = info.Fees[len(info.Fees)-params.MaxFeeHistory:]
+	}
+	return nil
+}
+
+// GetDescription returns the description of the validator addr, nil if it gave none.
+func (i *ImpawnImpl) GetDescription(addr common.Address) *Description {
+	return i.getInfo(addr).Description
+}
+
+// GetFeeHistory returns the last fee changes of the validator addr.
+func (i *ImpawnImpl) GetFeeHistory(addr common.Address) []*FeeChange {
+	return i.getInfo(addr).Fees
+}
+
+func (i *ImpawnImpl) saveValidatorInfos(state StateDB, preAddress common.Address) {
+	var addrs []common.Address
+	for k := range i.infos {
+		addrs = append(addrs, k)
+	}
+	for _, addr := range sortAddresses(addrs) {
+		if v := i.infos[addr]; v.empty() {
+			writeEntry(state, preAddress, validatorInfoKey(addr), nil)
+		} else {
+			writeEntry(state, preAddress, validatorInfoKey(addr), v)
+		}
+	}
+}
+
+// infoDisplay adds the description and the fee history of the validator addr
+// to its RPC attributes.
+func (i *ImpawnImpl) infoDisplay(attr map[string]interface{}, addr common.Address) {
+	if desc := i.GetDescription(addr); desc != nil {
+		attr["description"] = map[string]interface{}{
+			"name":    desc.Name,
+			"website": desc.Website,
+			"contact": desc.Contact,
+			"details": desc.Details,
+		}
+	}
+	fees := make([]map[string]interface{}, 0)
+	for _, v := range i.GetFeeHistory(addr) {
+		fees = append(fees, map[string]interface{}{
+			"epoch":  v.Epoch,
+			"height": v.Height,
+			"fee":    v.Fee.Uint64(),
+		})
+	}
+	attr["feeHistory"] = fees
+}
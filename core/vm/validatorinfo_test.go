@@ -0,0 +1,69 @@
+package vm
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/core/state"
+	"git.taiyue.io/pist/go-pist/core/types"
+	"git.taiyue.io/pist/go-pist/params"
+	"git.taiyue.io/pist/go-pist/pistdb"
+)
+
+func TestValidatorInfo(t *testing.T) {
+	impl := NewImpawnImpl()
+	addr := insertValidators(t, impl, 20000)[0]
+	if _, err := impl.DoElections(1, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := impl.Shift(1); err != nil {
+		t.Fatal(err)
+	}
+
+	long := &Description{Name: strings.Repeat("n", params.MaxDescriptionName+1)}
+	if err := impl.SetDescription(10, addr, long); err != ErrDescriptionTooLong {
+		t.Fatalf("expected too long error, got %v", err)
+	}
+	if err := impl.SetDescription(10, common.Address{1}, &Description{Name: "nobody"}); err == nil {
+		t.Fatal("description set without a staking account")
+	}
+	desc := &Description{Name: "validator", Website: "https://example.com", Contact: "ops@example.com"}
+	if err := impl.SetDescription(10, addr, desc); err != nil {
+		t.Fatal(err)
+	}
+
+	over := new(big.Int).SetUint64(50 + params.MaxFeeChangePerEpoch + 1)
+	if err := impl.ChangeSAFee(10, addr, over); err != ErrFeeChangeTooLarge {
+		t.Fatalf("expected fee change error, got %v", err)
+	}
+	for _, fee := range []int64{100, 120} {
+		if err := impl.ChangeSAFee(10, addr, big.NewInt(fee)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the second change of the epoch replaces the first one
+	if history := impl.GetFeeHistory(addr); len(history) != 1 || history[0].Epoch != 2 || history[0].Fee.Int64() != 120 {
+		t.Fatalf("fee history mismatch: %v", history)
+	}
+
+	// The infos survive a reload
+	db := pistdb.NewMemDatabase()
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(db))
+	impl.Save(statedb, types.StakingAddress)
+	impl = NewImpawnImpl()
+	if err := impl.Load(statedb, types.StakingAddress); err != nil {
+		t.Fatal(err)
+	}
+	if have := impl.GetDescription(addr); have == nil || *have != *desc {
+		t.Fatalf("description mismatch: have %v, want %v", have, desc)
+	}
+	if history := impl.GetFeeHistory(addr); len(history) != 1 || history[0].Height != 10 {
+		t.Fatalf("reloaded fee history mismatch: %v", history)
+	}
+	attr := impl.GetStakingAccountRPC(10, addr)
+	if attr["description"] == nil || len(attr["feeHistory"].([]map[string]interface{})) != 1 {
+		t.Fatalf("RPC attributes mismatch: %v", attr)
+	}
+}
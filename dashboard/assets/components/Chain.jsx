@@ -0,0 +1,102 @@
+// @flow
+
+// Copyright 2018 The PistChain Authors
+// This file is part of the pist library.
+//
+// The pist library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The pist library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the pist library. If not, see <http://www.gnu.org/licenses/>.
+
+import React, {Component} from 'react';
+
+import Grid from '@material-ui/core/Grid';
+import Typography from '@material-ui/core/Typography';
+import ResponsiveContainer from 'recharts/es6/component/ResponsiveContainer';
+import AreaChart from 'recharts/es6/chart/AreaChart';
+import Area from 'recharts/es6/cartesian/Area';
+import Tooltip from 'recharts/es6/component/Tooltip';
+import {FontAwesomeIcon} from '@fortawesome/react-fontawesome';
+import {faCircle} from '@fortawesome/free-solid-svg-icons';
+
+import CustomTooltip, {unitPlotter} from 'CustomTooltip';
+import {chartStrokeWidth} from '../common';
+import type {Chain as ChainType} from '../types/content';
+
+const CHAIN_SYNC_ID = 'chainSyncId';
+
+// Block chart dimensions.
+const blockChartHeight = 120;
+
+// charts are the processing records of the inserted blocks, charted one by one.
+const charts = [
+	{key: 'verify', label: 'Verification', unit: 'ms', color: '#8884d8'},
+	{key: 'execute', label: 'Execution', unit: 'ms', color: '#82ca9d'},
+	{key: 'validate', label: 'Validation', unit: 'ms', color: '#ffc658'},
+	{key: 'commit', label: 'Commit', unit: 'ms', color: '#ff7c43'},
+	{key: 'gasUsed', label: 'Gas used', unit: 'gas', color: '#a05195'},
+	{key: 'transactions', label: 'Transactions', unit: 'txs', color: '#2f4b7c'},
+];
+
+// styles contains the constant styles of the component.
+const styles = {
+	importing: {
+		marginBottom: 16,
+	},
+	chart: {
+		marginBottom: 16,
+	},
+};
+
+export type Props = {
+	content:      ChainType,
+	shouldUpdate: Object,
+};
+
+type State = {};
+
+// Chain renders the processing of the blocks inserted in the chain.
+class Chain extends Component<Props, State> {
+	shouldComponentUpdate(nextProps: Readonly<Props>, nextState: Readonly<State>, nextContext: any) {
+		return typeof nextProps.shouldUpdate.chain !== 'undefined';
+	}
+
+	// blockChart renders an area chart of a record of the inserted blocks.
+	blockChart = ({key, label, unit, color}, data) => (
+		<Grid item xs={12} md={6} key={key} style={styles.chart}>
+			<Typography type='subheading'>{label}</Typography>
+			<ResponsiveContainer width='100%' height={blockChartHeight}>
+				<AreaChart syncId={CHAIN_SYNC_ID} data={data.map(({value}) => ({[key]: value || 0}))}>
+					<Tooltip cursor={false} content={<CustomTooltip tooltip={unitPlotter(label, unit)} />} />
+					<Area isAnimationActive={false} strokeWidth={chartStrokeWidth} type='monotone' dataKey={key} stroke={color} fill={color} />
+				</AreaChart>
+			</ResponsiveContainer>
+		</Grid>
+	);
+
+	render() {
+		const {processing} = this.props.content;
+
+		return (
+			<div>
+				<Typography type='subheading' style={styles.importing}>
+					<FontAwesomeIcon icon={faCircle} color={processing.importing ? '#82ca9d' : '#9e9e9e'} />
+					{processing.importing ? ' Importing blocks' : ' Idle'}
+				</Typography>
+				<Grid container>
+					{charts.map(chart => Array.isArray(processing[chart.key]) && this.blockChart(chart, processing[chart.key]))}
+				</Grid>
+			</div>
+		);
+	}
+}
+
+export default Chain;
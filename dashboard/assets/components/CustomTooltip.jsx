@@ -64,6 +64,19 @@ export const bytePerSecPlotter = <T>(text: string, mapper: (T => T) = multiplier
 	);
 };
 
+// unitPlotter renders a tooltip, which displays the value of the payload followed by a unit.
+export const unitPlotter = <T>(text: string, unit: string = '', mapper: (T => T) = multiplier(1)) => (payload: T) => {
+	const p = mapper(payload);
+	if (typeof p !== 'number') {
+		return null;
+	}
+	return (
+		<Typography type='caption' color='inherit'>
+			<span style={styles.light}>{text}</span> {p.toFixed(2)} {unit}
+		</Typography>
+	);
+};
+
 export type Props = {
 	active: boolean,
 	payload: Object,
@@ -87,7 +87,18 @@ const defaultContent: () => Content = () => ({
 		commit:  null,
 	},
 	home:    {},
-	chain:   {},
+	chain:   {
+		fastChain:  {},
+		processing: {
+			importing:    false,
+			verify:       [],
+			execute:      [],
+			validate:     [],
+			commit:       [],
+			gasUsed:      [],
+			transactions: [],
+		},
+	},
 	txpool:  {},
 	network: {
 		peers: {
@@ -123,7 +134,18 @@ const updaters = {
 		commit:  replacer,
 	},
 	home:    null,
-	chain:   null,
+	chain:   {
+		fastChain:  replacer,
+		processing: {
+			importing:    replacer,
+			verify:       appender(200),
+			execute:      appender(200),
+			validate:     appender(200),
+			commit:       appender(200),
+			gasUsed:      appender(200),
+			transactions: appender(200),
+		},
+	},
 	txpool:  null,
 	network: peerInserter(200),
 	system:  {
@@ -42,7 +42,17 @@ export type Home = {
 };
 
 export type Chain = {
-	/* TODO (kurkomisi) */
+	processing: Processing,
+};
+
+export type Processing = {
+	importing:    boolean,
+	verify:       ChartEntries,
+	execute:      ChartEntries,
+	validate:     ChartEntries,
+	commit:       ChartEntries,
+	gasUsed:      ChartEntries,
+	transactions: ChartEntries,
 };
 
 export type TxPool = {
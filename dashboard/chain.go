@@ -18,6 +18,8 @@ package dashboard
 
 import (
 	"time"
+
+	"git.taiyue.io/pist/go-pist/core/types"
 )
 
 // fastChainInfo contains the info of fast chain.
@@ -28,16 +30,87 @@ type fastChainInfo struct {
 	GasLimit     ChartEntries `json:"gasLimit,omitempty"`
 }
 
+// blockProcessingInfo contains the processing records of the inserted blocks,
+// the times are in milliseconds.
+type blockProcessingInfo struct {
+	Importing    bool         `json:"importing"`
+	Verify       ChartEntries `json:"verify,omitempty"`
+	Execute      ChartEntries `json:"execute,omitempty"`
+	Validate     ChartEntries `json:"validate,omitempty"`
+	Commit       ChartEntries `json:"commit,omitempty"`
+	GasUsed      ChartEntries `json:"gasUsed,omitempty"`
+	Transactions ChartEntries `json:"transactions,omitempty"`
+}
+
+// millis returns the chart entry of a duration in milliseconds.
+func millis(d time.Duration) *ChartEntry {
+	return &ChartEntry{Value: float64(d) / float64(time.Millisecond)}
+}
+
 // collectTxpoolData gathers data about the tx_pool and sends it to the clients.
 func (db *Dashboard) collectChainData() {
 	defer db.wg.Done()
 	fastchain := db.pist.BlockChain()
 
+	procCh := make(chan bool, 10)
+	procSub := fastchain.SubscribeBlockProcessingEvent(procCh)
+	defer procSub.Unsubscribe()
+
+	statsCh := make(chan types.BlockStatsEvent, 10)
+	statsSub := fastchain.SubscribeBlockStatsEvent(statsCh)
+	defer statsSub.Unsubscribe()
+
 	for {
 		select {
 		case errc := <-db.quit:
 			errc <- nil
 			return
+		case importing := <-procCh:
+			db.chainLock.Lock()
+			db.history.Chain.Processing.Importing = importing
+			db.chainLock.Unlock()
+
+			db.sendToAll(&Message{
+				Chain: &ChainMessage{
+					Processing: &blockProcessingInfo{Importing: importing},
+				},
+			})
+		case ev := <-statsCh:
+			verify := millis(ev.Verify)
+			execute := millis(ev.Execute)
+			validate := millis(ev.Validate)
+			commit := millis(ev.Commit)
+			gasUsed := &ChartEntry{
+				Value: float64(ev.GasUsed),
+			}
+			txs := &ChartEntry{
+				Value: float64(len(ev.Block.Transactions())),
+			}
+
+			db.chainLock.Lock()
+			proc := db.history.Chain.Processing
+			proc.Verify = append(proc.Verify[1:], verify)
+			proc.Execute = append(proc.Execute[1:], execute)
+			proc.Validate = append(proc.Validate[1:], validate)
+			proc.Commit = append(proc.Commit[1:], commit)
+			proc.GasUsed = append(proc.GasUsed[1:], gasUsed)
+			proc.Transactions = append(proc.Transactions[1:], txs)
+			importing := proc.Importing
+			db.chainLock.Unlock()
+
+			db.sendToAll(&Message{
+				Chain: &ChainMessage{
+					Processing: &blockProcessingInfo{
+						Importing:    importing,
+						Verify:       ChartEntries{verify},
+						Execute:      ChartEntries{execute},
+						Validate:     ChartEntries{validate},
+						Commit:       ChartEntries{commit},
+						GasUsed:      ChartEntries{gasUsed},
+						Transactions: ChartEntries{txs},
+					},
+				},
+			})
 		case <-time.After(db.config.Refresh):
 			lastFastTime := fastchain.CurrentHeader().Time
 			lastTxsCount := len(fastchain.CurrentBlock().Body().Transactions)
@@ -63,9 +136,7 @@ func (db *Dashboard) collectChainData() {
 			}
 
 			db.chainLock.Lock()
-			db.history.Chain = &ChainMessage{
-				FastChain: fastChainInfo,
-			}
+			db.history.Chain.FastChain = fastChainInfo
 			db.chainLock.Unlock()
 
 			db.sendToAll(&Message{
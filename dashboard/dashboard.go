@@ -138,6 +138,16 @@ func New(config *Config, commit string, logdir string, ethServ *pist.Pistchain)
 				AllSendCounter:             emptyChartEntries(now, sampleLimit),
 				AllSendTimesCounter:        emptyChartEntries(now, sampleLimit),
 			},
+			Chain: &ChainMessage{
+				Processing: &blockProcessingInfo{
+					Verify:       emptyChartEntries(now, sampleLimit),
+					Execute:      emptyChartEntries(now, sampleLimit),
+					Validate:     emptyChartEntries(now, sampleLimit),
+					Commit:       emptyChartEntries(now, sampleLimit),
+					GasUsed:      emptyChartEntries(now, sampleLimit),
+					Transactions: emptyChartEntries(now, sampleLimit),
+				},
+			},
 		},
 		logdir: logdir,
 	}
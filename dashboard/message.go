@@ -48,7 +48,8 @@ type HomeMessage struct {
 }
 
 type ChainMessage struct {
-	FastChain *fastChainInfo `json:"fastChain,omitempty"` // fastChain info tree.
+	FastChain  *fastChainInfo       `json:"fastChain,omitempty"`  // fastChain info tree.
+	Processing *blockProcessingInfo `json:"processing,omitempty"` // Timings of the inserted blocks.
 }
 
 // CommitteeMessage contains the info of committee.
@@ -0,0 +1,50 @@
+// Package graphql serves the chain, committee and staking data of a full node
+// over GraphQL.
+package graphql
+
+import (
+	"fmt"
+)
+
+// DefaultConfig contains the default settings of the GraphQL service.
+var DefaultConfig = Config{
+	Host:          "localhost",
+	Port:          8547,
+	VirtualHosts:  []string{"localhost"},
+	MaxPageSize:   100,
+	MaxBlockRange: 1000,
+	MaxDepth:      10,
+	MaxComplexity: 10000,
+}
+
+// Config are the settings of the GraphQL HTTP endpoint.
+type Config struct {
+	// Host and Port are the interface and the port the endpoint listens on.
+	Host string
+	Port int
+
+	// Cors are the domains allowed to make cross origin requests, none if empty.
+	Cors []string `toml:",omitempty"`
+
+	// VirtualHosts are the host names accepted in the requests, "*" for any.
+	VirtualHosts []string `toml:",omitempty"`
+
+	// MaxPageSize is the most items a list field returns at once, and its size
+	// when the query doesn't ask for one.
+	MaxPageSize int
+
+	// MaxBlockRange is the most blocks the logs of a query are looked for in.
+	MaxBlockRange uint64
+
+	// MaxDepth is the most a query nests its fields.
+	MaxDepth int
+
+	// MaxComplexity is the most fields a query resolves, each item of a list
+	// counting for its own fields.
+	MaxComplexity int
+}
+
+// Endpoint returns the address the endpoint listens on.
+func (c *Config) Endpoint() string {
+	return fmt.Sprintf("%s:%d", c.Host, c.Port)
+}
@@ -0,0 +1,838 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/common/hexutil"
+	"git.taiyue.io/pist/go-pist/core/rawdb"
+	"git.taiyue.io/pist/go-pist/core/types"
+	"git.taiyue.io/pist/go-pist/core/vm"
+	"git.taiyue.io/pist/go-pist/crypto"
+	"git.taiyue.io/pist/go-pist/internal/pistapi"
+	"git.taiyue.io/pist/go-pist/pist/filters"
+	"git.taiyue.io/pist/go-pist/rpc"
+)
+
+var errPageRange = errors.New("negative page bounds")
+
+// Backend is the chain the service reads, the API backend of a full node.
+type Backend interface {
+	pistapi.Backend
+	filters.Backend
+}
+
+// Long is a 64 bit unsigned integer.
+type Long uint64
+
+// ImplementsGraphQLType returns true if Long implements the provided GraphQL type.
+func (Long) ImplementsGraphQLType(name string) bool { return name == "Long" }
+
+// UnmarshalGraphQL unmarshals the provided GraphQL query data.
+func (l *Long) UnmarshalGraphQL(input interface{}) error {
+	switch input := input.(type) {
+	case string:
+		var (
+			value uint64
+			err   error
+		)
+		if strings.HasPrefix(input, "0x") {
+			value, err = hexutil.DecodeUint64(input)
+		} else {
+			value, err = strconv.ParseUint(input, 10, 64)
+		}
+		*l = Long(value)
+		return err
+	case int32:
+		if input < 0 {
+			return fmt.Errorf("negative Long %d", input)
+		}
+		*l = Long(input)
+		return nil
+	case float64:
+		if input < 0 || input > math.MaxUint64 || input != math.Trunc(input) {
+			return fmt.Errorf("invalid Long %v", input)
+		}
+		*l = Long(input)
+		return nil
+	default:
+		return fmt.Errorf("unexpected type %T for Long", input)
+	}
+}
+
+// Resolver is the root of the queries.
+type Resolver struct {
+	backend Backend
+	config  *Config
+}
+
+// page returns the bounds of the page of a list of n items, of first items after
+// the skip ones. The size of a page is capped by the configured one.
+func (r *Resolver) page(n int, first, skip *int32) (int, int, error) {
+	size := r.config.MaxPageSize
+	if first != nil {
+		if *first < 0 {
+			return 0, 0, errPageRange
+		}
+		if size <= 0 || int(*first) < size {
+			size = int(*first)
+		}
+	}
+	if size <= 0 && first == nil {
+		size = n
+	}
+	start := 0
+	if skip != nil {
+		if *skip < 0 {
+			return 0, 0, errPageRange
+		}
+		start = int(*skip)
+	}
+	if start > n {
+		start = n
+	}
+	end := start + size
+	if end > n {
+		end = n
+	}
+	return start, end, nil
+}
+
+// blockByNumber returns the canonical block of number, nil if there is none.
+func (r *Resolver) blockByNumber(ctx context.Context, number uint64) (*types.Block, error) {
+	if number > math.MaxInt64 {
+		return nil, nil
+	}
+	return r.backend.BlockByNumber(ctx, rpc.BlockNumber(number))
+}
+
+// blockRange returns the range of blocks from and to, defaulting to the genesis
+// block and the head and capped by the head.
+func (r *Resolver) blockRange(from, to *Long) (uint64, uint64) {
+	begin, end := uint64(0), r.backend.CurrentBlock().NumberU64()
+	if from != nil {
+		begin = uint64(*from)
+	}
+	if to != nil && uint64(*to) < end {
+		end = uint64(*to)
+	}
+	return begin, end
+}
+
+// Block returns a block by number or by hash, the head if both are omitted.
+func (r *Resolver) Block(ctx context.Context, args struct {
+	Number *Long
+	Hash   *common.Hash
+}) (*Block, error) {
+	var (
+		block *types.Block
+		err   error
+	)
+	switch {
+	case args.Hash != nil:
+		block, err = r.backend.GetBlock(ctx, *args.Hash)
+	case args.Number != nil:
+		block, err = r.blockByNumber(ctx, uint64(*args.Number))
+	default:
+		block = r.backend.CurrentBlock()
+	}
+	if block == nil || err != nil {
+		return nil, err
+	}
+	return &Block{r: r, block: block}, nil
+}
+
+// Blocks returns a page of the blocks of a range.
+func (r *Resolver) Blocks(ctx context.Context, args struct {
+	From  *Long
+	To    *Long
+	First *int32
+	Skip  *int32
+}) ([]*Block, error) {
+	from, to := r.blockRange(args.From, args.To)
+	if from > to {
+		return []*Block{}, nil
+	}
+	start, end, err := r.page(int(to-from+1), args.First, args.Skip)
+	if err != nil {
+		return nil, err
+	}
+	blocks := make([]*Block, 0, end-start)
+	for n := from + uint64(start); n < from+uint64(end); n++ {
+		block, err := r.blockByNumber(ctx, n)
+		if err != nil {
+			return nil, err
+		}
+		if block == nil {
+			break
+		}
+		blocks = append(blocks, &Block{r: r, block: block})
+	}
+	return blocks, nil
+}
+
+// Transaction returns a transaction of the chain or of the pool by hash.
+func (r *Resolver) Transaction(ctx context.Context, args struct{ Hash common.Hash }) (*Transaction, error) {
+	if tx, blockHash, _, index := rawdb.ReadTransaction(r.backend.ChainDb(), args.Hash); tx != nil {
+		return &Transaction{r: r, tx: tx, blockHash: blockHash, index: index}, nil
+	}
+	if tx := r.backend.GetPoolTransaction(args.Hash); tx != nil {
+		return &Transaction{r: r, tx: tx}, nil
+	}
+	return nil, nil
+}
+
+// FilterCriteria is the filter of the logs of a block range.
+type FilterCriteria struct {
+	FromBlock *Long
+	ToBlock   *Long
+	Addresses *[]common.Address
+	Topics    *[][]common.Hash
+}
+
+// BlockFilterCriteria is the filter of the logs of a block.
+type BlockFilterCriteria struct {
+	Addresses *[]common.Address
+	Topics    *[][]common.Hash
+}
+
+func filterArgs(addresses *[]common.Address, topics *[][]common.Hash) ([]common.Address, [][]common.Hash) {
+	var (
+		addrs        []common.Address
+		filterTopics [][]common.Hash
+	)
+	if addresses != nil {
+		addrs = *addresses
+	}
+	if topics != nil {
+		filterTopics = *topics
+	}
+	return addrs, filterTopics
+}
+
+func (r *Resolver) runFilter(ctx context.Context, filter *filters.Filter) ([]*Log, error) {
+	logs, err := filter.Logs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*Log, 0, len(logs))
+	for _, log := range logs {
+		ret = append(ret, &Log{r: r, log: log})
+	}
+	return ret, nil
+}
+
+// Logs returns a page of the logs matching a filter.
+func (r *Resolver) Logs(ctx context.Context, args struct {
+	Filter FilterCriteria
+	First  *int32
+	Skip   *int32
+}) ([]*Log, error) {
+	// the range is resolved against the head once, the blocks past it have no
+	// logs yet
+	head := r.backend.CurrentBlock().NumberU64()
+	begin, end := head, head
+	if args.Filter.FromBlock != nil {
+		begin = uint64(*args.Filter.FromBlock)
+	}
+	if args.Filter.ToBlock != nil && uint64(*args.Filter.ToBlock) < head {
+		end = uint64(*args.Filter.ToBlock)
+	}
+	if begin > end {
+		return []*Log{}, nil
+	}
+	if max := r.config.MaxBlockRange; max > 0 && end-begin >= max {
+		return nil, fmt.Errorf("block range too large (%d>%d)", end-begin+1, max)
+	}
+	addrs, topics := filterArgs(args.Filter.Addresses, args.Filter.Topics)
+	logs, err := r.runFilter(ctx, filters.NewRangeFilter(r.backend, int64(begin), int64(end), addrs, topics))
+	if err != nil {
+		return nil, err
+	}
+	start, stop, err := r.page(len(logs), args.First, args.Skip)
+	if err != nil {
+		return nil, err
+	}
+	return logs[start:stop], nil
+}
+
+// committee returns the committee of an epoch, nil if it isn't known yet.
+func (r *Resolver) committee(ctx context.Context, epoch *types.EpochIDInfo) (*Committee, error) {
+	if epoch.BeginHeight > r.backend.CurrentBlock().NumberU64() {
+		return nil, nil
+	}
+	block, err := r.blockByNumber(ctx, epoch.BeginHeight)
+	if block == nil || err != nil {
+		return nil, err
+	}
+	if len(block.SwitchInfos()) == 0 && epoch.EpochID == types.GetFirstEpoch().EpochID {
+		// the first committee is the one of the genesis block
+		if block, err = r.blockByNumber(ctx, 0); block == nil || err != nil {
+			return nil, err
+		}
+	}
+	return &Committee{r: r, epoch: epoch, block: block}, nil
+}
+
+// Committee returns the committee of an epoch, or of the epoch of a block.
+func (r *Resolver) Committee(ctx context.Context, args struct {
+	Epoch  *Long
+	Number *Long
+}) (*Committee, error) {
+	var epoch *types.EpochIDInfo
+	switch {
+	case args.Epoch != nil:
+		epoch = types.GetEpochFromID(uint64(*args.Epoch))
+	case args.Number != nil:
+		epoch = types.GetEpochFromHeight(uint64(*args.Number))
+	default:
+		epoch = types.GetEpochFromHeight(r.backend.CurrentBlock().NumberU64())
+	}
+	return r.committee(ctx, epoch)
+}
+
+// Rewards returns a page of the reward records of a block range, the blocks
+// without a record are left out.
+func (r *Resolver) Rewards(ctx context.Context, args struct {
+	From  *Long
+	To    *Long
+	First *int32
+	Skip  *int32
+}) ([]*Reward, error) {
+	from, to := r.blockRange(args.From, args.To)
+	if from > to {
+		return []*Reward{}, nil
+	}
+	// The records are counted while the range is read, so the page is taken of
+	// the most records the range may have.
+	start, end, err := r.page(math.MaxInt32, args.First, args.Skip)
+	if err != nil {
+		return nil, err
+	}
+	skip, size := start, end-start
+	rewards := make([]*Reward, 0)
+	for n := from; n <= to && len(rewards) < size; n++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		reward := r.backend.GetChainRewardContent(rpc.BlockNumber(n))
+		if reward == nil {
+			continue
+		}
+		if skip > 0 {
+			skip--
+			continue
+		}
+		rewards = append(rewards, &Reward{r: r, number: n, reward: reward})
+	}
+	return rewards, nil
+}
+
+// staking loads the staking state of an epoch, the current one if epochid is
+// nil. It is read at the last block of the epoch, or at the head if the epoch
+// isn't over.
+func (r *Resolver) staking(ctx context.Context, epochid *Long) (*Staking, error) {
+	head := r.backend.CurrentBlock().NumberU64()
+	epoch := types.GetEpochFromHeight(head)
+	if epochid != nil {
+		epoch = types.GetEpochFromID(uint64(*epochid))
+	}
+	number := epoch.EndHeight
+	if number > head {
+		number = head
+	}
+	statedb, _, err := r.backend.StateAndHeaderByNumber(ctx, rpc.BlockNumber(number))
+	if statedb == nil || err != nil {
+		return nil, err
+	}
+	impawn := vm.NewImpawnImpl()
+	if err := impawn.Load(statedb, types.StakingAddress); err != nil {
+		return nil, err
+	}
+	accounts := impawn.GetStakingAccountsByEpoch(epoch.EpochID)
+	if accounts == nil {
+		return nil, nil
+	}
+	return &Staking{r: r, epoch: epoch, number: number, accounts: accounts}, nil
+}
+
+// Staking returns the staking state of an epoch.
+func (r *Resolver) Staking(ctx context.Context, args struct{ Epoch *Long }) (*Staking, error) {
+	return r.staking(ctx, args.Epoch)
+}
+
+// Delegations returns the delegations of a delegator in an epoch.
+func (r *Resolver) Delegations(ctx context.Context, args struct {
+	Address common.Address
+	Epoch   *Long
+}) ([]*Delegation, error) {
+	staking, err := r.staking(ctx, args.Epoch)
+	if staking == nil || err != nil {
+		return []*Delegation{}, err
+	}
+	delegations := make([]*Delegation, 0)
+	for _, sa := range staking.accounts {
+		for _, da := range sa.Delegation {
+			if da.Unit.Address == args.Address {
+				delegations = append(delegations, &Delegation{da: da, validator: sa.Unit.Address, number: staking.number})
+			}
+		}
+	}
+	return delegations, nil
+}
+
+// Block is a block of the chain.
+type Block struct {
+	r     *Resolver
+	block *types.Block
+}
+
+func (b *Block) Number() Long                    { return Long(b.block.NumberU64()) }
+func (b *Block) Hash() common.Hash               { return b.block.Hash() }
+func (b *Block) StateRoot() common.Hash          { return b.block.Root() }
+func (b *Block) TransactionsRoot() common.Hash   { return b.block.TxHash() }
+func (b *Block) ReceiptsRoot() common.Hash       { return b.block.ReceiptHash() }
+func (b *Block) CommitteeRoot() common.Hash      { return b.block.Header().CommitteeHash }
+func (b *Block) Proposer() common.Address        { return b.block.Proposer() }
+func (b *Block) LogsBloom() hexutil.Bytes        { return b.block.Bloom().Bytes() }
+func (b *Block) GasLimit() Long                  { return Long(b.block.GasLimit()) }
+func (b *Block) GasUsed() Long                   { return Long(b.block.GasUsed()) }
+func (b *Block) Timestamp() Long                 { return Long(b.block.Time().Uint64()) }
+func (b *Block) ExtraData() hexutil.Bytes        { return b.block.Extra() }
+func (b *Block) TransactionCount() int32         { return int32(len(b.block.Transactions())) }
+func (b *Block) Epoch() Long                     { return Long(types.GetEpochFromHeight(b.block.NumberU64()).EpochID) }
+func (b *Block) SwitchInfos() []*CommitteeMember { return newCommitteeMembers(b.block.SwitchInfos()) }
+func (b *Block) transaction(index int) *Transaction {
+	return &Transaction{r: b.r, tx: b.block.Transactions()[index], blockHash: b.block.Hash(), index: uint64(index)}
+}
+
+// Parent returns the parent block, nil for the genesis block.
+func (b *Block) Parent(ctx context.Context) (*Block, error) {
+	if b.block.NumberU64() == 0 {
+		return nil, nil
+	}
+	parent, err := b.r.backend.GetBlock(ctx, b.block.ParentHash())
+	if parent == nil || err != nil {
+		return nil, err
+	}
+	return &Block{r: b.r, block: parent}, nil
+}
+
+// Transactions returns a page of the transactions of the block.
+func (b *Block) Transactions(args struct {
+	First *int32
+	Skip  *int32
+}) ([]*Transaction, error) {
+	start, end, err := b.r.page(len(b.block.Transactions()), args.First, args.Skip)
+	if err != nil {
+		return nil, err
+	}
+	txs := make([]*Transaction, 0, end-start)
+	for i := start; i < end; i++ {
+		txs = append(txs, b.transaction(i))
+	}
+	return txs, nil
+}
+
+// TransactionAt returns the transaction at index, nil if out of range.
+func (b *Block) TransactionAt(args struct{ Index int32 }) *Transaction {
+	if args.Index < 0 || int(args.Index) >= len(b.block.Transactions()) {
+		return nil
+	}
+	return b.transaction(int(args.Index))
+}
+
+// Receipts returns a page of the receipts of the transactions of the block.
+func (b *Block) Receipts(ctx context.Context, args struct {
+	First *int32
+	Skip  *int32
+}) ([]*Receipt, error) {
+	receipts, err := b.r.backend.GetReceipts(ctx, b.block.Hash())
+	if err != nil {
+		return nil, err
+	}
+	n := len(receipts)
+	if txs := len(b.block.Transactions()); txs < n {
+		n = txs
+	}
+	start, end, err := b.r.page(n, args.First, args.Skip)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*Receipt, 0, end-start)
+	for i := start; i < end; i++ {
+		ret = append(ret, &Receipt{r: b.r, tx: b.transaction(i), receipt: receipts[i]})
+	}
+	return ret, nil
+}
+
+// Logs returns the logs of the block matching a filter.
+func (b *Block) Logs(ctx context.Context, args struct{ Filter BlockFilterCriteria }) ([]*Log, error) {
+	addrs, topics := filterArgs(args.Filter.Addresses, args.Filter.Topics)
+	return b.r.runFilter(ctx, filters.NewBlockFilter(b.r.backend, b.block.Hash(), addrs, topics))
+}
+
+// Signs returns the votes of the committee on the block.
+func (b *Block) Signs() []*Sign {
+	signs := make([]*Sign, len(b.block.Signs()))
+	for i, sign := range b.block.Signs() {
+		signs[i] = &Sign{sign: sign}
+	}
+	return signs
+}
+
+// Committee returns the committee of the epoch of the block.
+func (b *Block) Committee(ctx context.Context) (*Committee, error) {
+	return b.r.committee(ctx, types.GetEpochFromHeight(b.block.NumberU64()))
+}
+
+// Reward returns the reward record of the block, nil if it has none.
+func (b *Block) Reward() *Reward {
+	reward := b.r.backend.GetChainRewardContent(rpc.BlockNumber(b.block.NumberU64()))
+	if reward == nil {
+		return nil
+	}
+	return &Reward{r: b.r, number: b.block.NumberU64(), reward: reward}
+}
+
+// Transaction is a transaction of the chain, or of the pool if blockHash is
+// zero.
+type Transaction struct {
+	r         *Resolver
+	tx        *types.Transaction
+	blockHash common.Hash
+	index     uint64
+}
+
+func (t *Transaction) Hash() common.Hash        { return t.tx.Hash() }
+func (t *Transaction) Nonce() Long              { return Long(t.tx.Nonce()) }
+func (t *Transaction) To() *common.Address      { return t.tx.To() }
+func (t *Transaction) Value() hexutil.Big       { return hexutil.Big(*t.tx.Value()) }
+func (t *Transaction) GasPrice() hexutil.Big    { return hexutil.Big(*t.tx.GasPrice()) }
+func (t *Transaction) Gas() Long                { return Long(t.tx.Gas()) }
+func (t *Transaction) InputData() hexutil.Bytes { return t.tx.Data() }
+func (t *Transaction) Payer() *common.Address   { return t.tx.Payer() }
+func (t *Transaction) Fee() *hexutil.Big        { return (*hexutil.Big)(t.tx.Fee()) }
+func (t *Transaction) pending() bool            { return t.blockHash == (common.Hash{}) }
+func (t *Transaction) signer() types.Signer     { return types.NewTIP1Signer(t.tx.ChainId()) }
+
+// Index returns the index of the transaction in its block, nil if pending.
+func (t *Transaction) Index() *int32 {
+	if t.pending() {
+		return nil
+	}
+	index := int32(t.index)
+	return &index
+}
+
+// From returns the sender of the transaction.
+func (t *Transaction) From() (common.Address, error) {
+	return types.Sender(t.signer(), t.tx)
+}
+
+// Block returns the block including the transaction, nil if pending.
+func (t *Transaction) Block(ctx context.Context) (*Block, error) {
+	if t.pending() {
+		return nil, nil
+	}
+	block, err := t.r.backend.GetBlock(ctx, t.blockHash)
+	if block == nil || err != nil {
+		return nil, err
+	}
+	return &Block{r: t.r, block: block}, nil
+}
+
+// Receipt returns the receipt of the transaction, nil if pending.
+func (t *Transaction) Receipt(ctx context.Context) (*Receipt, error) {
+	if t.pending() {
+		return nil, nil
+	}
+	receipts, err := t.r.backend.GetReceipts(ctx, t.blockHash)
+	if err != nil || uint64(len(receipts)) <= t.index {
+		return nil, err
+	}
+	return &Receipt{r: t.r, tx: t, receipt: receipts[t.index]}, nil
+}
+
+// Receipt is the outcome of a transaction included in a block.
+type Receipt struct {
+	r       *Resolver
+	tx      *Transaction
+	receipt *types.Receipt
+}
+
+func (r *Receipt) Status() Long              { return Long(r.receipt.Status) }
+func (r *Receipt) GasUsed() Long             { return Long(r.receipt.GasUsed) }
+func (r *Receipt) CumulativeGasUsed() Long   { return Long(r.receipt.CumulativeGasUsed) }
+func (r *Receipt) LogsBloom() hexutil.Bytes  { return r.receipt.Bloom.Bytes() }
+func (r *Receipt) Transaction() *Transaction { return r.tx }
+
+// ContractAddress returns the contract created by the transaction, nil if none.
+func (r *Receipt) ContractAddress() *common.Address {
+	if r.receipt.ContractAddress == (common.Address{}) {
+		return nil
+	}
+	return &r.receipt.ContractAddress
+}
+
+// Logs returns the logs emitted by the transaction.
+func (r *Receipt) Logs() []*Log {
+	logs := make([]*Log, len(r.receipt.Logs))
+	for i, log := range r.receipt.Logs {
+		logs[i] = &Log{r: r.r, log: log}
+	}
+	return logs
+}
+
+// Log is a log entry emitted by a contract.
+type Log struct {
+	r   *Resolver
+	log *types.Log
+}
+
+func (l *Log) Index() int32            { return int32(l.log.Index) }
+func (l *Log) Account() common.Address { return l.log.Address }
+func (l *Log) Topics() []common.Hash   { return l.log.Topics }
+func (l *Log) Data() hexutil.Bytes     { return l.log.Data }
+
+// Transaction returns the transaction that emitted the log.
+func (l *Log) Transaction(ctx context.Context) (*Transaction, error) {
+	block, err := l.r.backend.GetBlock(ctx, l.log.BlockHash)
+	if err != nil {
+		return nil, err
+	}
+	if block == nil || int(l.log.TxIndex) >= len(block.Transactions()) {
+		return nil, fmt.Errorf("transaction %x of the log not found", l.log.TxHash)
+	}
+	return (&Block{r: l.r, block: block}).transaction(int(l.log.TxIndex)), nil
+}
+
+// Sign is the vote of a committee member on a block.
+type Sign struct {
+	sign *types.PbftSign
+}
+
+func (s *Sign) FastHeight() Long      { return Long(s.sign.FastHeight.Uint64()) }
+func (s *Sign) FastHash() common.Hash { return s.sign.FastHash }
+func (s *Sign) Result() int32         { return int32(s.sign.Result) }
+func (s *Sign) Sign() hexutil.Bytes   { return s.sign.Sign }
+
+// Signer returns the committee base of the member recovered from the signature,
+// nil if it doesn't recover.
+func (s *Sign) Signer() *common.Address {
+	pubkey, err := crypto.SigToPub(s.sign.HashWithNoSign().Bytes(), s.sign.Sign)
+	if err != nil {
+		return nil
+	}
+	signer := crypto.PubkeyToAddress(*pubkey)
+	return &signer
+}
+
+// CommitteeMember is a member of a committee, as recorded by a switch block.
+type CommitteeMember struct {
+	member *types.CommitteeMember
+}
+
+func newCommitteeMembers(infos []*types.CommitteeMember) []*CommitteeMember {
+	members := make([]*CommitteeMember, len(infos))
+	for i, m := range infos {
+		members[i] = &CommitteeMember{member: m}
+	}
+	return members
+}
+
+func (m *CommitteeMember) Coinbase() common.Address      { return m.member.Coinbase }
+func (m *CommitteeMember) CommitteeBase() common.Address { return m.member.CommitteeBase }
+func (m *CommitteeMember) PublicKey() hexutil.Bytes      { return m.member.Publickey }
+func (m *CommitteeMember) Flag() int32                   { return int32(m.member.Flag) }
+func (m *CommitteeMember) Type() int32                   { return int32(m.member.MType) }
+func (m *CommitteeMember) Used() bool                    { return m.member.Flag == types.StateUsedFlag }
+
+// Committee is the committee signing the blocks of an epoch.
+type Committee struct {
+	r     *Resolver
+	epoch *types.EpochIDInfo
+	block *types.Block // block recording the committee
+}
+
+func (c *Committee) Epoch() Long       { return Long(c.epoch.EpochID) }
+func (c *Committee) BeginHeight() Long { return Long(c.epoch.BeginHeight) }
+func (c *Committee) EndHeight() Long   { return Long(c.epoch.EndHeight) }
+func (c *Committee) Block() *Block     { return &Block{r: c.r, block: c.block} }
+
+// Members returns a page of the switch infos of the block of the committee.
+func (c *Committee) Members(args struct {
+	First *int32
+	Skip  *int32
+}) ([]*CommitteeMember, error) {
+	infos := c.block.SwitchInfos()
+	start, end, err := c.r.page(len(infos), args.First, args.Skip)
+	if err != nil {
+		return nil, err
+	}
+	return newCommitteeMembers(infos[start:end]), nil
+}
+
+// Reward is the reward record of a block.
+type Reward struct {
+	r      *Resolver
+	number uint64
+	reward *types.ChainReward
+}
+
+func (r *Reward) Number() Long              { return Long(r.number) }
+func (r *Reward) Time() Long                { return Long(r.reward.St) }
+func (r *Reward) Fee() *hexutil.Big         { return (*hexutil.Big)(r.reward.Fee) }
+func (r *Reward) BlockReward() *hexutil.Big { return (*hexutil.Big)(r.reward.BlockReward) }
+
+// Validators returns a page of the rewards of the validators and their
+// delegations.
+func (r *Reward) Validators(args struct {
+	First *int32
+	Skip  *int32
+}) ([]*ValidatorReward, error) {
+	var validators []*ValidatorReward
+	for _, infos := range r.reward.CommitteeBase {
+		// the first item is the reward of the validator itself
+		if len(infos.Items) > 0 {
+			validators = append(validators, &ValidatorReward{infos: infos})
+		}
+	}
+	start, end, err := r.r.page(len(validators), args.First, args.Skip)
+	if err != nil {
+		return nil, err
+	}
+	return validators[start:end], nil
+}
+
+// Shares returns the shares paid apart from the stakers.
+func (r *Reward) Shares() []*RewardShare {
+	shares := make([]*RewardShare, len(r.reward.Shares))
+	for i, share := range r.reward.Shares {
+		shares[i] = &RewardShare{share: share}
+	}
+	return shares
+}
+
+// ValidatorReward is the reward paid to a validator and its delegations.
+type ValidatorReward struct {
+	infos *types.SARewardInfos
+}
+
+func (v *ValidatorReward) Validator() *RewardInfo { return &RewardInfo{info: v.infos.Items[0]} }
+
+// Delegations returns the rewards of the delegations of the validator.
+func (v *ValidatorReward) Delegations() []*RewardInfo {
+	infos := make([]*RewardInfo, 0, len(v.infos.Items)-1)
+	for _, info := range v.infos.Items[1:] {
+		infos = append(infos, &RewardInfo{info: info})
+	}
+	return infos
+}
+
+// RewardInfo is the reward paid to a staker.
+type RewardInfo struct {
+	info *types.RewardInfo
+}
+
+func (r *RewardInfo) Address() common.Address { return r.info.Address }
+func (r *RewardInfo) Amount() hexutil.Big     { return hexutil.Big(*r.info.Amount) }
+func (r *RewardInfo) Staking() *hexutil.Big   { return (*hexutil.Big)(r.info.Staking) }
+
+// RewardShare is a part of the fees and the block reward paid apart from the
+// stakers.
+type RewardShare struct {
+	share *types.RewardShare
+}
+
+func (s *RewardShare) Kind() int32             { return int32(s.share.Kind) }
+func (s *RewardShare) Address() common.Address { return s.share.Address }
+func (s *RewardShare) Amount() hexutil.Big     { return hexutil.Big(*s.share.Amount) }
+
+// Staking is the staking state of an epoch.
+type Staking struct {
+	r        *Resolver
+	epoch    *types.EpochIDInfo
+	number   uint64 // block the state is read at
+	accounts vm.SAImpawns
+}
+
+func (s *Staking) Epoch() Long         { return Long(s.epoch.EpochID) }
+func (s *Staking) Number() Long        { return Long(s.number) }
+func (s *Staking) AccountCount() int32 { return int32(len(s.accounts)) }
+
+// Accounts returns a page of the staking accounts of the epoch.
+func (s *Staking) Accounts(args struct {
+	First *int32
+	Skip  *int32
+}) ([]*StakingAccount, error) {
+	start, end, err := s.r.page(len(s.accounts), args.First, args.Skip)
+	if err != nil {
+		return nil, err
+	}
+	accounts := make([]*StakingAccount, 0, end-start)
+	for _, sa := range s.accounts[start:end] {
+		accounts = append(accounts, &StakingAccount{r: s.r, sa: sa, number: s.number})
+	}
+	return accounts, nil
+}
+
+// Account returns the staking account of a validator, nil if it has none.
+func (s *Staking) Account(args struct{ Address common.Address }) *StakingAccount {
+	for _, sa := range s.accounts {
+		if sa.Unit.Address == args.Address {
+			return &StakingAccount{r: s.r, sa: sa, number: s.number}
+		}
+	}
+	return nil
+}
+
+// StakingAccount is a validator staking account.
+type StakingAccount struct {
+	r      *Resolver
+	sa     *vm.StakingAccount
+	number uint64
+}
+
+func (s *StakingAccount) Address() common.Address   { return s.sa.Unit.Address }
+func (s *StakingAccount) VotePubKey() hexutil.Bytes { return s.sa.Votepubkey }
+func (s *StakingAccount) Fee() hexutil.Big          { return hexutil.Big(*s.sa.Fee) }
+func (s *StakingAccount) Committee() bool           { return s.sa.Committee }
+func (s *StakingAccount) Staking() hexutil.Big      { return hexutil.Big(*s.sa.GetAllStaking(s.number)) }
+func (s *StakingAccount) ValidStaking() hexutil.Big {
+	return hexutil.Big(*s.sa.GetValidStaking(s.number))
+}
+func (s *StakingAccount) DelegationCount() int32 { return int32(len(s.sa.Delegation)) }
+
+// Delegations returns a page of the delegations of the validator.
+func (s *StakingAccount) Delegations(args struct {
+	First *int32
+	Skip  *int32
+}) ([]*Delegation, error) {
+	start, end, err := s.r.page(len(s.sa.Delegation), args.First, args.Skip)
+	if err != nil {
+		return nil, err
+	}
+	delegations := make([]*Delegation, 0, end-start)
+	for _, da := range s.sa.Delegation[start:end] {
+		delegations = append(delegations, &Delegation{da: da, validator: s.sa.Unit.Address, number: s.number})
+	}
+	return delegations, nil
+}
+
+// Delegation is a stake delegated to a validator.
+type Delegation struct {
+	da        *vm.DelegationAccount
+	validator common.Address
+	number    uint64
+}
+
+func (d *Delegation) Address() common.Address   { return d.da.Unit.Address }
+func (d *Delegation) Validator() common.Address { return d.validator }
+func (d *Delegation) Staking() hexutil.Big      { return hexutil.Big(*d.da.GetAllStaking(d.number)) }
+func (d *Delegation) ValidStaking() hexutil.Big { return hexutil.Big(*d.da.GetValidStaking(d.number)) }
@@ -0,0 +1,307 @@
+package graphql
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/core/rawdb"
+	"git.taiyue.io/pist/go-pist/core/state"
+	"git.taiyue.io/pist/go-pist/core/types"
+	"git.taiyue.io/pist/go-pist/core/vm"
+	"git.taiyue.io/pist/go-pist/crypto"
+	"git.taiyue.io/pist/go-pist/pistdb"
+	"git.taiyue.io/pist/go-pist/rpc"
+	"github.com/graph-gophers/graphql-go"
+)
+
+// testBackend serves a short chain from memory, the methods the resolvers
+// don't call are left unimplemented.
+type testBackend struct {
+	Backend
+	db       pistdb.Database
+	blocks   []*types.Block
+	receipts map[common.Hash]types.Receipts
+	rewards  map[uint64]*types.ChainReward
+	statedb  *state.StateDB
+}
+
+func (b *testBackend) ChainDb() pistdb.Database   { return b.db }
+func (b *testBackend) CurrentBlock() *types.Block { return b.blocks[len(b.blocks)-1] }
+
+func (b *testBackend) BlockByNumber(ctx context.Context, number rpc.BlockNumber) (*types.Block, error) {
+	if number < 0 || int(number) >= len(b.blocks) {
+		return nil, nil
+	}
+	return b.blocks[number], nil
+}
+
+func (b *testBackend) GetBlock(ctx context.Context, hash common.Hash) (*types.Block, error) {
+	for _, block := range b.blocks {
+		if block.Hash() == hash {
+			return block, nil
+		}
+	}
+	return nil, nil
+}
+
+func (b *testBackend) GetReceipts(ctx context.Context, hash common.Hash) (types.Receipts, error) {
+	return b.receipts[hash], nil
+}
+
+func (b *testBackend) GetPoolTransaction(hash common.Hash) *types.Transaction { return nil }
+
+func (b *testBackend) GetChainRewardContent(number rpc.BlockNumber) *types.ChainReward {
+	return b.rewards[uint64(number)]
+}
+
+func (b *testBackend) StateAndHeaderByNumber(ctx context.Context, number rpc.BlockNumber) (*state.StateDB, *types.Header, error) {
+	return b.statedb.Copy(), b.blocks[number].Header(), nil
+}
+
+func newTestBackend(t *testing.T) (*testBackend, []*ecdsa.PrivateKey) {
+	var (
+		keys   []*ecdsa.PrivateKey
+		infos  []*types.CommitteeMember
+		signer = types.NewTIP1Signer(big.NewInt(1))
+		b      = &testBackend{
+			db:       pistdb.NewMemDatabase(),
+			receipts: make(map[common.Hash]types.Receipts),
+			rewards:  make(map[uint64]*types.ChainReward),
+		}
+	)
+	for n := 0; n < 4; n++ {
+		key, _ := crypto.GenerateKey()
+		keys = append(keys, key)
+		infos = append(infos, types.NewCommitteeMember(crypto.PubkeyToAddress(key.PublicKey), crypto.FromECDSAPub(&key.PublicKey), types.StateUsedFlag, types.TypeWorked))
+	}
+	header := func(number int64) *types.Header {
+		return &types.Header{Number: big.NewInt(number), Time: big.NewInt(number * 10), GasLimit: 8000000}
+	}
+	b.blocks = append(b.blocks, types.NewBlock(header(0), nil, nil, nil, infos))
+	for n := int64(1); n <= 3; n++ {
+		var tx *types.Transaction
+		if n == 2 {
+			tx = types.NewTransaction_Payment(uint64(n), common.Address{0x02}, big.NewInt(n), big.NewInt(100), 21000, big.NewInt(1), nil, crypto.PubkeyToAddress(keys[1].PublicKey))
+			tx, _ = types.SignTx(tx, signer, keys[0])
+			tx, _ = types.SignTx_Payment(tx, signer, keys[1])
+		} else {
+			tx, _ = types.SignTx(types.NewTransaction(uint64(n), common.Address{0x02}, big.NewInt(n), 21000, big.NewInt(1), nil), signer, keys[0])
+		}
+		h := header(n)
+		h.ParentHash = b.blocks[n-1].Hash()
+		block := types.NewBlock(h, []*types.Transaction{tx}, nil, nil, nil)
+		sign := &types.PbftSign{FastHeight: block.Number(), FastHash: block.Hash(), Result: types.VoteAgree}
+		sign.Sign, _ = crypto.Sign(sign.HashWithNoSign().Bytes(), keys[0])
+		block = block.WithBody([]*types.Transaction{tx}, []*types.PbftSign{sign}, nil, nil)
+
+		b.blocks = append(b.blocks, block)
+		b.receipts[block.Hash()] = types.Receipts{{Status: types.ReceiptStatusSuccessful, GasUsed: 21000, CumulativeGasUsed: 21000, TxHash: tx.Hash()}}
+		rawdb.WriteBlock(b.db, block)
+		rawdb.WriteCanonicalHash(b.db, block.Hash(), block.NumberU64())
+		rawdb.WriteTxLookupEntries(b.db, block)
+	}
+	b.rewards[2] = &types.ChainReward{
+		Height: 2,
+		St:     20,
+		CommitteeBase: []*types.SARewardInfos{{Items: []*types.RewardInfo{
+			{Address: common.Address{0x0a}, Amount: big.NewInt(10), Staking: big.NewInt(100)},
+			{Address: common.Address{0x0d}, Amount: big.NewInt(5), Staking: big.NewInt(50)},
+		}}},
+		Fee:         big.NewInt(3),
+		BlockReward: big.NewInt(7),
+	}
+
+	// Stake on a validator with a delegation in the epoch before the first one
+	impawn := vm.NewImpawnImpl()
+	if err := impawn.InsertSAccount2(0, common.Address{0x0a}, crypto.FromECDSAPub(&keys[0].PublicKey), big.NewInt(100), big.NewInt(50), true); err != nil {
+		t.Fatal(err)
+	}
+	if err := impawn.InsertDAccount2(0, common.Address{0x0a}, common.Address{0x0d}, big.NewInt(50)); err != nil {
+		t.Fatal(err)
+	}
+	b.statedb, _ = state.New(common.Hash{}, state.NewDatabase(pistdb.NewMemDatabase()))
+	if err := impawn.Save(b.statedb, types.StakingAddress); err != nil {
+		t.Fatal(err)
+	}
+	return b, keys
+}
+
+func TestQueries(t *testing.T) {
+	backend, keys := newTestBackend(t)
+	config := DefaultConfig
+	config.MaxPageSize = 2
+	schema := graphql.MustParseSchema(schema, &Resolver{backend: backend, config: &config})
+
+	sender := strings.ToLower(crypto.PubkeyToAddress(keys[0].PublicKey).Hex())
+	payer := strings.ToLower(crypto.PubkeyToAddress(keys[1].PublicKey).Hex())
+	tx := backend.blocks[2].Transactions()[0].Hash().Hex()
+
+	tests := []struct {
+		query string
+		want  string
+		err   bool
+	}{
+		// Block ranges are cut in pages of at most the configured size
+		{
+			query: `{ blocks(from: 1) { number } }`,
+			want:  `{"blocks":[{"number":1},{"number":2}]}`,
+		},
+		{
+			query: `{ blocks(from: 1, to: 2, first: 5, skip: 1) { number parent { number } } }`,
+			want:  `{"blocks":[{"number":2,"parent":{"number":1}}]}`,
+		},
+		{
+			query: `{ blocks(first: -1) { number } }`,
+			err:   true,
+		},
+		// Transactions, the sponsored ones with their payer and fee
+		{
+			query: `{ block(number: 2) { transactionCount transactions { from payer fee } receipts { status gasUsed } } }`,
+			want:  `{"block":{"transactionCount":1,"transactions":[{"from":"` + sender + `","payer":"` + payer + `","fee":"0x64"}],"receipts":[{"status":1,"gasUsed":21000}]}}`,
+		},
+		{
+			query: `{ block(number: 1) { transactionAt(index: 0) { payer fee receipt { transaction { index } } } } }`,
+			want:  `{"block":{"transactionAt":{"payer":null,"fee":null,"receipt":{"transaction":{"index":0}}}}}`,
+		},
+		{
+			query: `{ transaction(hash: "` + tx + `") { nonce block { number } } }`,
+			want:  `{"transaction":{"nonce":2,"block":{"number":2}}}`,
+		},
+		// The committee of the first epoch is the one of the genesis block
+		{
+			query: `{ committee(epoch: 1) { epoch block { number } members(first: 1) { committeeBase used } } }`,
+			want:  `{"committee":{"epoch":1,"block":{"number":0},"members":[{"committeeBase":"` + sender + `","used":true}]}}`,
+		},
+		{
+			query: `{ block(number: 3) { signs { fastHeight signer } committee { epoch } } }`,
+			want:  `{"block":{"signs":[{"fastHeight":3,"signer":"` + sender + `"}],"committee":{"epoch":1}}}`,
+		},
+		// Reward records, the blocks without one are left out
+		{
+			query: `{ rewards(from: 1) { number fee validators { validator { amount } delegations { address amount staking } } } }`,
+			want:  `{"rewards":[{"number":2,"fee":"0x3","validators":[{"validator":{"amount":"0xa"},"delegations":[{"address":"0x0d00000000000000000000000000000000000000","amount":"0x5","staking":"0x32"}]}]}]}`,
+		},
+		{
+			query: `{ block(number: 1) { reward { number } } }`,
+			want:  `{"block":{"reward":null}}`,
+		},
+		// Staking accounts and delegations by epoch
+		{
+			query: `{ staking(epoch: 0) { epoch accountCount accounts { address fee staking delegations { address staking } } } }`,
+			want:  `{"staking":{"epoch":0,"accountCount":1,"accounts":[{"address":"0x0a00000000000000000000000000000000000000","fee":"0x32","staking":"0x96","delegations":[{"address":"0x0d00000000000000000000000000000000000000","staking":"0x32"}]}]}}`,
+		},
+		{
+			query: `{ delegations(address: "0x0d00000000000000000000000000000000000000", epoch: 0) { validator validStaking } }`,
+			want:  `{"delegations":[{"validator":"0x0a00000000000000000000000000000000000000","validStaking":"0x32"}]}`,
+		},
+		{
+			query: `{ staking(epoch: 1) { epoch } }`,
+			want:  `{"staking":null}`,
+		},
+	}
+	for i, tt := range tests {
+		resp := schema.Exec(context.Background(), tt.query, "", nil)
+		if tt.err {
+			if len(resp.Errors) == 0 {
+				t.Errorf("test %d: expected error, got %s", i, resp.Data)
+			}
+			continue
+		}
+		if len(resp.Errors) > 0 {
+			t.Errorf("test %d: query failed: %v", i, resp.Errors)
+			continue
+		}
+		var have, want interface{}
+		if err := json.Unmarshal(resp.Data, &have); err != nil {
+			t.Fatal(err)
+		}
+		if err := json.Unmarshal([]byte(tt.want), &want); err != nil {
+			t.Fatal(err)
+		}
+		haveJSON, _ := json.Marshal(have)
+		wantJSON, _ := json.Marshal(want)
+		if string(haveJSON) != string(wantJSON) {
+			t.Errorf("test %d: result mismatch\nhave %s\nwant %s", i, haveJSON, wantJSON)
+		}
+	}
+}
+
+func TestQueryLimits(t *testing.T) {
+	backend, _ := newTestBackend(t)
+	config := DefaultConfig
+	config.MaxBlockRange = 2
+	config.MaxDepth = 3
+	config.MaxComplexity = 4
+	schema, err := newSchema(&config, backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		query string
+		err   bool
+	}{
+		// The block range of the logs is capped
+		{query: `{ logs(filter: {fromBlock: 1, toBlock: 3}) { index } }`, err: true},
+		{query: `{ logs(filter: {fromBlock: 1}) { index } }`, err: true},
+		// The depth of a query
+		{query: `{ block(number: 3) { parent { number } } }`},
+		{query: `{ block(number: 3) { parent { parent { number } } } }`, err: true},
+		// The fields a query resolves, counting those of each item of a list
+		{query: `{ blocks(from: 1, to: 2) { number } }`},
+		{query: `{ blocks(from: 1, to: 3) { number hash } }`, err: true},
+	}
+	for i, tt := range tests {
+		resp := schema.Exec(context.Background(), tt.query, "", nil)
+		if tt.err && len(resp.Errors) == 0 {
+			t.Errorf("test %d: expected error, got %s", i, resp.Data)
+		}
+		if !tt.err && len(resp.Errors) > 0 {
+			t.Errorf("test %d: query failed: %v", i, resp.Errors)
+		}
+	}
+}
+
+// Tests the queries are served only to the tokens allowed the graphql module
+// when the node authenticates its requests.
+func TestServiceAuth(t *testing.T) {
+	backend, _ := newTestBackend(t)
+	auth, err := rpc.NewAuth(&rpc.AuthConfig{Tokens: []rpc.Permission{
+		{Name: "graphql", Secret: "0x0102", Modules: []string{"graphql"}},
+		{Name: "rpc", Secret: "0x0304", Modules: []string{"pist"}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	service, err := New(&DefaultConfig, backend, auth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, test := range []struct {
+		secret []byte
+		code   int
+	}{
+		{nil, http.StatusUnauthorized},
+		{[]byte{3, 4}, http.StatusForbidden},
+		{[]byte{1, 2}, http.StatusOK},
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query": "{ block { number } }"}`))
+		if test.secret != nil {
+			req.Header.Set("Authorization", "Bearer "+rpc.NewAuthToken(test.secret, 0))
+		}
+		rec := httptest.NewRecorder()
+		service.handler.ServeHTTP(rec, req)
+		if rec.Code != test.code {
+			t.Errorf("test %d: status mismatch: have %d, want %d", i, rec.Code, test.code)
+		}
+		if test.code == http.StatusOK && !strings.Contains(rec.Body.String(), `"number":3`) {
+			t.Errorf("test %d: query not served: %s", i, rec.Body.String())
+		}
+	}
+}
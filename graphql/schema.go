@@ -0,0 +1,317 @@
+package graphql
+
+const schema string = `
+    # Bytes32 is a 32 byte binary string, represented as 0x-prefixed hexadecimal.
+    scalar Bytes32
+    # Address is a 20 byte Pistchain address, represented as 0x-prefixed hexadecimal.
+    scalar Address
+    # Bytes is an arbitrary length binary string, represented as 0x-prefixed hexadecimal.
+    # An empty byte string is represented as '0x'.
+    scalar Bytes
+    # BigInt is a large integer. Input is accepted as either a JSON number or as a
+    # 0x-prefixed hexadecimal string. Output values are all 0x-prefixed hexadecimal.
+    scalar BigInt
+    # Long is a 64 bit unsigned integer. Input is accepted as either a JSON number or
+    # as a decimal or 0x-prefixed hexadecimal string.
+    scalar Long
+
+    schema {
+        query: Query
+    }
+
+    # Log is a log entry emitted by a contract.
+    type Log {
+        # Index is the index of the log in its block.
+        index: Int!
+        # Account is the contract that emitted the log.
+        account: Address!
+        # Topics is the list of the indexed topics of the log.
+        topics: [Bytes32!]!
+        # Data is the unindexed data of the log.
+        data: Bytes!
+        # Transaction is the transaction that emitted the log.
+        transaction: Transaction!
+    }
+
+    # Transaction is a transaction of the chain, or of the pool if not included yet.
+    type Transaction {
+        # Hash is the hash of the transaction.
+        hash: Bytes32!
+        # Nonce is the nonce of the sender account of the transaction.
+        nonce: Long!
+        # Index is the index of the transaction in its block, null if pending.
+        index: Int
+        # From is the sender of the transaction.
+        from: Address!
+        # To is the recipient of the transaction, null for a contract creation.
+        to: Address
+        # Value is the value in wei sent by the transaction.
+        value: BigInt!
+        # GasPrice is the price in wei offered for each unit of gas.
+        gasPrice: BigInt!
+        # Gas is the most gas the transaction may use.
+        gas: Long!
+        # InputData is the data passed to the recipient.
+        inputData: Bytes!
+        # Payer is the account paying the gas of a sponsored transaction, null if
+        # the sender pays it.
+        payer: Address
+        # Fee is the fee paid by the payer of the transaction, null if none.
+        fee: BigInt
+        # Block is the block including the transaction, null if pending.
+        block: Block
+        # Receipt is the receipt of the transaction, null if pending.
+        receipt: Receipt
+    }
+
+    # Receipt is the outcome of a transaction included in a block.
+    type Receipt {
+        # Status is 1 if the transaction succeeded and 0 if it failed.
+        status: Long!
+        # GasUsed is the gas used by the transaction.
+        gasUsed: Long!
+        # CumulativeGasUsed is the gas used by the block up to the transaction.
+        cumulativeGasUsed: Long!
+        # ContractAddress is the contract created by the transaction, null if none.
+        contractAddress: Address
+        # LogsBloom is the bloom filter of the logs of the transaction.
+        logsBloom: Bytes!
+        # Logs are the logs emitted by the transaction.
+        logs: [Log!]!
+        # Transaction is the transaction of the receipt.
+        transaction: Transaction!
+    }
+
+    # Sign is the vote of a committee member on a block.
+    type Sign {
+        # FastHeight is the number of the block voted on.
+        fastHeight: Long!
+        # FastHash is the hash of the block voted on.
+        fastHash: Bytes32!
+        # Result is 1 for an agreement and 0 against.
+        result: Int!
+        # Sign is the signature of the member.
+        sign: Bytes!
+        # Signer is the committee base of the member recovered from the signature,
+        # null if it doesn't recover.
+        signer: Address
+    }
+
+    # CommitteeMember is a member of a committee, as recorded by a switch block.
+    type CommitteeMember {
+        # Coinbase is the address the rewards of the member are paid to.
+        coinbase: Address!
+        # CommitteeBase is the address of the public key of the member.
+        committeeBase: Address!
+        # PublicKey is the public key the member signs with.
+        publicKey: Bytes!
+        # Flag is the state of the member, 0xa1 while it signs blocks.
+        flag: Int!
+        # Type is the kind of member, 0xa2 for a worker and 0xa3 for a backup.
+        type: Int!
+        # Used is true if the member signs the blocks of the epoch.
+        used: Boolean!
+    }
+
+    # Committee is the committee signing the blocks of an epoch.
+    type Committee {
+        # Epoch is the id of the epoch.
+        epoch: Long!
+        # BeginHeight is the first block of the epoch.
+        beginHeight: Long!
+        # EndHeight is the last block of the epoch.
+        endHeight: Long!
+        # Block is the block recording the committee, the genesis block for the
+        # first epoch.
+        block: Block!
+        # Members are the switch infos of the block of the committee.
+        members(first: Int, skip: Int): [CommitteeMember!]!
+    }
+
+    # RewardInfo is the reward paid to a staker.
+    type RewardInfo {
+        # Address is the account of the staker.
+        address: Address!
+        # Amount is the reward in wei.
+        amount: BigInt!
+        # Staking is the stake the reward is paid for, null if not recorded.
+        staking: BigInt
+    }
+
+    # ValidatorReward is the reward paid to a validator and its delegations.
+    type ValidatorReward {
+        # Validator is the reward of the validator itself.
+        validator: RewardInfo!
+        # Delegations are the rewards of the delegations of the validator.
+        delegations: [RewardInfo!]!
+    }
+
+    # RewardShare is a part of the fees and the block reward paid apart from the
+    # stakers.
+    type RewardShare {
+        # Kind is 1 for the proposer, 2 for the committee and 3 for the treasury.
+        kind: Int!
+        # Address is the account paid.
+        address: Address!
+        # Amount is the share in wei.
+        amount: BigInt!
+    }
+
+    # Reward is the reward record of a block.
+    type Reward {
+        # Number is the number of the block.
+        number: Long!
+        # Time is the time the reward was paid at.
+        time: Long!
+        # Fee is the fees of the block, null if not recorded.
+        fee: BigInt
+        # BlockReward is the reward minted by the reward policy, null if not recorded.
+        blockReward: BigInt
+        # Validators are the rewards of the validators and their delegations.
+        validators(first: Int, skip: Int): [ValidatorReward!]!
+        # Shares are the shares paid apart from the stakers.
+        shares: [RewardShare!]!
+    }
+
+    # Delegation is a stake delegated to a validator.
+    type Delegation {
+        # Address is the delegator.
+        address: Address!
+        # Validator is the validator the stake is delegated to.
+        validator: Address!
+        # Staking is the amount delegated.
+        staking: BigInt!
+        # ValidStaking is the amount counted in the elections.
+        validStaking: BigInt!
+    }
+
+    # StakingAccount is a validator staking account.
+    type StakingAccount {
+        # Address is the validator.
+        address: Address!
+        # VotePubKey is the public key the validator signs blocks with.
+        votePubKey: Bytes!
+        # Fee is the commission rate of the validator.
+        fee: BigInt!
+        # Committee is true if the validator is elected in the committee.
+        committee: Boolean!
+        # Staking is the amount staked on the validator, its delegations included.
+        staking: BigInt!
+        # ValidStaking is the amount counted in the elections.
+        validStaking: BigInt!
+        # DelegationCount is the number of the delegations of the validator.
+        delegationCount: Int!
+        # Delegations are the delegations of the validator.
+        delegations(first: Int, skip: Int): [Delegation!]!
+    }
+
+    # Staking is the staking state of an epoch, read at its last block or at the
+    # head for the current epoch.
+    type Staking {
+        # Epoch is the id of the epoch.
+        epoch: Long!
+        # Number is the number of the block the state is read at.
+        number: Long!
+        # AccountCount is the number of the staking accounts of the epoch.
+        accountCount: Int!
+        # Accounts are the staking accounts of the epoch.
+        accounts(first: Int, skip: Int): [StakingAccount!]!
+        # Account is the staking account of a validator, null if it has none.
+        account(address: Address!): StakingAccount
+    }
+
+    # Block is a block of the chain.
+    type Block {
+        # Number is the number of the block.
+        number: Long!
+        # Hash is the hash of the block.
+        hash: Bytes32!
+        # Parent is the parent block, null for the genesis block.
+        parent: Block
+        # StateRoot is the root of the state trie after the block.
+        stateRoot: Bytes32!
+        # TransactionsRoot is the root of the trie of the transactions.
+        transactionsRoot: Bytes32!
+        # ReceiptsRoot is the root of the trie of the receipts.
+        receiptsRoot: Bytes32!
+        # CommitteeRoot is the hash of the switch infos of the block.
+        committeeRoot: Bytes32!
+        # Proposer is the committee member that proposed the block.
+        proposer: Address!
+        # LogsBloom is the bloom filter of the logs of the block.
+        logsBloom: Bytes!
+        # GasLimit is the most gas the transactions of the block may use.
+        gasLimit: Long!
+        # GasUsed is the gas used by the transactions of the block.
+        gasUsed: Long!
+        # Timestamp is the unix time the block was proposed at.
+        timestamp: Long!
+        # ExtraData is the extra data of the block.
+        extraData: Bytes!
+        # Epoch is the id of the epoch of the block.
+        epoch: Long!
+        # TransactionCount is the number of the transactions of the block.
+        transactionCount: Int!
+        # Transactions are the transactions of the block.
+        transactions(first: Int, skip: Int): [Transaction!]!
+        # TransactionAt returns the transaction at index, null if out of range.
+        transactionAt(index: Int!): Transaction
+        # Receipts are the receipts of the transactions of the block.
+        receipts(first: Int, skip: Int): [Receipt!]!
+        # Logs returns the logs of the block matching the filter.
+        logs(filter: BlockFilterCriteria!): [Log!]!
+        # Signs are the votes of the committee on the block.
+        signs: [Sign!]!
+        # SwitchInfos are the committee changes recorded by the block.
+        switchInfos: [CommitteeMember!]!
+        # Committee is the committee of the epoch of the block.
+        committee: Committee
+        # Reward is the reward record of the block, null if it has none.
+        reward: Reward
+    }
+
+    # BlockFilterCriteria encapsulates the criteria of a log filter on a block.
+    input BlockFilterCriteria {
+        # Addresses are the contracts the logs are emitted by, any if omitted.
+        addresses: [Address!]
+        # Topics are the topics the logs match, by position. An empty list at a
+        # position matches any topic, a list of several topics any of them.
+        topics: [[Bytes32!]!]
+    }
+
+    # FilterCriteria encapsulates the criteria of a log filter on a block range.
+    input FilterCriteria {
+        # FromBlock is the first block searched, the head if omitted.
+        fromBlock: Long
+        # ToBlock is the last block searched, the head if omitted.
+        toBlock: Long
+        # Addresses are the contracts the logs are emitted by, any if omitted.
+        addresses: [Address!]
+        # Topics are the topics the logs match, as in BlockFilterCriteria.
+        topics: [[Bytes32!]!]
+    }
+
+    type Query {
+        # Block returns a block by number or by hash, the head if both are omitted.
+        block(number: Long, hash: Bytes32): Block
+        # Blocks returns the blocks from the number from, the genesis block if
+        # omitted, up to the number to, the head if omitted.
+        blocks(from: Long, to: Long, first: Int, skip: Int): [Block!]!
+        # Transaction returns a transaction by hash.
+        transaction(hash: Bytes32!): Transaction
+        # Logs returns the logs matching the filter, whose block range can't be
+        # larger than the configured one.
+        logs(filter: FilterCriteria!, first: Int, skip: Int): [Log!]!
+        # Committee returns the committee of an epoch, or of the epoch of the
+        # block number, the current one if both are omitted.
+        committee(epoch: Long, number: Long): Committee
+        # Rewards returns the reward records of the blocks from the number from,
+        # the genesis block if omitted, up to the number to, the head if omitted.
+        rewards(from: Long, to: Long, first: Int, skip: Int): [Reward!]!
+        # Staking returns the staking state of an epoch, the current one if omitted.
+        staking(epoch: Long): Staking
+        # Delegations returns the delegations of a delegator in an epoch, the
+        # current one if omitted.
+        delegations(address: Address!, epoch: Long): [Delegation!]!
+    }
+`
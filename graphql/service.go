@@ -0,0 +1,128 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync/atomic"
+
+	"git.taiyue.io/pist/go-pist/log"
+	"git.taiyue.io/pist/go-pist/p2p"
+	"git.taiyue.io/pist/go-pist/rpc"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/introspection"
+	"github.com/graph-gophers/graphql-go/relay"
+	"github.com/graph-gophers/graphql-go/trace"
+)
+
+// Service serves the GraphQL queries on an HTTP endpoint of its own.
+type Service struct {
+	config   *Config
+	handler  http.Handler
+	listener net.Listener
+}
+
+// New creates the GraphQL service reading the chain of backend. The queries are
+// checked by auth when it isn't nil, served to the tokens allowed the graphql
+// module.
+func New(config *Config, backend Backend, auth *rpc.Auth) (*Service, error) {
+	handler, err := newHandler(config, backend)
+	if err != nil {
+		return nil, err
+	}
+	if auth != nil {
+		handler = auth.HTTPHandler("graphql", handler)
+	}
+	return &Service{config: config, handler: handler}, nil
+}
+
+// newHandler returns the handler of the GraphQL queries, served on /graphql.
+func newHandler(config *Config, backend Backend) (http.Handler, error) {
+	s, err := newSchema(config, backend)
+	if err != nil {
+		return nil, err
+	}
+	h := &relay.Handler{Schema: s}
+
+	mux := http.NewServeMux()
+	mux.Handle("/graphql", h)
+	mux.Handle("/graphql/", h)
+	return mux, nil
+}
+
+// newSchema parses the schema resolved from backend, with the depth and the
+// complexity of the queries limited.
+func newSchema(config *Config, backend Backend) (*graphql.Schema, error) {
+	return graphql.ParseSchema(schema, &Resolver{backend: backend, config: config},
+		graphql.MaxDepth(config.MaxDepth),
+		graphql.Tracer(&complexityTracer{max: config.MaxComplexity}))
+}
+
+var errQueryComplexity = errors.New("query too complex")
+
+// complexityTracer counts the fields resolved by a query, the resolvers of the
+// fields past the configured most are not run.
+type complexityTracer struct {
+	trace.NoopTracer
+	max int
+}
+
+type complexityKey struct{}
+
+// complexityContext is the context of a field resolved past the most of its
+// query, reporting the query too complex.
+type complexityContext struct {
+	context.Context
+}
+
+func (complexityContext) Err() error { return errQueryComplexity }
+
+// TraceQuery implements trace.Tracer, starting the count of the fields of a query.
+func (t *complexityTracer) TraceQuery(ctx context.Context, queryString string, operationName string, variables map[string]interface{}, varTypes map[string]*introspection.Type) (context.Context, trace.TraceQueryFinishFunc) {
+	ctx, finish := t.NoopTracer.TraceQuery(ctx, queryString, operationName, variables, varTypes)
+	if t.max > 0 {
+		ctx = context.WithValue(ctx, complexityKey{}, new(int64))
+	}
+	return ctx, finish
+}
+
+// TraceField implements trace.Tracer, counting a field of a query.
+func (t *complexityTracer) TraceField(ctx context.Context, label, typeName, fieldName string, trivial bool, args map[string]interface{}) (context.Context, trace.TraceFieldFinishFunc) {
+	ctx, finish := t.NoopTracer.TraceField(ctx, label, typeName, fieldName, trivial, args)
+	if count, ok := ctx.Value(complexityKey{}).(*int64); ok && atomic.AddInt64(count, 1) > int64(t.max) {
+		ctx = complexityContext{ctx}
+	}
+	return ctx, finish
+}
+
+// Protocols implements node.Service, returning the P2P network protocols used
+// by the GraphQL service (nil as it doesn't use the devp2p overlay network).
+func (s *Service) Protocols() []p2p.Protocol { return nil }
+
+// APIs implements node.Service, returning the RPC API endpoints provided by the
+// GraphQL service (nil as it serves its own endpoint).
+func (s *Service) APIs() []rpc.API { return nil }
+
+// Start implements node.Service, opening the HTTP endpoint.
+func (s *Service) Start(server *p2p.Server) error {
+	listener, err := net.Listen("tcp", s.config.Endpoint())
+	if err != nil {
+		return err
+	}
+	s.listener = listener
+	go rpc.NewHTTPServer(s.config.Cors, s.config.VirtualHosts, s.handler).Serve(listener)
+	log.Info("GraphQL endpoint opened", "url", fmt.Sprintf("http://%s/graphql", s.config.Endpoint()))
+	return nil
+}
+
+// Stop implements node.Service, closing the HTTP endpoint.
+func (s *Service) Stop() error {
+	if s.listener != nil {
+		s.listener.Close()
+		s.listener = nil
+		log.Info("GraphQL endpoint closed", "url", fmt.Sprintf("http://%s/graphql", s.config.Endpoint()))
+	}
+	return nil
+}
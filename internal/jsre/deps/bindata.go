@@ -89,7 +89,7 @@ func bignumberJs() (*asset, error) {
 	return a, nil
 }
 
-var _web3Js = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xfd\x69\x77\xdb\x46\xb2\x38\x0e\xbf\xbf\xe7\xcc\x77\x28\xeb\xb9\x8f\x49\x46\x34\x29\xc9\x8e\x93\x30\x51\x3c\xb2\x6c\xc7\x9a\x1b\x5b\x3e\xb6\x94\xcc\x5c\x8d\xae\x0f\x44\x34\x49\xc4\x20\xc0\x1f\x00\x6a\x19\x4b\xdf\xfd\x7f\x7a\x03\x7a\xa9\x6e\x00\x14\xbd\x64\x46\x7c\x61\x0b\x40\x2f\xd5\xd5\x55\xd5\xd5\xd5\xd5\x55\x19\xf9\x7f\xcb\x28\x23\xb0\x0b\xdd\xc9\x32\x19\x17\x51\x9a\x00\xe9\x16\x7d\x48\xfa\x90\xf5\xe0\xe3\x5f\xfe\x0b\x00\xa0\xfc\x94\x77\xd3\x3e\x2c\xcb\xf7\xf4\x17\x4d\xa0\x7b\x2f\x39\x49\x4f\xb5\xb7\xe5\x97\x02\xfb\x42\x7f\xe7\x41\x06\x01\xec\x42\x71\xb5\x20\xe9\x04\x4a\x40\x76\x61\x43\x76\xb7\x01\xf7\xef\xcb\x0f\x3f\xda\x2d\xb0\xf6\x97\xb4\x4c\xd0\x83\x8c\x14\xcb\x2c\x81\x80\x02\x78\x6f\xab\xe7\x28\x1e\x95\x05\x23\x77\x41\x0a\xd9\x04\x76\x21\x21\x17\xf0\x3c\xcb\xd2\xac\xbb\xb1\x1f\x24\x49\x5a\xc0\x24\x4a\x42\x98\xa7\xe1\x32\x26\xd0\xd9\x80\x4d\x48\x61\x13\x36\x3a\x1b\x58\x2b\xc5\x2c\x4b\x2f\x60\x32\x18\xa7\x21\x45\xef\xc6\xab\xc3\x67\xc7\xbf\x3e\x7f\xff\xfa\xf0\xe8\xfd\x8b\xc3\xe3\xd7\xcf\x36\xfa\x30\xd1\x6b\xdd\xe8\x8f\x14\x8c\x98\x82\x71\x92\x9e\xc2\x2e\x7c\x24\x97\x8b\x34\x2b\xf2\x11\x7c\xbc\xb9\x31\xfa\xa3\x48\x3e\xd9\x3a\x1d\x8c\x83\x38\xee\xc6\x03\x51\xb2\x5f\xcd\x5b\x97\x38\xe7\x20\xa1\x73\x40\xeb\x6f\x9f\x9e\x90\x53\x64\x20\x02\x61\x79\x37\x81\x27\x90\xc0\x08\x48\xcf\x80\xbb\x0f\x71\x1f\x94\x6e\x49\x1f\x24\x05\x55\x25\x95\xd1\x89\x16\xe9\xc0\x64\x25\xfe\xf1\xe6\x2f\xff\xc5\xff\xa0\x80\x45\xad\x89\x63\x92\x66\xd0\xa5\x55\x53\xd8\x85\xad\x1f\x21\x85\x9f\x20\x1b\xc4\x24\x99\x16\xb3\x1f\x21\xdd\xdc\xec\x41\xde\xcd\x28\x41\x8a\x0a\x72\x68\x7f\xf9\xaf\x9b\x5e\x57\xe0\x67\x7b\x04\x27\x15\xde\x44\x07\x7d\x31\xeb\x7d\x10\xf0\x6a\xe8\xe4\xdf\xe4\x50\x60\x17\x4e\x74\x04\x21\x98\xdf\x18\xa7\x49\x5e\x04\x49\xb1\x31\x82\x22\x5b\x92\x3e\x52\x24\x4a\x16\xcb\x22\xdf\x18\x99\xcd\x79\x9a\x2d\xeb\x26\xc1\x9c\x6c\x8c\x60\xe3\x7d\x7a\x91\x90\x6c\x03\x69\xbe\x2c\x4a\x71\x4c\x8b\x06\x61\x98\x91\x3c\xdf\xc0\x8b\xde\xd8\xaf\x4f\x31\xa0\x65\xc7\xec\x7f\xac\x40\xba\x2c\x6e\x3f\xac\xf4\xbd\xab\x7d\x6b\x58\x67\x57\x05\xc9\x1f\xee\xdc\x72\x58\xb2\xb5\x92\xfa\x4c\x16\xf8\x7a\x66\xfc\x33\x63\xa6\x9c\x12\x17\xa1\xad\x67\xc6\x3f\x23\x09\xdf\xcd\x75\xdd\x5c\x8f\xd3\xa4\x20\x49\xf1\x85\x67\xfb\x8e\xb3\x5d\x63\x59\xef\x6c\x53\xae\xba\x63\x6c\xde\x84\x32\xd5\x93\x20\xce\xff\xed\xe6\x3a\x23\x39\xc9\xce\x6b\x57\xee\x3b\x4e\xe2\x43\x6a\x89\xdd\x7c\x79\xf6\x96\x4c\xa3\xbc\xc8\x82\x3b\x8e\x12\x4d\xfc\xe9\x38\xca\xd1\x44\x33\x18\xc8\xc5\xe1\x17\x52\xc8\x8b\x2c\x48\xf2\x49\xbd\x8a\x76\x37\xcf\xf8\x98\x5a\xc1\x90\xf9\xb8\xdc\x02\x64\xbd\x13\x9d\x93\xe2\x5d\x0b\x39\xf3\x75\xcc\xb7\x77\x44\x7f\xb2\xb1\xfc\xc9\x69\x37\x58\x07\xcd\xde\x06\x80\x45\x16\xcd\x83\xec\xaa\x19\x1e\xd2\x34\x5e\x1f\xdf\xec\x89\x41\xdd\x91\xd9\xa7\x27\x33\xcf\x1e\xb2\x2d\x18\xed\x27\x7a\xbf\xd9\x06\xf6\x6e\xa2\xc5\x98\x5a\x62\x38\x8c\xf2\xf4\x22\xf9\x52\xd8\x0d\x92\x34\xb9\x9a\xa7\xcb\xfc\x53\xa1\x37\x4a\x42\x72\x49\x42\xf7\x26\xa5\x2c\xf9\x35\x70\x5c\x05\xad\x0b\x19\x36\xb8\x17\x51\xf2\x65\xb4\xd4\xbd\x25\x9b\xf5\xe7\x49\x48\x42\x94\x7e\x64\xb7\xe4\x9c\xf2\xef\x1d\x71\x28\xbf\xcf\x45\x1c\x67\x51\x18\xae\x87\x38\x3e\x13\xc0\xe7\x41\xbc\x6c\x86\xe0\x65\x94\x14\x3b\xdf\x3e\x5e\x13\x31\xbf\x26\x17\x4f\xa3\xff\x18\x32\xfe\x42\x6b\xcd\xfe\x2c\x48\xa6\xff\x39\xc2\xe2\xab\x90\x15\x4d\x81\x75\x59\x8e\x2d\x60\xd7\xbb\x88\xbc\xe1\x1b\x88\x95\x29\xa3\x7a\x3c\x95\x07\xd2\x37\x7d\xf8\x78\x23\x3b\xdd\xf9\xf7\x3a\x22\xbe\x3b\x30\xbc\xb3\x82\xda\x83\xf9\xa4\xd6\xb1\xc9\x32\x41\xf9\xd2\x82\x62\xbd\x82\xe1\xcb\xee\x4b\xfe\xcd\xf9\xf9\xee\x98\xb0\x6a\xe2\x4f\xc7\xce\x2d\xe7\xfa\xeb\x39\x26\xfc\x73\xa0\xf7\xee\xcc\xe8\x6e\x9e\xeb\x60\x58\x8b\xdd\xbd\xe5\x04\x0b\x9b\xf7\x9d\xa5\xee\x4f\xbb\x8b\xad\xdb\xab\x3c\xfc\xec\x7b\x95\x4f\xca\xa9\x93\x2c\x9d\x7f\x61\x46\x2d\xd2\x2f\x7c\x40\xf6\xa5\xcc\x59\xff\x29\xab\xc1\x1d\x8d\x31\xc1\x19\x65\x64\x5c\x1c\x34\xdb\xa6\x7d\x3a\x4c\x7c\x29\x62\x8f\xc6\xc1\xe2\xe8\x3f\x84\xe0\x1b\x52\xdb\x9a\xcf\x08\xc9\x22\xcd\xa3\xd5\x8e\x60\x17\xc1\x55\x70\x16\x13\xcf\x46\xfa\xdf\x53\x39\x69\x2c\x99\xbe\x8a\x73\x97\x2f\xc5\xba\x7b\x72\xe2\x9e\x79\x48\xec\xdf\xf0\x68\xe0\xab\xa0\x8e\xa6\xc0\xae\x47\xe6\xfc\x7b\x13\xf2\x1d\xfd\xe2\x63\xfe\x1a\x8e\xb6\xbe\x98\x86\xf6\x27\xa2\x5f\xaf\xfe\x74\x47\xc0\x77\x04\xdc\x94\x80\xbf\xf8\x6e\xe4\x4f\xc4\x74\x07\x75\x1b\x97\x5b\x99\x93\x1e\xb5\x37\x27\xc9\xeb\xf4\xa2\x70\xb7\x33\x18\x4e\xd2\x6c\x1e\x14\x05\xc9\xf2\x8e\x7a\x75\x9e\x96\x7c\x97\xc6\x51\x18\x15\x57\x47\x57\x0b\xa2\x57\xa2\x70\xb3\xe2\x55\x85\xe1\x37\xdf\x28\xf0\x7f\xa3\x55\x16\xbe\xbc\x10\xe5\x10\xc0\x22\x4b\x53\x5a\x1f\x8a\x59\x50\x40\x46\x16\x19\xc9\x49\x52\xe4\x20\xc8\x9b\x5d\x34\xd7\x9a\x3a\x28\x60\x1e\x14\xe3\x19\xc9\x47\xda\x7b\x51\x01\x7b\x77\x72\x8a\xbe\x7d\x84\xbf\x3e\x75\x14\x7f\xe8\xfa\x70\x7a\xf2\xf8\xf4\xe4\xb4\x0f\x83\xc1\x40\xfd\x3e\x74\xe3\x4f\xa2\x60\x57\x09\x04\x60\xc5\x01\x28\x66\x51\x3e\x78\xcf\x04\xe5\x0b\x39\x2d\xb4\xc6\x80\x4f\xd2\x01\xfd\x70\x90\x14\x66\xc4\x01\x56\x8b\xef\xd3\xb0\x6a\x87\xec\x8b\x00\x40\xa9\x7b\xa3\xcd\x1f\x02\xec\x60\x91\xa5\x05\x9f\x2b\x1e\x81\x41\x2d\xd3\xfd\x78\xa3\xd2\x8b\xb7\xfa\x80\xed\x9e\xb3\xe5\xb8\x48\x29\x60\x48\xd9\xe6\xa0\x0c\xa2\x5c\xd0\x63\x85\x48\xca\x6f\x16\x32\x45\x40\x81\x7b\xf7\xe8\xd7\x01\x23\xa0\xee\x50\x4c\x60\xf7\x9f\x27\xdd\x93\xad\x07\x3f\x9c\x7e\xd3\xfb\xe7\x69\xef\xc9\xb0\xe7\x44\x8b\x65\x80\xf5\x01\x4f\xd9\x73\x43\x65\xa9\x8d\x11\xfc\xd0\x87\x0d\xce\x30\x1b\x23\xd8\x7e\x54\x72\xef\xb7\x7f\x1a\xee\x7d\x9a\xa6\x71\x0d\xeb\x9e\xd1\x22\x8d\xf9\x96\x96\xb6\x5e\x18\x9c\xc6\x5e\x3d\x42\xde\x99\x2c\xc9\x5e\x5a\x8c\x2a\x8a\xb6\xe5\x52\x36\xd4\x5b\xb3\x28\x6d\x65\x25\x1e\x35\x2a\xba\x19\x94\x16\x5c\x95\x3b\xf5\xba\x1e\xd6\x14\xd0\x34\x6a\xe5\x56\x4c\xf9\x7f\x74\xb6\x74\x96\xfc\xe6\xbf\x57\xe5\x49\x0d\xea\x36\x0c\xf9\xf8\xcf\xc3\x90\x54\xa7\x2a\x39\xb2\xc0\x59\xb2\x98\x11\x60\xca\x17\xe3\xcb\x41\x33\xc6\xa4\xe5\xed\x37\x26\x6f\xb1\x77\x26\x6f\xf2\x82\x68\x51\x9b\x3b\x45\x61\x94\x3d\x41\xa8\x8c\xf6\xbb\xef\xf1\x4e\xcd\xe6\x7d\xfc\xcd\x10\x72\x7b\x06\xa7\xcd\xac\xc6\xe1\x46\x4d\x0f\x8b\xd3\x92\x2b\xf3\xb8\x5e\xd9\xc7\xe4\x02\xa0\x66\xed\xdc\x92\xcd\x69\x6b\x9c\xc9\x3f\x6e\xf7\x6f\x7a\x6b\x63\x79\x6d\x0c\x6d\x78\xfe\xbb\xf6\x3c\x3f\x14\x7c\x79\x34\x8b\x72\x98\x44\x31\xa1\x8c\xb8\x08\xb2\x02\xd2\x09\x5c\x90\xb3\x87\x83\x3f\xf2\x81\x84\x45\x3c\xd3\x22\x93\x8c\x10\xc8\xd3\x49\x71\x11\x64\x64\x04\x57\xe9\x12\xc6\x41\x02\x19\x09\xa3\xbc\xc8\xa2\xb3\x65\x41\x20\x2a\x20\x48\xc2\x61\x9a\x51\x08\xa2\xc9\x15\x6f\x24\x2a\x60\x99\x84\x24\x63\x2c\x5d\x90\x6c\x9e\xd3\xae\xe8\xc3\x2f\xaf\x8f\xe1\x57\x92\xe7\x24\x83\x5f\x48\x42\xb2\x20\x86\x37\xcb\xb3\x38\x1a\xc3\xaf\xd1\x98\x24\x39\x81\x20\x87\x05\x7d\x93\xcf\x48\x08\x67\xa2\x41\x5a\xf5\x05\x05\xe7\x9d\x00\x07\x5e\xa4\xcb\x24\x0c\x28\x16\xfa\x40\xa2\x62\x46\x32\x38\x27\x59\x4e\xb1\xf2\x50\x76\x26\x9a\xec\x43\x9a\xf1\x66\xba\x41\x41\x87\x91\x41\xba\xa0\x35\x7b\x10\x24\x57\x10\x07\x45\x55\x19\xc3\x43\x35\xdc\x10\xa2\x84\xb5\x3c\x4b\xa5\xfc\x8a\x0a\xb8\x88\xe2\x18\xce\x08\x2c\x73\x32\x59\xc6\x62\x9e\xce\x96\x05\xfc\x7e\x70\xf4\xf2\xf0\xf8\x08\xf6\x5e\xff\x03\x7e\xdf\x7b\xfb\x76\xef\xf5\xd1\x3f\x7e\x84\x8b\xa8\x98\xa5\xcb\x02\xe8\x0e\x8a\x35\x16\xcd\x17\x71\x44\x42\xb8\x08\xb2\x2c\x48\x8a\x2b\x48\x45\x68\xaf\x57\xcf\xdf\xee\xbf\xdc\x7b\x7d\xb4\xf7\xf4\xe0\xd7\x83\xa3\x7f\x40\x9a\xc1\x8b\x83\xa3\xd7\xcf\xdf\xbd\x83\x17\x87\x6f\x61\x0f\xde\xec\xbd\x3d\x3a\xd8\x3f\xfe\x75\xef\x2d\xbc\x39\x7e\xfb\xe6\xf0\xdd\xf3\x01\xc0\x3b\x42\x41\x13\x7a\x4d\x3d\xbe\x27\x6c\xee\x32\x02\x21\x29\x82\x28\xae\x28\xe1\x1f\xe9\x12\xf2\x59\xba\x8c\x43\x98\x05\xe7\x04\x32\x32\x26\xd1\x39\x09\x21\x80\x71\xba\xb8\x6a\x3c\xa3\xbc\xb5\x20\x4e\x93\x29\x1b\x7b\x49\x72\x00\x07\x13\x48\xd2\xa2\x0f\x39\x21\xf0\xd3\xac\x28\x16\xa3\xe1\xf0\xe2\xe2\x62\x30\x4d\x96\x83\x34\x9b\x0e\x63\xde\x42\x3e\xfc\x79\xf0\x97\xff\x52\xa5\xa4\xb9\xd6\xfc\x95\x91\xf5\x38\x0d\x49\x36\xf8\x43\x5f\x0f\xfe\x1a\x2c\x8b\x59\x9a\xc1\xab\x20\x23\x1f\xe0\x7f\xd2\x82\x5c\x44\xe3\x7f\xc1\x4f\x73\xfa\xfc\x57\x52\xcc\x42\x72\x3e\x18\xa7\xf3\x9f\xf5\x5a\x61\x50\x10\xd8\xd9\xda\xfe\xd6\x90\xd3\x6d\x96\xd2\x26\x7b\x2b\xa5\xb6\xd0\xf4\xbd\xab\xb0\x50\xf7\x94\x5a\x54\x13\xf1\x56\x39\x48\x0a\xbd\x46\x94\x14\xde\x0a\xc7\x56\x8d\x65\x5d\x95\x67\x57\x49\x30\x8f\xc6\x72\xb1\x52\xaa\x86\xfc\x0b\x13\xa4\xde\x26\xde\x15\x59\x94\x4c\xf5\xca\x39\x7b\xe7\xad\xf6\x96\x04\x06\x42\x32\x12\xf8\x11\x72\x6c\xd7\x59\xd6\x56\x42\x86\x56\x8d\x49\xaf\x16\xe5\x02\x1d\xda\xaa\x93\x2b\x8d\xf5\x99\x86\xe3\x5a\x83\xd4\x92\x83\xb2\x31\xb6\x7e\xf2\x6a\xd7\xd7\xb6\x61\x06\xaf\xb4\x97\x65\xc1\x15\xaf\xe5\x5c\xa8\x5c\x7a\xdb\x3e\xe5\x26\x45\x5d\x13\xb2\x80\x0b\xba\x10\x8a\x14\x48\x42\x39\x6e\x18\x12\x16\xa5\x50\x42\x40\x97\x96\x80\x4b\x7c\x2a\x5e\xcd\x3d\x96\x43\xd7\xe1\xbd\xa9\x08\xa3\x15\x73\x87\xb2\xc3\xbe\x89\x08\x7f\x6e\xe5\xc4\x1c\x19\x5b\x00\xe7\xa4\x98\xa5\x21\x32\x1a\xee\xa0\x92\x66\x73\xe0\xda\x69\xaa\xcd\xbf\xda\x90\x2e\x29\x44\x83\xef\x05\x65\x98\x85\xe1\xaf\x0c\x1f\xf0\x91\x13\xf8\x8d\xbd\xe9\xfc\x2b\x9f\xf7\x1c\x3e\xaa\x1d\xde\xe8\x65\x58\x40\xc8\x1c\x3e\xb2\x90\x92\x37\x20\x1e\x23\x2a\x40\xb9\x66\x4c\x99\x87\x01\x4e\x57\x6e\xba\x38\x3a\x90\xae\x21\x5c\x51\x94\x54\xf8\xad\x79\xb0\xa6\x81\x4e\x5d\xae\xef\x0e\x94\x99\x19\x4c\xa2\xb8\x20\x59\x57\x69\x04\x8d\x20\x29\x08\xbe\x10\x5a\x9a\x45\xa8\x6c\x4a\x7b\x27\x5b\xa7\xda\xb4\x82\x8c\xde\xa9\x02\x80\xb6\xcf\xa3\x68\xf2\x28\x9c\x9d\x28\x39\x0f\xe2\x28\xac\xe8\x94\xf6\x76\x6f\x04\x1d\xd8\x04\xb4\x63\xb3\x4f\x84\x3d\x9b\x33\x95\x83\x79\x60\x11\x07\x51\xc2\x59\xa6\x96\xc4\x78\x95\x37\x66\xd9\x06\x14\x26\x0a\x1c\x9e\xfd\x41\xc6\xc5\x8d\xab\x53\x49\x89\x55\x4b\xbc\xc7\xd0\x55\xa1\x01\x59\x29\x30\x5b\x54\xd5\xe7\x2d\xba\xe4\x20\x23\x28\xa5\x7e\xde\x3d\xa1\xb5\x4e\xfb\x70\xc2\xea\x9d\xb6\x90\x69\x2e\xf4\xc7\x51\xce\xd4\x60\x2e\xb3\xda\xcc\x40\x8e\x62\x98\x09\x5c\x3e\x03\xde\x02\x76\x7f\x6e\xe4\xbb\x61\x6c\x87\xff\xdc\x16\xaf\x62\x06\x6c\x31\x6b\xf2\x77\x2e\x19\x7c\x4a\x0a\x55\x4a\xe5\x42\x4c\x5b\x0c\x4a\x1b\x10\x03\xa0\x75\xb5\xb6\x06\xf3\x60\xd1\x75\xad\x8c\xec\xb0\xc4\x27\x2d\xb4\x95\x8e\x77\xd1\xe5\xa3\x38\x61\x75\x4f\xf9\xea\x2a\x9f\x6c\x79\x82\xc2\x2a\xf4\x94\xc3\xc9\x24\x27\x85\x05\x70\x46\xc2\xe5\x98\x28\x30\x07\xe3\x71\x1f\x9a\x02\xce\x90\x59\x04\x45\x34\x7e\x13\x64\xc5\xaf\x2c\xa2\xac\xd1\xc5\xc0\xfc\xde\xf5\x8d\x41\x36\x9a\x51\x39\x4f\xc2\x77\x76\xdb\xaf\x82\x62\x36\x98\xc4\x69\x9a\x75\xbb\x56\xd7\x9b\xf0\x70\xbb\x07\x43\x78\xb8\xd3\x83\x6f\xe0\xe1\x8e\x85\x10\x05\xdb\xc1\x78\x0c\x9b\xd0\x2d\x35\x0a\x6d\xb6\x1c\x18\x87\x27\x76\x73\xf4\xf7\x70\x07\x46\xf8\x17\xc7\x40\xac\xb9\xeb\xc3\x16\x3e\x7d\x19\xc9\x97\x71\x21\x89\x94\x53\xc5\xab\x65\x5c\x44\xbf\x47\xc5\x8c\x4f\xaa\xa4\x78\x6d\x00\xfd\x92\x48\xfb\x3a\x09\xd8\xdd\x08\x84\xf0\x9e\x6a\x0d\x22\x38\x1f\x1a\x20\x61\x0c\xd9\x10\x3c\x8c\x61\x4b\x24\x6c\x6c\xfc\x88\xb0\x33\x89\x27\x02\x41\xd6\xd8\xc4\xaa\x9d\x66\xcf\x83\xf1\xac\x6b\x4a\xe9\x08\xa5\x6a\x16\x11\xdb\x45\x16\x15\x49\x9c\xf6\xd0\xda\x1c\xa3\x0c\xde\x4d\xfb\xbc\xa8\xab\x0f\x56\xb2\x39\xc6\x07\x72\x78\x54\x05\xa1\x43\x14\x65\xcd\x79\x67\x20\xd9\x40\x4a\xf4\xb2\x07\x8b\x00\xb0\xbe\x74\x41\xb1\xb9\x0b\x44\xc4\x88\x86\x21\xec\x20\x75\x6e\x80\xc4\x39\x71\xa1\x60\x38\x84\x30\x4d\x3a\x05\x04\x61\x08\xa2\x9d\x22\xd5\x3b\x19\x40\x54\x74\x72\x08\xe2\x8c\x04\xe1\x15\x8c\xd3\x65\x52\x90\xb0\x0e\xa5\x9f\x1a\x17\x96\x7a\x24\x86\x73\x74\xf8\xec\x70\x04\x93\x68\xba\xcc\x08\xa4\xcb\x02\x12\x92\x17\x24\x84\x80\x2e\x7c\x79\xad\x2c\xfe\x32\x94\xf8\x35\x13\x90\x3e\xad\x58\x28\xfb\x1b\x04\xab\x7a\x6d\x9f\xe4\x2a\xe7\x2c\x23\x93\x80\xd9\x5b\x2f\x66\x69\x4c\xf8\xc8\xa2\x64\x7a\xaf\xa9\x70\xf3\xc8\x35\x73\xad\x14\x58\xeb\x43\xea\x96\x66\xba\xbc\x32\xe7\xbb\x76\xbf\xeb\x53\x21\xba\x1e\x6b\xba\xfc\x0d\x87\x02\x38\xb8\x08\x2a\xe6\xcb\x49\xe1\xa6\x20\x4e\xe9\xaf\x83\x39\x31\x57\xf7\xea\x0b\xba\xc7\xb1\x1b\x41\x96\x74\xbf\xba\x50\xf5\xe0\x6b\xbc\x5c\x21\xc4\x04\x60\x5b\x2b\xf9\x6b\x82\x24\xd9\xee\x22\x23\xe7\x51\xba\xcc\x4b\x60\x77\x7e\xa4\x08\x8c\x12\x88\x12\x07\xc2\xa0\xc1\x44\x2a\x83\xf2\x82\x00\x6a\xf8\xfe\x08\x76\x61\xfb\x47\x88\xe0\x27\x39\xca\x32\x88\x7f\xb4\xb9\x59\xdb\x0e\xfd\x19\xc3\xd9\xdc\x85\xcd\xae\xc4\x58\x04\x0f\x60\xfb\x94\x6e\x4a\xe1\xfa\x1a\xb6\x1c\xc8\x56\x7f\x9e\x65\x4e\x50\xd8\x26\x44\xf0\x8d\x6b\xe6\x37\x4d\x70\xa8\xca\x56\xbb\x24\xca\x1f\xe2\x00\x54\xf3\xe9\xa6\xd7\xb5\xc5\xb2\xfc\x0d\x87\x30\x89\xb2\xbc\x00\x12\x93\x39\x49\x0a\x88\x72\xb1\x6c\xf5\x21\xff\x10\x2d\x20\x72\x4c\x77\x53\x6a\xd2\xa6\x71\x0b\x9b\x46\x8a\xff\x66\x53\xc9\x92\x84\x84\x61\x44\xbb\x0d\xe2\x52\x34\x89\xf9\x70\x4b\x5b\xf9\xab\x5b\x4c\x2b\xf2\x74\xc8\xb7\x93\x08\x36\x61\xfb\x54\x8a\x39\xd8\xb4\xc0\xf1\x4d\xdf\x2a\xf3\xe3\x12\xf5\xde\xca\x42\x45\x41\xb8\x91\x13\xe3\x1a\xa5\xea\x9f\x4c\x4c\x32\x45\xda\x85\xeb\x75\x49\xaf\xa6\xac\x01\x2e\x61\xbb\xd5\x48\xd8\x42\x23\xee\x6a\x2e\x24\x87\x43\x18\x07\xf1\x78\x19\x07\x05\x91\xaa\x6b\x3a\x29\xa1\x83\xa8\x20\xf3\x75\x88\x5a\x2a\x66\x4f\xfe\x3d\x04\x6e\xcf\x59\x1b\xd5\xa6\x61\xad\x72\xf3\x4f\x23\x33\xbf\x42\x79\x69\x74\x83\xaa\xd7\x02\xfe\x5b\xd8\x24\xc5\x41\x8a\xf0\x81\x49\x5b\xd9\x86\x79\xdd\x5b\xd8\x86\x65\x01\xdb\xa3\xa6\x34\x4c\xd6\x98\x8f\x1b\x58\x23\x15\x28\x91\x3d\x02\xeb\xda\x6b\x0d\x56\xea\x57\xd6\x60\x5e\x8d\xab\xb2\xb7\x44\x7d\x4b\x73\x70\x1d\xd2\x6b\xad\xc1\x0d\x90\x2e\xda\x60\xdb\x67\x06\x5a\x85\xfc\xb6\xb6\x60\x15\x7b\x98\xe9\x09\xc7\xff\x0a\xa6\x60\xb3\x3a\x67\x67\xb5\x22\xe7\xe6\x1c\x35\x7a\x39\x4d\x6f\x9f\xca\x88\xcc\xf1\xd2\x65\xc3\x97\xb2\x07\x37\x6d\xca\x96\x31\x9b\x32\x4e\x7a\xae\xb9\xa8\x70\x50\x6b\x04\x44\x67\x84\xcb\xf4\x4f\x69\x5a\x6f\x65\x91\x46\x2c\x39\xd8\x86\x4c\x40\x5d\xbb\x22\x0d\x87\x90\x2f\xe7\xdc\x5d\x04\x51\x2d\xc4\xe6\xc3\xae\x27\xda\x3f\x89\x4e\xe9\x0a\x54\x3e\x31\x3d\xa2\xa1\x18\x97\x20\xea\x88\x94\x7b\x1c\x37\x0a\x87\x43\xc8\xc8\x3c\x3d\x67\xce\x37\x30\x5e\x66\x19\xdd\x1e\x95\x7b\xa3\x94\xbd\x16\x63\x89\x72\x36\x3c\x64\x48\xf9\x4a\xa7\x07\x72\x56\xda\x9f\x22\x68\x83\x86\x07\x56\x47\x6b\x21\xf4\x77\x86\xf4\xa8\x3b\xe3\xf7\x5b\x7f\xe4\x7a\x80\x90\x3b\x7a\x54\xad\xd4\x61\x2a\x89\x7a\xd0\xed\x38\x71\x76\x0e\x92\x42\x36\x16\x9e\x0a\xaa\x73\x23\x1b\x74\xd7\xb8\x26\x66\x7a\x3f\x0a\xa7\x9f\x6e\xaf\xef\x2f\xf7\x34\x4d\xe3\xda\x42\x54\x99\xad\x2b\x73\xdc\xa4\x90\xea\xba\x53\x0f\x5a\xa3\x52\x7c\x55\xab\x2d\xf6\x96\x04\xf5\xe3\x3c\xe6\xa5\xaa\x42\xa7\x3d\xbf\x9b\x25\x9b\x1e\xcd\xb5\xb2\x2a\xbc\x51\x3a\x59\x6d\x8c\xe0\x51\x5f\xfb\xc0\x72\x01\x8c\xe0\x5b\xe3\x2d\x1d\xf0\xc6\x08\x1e\xeb\xaf\x55\xaf\xa6\x8d\x11\x7c\xaf\x7f\x35\x1c\x39\xb5\x6f\x11\x0b\xc8\xb0\xbd\xa5\xbf\xcd\x48\x40\x3b\xdf\xde\xd1\x5f\x73\xff\x27\xfa\xe1\xa1\xfe\x61\x29\x9a\x31\xa0\x5d\xca\x76\x1e\x8b\xd1\x4b\xaf\xd1\xef\xbf\x2a\x4f\xf1\x1a\xef\xb1\x5b\xba\x3a\xab\xad\xad\xe4\xf1\xec\x68\xc0\xed\xf8\xac\x56\x58\xd5\xff\x19\x6f\xc3\xe3\x06\x6d\x40\xd9\xaa\xd5\x75\x38\x45\x37\xf7\x84\x6e\x04\x90\xe2\xe5\xe6\x27\x01\x29\xfe\xb3\xa5\xdb\xfb\xc6\xeb\x7b\x8d\x22\xae\x8d\x0b\xf6\x0f\x77\x2e\xd8\x77\x2e\xd8\x77\x2e\xd8\xa8\x0b\x76\xc5\x40\x9f\xd5\x0f\xfb\x69\x34\x7d\xbd\x9c\x9f\x31\x61\x5e\xae\x3d\x67\xd1\x34\x61\x2f\x07\x7f\xd8\x0b\xd6\xb2\x88\x62\xdd\x9f\x77\x30\x64\xef\xf8\xbf\x56\xf9\x31\x5a\x76\x9c\x26\x93\xc8\xed\xa0\x2c\x0d\x1d\xca\x72\xc8\x36\xee\x62\x3d\x74\x23\x93\x2f\x4e\x39\xb0\x95\x0e\xd8\x65\x70\x28\x52\xb6\x41\xaf\x6e\x4c\x31\xd6\xa3\x34\xa0\x9b\x7d\xbf\xa1\x73\xca\xab\x44\x39\x24\x64\x1a\x14\xd1\x39\xe9\x97\xa9\xcb\x8b\x4e\x0e\xc5\x45\xda\xc9\x61\x9c\xce\x17\xd6\x4e\x8a\x55\xa7\x54\x55\x36\x31\x89\xd3\xa0\x88\x92\x29\x2c\xd2\x28\x29\xfa\xdc\x59\x87\x72\x5d\x98\x5e\x24\xb5\x36\x12\xdd\x00\xeb\xb1\x82\x5c\xf3\x19\xbc\x2e\xe7\xf2\x46\x0e\x9c\x32\x79\x42\x48\xc8\x8c\x62\x67\x15\x91\x85\x98\xc5\x44\x71\xa3\x65\xe8\xbf\x71\x18\x49\x98\x7e\xa3\xc1\xa6\xad\x3b\xac\x6b\x6b\xf1\x29\x61\x1b\xf0\x99\xef\x8e\x07\x6f\x0e\xde\x1d\xbd\x7f\x7a\xf0\xcb\xeb\xe3\x57\x4f\x9f\xbf\x7d\xff\xf6\xf0\xf8\xf5\xb3\x83\xd7\xbf\xbc\x7f\x75\xf8\xec\x39\x66\x0b\x29\xcf\x16\x18\x05\x0d\x16\x41\xf8\x2b\x99\x14\x5d\xfe\x54\xa4\x47\x17\x69\xbe\x5f\xce\x8b\x00\x63\x50\xa4\x42\xa7\xde\x7e\xdc\xeb\xc3\xe3\x47\x8e\x13\x7e\x55\xdf\x60\x83\xef\xf2\xde\x9a\x3b\x4a\xea\x84\x67\x99\xa5\xea\x66\xf8\xa9\x6d\xc7\xd2\x2d\x5d\x6b\x9c\x31\x5b\x5d\xc4\xe7\x0c\x41\x7a\x91\xbe\x24\x97\x12\xb7\xf9\xf2\x2c\x2f\xb2\xee\x0e\x36\x59\xb1\xe1\x4a\xc7\xdb\x91\x07\x91\x9b\xf0\xf8\x61\x0f\x86\xe8\x7c\x98\x93\xfc\x36\x9a\xce\x0a\x51\xbf\x0f\x31\x7c\xf3\x75\xce\xa2\x50\x8e\x3e\xfb\x64\x3a\xb7\x00\x9f\x60\x4e\xa5\x05\x45\x9f\x4c\xfb\x68\xe3\x2b\x22\x00\xe3\x14\x8b\x77\xdf\x1b\x70\xf9\xb8\x09\xb7\xa3\x8f\xba\xe5\x85\xef\x42\xdb\x88\x81\x77\x56\x8d\x4f\x48\x3a\xe5\xc5\xa1\x15\x88\x66\x92\xa5\xf3\xe3\x62\xf2\xfd\x1d\xdd\x7c\x02\xba\x31\x83\x26\xd4\x2e\x1e\x66\x90\x05\x49\x33\xf4\x03\x09\x92\xb5\x2e\x1e\x66\xd8\x84\x06\x24\xd3\xd9\xba\xdd\xaf\x03\x9b\xa2\x1f\x78\x02\x9d\xed\x0e\x8c\xa0\xb3\xd5\xf9\x4c\x8b\x40\xdd\x64\x65\x24\xd0\x91\xff\x1b\xad\x90\x03\xdd\xe8\xcd\x97\x71\x11\xf1\x0d\xd2\xd9\x15\xec\xfc\xdf\x9c\x6e\x38\xcb\xeb\x09\x01\xed\xa2\x20\x53\x92\xb5\x52\x15\xde\x9a\x1d\xd6\x69\x83\x6b\x9c\x7d\x71\x01\xb0\x6e\xf6\xc5\x54\x18\x4c\x44\x67\xa6\x84\xaa\x54\xd0\xa2\x39\xc9\x8d\x4f\x3b\xbd\xc1\x22\xbd\xe8\x6e\xef\x7c\xdf\xeb\x35\x9f\xb6\xfd\x19\x19\x7f\x80\x68\xa2\xcd\x9b\xa2\xc8\xd7\xe2\x38\x8f\xa6\x09\x09\x0f\xf2\xd7\x48\x05\xeb\xe0\xb1\x6c\x7e\x46\x2e\xc5\x40\x1d\x78\x96\x4c\xc8\x2c\x2f\x0c\xbe\x42\xdf\x5f\xa4\xc5\x8c\x64\x17\x11\xdd\xcf\x06\x71\xee\xbb\xf3\x67\x42\xd8\x7c\x2a\x30\xe4\x4b\xa1\xbd\xd5\x87\xed\x5e\x1f\xb6\x1f\x2b\xea\xf2\x4e\x4f\xfb\xda\x83\xdd\xdd\x5d\xca\x7a\xad\x99\x28\xa3\x52\xf8\x41\x10\x33\xc8\x81\x5b\x10\xab\x83\x7a\x63\x03\xe6\x21\x7d\x6e\x61\x74\x6e\x84\x34\x5a\x76\x5f\xd0\xa2\xd3\x51\xed\x92\x3c\xb0\x95\x3b\x25\xc6\xf9\xd1\x14\xf8\x86\xb8\x96\x4b\x4a\x30\xb5\xa9\xc1\x6f\x6b\xd1\x5a\x9c\x8c\x76\x39\xc4\xca\x29\x54\xb7\x07\xd7\xd7\xb0\xb1\xb5\x61\x9d\x0e\x0e\x87\x30\x2e\x29\x9d\x6e\x49\x25\x21\xd9\x20\xf2\xd2\x51\xc1\x29\xae\xdc\xc8\x7a\xf6\xb0\xd2\x39\xcb\xa0\x33\x41\x5b\xbe\x13\x22\x84\xbe\x38\x49\xcd\xa3\x64\x69\x32\x78\x67\x72\xcb\x5f\x87\xb5\x2d\x1b\xdf\x46\x3c\x4d\x5a\x80\xb8\x66\x9a\x5e\xb6\x25\xea\xe3\x66\x54\xed\xa5\x67\xd2\x8a\xa0\x4d\x10\x3d\xa4\x7c\xbc\x5e\x5a\xfe\x72\xd3\x62\xae\xd3\xb5\xd3\xe2\x5e\x67\x9b\x4f\x0b\x9b\x15\x65\x33\xa9\x4f\x83\x09\x92\x67\x1a\xac\x85\xd7\x7b\x01\xd4\x90\x45\xa2\xf0\x20\x8c\xce\xa3\x90\x84\x4f\xaf\x3c\xeb\xed\xba\x99\xa1\x35\xda\x8f\x3f\x3d\xde\x2d\xa0\x7c\xf4\xbf\x3a\xe6\x8f\x3f\x15\xea\x6d\x2f\x2c\xde\x6d\x89\xfd\xe6\xfb\x87\x2a\x18\xd9\x2d\x50\x2e\x15\x1c\x9d\x14\x9c\xe8\x37\xc1\xf3\x60\xdf\xda\x6d\x78\x91\x8f\x08\x1e\xa6\xb6\xdc\x72\xfb\xb1\xdd\x81\x27\x5c\x79\x13\x71\x48\xd7\x37\x51\x2d\x0c\x4c\x4a\x50\xa9\x26\x2a\x50\x4c\x26\xd5\x54\x50\x1d\xb5\x89\x79\xc2\xd2\x72\x93\x60\x4e\x78\x70\x04\xfa\x97\x6b\x23\x21\x0a\xd3\x5e\xec\x36\x7d\xb3\x6b\x19\xad\x58\xf7\x7d\x40\xcf\x54\x69\x6d\x11\xca\x0c\x76\xc1\x15\x6d\xea\x9b\xde\x10\xb3\x41\xe4\xd1\xbf\xc4\xd2\x94\x33\x0f\x10\xd1\xd0\xc9\xb6\xe5\xdc\x23\x68\xa9\xb3\x75\x49\xb7\x9d\x36\x49\x0d\xf2\x38\x1a\x13\xaa\x16\xef\xc0\x37\xac\xdd\x35\x32\x6e\x6b\x7a\x50\xcd\x7f\x9f\x90\x2c\x6e\x3f\xd7\x4e\x3b\xa5\x5b\x9f\x28\xed\x46\x86\xcc\xe4\x73\x22\x3c\x49\xcc\x49\x79\xfc\xa8\x27\x74\xc3\x22\x15\xe5\x7b\xf0\x8d\x65\x6a\xc2\x66\xd9\x68\x91\x6f\x7f\x1e\x3f\xea\x0b\x40\xd6\x37\xcd\xad\xec\x82\x1c\x7d\x3e\xc3\xe0\xa7\x99\xe1\x20\x1f\x47\x51\xbb\x39\x46\x0c\x8a\x5f\x74\x76\xa5\x7d\x9b\x19\x2a\x9b\xcd\xf1\xfa\x26\x19\x89\x58\x5c\x3b\xcb\x7b\x48\x94\x63\xc7\x34\x3b\xd7\xda\xba\x69\xb5\xbb\xf0\xcc\x28\x16\xca\x78\x95\x0d\x00\x3e\x3f\x1b\x5b\x97\x1b\xb0\x09\xc2\x18\xc1\xe6\x98\xff\x5d\x7a\x55\x3e\xda\xea\x83\xfa\xaa\x45\xd4\x40\x03\x3e\xdd\x16\x35\x32\x9e\xfb\xce\xc2\x4c\x60\x8d\xac\x37\xee\x0a\xaa\xa0\x1b\xb9\x3e\xb8\xab\xf3\x79\x1a\xd9\xaf\x3c\x20\xa6\x69\x3c\x32\x5f\xb8\x8b\x53\x85\x76\x64\xbe\x40\x8b\x97\x1b\x88\x91\xf9\xc2\x53\xfc\xd8\x2a\x7f\xec\xaf\xa0\xc2\x53\xbd\xf1\xf5\x60\xd7\x38\xae\xa9\xa2\xa2\xa8\x7a\xe3\xab\xa0\x4e\x9f\xf2\xca\x53\x05\x9b\x78\xfb\x8b\xa7\x01\x7d\xea\xd5\x77\x9e\x4a\x82\x43\x47\x7e\x39\x72\x63\xf8\x6b\xe9\x6e\x18\x1b\x23\xd8\xfe\xbe\x0f\x1b\xba\x27\xc7\xc6\x08\x76\xb6\x98\x27\x17\xe3\x67\x5a\x68\xbb\x0f\x1b\xaa\x73\x08\x8b\xfa\xaf\x3e\x97\xae\x5e\xdb\x5b\x5f\x95\xe3\xa4\x2f\xc4\xee\x01\xbf\x8c\xeb\x09\x79\x1d\x25\x45\xf3\x88\xd7\xa6\x4f\x49\x94\x14\x46\x48\x5c\xfa\xe6\x91\xfd\xca\x8c\x9c\x4b\xdf\x59\xe1\x74\x79\x41\x3c\x98\x6e\x94\x14\x46\x28\xdd\x28\x29\x1e\x3f\xb2\x5b\xb0\x82\xeb\xf2\x4c\x08\x8d\x21\xa0\x6d\xb6\x8e\xb7\x6d\xda\x91\x3e\x6b\x44\x7c\xbd\x9e\xdb\xcb\xf2\x20\x29\x56\xf5\x43\xd5\xaa\x7a\xdc\x4f\x39\x28\x4d\xda\xb8\x9d\xb3\x69\x94\x14\x72\x47\xf4\x64\x5d\xd1\x77\x55\xd8\xdb\x38\x7e\x6e\x6f\xdf\x79\x7e\xde\x79\x7e\xde\x79\x7e\xa2\x9e\x9f\x5c\x5b\xfe\x9c\x4e\x9f\x4d\x5c\x38\xeb\xd7\x4e\xee\xa3\x99\xb2\xab\xad\x55\xb4\xd3\x81\x6f\x93\x74\x31\x23\x49\x19\x1e\xa7\xcf\x6f\x81\xd2\x1d\x63\x19\x59\xd2\xda\xc1\x38\x16\x14\xfb\x22\xac\x38\x37\x70\x84\xc5\x61\xab\x84\xdc\x9e\xf0\xff\xaf\xaf\xa1\xd3\xc1\x16\x93\x54\x5e\xdd\xe6\x7f\xfc\xa8\x04\xb4\x89\x12\x13\xc2\xdb\xc4\x4d\x9d\x92\x42\xbd\xa3\xc7\x6e\x97\x76\x72\x19\xe6\x88\xc9\xba\xda\xdd\xa3\xb2\x9f\xe5\xf7\xce\x1c\xdb\x3f\x69\x13\xaf\xfa\x53\xbb\x81\xae\x1c\x59\xcf\x81\x7c\x0d\xf1\xea\xcd\x58\xb3\xff\x66\x77\x10\xd8\x95\x64\x6d\x2b\x8e\x3a\x05\xdd\x06\xbb\xe3\x8c\x50\x66\x90\x4c\xaf\x07\xd9\xe5\x2c\x1d\x46\x93\x09\x61\xb7\x0d\xf9\xfc\xd6\x62\xfb\xa2\xbc\x8e\x8f\x6e\xd1\x25\x92\x05\xb5\xc8\x3b\x8b\x16\xd1\x78\xdc\x30\x98\xc6\x81\xc1\x1a\x2c\xb8\xb8\xb5\x21\x6d\x30\x4b\x17\x78\x80\x29\x07\xb3\x38\x1d\x69\x2a\x2e\x2a\x19\x6d\x5d\x93\x95\xce\xcf\xa2\xc4\x0e\x85\x5c\xa4\x53\x42\x57\x49\xad\x29\x32\x1d\x70\x49\x12\x2c\x16\x24\x61\x02\x24\x48\xf8\x3d\xef\xda\x19\x14\x1d\x35\xb1\xb0\x28\xc8\xbf\x98\x45\x63\xba\x02\x48\xc8\x5b\xb4\x22\x9c\xa0\xd2\x89\xa8\xc4\x16\xff\x96\xd3\x27\xb1\xd3\xf8\x20\xc4\x37\x75\xa5\xb9\x93\x9f\xe9\xdf\x72\x02\xc7\x41\x1c\x8b\x29\x94\x4e\x09\x1c\x0f\xb3\xa0\x92\x64\x79\xf4\x2f\x33\x2b\x09\xf3\x82\x99\x05\x79\x9f\xfe\x2f\x79\x81\xe5\xd0\xf3\xb9\xc3\xa0\x53\x5a\x5e\x81\x72\x30\x18\xe6\x04\xd7\x00\xe9\x78\x30\x72\xaf\x44\x13\x7c\x7f\x6f\x77\x97\x29\x92\x93\x28\x69\x11\xd5\xa2\x0e\xbf\x55\x94\x6d\xd1\x8d\x70\x49\xab\xb7\x30\xf2\xf2\x7b\x39\x72\x48\x60\x99\x07\xf9\x71\x9d\x6d\x32\x5e\x49\xec\x68\x1d\x37\x42\xe3\x3d\x86\xc7\x2a\x24\x61\x0f\x9e\x40\xa7\x03\xa3\x66\x3e\xff\xca\x24\xf8\x3d\xff\x6f\x37\x13\x74\xdd\xe6\xc6\xcd\x72\x3f\xd2\x2c\xd4\x48\x65\x11\xad\x99\x05\xa9\x6e\x48\x5f\xb1\x40\x5d\xad\x83\xb9\xca\x23\x8c\x8b\x5b\xce\x4b\x05\x87\x7f\x52\x58\xac\x70\x73\x46\xbc\xf1\xc8\x4b\x29\xd3\xc8\x21\x47\x99\x2f\x41\x24\xdd\xf5\xce\x92\xa6\xe3\x34\x9e\x26\x45\x31\xa9\x99\x27\xf9\x22\x90\xee\x80\x0d\x26\x8c\xcc\x17\xc5\x95\xff\x5c\xa5\x81\xb2\xd5\x5c\x28\xe9\xcc\xa4\xac\x03\x23\x5d\xff\xbd\x1d\xb6\x65\x50\xf6\x16\xe1\xd8\xfd\xb8\x6d\x89\x1a\xd1\x7f\x63\xac\x68\x4e\x02\x9b\x88\x42\xba\x6e\xcc\x54\x61\x68\xda\x04\x4c\xff\x35\xca\x71\x55\x93\x05\xb7\x39\xd1\xb0\x71\xea\x0b\x8e\xde\x12\xb1\x55\xef\xb6\xd2\xc1\xcd\x34\x3a\x6a\x87\x43\x58\x2e\x42\x7e\xd9\x4d\x84\x81\xd1\x0b\xd0\xcd\x5b\x91\x16\x4a\x60\x2c\xde\x96\xd4\xfb\x45\x34\x6d\xb3\x0a\x6f\xab\x0c\xbc\x23\xea\xe8\xb1\x2b\x70\x4d\x08\xa4\xfc\xe2\xca\x4e\x9d\x00\x03\xc3\x8b\xa4\x3e\x58\xac\x0e\x22\xec\xaa\xc3\x43\x6a\xab\x83\xdf\xdc\xb5\x4f\x9c\x45\xd8\x11\x4f\xa8\x11\x5e\xa5\x52\xe5\xbb\xb6\x0a\x0e\x78\x40\x99\xe1\x50\x52\x21\x39\x27\xd9\x55\x31\xd3\x83\xd3\x2a\x7d\xa8\xf8\xb6\x63\xb7\xcb\xbb\x14\x6e\x8c\x6b\x51\xc1\xf0\x83\x75\x3b\x32\xf9\xa7\xe9\xd4\x73\xfc\x78\xd3\x87\x4e\x07\x39\xea\xad\x9e\x9d\x56\xd0\x37\x82\x38\xf0\xe3\x94\xea\xdc\xa4\x32\x7d\xee\xfc\x69\x0e\x42\x98\xa7\x9b\xff\x24\x24\xa3\x45\x1a\x1f\x85\x58\x57\x23\xe8\x0b\xe3\x38\x81\xbd\x7a\x84\xbc\x33\xcf\x1d\xd8\x4b\xeb\x34\x42\x14\xc5\x0f\x44\xe8\x47\xe3\x44\x84\xbe\xb2\x8e\x44\xe8\x4b\xeb\x4c\x84\xbe\xc4\x0e\x45\xdc\x70\xac\x74\x2c\x62\xb9\x17\xae\x74\x2e\x42\x5b\x59\xe9\x60\xc4\xa8\xe8\x3e\x19\xa1\x05\x57\x3d\x1a\xd1\xeb\x7a\xce\x46\x04\x34\x8d\x5a\xb9\xd5\xe9\x08\x9d\x2e\xc7\xe9\xc8\xca\x19\x82\x35\xe0\x5b\x9d\x8e\x3c\xfc\xaa\x44\x84\x33\xbf\xd8\x2d\x69\x94\xb7\xb3\x12\x95\x5a\x55\xdd\x74\xca\x8b\xae\x4a\xa9\x66\x6d\x0f\xad\x96\x30\x35\x6c\xe9\x76\xa7\x79\x7c\xbf\xb0\x62\xec\x18\x04\x96\xcf\x17\x35\xc6\xc0\x53\x2b\xc6\xf8\x6c\x69\xef\x6b\xe2\x4d\xc8\x2a\xbe\xd5\x53\x49\xf8\x16\xe5\xcd\x33\xbd\x8d\x49\x56\x04\x51\xd2\x34\xdb\x9b\x35\x61\xdc\x83\xa3\x29\x43\xf2\xd2\x03\xfd\x7d\x43\x96\x14\x75\x8d\x0f\xb7\x0a\x1d\x5a\x90\x6c\x1e\x25\x6c\xdf\x4c\x7b\xd5\x47\x19\x8a\xfc\x6c\x30\x8d\xce\x49\x62\x39\x20\xa3\xbb\x26\xce\x64\xe8\x8e\x49\x75\x69\xc6\x76\x48\xdc\x50\x58\xdd\xd2\x63\x33\xc9\x01\xb0\x60\x6b\x7a\x67\x4f\xad\xb3\x82\x28\xe0\x59\xd8\x36\x0a\x74\x87\x99\x9e\x93\x2c\xbb\xc8\xa2\xa2\x20\xec\xda\x01\x07\x78\x03\x36\xd9\x08\xd7\x32\x2d\x17\xec\xa8\x38\x57\x03\x30\x32\xef\xd1\xca\xf4\x15\x25\x62\x76\x4c\xf2\xad\xb1\x7f\x61\x67\x55\x8d\xe7\xc9\x3e\xcb\x32\x40\xf2\x9d\x22\x3a\xa6\x04\x89\x13\x59\x33\x39\xc3\x21\x3b\x08\x0e\xe6\x94\xdf\x59\x22\x1a\x71\x06\x41\xd1\x45\x05\x26\xe1\xe7\xec\x71\xf4\x81\x40\x00\x79\x94\x4c\x63\x52\x46\xff\x67\x25\x07\xa8\xa8\xe5\x66\x4c\x1e\xee\x99\x87\x87\xe5\xfd\x5f\x5f\xc3\x49\xe7\x64\xfb\xb4\x73\xda\xb3\xf7\x40\x4d\xe3\x39\x0a\xc8\xf5\x19\xa5\x4f\x6a\xb2\x99\xba\xed\x31\x77\xa1\xe7\x78\x63\x5e\x9b\xdb\x7d\x78\xc0\xae\x90\x6e\x31\x30\xb7\xd1\x78\xd0\x08\xd0\xd6\x66\x4f\x46\xf5\xec\xcb\x38\xa0\xb5\x9b\x75\x19\x06\xf4\x1b\x59\xc3\x11\xe7\x7a\x38\x84\x20\x8e\xe1\x2c\xc8\xa3\x31\x0f\x81\xc9\x6e\x68\x3f\xdc\x11\x26\xef\x38\xd5\xcc\x71\x12\xea\x3e\x3c\xdc\xb9\x8d\x4f\xb0\x2e\xe3\xc4\xe2\x20\x6d\x83\xd6\x91\x15\x90\xa9\xbe\x83\xda\xa0\x55\x4e\x4e\x37\x60\xf7\x67\x26\x98\x90\x8f\x8f\xf8\xd7\x96\x67\x25\x7b\x56\xd7\x2b\xcb\xc9\x19\xa9\x1f\x58\x13\x99\xa8\x42\x56\xcf\x7e\x55\x2a\x03\x2d\xb4\xb2\xc5\x36\xb8\xe7\xf1\xbd\x7b\x6a\xdd\xfb\xf7\x41\x7d\x3e\x51\xfe\x56\x52\x67\x9c\x4a\x65\xac\xcc\x6a\x3e\x9c\xae\x9d\x34\x84\x24\x6b\x47\x19\xd6\xdc\xeb\xa4\x61\x12\x8e\x83\x32\x94\x94\x15\x6b\x27\x0c\xd7\xb0\x9a\xd0\x85\x02\xd7\xe7\x27\x8b\xcf\x45\x17\x7a\x38\xe1\x6a\x49\xc3\x08\x41\x37\x72\x6c\x30\x2f\xcd\x1d\x3e\xd5\x86\xb5\x63\x43\x38\x76\x6e\x0b\x4a\xd8\x7e\x64\x57\xdd\x39\x3d\x79\x28\x2a\x5b\x1f\x79\x25\xbb\xce\xf6\xa9\xeb\x0b\xf2\xc1\xa3\x0a\xb0\x49\x5d\x8b\x2e\xa0\xa2\x4b\x60\x71\x15\x05\x40\x01\xe8\x93\xd1\x5a\x34\x81\xae\x52\xc6\x67\xd2\x2c\xd7\xda\x15\xa9\x90\x2d\xc6\xcd\x2e\xc7\x6f\xaf\x48\xb1\x42\x6f\xb0\x6c\x82\x5e\x32\x65\xb4\xe5\xa5\x54\xf1\x6c\x17\x52\xe8\x55\x3c\xda\x65\x3c\x7d\x9c\x38\x00\x40\x89\xb4\x4a\xb9\xb1\x22\x75\x96\x25\x38\x92\xcc\x82\xf5\x54\xa9\x65\xfc\xa9\x57\x48\x45\x1c\xf5\x38\xc8\x0b\x38\x39\xa5\xaa\xb0\xd1\xe5\xed\xa8\xf6\x5e\x43\xb2\x35\x36\x20\xe0\x09\x1a\xcf\x8c\x1c\x55\xa4\x11\xf6\x58\x12\x75\x13\xaa\x5f\xf1\x6e\x9e\x62\xd6\x28\x97\x5f\xd3\x65\x05\xce\xae\x20\x24\x93\x60\x19\xeb\x67\x70\xf9\x92\xee\x09\x4b\x15\x72\x43\x04\x9b\xee\xc3\x06\x0f\x6b\xdd\x5c\x0b\x5b\xdb\x66\xb5\xd2\xbd\x6e\xbd\x35\x6d\x6b\x19\x5a\xe9\x46\xb4\xcc\x91\x2b\x0f\x45\x15\x29\x92\xd7\x8b\x11\x26\x02\x04\x27\x9f\x6c\x50\x11\xd0\x87\x0d\x2a\x15\xe8\x7f\xa7\x1b\xa7\x08\xd7\x8b\xa2\xd8\x47\xd6\x4c\xb2\x8c\xeb\xaf\xcc\x2b\x34\xb8\xe2\xac\x99\x39\x49\x5c\xe3\x6e\x2a\x19\xec\xc4\x00\x6e\xd1\xc0\x15\x0c\x91\xa7\x85\xd3\x6c\xce\x7c\xe9\x28\x5a\x34\x04\xd2\x7f\x75\xb9\x6a\x30\xac\x58\x6a\xba\xff\x3c\xe1\xd6\xc8\x7f\x9e\xf6\x6e\xa7\x0b\x8b\xe3\xc9\x32\x7a\x6a\xed\x54\x60\x2e\x04\x46\xae\x76\xab\xa1\x15\x04\xb7\x0c\xd5\xd5\xc4\xcb\xc6\x98\x20\xc4\x23\x41\xb8\x4e\x3a\x95\x09\x7f\xe2\x4e\xdd\x9b\x43\x64\x4a\x2b\x73\xa4\x39\x85\x71\xd3\xe4\x72\xe5\x7d\x5c\xf5\xbe\xe5\x8f\x58\x69\x31\xa9\xfe\xc4\x69\x76\xbe\x3a\x12\x4f\xd4\x3c\x75\x72\x91\x71\x57\x2f\xe3\xc7\x9d\x98\x29\x48\xaa\x21\xb2\xe0\x80\x8b\x65\x3e\xeb\x5a\x19\xcb\x64\xcc\x3e\x99\x7e\xcc\xd7\x19\x1d\xb1\x9d\x3a\xf7\xdc\x9b\xf4\x4b\xed\x5c\x49\xd9\xd5\x3d\xef\x83\x9a\xc0\xce\x95\xb7\xec\xd3\xa5\x22\x14\x84\xa2\xa6\x20\xfc\x14\x74\xc2\xc6\x6c\xa9\xcd\x62\x5e\xab\x3a\x5f\x03\x9d\x60\x39\xde\x9a\xe6\x76\x73\x4e\x33\x25\x1a\x96\x73\xad\xc9\x6c\xaf\x77\xae\x1d\xca\x14\x66\xf4\xd7\x84\x0e\x96\x8a\x6f\xc5\x84\x5f\xdc\x87\x6c\x92\xa5\xf3\x86\x5e\xa8\xbc\x9e\x57\x1e\x23\x6e\xf2\xb8\x67\x3d\xee\x52\xbf\x4a\xec\x12\xb9\x58\x70\xe8\x56\x12\xf4\x02\x21\xaa\xa0\xd7\x12\x62\x35\x16\xf8\x9f\x5d\xe4\x33\x35\x44\x75\xc8\xe2\x9b\x4d\x11\x14\x83\x0d\x42\xea\xe6\x02\xf1\xdf\xc0\x0e\xf3\xa5\xed\xc9\xc4\x99\xe6\x34\x18\x3d\x94\xc2\xc2\xdb\xb8\x0a\x87\xd5\x83\x57\x72\xb0\x9a\xef\x0a\xee\x10\xa9\x36\xb3\x09\x0f\x79\x26\x65\xae\x6a\xe5\xb4\x48\x0e\xc1\xa4\x20\x59\xc9\xf9\xda\x08\x6e\x29\x9d\xfc\x95\xb1\x6c\xab\x95\xf4\x74\x64\x59\x85\x66\x28\x66\x41\xe5\x49\xf8\xda\xd5\x91\x1a\x4c\xd7\x99\xb7\xf3\xe1\x76\x0f\x86\xf0\x70\xa7\x87\x39\xe2\x69\xbd\x69\xb2\xb6\xad\xb4\x85\x6f\xfc\xe0\xd2\xb2\x9b\xbb\xfe\x32\xed\x84\xb5\x9e\xa1\x4e\x21\x97\x4d\x88\xbe\x84\xe4\xfe\x5a\x57\x69\xf5\xd7\x96\xef\xe4\x3d\xb9\x86\xbf\xaf\x9d\xed\xee\xb8\xee\xcf\xcf\x75\xda\x2b\x8b\xe7\x14\x13\xc7\x3a\x79\x4e\x58\x60\x56\x5d\x50\xd9\x6f\x8d\xab\xaa\x0e\xce\x4a\x1d\x09\x92\x40\xa9\x3a\xb6\xa8\x18\x97\x00\x0d\xa4\xc9\x42\x78\xdf\xd8\xd7\xd6\xea\x06\xd4\xdd\x86\x4d\x1d\xca\x1e\x8f\xf3\xde\x87\x2d\x17\x6d\x69\x29\x0d\x0d\x6f\x16\x2d\x00\x5d\x43\xf2\x32\x29\x47\x9b\x21\xe3\xb2\x81\x26\x7d\xed\x6a\x0d\x11\xa1\xd2\x4e\xc9\xe2\x3b\x16\x33\x69\xbb\x82\x86\x03\x6d\xe1\xd8\xd5\xc0\xa5\xab\x0a\x1c\x53\xf9\x74\x7d\xfb\x55\x39\x3b\xfa\x3c\xba\x8e\xeb\x23\xc3\x2c\x5b\x85\x86\x31\xc3\xee\xb2\x17\x86\x23\xf1\x12\x89\x0e\xb3\xc4\xc2\xc3\x2c\xd1\xe8\x2c\x4b\x5f\x80\x98\xa5\x1d\x21\x66\x89\x85\x88\x59\x62\x31\x62\x96\x8e\x20\x31\x6e\x38\x56\xf2\x87\xb6\xe2\x0d\x7f\xd6\x38\x31\xc7\x4d\x03\xc5\x1c\xdf\x22\x52\xcc\x71\xd3\x50\x31\xc7\xbe\x58\x31\xc7\xeb\x0c\x16\xb3\xfc\x14\xd1\x62\x8e\x57\x0e\x17\xf3\xf8\xcf\x23\x23\x1a\x5c\x9a\x58\xb6\xbb\x35\x61\x45\x27\xe6\x6f\x4c\x06\xc3\x2e\x4e\x2c\xd1\x9b\x13\x4b\xfc\xca\xc2\xd2\x7b\x77\x62\x89\x5c\x9e\x58\xa2\xb7\x27\x96\xe8\xf5\x89\xa5\xeb\xfe\x84\x0f\x9a\xd5\x24\xc6\x17\xbe\x42\x71\xdc\xf8\x0e\xc5\xf1\x6d\x2e\x51\x1c\x37\xbe\x45\x71\xec\xbd\x46\x61\xb6\x73\x4b\xc1\xe1\xb9\x49\x71\x0b\xc9\xb1\xfa\x5d\x8a\xef\xda\x8b\x8e\xce\x32\x27\xec\x40\x6c\x5c\x74\x58\xa7\xc3\x21\x4c\x53\x20\xc9\x39\x84\x29\x61\xbe\x9d\x2c\x94\x50\x90\x84\x2c\x9b\x1e\xfc\xfd\xd5\xaf\x2f\x8b\x62\xf1\x96\xfc\xbf\x25\x51\xef\x67\xb2\x2d\xc6\xd5\x82\xa4\x13\xa3\x08\x0f\x43\x5d\xc6\x04\xe8\x58\xc8\x15\x60\x0d\xcc\x6a\xf0\xf1\x46\xc5\x20\xdf\xc9\x34\xad\xab\xbf\x60\x1b\xdb\x3f\xf2\x19\xd5\x58\xa2\x69\x92\x66\x64\x14\x6b\x31\x2c\x6e\xf4\x5b\x6d\x15\x4a\x57\xc8\x81\x7c\x17\xbc\xeb\x2e\x78\xd7\xbf\x5f\xf0\x2e\x11\xb0\x4b\x5c\x84\xc0\x23\x76\x19\x8b\x39\xac\x3f\x82\x97\xa9\x8e\x1c\x17\x51\xec\x3b\x7e\x61\x6c\xca\x03\x68\xb4\x6a\x33\x2a\xae\xca\x35\xc1\xd3\xfe\x38\x0e\xf2\x1c\x4e\x58\xfb\xa7\x02\x37\x46\x89\x6a\xa5\xb2\x00\xa0\xc2\x76\x28\xf5\x2f\x71\xbe\x44\x65\x24\xf8\xb2\x83\xea\xba\x40\xb3\x14\xb6\x7a\x1d\xd6\xfa\xf1\xeb\x83\xa3\x77\xb0\x0b\x27\xba\x3c\xed\x5c\x90\xa8\x63\x44\x5c\xed\x7c\xc0\x5e\xbe\xc2\x5e\xfe\x82\xbd\xcc\xff\x15\x9c\xa5\xd6\xdb\x49\x94\x24\xe4\xca\x7e\x4d\xe6\x45\x5a\x64\x4b\x62\x7d\x59\x44\x63\xfc\x43\x12\x24\xf8\x87\x79\x34\xce\x5c\x5f\xe2\x38\x72\x36\x86\x37\x84\x37\x82\x80\x99\x17\xd6\xcb\x69\x16\x24\xa1\x8d\x42\xb4\xec\x2f\x28\x5c\x47\xe8\xdb\x37\xf8\x5b\xac\xd9\xff\x45\x8b\xfe\x03\x7d\xfb\x1a\x7d\xfb\x0c\x7d\xfb\x1b\xfa\xf6\x98\xbd\xad\x5e\x9e\xb6\x8a\x57\xcd\x28\xf4\xcd\xde\x33\x4a\xf4\x23\x78\xb8\xd3\x47\x3e\xbf\x3b\xf8\xe5\xf5\xde\xd1\xf1\xdb\xe7\xef\x7f\x7d\xfe\xfa\x97\xa3\x97\x23\x78\x84\x15\x63\x74\x3e\x52\xfe\xc6\x0a\x39\x58\x6d\x04\x1f\x8d\xe7\x2a\x93\x2f\xfb\xf0\xfe\xd9\xe1\xef\xaf\x6f\xb0\x16\xdf\x1c\xfe\xfa\x2b\xad\x76\x74\xf0\xea\xf9\xe1\xf1\xd1\x08\xb6\xb7\xb6\xb6\x60\x08\xe6\x50\x84\x8b\xdd\xd3\x38\x1d\x7f\x18\x41\x87\xae\x8d\xf6\xdc\x89\x42\x7b\xe3\x71\xba\x4c\x8a\x51\x15\x5f\xc9\xd4\x2f\x15\x95\xb1\x61\xa4\xe2\xbb\xac\xf4\x77\xea\xcd\x9d\x7a\x83\xc7\x26\xcd\x67\xc1\xc3\xcf\x1a\x9a\x74\x3f\xbb\x5a\x14\xe9\xdf\xde\xa9\x6b\xf9\x98\xbd\x7b\x80\xe4\xa2\xa7\xe0\xa1\x25\x87\xf4\x8b\xb1\xee\x5b\x12\xd7\x0e\x1e\xca\x68\xd8\x76\x6d\xa6\xfb\x3a\xf1\x0d\xee\xdf\x97\xc5\x06\x32\x90\x29\xdf\xdd\xcd\xc8\xa5\xbd\xaf\x93\xb5\xb5\xb4\x06\x3f\xc3\x0e\x6d\xc6\xcc\xc1\xb8\x23\xb3\x15\x39\xda\x01\xe9\xa9\x56\xfa\xe8\xb9\x92\xee\x82\x2b\x5a\x0d\xaf\x2c\x71\x4c\x07\x30\x78\x49\x2e\x07\xec\x18\xa9\x6b\xc5\x03\x04\x8f\xab\x11\xc5\xaf\x44\x1b\x02\x2b\xb7\x99\xf0\xd3\x8e\x11\xec\x7c\xfb\xd8\x68\x55\x09\xcf\xe6\x0a\xc3\xc2\x44\x78\x39\xa1\x1b\x23\xf8\x96\xee\xf9\xf5\x19\xde\x18\xc1\xf7\x55\xa8\x95\x9d\x15\x62\xce\xdf\x49\xf2\x3b\x49\xfe\x1f\x21\xc9\x79\xc8\xc4\xcf\x25\xca\xbf\xea\x3d\xaa\xd5\x4d\xdd\x16\x55\x3e\x37\xdf\x6d\xaa\x35\xcc\x45\x6a\x30\x14\xab\xaa\x55\x72\x59\x4c\xbe\x57\x4b\xd2\x67\x64\xf3\xba\x4c\xa2\xe2\x55\xb0\xb0\xf7\x0c\x1d\xb1\x09\x64\xe9\xb1\xcd\xed\x08\xdd\x96\x8e\xa0\xb3\x8d\x6f\x6d\xe9\x97\xad\x2d\xbb\xd6\xff\xf8\x3e\x9e\x05\x67\x67\xc1\x94\x38\xbf\x57\x1b\x59\x57\x89\xb9\xd2\x3c\xf6\xfd\x55\xcd\xf7\x38\x3d\x27\x71\x30\x26\xbe\x32\xe5\xa6\xd9\x53\x66\xaa\xf7\x83\x15\xf9\xa5\xbe\x48\x3e\x0b\x92\x24\x4d\x6a\x4a\x95\x7b\xf5\xfa\x62\x75\xfd\x31\xab\x82\x56\x06\x45\x72\x69\x03\x68\x56\xb4\xbe\x98\x30\x5c\x98\xe5\xf0\x16\xa5\x99\xa1\x71\xe9\x46\x25\x99\xdd\x00\x29\x88\x95\xfd\xe0\x29\x8c\x52\x03\x33\x55\x34\x2f\x3f\xf7\xb7\x8f\x76\x51\x5b\x05\xab\x55\x34\xa9\x45\x2b\x9a\x2a\x95\x7c\x72\xbb\x77\x57\x01\x2b\x17\x41\x28\xae\x07\xb1\x9b\x39\x97\x0b\x32\xa6\xab\xbb\x7d\xa9\xd6\x90\xe1\xdc\xcd\x5b\x84\xe8\xd5\xbf\x19\x9e\xd9\x55\xeb\x67\x84\x56\x08\xd5\x6d\x3c\xe2\xf5\x3d\x9e\x05\x59\x30\x2e\x48\x96\xcb\xb3\x55\xe6\x0e\x27\x9a\x51\xd6\x61\x7f\xaf\xd1\x34\xe9\x2b\x77\xfa\x60\x4b\x2f\x6e\x47\x77\x8d\xa6\xb3\x02\x64\x8a\xb5\xda\x4c\x78\x62\xe4\xda\xc6\x82\x57\xea\xb3\x11\xe4\x7d\x06\x82\x2f\x66\x37\x77\x03\x65\x85\xe1\x81\xe8\x71\x50\x3a\x5a\x6d\xf7\x06\x7f\xa4\x51\xc2\xb2\x73\xc3\x13\xd6\x18\x8c\x60\x63\x6b\xa3\x07\x9b\xa2\xf0\x0a\x7e\xfd\x6b\x9c\xf8\xb7\x14\x61\xff\x99\x33\xcf\x86\xbe\xea\xd4\x8b\xe1\x6c\xf2\x54\x88\xab\x12\xc1\x2a\x97\x3a\xf4\x70\xc9\x4c\xff\x60\x57\x3a\x58\x62\x79\x3b\x79\x64\x2d\x11\xf0\x5c\x8b\x4d\x48\x20\x4a\x68\xfb\x35\xf3\xa0\xe6\xa0\x44\x82\x92\x53\x00\x7d\x17\x36\x78\xd0\xd9\x63\xae\x55\x55\x33\x33\x23\x97\x6c\x26\x86\x43\x78\x11\x25\x21\xf0\x30\x1c\xe6\x00\xa5\xf6\x56\x50\x4d\x6f\xc3\xcc\x5d\x5e\x3a\xda\xf6\x21\x86\x5d\x0a\x89\xbc\x31\x67\x9b\x0e\xe8\x47\xbe\x55\xa7\xfb\xdd\x06\x3b\x7d\xda\xb0\xe9\x0e\x6c\xec\xe7\x99\xb7\xaf\x70\xf2\x15\xfe\xbb\x3b\x68\x5b\x14\x50\x71\x3f\xa5\xf4\x16\xad\x00\xea\x46\x14\x1a\x33\xdd\xba\x0a\x3c\xaf\xbb\xbb\x0b\x5b\x48\x00\x1f\xfa\x3b\xcb\x48\xf0\x01\xa9\x4c\x11\xb7\xb9\x0b\x22\xf6\x1c\xa5\xaa\xfd\x59\x90\xed\xa7\x21\x61\x6d\x36\x35\x34\x50\x9a\x94\xfe\xc4\x79\x91\xdd\x9e\xc8\x39\x4d\xdd\x92\xca\xf7\x68\x23\x5f\x17\x99\x33\x90\xee\xe8\x7c\x15\x3a\x5f\x13\xa9\xe6\xc5\x2a\xd1\xf0\x74\xea\x44\x12\xf6\x76\x17\x19\x99\x44\x97\xcc\xe2\x03\x5b\x97\x3d\x4a\x12\x4c\x52\x37\x4d\x23\x9c\xa5\xf3\x86\x52\xd9\xbb\x16\x73\xbb\x50\x10\xb3\x75\xbb\x59\x86\x68\x9b\x96\x79\xa2\xda\xfa\xac\xc2\x02\x66\x73\x45\xb5\xa6\x9e\x93\x2d\x13\x12\xe2\x6e\xa3\x21\x24\x64\x8b\x14\x1c\x84\xbe\xb1\x5b\x13\x79\x91\x0d\xea\x6e\x87\x2a\xe4\x46\x8b\x8f\x05\xbd\xec\x15\xdd\x68\xed\x92\x94\x5d\x68\x61\x01\x18\x43\xa2\xa5\xb5\x40\xca\xd2\x61\x6e\xee\x42\x22\x15\x86\x9f\x60\x07\x9e\x40\x67\xab\x03\x9b\x40\x15\x85\xa4\x21\x2d\x8b\xd4\xbc\x33\x72\xf9\xb9\x48\xda\x95\x6f\xda\x49\xd3\x4d\x65\xf0\x57\x45\xd4\xb6\x94\xc6\xa8\xfa\xcb\x13\xec\x9f\x8e\xe6\x8c\xb4\x5f\x93\x65\x1c\x97\x48\x1e\x92\x73\x92\x14\xfc\x42\x30\x5b\xf8\xff\xc8\xd3\x04\x82\xb3\xa8\x7e\xa5\x97\x49\x77\x8e\xd2\x17\xcb\x38\x76\x86\x02\x92\xd7\x88\x69\xc3\x0f\x8c\x86\xed\xa8\x12\x1c\x38\x0b\x36\xdf\xf2\x6e\xc3\xa1\x11\x11\xed\x17\x3d\xb6\xa2\x1f\x06\xcc\x63\x33\x4a\x42\x72\x79\x38\xe9\x76\xba\x9d\x1e\x4b\x51\xf4\x60\xdb\x17\xc3\xa7\xac\xd8\xe4\xba\x4b\x71\xb5\x20\x02\x26\x56\x8f\x31\x84\x99\xa7\x21\xf2\x75\x17\x0d\x0a\x7e\xa5\x44\xeb\x4b\xec\x75\x1c\x17\x5b\x4a\x10\x61\x13\x3a\x5d\x4a\x6d\x25\x1c\x9b\xd0\xe9\x35\x4f\x6e\xe2\x92\x5c\x61\x94\x2f\xe2\xe0\x8a\x13\x0e\xcb\xe0\x95\x14\x74\x3b\x5a\x22\xbe\x96\x7e\xc8\x25\xab\xf0\x8c\x37\xd4\x28\x92\x14\xed\x48\x27\xdc\x1a\x99\xa4\x41\x49\xc5\x83\x41\xf6\x64\x3a\x80\xf9\x32\x2e\xa2\x45\x7c\xd5\x15\xb7\x38\x7a\xf0\xe0\xe7\xf2\xa5\x87\xee\x6c\xf8\x9b\x85\x45\x2b\xef\x3f\x59\xa4\x87\x4f\xa5\x28\xcf\xc9\x12\x9e\x98\x61\xa1\x44\x28\x0f\x2a\x4b\xb0\xd8\xb6\xc3\xe1\xb0\xc2\x4b\x7a\x4e\xb2\x38\x0d\x42\x12\x96\xe7\x78\x88\x1c\x40\x87\x79\x54\x91\x71\x6d\x40\x9d\x21\x1c\x1d\x3e\x3b\x1c\xc1\x3c\xf8\xc0\x4e\xfa\xa2\xe4\x7c\x19\x27\x24\x0b\xce\x62\x24\xe0\xd6\x3a\xf1\x51\x99\x02\xcc\x78\x59\xdb\xf0\x00\x94\xcf\xbd\xde\x20\x23\x8b\x38\x18\x93\x6e\x07\x3a\x2c\xe7\x05\x8c\xf4\x15\xc5\xcf\x17\xfb\x69\x72\x4e\xb2\x22\x17\x27\xc7\x45\xca\x77\x4c\x21\x19\x47\xf3\x20\x36\x17\xc2\x28\x69\xba\x7a\x17\xe9\x33\xde\x84\x87\x19\xae\xf9\x0a\x7d\x5d\x9e\xb7\xdc\x78\x65\xaa\x77\x6b\x24\x7a\xb3\x8f\xfc\x9d\xd1\xce\xd3\xb2\x5b\x51\x70\x50\xa4\xe2\xb9\xf9\x0e\xd4\x81\xbe\x15\x36\x9c\x74\xd1\xfa\x9c\x28\x53\xfa\x6b\x80\x34\xa6\x2b\xc8\x33\x31\x1b\x75\x88\x32\x5e\xde\x67\x16\xa7\x66\x86\x82\x81\xce\x89\x28\x1a\x93\x3c\x3f\x9a\x05\x49\x77\x8b\xe5\x86\x7b\xc0\x2f\xbe\x8a\x1b\xc8\x82\x43\xb6\x29\x9d\x77\xd4\x2f\x8d\xe7\x6c\x6f\xc9\xb2\x51\x8a\x89\x0b\x92\x2b\x11\xdf\x5a\xe4\xf7\x4a\xdc\x93\x38\x70\xce\xe2\x5e\x12\xf2\x03\x6d\xce\x1e\xd1\xe4\x2a\x17\x69\x73\x73\x38\x23\x93\x34\x23\xee\xba\x15\xcb\xbc\x34\xcd\x08\xfe\xb9\xbf\x16\x2a\xc9\xea\x5c\xf3\x92\x29\xa0\xda\xe4\xdb\x72\xf0\x1b\x71\xa3\x60\x1e\x5c\x8e\x59\x22\xbe\xcb\xa8\xb8\x1a\xc1\xf7\xc6\xd9\x2d\x08\x75\x24\xca\x45\x36\x46\xd6\x1a\xb2\x0f\x92\x41\xe5\x2a\x02\xec\x6e\xd2\xa2\x68\x78\x94\x28\xd7\x68\xad\x61\x7b\xce\xe6\xc4\xd5\x8d\x73\x4a\xf4\xbb\xbb\xd0\xe1\x73\xd4\xf1\xb7\x4a\x77\xa9\xdd\xbf\xbd\x3b\x7c\x3d\x28\x67\x97\xc3\x82\x65\x61\x62\xf9\xe1\x72\x08\x20\x21\xd3\xa0\x88\xce\x89\xa0\xea\x3e\x2c\x82\x3c\xa7\xcb\x48\x31\xcb\xd2\xe5\x74\xe6\xe0\xf8\x72\xdc\x82\x5d\x58\x47\x1e\x9f\xa3\x6a\xa5\x79\xc0\x6c\x30\xbe\xdd\xa7\x1b\x53\x66\xc9\xf2\x3a\xbd\xd6\x43\xd3\x0e\xce\xad\x6b\x56\x5a\x9b\xf7\xa2\xfc\x45\x94\x44\x05\x71\xcd\xa8\x01\x2b\xdb\x50\x61\x90\xba\x76\x19\x9e\x01\xfa\x45\xc3\x5b\xa1\x60\x70\x51\x90\x4e\xd8\x39\x3c\x5d\xf5\x7e\x27\xf5\x1b\x89\x29\x29\x7e\xa3\xf5\x0e\x27\xc7\x49\xe4\x3f\x23\x61\xcd\x16\x33\x22\xfe\x28\xc5\x11\x14\x69\xbf\x3c\xc1\xb0\xb3\xe2\x2a\x09\x4f\x4b\xf9\x5f\xc1\x5a\xb6\xd7\xe5\x00\xf7\xf4\xba\x2c\xd3\x40\x0e\x24\xcb\xd2\x4c\xc6\x6e\xe6\xc3\xcb\x21\x49\x0b\x18\xa7\x59\x46\xc6\xc5\xe8\xc2\x23\x31\xf4\x21\x6a\xa2\x83\x36\x65\xd1\xe9\x92\x97\x62\xff\x3d\x61\xff\x0d\x8a\xf4\xd7\xf4\x82\x64\xfb\x41\x4e\xba\x4c\x86\xb3\x83\x63\x64\x01\xa1\xa5\x7f\x13\xee\x6c\xc2\x1d\xe2\x84\xfe\x6f\x06\x00\xa3\x14\xa5\x94\x55\x13\xb3\xa2\x7c\xc3\x33\x2e\x24\xe4\x02\x9e\x53\x54\x74\x3b\xcc\x2f\x8c\x81\xc8\xae\x82\xfd\xb3\x53\x00\xb9\x8c\xf2\x22\xef\xc3\x22\x26\x41\xce\x36\x9e\x0c\x5d\x69\x52\x22\x7a\x92\xc6\x71\x7a\x11\x25\x53\x56\x33\xa7\xcb\x90\x21\x21\x04\xcc\x7d\x16\x77\xb2\x8f\xdc\xdd\xc7\x83\xf4\x52\xc0\x2a\x91\x57\x0e\x8c\x05\xde\x6f\x9e\xb0\x30\xf8\x40\x98\x0c\xe2\x4b\x36\xf3\x73\x8b\xd8\xe5\xb6\x72\xdd\xe3\x74\x47\x57\x3f\x16\x4c\x94\x53\x1b\x9f\x22\x27\xa5\xbf\x49\xf3\x3c\x3a\x8b\x39\xdd\xb0\xc8\xa8\xe6\x4d\x98\x77\x07\x74\xa7\x95\x15\xfc\x4f\xba\x8f\x95\x5f\x9f\x4f\x26\xd1\xf4\x4a\x3c\x1c\x5a\xa4\xfd\x00\x3e\x50\x10\xf9\x8f\x39\x95\x70\x80\xe8\x4f\xf8\xa0\x18\xe5\xe7\x55\xf9\x45\x34\x56\x8a\x83\xf4\x18\x31\x2a\x4c\xab\x0a\x49\x90\xa8\x15\x84\x53\x47\xf5\xcd\xa8\xf9\xe0\x41\xf9\xc8\xbc\x27\xaa\xaa\xcc\x3d\x43\xfb\xe6\xab\x1a\xc7\x51\x55\x95\x3b\x58\xa8\xdf\x8c\xaa\x0a\x80\x4a\x2b\xfc\xd1\xc4\x9d\x52\x16\x8c\xa2\xca\x03\x73\x7a\x30\xd1\x68\x8b\x99\x07\x80\x64\xe4\x7e\x00\x85\xf5\xd2\xa9\xc8\x1a\xe2\xd2\x30\xc9\x5d\x97\x7b\x61\x4e\x9e\xe3\x20\xa1\x1b\xf3\xa0\x5c\x29\xc5\x7b\x71\x5a\x92\x66\x10\xc0\xcb\xe7\x7f\x67\x16\x44\xb9\x33\xf9\x84\xe2\xb5\xd4\xb7\xa4\xc5\xe7\xf7\x19\x91\x39\x50\x02\xc5\x39\x8c\xeb\x0e\x6a\x72\x6b\x2a\x1f\x82\x1c\x2e\x08\x65\xf8\x2a\x46\xaf\x1c\x58\x8d\x36\xfe\x3b\xd1\xad\x86\x12\x1b\xa8\x64\xe5\x2a\x36\xed\x56\xca\x48\x55\x2b\xe7\x55\x7b\x83\x30\x3a\x8f\x42\x12\x3e\xbd\xea\xea\x92\x9b\x4b\x6b\xa7\x1e\xae\x2a\x5d\xa2\x29\x78\xa2\x75\x37\x52\x9f\x14\xdd\xfe\x56\x32\x2a\xe0\xf3\x85\x88\xa9\x0b\x12\xdd\x49\xa5\x4f\x25\x95\xee\x04\x5a\xf9\xd2\xb1\x2f\x6b\x24\xce\x94\x3d\xf9\xe7\x17\x6c\x54\x7e\x7c\xa5\xa2\x8d\xa1\x6f\xdd\x82\xad\x88\xe6\x24\xff\x7a\x85\x5a\x22\xce\x8b\xa8\x28\x2b\xed\xfa\x39\xb7\x21\x52\xad\x0b\x4a\x6f\xe2\x06\xd4\x57\x82\xdd\x8e\x06\x2b\xaa\x93\x6e\x4a\x94\xd4\x2a\xd2\x73\x34\x5b\x06\x9f\xaf\x5a\x42\x4b\x5a\xb3\xac\x7a\x4d\x9b\x73\xdd\xd4\xa8\xf0\xad\xd6\x2e\xfd\x95\x46\x27\xf1\xc7\xf5\x35\x6c\x21\x1b\x00\x64\x72\xdd\x7b\x7a\x5e\xc0\x61\x6e\x10\x93\x2e\xe1\xbe\x7f\x5f\x8e\x01\xdb\x06\x53\x70\xcc\xaf\xea\x36\xdc\x9b\x41\x41\x53\xf4\x45\x2b\xa5\x31\x77\xeb\x92\x5b\x73\x31\x7f\x06\x67\xa2\x05\xac\x45\x95\x8c\x57\xda\x44\x24\xa1\x20\x66\x84\x90\x4b\x32\xe6\xc5\x64\x6a\xfc\xd2\xf4\x21\x6e\x0f\xb1\xb2\xc5\x45\xda\xc9\x81\x4f\xf6\xdc\x38\xf2\x70\x50\xfe\xd1\x45\x9a\xef\xa3\x15\x6a\xc9\xbf\x8e\xa8\xbd\xa4\xac\xf7\xdb\x84\x9e\x69\xbd\x33\x85\x01\x30\x99\xc5\x62\xd8\x59\x07\x5d\x94\xea\xca\x9a\xaa\xe9\xb3\x39\xed\x6c\x4c\x6e\xf9\xdb\x60\x54\x36\x58\xc4\xcb\xbc\x82\x45\x3c\x37\xcc\x2d\x53\x56\x6b\x6e\x3a\x9f\x91\xf1\x87\x5c\x5a\x22\xf8\x1a\x24\x3d\xaa\x72\x11\xab\x25\xbe\x62\x29\xf7\xc2\x30\x23\x79\x7d\xf8\x69\xce\xbc\xe3\x62\xcf\x2e\x6f\xad\xa4\xa2\x4d\xa5\x6f\x2a\x1d\x03\xa4\xa2\x9a\x8d\x83\x04\x89\x8f\x72\x0c\x00\x34\xc2\x11\x1d\xba\x8e\x23\x86\xff\xb7\x75\x79\xb2\xf5\xe0\x87\xe0\xc1\xe4\xf4\xe3\xa3\xad\x9b\xff\x1e\x46\x83\x82\xe4\x45\x59\x6f\x4d\x78\x6d\x85\xce\x2f\x88\xc8\x36\x28\x64\x36\xc5\xe1\xff\x75\xb7\x2e\x7b\x4f\xbc\x38\x44\x59\x6a\x38\x84\x31\x45\x99\x10\x5f\xb3\x80\x8f\x85\x67\x11\x14\xf7\x74\xe6\x2c\xc8\x17\x55\xd3\x30\xf4\xe9\x33\x69\x26\x4b\x01\x3d\x3e\x29\x0a\xa7\x0e\x26\x5d\x55\x94\x62\x7b\x0f\x5e\xa0\xc5\x5c\xa3\x39\x98\xf0\xf3\x8b\x20\x8e\x21\x9f\xd3\x7f\xc7\xc1\x22\x67\xda\x66\x1c\x83\x7c\xee\xab\xf9\x71\x9c\x83\x31\x72\x02\x83\x33\x42\x91\xe8\xfb\xb0\xd4\x0c\x39\x4e\x49\x30\x9e\xc1\x38\xc8\xdd\x3d\x44\x39\x27\xd8\xe5\x5c\x4c\x39\x46\xf1\xa0\xcb\x9d\x5b\x31\x00\x87\x2c\x5f\xce\xe7\x24\x6c\xc1\x0b\x06\x94\x5f\x82\x27\x0c\x10\x1a\xf1\xc6\x70\xc8\xf1\xe1\x9a\x89\xa0\x6c\x4b\xfc\x65\xab\x20\x88\x6d\x56\x94\x7d\x19\xe4\x2c\xe0\xf2\x2c\x78\x28\x01\xd0\xed\xbb\xb6\x32\x8e\xf9\x36\x3d\xda\x72\xfb\x34\x89\x44\xa6\x49\x31\x83\x98\xb0\xd8\x6c\x55\x7a\xdc\xe5\x62\x41\x32\x3a\x24\x39\xd7\xb4\x54\x18\x4d\x23\xe6\x11\x40\x3f\xcc\x83\x05\x9d\xf3\x6d\xbb\x5d\xca\x8a\xdd\xd2\x8b\x54\x19\x0f\x4b\xf7\xb0\xfd\xb8\x07\x3f\xc3\x77\x54\xfb\x13\x9f\x4e\xa2\xd3\x41\x91\x1e\xd3\x1e\x85\xe9\xfa\xde\xee\xae\xf2\x91\x31\xad\xbf\xc1\x9f\x76\x91\x16\x55\x63\xb8\xd1\x62\x5d\x5e\x54\x4c\xd0\x80\xbd\x40\xe3\xeb\x35\x9a\xec\xdb\xcd\x53\xaf\x84\xb1\x46\xf2\x4e\x63\xc6\x29\xd2\x4f\xcb\x38\x4d\x4e\x39\x57\xe1\x1b\xe5\xb0\xb0\x64\x11\x33\xc0\x9b\x80\xa1\xd3\xb1\xa8\xdc\xe6\x2a\x6d\xa6\x6f\xc9\x63\x58\xe9\xb1\x35\x46\xda\x76\x23\xf6\x93\x20\xd6\xb9\x17\xca\xa5\x65\x56\x5d\xd9\xa1\xcc\xf5\x03\x14\x29\x4c\x78\x46\x64\xe9\x29\x53\x72\x26\xce\x78\x75\x7c\xe7\xa4\x7c\x73\x94\x9b\xbb\x4e\xfe\xc4\x18\xc3\xb9\x72\xd5\xb6\xbd\x2a\x9b\x19\x8d\x36\xdf\x7b\x55\x7b\x2d\x6d\x01\x2b\x52\xba\xa7\x8a\x42\xd8\xd9\xe2\x81\xbd\x1f\x08\x5f\x20\x0e\x2a\xbf\x60\xbe\x75\x09\xdc\xf9\xb6\x01\x77\xb6\xe0\x4a\x2f\x03\x82\x0c\xdd\x88\xae\xa9\xf6\x4d\x86\x96\xac\x68\x68\xd6\x7e\x1d\x48\x66\x9e\xb3\x70\x0e\xd8\xe6\x99\x2b\x65\x3e\x7d\xcc\xd7\x89\x70\x41\x69\xd8\x97\x5e\x49\xdc\x26\xec\x32\x3f\x8c\xb2\xb7\x2a\xe4\x47\x1f\x1e\xb5\xd8\xae\xcb\xb3\x6b\x99\x2f\x50\xda\xf0\xf2\x6a\xa7\xe8\x4d\x1c\xe8\xd0\x7b\xfc\xd6\x27\xaf\x07\x4a\x23\xa5\x06\x37\x1b\x71\xe0\x5d\x9b\x25\x39\xb4\x24\x2f\x82\x64\x4c\x65\x74\xd5\xca\xf5\xb5\x3d\x57\xa2\x39\x16\xd8\x85\xfd\xa5\x45\x56\x45\xdf\x72\xa7\x53\x26\xf2\xcb\xb6\x3b\xeb\x98\x0c\x69\x8c\x6b\x3f\x13\xef\xdc\xae\xee\xb7\x9f\x06\xde\x78\x8b\x39\x10\x8b\xa3\x18\x18\x43\x14\x1f\x5a\xe7\x13\x4c\x01\x87\x6e\x2d\xf8\x97\xe3\x5b\x65\x06\x5e\x20\x3e\xc1\x6b\x9c\x03\xd9\xfc\xed\x66\x41\x56\x6d\xee\x1b\xed\x46\x16\x1d\x53\xb1\x0a\xa6\x38\x32\x3e\x11\x9e\x0e\xc5\x68\xdb\xca\x0b\xaa\x5f\x27\xcb\x38\x66\x59\xbf\xbb\xb6\x10\x61\xb7\x72\x99\xc9\x17\x41\xab\xf0\x14\x5b\x03\x52\xcf\xf8\x18\x57\x12\xc6\xbc\xea\xa7\x12\xc5\xbc\xd0\xed\xc8\x4f\x8c\x6e\x1d\x88\x62\x49\x65\x56\x41\x93\x3b\xc1\xfd\xed\x91\x64\x27\xa9\x6f\xbb\x56\xb1\x16\x56\x46\x8f\x69\xcf\xe0\xea\x20\xbb\xed\x92\x5a\x4c\xe7\xc0\xcf\xdf\x72\x87\x0c\x43\x76\x53\x2d\xd1\x43\x9b\xae\xbd\xfb\x54\x64\x57\x1e\x95\xea\xde\x3d\xe6\x49\xc5\x23\x96\x21\x57\xfd\x6e\x60\x1c\x14\xe3\x19\x74\x6d\x37\x65\xa8\x31\xc3\xad\x0b\xe9\x81\x40\xfb\x73\x4a\x9a\x64\x39\x87\xb3\x38\x1d\x7f\x80\x19\x09\x42\x92\xd1\x87\x74\x5e\xef\xef\x1b\xe5\x4f\x69\x41\xaf\xea\x3d\x23\x97\x65\x82\x5a\xd6\x2c\x4c\xa2\xb8\x70\x9e\xd9\x35\xe2\x72\xd6\x8c\x96\xca\x90\xbe\x69\x66\x55\xfd\x76\x7b\xa7\x32\xab\xf2\x7a\xad\x27\xc1\x6d\x0b\xe5\xad\xab\x8d\x63\xa6\x50\xbb\x94\x0f\x04\xcc\x82\x89\x32\x6a\xbb\x24\xd7\x4d\x29\x24\x4e\xe9\xa6\x6d\x11\x8d\x9b\xd0\xc3\x11\x2d\xd8\x98\x1e\x0a\xbb\x74\x2b\x42\x60\xbd\x69\x84\xc0\x5a\x6c\x46\x08\x8f\x1f\x55\x74\xc0\xab\xad\x91\x0e\x58\xe3\x6a\xdb\x18\x19\x58\x85\x3e\x03\x15\xd4\x45\xf4\x15\x3b\xba\x91\xfc\xa3\x6f\x7d\x66\x41\x44\x46\xe5\x5f\x46\x01\xb6\x13\x1c\xf1\xff\xac\x4f\xc2\xa3\x79\x54\xfd\x69\x14\x51\xdc\x9e\x47\xea\x83\xd5\xd2\x71\x31\xf9\x7e\x24\xfe\xb7\x3e\x32\x6f\xeb\x91\xfc\x03\xe9\x82\xd7\x96\x7f\x21\x05\x44\x0b\xe5\x9f\x66\x17\xf6\x45\xc7\x11\xf6\xd2\xa8\x66\xdf\x53\x1b\x21\xef\xf0\x4a\xf2\xd6\xd7\xc8\x7c\x61\x8d\xfe\x77\xc2\xc6\xfe\x3b\xc1\x46\xce\x3e\x8a\x3f\xac\x9a\xe5\x36\x71\xa4\x3e\x58\xc5\xf4\xb3\xe5\x91\xf5\xc6\x9e\x0f\x6e\x17\x18\x55\x7f\x1a\x45\x94\x3d\xf4\x48\x7d\xb0\x8a\x69\x26\x94\x91\xf9\xc2\x2a\xae\x14\x74\x15\x31\x2c\xab\x23\xfb\x95\x35\x1c\xab\x8a\xf5\xca\xea\x45\xee\x8b\x46\xca\xdf\xe8\xe0\x92\xe9\xa8\xfc\xcb\x2a\xc0\x55\xbf\x51\xf9\x97\x8d\x45\x2e\x34\x47\xd5\x9f\x36\x4a\xa8\xea\x36\x92\x7f\x58\x9f\xa9\xf2\x33\x12\xff\xdb\xcd\xd3\xa5\x6a\x24\xff\xb0\x3e\x33\x69\x3c\x92\x7f\xf4\x31\x09\x24\x92\xa4\x88\xd0\x82\x1b\x23\xd8\xfe\xa1\x0f\xfe\x10\xd8\x7d\xd8\x58\x16\x93\xef\x37\x46\xf0\xfd\xb7\x55\x10\xd5\xed\xf6\x41\x54\xeb\x24\xdf\x86\x08\x20\x4a\x21\xd8\x1a\xec\x6c\x0d\xb6\x37\x94\x21\xa0\x49\x47\x76\x76\xee\x62\xb9\xde\xc5\x72\xbd\x8b\xe5\x8a\xc6\x72\x15\x0d\xeb\x5f\x1c\xe9\x47\xfe\x46\x26\x93\x8c\x5c\xc1\xef\x51\x3c\xfe\x40\xe0\xa7\x3f\xc8\x64\xe2\x8c\xec\xda\x36\x5b\x09\x2b\x1f\x05\x09\x1c\xd2\xed\x6b\xc0\x8a\x47\x41\xe2\x29\xff\x22\x38\xa3\xe5\x7f\x4b\xa7\x24\xce\x0b\x12\xc7\x24\x83\x9f\x26\xec\xa5\xa7\xd6\x2f\xc1\x39\xfc\x9e\xa6\x21\xfc\x34\xad\x8f\x4a\xfb\xc8\x50\x6c\x75\xd5\x56\x64\x43\x7a\x15\x24\xc1\x54\x0f\xe2\x3a\x18\x52\xbc\x0e\x33\x5e\x60\xce\x0b\x58\x31\x5a\x0f\xce\x98\x11\xc4\xac\x15\x9d\x05\x89\x55\xf6\x4d\xc4\xf2\x30\x99\x65\xb9\x4a\x9f\x0f\x59\x30\x47\xb3\xce\xb3\xa7\x9e\x1a\xe1\x99\x9d\xc9\x6e\x36\xf3\x54\xc8\x67\x33\xab\xc6\x6b\xe2\x03\x2a\x21\x36\x4c\x6f\x48\x96\xb3\x20\x29\x9e\xb1\x88\x22\x36\x7c\x17\x41\x36\xf7\x41\x48\xbf\xdb\xb5\x48\x51\x44\xc9\x34\x47\x2a\xe6\xe2\x93\x55\x47\x8a\x45\xad\x8a\x14\x77\x7f\xe4\xa9\x3d\x3f\x2c\x10\xb0\x5e\x9e\xbd\xe2\xff\x36\x08\xe4\xcb\x4b\x97\xb1\xe6\xd5\xc2\xe4\xb2\x20\x49\x88\xc0\xcf\x3f\x58\xe5\x9f\x32\xb3\x85\x5d\xfc\x8c\xbe\xb7\x67\x24\x4b\x17\x24\x2b\xae\x90\x0a\x0b\xf1\xc9\xaa\xf3\xb2\x28\x16\x6f\xb2\xf4\x3c\x0a\x51\xba\xa7\x62\x69\x21\x3e\xdb\x54\xbf\x18\x7b\xaa\x46\x8b\xb1\xb3\x66\xd3\xec\x3c\x55\xad\x72\xd5\xff\x9d\x9c\x3d\xec\xca\x86\x1d\x39\xfd\x32\x93\x9d\x13\x72\x61\xf0\x78\xd5\x04\x96\xde\x6f\xbc\xcc\x32\x92\x14\xca\xe8\x64\x71\xac\xf4\x82\x73\x34\xed\x84\x32\x37\x4b\x28\x8d\x36\x1b\x9e\x89\x62\xcf\x9e\xba\x0b\xe5\x8c\x77\x59\x0e\xc0\xd9\xcc\x5d\x2c\x21\xb2\xcf\xd7\xc4\xd3\xe5\xa2\xe2\x52\x06\x9f\x78\x74\x57\x38\xfb\xd7\xbf\x64\xf7\x94\x09\x3d\x70\x56\xdc\xc8\x4a\x8b\x47\xeb\x1c\x9d\x15\xae\xd8\x10\xd9\x71\x07\x8b\x68\x54\xaa\x20\xe2\x7f\x63\xe7\x8d\x0e\x4d\x4c\x09\xa2\x5b\xd2\x9f\x4a\xd9\x23\xed\xa9\x6f\x17\x56\x48\x79\xa4\x3e\x34\x00\xe3\x7d\xc9\xd4\xfc\x0f\x37\xc6\x44\xc9\x2e\x02\xac\xe0\xcf\x88\xe4\x23\xe5\xef\xae\xe1\x91\xae\xa5\x81\xbc\x11\x49\x09\xa9\xd2\x9b\x13\xa8\xb0\x91\x72\xc5\x8a\x85\x37\xaf\x8a\x53\xbe\xf1\xa1\xac\x31\xba\xea\x51\xa5\x5b\x40\x64\xc7\x22\xb3\x64\x4e\x54\xb6\xaa\x94\xf9\x56\x2c\xad\xb6\xb2\x06\x4e\xf6\x02\x9c\x11\x9e\xb8\xbd\x02\xf5\x03\x21\x8b\x83\xfc\xdd\x55\x32\x8e\x92\x69\x33\x78\x59\x23\x46\xc5\x55\x99\xca\x0b\xad\x2a\x59\x9f\x22\x1e\xd6\x46\x71\x19\xd0\x81\x27\x02\x60\x4f\xbe\xc2\x32\x50\x99\x2c\xce\x9e\x7d\x15\x44\xb8\x3e\x59\x9e\x3e\xba\x8b\xab\xa1\xd0\x78\x8d\xf2\x8d\xbf\x92\xd6\x8b\x7c\xe1\x03\xab\x0a\x63\x22\x21\x13\x6f\xfc\xfd\x98\xd5\x94\x77\xbe\xde\xd4\x39\x91\xfd\x35\x9a\x1b\x7e\x0d\x4a\x56\xf9\x9d\xd4\xa0\x41\x2d\x2e\x9e\xdd\x15\x54\x87\x69\x5e\xa5\x7c\xe3\xab\x64\x7b\xc6\xc9\xca\xfb\x4e\xc7\x25\x6b\x5c\xae\x46\xac\x2f\x3e\x48\x0e\x9e\xee\xbd\x56\xba\xa7\x8f\xee\xe2\x55\x18\x6e\x5e\x5e\x3c\x7b\x2b\xc8\xe8\xcd\x65\x0d\xf6\xc2\xd0\x4b\x4c\xe9\xc6\xb5\x41\x3b\xe0\xb3\x2b\x8b\x90\xee\xdf\xc3\x7c\xf6\xcc\x2a\xab\xb8\x80\x85\x51\xc6\xce\x10\xc7\xc1\x82\x5d\xda\xf7\x3a\x58\x21\xa4\x74\xb0\xbf\xf7\x46\x1b\x07\x6d\x09\xbd\xc3\x12\xf1\xad\x0f\x95\x56\x74\x17\xc4\x0b\xe2\xf1\xab\x68\xd1\x81\x00\xc5\x23\xd1\x58\xf0\xec\x72\x0d\xf4\xe7\x70\x16\x2d\x9f\xd8\x2b\x2a\xd3\x76\x84\xd6\x8b\xad\xb8\xac\x0c\x33\xf0\x76\xa4\xea\x91\xa4\xa1\x95\xb0\x4f\xfe\xa6\xcc\x95\x78\x04\x1d\xaa\xdb\xbe\x1f\xc7\x11\x49\x8a\xdf\x78\xbd\x8e\x5d\xe3\xa6\x87\x34\xb3\x0a\x44\xa4\xb8\x48\xb3\x0f\xb5\x40\x25\xa4\x78\x2f\xea\xb8\xca\xea\x79\xae\x47\xa6\xe0\xfb\x74\x63\x20\xe2\xc4\xb3\x76\x10\x54\x8d\x7e\xcf\xc8\x70\x9c\xc6\xbf\xad\x7d\x34\xfa\xbb\xd3\xa6\x0b\x6a\x94\xef\xa7\x49\xc2\x83\xde\x37\x21\xc5\x2e\xaa\x76\xdc\xbf\x8f\xaa\x23\x6a\xeb\x5d\x4f\xac\x76\x03\x26\x1e\xce\x52\x6e\x0e\xeb\x61\x62\x97\xbf\x58\x6e\x6e\x53\x37\xad\x39\xac\xa2\xfd\x1a\xd9\xb7\xaa\xd2\x1b\xea\x56\x97\xdb\x96\x91\x8f\xec\xdf\x8d\x11\xec\x6c\xe9\x5f\xd5\x3d\x38\xfd\xbc\xad\x7f\xae\xb6\xb9\xf4\xe3\x23\xe4\x23\x57\xa6\xe9\xd7\xef\x91\xaf\xea\xc6\x75\xc3\x48\x95\xb9\xa1\x98\x67\xe8\xb7\x87\xd8\xb7\x6a\xf7\x4a\x8b\x60\x00\x54\xf6\x17\x5a\xe2\x3b\x4f\x09\x4a\xd9\xb4\x0c\x06\xa8\x62\x62\xa1\x45\x7e\xf0\x35\x23\x36\x6f\x1b\x23\x78\xb4\xe5\x29\x97\xcf\x28\xd2\x1e\x61\x18\xd5\x0c\x2c\xb4\x10\x86\x17\x69\x2c\xa0\xdf\xbf\x45\xbe\xeb\xc6\x30\x5a\xea\x31\x52\x4a\xaa\xb2\xf4\xbb\x86\x1c\xef\xf1\x83\x20\xb4\xd2\xe2\xff\xf0\xce\xe2\x7f\x67\xf1\xbf\xb3\xf8\xa3\x16\xff\x20\x8e\x59\xec\xd2\x75\x67\x70\xf3\xdb\xca\x2d\x83\xa7\xd7\xe2\xf9\x2e\x8d\xa3\x30\x2a\xae\x9e\x9f\xf3\x7b\xd1\x8a\x95\x90\xc1\x6e\x55\x90\xb7\x02\x32\xc3\x06\x5b\xbd\x6f\x60\xb1\xf5\x9b\x6c\x5f\x30\x87\x28\xa3\x79\xf6\xce\x2a\x7a\x41\x57\x20\x62\x80\x22\x85\xa8\xf8\x88\x24\x63\xdb\x8b\x63\x6d\xe0\xba\x16\xab\xdb\x06\xfa\xcc\x15\xaf\x0f\xae\xcb\x0c\x0e\x93\xa6\xfe\x02\x35\x37\xfd\xc1\x3d\xeb\xe8\x7f\xe8\x77\xeb\xce\x91\x53\x31\xb0\xc6\xa3\x28\x23\xdc\xb9\x48\xf7\x6f\x74\x6c\x76\x64\xba\xd4\xdd\xf2\xaf\xeb\x6b\xf8\x68\x5a\xd5\xb4\x58\xa0\x1f\x6f\xac\x1b\x49\x27\x1d\xba\x43\x61\x99\xa1\x3b\x7d\xe8\x14\x29\xff\xf3\x74\xc0\xe7\x50\x09\x2e\x3d\xf1\x39\xf8\x08\x10\x4e\x26\xa7\xcc\xcd\xb7\xbc\xa6\x65\x87\x9b\x9e\xa4\xd9\xf3\x60\x3c\x6b\xd0\x30\x05\x9a\x36\xb8\xab\x90\x31\x8f\x7b\xcd\x60\xe4\x3b\xfe\x52\x6d\xed\x56\x20\x58\x1e\x8b\x58\x50\x12\x16\xcd\xb4\x9a\x34\x6d\x12\x5d\x31\x4c\x6a\x22\x9d\xfa\x26\x96\xe7\x57\xd1\x26\x36\x0c\x8a\xc0\x1a\x3a\x7d\x39\xa0\xff\xc0\xae\xf2\xf7\xf5\x35\xbf\xe6\x66\x95\x64\xae\x57\xb9\x2c\x2b\x9e\xae\xaf\xe1\xe4\xd4\x1a\x03\x3b\x32\xa1\x60\x49\xc7\x33\xa5\xca\xc9\xd6\xe9\x20\xa7\x02\xd3\xce\x74\x4b\xab\xcd\x85\x72\x5c\x71\x82\x4d\x1e\x7f\xf8\xc8\x43\xed\x77\x57\xdc\xa6\x13\xbb\x0c\xdb\xf1\xa8\xfb\x87\x1d\xab\xaf\x77\xb2\x65\x8f\x88\x79\xc6\x31\xd8\x7a\xf0\x91\x5d\x24\x0f\x92\x24\x2d\x60\x12\x25\x21\x87\x39\x4a\xa6\xbc\xef\x27\x36\x68\xe3\x34\xc9\xd3\x98\x0c\x2e\x82\x2c\xe9\x76\xd4\xaa\x3c\x86\x35\x5d\xdb\xe2\x74\x6a\x5d\x0e\x54\x86\x45\x31\xd8\x24\x78\x3b\x11\xf2\x9a\x59\x21\x55\x0a\xe9\x62\xf2\xa8\xcf\x61\xef\xeb\x34\xe9\x30\x03\xb0\xa6\x65\xf6\x1e\x46\x51\xab\xc9\x9d\x4b\x32\xa6\x2a\x19\x22\x78\xfa\x2c\x64\xfb\x59\x30\xfe\xc0\x66\xd8\x9e\x02\x69\x34\x92\xbe\x41\xdd\x20\x9b\x2e\xd9\x95\xfd\x93\xf2\x2f\x25\x82\xb6\xe3\x66\xad\xec\x84\x0a\x50\x7f\x7d\x64\x3e\x28\x18\x56\x59\x4a\x69\xdb\x8e\xf0\xa6\x95\xf0\x4c\x96\xb1\x19\x2d\x15\x9d\xc3\x54\xd2\xbf\x48\x82\x82\x18\x94\xc0\x5c\x75\x65\x15\x3e\x3d\x83\xb3\xc8\x71\xa0\xa1\xec\x2c\xf9\x72\xda\x4d\xfb\x22\xff\x62\x1f\x5d\xb2\xfa\x72\x2d\x65\xc7\x65\xdd\x5e\xbf\xea\x53\x99\xae\x95\x28\x21\x28\x8a\x60\x3c\x3b\x4a\xf7\x65\x24\x7e\x95\x24\x64\x78\x7e\xd4\x78\x55\xd1\x10\xc7\x13\x7f\x74\x8f\x5a\x36\x36\x08\xe2\xb8\x5c\xd8\x45\xad\xe6\xbb\x6a\x6b\x2c\x9e\x2d\xb6\x7f\x8f\x5d\xb7\xc9\x66\xac\x46\xdf\x1b\xbb\x53\x2e\x0a\xe9\x07\x63\xbf\x59\x2d\x5b\x74\x33\x6a\xb4\x66\xe8\x3d\x74\x63\xf7\xd0\xdc\xaf\x3d\xba\xdb\xaf\xdd\xed\xd7\xee\xf6\x6b\xe8\x7e\x8d\x99\xb3\x3e\x5b\xb6\x6d\x2a\xdf\xfe\x96\xa7\x49\xb6\x18\xeb\xdb\x97\x3f\xf8\x4b\xdb\x39\x25\xcb\x52\x73\xd7\xc5\xdf\x21\x3b\x1c\xdb\xf8\x48\xd1\x86\x6f\x5e\xac\xbd\x0b\xc3\xf0\xfb\xfa\x1d\x8c\x28\x41\x61\x3a\x71\x1b\x6c\x9b\x24\x6e\x09\xc2\x50\x26\x01\xa2\x4b\x96\x68\x98\xa5\x1c\x65\x03\x11\x2f\xd4\x76\x74\x7c\x8b\x1b\x17\x41\x88\xa7\x26\x55\x53\xfd\x50\x84\xb3\xf6\x0a\xe4\x7e\x95\x42\x24\x0c\x85\xea\x32\x16\x86\xd8\x36\xd1\x8b\xd3\x7c\xb0\x58\xe6\xb3\xb2\xe8\xad\x70\x24\x17\xc3\x76\x18\x11\xb5\x1a\x8e\x11\x53\xda\x1c\x01\x1d\xcb\xa9\xd7\xc6\xeb\x21\x93\xca\x37\x20\x09\xb9\xb5\x5b\x56\xea\x2b\xbd\x91\x2c\xeb\x8b\x5d\x91\xbd\x41\x85\x72\x9f\xc5\xf9\x80\xff\x25\x37\x27\x76\x49\x31\x07\x7a\x4a\x23\xf1\xba\x0f\x2c\xc0\x5f\x5d\x4c\x16\xd1\xc9\x09\x2b\x7c\x8a\x6e\x88\xc1\xb5\x0d\xe5\x75\xeb\x3a\xa2\x9a\xa6\x04\x55\x74\x33\xf0\xe8\xc7\x66\xd5\x7b\x42\x88\x0c\xa2\xfc\xb7\x20\x8e\xc2\xb7\x24\x5f\xa4\x49\x4e\x44\xef\xee\xa0\x33\xd6\x40\x71\x10\xba\x5c\xc6\x0c\x0e\x92\x73\xb4\x79\x04\x19\x25\x52\x7c\xb0\x3b\xfb\xe3\xd1\xd5\x2d\x94\x70\xd5\x87\x05\xf8\xc4\x3e\x08\x78\x06\x02\x2c\x16\xfa\x53\x7d\xe1\x82\xf3\x06\x9b\x4b\x6c\xb7\x5f\x3e\xf8\x95\x47\x46\xd9\x86\xc2\xb8\x21\x05\x35\xd5\xe8\x1e\xf7\xa9\xae\x26\x04\x11\xd5\xe2\x14\x37\xfe\x6f\xef\xb4\xb3\x3b\xed\xec\x4e\x3b\x43\xb5\xb3\x72\x6f\xf7\x39\x8d\xe9\x6d\x4d\xd7\x74\x53\x9e\x19\xe5\x73\xb1\x97\x1c\xb2\x8f\xb7\xb7\xc0\xcb\x0a\x4a\x70\x09\xd5\x50\x2e\x83\x45\x98\xd5\xf6\x94\x2d\xb1\x52\xbe\x3c\xa4\x30\x94\xc8\x46\x1a\x09\x37\x2d\xab\x71\x3f\x98\xbe\x55\x1f\x44\x8c\xd7\xdc\xaf\x2a\xbe\xb1\xea\x95\xba\x1b\xbb\x99\x75\x63\x66\x7e\x34\x3f\xfb\x81\x30\x33\xe4\xe1\xdd\xeb\xc1\x8b\xce\xa2\xbe\x68\xc9\x75\x6e\x1f\x9c\x45\x88\xd5\x12\xcb\xea\x08\x46\x02\x44\xaa\x6c\xf1\xe8\x0a\x0a\xdc\x1d\xb8\x7f\x5f\x4b\xc5\xa8\x58\x9d\x38\x20\x78\x1a\xe7\x9b\x9e\xa1\xe1\x34\x02\x01\x4f\xf7\x48\x5f\xd6\xa9\x44\xac\x10\x96\xfa\x11\xf0\xc5\xd3\xe8\x83\x56\x74\xa9\x76\x3c\xc4\x16\xe5\x13\x61\x14\xe3\x13\xc3\xeb\x94\xd3\x81\x99\x72\x2d\x83\xf6\x6a\x9b\x10\x09\x66\x2e\x62\xb1\x73\x83\x55\xc3\x38\x0c\x41\x18\x4a\xa6\xcc\x2b\x73\x17\x4a\xb5\xf2\xe3\x4d\xd9\x49\x0b\xda\x37\xa8\x19\xef\xb6\x99\x95\xad\xb2\x98\xad\x81\x96\xb1\x40\x35\x28\x09\xd4\xb5\xab\x5a\xb5\x4b\x1b\x70\x09\xea\xfb\x45\x44\xd5\x77\x7e\x2c\x57\x0d\xc0\x11\x64\xb4\xf9\xf9\xd0\xc4\xb2\x54\x56\x78\x6b\xae\x11\x36\xa5\x34\x2e\x75\x57\x24\x33\x2e\xc9\x3f\x2f\x8d\x99\x7d\xb6\x30\xe3\xca\x75\x67\xad\xd4\xc6\x57\xc7\xfa\x83\x39\xb1\xf8\x89\xc3\x92\x72\x19\x34\xe8\xc9\xb6\x87\x73\xa8\xeb\x29\x6c\x2f\x8e\xfd\x84\xa3\x17\x17\x2e\x01\xab\x33\x04\x3f\xe6\xa9\x83\xfe\x16\xdc\x81\x47\x61\xb1\xed\xf8\x6d\xb8\xa3\x1d\x7f\x94\xe1\x9b\xd9\x45\x09\x49\x6f\x53\x52\xe4\xc2\xf3\x34\xbe\x82\x90\x2c\xe2\xf4\x8a\x84\xf2\x3e\x05\x0b\xd8\x32\x9e\x05\x91\x27\xa9\xa3\xe4\x20\xd6\xfe\x8b\x34\x93\x23\xf1\xc5\x4c\x94\xa6\x23\x2f\x0f\x49\x21\x75\x53\x1e\x95\xe8\xc5\xca\x34\x6f\xc7\xf2\x18\xdb\x17\xc9\xc3\x01\x1e\xca\x70\xc6\x59\x9a\x49\xf8\xe3\x74\xc9\xb4\xca\x2d\xc4\x51\x53\x56\x7c\x11\x65\xdc\x79\x52\x44\xca\xd0\x4b\x0e\x87\x70\x11\x44\xfc\xf0\x92\xed\x12\x16\x85\xdd\xcd\x44\xba\x6c\x18\x64\x29\x78\xbc\x43\xb7\x61\xec\xe8\xa9\x8e\xca\x98\x45\x83\xb0\x78\x5e\x1a\x78\xee\x00\xa2\x74\x80\x9b\x9b\x16\xd8\x0a\xf8\x79\x91\x2e\xf8\xe9\x56\x94\x4c\x21\x98\x50\x48\xbf\xdd\xe2\x04\x93\x43\xb7\x88\xe6\x24\x5d\x16\x8e\x63\x3d\x0a\x10\x47\xe2\xcf\xf0\xed\x56\x8d\x3d\x86\x0f\x77\x40\x3b\xfc\x5d\xf4\xd7\xf5\x18\xd2\xd4\x9f\xa5\x37\x69\x83\x34\x66\x4a\x04\x3f\xf1\x1b\x86\x4a\xba\xf0\x9b\x7f\x2a\xbb\x4b\x95\x40\xaf\x14\xf1\xec\x2c\x3d\xe0\xf0\x8f\x29\x9f\xb2\x64\x7a\x67\x74\x63\xb9\x4c\x42\x13\x97\x1d\xaf\x35\x88\xc4\x58\xf4\x58\xf5\x67\x25\xf2\x5b\x19\x0e\x43\xe6\xa8\xbf\x2a\x66\xac\x07\xdf\x3a\x15\x4f\x49\x71\x54\x01\xf0\x96\x33\x41\x25\x81\x15\xe0\x5e\x06\xf9\x4c\x23\xf2\xbe\xe4\x99\x5a\x33\x1c\x37\x03\xb2\xb2\x0e\xf2\xf7\xd7\xc7\xe1\xde\x4f\x43\x22\x9b\x1d\x8c\x75\x79\x62\x00\x4a\xd5\xed\x5a\x28\xe5\xcf\x95\x43\xf6\x31\x92\x43\xd6\x37\x62\x9d\xb2\xaf\xaf\xe1\x1e\x03\xa3\x59\x03\x50\x2e\x90\x5e\x66\x50\x7f\xb7\xe0\x50\xf5\xe7\xe5\x56\xf5\xd7\x9a\x73\xd5\x1f\x97\x3d\x61\x99\x2c\xfc\x67\x78\xd8\x90\x10\xe4\x6f\x38\x2c\x9d\x52\xe2\x74\xaa\x30\x14\xdb\xb8\xcb\x25\xf4\x5e\xc7\x56\x53\xbc\x83\x32\xb4\x0a\x66\x51\x40\x49\xac\x55\xb3\xc3\x21\x70\x15\x43\x2a\x8c\x41\x12\x82\x38\xd4\x86\x60\x1a\x44\x89\x60\xf4\x0b\xc2\x8c\x56\xcd\x5b\xc6\x77\x48\x4e\xfd\xa5\xa6\x29\x53\x11\xf6\x28\x7b\x35\xc3\x1d\xf3\xdc\x0d\xc2\x4d\x85\xae\xb0\x54\x93\xc9\xc9\x38\x4d\x42\xa0\xeb\x52\xf3\xd6\x5a\x48\x7c\xf3\x67\x58\xde\xdb\x8f\xc6\x1f\x86\xfb\x93\x41\xab\x26\x7c\x55\xd4\x44\x61\x93\x52\x16\x89\xbc\x48\x33\x12\x96\xc9\x5f\xb9\x76\xc9\x4c\xc5\xd3\x20\x87\x60\x4e\x97\xf7\x81\x7f\xf1\x32\x7f\xf5\x8b\x99\xf9\x43\xb2\xd4\xae\x03\xe8\x86\x30\x23\xc7\x1c\x56\x91\xba\xb6\x3c\x6d\x38\xeb\x62\xc7\x2b\x6b\xdd\x4a\x2b\xa7\xc6\x92\x1f\x5f\x04\xe3\x22\xcd\xae\xca\x08\x9d\xb5\xdb\x01\xa3\xe2\xea\xbb\x64\x13\x02\xed\x02\x35\x33\x5a\x04\x67\x11\x7e\x60\x2c\x82\x14\xd0\xff\xb0\x33\xd4\xe0\x2c\x82\x5d\x5a\xdb\xd6\xcf\x35\x24\x35\x42\x54\x65\x70\x48\x20\x50\x36\x4e\x66\x3b\x56\xbb\x12\x61\x09\xb9\xb0\xbf\x49\x44\x25\x57\x37\x2a\x5d\x1b\x66\xd9\x6d\xe9\x05\x18\xc4\x26\xe3\x37\x6e\x63\xa7\x49\x1b\xe6\xbe\x4d\xd3\x21\x45\x0c\x57\x79\xc4\x16\xba\x9b\xf1\xee\xec\xc0\xd8\xdd\x55\x16\x17\xf9\xaa\xec\x1d\x21\x46\x93\x82\xa0\x8a\x1d\x71\xe1\x3f\x81\x07\x8f\x06\xf6\x9d\x43\x03\xe3\xbb\xc1\xd2\x74\xa3\x32\x4c\xb7\xa4\xc0\x7e\x49\x6d\xb8\xf4\x1f\x0e\x81\x45\x71\xad\x1c\x2a\xf1\x8e\x2a\x67\xc8\x8f\x37\x3f\xd2\x5a\x12\xd1\xf7\x1c\x80\x09\xdc\xa2\x9d\x32\xfb\x53\xc6\x2e\xdd\x33\x2e\x54\xef\xf3\xc6\xd1\x98\xb0\xa3\xe3\xca\x59\x13\x13\x45\x2e\xa7\x52\xe6\x0f\xda\xc0\x95\x14\x2c\x77\xd2\x7c\xb0\x48\x17\x78\x52\x0a\xd7\x18\xe2\x80\xb1\x38\xda\x6d\x0d\xcc\x9c\x98\xbb\xb4\x05\x16\xce\xfa\x5e\x79\x25\x9c\x22\x84\xbf\x77\x02\x5e\x4d\x46\x7b\xb8\x29\x10\xa2\xfe\x80\xe7\xa5\xff\x19\xb6\x9c\x3d\x09\x1a\x93\xdc\xba\x27\x85\x56\x0b\x5b\x9f\xfc\xdd\xea\xb4\x44\xc1\xae\x6b\x5d\x92\x67\x06\xc8\x05\x06\x75\xec\xf7\xf4\xe1\x0c\x16\xc1\x55\x70\x16\xfb\xf7\x49\xf6\x36\x96\x7b\x82\xe7\x24\x09\x45\x72\xff\x22\x85\x24\x4d\x1e\x88\xd6\x54\x9c\x39\x57\x74\x7c\x25\xc5\x29\x8d\x25\x12\x81\x5d\xd7\x39\x57\x57\x32\x79\x9f\x61\x0a\xeb\x51\xce\x39\xbb\x2b\xb0\xb9\xcb\x5b\x44\x31\xa5\xa9\x72\xee\xdd\x89\x6a\x46\xd2\xac\x7a\x72\x0f\x11\x84\x89\x69\xf7\x0b\x09\x5c\x04\x79\xb9\x4d\xc1\x1b\x2e\x65\x17\x73\x2c\x52\xf6\xe9\x95\xb7\xb9\xe1\x5d\x34\x0b\xf2\x99\x77\x02\xe9\x90\x48\x66\x87\x3a\x31\x7f\xaa\x8b\x8c\xd7\x0f\xa6\x91\x7e\x4c\xb7\x3f\x61\xc8\xbd\x2a\x94\xa5\x8a\x42\x5b\x03\x86\xc3\x10\x01\xbb\xac\x72\xad\x1a\xef\xdc\x88\x4c\xa2\x2c\x2f\x1a\xec\x43\x56\xde\x40\x38\x0c\x9d\x98\x71\xd3\xef\x66\x84\xbe\x6e\x9f\x31\x88\xf2\xce\x8c\x63\xae\x96\xac\x5c\x10\xb5\x9e\xc6\xfa\xe9\x5b\x05\x75\x8d\x25\x7c\x79\xe8\xca\xeb\x99\xa6\x7c\x6b\x0e\xa5\x86\x32\x98\x92\xe2\x19\xbf\x55\xc4\x5e\x89\x47\xdc\x39\x7f\x9d\x7a\x7d\xbd\x75\xbf\xfd\x69\x17\xa2\xc3\x89\xde\x6e\x74\x8d\x79\x62\xc3\x30\xfc\xcb\x7f\x0d\x87\x86\x76\xa5\xb9\x11\xe8\xfe\x5a\x43\xe5\x48\xc7\xe8\xab\x2b\xf4\xae\xe1\x70\x95\x43\x93\x29\x29\x20\x18\x8f\x59\xb6\xb5\x14\xc8\x65\x94\x17\x51\x32\x6d\xa2\xec\x3b\x8e\x19\x1d\x08\xe4\x84\x76\x63\x4b\x70\x54\x9b\xf6\x69\xd2\xf0\x11\x53\xe3\x9b\x2a\xd4\x13\x48\xd2\xaa\x29\xba\x50\x2c\x82\x3c\x27\x61\x5f\x6b\xab\xca\x35\x41\x8b\xe6\x8a\x90\xd3\x17\x85\x92\x6f\x1c\xdb\x3b\x63\xaa\xb4\xeb\x2d\x58\xd2\xab\xda\x73\x99\xc6\x9a\x78\x75\xf5\x14\x3b\x9b\x29\x66\x4a\x0a\x9c\x1c\x82\x8c\x00\xd5\x35\xa4\x07\x60\x09\x66\xdf\xaa\x79\x46\xc6\xc1\x32\x27\x70\x41\x20\x4c\x93\x02\x2e\x82\x84\x79\x5c\xe7\x8b\x34\x8a\xb9\xef\x5a\x52\x90\x6c\x12\x98\x9b\x96\x96\xd6\xb4\x76\x16\x33\x4b\x9d\x00\xe3\xe7\x59\x6c\xd4\x62\x8e\xec\x6d\xb6\x94\xf3\x8b\xa6\x5f\x48\xc1\x93\x17\x52\x45\xa8\x0f\x17\xb3\x68\x3c\x63\x6e\x83\x54\xf2\x15\xa9\xd0\x4d\x60\x11\x2f\xf3\x55\xfc\xa0\x84\xd0\x6c\x4d\x73\x95\xec\xad\xf1\xd3\x6e\xba\x11\x5b\x61\x8f\x65\x37\xd0\x62\x83\xb3\x96\xcd\x4d\x83\x8d\x0d\x7a\xf1\xee\x76\xea\x71\x33\xd5\x58\xbf\xc5\x3c\x30\xee\x75\xde\x6a\x3d\xf4\xd9\x14\xfc\x06\xae\x56\x2b\x62\x9d\xbc\x6f\x60\x07\xc3\x0d\x60\x75\x77\xe9\x6b\x4c\x61\xb6\x9a\x84\x5c\xb8\xe4\x53\xe8\xb9\x40\x0f\x98\x65\x0d\x9f\x1e\xcb\xc7\xdb\xe0\x4b\x23\x9c\xbb\xe9\xf1\xb9\x31\x82\xef\xfa\xf8\x65\x40\xd8\x50\x1c\x30\xe9\xab\x87\x7d\xe3\x5a\x20\xbb\xf4\x27\x50\xb8\x31\x82\x87\xdb\x95\xb3\xf8\xe3\x3b\x67\xf1\x3b\x67\xf1\x3b\x67\x71\xd4\x59\x5c\xdc\x59\xf9\x5c\x77\xf9\xea\x72\x29\x88\xbb\x33\x3c\xbe\xc4\xe1\x44\x7a\x72\xed\x65\xd3\x7c\x54\x67\xe7\x55\xb6\x0b\xc2\x9c\x24\x5a\x03\x1e\x95\x89\x25\xb1\x97\xeb\x32\x5d\x29\x64\xeb\x80\xba\x82\x73\x39\xe5\x05\xef\xed\x9b\x7d\xbe\x10\xae\x05\x36\x66\x9d\xe3\xfa\x10\x61\x61\x63\x8a\x14\xde\xbe\xd9\x17\x07\xbb\x4d\x61\x13\x61\xd8\x58\xce\x90\x0a\xa8\x59\x8a\xdc\xbf\xc3\x01\xdb\x3f\x7c\xfd\xfa\xf9\xfe\xd1\xc1\xe1\x6b\x78\xfe\xf6\xed\xe1\xdb\x11\xec\x97\xa7\x6d\x63\xde\x38\xb7\xca\x85\x04\x3a\xb0\x09\xb4\x69\xd8\x84\x8e\x7d\xc4\xe6\x80\xb0\x0a\xba\xdb\x1a\x69\x65\x18\x3a\x6e\x25\x2c\x28\x7b\x47\xbc\xd5\xa6\xbd\xcb\x6b\x59\x23\x30\xaf\x9f\xa1\x30\xb0\x10\x1c\x24\xcf\x83\x29\x81\x5d\xb8\x77\x4f\xc4\x73\xa1\xca\x97\xf8\x7b\xc0\x58\xc8\x7e\x33\x90\xd5\x9e\x00\xfa\x7a\x04\x25\x0d\xfc\xed\xdd\xe1\x6b\x36\xd5\x59\x09\x1c\xc5\x2c\xcb\x05\xc7\x83\x68\x46\x93\x2b\x09\xa5\x3b\x24\x46\x85\x28\xd1\x47\x1d\x4a\x2a\x5a\x39\xe2\xae\x5d\x2a\x52\x10\x6f\xfe\x5a\x82\x39\x3a\x78\xf5\xfc\xf0\xf8\x68\x04\xc2\x55\x8c\x92\x35\x1d\xc9\x3c\xa7\x14\x42\xff\x0b\xc6\x33\x2a\x10\xed\xd9\xc2\x74\x0a\x3d\x67\xca\x77\x77\xcb\xf8\xdd\x32\x7e\xb7\x8c\xe3\xcb\x38\x0b\x45\xf3\x6f\x76\xe1\x6b\x85\x08\x6a\xed\x62\xba\x7d\xda\xf8\x69\x56\x54\x63\x2a\x84\x4a\x6b\x81\x24\x30\xce\x3d\xea\xce\x55\x84\x40\x62\x70\xf8\xf6\x8e\xe6\x7d\xb8\xcf\x1f\x97\x6d\x21\x2f\x83\x29\xa7\x8b\x68\x41\x9e\xce\x1a\x3c\x61\xa7\xf2\x34\xc1\x53\x0d\x34\xda\x9a\xbe\x0f\x92\x34\xb9\x9a\xa7\xcb\x12\x98\xf2\xc5\x0a\xb6\x04\x39\x1f\x53\x22\xa7\x81\x84\x5c\x2f\x63\x59\x7f\xeb\x8d\x55\x56\xa9\xd2\x46\xf0\x34\x4d\xe3\x1b\x08\xc9\x38\x0a\x59\xfe\x47\xbe\x9a\x12\x5e\x25\x54\x68\x82\x5d\xd9\x26\x21\x66\xed\xad\x0c\x11\x2c\x1f\x2f\x15\x46\x66\x7f\x0a\xa5\x68\x54\xa2\x06\x2f\xa7\x75\xf4\xc8\xd8\xbc\x4b\x67\xc2\x63\x65\xca\xed\x63\x6a\xdb\x59\x47\xa9\x1a\x0d\x44\xdb\xec\xcc\x59\xfc\x5d\x77\xf5\xa9\xa6\x45\xe4\x56\xdd\x4a\x0e\x52\xea\x64\x73\xce\x0b\x79\x4a\x45\x16\xfe\xb9\x76\xaa\xc3\x2a\xff\x22\x3e\x57\x32\x7d\xa9\xbf\xed\x06\x13\xa6\xf4\xd4\x28\x56\x32\x67\x36\x3b\x4d\x64\xb7\x62\xc9\xf5\xe0\x8b\x49\xb3\x46\xc8\x2a\x44\xea\xc9\x26\x98\x42\x5b\x6d\x48\xd7\xab\xe1\x48\x26\xc6\x5c\x3b\x82\xf2\x68\x9a\x04\xc5\x32\xab\x47\x10\x5a\xd2\x85\x21\xb4\x70\x03\x0c\x95\xf5\x1a\xa1\x88\xc5\x0b\x5c\x0f\x46\xc4\x2d\x68\x29\x0b\xc4\xea\x11\x24\x61\x69\x9f\x2e\x52\x48\x13\x02\x93\x28\x09\xe2\xa6\x97\xfc\x78\xab\xa8\xb4\x95\x9e\x6d\xa8\x30\x35\xca\x08\x10\x70\xc4\xcb\x42\xe4\x9c\x64\x57\xc5\x8c\x9f\x16\xce\xcf\x22\x26\xb9\xd3\x29\x8b\x0c\xcf\x06\x22\x12\x14\xb7\x9c\x11\x24\xf2\xa8\x00\xd9\x9d\x6f\xa1\x94\xa8\xe5\x5f\x58\xc4\x95\xbb\x40\xa5\xb7\x0f\x54\x5a\xc6\xf9\x44\x02\x7b\xd6\xc6\x32\xd5\xa6\x6c\x02\xdd\x7b\x86\xbe\xe2\x19\x96\xe8\x98\x07\x46\x12\x79\x35\x78\x6e\x1d\xc9\xc0\x5d\x3b\x58\x27\x76\x8a\x23\x08\xe4\x48\x8e\x63\x6d\x2b\x39\x96\x5a\xba\xd9\x32\xce\x32\xda\x31\x07\xaf\x92\x7e\x4f\xa2\x01\x15\x30\x2e\x07\x3f\x51\x5a\xa5\x1b\x4a\xcb\xd5\xeb\x64\x19\xc7\x75\x17\xf2\x91\xf3\x0f\x14\x6b\x60\x1c\xbc\xf1\xf3\x35\xd6\x97\xdb\x7b\x50\xf4\xc1\x4a\x19\x48\x38\x6f\xe2\xc1\x27\xa6\xd8\xba\xd4\xdf\xe5\xca\x4e\x1f\xce\x9d\x1e\x6f\x0e\x1f\x96\x95\x3b\x62\x03\x6d\xcf\x20\x3a\xdd\x8e\xd3\x64\x1c\x14\x5d\x8d\xfa\xb0\x36\x9a\x84\xf7\xad\x5f\x5c\x44\x90\x5f\xf7\xe2\x52\xaf\xca\x91\xda\x85\x24\x34\x8e\xa0\xed\x15\x42\x88\x51\xe1\x36\xcd\x36\xfd\xbc\xdd\xb0\x04\xed\xfe\x7d\x66\xbe\xd4\x21\x6d\xab\x0c\x7a\x42\x1a\xeb\xf8\xfd\xb4\x41\x8d\x83\x6c\x6a\x88\x95\x6a\x27\xf6\x44\x6b\x63\xa4\x3e\x89\x80\xc7\xdb\x58\x04\x57\x81\x17\x71\x6a\x5f\x76\x60\xc6\xc6\xe0\xe4\xe4\xcb\x7b\xaf\xf6\x64\x85\x56\xbe\xe9\x0d\xfe\x48\xa3\xa4\xbb\xb1\xe1\x81\xa1\x0c\x76\xc2\x59\x85\x23\x5d\x3d\xfa\x66\x9b\xa8\x2e\x15\x83\xbd\xbe\x0a\x38\x7e\xea\x9f\xa4\xc5\x81\x36\xb8\x72\x3e\x7c\x01\xa0\xab\x5a\x0d\xc1\x61\xb7\x93\x7b\x7d\xa3\x3b\x1c\xa4\x72\xd5\x57\x56\xcf\x74\x59\x2c\x96\xc5\xaf\xe9\xb4\x5a\x36\xcd\xe0\xc6\x50\x31\xbf\x8c\xaf\xcc\x83\xed\x2a\xdb\x0c\x47\xf9\x6a\xa9\x64\x03\x77\x47\xfd\xe6\xa5\xb9\xa7\x85\xb6\x62\x65\x24\x5c\x8e\x89\x42\x0b\xc1\x78\xdc\x07\x91\x90\x06\xa5\x88\x60\x3c\x3e\x11\xdf\xf9\x1a\x43\x71\x28\x9e\x25\x1b\x3e\xd1\x27\x7d\x90\xcf\xa2\x49\xd1\xed\xc1\xc8\x9a\x00\xf9\xc9\x6d\x88\x0f\xc6\x63\xcb\xf2\x0e\x1f\x11\x01\x1a\x92\x98\x14\x44\x0e\x16\x09\x69\xad\x17\xe0\x24\xfd\xc9\x44\xa8\xe2\x39\x21\xae\xa9\x0b\x31\x46\xb5\x3f\xbe\xe9\xa8\xd7\xc6\xed\x28\x8a\x9f\x4e\x1d\xd7\xa0\x6c\xab\x73\x23\x01\x1c\x4d\xa5\xfb\x4f\x1d\x7e\x7b\x67\x95\xf0\xdb\xde\x16\xb7\x1b\x5c\x9c\x70\xb4\x09\xda\x8e\x05\x0f\x0c\xd9\x40\x93\x35\x03\x81\x5b\xbb\xa4\x3f\x63\x44\xf0\x7a\xc6\x54\x2f\xfb\xae\x14\x07\xc7\x88\x47\x82\x72\x5e\xe9\x41\xda\x92\x91\x3e\x5f\xd0\x72\x5a\x47\xb7\x82\xd5\x2e\x3a\xf2\x66\x0a\x03\xe2\x44\x29\x79\x8a\x73\x22\x56\x12\x8d\x8c\x0e\x36\xc5\xa2\x95\x4f\xca\x85\x99\xc3\x77\xea\x1c\x2d\xee\xa1\x59\xe3\x60\xa5\x4d\x8a\x37\xfa\x3a\xe2\x6a\xa5\x17\xb8\x4d\x78\xf6\x4f\x17\x86\xfd\xfb\xf6\x87\xbe\x6b\x4b\xbe\x33\xac\x39\xd2\x7a\xc5\xb9\x0b\x39\x12\x6a\x98\xd1\x5c\x4b\x66\xce\x72\x20\x1f\x1d\x3e\x3b\x1c\x41\x46\xb8\xf7\x7c\x1f\xf2\x54\xf8\x17\x97\xae\x21\x95\xe7\x72\xc0\x4f\x26\x06\xac\x62\x54\x74\x72\x48\xc8\x98\xe4\x79\x90\x5d\x51\x21\x31\x0f\x3e\x10\xc8\x29\x9f\x74\x58\x86\x39\x96\x85\x10\x2e\xd2\xec\x03\xdf\x96\xcc\x97\x71\x11\x2d\x62\x25\xe1\xb2\x0e\x72\x99\x0d\xba\x26\x32\xf6\xf0\x1b\x40\xef\x57\x7e\x6b\x5d\xd9\xe4\xad\x6a\x2d\x96\x90\x39\x2e\x44\x55\x80\x90\x90\xaf\xfa\x8e\x05\xab\x6c\x68\x20\x91\xea\x0d\x23\x7c\x8f\x0e\xe5\xc4\xae\x84\x8b\x05\xf9\x73\x55\x72\x2d\x69\xe0\xbe\x73\xa3\x0f\x4a\x84\x12\xc7\xda\x6e\x7d\x35\x07\x6d\xb9\x8d\xd1\xa3\x02\x42\x30\xa8\x13\x27\x56\x49\xc4\x70\xc7\xbf\x78\xd1\xca\x8b\x94\x0b\x89\xf0\x72\xd6\xc7\xe1\xbb\xd6\x24\xea\xe7\xa4\x30\x52\xe3\x63\xe1\xd9\xdb\xda\x50\x1a\x92\x5b\xe4\x88\xd4\xa8\xa3\xa9\x2a\x8c\x60\xaa\x96\x70\xa1\xca\xaf\x7e\xb5\x3a\xb6\xca\x16\x3e\x0d\xbe\xb4\x47\x3b\xa6\xdb\xe5\x40\x13\xcd\xd5\xc3\x8f\x56\x41\x29\x90\xd9\xff\xf6\xe7\x52\xfa\xf2\x3f\xec\x02\x8a\xcc\x95\x7f\xba\xb6\x4e\x5a\xb2\x70\xf3\x4a\x91\xb5\xe8\x72\x5c\xdb\xa1\xab\x5d\x8e\xcb\xe6\x22\x58\xad\x7d\xf4\x99\x87\xb9\xd6\x52\x55\x56\xcb\xdf\x0f\x77\x3e\x4f\x77\x3e\x4f\x77\x3e\x4f\xdf\x48\x3f\x27\x11\x95\x0a\x77\x74\xca\x47\xda\x5b\x80\xbf\x91\xc9\x24\x23\x57\xf0\x7b\x14\x8f\x3f\x10\xf8\xe9\x0f\x32\x99\x38\xfd\x9e\xa0\xa5\x9f\x14\x2b\x1f\x05\x09\x1c\x06\xc9\x98\x04\xac\x78\x14\x24\x9e\xf2\x2f\x82\x33\x5a\xfe\xb7\x74\x4a\xe2\xbc\x20\x71\x4c\x32\xf8\x69\xc2\x5e\x7a\x6a\xfd\x12\x9c\xc3\xef\x69\x1a\xc2\x4f\xd3\xdb\xfa\x6c\x7d\xa2\x94\x94\xee\xad\xec\x7e\x9a\x9c\x13\x2a\x34\x03\x98\x46\x8c\x01\x58\x1e\x3a\xba\xa5\x85\x19\xb9\x04\x99\x21\x9e\xb2\x4f\x10\xe7\x29\x04\x71\x9c\x5e\xe4\xcc\x8a\xc0\x4f\x21\x72\x4f\x8c\x4a\xb1\x71\x7d\x15\x5d\x92\xf0\x86\x17\xc7\xed\x45\xe2\xdc\xdc\xb1\xb1\xa5\xc3\x2c\x52\x99\x99\x4f\x39\xb4\x61\xa7\x20\xb8\xe1\x47\x3f\x76\x82\xeb\x6b\xe6\xaf\x90\x4e\x94\xf3\xa8\x4e\x79\x4e\xd5\x41\x2c\x31\xfa\x81\x94\xa9\x27\xf3\x83\x31\x0e\x76\xb7\x3c\x8d\x71\x80\xc1\x4d\x98\x87\x13\x76\x46\xd8\x63\x7d\x6f\xb9\x7b\x64\x55\xcc\xc5\x12\x8d\xd1\xa4\xf9\x48\x4c\xb2\x74\x7e\x5c\x4c\xbe\xef\x5a\x67\x43\x37\x62\xef\x32\xe4\x2b\x8e\xf4\x64\x30\x6f\x66\xa5\xe5\xf9\xaf\xb0\x5a\xf4\x29\x19\x9c\x93\x2c\x9a\xb0\x10\xa5\x19\x19\x07\x54\xe4\x2a\x09\xf3\xef\xdf\x87\x38\xf8\xd7\x15\xc4\x69\x10\x42\x78\x95\x04\xf3\x68\x0c\x69\x42\x72\xde\x9d\x98\xff\xaa\x27\x4e\x4d\x54\x6e\x4a\xbb\x08\x2b\x26\x2f\xc4\xca\xee\xed\x62\x2a\x25\x4c\x49\x71\x58\x5a\xb3\x90\x84\x7c\x74\x9e\x91\x3d\x50\xf3\x20\x7f\xaa\xdd\x50\xcc\xb0\xb4\x5e\x35\x38\x3c\xaf\x3b\xef\x75\x1f\xf8\x9b\x25\xf3\x8b\xa8\x18\xcf\x78\x94\x71\x87\x59\x32\x27\xc2\xee\x35\xf2\x04\xab\xe0\xfb\xcc\x65\x46\xc4\x89\x4b\x9f\x79\xbe\x8c\x39\xe7\x73\xfb\xd5\x8c\x5c\x7a\xed\x86\xd5\xa9\x93\xf1\xc2\x95\xb0\xa6\x41\x5d\xec\xbc\xa8\xc9\x29\xac\x71\xe6\xcb\xeb\xf5\xe0\x09\x1f\x1d\x6b\x55\x08\x8b\x1e\x8c\xa4\xdc\x10\xc5\x7c\xaa\x32\xfe\x49\x4a\x28\x37\x58\x7c\x34\x23\x63\x74\x48\x90\x5a\xf9\xa3\x9c\x5a\x15\xa7\x4f\x9e\xc2\x45\xaa\xb6\xec\x29\x28\xce\x69\xaa\xd2\xf2\xf6\xb6\x1f\x10\xe6\x84\x31\x6a\xe5\x9b\x31\x28\xeb\xf5\xbc\x80\xaf\xd2\xb4\xa8\xe5\xb0\x8c\x63\x5b\x78\xce\x04\xf9\x6c\xd6\x19\x79\x67\xd0\xc9\x9e\x6a\xe3\xae\x85\x72\x2f\x0c\xf9\xdd\xe9\xf2\x24\x20\x48\x42\xc8\x49\x91\xc3\x72\xc1\x3e\x88\xfd\x35\x93\x99\x51\x41\x32\xba\xdc\xa7\xe7\x42\xe5\x16\x99\x97\x06\x1a\x91\x29\x17\xa7\x7f\x4d\xa7\xf9\x5e\xf1\xae\x08\x32\xd5\xf2\x6b\x9e\xb8\xe4\x24\x9e\x20\x9f\x27\xde\x80\xcd\x43\x4b\x78\x2a\x9d\x69\x02\x94\x36\xef\xb9\xea\x2f\x43\xab\x4c\x49\xc1\x8d\xee\xb4\x1d\x23\xbe\x0a\xb3\x13\x56\x38\xca\x8d\x01\x49\xd3\x8e\x21\x5c\x69\xc7\x03\xaf\x84\x65\x25\xa6\xa4\xe8\x1a\x91\x0e\xc4\x2d\x1a\xf7\xc6\x7e\x38\x84\x30\x4d\x3a\x22\x60\x11\x05\x5f\x4c\x07\xbb\xc4\xc3\xdc\xd9\xe4\x4b\xe9\x3f\xce\xa2\x73\x0e\x06\x03\xf8\x63\xc9\xd3\xb6\xd1\xce\xe9\xa2\xe6\xb6\x55\xd5\x05\xd8\x69\x12\x5c\xc7\x99\x60\xca\xf6\x72\x29\x47\x5d\x63\xb3\xe1\xa5\x50\x8b\x0f\xbf\x7c\xd4\x38\x28\x10\x8f\x4f\xe0\xb8\xb3\xa4\x8d\xe2\x56\xb9\xa9\xbe\x3c\x2f\x2e\xd2\x38\xe6\xd4\xdd\x9c\x0f\x4d\x16\xab\xda\xb0\xd8\x0b\xd1\x55\xd9\xa1\x59\xf2\xaa\xbc\xc0\xa6\x51\x78\x5a\x47\xe3\x82\xfa\x52\x37\xfd\x49\x27\x51\xca\x41\x12\x61\x18\x4d\x78\x03\x55\xc8\x9f\x9e\x48\x6d\x3d\x86\xba\xb6\xb4\x7d\x4b\xba\xae\xae\x0a\x32\x94\x54\x67\x8f\x4f\x8c\x17\x55\x6b\x23\x59\xc9\x43\xf8\xeb\xc1\x2f\xac\x99\xe9\x56\xb2\x00\xb2\xa1\x98\x89\x0e\xa9\x24\x7f\x93\xc6\x31\x95\xd7\xc8\x00\x38\xfb\x8c\x78\xdd\x88\xaa\xd7\x73\x92\x14\xcc\x1c\x34\xa0\xfc\xc0\x50\x83\x28\x0b\x0b\x71\x39\xf7\x84\xe3\x9e\x71\xcd\x41\x78\x6a\x94\xbc\xe9\x83\xf6\xbd\x5f\x71\x8c\xf8\xa2\x06\xc1\xe6\x07\x76\x8c\xdd\x74\xb5\xee\xc6\x4a\x2d\x8a\xb0\xa9\xb6\x8d\xe8\x83\x95\x7c\x5c\x8a\x16\xe4\x00\xb9\x2f\x8c\x1f\xfb\x19\x61\x23\x67\xb7\x2f\xf7\x7d\xa1\x73\xd8\xd2\xc2\x8f\x1b\x31\x1f\x27\x0d\x8f\xd8\x11\xc6\x4a\x46\x7d\xa7\x31\xbe\xde\xac\x8c\x9f\x03\xe8\x70\x7a\x33\x68\x80\x33\x51\x6b\xfd\x65\xa6\x6a\xe3\x54\xed\x00\x8d\x5d\x1f\x56\xcd\xc2\xa2\xfe\x02\xab\x22\xe9\xcc\x13\x8f\xa3\x64\x75\x33\x61\x6c\x59\x42\x68\x5a\xfb\xb5\x05\xab\xf5\xc2\x5d\x46\x75\x91\x28\xff\xae\x1f\xed\x20\x21\x17\xc2\x43\x42\x45\x61\xdf\x5e\x65\x22\x9c\x56\xea\xd7\x97\x7a\xc1\x77\xe6\x5f\x52\xce\x56\x58\x4c\x24\x68\xc2\xa4\xe3\x61\x3b\x23\x9b\x93\x17\x14\x4f\x33\x35\x30\x22\x52\xd6\x7b\x02\xa8\x49\x33\x4a\x92\xa1\x2f\xe9\x87\x1a\x4a\x32\x23\xec\x74\x99\x6e\xe0\x17\x24\x09\xd9\x3d\x87\x92\xc2\x82\x9c\xd9\x82\x93\x9c\xf2\x92\x1d\xa0\xb7\x6a\x31\x9d\xb0\x5a\xb4\x36\xd5\xaa\x7b\xdc\x0e\x5c\x02\xb4\x4c\x82\x3c\x8f\xa6\x09\x3b\xba\x76\xc2\x6f\x12\x78\xfb\xb9\x2f\xd5\xfa\xf1\x59\xeb\xe9\x47\x41\xc0\xaf\x00\x28\x03\xaf\x2e\x0c\x42\xac\x6e\x5f\x82\x38\x23\x41\x78\x55\x85\x9a\xab\x76\x32\x48\x58\x60\x58\x03\xdd\xeb\x1b\x31\xb9\xf9\x6a\x8f\x07\xca\x06\x06\x2c\x65\x8a\x05\xcc\xc8\x28\x7f\x95\xd4\xe1\x4b\xa5\x3f\xeb\x0c\xdd\x2b\x16\xa9\xc0\x4a\x34\x9f\x93\x30\x0a\x0a\x12\x5f\xd5\xb2\xe6\x78\x05\x3e\x54\x55\x56\xd6\x63\xb7\x2e\x74\x66\xc3\xd0\xec\xfa\x2b\xe5\xd6\xa4\xd3\x9d\x87\x63\x48\xf1\xa5\xba\xb0\x92\x91\x3b\x15\x3b\x7d\x9d\xe0\x97\x44\xd4\x81\xd8\x1b\x63\x6d\xf1\x41\x51\x64\x50\x8d\xf0\x4a\xf2\x60\x47\x99\x66\xcc\x61\xcb\xd2\xca\x57\x42\x8a\xaa\x7d\xb5\xc0\x8d\xa5\x63\xa6\x0b\xa9\x62\xea\x98\x68\xbd\x00\xb3\xe0\x70\xf3\xf4\x5c\x1e\x47\x41\x90\x5f\x25\x63\x1b\xe1\x5e\xa5\x1c\x5b\x4f\x97\x09\x0b\x54\xa6\xe1\x54\x51\x4b\x5d\x33\xe1\x5e\x0d\xd4\xab\xbb\x6d\xfa\x6a\xbc\x77\xb6\x26\x8b\x8a\xc0\x46\x73\xe4\x57\x50\x51\xe7\xda\xb2\x2d\xa7\x32\x61\xe8\x57\x75\xb7\x82\x86\x43\x38\x98\x54\x0b\x53\x94\x97\x71\x65\xae\x88\x08\xa9\x0b\x51\x01\x48\x2c\xfb\xaa\x81\x8b\x19\x61\xbe\xa2\x02\x8d\x3d\xe0\x8b\x5b\x0e\x51\xe1\x58\xde\x50\xfd\xcd\x66\x60\xb3\x9a\x7f\xcd\xc7\xa6\x58\xf4\x61\x92\x91\x9d\x42\xfe\x2b\x8c\xf1\x6c\xec\x53\x33\x62\x1a\xf4\xe3\x74\xda\x28\x09\x91\x2a\xee\x3d\xdb\x71\xd6\xdc\x88\x2e\xdb\x1e\xd0\x19\xf8\x75\x89\xdc\xf1\x4a\x0d\xb6\xca\x4e\xbc\xac\x40\xd9\x56\x64\x75\xb1\x17\x3d\x78\xc6\x5f\x8c\x04\xd5\x77\x7b\x03\xae\xa2\x8d\x03\x99\x4e\x85\xc5\xbc\x25\x21\x50\x99\x36\xcb\xd2\x24\x5d\xe6\x65\x6e\x15\xe1\x2a\x48\xf5\x40\x33\x04\x36\x6f\x46\x6c\xe1\xd0\xd8\xec\x08\x6e\x58\x2c\xcc\xb4\xba\xe2\xd1\x84\x80\x3d\xf7\x2e\x68\x53\x2d\xc8\x64\x1d\xa4\x81\x99\x19\xdb\xaf\x79\x96\x8b\x11\x9f\x2e\xcb\xc5\xa8\x99\x83\x51\xe9\x3e\xf4\x70\xab\xbd\xfb\x50\x67\x99\xf3\x93\xd4\x71\xd1\xd1\xad\xa4\xfc\xef\x3b\xb7\xa2\x3b\xb7\xa2\x7f\x1f\xb7\x22\x85\x55\x4b\x17\xa3\xea\x40\x71\x3d\xf1\x94\x44\xad\x55\xbc\x7f\x1a\x44\x54\x5c\x2d\x16\x53\x32\x89\xa6\x68\x05\xfe\xc9\xaa\x71\x70\x16\x18\xe9\xf3\xa3\xb3\x20\x69\x96\x09\x9f\x1d\xe0\x31\xa4\x02\xbf\x5a\xc8\xfd\x7f\xce\xa2\xa9\x08\x7e\xa8\x56\xd2\x87\x2f\xce\x4d\x78\xb5\xa7\xd1\xd4\x38\xd7\xd5\x0b\x8b\x73\x14\x7e\xec\x77\xcd\x8b\x5e\x97\x95\x6e\xf4\xc2\x65\xe0\xf4\xaa\x80\xff\x1e\x19\x3b\x49\x71\xc0\xa1\x69\xbc\x7c\x4c\xfe\x88\x23\x45\x5a\x36\x22\xcb\x3b\xd7\x06\x66\xab\xcd\xdf\x64\x44\x78\x13\x29\x07\xdc\x5a\xbf\x67\xd5\x7b\x57\xe7\x67\x6a\x55\xba\x63\x16\xd9\x66\xe1\xfa\xda\xfe\x26\x0c\x40\xf8\x47\x12\x64\x71\x44\xab\x36\x06\xfb\x75\xfa\x86\x37\xf8\x19\x40\x6f\x08\x5d\xb2\x58\x16\xcf\xc8\x24\x58\xc6\xa8\xd7\x40\x63\x10\xa9\x7e\x66\xc2\x50\x3a\x7f\xf9\xf3\xf7\x53\x66\x1b\x84\x0a\x0c\xfe\x2b\x46\x32\x0a\x83\xd3\xd1\x41\x05\xb3\x7e\xf0\x5f\x66\xd4\xce\xb8\x1f\x5c\x01\xa6\xed\x3a\xe8\x5d\x83\xc7\xd7\x85\x52\xae\x11\x42\x25\x57\xbe\x24\x97\x2d\x51\x68\x90\xf5\xd7\x8b\x46\x03\xd0\xaf\x07\x95\xe6\xaa\xc1\x51\x98\x2b\xf7\x9e\xd2\x09\x04\x5a\x8a\x98\x20\x09\xa5\x83\x59\xce\x3c\x2c\xb8\xab\x28\x5d\x57\x5e\x3e\xff\x7b\xed\x72\xc2\x5a\xa5\x3b\x6f\xff\x42\x22\x0f\xe4\xb5\x74\x78\xee\x6b\xc9\x79\xfd\xfa\x61\x77\x8c\x1d\x0e\x22\xc7\xf8\xaa\x6b\x94\xe2\xe9\xc6\x1e\xaf\xaf\x0d\x39\xb2\x37\x66\x69\xb8\x51\x1b\xa0\x5a\xd3\x97\x29\x40\xf6\xc4\x20\x16\x81\xed\x71\x4f\xad\xda\xfd\x8f\xda\x6d\x91\xf6\xe0\x23\xb0\xeb\x6a\x30\x8f\xa6\x33\xbe\xe1\x2c\xd3\x17\x8a\xb3\x11\x37\x54\x45\x5a\x0b\x53\x91\xd6\x42\x74\xd2\x99\x06\xf9\x9b\x2c\x1a\x93\x4e\x1f\xe8\xdf\xf4\x3f\x46\x42\xf4\x8f\x24\x4d\xc6\x04\x8b\x6d\xf4\x81\xe0\x57\x73\x8c\xe8\x46\x1f\xc8\xd5\x0a\xf1\x8d\x5c\x8d\x6b\xad\xee\x2a\xce\xb8\xcf\xc8\x38\x9a\x07\x71\x57\x2d\xd0\x28\x64\x8b\xc3\x5d\xed\xab\x60\x4a\x25\x7b\xd4\x17\xe1\x4d\xac\x7f\x27\x8b\x22\xd3\xd4\x96\x41\x3d\x4d\x34\xe3\xbc\xaf\x8f\xd5\x5c\xf0\x2c\x82\xab\x39\x8f\xcf\x61\x02\x25\xbe\xb8\x41\x11\x05\x6a\xe1\x91\x5d\x34\x07\x6a\x42\x88\x00\xa8\xb8\x84\x59\x90\xc3\x84\x20\xc7\x9a\xc3\xa1\x52\x1e\x76\x41\xd5\xde\xd5\x96\x10\xf3\x90\x5e\xd1\xc9\xbd\xac\xfa\x9d\xd4\x92\x3d\x7d\x0a\xa9\x25\xb6\x9e\x96\xd8\x62\x4e\x7c\xb9\xb8\x76\x20\x64\x56\xc3\x0d\x69\x7b\x69\x75\xe9\xd8\x84\x8a\x02\xb5\x5b\xcf\x5a\xf9\x54\x5c\xa2\x0a\x66\x71\x39\x50\x75\xcc\x7b\xd2\x7c\x6c\xcf\x9c\x51\xb2\x8c\xd9\x9b\xca\x99\xd3\x0b\x60\xf1\x16\x8a\x4b\x35\xf3\x0c\x0b\x9d\x53\xd3\xa3\x55\x1c\xed\xd6\x2c\x65\x1d\xdc\x5d\x0e\x18\x17\xe0\xb5\xd9\x27\xa4\xca\x34\xc8\xf1\x0a\xd3\xc0\x3a\xcf\xe4\x6f\x19\x0f\x2a\x75\x2a\x61\xa0\x7c\x47\xaa\xca\x6b\x3d\x68\x3d\x34\xe4\x9a\x34\x1d\xdb\xb7\x53\xe5\xd3\x2a\x14\xcf\x4c\x70\x8b\x62\x4d\x94\xff\x96\xb7\xd6\x8c\x01\x44\xd7\xeb\xe3\x02\xb3\x77\x23\x0c\x36\xfb\x88\x72\x84\xf8\xd6\x94\x2d\xb0\xe2\x36\xd5\x20\xa5\x30\x06\x91\xc5\xda\x70\x89\xb3\x8e\x1b\x8a\x3a\x7e\x91\xe5\xc6\xcb\xf9\x32\x0e\x8a\xe8\x9c\xfc\x12\xe4\xc7\x39\x8b\xd0\xe3\x6a\xd3\x2a\xeb\x6a\x74\x5a\xdb\xd4\xb4\x6a\xc0\x46\x91\xee\xb6\x2b\xab\xc4\xe9\xd4\x79\x49\xaa\x2a\xc1\xec\x94\xd5\x63\xfb\x03\x21\x24\x00\x1a\xad\x75\x9b\xd3\x1f\x01\xd0\xad\xf9\x98\x51\xd6\x6d\x38\x97\x59\x53\x9a\x31\x2b\xeb\xeb\x96\xac\xaa\x77\x67\x1b\x45\x90\xbd\x2e\x55\xc6\x64\x84\x78\x96\x68\xc6\xb4\x11\x03\x70\xd0\x28\x05\xfd\x1a\xcd\xa3\x02\x21\x32\xbd\x80\x39\x4b\xe5\x57\x07\x89\x6a\xdf\xf1\xca\x79\xf4\x2f\x6c\xa1\xa9\x3e\xe2\xd5\x8a\x68\x4e\xf2\x22\x98\x2f\x9c\x75\xcb\x12\x98\xe4\xe0\x45\x92\x5a\x71\xa5\x95\x73\xf5\x54\x19\x9e\x31\x48\xc3\x68\x32\x89\xc6\xcb\x98\xc5\x4f\xb0\x97\x2c\xb3\x90\x09\xed\x70\x28\x47\x9c\x16\x41\xfc\xac\x49\x63\x46\xc9\x26\x82\x41\x54\xac\x04\x9d\x43\x3c\xd8\xe5\x10\x65\x36\x2a\x08\x6e\x13\x01\xc7\xcd\x22\x56\xc1\xe3\x04\xa7\x89\x13\x4c\x75\xe3\x2d\xdc\x46\xae\x9c\x19\x36\xe3\xd5\xa4\x4a\x9c\x4e\x1b\x8a\x0f\x55\x28\x7a\x85\x47\x9c\x4e\x91\x3d\xb7\x2d\x41\xcc\xbe\x51\x29\xa2\xf6\xaa\xc9\x10\x4c\x98\xd3\x99\x8a\xd3\xa9\xb6\x08\xdb\x18\x36\x4a\x20\x4c\x62\xb6\x81\xb0\x23\x2d\x62\xad\xb2\x78\x5f\x0d\xd6\x6d\xb4\x35\x47\xaf\x71\x3a\xf5\xf5\x26\x3f\x3b\x7a\x29\x6b\xbb\x36\x5a\xba\xaf\xc3\x4a\xa6\xa1\x8b\x59\x94\xd3\xd5\x69\x91\xe6\xc5\x5a\x6c\x43\x6f\xd2\xbc\xa1\x96\xa9\x19\x85\x1a\xd0\x60\x9d\x55\x48\xeb\x59\xcf\xd4\x29\xd3\xaa\x59\xc2\x8f\x7e\x19\x2c\x82\x2b\x76\x77\x7d\x57\xb3\x8a\xab\x9f\xcc\xf9\x65\xdf\x8a\x22\x46\x37\xcd\xf2\x23\x5a\xe9\x22\xcd\x3e\x1c\xa5\x6f\xb2\xf4\x1c\xb7\x34\x98\x85\xd0\x46\x16\x59\x94\x66\x91\x22\xa5\xad\x16\x64\x09\x9b\x74\x86\x43\x98\xd8\x37\x44\x4d\xd1\xc9\xe5\x36\x1f\x09\x0f\xe1\x8b\x8a\x5c\xa5\x00\xec\x6a\x4f\x4f\xe0\x44\x79\x3c\x85\x91\xed\x9f\x69\x09\x4b\x0a\x1a\x3f\x80\xe6\x67\xd1\x71\x9c\x5e\xb0\x10\x00\x96\xc5\xd0\xd7\x75\xe3\x1b\xe5\xc3\xa1\x24\x76\x48\x93\xf8\x8a\x27\xe0\x2e\xf0\x5b\xf0\xf2\xd6\x39\xbf\x5d\x8e\x45\x72\x90\x57\xcf\x61\x64\x86\x62\x40\x2f\x9d\xbb\xad\x27\x74\x28\xb7\x5e\x2a\x4a\xff\x0d\xc6\xd7\xe2\xfa\x58\xc3\xc5\xa3\x31\x07\xdf\x52\xe9\x6c\xcd\xaf\x6c\x92\xc9\xe5\x22\xca\xae\x10\x89\xa9\x7c\x45\x99\x26\xe7\xb6\x49\xb4\x5a\x8e\xd8\x23\x2d\x1e\x37\xea\xf8\x38\x9c\xb1\x2e\xa6\xb2\x1a\x25\x10\x75\x4c\x15\x3b\x6f\x83\x0b\x49\xdb\xe2\x8d\xbf\xbc\xd2\xe1\x5e\x3e\x8e\x22\x4b\x86\x59\xb5\x55\x5d\xed\x6f\x79\x9a\xe8\x35\xb0\xeb\xdf\xe5\x38\xab\x3e\x59\x02\xc4\x45\x90\xe5\xc4\x2b\x34\x87\xc3\x5b\xb2\x3c\x93\x50\xaa\x4c\xaa\x17\x49\x98\xd0\x71\x97\x5e\x45\x8a\x18\xe7\xa9\x1c\xef\xeb\x64\xf9\x72\x7d\x33\x0d\xea\x58\x96\x7e\xd4\x7f\x3b\xe2\x5e\x41\x09\xb9\x60\x0e\x42\x5d\x25\xfd\xbe\x89\x5e\x5a\x74\x10\xe5\xbf\x05\x71\x14\x76\x59\xf2\x72\xf1\xe6\x59\x94\x91\x71\xd1\xf5\x9e\x41\x8b\x84\x05\xac\x86\xe8\xc3\xce\x5f\x5e\x1d\x7b\xab\x6a\xfa\x58\x8e\xae\x04\xce\xd7\x11\x9e\xb7\x03\x69\xba\x4d\xa3\x02\x7a\x47\xdb\x86\x99\xd0\xf4\xdb\x15\xd9\x4d\x65\xed\x8e\x7d\x8e\x8e\x49\xc0\x77\x57\xc9\x38\x4a\x1c\x4a\xb3\x23\xdb\x29\x63\x02\x4f\x26\x54\x57\x94\x71\x64\x14\x22\x5c\x39\xbb\xd8\x43\xc1\x08\x72\x6e\x08\x40\xad\x41\x78\x51\x47\xf4\x78\x11\xb5\xbd\x41\x8b\x66\x49\x47\x83\xb3\x68\x3a\x23\x79\x93\x06\xcd\x92\x18\xd3\x69\x63\x79\x97\x04\x51\xdc\x70\xdc\x55\x59\xff\xc0\x9b\xb4\x69\x15\xf5\x0f\xbd\x49\x93\x56\x51\x7c\x73\x2e\x4a\x7f\x48\xd2\x8b\xe4\x5d\x11\x14\x8e\x9b\x05\x76\x31\x77\xcf\x6a\x5b\xa8\xe3\x37\x2b\xb5\x58\xc6\x31\x09\xeb\xda\x52\x4b\x35\x37\xdc\x79\x83\xea\xd7\xe5\xb3\xae\xf3\x31\x1b\xd5\x96\xe8\x23\x0d\x7a\x5a\x6a\xda\x84\xcb\x6f\x69\xe4\xff\x8c\x35\xa5\x39\xb4\x8c\x90\x77\x58\x25\xcc\x1c\x32\x72\x7f\xc2\x9a\x30\x97\xad\x11\xfe\x1a\xab\xaa\x69\x88\x23\xe4\x9d\x51\xc9\xe5\xf8\x39\x72\x7e\x41\x1b\xc0\x07\xed\xfe\x56\xd7\x88\x79\xfa\x81\xb4\x65\x16\xc1\x07\xa6\x59\x69\x47\xe8\x5b\xb4\xa2\x6a\x98\x19\x21\xef\xd0\x4a\x06\xf2\x91\x97\x68\x35\x73\x4d\x1b\x39\xde\xe3\xeb\xa3\x7e\x7f\x82\xfb\x63\x6c\x8c\x60\xfb\xfb\x3e\x1e\xde\x1c\x36\xb8\x8b\xf3\xc6\x08\x1e\x3e\xac\x2e\x54\x6c\xdf\xc5\x63\xbd\xbb\x38\x71\x77\x71\x02\xbf\x38\x21\xf8\x62\xcd\x69\xa8\xfd\x57\x1f\x56\x48\x2b\xdd\xf6\xb6\x04\x0b\xce\x60\x44\x4c\xe5\xef\x3e\x4f\xc6\x6a\x37\xde\xeb\x33\x4a\x07\x71\x3c\xcc\x49\x12\x1e\xe9\x1e\x2f\x12\x43\xe5\x9c\x35\xc9\x33\x2d\xef\xe1\xea\x16\x95\x28\x2f\x9a\x25\x98\xa6\x25\xe9\x56\x38\xca\xcd\xdd\x03\xff\xce\xd7\x7e\x91\x95\x58\x49\x28\xbd\xde\xb4\xc0\x55\x7f\x62\xa9\x54\x3b\xe4\xaf\x3e\x59\x8f\xe3\x34\xc9\x8b\x80\x19\x8b\x58\x77\xf2\x19\x2d\xbc\x08\xae\x82\xb3\x98\xc8\xb2\xe2\x11\x2d\xfa\x89\xb2\x6b\xeb\x9a\xae\x49\x05\x5a\x2a\x25\xe6\x54\x58\x05\x45\x51\xcd\x07\xd9\x14\xc9\x5d\xea\x48\x9d\xc4\xb2\x1e\x35\x48\x98\x24\x77\xeb\xb4\xf0\x22\x5d\x74\x7b\x3f\xb2\x40\xa4\x6c\x09\xe4\x01\x37\xb2\x69\xce\xd3\x63\xdf\x73\x6e\x54\xdb\x0e\x4f\xd5\xd1\x9b\x0d\x51\x1d\xcc\xcf\x16\x95\xcb\x2f\xf7\xef\x43\x69\x1d\x17\x21\x8f\xda\xa3\xc2\x8c\x82\xe9\xd9\x50\x74\x2b\xb4\xad\x01\x6f\xee\xe4\x45\x22\xee\x2f\x95\x42\x4a\x84\x19\x71\x9c\xcf\x8c\xc9\x32\xc7\x14\x5d\xe5\xc7\x69\x96\x35\x49\x65\xc4\xac\x21\x41\x41\xf6\xb2\x29\x9e\x61\xbd\xcc\x8d\x2e\x1a\xd7\x0b\x31\xfb\x4a\x0e\x1f\x99\x75\xe5\x86\xc2\x14\x15\xe2\xde\xbf\x43\x02\x7a\x08\x43\x85\xa5\x9e\x22\x2a\x73\x1b\x2f\xce\xa6\xc1\x72\xd7\x0c\x5c\x27\x0a\x22\xd4\x04\xb3\xc7\x6b\x71\x94\x99\xae\xc3\xf2\xc1\x94\xf8\x64\xaa\x51\xc0\x82\xf9\x64\xc1\x15\x12\x62\x46\x50\xcd\xbd\x6e\xd7\xa4\xbc\x5e\x99\xd8\xb5\x07\xf7\xef\xdb\x35\xe9\xcf\x38\x84\x17\x75\x78\xda\xc1\xfa\x4a\xd5\xb1\xbf\x56\xd1\xae\x55\x1b\xea\x8b\xd9\x15\x25\x46\x25\xab\xdc\xdb\xdd\x75\xb1\x9a\x23\x0e\x47\x96\x5e\x88\x35\x7e\x70\xc0\xad\x6d\x1c\xbe\xc3\x89\x9c\x7b\xda\xbe\x6d\x70\x6c\xcf\x12\x46\xa2\x3d\x69\xe8\x66\x1e\xdf\x28\xc1\xa2\x2c\x50\xa4\x6f\x78\x45\x1f\xfd\x5b\x2b\x3c\x92\x67\xd4\x91\x74\x2f\x88\x4b\xc8\x10\x97\xfa\x66\xac\x51\xc2\xd8\x8c\x2f\xaa\xe0\x6b\x56\x10\xba\x56\x82\x74\x75\x39\x5a\x41\x80\x56\xaa\xb3\xda\x46\xb9\x26\x0d\xf8\x48\x1d\x0e\xfe\x45\x5a\x93\x27\x5a\x16\x14\x59\x5b\x1d\xa9\x9f\xb1\x0c\xbe\x22\x54\x82\x82\x9c\x3e\x60\xa0\xac\xe8\x4f\x8d\x27\xb9\x2f\xe7\x77\xed\x79\xee\xfd\x2d\x37\xa3\xc4\xdb\x65\xbb\x17\x89\x59\xb7\xfa\xf0\xbd\xcf\xf2\xee\xe9\x7f\x99\x2c\x82\xf1\x87\x43\x7e\x84\xaa\x5d\x25\x61\xaf\x70\x33\xbc\xe3\x5b\x05\x68\x9d\xf5\x32\x95\xfd\xf1\x3f\x4a\xe6\xd9\x85\x1d\x78\x22\x5f\xca\xa4\xb3\x20\x4d\x29\x3f\xda\x9c\x59\x26\x88\x75\xe5\x9c\x55\xf5\xe8\xbe\x68\xc7\x41\x6e\xc2\x0c\xab\x66\x94\x84\x27\x32\x87\xfb\xd6\x29\x8c\xda\xa6\x30\xa5\x1a\xa7\x08\x4a\xc7\xaf\xb5\x94\x5b\xd0\x5a\x0a\xa4\xda\x09\x2a\x0b\x07\x83\x81\x14\x87\xfb\x66\xb3\x2e\xad\xc2\x0a\x95\x7d\xc0\x75\x9d\x38\xc8\xab\x65\x99\xaa\x19\x41\xd9\x54\x9f\xc7\x18\x36\x7b\xd0\xda\xe5\x51\x70\x4a\x03\x47\xa0\x45\x64\x09\x92\x50\x0f\x53\x2c\x8b\x2d\x82\x9c\x31\xe7\x8c\x18\x8d\xb1\x35\x8e\xd5\x13\x13\xe1\x67\x3e\x41\x42\x67\x57\x05\x59\x61\x01\x60\x90\x7b\x39\x4e\xa4\x8f\xa6\x22\x97\xad\x59\x2a\xcf\x52\xc2\x64\x4d\x54\x89\x48\x7b\x4d\x75\x25\xb9\x39\xa8\xb9\xcd\x82\xd0\xba\x92\x87\x55\xa4\x68\xd4\xf6\x35\xa8\x50\x67\x39\x29\xf5\x9d\x81\x5a\x57\xd5\xc3\x9d\xf5\xab\x03\x6f\x9e\x2c\x52\xae\x9d\x65\x05\xec\xac\xe5\x9e\x37\xba\x58\x75\x14\x58\x65\x4f\x8e\xf2\x82\xe3\x54\xf4\xd7\xd7\x00\xf7\x84\xd5\x61\xf5\x55\x39\xd6\xc5\xd9\x1c\x4d\xd9\xea\x8e\xf1\xd5\x08\x2c\x24\x98\xa8\x47\x38\x2a\x01\x45\xe5\x15\x7b\x2d\xc4\x66\x9f\x3b\xcc\xa2\xd1\x08\x99\x69\x88\x0d\x92\x38\x42\xb3\x32\xa0\xb3\x2b\x97\x8b\xa8\x52\x99\x45\x10\x6a\x80\x31\x86\x35\x18\xb3\xa8\x7f\x5d\x77\x64\x69\xce\xb9\xbb\x80\x85\x88\x46\x23\x5f\x9b\xa3\x96\xa0\x79\x55\xea\xb6\x6b\x7f\x13\xbb\x52\xbd\x26\xa0\x37\xb2\xc2\xfa\x6e\x40\xf1\x1f\x22\x73\x1a\xc8\x0c\xb5\x0a\x65\x0c\x51\x45\xdc\x43\xfa\x19\xb6\x98\xe9\x41\xb3\x36\x79\x76\x48\x8a\x4f\xc2\x7e\x90\xf0\x38\x79\x49\x28\x72\x15\x15\x29\x24\x69\xf2\x40\xda\xac\xaa\x40\x9c\x4d\x2e\x87\xfb\x25\x99\x2a\x83\xb8\xb0\x30\x66\xbc\xeb\xf0\x0a\xb2\xfa\xaa\x6f\x62\x3d\xd9\x9a\x49\x5e\x44\xf3\xa0\x20\xbf\x04\xec\xdc\xa5\x3d\x4f\x28\x0d\xb4\xe7\x07\xb5\xf7\xb5\xf2\xc2\x97\x25\xde\xb6\x44\xa2\xa0\x61\x15\x02\x41\xaa\xaf\x42\x1c\x6f\x25\x5c\x04\xf8\x2e\x2d\x04\xb6\xa9\x13\x87\x37\xa6\x12\x8a\x52\xc3\x94\x14\xcf\x82\x22\xf0\x2b\x6b\x66\x0f\xed\xe9\x46\x74\xf3\xc9\x69\xa6\xe5\xdc\x4b\x47\x36\x21\xbb\xe8\xd8\xd6\xb5\x5d\x15\x69\xba\x81\x6e\xf4\x6a\x67\x41\xc9\xe9\xed\x9f\x09\xb5\x55\x39\xcf\x18\xe7\x37\x9b\x15\x3d\xdd\x79\xfd\xfe\x95\x1b\x40\xa4\xf2\xa9\x24\x46\x57\xf6\xb4\xeb\xc2\x1f\x3b\x73\x6a\x84\x3c\x99\xfe\xbc\x86\x86\x65\x7b\xb7\x47\x9b\xec\xb0\x3d\xce\x8e\x64\xa6\xf6\xdb\x21\xac\x32\x7e\x53\x94\x65\x8b\xb1\xcc\x40\x90\x73\x6b\x5f\xfb\x45\x41\xd4\xc7\x50\x58\xeb\x1a\xed\xc1\x94\x68\xf6\xcf\xbb\x54\x98\x15\x84\xd7\xef\xae\xbd\x6b\xa9\x52\xed\x3b\xc5\x8c\x27\xf7\x88\x71\x84\xf7\x84\x67\xc6\x7b\x4f\xc7\xd5\x81\x91\x78\x32\xf4\x8a\x0e\x92\x93\x44\x22\x62\x54\x25\xf6\xb0\x0b\x95\x89\x4b\xc4\xf0\xcd\x94\x25\xf4\xc7\x07\x2a\x13\xa0\x19\x4b\xdb\xad\x48\x96\x5c\x92\xf1\xb2\xc0\xf9\x0f\x57\x58\x78\x85\x15\x94\x15\xd1\x53\x2d\xf5\x15\x9a\x86\x7f\x4f\x9f\x0c\xcc\xff\x9c\x29\xa7\x05\xba\xb1\x90\x61\x0a\xaa\x8f\xb5\x3a\x85\x31\xaf\x83\x60\xb1\x88\xaf\x44\x7c\xf4\x46\x1c\x51\xab\x7b\x88\xfc\x67\x28\x55\x96\x11\xc9\x6f\xd7\x6f\x7b\x42\xe0\x99\x58\x14\x89\x9f\x96\x16\xab\x5a\x9a\x90\x59\x5c\xf6\x91\x0a\xa5\xc5\x4c\x7e\x5c\x41\x70\x99\xed\xeb\x51\xc7\xc5\x4b\x94\x96\x2a\xa2\x13\x92\x88\x3d\xea\xd2\x41\xad\x23\x0b\x54\xa2\x52\x8d\x2f\x5f\x5f\x4f\x98\xc2\xaa\x59\xac\xad\x61\xef\x69\x51\x2a\xac\x6d\x47\xdf\x0b\xf0\xd1\x56\xaf\xea\xeb\x57\x3a\xa1\x8c\x59\x4e\x1f\xdd\xf5\x98\x31\x4c\xd3\x58\x58\x3d\xe5\x95\x75\x8a\xc6\x75\x7b\x31\x5d\x27\x4a\xc9\x53\x3c\x27\x29\x56\x92\xa5\x64\x67\x10\xfb\x8f\x69\xd0\xca\x27\x7c\x5d\x91\xcb\x7e\x4f\x6d\x8d\x71\x65\x94\x8d\x97\x71\x90\xdd\xbb\x77\xef\x9e\x8b\x97\x2c\x2f\x60\x93\x6e\x7f\x34\xdd\x10\x75\x17\xa5\x8d\x11\x7c\xa7\xfa\x21\xe6\xb3\xe0\xe1\xc6\x08\xb6\x7f\xf0\x38\x27\xf2\x13\x4b\xfa\xfc\xd8\x1f\x03\x7a\xe7\xce\x65\xf1\xce\x65\xf1\xce\x65\xb1\x4c\x21\x4f\x6b\x89\x38\xf6\x8d\x13\xc9\x7f\x8d\x99\xe1\x1b\x3a\x48\xd6\x78\x2f\xfe\xe5\xbf\x86\x43\xb8\x48\xb3\x0f\x41\x46\xf9\x83\x2e\xf1\xcb\x5c\x47\x12\x25\xe1\x30\x9a\x4c\x48\x46\x92\x02\x48\x72\x9e\x8b\x6a\x67\x59\x7a\x91\xab\x0e\xd7\x4a\x66\x9e\x8b\x28\x09\xd3\x0b\x66\x0f\x55\x12\xa1\xc3\xfd\xfb\xe2\xcb\xe0\xef\xaf\x7e\x7d\x59\x14\x0b\x91\x24\xce\x12\xf6\xfa\x67\xd8\xc5\xab\x31\x09\x2d\x52\x6f\x47\xd3\x24\xa5\x52\x27\x8e\x12\xc2\xc0\x4b\xd2\x50\x51\x49\xf1\xf4\x0a\x56\x37\x25\x96\x2e\xe7\x31\x45\x82\x58\xe5\x3b\xbd\xc6\x3d\x2b\x8b\x8f\x3e\x13\x7f\x7f\xf9\x76\x47\xeb\x61\x96\xed\x74\x7a\xee\x86\xdc\xba\x1a\x85\xe3\x8d\x9c\x1d\xdb\xeb\x93\xe9\xbe\x74\xcf\xc9\x12\x4c\xf1\xdc\xa4\x74\x2c\x0e\x25\x8b\xc2\xa6\xb5\xa8\x6a\x52\xb3\x34\x2f\xfa\x50\x44\x73\x92\x2e\x8b\x3e\xed\x22\xeb\xb3\x23\xc6\x8b\x34\xb3\xc3\xd3\xb2\xb5\x94\x56\x81\x5d\x60\xff\x5d\x5f\x43\x47\xb0\x69\x9c\x8e\x83\x98\xbe\x1c\x7d\xff\xed\xa3\x6f\x3b\xd8\x61\x90\xe8\x86\xea\x0d\xe2\xaf\xeb\x6b\xd8\xc2\x4a\x52\x38\x60\x97\x81\x83\x7d\x96\x00\xc2\x6e\x09\xeb\xad\x14\xe1\x45\x46\x16\x74\x0d\x49\xc8\x85\x41\x32\xb5\x7a\xb0\xa8\x8a\x14\x2f\xb5\xe0\xa7\x69\x1a\x93\x20\xb9\x61\x6e\x57\x94\x89\xa4\xa6\x59\x4d\xad\x99\x61\x48\x31\x9f\xe8\xf0\xd4\x44\x31\x57\xe7\x59\x51\xa6\x75\x20\x75\x9f\x1d\xda\x33\xaa\x47\x0b\x28\x51\x73\x2d\x5e\x0b\xd8\x9e\x46\xf6\xc1\x90\xf9\xf2\xed\x8e\xa5\x13\x2a\xc5\x54\x92\x50\x28\x04\xbf\xa0\x59\xdf\x9b\x86\x2a\xcc\xa3\xcb\xdc\x7f\x71\x20\xd2\x05\x49\xba\x9d\x37\x87\xef\x8e\x3a\xfd\x8a\xc6\xfb\x7c\x56\xd0\x10\x78\x25\x8d\xde\xbf\xaf\x53\xa4\xf3\xb4\x96\xae\x3c\xb0\x0b\x9d\xa7\x41\x1e\x8d\xa1\x03\x9b\x0c\xe2\xa7\x4b\x2a\x77\x95\xf6\x36\xa1\x33\x2a\xbd\x81\xca\x46\x07\x45\x2a\x62\xd2\x74\xce\x82\x9c\x3c\x7e\x84\x26\x60\x91\xc3\xa9\x72\x73\xbe\x24\x41\x48\xb2\x6e\x67\x8f\x2d\x7b\xd1\xbf\x02\x6e\xb5\x60\xc0\xd4\x84\xd1\x74\x37\x46\x77\x64\x24\x29\x1e\x1c\x5d\x2d\x58\x68\x4a\xba\x69\x8d\xc6\xac\xe9\xe1\x1f\x39\x72\x2a\x54\xba\x87\x48\x7a\x2a\xbb\x6c\xcd\xa8\xf3\xe0\x03\x61\x99\x6b\x30\x7b\x19\xca\x9f\x54\x58\xa2\x5c\x29\xbd\x40\x16\x98\xb3\x9d\x64\xbe\x2a\xb0\x5d\xbe\x8c\x5b\x32\x1d\x13\xd3\x9a\x2f\xbd\x38\xa7\xf0\x30\x9b\xe4\x03\x9d\x61\xbb\xdc\x85\xd2\xe2\x44\xc7\x61\x75\x35\x75\x49\xd8\x65\x77\xec\x73\x46\x3c\xd1\xe4\xaa\x84\xc1\xbe\x5e\x2d\x4f\xa9\x9d\xf9\x3a\x31\x4f\xca\xfd\x34\x49\x08\x3f\x63\x2b\xf9\xa6\x91\xdb\x40\xe9\x77\x24\x81\x95\x59\xd5\x8f\xc8\xa5\x2d\x72\x9c\x03\x15\x8d\x28\x81\x04\xc4\x0d\x67\xe7\xf0\x1a\x0f\xed\xad\x00\xa8\x8b\x41\xb8\xa6\xeb\xa5\xf5\x16\x79\x46\xf0\x41\x6b\x8a\xdf\xb3\xd7\x93\x26\x64\x2f\xca\xbc\x28\x1d\x9d\x4a\xb3\x6e\x91\x45\xd3\x29\xc9\x48\x08\x69\x02\x94\xb0\x99\xe2\x5e\x66\x18\xa3\x28\x6f\xcf\x1d\x0c\x4a\x8c\x45\x94\xb3\xb8\xf6\xcc\xc2\x9c\x9b\x11\x0b\xb0\x90\xf5\x09\xcb\xd5\x99\x17\x41\x41\xc6\xb3\x20\x99\xd6\x58\x27\xa1\xbc\xfb\x2c\xa9\x20\x08\xaf\xd8\x1d\x63\xe6\xe1\xf6\x88\xae\x00\xe6\x62\x46\xb5\xe2\x6d\xa7\xb7\x47\x13\xf2\x77\xd5\x93\x9e\x22\xc2\x7b\x05\x2f\xe8\xf1\x61\x81\x56\x5c\x23\x7f\x0d\x5c\x58\x40\x75\x63\x59\x9d\x8f\xca\x1e\x5d\x63\x33\x3d\x5f\xdc\x80\xd7\x66\x2a\xaf\x28\xa2\x52\x42\x6a\x08\x41\xef\x3d\x1f\x54\xe2\xef\x88\x37\xd1\x55\xb5\x18\x5b\xc8\x7e\x61\x09\x6e\x82\xef\x13\xe2\xab\xbb\xc3\x57\x5e\x8c\xf1\x15\x95\x1b\x3c\x0e\x18\x13\x64\x54\x16\x34\x96\x63\x51\x2e\x00\x23\x8e\xc5\xb9\xd4\xab\xe5\x31\x99\xa9\x5f\xcf\x82\x73\x92\x74\x0a\x98\x04\x51\x4c\xc2\x01\x1c\x16\x33\x92\x5d\x44\x39\xe1\x77\x12\xda\x09\x2c\x05\x1c\x3f\xa1\x38\xe6\xb4\x34\xf1\x62\x19\xf0\xe9\x2f\x0a\x47\xf0\x43\xf9\x43\x4e\x84\xe8\x8f\x2a\x58\xd9\x62\x3c\x82\xce\xce\x60\x0b\x3b\x7f\x02\xe5\x4c\xab\x93\x90\xe2\x7d\x1c\xe5\x05\x49\xa2\x64\xea\x2a\x5d\x1e\x46\x9d\x22\x4c\xe4\x73\x1b\xcc\x96\x96\x41\xd6\x2f\x28\xe4\x65\x26\x8a\xfc\xa6\xe4\x65\xd9\x5e\xd5\xe9\x31\xec\xae\x86\xd1\x94\x6e\xc0\x37\x46\xf0\x3d\xfb\x5b\xdb\xee\x6f\x8c\x60\xfb\xbb\xca\x84\xfa\xf0\xce\x84\x7a\x67\x42\xbd\x33\xa1\xa2\xb7\xbe\x59\x50\xa6\xcf\x79\xe3\xbb\xbc\x3f\xa6\xda\xd2\xce\xa2\x29\xbf\x58\x38\xf8\x23\x37\x2e\x4d\x73\x2f\x82\xf0\x57\x32\xd1\x17\x70\xbe\x62\xf6\xb9\x83\xbb\x43\x8d\x14\x8a\x10\x2f\x6a\x48\xa4\x8b\x19\x1d\x7e\x57\xbf\xd4\xf0\x13\x6f\x0e\xbe\x81\x1d\x4f\xe8\x1b\x76\xa9\x87\xee\xe2\x9b\x05\x53\x5a\x65\xa7\xf0\x46\x58\xac\x82\x04\x0e\x9e\xee\xbd\x16\x14\x17\xc2\x0f\xdf\xc1\x38\x9d\x2f\x96\x3c\xc3\x2a\x9c\x5d\xc1\x3c\x3d\x8f\x92\x29\x77\xb8\x89\xb2\xbc\x80\x47\x30\x9e\x05\x19\x5b\x94\xb9\x5b\x59\xc8\x2f\x1b\xc8\x8b\xc5\xb2\x74\x4c\xf8\x4d\xf7\x22\xd5\x7a\xe6\xf3\x90\x43\x77\x0f\x76\x61\x7b\xab\x0f\x4f\xe9\xff\xdb\x7d\x18\x0c\x06\x7d\xf8\x5f\xd8\x85\x87\xdf\xf6\xfa\x54\xe4\xe4\x0b\x32\x8e\x26\x11\xe7\xf0\x83\x77\x87\xdb\x0f\x1f\x6f\x3f\xae\xbf\xba\x11\xe5\x29\x2b\x29\x86\x88\xee\x50\xa4\x4f\x11\x8b\x50\x46\x81\xa5\x48\xc0\xb4\x84\x5c\xf7\xa1\x13\x5b\x85\xd0\x2a\x6f\xc6\x05\xd5\x61\xd0\x08\x8b\xf6\x89\xd2\x13\x45\x48\x67\xaf\x33\xa0\xe8\xdd\x4f\x43\xb2\x57\x74\xb7\xb0\x23\x54\x8a\xa3\xce\xff\xda\xe5\x0c\x3b\x13\x0f\xbe\xc6\xf8\xaf\x48\x8f\x17\x0b\x92\xed\x07\x39\x72\xc2\xaa\x94\xcb\x97\x67\x79\x91\x75\x1f\xf5\x64\x34\x35\xf1\x62\xab\x0f\x8f\x9c\x4e\x31\xbc\xe0\x22\x8e\x8a\x6e\xa7\xd3\x33\x2e\xc7\xe3\xbe\x13\x32\x32\x03\xdd\x89\x78\x87\x0b\x32\x55\x3a\x2d\xfc\xf3\x2e\xec\xd1\xed\x12\x7b\xf8\x69\x17\xfe\xd7\x97\xb8\x1b\xa1\x2e\x41\x5c\x78\x95\x32\xe5\x5e\x48\xe0\x01\xec\xc1\x26\x6c\x9b\x96\x65\xa8\xcd\x4e\x2d\x1a\x31\xaf\x5f\x01\xb2\x9d\xe8\x0d\xfe\x48\xa3\x84\x62\xac\xcd\x75\xa6\xf1\x92\xe5\x35\x64\xb4\xf8\xea\xf0\x19\x65\xd7\xed\x2d\x29\xf5\xc5\xcd\x1e\xc6\xcf\x08\xfb\x7c\xb7\xf5\xf8\x51\x3d\xf7\xcc\xd3\xf0\x87\xef\xb6\xb7\x6a\xb9\xc6\xc1\x2c\x48\x32\x4d\x83\x35\x44\x07\xcd\x58\x22\x23\xf3\x20\x4a\xf8\xa1\x03\x2d\x84\xa8\xa0\x32\xc4\xb5\x43\xfa\x8a\xfa\xd5\xf5\x50\x5c\xf0\x9e\x89\xcb\x39\x55\x85\xf2\x66\xdf\x0f\xb8\x0e\x5b\x01\xc6\xb6\xc0\x07\x49\xc1\xc3\x8d\xf7\x61\x7b\xab\x07\xff\x7f\x3a\x35\x9b\x56\x73\x3c\xd0\xb8\xb8\x6e\xdc\xd0\x06\x54\x36\x5f\x36\x56\x75\xd1\x98\x76\xea\x43\x81\xf0\x3b\xc7\x4c\x1a\x88\x8b\xe3\xcc\x13\x91\xbd\x10\xb7\xef\xc5\x42\xe7\xa6\xa1\x1a\x3a\x31\x28\x41\x24\x8d\xad\x21\x03\x71\x63\xb5\x92\x52\xed\xc6\x2c\x8d\x5a\x8d\x07\x4c\xa8\xde\x4a\x96\x73\x19\xf1\xa2\x96\x63\x68\xad\x3d\xbb\xac\x85\x0d\xa4\xbd\x6a\x2f\x4a\x71\x71\x53\xae\x44\xfe\x43\x1a\x5a\x76\xa0\xf4\xda\x38\x1e\x67\x90\x3f\x95\xf1\x38\x95\x5b\xf6\xbc\x42\x1f\xb6\x1f\x63\x8b\xcd\x59\x90\x93\x87\x8f\x61\x97\x55\xae\xce\x11\x1e\xa2\x85\x17\x41\x18\x12\x7e\xaa\xc6\x14\xaa\x2e\xaf\xdd\x87\xed\x6f\x1d\x46\xfd\x72\x2c\x4f\xcf\x82\xa4\xcb\xeb\xeb\xcb\x55\x0b\xf9\x28\x82\x2a\x2b\xb2\xf0\x29\x45\x66\x91\x6a\x6a\x0e\x25\x28\x60\x19\xd6\xb2\x2b\x29\x24\xf9\xf1\x83\x7e\xe3\xf1\x0d\xcf\x69\x9f\xa4\x85\xd8\x56\xfc\x14\xfd\xbc\x31\x65\xea\x34\x0f\x1d\x3e\xa9\xe6\x2b\x9f\x05\x7c\xbf\xc1\x34\xa2\xcb\x71\xbc\xcc\x23\xa6\x9e\x33\x53\x55\x74\x16\xc5\x51\x51\x6a\xe7\x67\x41\xf2\x61\x78\x96\x05\xc9\x78\x06\x39\xc9\xce\xa3\xb1\x54\x99\x02\x9e\xf9\x6d\xe3\xa7\x61\xf4\xf3\xc0\x49\xcb\x65\x16\xfc\x5c\xea\x24\x13\x92\x51\xa5\x24\x88\xa7\x69\x16\x15\xb3\x39\x84\x24\x1f\x67\xd1\x19\x97\xfd\x42\x89\x27\xc9\xe0\x22\xfa\x10\x2d\x48\x18\x05\x4c\x93\xa7\x4f\xc3\x83\xa4\x20\x59\x12\xf0\x8b\xfd\xef\x9f\x06\xc9\x87\xf7\x22\xff\xdc\x7b\x4e\x20\xff\xbf\x5f\xc4\x90\x93\xe9\x7b\x3a\xd6\xf7\x2c\x5c\xc7\xfb\x30\x9a\x46\x0d\x82\x11\xc8\xa9\xf5\x72\xc6\x99\xd4\xc2\xe4\x74\xc9\xf0\xd8\x45\xea\x53\xcc\x56\xe2\x99\xa7\xa6\xd0\x39\x73\xad\x3d\x82\x44\xf6\xb9\x9e\xd2\xf9\xfb\xf3\x8e\xb5\xca\x98\x4b\x94\x58\xdb\xba\x86\xfa\xd7\x55\x9b\xda\x84\xce\x16\x53\xee\x59\xc7\xa8\x2f\x34\x45\xf0\x33\x8a\x5f\xd8\x85\x2e\xdf\x09\x74\x7f\xf8\x1e\x1e\x54\x7d\xf5\xe4\xcd\xf3\x07\x3b\x4e\xbd\xac\x0c\xbb\xab\xf7\xae\xb4\x2e\x60\xb8\xc5\xf1\x82\x10\xa2\x0a\xfe\x19\x8b\x4d\x23\xba\x8f\x8e\x92\xbc\x88\x8a\x65\x21\xb3\x3f\x46\x21\x49\x0a\xaa\x91\xd4\x67\xf4\xe6\xed\x1e\x24\x61\x64\x46\x7d\x71\x04\xa5\xc8\xfb\x72\xb3\x57\x46\xa5\x60\x11\x4e\x36\x14\x20\x36\x18\x14\x1b\x15\x18\x1b\x6b\x92\xc5\x3a\xb4\x8d\x12\x42\xa2\xe2\xaf\xf3\xfc\xe8\x25\x9d\x6c\x19\xe0\x41\x45\xa0\xf2\xb6\x84\xbf\xf9\xcc\x1d\xcd\x52\xdf\x52\x28\x03\xf0\xf0\x79\xe3\xab\x3c\x44\x39\x8f\xa6\xa3\x2e\x91\x0d\x36\x5f\x2c\x84\x73\xfd\xa6\xcb\x56\x25\x10\xc3\xac\x34\xc8\xf2\x20\x3c\x1c\x1a\xcf\xce\xeb\x40\x09\x22\xdd\x4c\xbf\x8c\xd4\xf0\xd4\x91\xc1\x0c\xa0\x86\xf1\x2a\x63\x53\xdf\xea\xfc\xb9\xc4\x34\xc3\x41\x8b\xb8\x46\x28\x5e\xab\x24\xf5\x28\xc2\xfa\x90\x36\x32\x58\x33\xb4\xa9\x86\x6a\x1b\x81\x2e\xfa\x1d\xfe\xdf\xdf\x9f\x9f\x6c\x3d\xf8\xe1\xf4\xe3\xce\x4d\xf7\xf9\xd1\x4b\xfa\xf7\xde\x83\xff\x3d\xfd\xb8\xfd\xf0\xe6\xba\x7c\x78\xb8\xd5\x7f\xb8\x7d\xd3\xfb\xef\xe1\xa0\x60\x07\x6c\xa5\x46\x87\x87\x03\x72\x49\x51\xa5\x1e\x8f\x0c\xb4\xbd\xc6\xb9\x10\x71\xa7\x98\x69\xb1\xe1\x8c\x3c\x43\x84\xd3\xa7\x9a\x92\x67\xb6\x68\x71\xcd\x49\x85\x26\x35\x94\xc6\xc3\x47\x70\x7d\xed\xfa\xf6\xed\x27\x40\xe4\x04\x22\x44\x7c\x3b\x50\x89\x4b\xfa\x4f\x85\x4c\x54\x52\xb7\x43\xe7\xce\xd6\xad\x6f\x7d\xf1\x9d\x15\xc5\x5d\xbe\x9c\x6b\x35\x8e\x73\xb1\xc5\x9f\xa7\xe1\x83\x1f\xbe\x7b\xb0\xbd\x55\x96\x63\xd6\x35\x36\x96\x71\x1a\x43\xf7\xe0\xdd\xe1\xf0\xe0\xf9\x3e\xd0\xbd\xfd\x68\x67\x6b\xeb\x61\xaf\x7e\x69\xc5\x7a\xb4\xad\x5d\x58\x31\x37\x4e\x65\xe9\xb6\x18\x15\xf6\xa5\x9d\x3e\xec\xdc\xfe\x1a\x9d\xba\x5c\xe2\xba\x06\x90\xe9\x00\xfe\xfe\xf6\xf9\x2f\xf5\x24\x59\xb5\x55\x83\xa8\xfa\x5e\x3d\xa4\xa8\xd4\x6d\x8c\xb9\x8a\x7e\xbb\x2c\xf9\x8b\x85\xcd\xef\xfa\xf0\xa8\x07\x23\xe8\x74\x6e\x8d\xd2\x71\x1c\xb1\xd0\x34\xe5\xb8\xd8\x69\x41\x94\x38\xf1\x43\x11\xfc\xcb\xde\x6f\x87\x2f\xfe\x71\xf8\xf6\x7f\xea\x69\x91\x35\x5f\x47\x89\x26\x0c\x0d\x49\x92\x57\x5b\x1f\x5a\xb7\xb7\xd7\x8b\x54\x21\x83\x9a\x9a\x19\x3c\xe6\x03\x1b\x59\xee\xb6\xdd\x08\x0b\x10\x6b\x02\x9e\x2d\x98\xa3\xcb\x9b\x99\x43\x33\x1d\xd8\xb8\x7c\x84\xd9\xd4\x9c\x26\x0a\x69\x45\xb0\x8d\x0e\xa0\x9a\xc9\xb8\xc9\x41\xb7\x53\x6c\x3f\xee\xf5\x61\xc7\xb2\x2b\xbb\x6c\x6d\x9e\xd9\x35\xb0\x25\x7b\x68\x29\xf1\x9a\x67\x0a\x38\xe0\xa5\xb5\x13\x6a\xf5\x18\x6b\x63\x04\xfa\x73\x75\x1e\xfd\xe8\xee\x3c\xfa\xee\x3c\xfa\xee\x3c\xba\xbc\xd2\x13\x2d\xc6\x6d\x6f\xf4\x7c\xa2\x1b\x37\x1b\xcb\x9c\xb0\xad\xef\xb8\xd8\xb0\x4e\xa1\xd7\x1c\x78\xdc\x6c\xfe\x60\x31\x46\xaf\x70\x2c\x82\x62\xd6\x87\x84\xe0\xb7\x61\xdf\x33\x73\xa9\x3b\x40\x97\xf4\xc6\x93\x37\xf5\xd1\x20\x9d\xc2\x1b\x9d\x79\xb0\xd3\xff\x6c\x3f\x36\x76\xf9\xb5\xf4\x23\x63\x2b\x40\x21\x5f\x74\x3f\xd2\x3a\xa3\xaa\x15\x24\xa1\x95\xd1\xc0\x20\x4d\xba\x1d\x86\x8b\x8e\x16\x21\xcc\x75\x69\x34\x4f\xa9\xec\xe5\xe1\x8b\x0e\xde\xec\x43\xe5\xd2\xc6\x83\x1a\x75\xfa\x60\x65\x44\xa4\xbf\xf7\x5c\xb2\x0b\x87\x3d\xfb\xc6\x40\x43\x40\x93\x50\x03\x13\x85\xb2\x59\x57\xfa\xcb\xe1\x10\x7e\x3d\x78\x77\xf4\xfc\x35\x93\x05\xfb\x87\xaf\x5f\x3f\xdf\x3f\x3a\x38\x7c\x0d\x6f\x9f\xbf\x7b\x73\xf8\xfa\xdd\xf3\x77\xf5\xc0\x85\x41\x11\x68\xd0\xd1\x17\x78\x14\xe2\x6f\xc4\x3d\xa5\x79\x70\x39\x4e\xe7\x8b\x98\x5c\x46\xc5\xd5\x08\x1e\x1b\x4c\x60\x8c\x88\x9d\x6f\x95\x5e\x9e\xb4\xf5\x6a\xf9\xee\xf5\x90\x44\xbc\x4a\x12\x2a\xbb\x51\x90\x96\x9d\xb0\xce\xcd\x75\x38\x64\xda\x18\x93\xae\x21\x5c\xcc\xa2\xf1\x0c\xe6\x41\x31\x9e\x89\xcd\x17\x5f\xbb\xa9\x3c\x0f\xf1\x06\xb0\x44\xe4\x0c\x32\xaf\x97\xab\x70\x08\xb1\x87\x85\xfa\xf6\x63\x7d\xbe\xc7\x19\xef\x84\x45\xc0\x89\xc2\x53\x4f\xca\xe1\xb2\x19\x8a\x1d\x51\xde\xe1\x42\x0b\x2e\xf7\x3d\xa8\x3d\x06\x2f\x7b\x10\x63\x75\xf6\xe1\x74\xd3\x65\x01\xad\x33\xa2\x45\xb0\x74\x4f\x82\x0b\x21\x14\x17\x3e\x18\x3d\xf5\xba\x94\x74\x7c\xee\xc1\xf2\x17\x92\x98\x14\xc4\xd7\x94\x7b\xec\xd6\xab\x95\xe3\xef\xfd\x4e\xd5\x0b\xc6\x48\x82\x74\x39\x1c\xcc\xe0\xcd\xaf\x00\x24\x3c\xd8\x37\xa4\x3c\x53\x67\x54\x0c\x34\xdc\xcb\x5d\x86\xce\x8d\x6a\x01\xc3\x88\xeb\x89\x22\xa5\x2c\x34\x8a\xc2\xac\xb7\xac\xad\x40\xa8\x44\x31\x17\x1f\xe4\xe8\x9f\x73\xe8\x6f\x3c\x57\xb0\xc8\xb9\x68\x09\xc0\x67\xcf\x1f\xec\xbf\x3c\x7e\xfd\x3f\xcf\xdf\xda\xed\x87\x64\x3c\x5b\x26\x1f\x48\x28\xc2\x18\x18\x83\x12\xbf\x41\x46\x16\x71\x30\x26\xdd\xe1\x3f\x6f\x4e\xfe\x99\xfc\x33\x3b\x7d\xf2\xcf\x8f\xc3\x69\x1f\x3a\x37\xd7\x0f\x1e\x5c\x7f\xec\xf4\x58\x8a\x49\x84\xd0\xd4\x9a\xff\x3c\x95\x75\x4f\x64\xed\x53\x5a\xfd\x44\xd6\x3f\x3d\xa9\x69\xc1\xaa\xaf\x55\xaf\xab\x5d\xf5\xaf\xf6\x5e\x76\x8e\xd5\x16\x4e\x3d\xb4\x5c\xc7\x5e\xc9\x34\xe4\x21\xf2\xac\x9c\x55\x64\xa5\x18\x32\x4f\x2a\x22\x62\xd4\xb2\x38\xb8\xac\x31\xbb\x68\xc5\xdf\xb4\xd4\x3e\x2d\xe4\x90\x6f\x22\x1c\xb6\x51\x18\x36\x41\x04\x05\xc3\x37\x9c\xa5\xc7\x9d\x73\xad\xf0\x5c\x87\xc0\xae\x42\xb0\x61\xa3\x0d\x19\xde\xcd\x78\x93\x26\xf8\xbb\x6e\xf0\x05\x26\x59\x8e\xc0\xf2\x46\x2c\xcb\xea\x92\x8c\x49\xcc\xf2\x68\xcb\xa8\x52\x78\xe5\x71\x4c\x82\x4c\xde\x3c\x30\x3a\x16\xaf\x5d\xa2\x0f\x2f\xcd\x42\x9b\x16\xb2\xc5\x1a\x85\x46\x6f\xca\xa9\xd8\xa8\x3f\xef\x3d\x2b\x89\x78\xac\xe2\x4d\x1f\xb6\xb7\xb6\xb6\xe0\x1b\xe1\x89\xe0\x9a\x4e\x24\x76\x36\xb8\xd6\x29\x16\x92\x87\x61\x5a\xe2\x9e\x4a\xdb\x9c\x08\x52\x66\x41\x2f\x97\x66\xc8\x1e\x58\x1d\xed\x36\x65\x38\x49\xb6\x4a\xb6\xe8\xe0\x15\x55\x72\x0e\x16\xcb\x7c\xe6\xba\xf7\xe6\x3c\x5d\x56\x5b\xf0\x44\x3e\xd7\x97\xa9\x5f\x84\xbe\x15\x84\x21\x8b\xcb\x5d\xde\x0e\x4b\xb5\x65\xab\xda\x4b\xf0\x53\x47\x55\xf4\x73\x3d\x4d\x6e\xa7\x85\x61\x2d\xe2\xa9\xa7\xc5\xd2\xc2\x94\x38\xe9\x52\x51\xbe\x3d\x08\x79\xad\x20\xcb\xa2\x73\xe2\x58\xfd\x82\xb0\xa4\xa7\x7d\x5b\xed\x68\xb0\xc8\x21\x0d\xac\x70\x41\x2d\xe2\x3e\x34\x57\x42\x43\x83\xeb\x6b\xf9\x74\xb2\x75\x6a\xab\x53\xcc\xad\x8e\x8f\xa0\xaa\x26\x5e\xe8\x55\xf9\x4b\x7c\x53\x82\x2a\x2f\xb0\x5b\x42\xda\x68\xf7\x47\xeb\x0c\x4a\x58\xca\xee\x74\xf2\xc0\x89\x43\xca\x10\x55\x6e\xc1\xc5\x8c\xc8\xf8\x98\x21\xdf\xdc\x32\x9b\x08\x3b\x0f\xa6\x1a\x62\x88\xcf\xa3\xe0\xc8\x76\x73\xd7\xe8\x1a\xd7\x24\xcd\xa0\x4b\x11\xfe\x81\x5c\x71\x4b\x0f\x86\x06\xe7\x35\x40\xbc\xf8\x60\x16\xe4\x87\x17\xc9\x9b\x2c\x5d\x90\xac\xb8\xea\x7e\x20\x57\xee\x4d\x84\x03\xf1\x1f\xc8\xd5\xa9\xfb\x62\x56\x27\x4d\xe0\xe0\xcd\x7e\xc7\x72\x38\x91\x3f\xa1\xc7\x7a\x1a\x6f\xe0\xd4\xda\x54\x0e\xec\xab\x19\x7f\x44\x3e\x5a\x50\xb6\xff\x51\x0e\x79\x41\x99\x95\x0d\x03\xe7\x55\xc7\xf5\xae\xda\x69\x6e\x7c\x0f\x0b\xb1\x7e\x58\xca\x25\xd5\x0d\x32\x22\x20\xef\x73\x6a\xd5\xc7\x31\x4d\xd5\xa0\x1f\x92\x0c\xee\xbd\x37\xb7\xda\x17\x59\x54\xb0\x10\xcb\x2e\xc1\xaf\x94\x35\xec\x22\xef\x7d\x86\x11\x99\xca\xe7\x9e\xab\x43\xb7\xc1\x1b\x45\x9f\xf7\x56\xba\x3d\x50\xac\x57\xf2\xce\x15\xfc\xa1\x52\xc8\xd0\x65\x6d\x45\x84\x97\x48\x6f\x81\x73\x40\xac\x21\x2d\xcc\x51\x72\x30\xa1\x12\x1e\x0d\xc3\x82\xef\x72\xe6\xda\x34\x51\xb3\x6c\x83\xeb\xb8\x2b\x29\x5a\xa8\x96\x64\x5e\x4f\x31\x24\x86\xd3\x8e\x60\x45\x15\xff\x47\xba\x64\xb7\x41\xab\x10\x36\x1b\x1d\xd8\x34\x97\xbb\x4d\xe8\x6c\x40\xae\x5e\x21\x1d\xa8\x37\x4a\xab\x85\x85\x85\x6b\x49\x0b\xc8\x97\x8b\x45\x9a\x15\xec\x1c\x81\xfb\x7c\xbd\xd9\x87\xd2\x5a\x8c\x45\x28\x2f\xff\x6c\xc8\x2d\xab\xdc\x52\xbf\x8d\x68\x69\x41\xe5\x2d\x29\xdc\x6f\x2c\x64\x44\xdd\xf4\xb6\x71\x99\xdf\xc8\xd4\x96\xda\x84\xd3\xb6\xcf\xcc\xaa\x49\x70\xe6\xf6\x75\xc7\xc9\xab\x0e\xd0\xbe\xbd\x3b\x40\xbb\x3b\x40\xbb\x3b\x40\x2b\x0f\xd0\xc4\xad\xf1\x4f\x15\x0e\x6f\x2f\xc8\xd2\x04\xfe\x67\x39\x0f\xce\xa3\x1c\x7e\x0a\xe8\xe3\x5f\x3f\xf0\xc7\xc1\x9c\x34\x3e\x65\x1b\x0e\xe1\x20\x89\x8a\x28\x88\xa3\x7f\x11\xf8\x1b\x07\x9a\xd2\x7a\x00\x39\x73\x9a\x97\x3e\xca\x0c\x43\x54\x10\x68\x39\x6e\x15\x8f\x7b\xba\x76\xcb\xfa\x56\xd2\xfe\x39\xc9\xf3\x60\x4a\x0e\xc2\x11\x6c\x35\xdb\xda\xe0\xbe\x20\xdc\x9f\x95\xe2\xd6\xcc\xf8\xd7\xd0\xd9\xd6\x9f\xe9\xaf\x8a\xc2\x22\x8a\xa7\x13\x39\x91\x0c\x88\xca\x63\x1a\xad\x2e\x12\x05\xf2\xdb\xfd\x7d\xc5\x80\x3c\x91\xed\xc9\x4f\x32\x2d\xa0\xde\x8c\x19\x7b\x5c\x19\x2e\x85\xc0\x3d\x54\x85\x02\xc5\x14\x38\xd2\x05\x72\x30\xfa\x02\x0e\x3c\x53\x1a\x2f\x83\x2c\x7d\xc6\xa1\x9f\x04\xcb\xf2\x91\x2e\x6f\xd1\xdd\x43\x4c\xa0\xc3\x21\x04\xe1\x79\x90\x8c\x89\x24\x0a\x38\x78\xa6\x17\x91\x43\x28\x89\x66\x73\xb3\x45\x74\xf1\xda\x70\x0d\x51\x38\xb2\xbb\x40\xca\xc9\x90\x0e\x02\x67\x76\x01\x19\xc5\x81\xff\x0f\xd7\xd7\x56\x3c\x87\xdb\x05\x0d\x2f\x9d\x44\x25\xa6\x4b\xc3\x8c\xf4\xec\xae\x25\x77\xe1\x98\x8c\x1c\x4e\x58\xb7\x02\x1c\xa4\x88\x44\xd2\x33\x80\x68\xea\x53\x2a\x71\x6e\xc0\xa4\xd1\xa7\x6c\xdc\xe5\x15\xc4\x23\x74\x2b\x67\x87\xa2\xf4\x93\x12\xac\x01\x39\x27\xd9\x55\x57\xa6\x8e\x7c\x17\x25\xd3\x98\xbc\xe2\xd3\xdc\x83\x11\xa0\x1f\xaa\x96\x2c\x42\x2b\x61\xc3\x2b\xce\x65\xcb\xee\xd8\x1b\xf7\xee\x49\x42\x77\x25\x72\x95\x05\x38\x67\x39\x8b\xc9\x52\x92\x1c\x76\x77\x77\x39\x91\x3b\x6b\x88\xc8\xa1\xb2\x62\x14\xf2\x3a\xdc\x01\xca\x5d\x4d\x96\x17\x3b\x24\x3d\x03\x13\xe5\xe0\x34\x89\xaf\xaa\x57\x22\xdb\xaf\x2a\x9c\x2d\x11\x75\xeb\x84\xc7\x5c\xda\x9f\xb1\x5d\x58\x6b\x99\xff\x94\x56\x6b\x90\xe2\x55\x0c\xdc\x90\xdd\xbc\x9b\x9c\x2f\x81\xa2\x4d\xa9\xe1\x86\x3d\x66\xc2\x16\x22\xa0\x2b\xe5\x7a\x0f\x26\x11\x89\x43\x97\xeb\xa1\xe8\x4e\x1b\x4d\xad\x3c\x57\x07\x61\x08\x75\x0e\xb5\x8b\x69\xe4\x77\xe3\x9e\x79\x03\xd2\xb5\x16\x13\x59\x69\x20\x17\x12\xf9\x2c\x16\x94\xe6\x87\xb1\xd6\x46\x44\xf4\x65\x6c\x42\xaa\x0d\xc6\xe3\xbb\x0d\xc6\xdd\x06\xe3\x6e\x83\x81\x46\x8c\xe1\xdc\xf8\x59\x63\xc6\xac\xdd\xf3\x4e\x2d\xfc\x4a\x9e\x86\xd4\xdf\x0a\x64\x56\x91\x84\xa7\x46\x90\xd7\xfd\xe8\x23\x66\x3c\x11\x69\x23\x64\x31\xfa\x88\x7b\xdd\x31\x61\x5e\x15\xac\x14\x3c\x34\x72\x32\x4b\x0d\xfd\x42\x26\x0b\x50\xea\xe9\x1f\xb0\xaa\x3c\x87\x25\x56\xd7\xf8\x82\x9f\x24\x31\xc3\xdc\xab\x20\x09\xa6\x24\xb3\x13\x6c\xea\x02\x97\xe3\x54\x33\xb4\xc9\x30\xb3\x55\x0b\x8a\x80\x9d\xe3\xa8\xb6\x3a\xcd\xe6\xb7\xb8\x0c\x1b\x12\x2a\x24\xa3\x44\xcf\x52\x65\xec\x2c\xce\x82\x9c\x07\xc1\x6c\x9e\xd0\x7d\x4a\x58\x1e\x24\xdf\x5a\xef\x48\x3c\x6c\xa6\xd0\x92\x70\xe9\x30\x39\x16\x6b\x0b\xc7\x02\x8e\xfa\x94\xed\x5a\xe2\xac\x28\x97\x1b\xd1\x6e\x49\xb7\xe5\x05\x0b\x99\x8e\x26\xa7\xda\x6c\xf9\xe6\x36\xc9\x15\x79\xde\xa8\xea\x68\x59\x24\xcf\x17\xda\x4f\x89\xa7\x01\xbc\xa2\x2b\x5d\x44\x72\x60\x84\xcd\x95\x9e\xda\xa9\x30\xd2\x52\xdd\x62\x4a\x24\x52\xae\x5f\x2f\xe3\xb8\x0a\x94\xda\xa7\xbb\x12\x72\x19\xe9\x3e\x1b\xde\x69\x31\x60\xaa\x9f\x1e\xd5\x6f\xb1\x9c\x9b\x16\xb9\xf0\x65\x36\x53\x5a\x78\x91\x2e\xba\x3c\x46\x3d\xd7\x1c\xf8\xf1\x7e\x36\xcd\x39\xce\xef\xad\x53\x6b\x56\x0f\x0d\xc5\x2d\x43\x75\x4a\xdb\x5c\xa7\x55\x93\xf1\xaf\x30\x89\xec\x70\x22\x87\x8f\xec\x68\xe2\xa6\xba\xa1\x9c\xa4\x2e\x4b\x86\x35\x6b\x2a\x04\xcd\xa6\x4c\x9d\x1c\xba\x8b\x51\xc4\x7b\xe3\xa0\xc4\xfc\x42\xcd\xe1\xe4\xed\x9b\x7d\x91\xa4\x7d\xf5\x38\x9d\xc8\x14\x89\xe4\x6a\x9c\xa3\x18\x15\x94\xd6\xa2\xda\x29\xe1\x75\x0f\x68\x55\xcf\x8c\xe0\xcc\x64\x7f\xf3\xa1\x5e\xe9\xa9\x19\xe6\xef\x21\x2b\x63\x1d\x67\x34\xbc\x70\x84\xb4\x6c\xec\x6c\xca\xc4\x3d\x7d\x88\x92\x90\x5c\x7a\x23\x63\x96\x6b\xef\x93\xea\x6f\xce\xda\xac\xee\x29\x15\xb3\xca\xe3\xaa\xee\xa6\xde\xd9\xe7\x8b\x7d\xe9\xa5\xdd\x96\x02\x78\x32\xbe\xb6\xc6\x9d\xba\x14\x87\x0e\x22\x38\x94\x39\xd0\x51\xef\x72\xd7\x7c\x99\x8a\x0e\x0b\xe1\xcc\xac\x0b\x4f\xd0\x02\x65\x9b\xa3\x15\x03\x7a\x1b\xe6\x61\xb6\xa6\xc9\x18\x1b\x92\xd5\x1a\x58\x0e\x1a\x18\x0d\xcc\x96\x56\xc6\x2f\x6e\xb2\x45\x59\x4c\xe6\x7f\x54\xf2\x88\xed\x97\x7a\xc1\x5a\x73\x45\x0a\x55\x98\xd5\x52\xa4\x00\x5a\x83\x15\x52\xa5\x74\xb7\x32\x0b\xa0\xa4\xe1\x49\x0e\xc9\xcc\xed\xf6\x67\xdd\xe6\xda\x28\x19\xa4\xc1\xb0\xcd\x15\x64\x99\x0f\x8f\x4f\xa3\xbe\x1b\x39\xfb\x03\x9d\x13\x5a\x42\x62\xeb\x6c\x19\xc5\x21\x9b\x15\x13\x4f\xb4\x94\x95\xc3\x8e\x69\x04\x47\x87\xcf\x0e\xef\xdd\xbb\xc7\x76\xd8\x9d\x1c\x96\xd3\xf8\x6a\x00\x3c\x63\x3a\xdd\x93\x2f\x73\xaa\x7c\x15\x76\xbf\x89\x92\x1b\x8e\xfe\x2d\xfd\x90\xed\xd3\x78\x2a\x9c\x59\x89\x32\xba\x1b\x1e\x36\x3d\x3d\xfb\xe3\x84\x96\x3b\xd9\x3a\x3d\xa5\xdb\x12\xf5\xf1\xfa\xda\xbe\xaf\x64\xd6\xe1\x7f\x6c\xb3\xca\x74\xbc\x8d\xf3\x8b\x18\x1d\x63\x75\x1b\xcf\x60\x39\x05\xf5\x6e\x4b\xa5\x03\x1e\x72\x6b\x8b\x7e\xb7\x9c\x78\x9c\xde\x38\x55\x7e\x55\xb1\x2d\xaf\xac\x67\x2b\x25\xb6\x04\x23\xd9\xbc\x37\x6d\x37\xa8\x66\x3f\xd6\xbb\xbe\x61\xab\xdc\x2b\x2a\xaf\x01\xe5\xb2\x97\x92\x5b\xc0\xe7\x7f\x6c\x82\xc2\x2b\x8a\x0e\xd5\x65\xa2\xbc\xde\xe3\x72\x32\x6e\x10\x46\xde\x1e\x92\xd6\x83\x7b\x98\xee\xb8\xed\xc6\x33\x2d\xdc\x3a\x17\xa5\x00\x89\xd6\xbd\xdd\x29\x8f\x58\xa8\x96\x19\x61\x9e\x48\x6f\xdf\xec\x97\xd1\xd4\xb9\xe7\xee\x38\x48\xca\xfd\x5a\x94\x08\xab\x71\xd3\x90\xee\x68\x92\x63\xb1\x80\x0d\x06\x03\x79\x5a\xe9\x5d\xc0\x94\x03\x28\xab\x31\xdf\x82\xd6\x38\x13\xb2\x33\x25\xf1\x4a\x0c\x23\xa9\x53\xcf\x5b\xac\x12\x4d\xed\xac\x8a\x26\x9a\x5b\xaf\x5f\xe9\x5e\xc2\xed\xfd\x67\xbe\xbb\x33\x6f\xdf\x99\xb7\xef\xcc\xdb\xa5\xff\x4c\x78\xf6\x69\x5c\x67\x1a\x9a\xb7\x4b\x0b\xb4\x6a\xdf\xe6\x22\x15\xb1\x57\x3f\x7b\xaa\xc9\x38\x8a\x08\x47\x50\x56\xcb\x7c\xca\x90\x66\xbc\x46\x83\x26\xe6\x24\x9e\xb8\xdc\xaa\x39\x60\x79\x17\xbb\xfa\x2b\xdc\x39\xdc\xba\xb6\xa1\xe7\x76\x69\x47\xd8\xb2\x2c\x4a\x5b\x66\xe3\x2e\x36\x84\x16\x27\x81\x95\xf6\x55\x13\x48\x85\xad\x14\xcb\xa2\x0c\x22\x92\x90\x0b\x31\x4f\x58\x7e\x0f\xaa\x43\x8e\xa0\x53\x56\x70\x65\x8e\x1f\x41\x27\x3c\x7b\xef\x2d\x26\x37\x1d\x0f\x91\x31\xd9\x20\x4e\x49\x4b\x10\xcb\x0a\x7e\x10\xbd\xc5\x24\x88\x3b\x8d\x40\x5c\x2c\x8b\x97\xe4\xb2\x05\x0a\x5f\x92\xcb\x5a\xfc\x39\xca\xb4\x46\x5e\x0b\xc8\x78\xe9\x5a\xb4\xd5\x40\x56\x8f\x33\xa1\x15\x9c\x20\x6d\x48\xba\xe9\x57\xf3\xde\x17\xf8\xed\x8b\xd1\xe8\xb5\x4e\x9b\x2b\x15\xcf\x9e\x5a\x0a\x05\x67\x94\x8d\x11\x3c\x54\x74\x87\xef\xef\x74\x87\x3b\xdd\xe1\x4e\x77\x40\x8f\xc6\x17\x51\x5e\xac\xe9\x60\x5c\xd4\xfa\x12\x71\x6e\xaa\x54\xf4\x86\x4a\x52\x7d\xb0\x4e\xdc\xb1\x13\xfa\x9a\x43\xfa\x3a\xad\x47\x2d\x2b\x6f\xde\x19\xa5\x17\xe2\xb5\x55\x7e\xec\x02\x65\x9c\x26\x93\x68\x6a\x95\xdf\x4f\x13\x7e\x2e\xa9\x57\x1b\x8b\xd7\x56\xf9\x0b\x11\x87\x44\xf3\x30\x10\x2f\xad\xc2\x2f\x98\x49\xcd\xc4\x25\x7b\x69\x95\x3d\xc8\xdf\x5d\x25\x63\xbe\x9e\xab\xc5\x73\xfe\xd6\x2a\x4f\xd7\xa6\x8c\x98\xa5\xc5\x5b\xbb\x75\x1e\x5c\x5b\x2d\x1a\x9d\x05\x89\x55\x8e\xe7\x8a\xb1\x60\x96\xaf\x11\xa5\x94\x25\x4e\x68\x75\xfa\x5c\x1e\x71\x8a\x50\x32\xec\xd8\x63\xeb\xb4\x07\xf7\xef\x83\xf8\x7b\xc0\x4e\x41\x0e\x27\xdd\xce\xd6\x65\x87\x87\x94\xdd\xea\xc1\x13\xd8\xa0\x7c\x46\xd7\xda\xa7\xb4\xd7\xa7\x57\x2f\x83\x7c\xb6\x01\x23\xeb\x3d\x3f\x4d\xdb\xf0\x2a\x83\x6c\x4c\x01\x83\xf7\x45\x96\xce\x9f\x7e\xce\x71\x74\x24\xbc\x47\x15\x10\x4f\xaf\x18\x08\x74\x48\x7b\x49\x78\x40\x2b\x76\x60\xe4\x2b\xca\x47\x59\x16\xf6\x8e\x76\x99\x8c\x63\xf2\xd9\x87\x77\x4c\x7b\xad\x1b\x98\x5a\xa8\xcd\x90\xe4\x74\x2b\x88\xd9\x4f\x97\x49\x3b\x57\x88\x75\x8c\x12\x85\x82\x13\xa7\x36\x50\x47\x39\x3e\xe6\x26\xd3\xf7\x45\x46\x77\x5c\x76\xad\x4c\xa4\x3d\x81\x6a\x89\xba\x11\x95\xc0\xbf\x89\xf2\xe2\x3f\x6d\x1f\x4b\x2b\x34\xda\xc7\x1a\xe6\x46\xbe\xe2\x45\x04\x87\x7b\x81\x82\xbc\x68\x0a\xed\xe2\x76\x80\x22\x73\x27\xf2\xc2\x1c\xe8\xa1\x35\x41\xcd\x97\x79\xf0\x74\xef\xb5\xc2\x0e\x74\xba\xc4\x3a\xc3\x2d\xa7\x3c\xd2\x93\x69\x3f\xd5\x0e\xec\xf9\xb0\x06\xdc\x45\xbc\xbc\xa5\x4f\xc9\xaa\x32\xe7\xf6\xa1\x13\x92\x49\xb0\x8c\x39\x03\x76\xfa\x26\xa6\xa6\xff\x1f\x7b\x7f\xde\xdd\x36\xae\x34\x0e\xc2\x7f\xbf\xef\x39\xf3\x1d\xaa\xfb\x37\xb7\x23\xc7\xb2\xac\xcd\xb2\xa3\xdb\xea\xe7\x71\x9c\xcd\x37\x49\x27\x63\xa7\x97\x79\x7c\xd2\x3e\x94\x08\x59\x4c\x24\x52\x97\xa4\xbc\xf4\x4d\x7e\xdf\x67\x3e\xc7\x7c\xb1\x39\x58\x09\x92\xd8\xa8\x25\x4e\xba\xa5\xbe\x37\x96\x48\xa0\x50\x28\x14\x0a\x85\x42\xa1\x0a\xa5\x7d\xdb\x91\x0b\xcf\x42\xd5\x90\x41\x15\x89\x51\xd8\xfd\x25\x79\xb8\xd7\xde\x54\x1d\xe5\x2e\x07\x13\x06\x70\xed\x15\x1d\x06\x25\x0c\xca\x2f\xe5\x83\xaa\x3c\xdf\x54\xa3\x0e\xcb\x2e\xb2\x1e\xfa\x30\x60\x6b\xa6\x10\x83\xba\x4e\x1a\x55\xb2\x0b\x61\x21\xee\x4d\xc9\xb5\x22\x77\xdb\x01\xab\x61\xb0\x1f\x88\x05\x42\x5f\x52\x58\x11\x54\x57\x8d\x72\x5e\x2a\x78\x97\x2e\xd4\x75\xea\xc1\xc2\xd2\x11\x3d\xcb\x1c\x56\x8a\x25\x9e\x48\x1c\x48\xa5\xb7\x28\xfc\x5e\xd1\x62\xc1\x8d\xa2\x2f\xc3\xa3\xef\x44\x8c\x60\x51\xc8\xd5\x30\xf3\x0a\x6b\x70\x95\x89\x2c\xd5\x72\x20\xb4\xa5\xf4\xdf\x87\xd8\xef\xa2\xd4\x9b\x56\xa7\xf6\x2f\x21\xc6\x1d\xf9\xee\x14\x97\x1b\xfa\x7b\x93\xfc\x3c\x8d\x62\xef\x0a\x1d\xa7\x95\x0c\xb7\xac\x8e\x03\xa5\x8d\x65\x85\x99\xd4\x85\xcc\x74\xfd\xa5\x2a\x64\x1a\x11\x53\x63\x15\xba\xba\x12\x84\xe5\x71\x72\xa5\x05\x2e\xee\x40\x06\x5d\xb1\x7b\x64\x34\x57\x82\x70\x3d\xc0\x79\x81\xa1\x6a\x8d\x8e\x24\x62\x87\xbe\x6e\x6a\xfc\x1c\xbd\x45\xa1\x1f\x84\xc5\x99\x50\x77\x58\xd2\x41\xbe\xb9\xa8\x5e\xcc\x3f\x2f\x39\x33\x71\x77\x2b\xcf\xca\x93\x89\x17\x84\x67\xe8\xc6\x8b\xfd\x93\x28\x4c\x69\x82\x02\x67\x96\x2c\x55\x76\x61\x50\xa7\x4a\x2b\x0d\x90\x81\x19\xeb\x36\xd6\x76\xe6\xd6\xf3\xd4\x4b\xd1\xc9\xc4\x0b\xaf\xd0\xe3\xbb\x67\x5e\x92\x8a\x24\xcf\xee\xd2\x4d\x09\xc1\x49\xd6\x39\xd7\xe4\x84\x6c\xad\x99\x90\xce\x74\x22\xbb\xe4\x4a\x6b\xec\x68\x6a\x10\x74\xc2\xa0\xb3\x6e\xa6\x51\x72\x8b\xbc\x0a\xac\x63\x5a\xd6\x4b\xf1\x86\x0c\xcb\xc3\x6c\x1e\x4c\xa9\x4d\xda\x7d\x8d\x60\x75\x9c\x16\x0a\x43\x59\x4e\xc9\x66\x25\xd9\x5d\xb4\xf1\x54\x95\xe5\xc5\xfa\xfa\x5e\x98\x6c\x60\xeb\x9e\x04\x4b\x2b\x4f\x9c\x77\x9e\xa0\x51\x30\xf3\xa6\x95\x48\x99\xd9\x96\xaa\x12\x31\xab\x69\x9b\x44\x7f\x15\x82\xe5\x6d\x29\xae\xc4\x92\x6a\xb9\xe8\xf1\xb2\x15\x9a\x58\x02\x2b\x52\xcd\x2e\x29\xa4\x26\xaa\xef\x67\x14\x46\xfd\xe5\x68\x21\xaa\xeb\x89\xa2\x3b\x41\xf8\x46\x24\xf2\x9a\xe8\x7c\x86\x46\x28\x98\x57\x99\x9e\xe5\xca\xd5\x18\xcf\x50\x69\x6d\x9c\xc7\xda\x58\x85\x30\x55\xa5\x96\xbb\xd4\x57\x11\x45\x5b\xa5\x12\xeb\xd1\x2d\xdf\xba\x77\xcf\xcb\xc8\xb3\x04\x85\xfe\x99\x77\xb3\x84\x4c\x2b\xd7\xb4\x51\xd2\xad\x46\xa5\xb5\x00\x13\xd2\x4d\x35\xc4\x8d\xbf\x8b\x17\x68\xf9\xce\x96\x6b\xbb\x74\xd8\xad\xd6\x46\x3b\xbd\x64\x6f\x2b\x76\x73\x7d\xfd\x2b\xce\x0b\x95\x04\x75\xec\x7f\x70\x15\x2e\xd3\xff\x7c\x35\x6b\xff\xed\xc5\xef\xb1\xff\x55\x3a\xed\xd2\xd3\x2f\x60\xed\x71\x67\x70\x76\xef\xca\xa9\x83\xb8\xac\xad\x83\xba\x32\x2b\x75\x10\x2b\x2b\x9b\xb4\x65\xa1\x24\x0d\x66\x5e\x8a\x9e\x7b\xce\x9b\x37\xa9\x8a\x8d\x24\x96\xa2\x2b\x71\x76\x8e\x32\x1b\x5b\xe3\x46\x74\xeb\x79\x1e\x4d\x03\x3f\x20\x3e\x4e\x6e\xfc\x42\xab\x35\x12\x56\xcf\xca\x3b\xf9\x66\x8c\xc4\xaa\x82\xf7\xab\x57\xaf\xaa\xa2\x3c\x75\xe0\x74\x01\x7c\x6d\x88\x9e\xa3\x78\x5e\xc1\xa2\x27\xe8\x4b\xab\xb9\x92\x57\x5f\xba\x1a\xd2\xd4\x26\x31\x0b\xd2\x14\x55\xb3\x8b\xb3\x3a\x6e\x36\x0f\x7d\xd9\x95\x26\x8e\xce\x26\xec\x7e\x1a\x40\x83\xce\x0b\x0c\x2b\x5b\x13\xd5\x00\x5c\x88\xe2\x5c\xb1\xd2\x2e\x63\xc9\xcd\x3c\xc3\x86\x58\x38\xab\xf7\x9e\x54\x73\xef\xb3\xb6\xb8\xbb\xfd\x4b\xef\xbc\x9e\x1d\x6c\x2b\x1a\xc8\x1f\xc6\xaa\x0b\xc8\x67\x87\xea\x12\xe2\xcc\x4b\xfd\xfa\x24\xf2\x35\x15\xc9\xda\xa6\xa9\x54\xb2\xcf\xeb\xda\x56\xda\xa0\xd5\x85\x89\x51\x4a\x87\x24\xb3\x43\x1a\x30\x2d\xee\xf7\x0c\x45\x33\xf3\x97\x86\xa8\x19\x28\x6b\x01\x61\xdd\xb0\x96\x64\xdb\x66\x6b\x39\x1d\x62\x9a\xfb\xf8\xd2\x52\xaf\x78\x5b\xde\xbe\x69\x0a\x95\xb7\x3c\xaa\x82\x79\xa5\x59\x0b\xca\x06\x43\xd5\xbb\xfc\x42\xac\x2f\xf1\xea\xd5\x2b\x43\x75\xba\xd0\x68\xb9\x88\x4a\x2f\xcd\x6b\xa5\x8c\x33\x96\x25\xfc\x9d\x2f\x50\xbe\x5c\x92\xfd\x26\x17\x7f\x84\xaf\x9a\x53\x12\x66\x85\xcc\xc0\xe2\x4e\x38\x2c\x69\x8e\x0f\xc5\x6a\x1d\x84\x43\x2f\x51\x8a\x2f\xd6\x13\x22\x87\xf9\x62\xcd\x4a\x97\x0b\x7f\xde\x51\x40\xa8\x80\xc8\x7c\x31\xfc\x88\x94\xda\x55\x19\x0d\x56\x76\xfd\x48\x8c\xf8\xc8\x3e\xae\x40\x12\xb9\xca\xfa\x51\x0a\x12\xc1\x6e\xaf\x91\x6e\x49\x2d\xa3\x55\xae\xb6\x7e\xd4\xb8\x73\xbd\x13\x42\x96\xc2\x76\xf3\x22\x73\xf0\xd7\xd8\x14\xd7\xd1\x9f\x2b\x2f\x79\x1b\x07\x6a\x0f\x9b\x72\x87\x6c\xa5\x57\xf7\x82\x59\x47\x9f\x3c\xea\xf3\xa7\xdc\xe3\x95\xfb\x24\x4a\xaf\x1f\x91\xa1\xe4\xda\xec\x84\x8b\x43\x85\x6a\xda\xe2\x3a\x7a\x31\xb2\xab\xb7\xe5\x9e\x38\x56\xfa\xf2\xbd\x21\x6e\xac\xa3\x68\xfa\x2b\xbd\x43\xe7\x28\x7c\x0b\x95\x54\x68\xd9\x16\x3c\xfe\x2b\xef\x4c\xdb\x18\x65\xd7\x89\x24\x0f\xfd\x61\xa0\x0e\xe3\xe3\x8d\xd2\x28\xe6\xdb\x7e\x7e\x13\x89\x04\x8c\xa8\x03\xae\xa4\x8e\x1a\xc1\xaa\xb9\xa2\x34\xe6\x97\x90\x4a\x51\x4e\xeb\x52\x70\x43\x5a\xea\x24\x46\xe4\x62\x23\x09\x9f\x77\xa2\x8b\x00\xc3\xf0\xc0\x48\xd3\x1b\x4e\x19\x40\x42\xe0\x07\x75\xa5\xfb\x7e\x9d\x5f\x9e\x6a\xe0\x42\xb5\x9d\x7a\x59\x9e\xbc\x8a\xae\x24\x7b\x94\x1b\x76\xae\x74\xc8\x2e\x4c\xd9\x35\x12\x9e\x41\x87\x0e\x08\xab\xd9\xb8\x9a\x46\x43\x6f\xda\xc0\x23\xd3\xf0\xca\x8f\xa9\xb5\xd0\x19\x9f\x60\xe4\xcd\x7f\x5e\x19\x27\x0c\xa5\x84\x11\x7d\x58\x15\x1f\xe9\x02\x5a\x86\x8d\x36\x0c\x90\xc4\x04\xe2\xea\x5a\x4d\x3d\xec\x4b\xe4\x0b\xc2\xb8\xe5\x6e\x25\x67\x65\xbf\x2f\xdf\xea\xfb\xbe\x0f\xad\xa3\xba\xa6\x88\x14\x16\x25\x5f\x82\xd3\x12\xbf\x3b\x28\xbc\xa3\x0c\x87\xdf\x3c\x2a\xbe\x11\x3c\xfb\x7d\x1f\x3a\x45\x98\xc1\xd0\x0b\xf1\xf3\x4e\xe1\xb9\x74\xa1\xba\xf0\x86\x8d\xd9\xf7\x7d\xe8\x76\x0b\xaf\xf8\x25\x47\xfc\xae\x88\x20\x53\x46\xf0\xab\x62\xcf\xf9\x25\x0a\xfc\x2e\x8f\x3d\xbf\xa8\x88\xdf\xb0\x8b\xf2\xd9\xf5\xee\x47\xdb\xeb\xdd\xdb\xeb\xdd\xdb\xeb\xdd\x0f\x1d\xae\x74\x7f\x99\xe0\x30\x4b\xdc\xac\x76\xb9\x2d\x9d\xaf\xf1\x33\x4a\xef\x37\xa8\xcc\x97\xbf\xd7\xb6\x64\x20\x99\xfd\xfd\xfd\x2c\xb7\x86\x2a\x71\x87\x8f\x92\x51\x1c\x0c\xf1\x0a\x4a\x9a\xc0\x0c\x04\xde\x3c\x90\xba\x78\x8f\x66\x92\x69\x90\xa4\x28\x74\xd9\xec\x86\x28\xbd\xcc\x4a\x6f\x40\x67\x47\x28\xd6\x7a\xab\x94\x30\xb1\x96\x5e\x66\xd3\xe1\xaa\xdd\x97\xf4\x92\x9f\x51\x5a\x0a\x96\xa2\x09\xc0\x56\x58\xbf\xc5\x42\xdb\x6d\x6e\x17\xda\xed\x42\xbb\x5d\x68\xff\xc2\x71\x54\x36\x19\xe0\xc4\x35\x46\x4b\x56\x2b\xbb\x6d\x8f\xe2\x24\x0a\xbd\xe9\xf6\xc6\xfd\xb7\x7e\xe3\x5e\xfc\x58\xfa\x26\x73\x88\x6e\xb2\xcb\xd4\x4e\x87\xcb\x59\x0d\xd3\xb9\x32\x63\xb1\x4b\x73\xe9\x0d\x79\x15\x06\x33\xbc\x74\x9e\x79\x37\x2f\x91\xb3\x33\x8d\x5c\xc7\xa5\x63\xb6\xf2\xd5\x42\xe4\xad\xd3\x0f\x8e\x63\x68\xf3\x85\xab\x70\xf7\x73\x9d\x1e\x71\x68\x74\x86\x46\xd1\xb5\xbb\x2f\x87\xa8\xe0\xd2\x69\x63\xe1\x6a\x63\xb2\x20\x97\x9b\x2b\xce\x8e\x5c\x25\x17\x84\xad\x15\x2a\x0d\x97\xdb\x40\x55\x19\xae\xcd\x7b\xe8\x0a\x7e\x75\xf7\xd2\x5d\xca\xcb\x51\xe5\xa5\x5a\x85\x12\x4b\x70\x43\x45\x5e\x70\xe4\x84\xa5\x1c\xb2\xaa\xdf\xeb\x34\x6e\x37\x19\x9a\x2a\x4c\x24\xc1\xa8\x78\x9d\xe3\x77\x95\x8b\x07\x9f\xc0\xee\xee\x14\x76\x3f\x0c\xa9\x49\xd7\x2d\xdf\xbd\x6c\xcc\x8f\x9d\xcf\x38\x05\xcb\xc8\xb5\x96\xd9\xe2\x1a\x36\xb9\x5c\x4f\x2c\xed\x74\x8b\xb6\xef\x92\x69\xdb\xb4\xed\x6d\x6d\xb7\xbd\xdb\x6d\xef\x76\xdb\x2b\xec\xcb\xc9\x64\xe2\x6a\x5e\xb6\xec\x61\x51\x8c\x16\x33\xdc\x6c\x31\x7d\xbf\x76\xc7\x3c\x8a\x62\xd4\x08\x22\xcd\xae\xf7\x50\xbb\xeb\xad\xba\xb9\xad\x12\x00\xd3\x1a\x59\x33\x5f\xfc\x7c\x32\xf9\x3b\x47\x43\xaf\xbe\x55\xcc\x75\xe0\x7c\x32\x91\x8f\xc6\xd1\x0d\xcb\x34\xfe\xec\x8b\x3a\x0b\x24\x93\x89\xd5\x57\x20\x99\x4c\x6a\x3b\x5c\x7d\x5d\xd1\x23\xc0\xb8\x43\xae\xb2\xb4\xeb\x15\x40\xc8\x16\xf6\x6b\xb3\x4f\x0a\x53\x02\x93\xc9\xe4\xd2\x52\x52\xe3\x84\x0d\x26\x5b\xbc\x13\x86\x41\x38\x8e\x1c\xd0\x33\x15\xdb\x18\x6e\x09\x4a\x5f\x7b\xb7\x8c\x2d\xcf\x83\x3f\xb5\x5e\x6a\x12\xa2\xce\x75\x34\x17\x32\xd6\x83\x75\x10\xbe\x8d\x7e\x73\xc4\xd6\x58\x76\x63\x58\xce\xbc\xf8\xe3\xbb\x78\x91\xa4\xc8\x7f\x8b\xf4\xee\x5c\x12\xae\x8e\x35\x36\x86\x71\x88\xf0\xb6\xe2\xad\x17\xb8\x20\x6b\x2f\xbc\x31\xae\xf5\x7c\xff\x6d\x1c\x5c\x7b\x29\xd2\x98\x87\x0a\xa8\x3a\x95\xdf\x18\x55\x7d\x34\x45\xa4\x65\x47\xc2\x3a\x95\xdf\x18\xb6\x13\x2f\x71\x47\xd5\x5e\x78\x63\x78\x5e\xa1\x94\xea\x91\x6e\x2c\xe0\x52\x7c\xa3\xb8\x56\xe1\x57\xa7\xf2\x9b\x94\x02\xe7\x77\x33\x37\x4c\xad\x65\x37\x29\x03\x9c\xb1\xb4\x96\xdd\xe0\xc8\xe3\x2d\x4f\x8a\x68\xf3\xcf\xe2\x68\xf6\xd6\x4b\x92\x9b\x28\xf6\x9d\xd8\xa0\x6a\xe5\x4d\x4a\x05\x67\x6a\x5b\xcb\x6e\x72\x9e\x39\x63\x69\x2d\xbb\xe1\xf5\xc0\x19\x51\x97\xe2\x36\x5c\xcb\x8f\xf7\xf7\x21\x59\x0c\x89\x2b\x0d\x02\x2f\xf4\x61\x11\x66\xbf\x67\x41\x92\x04\xe1\x95\xaa\x9e\x73\x2f\xe7\x51\xa2\x75\x26\x91\x7a\x67\x2a\x66\xb2\xc8\x82\xe3\x19\x16\xac\xe6\x89\x72\x3e\x99\x48\x76\x3c\x61\xa1\x93\xfc\x56\x55\xd6\xb9\x9c\x07\x68\x66\x9b\x6b\x6f\x6d\x73\x5b\xdb\xdc\xd6\x36\xa7\x74\x49\x19\xfe\xf9\xa7\xce\x23\xe5\x78\x8a\x6e\xe1\x31\x8a\xd1\x55\xf2\xa7\x97\xfc\x19\xc0\x8f\xde\x14\xdd\xfe\x77\x9c\x8e\x93\xc6\x64\xa1\xb1\xae\xf5\xe4\xc7\xb9\x22\x67\x68\x8c\x62\x14\x8e\x50\x1f\x30\xda\x49\x7f\x7f\xff\x2a\x48\x1b\xa9\x17\xdc\x2d\x50\x23\x88\xf6\xe7\x41\x92\xee\x5f\x45\x7b\xe4\xef\x70\x1a\x0d\xf7\x93\x1b\x2f\x9e\xed\x07\x61\x8a\xe2\xd0\x9b\xee\x63\x22\xa0\xdb\x94\xff\x6d\x5c\x45\xff\xeb\x55\x47\x4e\x89\x76\x8f\x4e\x2c\x59\x0d\x21\x69\xce\x31\xf6\x5b\xcb\xdd\xd6\x1b\x85\xde\x94\x43\xe9\x4d\x14\x7f\x3c\x43\x24\x45\xaf\xd3\x39\x6b\xb1\x9e\xe1\xb0\x75\xf8\xe7\x9f\x97\x2e\xc5\x37\x15\xf0\xea\x2e\x1c\x3d\x0d\xbd\xe1\x14\x39\x77\x4e\xaa\x62\xe9\x97\xa5\xe4\xa6\xba\x74\xe3\xcd\xab\x76\x29\xab\x62\xeb\x92\xb9\xe4\x86\xba\xe4\x47\x37\x21\x4b\x11\xed\xd4\x1f\x5e\xde\xd2\x19\x53\xb1\x25\xc2\xf3\xb9\xf7\x67\x31\xaf\xd2\x1b\x5a\xda\xd2\x17\x7d\xa1\x8d\xf6\x24\x46\x69\x1c\xa0\x6b\xe7\x50\x28\xbc\xbc\xa5\x37\xa6\x62\x9b\x9a\x36\x29\xd6\x8b\x5c\x27\x0c\x2e\x6c\x9b\x2a\xba\x32\x1b\x1d\x90\x2b\x54\x25\xb6\xa5\xa5\x0b\x9a\x12\x1b\x1a\x81\xf9\xc2\x19\xf5\xf9\xc2\x86\xba\xa6\xc4\x46\x69\x4f\x77\x45\xae\x7d\xa0\xa5\x2d\xdd\xd0\x17\xe2\x3d\xe9\x56\xeb\x49\x35\x8f\x33\xfd\x71\x5b\x71\xcd\x56\xf9\x01\x65\xcb\x9f\xea\x6d\xb6\x92\x28\xde\x72\xd1\xac\xf2\x58\x9a\x6b\x5e\x70\xa1\xa1\x6a\x0c\xcf\x45\xc5\xf3\x2b\xa4\xf2\x79\x9a\x2f\x54\x4f\xe5\x3d\x2f\xff\x7c\x45\xfe\x4a\x93\x40\x2d\x2e\x41\xf6\x53\xc2\x2c\x45\x0a\x6a\xcc\x3e\x2b\xb4\x6f\x3a\x8d\xcc\xb5\x4f\x0a\x2e\x63\x73\x31\x59\x5d\xf0\x8e\xc5\x94\x2e\xd9\xe8\x02\xd5\xd9\x9a\x59\xb6\x66\x96\xad\x99\x45\xb8\x40\x71\x1f\x8f\xfb\xbc\x65\x6b\x33\x73\x2c\x79\xf1\x93\x87\xdd\xf0\xe6\x01\xdf\x06\x17\x64\x76\x90\xa4\x4e\xd7\x34\xa8\xd7\x8c\x5b\xda\x4f\x5e\x2d\xbd\x9b\x63\x15\x93\xa5\xf8\x2c\xad\xb6\x40\x96\xc5\x20\x1d\x4d\xa0\x86\x8b\x6a\x33\xf4\x8c\xbc\x04\xc1\x03\x3c\xc9\x92\xf4\x41\x5f\x5d\x86\x30\x48\x7c\x95\x34\x92\x49\x30\x4e\x6b\x2a\xeb\x02\xff\x10\xa3\x0e\xd5\x27\x60\x00\x4d\x43\x49\xb6\x52\xd1\x78\x2d\x21\xba\xa1\xd9\x43\xa8\xe3\x98\xa6\x1a\xc5\x75\x4e\xa3\x4f\xde\x2b\xb2\x2c\x02\xa6\xec\x77\x6e\xc4\x9c\x25\x37\x34\xa0\x5c\x6c\x41\x0f\xef\x73\x41\xd5\x52\xea\x8f\x02\x42\x85\xbb\x3f\xac\x49\xad\x16\x99\xe3\x54\x93\x2e\xef\xb6\x71\x0d\x83\x30\x49\xbd\xe9\xb4\x1a\x9e\x85\x6a\xb6\x00\x98\x0e\xc5\x2b\x87\x6e\x7d\x15\x5d\x55\xc9\x54\x83\x8b\x3b\xc4\xe9\xa4\x08\xea\x0a\x57\x43\x72\x1e\xb9\x87\xa6\xc6\x65\x9d\xd1\xa3\x41\x30\x57\xc4\xd0\xa8\xa3\xd2\x76\x94\xb7\x0b\x72\x23\xa9\xd6\xc0\x31\xf9\x54\xb8\x45\xd3\xa9\x4d\x25\xac\xbc\x06\x24\x93\x49\x83\x2c\x6f\xfa\x15\x20\x29\x78\xb0\x6e\xc6\x0b\xd1\x38\x75\xa1\xe4\x33\x91\xf3\x03\xfd\xe2\x07\xd0\x0e\x13\x12\x54\x67\xd0\xf7\x8b\xb5\x61\x1a\x43\xe9\x68\x9f\xe2\xc8\x10\xd6\xd6\xd9\x18\xae\xba\x09\xbd\x11\x44\x5d\xf7\xb0\xa5\x6d\x56\xa1\x0f\x58\xcc\xf4\xc9\xbf\x05\x2c\x92\xc9\xa4\x8f\xff\x51\xef\xe0\x14\xdb\xb3\x6c\x2f\xd6\xdd\xee\xc5\xb6\x7b\xb1\xed\x5e\x4c\x79\xe4\xcd\xe3\xc8\xad\x27\x10\x83\xdb\x3e\x8c\x46\xd1\x3b\x43\x57\x98\x49\xbc\xf8\x78\x18\x14\xf6\x64\x3c\x5c\x5b\xb2\xff\x3c\x5f\xb4\xf1\x21\x89\xc2\xd2\x91\x74\x30\xf2\xe6\x4e\xd0\x4e\x4f\x8e\xdf\x2a\x60\xa9\xb0\x63\xe1\xfa\xd8\x95\x4a\x18\xc0\x83\xe6\xed\xa8\xe7\x3f\xf2\xdb\x23\xbf\xdb\x7d\xe4\x1d\x1e\x74\x47\xdd\x47\xdd\x76\xaf\x8b\x5a\x47\xcd\x47\xa3\x83\x26\xea\x74\xfd\x5e\xf7\xa0\xd7\x1e\x3e\x50\xe0\xa7\x82\xe7\xb5\xbc\x56\xab\x35\x1c\x35\x0f\xbb\xa3\x47\xa3\xb1\x77\x78\xd4\x1a\x37\x47\x9d\x23\xd4\xeb\x0c\xfd\x83\xd6\xe8\x51\x6b\x78\xe4\x8d\x9b\xcd\x07\x95\x24\x28\xc5\xbf\xaf\xda\xe7\x79\xc3\xa0\xaf\xa0\xbe\x42\xde\xb3\x88\x82\x7d\x25\x35\x0a\x8a\x5d\xa1\x3a\xee\xad\xbe\xf5\xe2\x58\x99\xda\x2e\xd3\xad\xd0\xb2\x79\x2d\x30\x73\xd1\xf7\x7d\x68\xd5\xe1\x7b\x0b\x7f\x7c\xdf\x87\x76\xb6\x90\x1c\x6c\x17\x92\xed\x42\xb2\x5d\x48\xd4\xe1\x7c\x98\x1d\x5c\xb7\x92\x98\x2e\x36\x8e\xe3\xe8\x4f\x34\xf3\xc2\x86\x8f\xd4\x91\x7d\xbe\x58\xd0\xbd\x62\xc4\xbd\x7c\x0d\xc9\xf7\xa9\x74\x69\x4e\xed\xd6\x84\x97\x57\x18\x30\x96\xa7\x3f\xff\xa9\x28\x46\x0f\x33\xa4\x82\xf4\x81\xaa\x68\x52\x2c\x9a\x68\x8b\x16\xc2\xa5\x49\x75\x0a\x6f\x54\x95\xf3\xe7\x7d\x52\xdd\xfc\x0b\x55\xd5\x92\x2b\x57\xb8\x98\x4e\xf5\xb1\x69\x39\xe3\x64\x97\x14\x4b\x0e\x4a\x39\x82\xc7\x33\x35\xad\x4b\xcd\x92\xe3\x1b\x75\xa3\x45\x1e\x3e\xa7\x73\x6d\x88\xc8\xce\x08\xf9\x90\x46\x2c\x22\x0a\x3d\xf8\x24\x96\x44\x3c\xeb\xe8\x06\x43\xae\x9b\x67\x3d\xfa\x9e\xd5\x3d\xc5\x55\xf3\xef\xc9\x26\x0a\xfe\x73\x1c\xc7\xde\xdd\xe7\xfc\x2b\x66\x01\x50\xbc\xdb\x37\x52\x4b\x6a\xab\x68\x36\x36\x06\x18\x2e\x8c\xf0\x7f\xa9\x9e\x52\x20\x7d\xdc\xfd\x75\xd0\x92\xf2\x5d\x2d\x46\xc9\x62\x9a\xee\x54\xa6\xe7\x1b\x52\x5d\x49\x50\xea\xf4\xa6\xa1\xa8\xe2\xa5\x0b\x49\x69\x73\x79\xde\x63\x98\x1b\xc8\x5a\x9c\x75\x3f\xfc\x00\xb4\x16\x7c\x37\xa0\x53\xa1\xf0\x08\xaf\xd6\xe3\x20\x44\x3e\x1f\x82\x02\x04\xd1\x68\x9f\xd5\x5a\x62\x24\x16\x09\x1d\x07\x74\x4b\x63\x96\xf3\xdb\xb5\x30\x8e\xa3\x59\x66\xe2\xf2\xe2\xab\xc5\x0c\x85\x69\xd2\x80\xd7\x58\xa5\x08\x50\xc2\xf8\x9f\x90\xd9\x3a\x4c\x0c\x3c\xbf\x9e\x6b\x60\xfd\xac\x29\xf5\x90\x3d\x63\x24\xff\xf4\xf3\x62\x3a\xfd\x2c\xdd\x06\x0e\xc6\x80\x6e\x83\x24\x5f\xcf\x3c\x9a\x05\xac\xec\x67\x2b\xc1\x18\x6a\x34\xb4\x64\x90\x70\x44\x48\xc9\x0b\x72\xa0\x30\x45\xe1\x55\x3a\x81\x3d\x68\xbd\xdf\x51\x9e\xa7\xb0\x4e\x90\xc2\xf3\x68\x5e\xdb\xf9\x27\xec\xef\x73\x57\x0e\xbc\x6e\x13\x69\x42\xa8\xfe\x9d\x93\x4a\x6b\x18\x5c\xea\xf5\x99\xf5\x28\x8d\x5c\xe7\x54\xde\x5f\xd4\x7d\x56\xb1\x77\x9c\x30\x15\xa6\x55\xbe\xc5\xfc\x2a\x3a\xfc\xa0\x3c\x15\x23\x95\xcb\x9b\x1c\xa0\x66\xde\x3e\x9d\x30\xc3\x45\x30\xf5\x9f\xa3\xb4\xa6\xb2\x97\xa1\x70\x31\x43\xb1\x37\x9c\xa2\x3e\xa4\xf1\xa2\x90\xdf\x46\x73\x6a\xe2\xcd\x88\x83\x85\x58\xc3\x1b\xc9\x7c\x1a\xa4\xb5\x07\x8d\x07\xc5\x73\x24\x5e\x1c\x2f\x73\xb8\x16\x3d\x84\x93\x4b\x60\x6e\x22\xaf\x38\xe3\xfc\x04\x2d\x25\xdb\x44\xc3\x0f\x17\x1c\xc6\x7b\xbc\xe2\xe6\x7e\x7f\xfa\x04\xff\xf9\xac\x38\x06\x8a\x86\x1f\x8a\x65\x15\xa5\x72\x28\xb6\x8a\x25\x3e\x17\x89\x40\x47\xa8\x41\x05\x93\xf0\xd6\x88\x86\x1f\xea\x04\x44\x9d\x8e\x4b\x91\x16\x18\x09\x2f\xb9\x0b\x47\xcf\x89\x4e\x82\xf7\x6e\xa4\xe7\x3b\xef\x39\x2d\xc9\x48\x1d\xb3\x22\x35\xf3\xf5\xfd\x02\xa4\x1c\xbb\x10\xa8\x1a\x29\x4c\x9c\xd0\x60\x97\x20\xda\x18\x4d\xbc\xf8\x38\xad\x35\x77\x1a\x69\xf4\xcb\x7c\x8e\xe2\x13\x2f\x41\xb5\x1d\xfe\x3a\xc1\x5a\x6e\xad\x65\x88\x9c\x5f\xe6\x62\xce\x6d\xf6\x23\xdd\x79\xa6\x2f\x32\xef\x74\x05\xba\x02\xc6\x15\xa6\x88\x41\x9c\x08\x0d\x5b\x5e\x9d\x6a\xe2\x69\x5e\xfd\x69\x24\x28\xd4\x1a\x88\xa9\x00\xe8\x67\x10\xa9\xba\x59\x2e\xfc\x79\xa7\xe4\x05\x5e\x99\x52\x7c\xb4\x57\x25\x57\xe6\x89\xe8\x90\xb2\x00\x32\x37\x7a\x25\x65\x08\x52\xab\x93\xa7\x2e\xe1\x82\xe2\xb8\x0e\x1a\xfd\x80\x7f\x38\xbe\xb4\xb0\x7a\x40\x19\x08\xd5\x79\xf5\x67\xd3\x68\x00\x15\x89\xbc\xb7\x7c\xc6\xb1\x9f\x8d\x61\x10\xfa\x24\x69\x83\x26\xd5\xc8\x15\x5a\x46\xaf\xc8\x34\xbc\x51\x8c\xf0\xae\x6b\xbe\x88\x11\xfc\xeb\xfc\xcd\xcf\x67\x6f\x4f\x80\xa3\x72\x33\x09\x46\x13\x62\xbf\xe0\xaa\x48\x10\xc2\x90\x1c\x99\xb1\x22\xd6\xc5\x4a\x51\x4e\xac\x44\x8d\x46\xe3\x33\x3b\x9d\x30\xea\x7f\xf0\x21\x89\xc2\x78\x3e\x52\x01\x33\x2f\x5c\x19\x4d\x6d\x2c\xec\xdd\x31\x37\x6c\xed\xad\x92\xbe\xbc\xe5\x33\x9c\x9f\x5e\xa8\x72\xd1\x72\x06\x62\x40\x0a\x9a\x4d\x8d\xe8\x56\xf2\x46\x0a\x8b\xb7\x06\xae\x54\x13\xfa\xd6\xce\x8e\x99\x87\x58\x17\x18\x53\x72\x36\x92\x59\xd4\xca\x4b\x0c\x44\x85\x1c\x20\x8c\xe4\x0a\x2b\x5e\x31\xde\x76\x66\x8d\xeb\x6d\xad\x71\x5b\x6b\xdc\xd6\x1a\xa7\xb4\xc6\x31\x81\x35\x63\x4b\x9e\xc6\x26\xf7\x2f\x34\x1e\xc7\xe8\x0e\x7e\x0b\xa6\xa3\x8f\x08\x7e\xfc\x80\xc6\x63\x6b\x94\xed\xe5\x62\x73\xbf\xf6\xe2\xc0\x0b\xe1\x8d\x17\x8e\x90\x47\x2a\x19\xa3\x72\xaf\x12\xd1\x9b\xd5\x7d\xee\x5d\xc3\x6f\x51\xe4\xc3\x8f\x57\x76\x4b\x61\xb7\xb0\x16\xe4\x35\xd1\x7f\xb1\x75\x23\x17\xaf\x8c\x2d\x26\xa5\xd3\x28\x17\xb3\xa2\x5c\x7e\xa4\x2c\x4b\xb3\x1d\x95\x0a\xa3\x38\x8e\x0a\xe1\xc0\xf7\xe9\xb3\x82\xb9\xb2\xc8\x16\xa7\xe9\x83\x04\x6b\x27\xf3\x28\x4c\x82\xe1\x94\x32\xfb\xdc\x23\x57\xec\x61\xc6\x4e\xfe\xf1\x3a\x3e\x8f\xa3\xeb\xc0\x47\x71\x52\xae\xee\x4d\x93\xa8\x0c\x23\x9a\x4e\x31\x0c\x3c\x01\x78\x80\x3a\x08\x23\x9f\xbe\x0d\xc2\x51\x34\x93\x9b\xc8\x41\x65\x59\xe7\xa9\x9b\x52\x1a\xcc\x10\x16\x06\x41\x02\x2d\x48\xd0\x28\x0a\xfd\xbc\xd2\x11\x84\x57\x53\x94\x46\xa1\x66\xd9\xc6\xe4\x31\x58\x0d\x79\xbf\xd4\xb6\x43\xfe\x16\x06\x82\x00\x2a\xcb\x26\x46\x94\x1c\xb9\x15\x17\x4d\xf2\x96\xf7\xc0\x62\xf0\xb4\x5b\x69\xb0\x72\x3a\x89\xa3\x30\x5a\x24\xd3\x3b\x12\xfb\xd4\x59\x4f\xc2\x85\x4d\x5b\x79\xf0\xbd\xd4\x5b\xc6\x4a\x96\x27\x6d\xce\x58\x1b\xfa\x39\x4a\xe3\x16\x94\x26\x95\xef\x72\xa4\x56\x2a\xc9\xa3\x28\x4c\x22\xac\x1a\x60\x9e\xae\x51\xce\x6e\x9c\x86\xd7\xde\x34\xf0\xdf\xb2\x8a\x35\xa5\x6e\xcc\x83\xcf\xe5\x29\x0f\xaa\xcd\x6d\x5e\x51\x63\x93\xbb\x91\x46\x6f\xe9\x33\xd2\x81\x06\xa5\x67\x9d\xd0\x8b\xf9\x86\xaa\xb6\xfe\xcc\x8e\x37\xc8\xb3\x11\xdd\x7d\xb1\x36\xca\x8e\x6f\x84\x18\xbc\xdd\x20\xf9\x15\xf7\xee\x8c\xce\x2b\x24\xf4\x7f\x15\x79\xd2\x49\x1c\xdd\x40\x9e\x2c\xc5\x8a\xb6\xde\x33\x42\xd1\xd2\x8d\x95\x2d\x8a\xde\x8a\xcc\x4a\x76\x62\x15\x39\xb6\x68\x81\x12\x6a\xf1\x32\xbc\x4b\x10\x28\x31\xb0\x94\xca\x6e\x49\x56\x66\x74\x96\xb7\x7c\x4e\xcc\xbc\x31\x76\x2d\x73\x28\xdd\x05\x33\xd8\x55\xf6\xb2\x98\x04\x28\x56\xf7\x5b\xd3\x77\xe5\x7e\x56\xe5\xad\xbe\xf4\xe4\xb0\x13\xbd\x04\xc6\x15\x27\x01\x8f\x5e\x74\xcb\xcd\x9d\x0a\xa1\x38\x5d\xf6\xd1\xaa\xf9\x54\x6d\xab\x8c\xab\x3c\xc6\x35\x94\x93\x86\x99\xdf\x29\xc8\x0d\x4f\x2d\x82\xc5\xb7\x38\xb5\x08\xe2\xf2\xfc\x2a\xcb\xf0\xe5\x66\x93\xfa\x1e\xc7\xc6\xa7\x13\x3f\xcc\x20\x83\x5f\xe3\xa8\xac\x67\x16\x25\x4b\x4c\xa3\x1c\x45\xd6\x31\x7f\x84\xe6\x84\x52\xa1\xc0\xe1\x6d\x19\x37\xdc\xb0\x8d\x90\xc3\xdc\x49\x39\xfb\x98\xd6\xa4\xea\x93\x41\x80\xcd\x2b\xa5\x76\x6d\x74\x15\x62\xa4\x5e\x9c\x72\x05\xdd\xde\x75\x5c\xfa\x6d\xb9\xb0\xfb\x7a\x4c\x33\x46\x7f\x26\x2d\x9e\xaa\xb5\x50\xb3\x5c\x51\x14\x13\x6e\xe2\x7a\xfc\xd3\xc8\x8f\x60\x34\x45\x5e\xb8\x98\x43\x48\x70\xc0\x43\x5f\x36\x08\x3a\x8d\x94\x44\x05\x85\xe4\xa2\x5d\x93\xa3\x13\x0b\x04\x35\x43\x89\x77\x0c\x17\xb4\xda\x7b\xbc\x73\xc0\x70\xfa\x40\xa1\x05\x7e\xbf\x04\xb1\xaf\x82\xdd\xcf\xbe\x16\x8f\x05\x81\xc5\x51\xd3\x8c\x75\x4e\xa8\xb2\x0d\x8a\x46\xb5\x64\xd8\x96\x6e\x4a\x95\xce\x24\xab\x70\x60\x34\x17\x3b\x44\xbc\x1f\x64\x57\xe5\x88\xf1\xe3\x2a\xb8\x46\x21\x04\xf6\x83\x4a\x0c\xc5\xc4\x98\x7a\xbe\x73\x1b\x71\x01\x3d\x3f\x37\x09\xb4\x12\xad\xe8\x2d\x04\xc5\xe0\x96\x56\x08\x32\x2a\x59\xff\xcb\x83\xc2\x0e\xd9\x3e\xa2\xbb\xa4\x96\xc1\xdb\xe1\xc7\x84\x83\xc1\x00\x9a\xf0\xc3\x0f\x60\x1d\x3b\xcc\xfc\xf1\x3b\x5a\xa0\x96\x2b\xad\x90\xcc\xe6\xad\xea\x92\x03\x9e\xe9\x2f\x31\xc2\x32\x38\x9d\x20\x31\xee\x33\x34\x9a\x78\x61\x90\xcc\xb8\x9d\xac\xaa\x50\x26\x20\xab\x0e\x2b\xc5\x43\x1e\xd0\x8f\x08\xcd\x45\xfe\xea\x12\x1d\xf7\x1f\x7e\x48\x26\x41\x88\xb1\xba\x1d\x45\xb3\xf9\x14\xdd\x06\xe9\x5d\xff\xa0\x60\x0e\xc2\x1f\xcc\xc8\x35\xac\x3a\x7c\x44\x77\xd4\x0c\x2a\xc6\x4e\x35\x3a\xfb\xfb\x10\xa3\x59\x74\x8d\xc0\x9b\x4e\x09\x55\x92\x3a\xa0\xdb\x11\x9a\xa7\x64\xa7\xcf\x1e\x29\x2b\xa6\x13\x74\x07\x21\xa2\xa4\x1d\x22\x06\xc8\xc7\xa4\x5b\x78\xd3\xe9\x1d\x0c\xef\x08\xed\x31\x9d\x59\x9e\x68\xc2\xd0\xbf\x61\x05\x26\x08\xaf\x6a\x3b\x1a\x8d\x20\x47\x0c\xf8\xf4\x09\xf7\xa5\x11\x84\x3e\xba\x7d\x33\xae\x91\x78\x44\x78\x4e\x5c\x3e\xd8\x21\x6c\xb8\xa7\x3e\xb0\x86\xbc\x94\xfb\x88\xee\xde\x37\x84\xa0\xd2\xde\xb8\x2c\xcf\x20\x5c\xd1\xe1\x82\xe3\x76\x82\x11\x03\x5d\x34\x9d\x12\x16\x1c\xd1\x3b\x78\x10\x85\xcb\x89\xd5\xc2\x65\x38\xa7\x59\xc5\x2e\x14\x1a\x4e\xc1\x34\xf3\x08\x7a\xb9\x06\x14\x74\x4a\x50\x9a\xa3\x30\x6e\x4a\x3a\x69\xaa\xc3\xa8\xf1\xf6\xf4\xfc\xdd\xe5\xdb\x37\xaf\x5e\x9d\xfe\xfc\xfc\xf2\xdd\xe9\xeb\xa7\x6f\x7e\x79\xa7\xb6\xae\xb8\x8c\xbc\x61\x6b\x61\xdb\x37\x7c\x21\x6b\x96\xd3\xf6\x05\x77\xec\x89\x97\x7a\x30\x80\x8b\xe2\x2c\x12\x05\x4e\x49\x68\xb6\xd2\xfb\x8a\x92\x4c\xb4\xd5\x98\x2f\x92\x49\xad\x38\xf5\xf9\x46\x49\x59\xed\xd4\x4f\x68\xad\x8f\xe8\xce\xba\x2f\xc3\xf4\xcd\x1a\x5b\xd3\xa0\x39\x6d\xf6\x44\xab\x65\xbe\xda\xdf\x87\x99\x37\xcf\xad\x60\x01\x99\x91\x44\x48\x93\x99\x91\x9b\x75\x79\xfa\xbf\xf6\xe6\x2a\xc3\xb1\x74\xda\x5a\x08\xf3\x47\x77\x91\x44\x24\xeb\x07\x83\xc0\xbd\x20\x10\x02\xa2\x5f\x72\x5a\x5f\x90\x8a\x25\x3f\x9f\x1d\x85\xf6\x58\x8e\xa1\x28\xbf\xad\xb6\xcf\x8d\x0a\x3b\x5d\x4d\xd4\xdf\x77\x6f\x9e\xbc\xe9\xf3\xe9\x01\xd3\xe8\xea\xbf\xb4\x9b\xe2\xc8\xb6\x2d\xde\xe4\x46\xd8\xc1\xde\x5a\xda\xbf\x42\x79\xe5\xa2\xc8\x92\xd2\x8d\x99\x37\xaf\x59\xfd\x49\xf9\x87\xdc\x1e\xf2\xb3\x61\xc5\x83\xcd\x8c\x4f\x81\x42\xe3\xe4\x1f\xc2\xa9\x1f\x11\x24\x8b\x98\x1c\x80\xf2\xf5\x21\x48\x20\x49\x03\xcc\xa7\x74\x89\x46\x3e\x78\x63\x12\xba\x22\x8e\x83\x6b\x6f\x5a\x50\xd0\xd4\xc0\x31\x1d\x49\xbc\x48\x3a\xf3\x03\xff\xbd\x16\xfd\xac\xdf\x8d\x51\xe6\x7c\x99\xaf\x2c\xde\xd8\xa3\x2e\x94\x6c\xd5\x39\x92\x03\x9a\x26\xc8\x0a\x63\xec\x4d\x93\xa2\x0b\x3e\xa9\xbe\xc3\xc2\x78\xb8\x0f\x0e\x83\xf8\xdd\x77\x5a\xbc\x96\x00\x8a\x47\x9c\xb0\x99\x24\xa2\x34\x46\x50\x0d\x21\x08\x9f\x13\x10\x55\xc6\x65\x09\x4b\x29\xa8\x19\x5d\x22\x0d\x01\xa5\xa1\x4b\x49\xde\x59\xa9\x9d\xc7\xd7\xc1\x12\x0b\x4a\x97\x29\xbd\x79\xa9\xe4\x96\x72\x56\x0e\x3e\x4b\x4b\xe7\x9d\x53\xe8\x59\xed\xf7\x7d\x68\x1d\xd1\x1b\x65\xaa\x1c\xf1\xec\x98\x16\xff\xa6\xe1\xb9\xd9\xf9\xf1\xf7\x7d\xe8\x48\x71\xa3\x0e\xdd\x9d\x5a\x4a\x69\xb2\x50\x9a\x06\xe1\x95\x25\x30\x18\x91\xe7\x2c\x0c\x09\x09\xb5\x02\x03\x11\xf6\x45\x25\xfa\x59\xd1\x2c\x2b\xa6\x70\x57\xaf\x10\xc0\x9c\x61\x56\xa0\x60\xd6\xeb\xa3\xad\x2b\xcf\xd6\x95\x67\xeb\xca\x93\x25\x0c\x64\x5b\xf9\xf5\x24\x0d\x5c\xe5\x92\x9c\x26\x07\x7d\x31\x05\xbd\x5c\xa5\xfa\xbd\x3a\x2e\x5a\x5a\x86\xcd\xf0\xb1\xef\x27\x64\x68\x84\x26\xe1\x85\xc4\x5e\x9f\xc0\x82\x2a\xe5\x2c\xf2\x4a\x1d\xab\xe4\x41\x4a\x92\x96\x00\x49\x42\x4d\x55\x1a\xaa\x7e\xe5\x54\x26\x79\x27\xcc\x4c\x21\xf2\xdb\x82\xdd\x1b\xeb\x2d\x9a\xfd\x32\xd7\xf3\xb9\x3d\x45\x96\xc0\x24\x0e\x77\x39\xde\x0b\xae\x12\x85\x2c\xe6\x46\xae\x02\x53\xa3\x31\x0f\x98\x4e\x8b\xac\x8a\x31\x55\xb4\x38\xb9\x12\xc5\x92\x6b\x74\x9f\xe6\x9f\xbc\x86\xa0\x55\x04\x1c\xd5\xe0\xfd\xfd\x1f\x7e\x20\x5d\xa3\x96\xf6\x20\xbc\x22\x8b\x90\xba\x97\x5c\x5b\xe7\x11\xcd\x09\x45\x94\xf5\x15\x66\x2e\x60\xe1\x42\x31\x6d\xb3\x44\xc9\xf4\x6e\x15\x1b\xa7\xec\x8a\x15\x01\xad\x0e\x9e\xb6\x32\x11\x85\xc2\x3c\xf5\x12\xd2\xf2\x79\x8a\x59\xf3\xbb\xc1\x20\x1b\x63\x3d\xf9\xf7\xf7\xc9\x08\xe4\x59\x9f\x48\x9c\x34\x5e\x60\xcd\x3e\x4e\x52\x48\x22\x7a\xad\x68\x3e\x27\x8b\x19\xb1\x8a\x79\xe1\x5d\x3a\x09\xc2\xab\x3a\x0c\xd1\x18\x8b\x42\x2a\xea\xb8\xaf\x16\x21\x4d\xe1\x28\x47\x81\xf8\x77\x0a\xcc\x7f\xf8\x01\x54\x23\xa3\x19\x03\xfe\x29\x68\x6e\x18\x7b\x35\xc5\x8b\x3d\x8f\xe8\x82\x12\xa2\xdb\x14\x4e\xde\xfe\x02\xa3\xbb\x11\x56\x0a\x78\x9f\xc9\x30\x88\xa5\x98\x74\x8b\xf4\x9f\xb9\x9b\xcf\xe3\x68\x84\x05\x79\x42\x49\xa5\x6f\x4e\xb2\x3f\x19\xd4\x27\x4b\xaf\x38\x1f\xe9\x8a\x7f\xae\x43\xd3\xdc\x6b\x05\xbd\x29\x9f\xb8\x6b\xe0\xca\x6b\x02\xf9\x27\xa4\x95\xe2\xbd\x08\xe9\xe8\x4b\x75\x35\x82\xbb\xaf\xd3\x50\x60\x6c\x89\x32\x45\x00\x2b\x79\xb0\x7f\xae\x67\x9b\xbf\x53\xbf\x9e\xc9\x3f\xf6\x5c\xb6\x5c\x53\xd3\x1f\x11\x9e\x79\x82\x95\x2f\x0a\x65\x76\xec\xfc\x55\xcd\x7c\x96\x51\xed\x3c\xd5\x5c\x1e\x2e\xea\xfe\xa5\x2a\xb4\x17\x58\x7d\xce\xcc\xe5\xb0\x4b\x17\xb3\xdd\x5d\x55\x0d\x21\x46\x54\xb6\x38\x52\xc2\xf3\x7d\xe1\xcc\x3f\x52\x24\x39\x15\x05\x8b\x1c\xa2\xda\xd7\x4a\x2b\x92\xf0\xd6\xcf\x97\x90\xee\xad\x6a\xb5\x79\x41\x5d\xf9\x22\x5f\x86\xa6\xdb\x7d\x1c\x2c\x4a\xc4\xcb\x32\xc7\xe4\xa9\x43\xed\x84\xba\xee\x2f\x8b\xb3\xcc\x5c\x0e\x5b\xa4\xd2\xe4\x10\xa7\x84\x35\x69\xf4\x95\x63\xa3\x1d\x66\xcb\x36\x49\x60\xed\x70\x0d\x82\x6c\x21\x0b\x49\xda\xb3\xfd\xd4\xa3\xed\x7e\x6a\xbb\x9f\xda\xee\xa7\x94\x57\x23\xd2\xd8\x0b\x93\xb1\xfe\x52\xc4\x46\x62\x8d\x9c\x0e\xbd\x30\xbf\x81\x0a\x86\x5e\x39\x92\x15\xba\xa5\x87\x7a\xa6\x20\x56\xe7\x33\x2f\x4e\x9f\xb2\x82\xaa\x20\x56\x76\xb7\x0c\x62\x1c\x26\x28\x71\x62\xc8\x15\xf2\xfd\x63\x5b\x24\x55\x41\xb1\x43\x3a\x4f\xe3\x20\xbc\xfa\x4c\x82\x09\x18\x0b\xe0\x9d\xd9\xd0\x0b\x95\x65\x7e\xf5\xa6\x0b\xf4\x19\xae\xf1\x1f\x76\xda\x8d\x1b\x1d\xa3\x18\xb9\xfa\x12\xd5\x6d\xde\x8a\x24\x60\x32\xeb\x49\xde\xd9\x23\x48\xd2\x3a\xc1\x1f\x6f\x1f\xeb\x14\x0b\x83\xce\x40\x0c\xf3\x74\x4c\x43\x74\x43\x68\x59\x2b\xdf\x87\x26\x0a\x34\x2e\xc6\x4d\xb8\x35\x93\x5b\x37\x06\x44\xb2\x9b\xd7\x1e\x04\xd4\x0a\x4b\x9b\x60\xf1\xb0\x4a\x37\xcf\x95\xa7\x66\xac\xb5\x27\x41\x8c\xf5\x72\x63\x78\x02\x4e\x88\x77\x11\x8b\xae\x95\xa3\x02\x01\xc4\x9a\xae\xed\x94\x29\xe2\x74\x44\x6a\xdc\x1b\x91\x3b\xde\x22\x1e\x1a\x89\xa4\x2d\x45\xfc\xaa\xed\x90\xd6\x59\x87\xc2\x24\x0d\xd2\x05\x89\xc3\x60\xf2\xfe\xf7\xd1\x3c\x4a\x82\x34\xd7\x11\xd6\x84\xe8\x01\x01\x38\x9a\x06\x28\x4c\x1d\x3c\x44\xdd\xd1\x2a\x79\x7c\xb2\x86\x4d\x43\x50\x19\x5f\xc3\x00\x98\xb4\x64\xbb\x48\x10\xb3\x62\xbc\x08\x7d\x72\x11\x68\x84\xe2\xd4\x0b\x04\xfb\x39\x4b\x08\xc1\x4e\xab\x8a\x8a\xaf\x42\x4a\x88\xde\xac\x24\x2e\xf8\xbd\x58\xcc\x4c\x09\x0a\x7d\x29\x5e\xb8\x6a\xa7\x25\x22\xe0\xa5\x91\x62\x7b\x85\x9b\xed\xd3\xc6\x55\x93\x6a\xba\x40\x7d\xfa\xa7\xb4\xf9\x52\xf1\x4e\x55\x46\x61\x2c\x9b\xf1\x09\x49\x10\x1c\x8c\x80\x2f\x49\xc0\x97\x2a\xa8\xcd\x16\x49\x4a\x14\x17\x34\x43\x61\x2a\xb8\x7d\x78\x97\xa2\xa4\xd3\xde\x61\x3b\xca\xef\x76\xac\xbc\xc5\x6a\x7e\x49\x8e\x4a\xf4\x2c\xc5\x01\xd2\x49\x09\x8b\x30\xf8\xf7\x02\x41\xe0\xa3\x30\x0d\xc6\x81\x66\x8d\x5c\x92\x0f\x39\xb9\x5d\xb8\x8f\xa0\x63\x59\xb4\x3c\xa2\x59\x48\x7a\x86\xe6\x06\x37\xe6\x54\x3e\x90\x35\x6f\x18\xec\x34\xbc\x14\xaf\x70\x0d\x3e\x88\xbc\x35\x05\xfb\x6e\x92\x41\x4b\x9b\x26\x3e\x56\xc6\x00\x90\x65\x9d\x09\x6f\x98\xc8\x1e\x0a\x8b\x57\xfc\x23\x4b\x91\x7b\xd0\xac\x72\x06\x07\xc5\xc3\xac\x83\x56\xf5\xcd\xd7\x3f\xe5\x73\xd0\x08\x2b\xbb\x63\x6f\x94\x46\xf1\x5d\x9d\x1e\xb7\x29\xf7\xd3\x78\x57\x1b\x8d\x41\x90\x62\x30\x80\xef\x69\x8c\xf2\xef\x75\x7e\x88\x27\xd1\x6c\x16\x85\xff\x3a\x57\x18\x77\x8a\x74\xcd\xbe\x31\x54\x78\x4f\x6a\xdf\x63\xca\xc6\xe8\xfb\x9d\x3a\x48\x8f\x50\x38\xda\x1b\x7a\x09\xea\x75\x0b\x2f\x66\xfe\x41\xb1\xe8\xf5\xfc\xa3\x3f\x2e\x3c\x1c\x05\xf3\x09\x8a\xf7\x28\xe4\xd2\xe2\x46\x0e\xf7\xe5\x5e\xd3\x33\x48\xda\x69\x4e\xbb\xef\xe1\x87\x1f\xd8\x8b\x86\x37\x53\x1f\x7f\xef\xef\xc3\xf1\xeb\x27\xe5\xe7\xb4\x5a\xed\x82\x77\x8e\x1e\xd9\x66\x7d\x22\xbf\x71\x57\xe8\x0b\xda\x03\xf2\x5d\x46\xfc\xbd\x18\x38\x4d\x0f\xd4\x18\xd1\xf8\xa4\x50\x1b\xc6\xd1\x4d\x82\x62\x85\x81\x44\x8c\x41\x14\xa5\x8d\x93\xf8\x6e\x9e\x46\xff\x3a\x37\x39\x6a\x13\x23\x85\xac\x92\x88\x4a\x65\x73\xb3\xcd\xde\xb9\xbf\x8f\xd7\x81\x38\x1d\x2d\xd2\x44\xad\xc6\x9d\xc0\x00\x78\x03\x0a\xed\x8c\x14\xb9\x9c\x06\x43\x5c\xac\x31\x0d\x86\x9a\x32\xc4\x94\x7f\x42\xe8\x89\x4b\xe2\x1a\x0d\xe9\x99\x16\xb2\x37\xbd\x8a\x08\x68\xfc\x45\x69\x5d\xdd\xdf\x87\x57\x51\xf4\x71\x31\x87\xd4\x1b\x4e\x91\xa6\x1b\xe7\x8f\xdf\xfc\xae\x32\xcd\xf1\xf7\xa7\x3f\xff\x7a\x69\x2b\x73\xfe\xcb\xe3\xcb\xd7\xa7\xbf\x5f\x36\x5d\x0a\xb5\x5c\x0a\xb5\x5d\x0a\x75\xac\x78\xbb\xe0\x25\x17\x34\xe2\x26\x17\x34\xe2\x27\x17\x14\x38\xea\x64\xd3\x7c\x91\x22\x98\x9a\xc7\xc9\xc5\x38\x2f\x41\xf3\xa3\xc5\x10\x6f\xfa\x31\x34\x75\x61\xb2\xca\xea\xba\x00\xb2\x93\x64\x40\x92\xc3\x40\x00\x3f\x42\xfb\xa0\xf7\x4f\x08\x76\x77\x8d\xe7\x03\x64\x3f\x06\x3f\x42\xab\x7d\x64\x3d\x47\xf0\x2f\x82\xf7\x30\xc0\xb0\x7f\x24\xc7\xa0\xba\x72\x7a\x39\xa2\x80\x56\xa3\xe0\x76\xe0\x0f\x68\xde\xb6\x5a\xaa\x69\x27\xe0\xaa\x5f\x29\x3d\xe9\x80\x92\xf8\x37\x6f\xfa\x11\x9e\x3f\xab\xb5\xff\x38\xd2\x1c\xfd\x60\xa2\xdd\x1a\x32\xea\x90\xf7\x81\xa1\xc0\xd2\xa4\x97\x38\x20\x19\x46\xb7\xfa\x82\xc4\xf9\x11\xe3\x78\x1b\xc0\x1f\x50\xbb\xcd\x28\xc6\xbe\xb7\xa5\xef\x1d\xe9\x7b\xd7\x74\xcc\x43\x20\xd6\x92\x5b\xf8\xe9\xa7\x9f\xe0\x88\xd4\x4a\x6e\xe1\x07\x68\xde\x8e\xc7\x74\x34\x7a\x1d\x43\x75\x2c\x63\x2e\x6e\xf1\x08\x26\xb7\x86\x62\x5c\x1c\x5d\x24\xa4\xec\xad\xed\x34\x8d\x53\x64\xb6\x98\xa6\xc1\x7c\x1a\x8c\x88\x65\xd4\x4c\x9b\x5b\x3c\xbb\xfd\x8b\x92\x27\x69\xa9\x5c\x97\x96\x6b\x5b\x0b\x1e\xd1\x82\x5d\xbd\xfb\x62\x01\xdd\x64\x31\x04\xb2\x65\xa8\xc3\x2c\xb8\x85\x51\x34\x5d\xcc\xc2\x44\x2b\x20\xe4\xc6\xb0\xde\x5c\xf3\x09\x7d\x1e\xe2\x29\xd0\x6c\xf1\xb1\x60\x3f\x9b\xad\x66\xd3\x34\x90\x42\x6a\xd2\xe1\xa8\xa5\x84\x25\xba\x3b\xf0\x09\x7f\xa7\xa3\xeb\x50\xbd\x25\x57\x6f\xf5\xa4\xea\xad\x9e\x4b\xfd\xb6\x5c\xff\x48\xaa\xde\x36\xb2\xa1\x10\xbc\xb4\x7a\xea\x4a\xf1\x20\xbc\x96\xa9\x8e\x7f\x2e\x45\xf9\xdb\x23\x89\xce\x6c\x52\x75\xd9\xa3\x26\x7f\xd0\xce\x0d\x8d\xf3\xc8\xe4\xd6\x34\x36\x03\xaa\x0e\x4f\x6e\xb9\xcb\xc1\xa8\x30\x46\xb9\xa5\x30\x07\xc4\x7d\xa0\x72\xab\x24\x83\xe1\x3c\x5a\xe4\x60\x9c\x9c\x3a\xaa\x62\xd4\xf1\x0f\xb1\xc7\xa9\x3d\xc9\xe5\x0f\x13\x85\x83\x35\x2c\x42\x04\x54\x1b\xfe\x00\xff\x02\xff\x77\x7b\x04\x7f\xc0\x6d\xfb\xbd\x32\x3c\x65\xae\x5e\x00\x7f\x0c\x48\xa5\xdb\xc0\x58\x58\xbf\x66\x95\x1e\xd5\x76\xd4\xa7\xee\xfb\xfb\xf0\x36\x46\x23\x4a\x49\x1f\xce\x46\x51\xc8\x14\x10\xb5\x3a\x73\x76\xf2\xe6\x67\xac\x2b\x34\x6f\x9b\xcd\x3a\x34\x6f\x9b\x2d\xf2\x6f\x9b\xfc\xdb\x25\xff\x1e\xd5\x09\x03\xe3\x7f\xdb\xe4\xdf\x2e\xf9\xf7\x88\xfc\xdb\x1a\xe2\x7f\x3b\x3d\x8d\x16\x94\xb3\xbe\xf0\xcf\x43\x38\x7e\x7a\x4e\x93\xe3\x02\xdd\x6c\x00\xd6\x73\xe3\x20\x9d\xcc\x1a\xaa\xf2\xfb\x6a\xdc\x31\x94\x01\xd3\x96\x1b\xf4\x87\xa4\x5c\x37\xd0\x6d\x6a\x08\x19\x79\xe9\x47\x67\x28\x41\x69\xdf\x74\x70\x5a\xa0\xec\xf9\xc7\x60\xce\xee\x03\x46\x63\x08\xcf\x22\x62\x32\x9a\x78\x09\x0c\x11\x0a\xc9\x5d\x6d\xe6\x9a\xe2\x85\x3e\xb9\x82\xe2\x07\x3e\x84\x51\xca\xae\x36\x99\x99\x99\x1c\xb5\x5e\x72\xa8\xfc\x16\xd7\xe5\x47\x74\xf7\x36\x0e\xa2\xf8\x8c\xde\xff\x1b\x0c\xb2\xe7\x56\xe6\xd7\x5e\x28\xe0\x1f\xad\x3a\x04\xd6\x6d\x12\xff\xf0\xdb\x36\x03\x35\xbe\xd9\x53\xcb\x3a\xfa\x11\xdd\xfd\x16\xc5\xe4\x5a\xcf\x47\x74\xd7\xb8\xc1\xdf\xed\x55\xce\x83\x3f\x11\xab\x91\x04\x57\x8f\xb1\x7c\x87\x7d\xe8\x3a\x8b\x1a\x71\xbb\x3a\x26\x64\x37\xb7\xc7\xc7\x86\x77\x2a\xfb\xcd\x31\xd9\x85\x5e\xf5\xa6\x31\xf5\x92\xd1\x04\xf9\x8b\x29\x02\xbc\x63\xb6\xf4\x3a\x39\x8b\x6e\x70\xa3\x35\xde\xfe\x2e\x56\xf0\x1e\xba\xf7\x5a\x6e\xd0\x4e\x61\x8e\x99\x34\x94\xd2\x33\xed\x2e\x03\x72\xd7\xb1\x30\xce\x54\xe5\xa5\x5f\x7f\x64\xdd\x60\xbf\x2d\xda\x2f\xb0\x29\x22\xea\x52\x72\x5b\xeb\xe0\x8f\x84\xee\x05\xa9\xff\x9e\x8e\x17\x61\x36\xf6\xc4\x22\xc0\xdd\x96\x07\x90\x34\x85\x52\xa3\x24\xb8\xb6\x71\x7c\xe4\x7e\x7e\xc7\x7a\xfa\x0f\xd1\x53\xa7\xae\x02\x1d\xe6\xb3\x28\x05\x3c\x7d\xdc\x2a\xa4\x86\xe5\xdd\xb9\xc9\xf3\xc5\xb0\x6a\x93\x44\xd1\xe7\x8d\xbd\x97\xf4\x1c\xf2\x22\xd3\x54\xd8\x2e\xe3\xbd\xa4\xc5\xc8\x25\x8e\x72\x05\x48\x17\x18\x64\xf6\xb8\x4a\x37\x5e\x07\xb7\x64\xc9\x74\xed\xc6\x1f\x03\xb2\x80\x5e\xb0\xe1\xda\xcf\x18\xf3\x13\x34\x59\x9f\x2c\xbc\x05\x79\x6b\x20\x17\x24\x3f\x41\x0f\x2f\x01\x05\x36\x80\xc1\x00\xba\x55\x58\xe1\xeb\x1e\x17\x2b\x5d\x5c\x86\x4e\x37\xbf\x4b\xf3\x8f\xd1\xf0\x3d\xfc\x01\xba\x3b\x57\x52\xc3\x4b\xe1\x54\xd8\x72\xb8\xcb\xd9\x20\xbc\x7e\xa9\x10\xb5\xa5\xc7\x6e\xd2\x16\x57\xcb\x04\xae\xf8\x95\xc9\x5c\xfe\xc8\x41\xec\xca\xd2\x9b\xad\x3c\x7b\xa2\xbe\x75\x6e\x11\x8b\x11\x6f\xff\x1f\x8e\xbc\xab\x15\xa2\x5f\x46\x4e\x77\xad\xcd\x54\xea\xf5\x8f\xd0\x25\xe1\x08\xe8\x0f\xd7\xf9\x9b\x1f\xf9\x0b\x0e\x8d\xef\xa3\x8c\xe8\x39\x53\xc1\xd0\x48\x7e\x37\x9a\x97\x09\x78\x06\xe5\x77\x9a\x1a\xd1\xf0\x1e\xfe\x70\x13\x3c\xf9\x2d\xa7\x5a\x8c\x14\x5b\xed\x5c\xe4\xe5\x89\xc3\xa8\x55\x7c\xf5\xb9\xae\x1b\x68\x14\x8e\xe2\xbb\x39\xbd\x6e\x26\x6f\x20\x5e\xd7\x21\x1a\x8f\x13\xa4\xbf\xf5\x07\xdc\xa7\xf2\xd2\x8f\x4e\x04\x8c\xac\x62\xbd\xac\x64\xd5\x33\xa3\x4d\xf6\xb5\x95\x7d\x6d\x67\x5f\x3b\x75\x22\x64\xb5\x77\x1a\xb4\x1d\xf2\xd1\x2a\x1d\xc2\x0b\xcd\x8d\x37\x87\x76\xe8\x93\x9d\x4f\x37\x9d\x38\xe8\xb1\x78\xfe\xbd\xbe\xa0\xe0\xb1\x0a\x6b\x1a\xc0\x5c\xb9\x7c\xbd\x8e\x63\xbd\x8e\xdd\x06\xe1\x32\x30\xf9\x39\x53\xcf\x4f\x94\xfc\xcf\x56\xfe\x67\x3b\xff\xb3\x53\x17\xf6\x4e\xeb\xb5\x81\xd3\xf0\x1a\x92\x6f\x87\xc2\xaa\x72\x7a\xde\xcb\x11\x5c\xc9\x7c\x75\x58\x6d\x3a\xd8\x78\x97\x6d\x72\x97\xda\xfc\xd9\x86\xee\x39\x62\x19\xb7\x88\x23\x14\xdd\x66\xe2\xee\x58\x2c\xf8\x4d\x69\x08\xb0\xe4\x93\x17\xaa\x52\x42\x96\x52\xed\x56\x71\xe0\x0b\x10\x8c\x9c\x40\x20\xb4\xf3\x10\xda\x45\x08\x56\xb3\x78\xd2\x29\x32\x51\x01\x42\xc7\x6a\x2f\x7f\x59\xd8\x17\xdb\x6d\x82\xb2\xb2\x62\xdd\x0d\x9f\x59\x76\xfc\x42\x9d\xa2\x63\x36\x80\xd6\x3f\xd9\xd7\x1f\x39\x33\xb0\x07\x0e\x6a\x14\x61\xb3\x60\x9c\x92\x59\x5b\xd7\x1c\x01\x38\xb3\x08\xef\x6b\x8a\xd9\x44\x32\x1a\x37\x85\xda\xfe\x87\x64\xaa\xaf\x25\xad\x92\xd2\xfe\x87\x64\x8a\xaf\x25\xed\xa2\xca\xfe\x87\x64\x6a\x4f\x3a\xd2\xe3\x92\xc2\xb4\xbb\x6b\x5b\x78\x09\xa2\xad\x3c\xa2\x2d\x0d\xa2\x6d\x0b\xa2\x1d\x23\xa2\xcd\x35\x20\xda\xce\x23\xda\xd6\x20\xda\xb1\x20\xda\x34\x22\xda\x5a\x03\xa2\x9d\x3c\xa2\x1d\x0d\xa2\x4d\x0b\xa2\x2d\x23\xa2\x6d\x3b\xa2\x56\xbe\xff\x65\x4e\x5c\xca\x93\xd4\x4b\x0d\xbb\x20\xfc\x21\x52\x2f\xd5\x9d\x9d\x8a\x52\x98\x97\x52\x93\x39\x9f\x94\xc2\x03\x99\xb6\x6d\xa5\x30\x15\x53\xd3\xd1\xa5\xdd\x42\xaa\x9c\xd5\x15\x64\x3d\x99\xc4\x35\xba\x79\x96\x66\x70\x71\xe3\xad\x98\xc4\xc5\x9d\x77\x79\x1e\xe7\xb6\xde\xd9\x44\xde\xa9\xce\x77\x62\x16\x73\x4c\x5b\x7a\x4c\xcb\xb3\xb8\x84\x69\x69\x22\xe7\x31\x6d\xae\x8a\x69\x5b\xc2\xb4\xad\xc7\xb4\x3c\x8d\x4b\x98\x96\x66\x72\x1e\xd3\xd6\xaa\x98\x76\x24\x4c\x3b\x7a\x4c\xcb\xf3\xb8\x84\x69\x69\x2a\xe7\x31\x6d\x3b\x60\x6a\x66\x75\xc4\x13\x5e\xda\xd5\xc1\xf7\xb6\x99\x5c\xd4\x37\x8d\x13\x3a\xaf\x89\x58\xe6\x75\x49\x23\xd5\x4d\x6f\xbd\x4a\xca\x4c\x45\x7d\x68\x1f\xf4\x60\x1f\x3a\x6d\xc5\x09\x9c\xe6\xfc\x4d\x73\xe2\xc5\x75\x4c\xa1\xde\x26\x2c\xd0\x16\x3b\x01\x7b\x90\x00\x75\x75\x84\x00\xab\x38\x63\x6f\x84\x94\x47\x61\x4a\xe0\xff\x8d\x6e\xbd\xd9\x5c\x69\x63\x52\x57\x00\x1e\xa7\x80\xb4\x9d\xa2\xdb\x54\xf2\x77\x6b\x1c\x3f\x3d\x6f\xb0\x1d\x6e\x6d\xc6\xef\xb3\x7e\x44\x77\x75\x18\x8d\xaf\x94\x7b\xcb\x0c\xe2\x7c\xea\xe1\x0e\xdc\xa6\x50\x84\xc8\xb6\x98\xb5\xac\x4d\x1b\x50\xc5\xb1\xdf\x89\xe2\x9c\xef\x92\x66\xea\x7a\x81\xa6\x73\x14\xd7\x8e\x9f\x96\xdd\x08\xf9\x61\x69\xfe\x31\xf3\x0c\x96\x91\xcc\xdf\x38\x10\x5e\x9b\xd4\xff\x36\xe7\x18\xd9\x87\x83\x36\xf5\x96\x64\xbf\x3a\x45\x07\xcb\x3e\x1c\x74\x65\xdf\xca\x3e\x1c\xf4\x84\xd3\x65\x1f\x7a\xad\xcc\xc1\xb6\xbd\x46\x07\xdb\xaf\xd3\xb3\xf6\xfe\xfd\x5f\xbf\x4d\x4f\xd6\xb2\x38\x79\x08\xcc\x9f\x14\xf7\x0a\x46\xd1\x6c\x1e\x85\x24\xc1\x6d\xb1\x5c\x61\xf6\x08\x36\x9f\x06\xc3\x06\x03\xf1\xe9\x93\xec\x7d\x28\xe2\x20\xdd\xb3\xcf\xac\x97\xa0\xcc\x59\xd6\x53\x06\x37\xc3\xe5\x7e\x8b\x62\x9f\x44\xbd\x13\x85\xc5\x13\x1d\xe4\xc5\x98\xdc\x81\x20\xc2\xe3\x98\x7b\x1c\x64\x6d\x29\x5f\x6b\x7b\x82\x48\x24\x8e\x13\x2c\x29\x35\x65\x7e\x49\xc7\x47\x04\x3a\x0a\x47\x0d\xfc\xc3\xd0\xe3\x5e\x57\x94\xa4\x3f\x9d\x7d\x83\x55\xa5\x9e\x5e\xcf\x5f\x3e\x79\x96\x39\x47\xd0\xdf\xd5\x5c\x34\x86\x09\xbd\xf4\x82\xc5\x19\x77\xd3\x48\xd1\x6c\x3e\xf5\xd2\x2a\x4b\x93\xc8\x81\xf9\x9f\x90\x45\xb5\xe6\x67\x59\xe4\x62\xb6\x58\xfd\xc8\x59\x49\xf0\x27\x6a\xf0\x24\x4a\x7d\xe8\x42\xad\xd5\x3e\x82\x61\x90\x26\x2a\x7f\x4c\x25\xf0\xe0\x5a\x01\xfb\xf4\xd7\x75\x80\xbe\x7c\xfa\xf3\xc9\xe5\xef\xcf\xde\x9c\xbd\xbe\x7c\xfd\xe6\xc9\x53\x38\x26\xf1\x1e\x53\x2f\x4c\x21\x46\xf3\x18\x25\x28\x4c\x83\xf0\x8a\x1b\x87\xf1\x9c\x9a\x45\xbe\x9a\x56\x4a\xf8\x4f\x9e\x3a\xc1\x67\x0b\xa9\x11\xbe\xc6\x63\xa6\xe0\x8a\x2e\x7e\xaa\x59\xdf\xe2\x41\xa3\xe6\x1c\xda\xbd\x13\x12\x41\x6e\x11\x13\x87\x4c\x91\x69\x5f\x57\x5c\x0b\x46\xa2\x92\x98\xdc\x78\x8c\xe1\xdd\x04\xe1\x51\x4d\x23\x58\xb0\xcb\xdc\x58\xa8\x02\x2e\x4c\x9a\xd4\x36\xa5\x20\x0c\xfe\x8c\xc6\x57\x7d\x32\x0f\x79\x9f\x77\xb4\xba\xa1\xb1\xdb\x44\x13\x49\x28\x32\x6c\xce\x04\xa1\x0b\x47\xd8\xe8\x40\xef\x5c\x49\x44\xc0\xf3\x05\x53\xe1\x23\xba\xd3\xc2\x2b\x45\x7c\x1a\x8d\xaf\xa0\xf6\x86\x60\xe2\x4d\x77\x48\xfd\x91\x6a\xa4\x96\x20\xac\x1e\x07\x9e\x8e\x8b\x72\xdb\x67\xcc\xd7\x9c\x32\x98\xbb\xd5\x8a\xae\x05\x66\x92\x7a\x69\x30\xaa\x5e\x4f\xaf\x28\x9b\x2b\x42\x4e\x61\x96\x55\x5b\xee\x7c\xd6\xa0\x5a\xe8\x53\x3a\xd4\x51\x5c\x63\x2e\x26\x64\xb8\xea\xf0\x1f\x08\xae\xfb\x10\x5c\x67\xab\x96\x32\xc0\x14\x6d\x4e\xc7\xa4\xf9\x16\xfa\xb9\x50\xf0\x4c\x91\x36\x47\xc8\xcc\x92\xfa\x53\x58\xcc\xe7\x2c\x2f\xd8\x2c\x6a\xb9\x71\xe3\xb4\xc4\xe4\x70\x10\x67\xb0\x9d\x1c\x55\x60\x7e\xb5\x93\xe3\x09\xda\xf4\xe4\x10\x2d\xac\x6f\x72\xe4\x57\xe5\x4d\x4d\x8e\xd3\x30\x48\x03\x6f\x1a\xfc\x89\x12\xf0\x20\x44\x37\xd3\x3b\xd6\x25\x9f\xd1\x75\xf9\x89\xc1\x15\x8c\xdb\x71\x14\xcf\x5e\x47\x3e\x82\xa7\x34\x74\x0c\x49\x71\x99\xad\x4d\x51\x2c\x4f\x46\x72\x0f\x95\xc4\xe3\xe1\x53\x53\xe8\x23\xf6\x39\xf5\x4d\xce\xc5\x8d\xf3\x7f\xad\xfc\x42\x25\x7b\xd7\x3c\x33\x82\x30\xc8\x79\x39\x0b\x36\x90\x78\xd9\x72\x42\x7a\x3c\x9f\x63\x7e\x24\x23\x00\x2c\x80\xae\xe1\xe4\x8c\x4e\xa2\xf1\x15\x3f\x2b\x1d\x8d\xaf\xb8\x5e\xc5\x26\x8e\xd9\xce\x98\xe2\xad\x2d\xbd\x07\x1d\xc5\x33\xca\x7b\x9e\xcd\x94\x4e\x67\x6b\xc6\xe3\x83\x8c\xdf\x0d\xc6\x42\xe1\x6d\x41\x9d\x81\x5c\x4c\xa0\x01\x9d\xaa\xf4\xc6\xb7\x8d\x0a\xc4\x5b\x5c\x9b\x39\x64\x39\x59\x41\x3c\xab\xf3\xeb\x28\x89\x10\x9a\x08\xd4\xc8\x59\xcb\x17\x9e\x01\x4c\x4a\x99\x3b\xac\x65\xd2\xb8\xaa\x2f\x3e\x75\x2f\xf7\xbd\xd4\x83\x21\xd9\xbd\xe8\x4b\x6b\x76\x37\xa4\x49\x9a\x4b\x5e\x1d\x4c\xae\xd0\xe2\x5b\x14\x13\x76\x1c\x45\xe1\x28\x46\x29\xda\x63\xb4\x9f\x46\x57\xda\xd5\x56\x72\x2a\x39\xdb\x00\x27\x90\x18\xb2\x84\x06\x34\xce\x03\x13\xe6\xc8\x97\x64\x39\xf2\xd7\xa0\x52\x7d\x4a\x58\x88\x08\xdc\x18\x3b\xd0\xc3\x42\x99\xb7\xcd\x1a\x96\x9a\x5d\x41\x17\x92\x16\x0f\xd1\x06\x0d\xeb\xcf\x62\x5f\x06\xe1\xd5\x3d\x88\xf7\x8c\xb8\x03\xce\xec\x0c\x9f\xda\x03\x8c\x63\x29\x9e\x51\x35\x18\x37\xbc\xd7\x95\xe7\x0e\x83\xd0\x2f\x24\x77\xa3\xc3\xe4\x20\xda\xf3\x19\x96\x0b\x1f\xca\xbe\x1e\x29\x25\x83\xb5\xce\x16\x8a\x14\x78\xd7\x5e\x30\xf5\x86\x53\x44\x2f\xfc\x18\xe4\xa5\xac\x81\x5d\x72\xaa\xac\x77\xbe\x3c\x0b\x42\xa6\x63\x19\x55\x1f\xd6\xb8\x5e\x5d\xf9\x39\x4a\x59\x20\x3c\x9a\x8c\x82\x42\xcd\x94\x0f\x08\x12\x40\xe3\x31\x1a\xa5\xc1\x35\x9a\xde\x81\x07\x3e\x4a\xd2\x78\x41\x7e\xd7\x21\x46\x9e\xbf\x17\x85\x23\xb4\x92\xba\xe2\x3a\x47\x09\x7a\x5f\x7a\xa6\xd2\x46\xbf\xb6\xf9\xca\x47\xca\xb0\x3e\x39\x55\x5f\x65\xbe\x0b\x20\xcb\x4f\x78\x0e\x62\xb9\x19\xff\x2c\xe3\x87\x05\xa9\xa0\x2f\x1d\x8c\x9d\x01\x83\x59\x56\xe8\xaa\xd8\xdc\x2b\x96\x5c\x74\x49\xa4\x77\xdc\x4f\x26\x86\x90\xcf\x32\x4a\xf1\xd5\xf8\x99\xcc\x09\x56\x99\x54\x06\xb5\xfc\x91\x72\xab\x7d\x44\x8e\x94\xb5\x25\xa9\x09\xdb\xa1\x60\x61\xef\xd0\x87\x96\xbe\x68\x7e\x17\xdb\x07\x3d\x54\x17\x13\x4e\xa2\x3c\xcd\xf6\xaa\x9d\x65\x83\x93\x78\xe3\x86\x11\x36\xee\xef\x26\x48\xd2\x79\xe9\xb6\x0a\x3c\x98\x90\xc3\x5f\xbc\x0d\x5c\x41\x9a\xf1\xcd\xe7\x71\xc8\xf1\x27\x81\x3b\xb9\xc4\xc4\x3b\x10\x26\x32\x15\x04\xf8\x86\xec\x34\xec\xde\x6c\xf9\x40\xb0\x70\x94\x5e\xda\xad\x56\x16\x54\x39\x78\x7d\xa7\x50\x27\xf8\x23\x8a\x25\x68\x8a\x46\x29\x45\xee\x3c\x8d\xbd\x14\x5d\xdd\xd5\x5c\x2e\xbe\x4a\x07\xcb\x64\x6f\x37\x80\x07\x74\x75\x7c\xe0\x74\xfb\x82\x71\xc4\x5b\x2f\x49\xb0\x94\x7e\xec\x25\xc8\xd7\x86\xec\x91\x3f\xee\x77\x2f\x58\x13\xe7\x28\x26\x66\x1f\xac\x21\xb9\xb5\xb0\xa4\x2c\xe5\x92\x2c\x0b\x6d\x4d\x5a\x73\xbc\x42\xec\xd2\x23\x36\x4f\xe4\x45\xa9\xec\x3d\xe2\x7a\x77\x2d\xcb\xf8\xa0\x66\x00\xe1\xa0\x42\xbb\x51\x07\x37\x47\x95\xc2\x47\x2f\xae\xe5\x0f\x9b\xf4\xfd\x12\xf1\x0a\x4e\x2c\x6b\xeb\x5b\xde\x55\xa6\x0e\xce\x2e\x33\xc5\xee\x59\x58\xc9\xb4\x2e\xeb\x16\xb8\x9a\x2a\x2d\x42\x45\x67\xa8\xfc\xd9\x72\x92\xc6\xc8\x9b\xad\xf5\x88\x99\x6c\x35\xd8\x41\xb0\x7c\xd3\xba\xd3\xde\x1b\x06\xf4\x72\x6c\xde\x7e\x42\xf5\x70\x92\x7b\x54\x3a\x25\x6e\x41\xad\xd3\xd6\x1f\x12\x6b\x0e\x59\xcf\x49\x7f\x0a\x47\xad\xc5\x87\x8e\x21\x0a\x9e\x29\x34\x3d\x9b\x7e\xc7\x77\x5e\x73\x2f\x26\xc6\x20\xdb\xbe\xab\xac\x2b\x11\x43\x49\x76\xd3\x81\xef\xc5\xbe\xfb\xee\xc1\x78\xba\x48\x26\x0f\x96\xd0\x99\x28\xc8\xca\x5a\x93\x18\xc8\x3e\xb4\x56\x66\x3b\x62\x16\x0c\xbd\x19\x4a\xe6\x3a\xf7\x3a\xdd\xb9\xf9\xe5\x8c\x9a\x14\x4f\x1a\xec\xcb\x7f\xca\x29\x28\x4c\x4d\xe7\x39\x3e\x17\xfa\x82\x00\x34\x72\xbd\x06\x29\xc9\x07\x8e\x19\x3c\x4b\x01\xe6\xd8\x73\xf9\x60\x7b\x99\xc3\x7c\xf9\xe0\x8e\xa0\x3b\x8e\x62\x69\xf7\xbc\x36\xe5\xee\x38\x4f\x19\xeb\x11\x98\x80\x53\x72\x0c\x20\x33\xfc\x1b\x52\xc9\x38\x7b\x71\x8d\x8b\x9c\x84\x9e\x3c\x3e\x29\x9d\x2a\xf3\x25\x21\xb8\xa6\x11\x32\xd6\x79\x88\x9c\xc1\x76\x3e\x29\x13\x70\xf8\x09\x87\x04\x63\x73\x87\xc7\x82\x07\x33\x9b\xcd\x96\x07\x37\xcd\x83\xd9\xe1\xed\x9a\x78\x50\x79\x56\xbb\x04\x0f\x0a\x38\x9b\xe6\x41\xd3\x19\xed\x6a\xae\x0b\x5f\x1d\x0f\x6e\x8c\x97\x0c\x02\x63\x49\x66\x2a\x1e\x6f\x3a\x72\x10\x55\x6d\xc4\x91\xed\xc8\xb6\xf7\x62\xb7\x7f\xaf\x61\x00\xc1\xb5\x8e\xad\x56\x56\x51\x98\x82\x48\xf3\x98\x9e\x4c\xbc\x20\x0c\xc2\xab\xea\x6e\x7e\x8f\x89\x2f\xee\x25\xa7\x3a\x0c\xdc\xa2\x8c\x9a\x4e\x9a\x72\x0a\x0c\x86\x69\xe4\x78\xcd\x58\x65\xc8\x15\x94\x14\xe1\x76\xa7\x4f\x01\x6d\x5a\x1c\x1e\x9f\x70\x75\xc4\x60\x00\xd2\xe0\x94\xe3\x4a\x4c\xb6\xc7\x27\x16\x5d\xc9\x8c\x0e\x45\xe9\xad\xc8\xd2\x96\x72\xc3\x38\x9d\xd3\x5e\xca\x2e\x35\xeb\xf0\x04\xc3\x04\x03\xd5\x6c\xa7\xbb\x19\x61\x7f\x67\x9b\x9b\x88\xef\x69\x40\xbf\x38\x82\xd2\x4d\x84\xdd\x9e\xc1\x00\xd9\xd7\x9b\x09\x62\x79\xb7\x69\x1f\x48\x62\x35\xad\x58\x71\xe8\x80\x59\xbc\xd8\x01\x00\xbd\x86\x80\xf8\xe1\x19\xbd\xab\x8f\xbb\x4f\x85\x88\x88\x5a\x60\xda\x24\xeb\x18\x02\xb2\x23\xb5\xd2\x95\xf4\x3c\x74\xa7\x4b\xbf\xd6\x00\x6a\x50\x74\x1f\x91\x45\x93\xc3\x4d\xd0\x6c\xb3\x2b\xce\x18\xc4\x23\x97\xfb\x99\xbf\xbf\x39\x23\xb6\x4d\x36\x83\xcc\xe5\x6f\xa3\x98\x10\x25\x3b\x37\xaf\x43\x8e\x28\xf5\x0c\x1f\x9b\x89\x82\x21\x2b\x07\xd3\xa8\x95\x86\xcf\x8a\xfe\x19\x9a\x21\xb2\xbf\x27\xaa\x21\x65\x50\xe6\x15\x44\xcc\xb7\x24\x9a\xe4\x50\x9d\x93\x53\xfe\xf0\xbd\x2e\xba\xe6\x59\xa4\xe9\xe2\x99\x4c\x83\x11\xaa\xf1\xce\x89\xab\x65\x4e\xbd\xd4\x05\x16\x59\x5e\xcc\xf9\x68\x05\x31\x27\x34\xa5\xad\x98\xdb\x8a\x39\xf8\x9b\x89\xb9\x75\xc9\x09\x72\x69\x77\x12\x24\xd5\xa5\x84\x15\x45\x36\x3f\x89\x34\xfe\xfd\xcd\x99\x93\xf4\x94\x23\xf7\x94\xa5\xa7\x09\x40\x55\x51\x6e\x45\xff\x5d\x46\xd8\x21\x1a\x45\x33\x26\x15\xb0\x48\x0d\xa2\x45\xb2\x9c\x10\x16\xa4\x5e\xab\x98\x15\xac\xce\x89\x50\xd3\xf6\x7c\x2d\xc1\x63\xc8\xbe\x81\x6f\x21\xac\x61\x3a\x27\x51\x94\x20\x98\x05\xb7\x58\xf9\xb7\x50\x8d\xc4\x1a\x33\x6f\x75\x40\x9e\x41\x7c\xff\xe2\x32\x5f\xa2\x6b\xb2\x89\x1c\x47\x31\x24\x8b\x61\x82\xfe\xbd\x40\x61\x6a\xb5\x27\x43\x7e\xb7\x24\xae\x15\xae\x1c\x7f\x59\xee\x43\x81\x51\x56\xf0\x30\xc0\xea\x8f\xad\x4b\xaa\xb0\xfd\x99\xf0\xb1\x06\xef\xc7\x1f\xc2\x5f\xd9\xcd\xf4\xe0\x3d\xfc\x31\xa0\x30\x2e\x82\xe5\x62\x42\xab\x5f\xf0\x5b\xcd\x8f\x4f\x14\x40\xf5\x61\xa3\x35\xfb\xd1\xb7\x9e\xef\x63\x26\x5c\xd2\x6a\x3e\xf7\x7c\x62\x34\xa7\x7f\x2b\xda\xcc\xdf\xbe\x3c\x39\x87\xff\x75\xb0\x7f\x08\x73\x86\x45\xc2\x4e\xc9\xaa\x20\xf1\xf6\xe3\x28\x39\x24\xbb\xe1\xb9\xe7\x37\xf8\xaf\x25\x76\xc0\x6f\x3d\xee\x6b\xb9\x48\x78\xce\x71\x11\xb6\x9a\x5d\x37\x26\x99\x52\x05\xde\x6b\xb9\xca\x42\x5a\x94\x5d\x2d\x71\x37\xac\x00\xd4\x07\x61\x2c\x4f\x82\xe4\xba\x46\x80\x26\x22\x27\x1a\x26\x34\xc9\x8a\xf6\x8d\x98\x2d\x85\x7d\x49\x8c\x2d\xfe\x96\x79\x56\xd5\x0d\x59\x2d\xb4\xde\x94\x9e\x5f\xf4\xab\x5a\xfb\x72\x20\xc0\xd1\x80\xd5\x03\x69\x9c\x9c\xc2\x38\x2f\xc2\x54\x4c\x0a\x12\x70\xc6\xdc\x5c\xc8\xa6\x71\xa9\x35\xfa\x60\x8f\xb0\x41\x16\x3f\xfb\x1f\x85\x02\x56\x7c\xa8\x17\x0e\x47\xc8\x1c\x6f\x96\x04\x89\xa0\x25\x31\x97\x93\x30\xd6\x39\xf4\xb2\xc8\x27\xa5\xe7\x2c\xdc\x49\xe9\x39\x09\x72\x92\x7b\x5a\x0d\x65\x67\x6c\x95\x79\xce\xe5\x8f\x6a\xa1\xc8\x23\x06\x01\xec\xba\x04\x01\x95\x5b\xa5\xf9\xc3\xa5\x27\x4b\xec\x39\x0b\xbd\x81\x41\x76\x81\x9e\x9b\x62\xe5\x26\xeb\x79\xb4\xad\xee\xbe\xc7\xbe\x6f\xa7\x26\x61\xb3\x51\x14\x8e\xbc\x94\x37\xb6\x5e\x0b\xfd\x2f\xe1\x5c\x48\x69\x22\xe3\x26\x9e\x4f\x63\xf3\x33\xc9\x76\xbf\xa2\x7b\x11\x9a\x84\xf7\x57\x2f\x5a\x09\xfa\x2b\xb8\xad\x92\xfa\x45\xd9\x6a\x13\xa8\xcf\x51\x2a\x39\x70\xe4\x84\x1e\xc9\xc8\x08\x53\x2f\x49\xc9\xef\x6a\x32\x30\xdb\x45\x5f\xd4\xf2\xe2\x6f\x0f\x5a\x3b\x34\x0e\xd3\x7b\x16\x31\xc9\x1a\x49\x90\xaa\xca\x6e\xfc\x9f\xb5\x33\x28\xc9\x2c\x55\x25\xd5\xa1\xc2\xba\x7c\x0f\xbe\x26\x67\x1b\x5b\x48\x06\xbb\x17\x84\xca\x03\xc2\xd5\xd7\xe6\xcb\x06\x33\x78\x1d\xf9\xe8\x33\x3d\x24\x7b\x27\xcc\x4d\xd4\x11\x84\x58\x24\x24\xb2\x9c\x3c\x3e\x71\x81\xc8\x18\xe9\xb3\x98\x21\x18\x6e\x51\x6f\x2e\x43\x27\x13\xbb\xe2\x34\x26\xb1\x12\x18\x51\xa5\x9b\x7d\x86\x69\x8c\x7b\x46\x7a\xa2\x48\x9f\xca\x3f\x0c\x57\x23\x4a\x9f\xf5\x51\x19\x96\xbc\x3f\x46\x39\x52\x5f\xf0\x44\xba\xd4\x56\xe1\xaa\x98\x63\xda\x94\xfc\x15\x49\x4b\xa0\x38\xb2\x99\xc6\xf4\xd6\x1e\x3f\x42\xfe\xf0\x15\x97\x9d\xd1\xdb\x8f\x0e\x94\xc8\x78\xd0\x6c\x6b\x28\x5d\xb3\xe4\x7b\xf1\xbc\x33\xba\x93\x41\x02\xb7\x46\x34\x31\x62\x91\x26\x96\xce\x82\x7b\x8a\xdd\x66\xb0\xff\xd0\x8c\x56\xde\xf1\x7d\x07\x1e\xee\xaf\x84\x99\x30\xa1\xbb\x98\x50\x5e\x22\x34\x07\x2f\x85\x29\xc2\x6b\x54\x14\xf2\x99\x1e\x84\xd4\xca\x4c\xee\x25\x12\x9d\x91\x2c\x8d\xe6\xc5\x03\x84\x59\x65\x16\x84\xf4\x4a\x23\xb3\x86\x1a\x33\x5b\xe9\x5f\x31\x60\x94\x59\xa4\x0e\x53\x3e\x9f\x91\x0b\xc2\xd4\x2a\x18\x5c\xc3\x0f\x3f\x58\x4f\xe8\x8d\x11\x9e\xdf\xae\x6c\x69\xce\xd0\xcd\x9b\xc3\x9d\x6c\x9e\x46\xdc\x96\x71\xb2\x74\xdb\xe7\x65\xca\xb6\xb8\x07\xcd\x1e\xd9\x26\x25\xc7\xe9\xcb\x4c\xc6\xfd\x7d\x78\xeb\xf9\x44\x3f\x71\xda\x95\x90\x5d\x36\xbb\x4a\x43\xf6\xc7\xe4\x7b\x35\x6b\x31\x77\x53\xa5\x37\xc5\x5c\x8c\x8a\xcb\x39\xaa\xea\xa0\x6d\x4c\x7e\x7c\xc1\xbe\x59\x11\x21\x1b\xa2\x0a\xe3\x4a\x55\x7c\x15\x22\x4b\x5f\xe2\xda\x94\x3b\x30\xbb\x1d\xa5\xd0\x8e\x2b\x3a\xa3\xc3\x28\x9a\x4e\x11\x9d\xf7\xd1\x98\x2b\xa9\x64\x3b\x87\x52\x14\x2b\x35\x3d\xab\x72\x2c\x6d\x03\xa5\x20\x9b\x58\x29\x8b\xbd\x1b\xe9\x91\x35\x3a\x98\x3a\x92\x06\x0d\x1e\x2a\x54\xea\xaa\x90\x32\x07\xb1\x45\x42\x77\xbe\x59\x24\x52\x2c\xaf\x8c\xd7\x52\x75\x40\x13\x6f\x4a\x7b\x48\xbe\x10\xc0\xe4\x60\xcd\xa3\x99\xf0\x50\x1c\x5c\x53\x0d\x9a\x4b\x59\x2b\x70\xee\x16\x97\xed\xd5\xa5\xeb\x5f\xe6\x94\x81\x6e\xda\xf6\xaa\xbd\x37\x6b\xdd\xa3\x09\x9a\xad\xde\x86\x7a\x9b\xc5\x4e\xa8\xc9\xad\xe3\xb1\x04\xdb\x0a\xee\x19\x09\xe9\xf2\x19\x6b\x1d\x33\x2f\x4d\xd9\x95\x3a\x16\xd3\x83\x3f\x2d\x6e\x1a\x46\x51\x78\x8d\xe2\x34\xb7\x8b\x23\x13\x44\xdc\xf4\x23\xd7\xff\xe8\x15\xab\xea\x01\xe9\xde\x52\x50\xf9\xb0\x74\xe2\xe1\x8a\xde\xeb\xf6\xc8\x3a\xf9\xae\x2c\x6f\xfa\x11\xc1\x6b\x64\xfc\x0b\x97\x09\xbd\xf0\x8e\x0f\xd8\x3c\x4a\x92\x60\x38\x45\x6e\x12\xc7\x82\xc2\x7a\x62\xd7\x64\x03\x51\xbe\x1c\x48\xdf\x71\x5b\xa1\x6e\x0d\x7c\x28\xbe\x65\x92\xa9\x2f\x7d\xcf\x62\xdb\x58\x01\x7c\x44\x77\xfd\x7c\x38\x1c\x6b\x95\x42\xb0\x1c\x7b\x05\x2c\xaa\xfa\xe4\xdf\x0a\x95\x84\xe4\xe9\x97\x23\xfd\xd8\x6b\xb3\xcd\x70\xd1\x33\xd6\x5e\x51\xec\x90\xf3\xa6\xb9\x97\x27\xe7\x87\xf6\xca\xd2\xca\xd9\xb5\x97\x16\xc2\x41\x6a\x8c\x3e\x6b\xbc\x99\xa3\xf0\xfc\xfc\x95\x11\xc4\xea\x31\x8a\x64\x76\xb4\xef\x0a\x66\xc1\xed\x69\x98\xaf\xb3\xde\xcb\x07\x54\xfc\x25\xcb\xcb\x3f\x70\x12\x20\x65\xd9\x5c\x88\x81\xa5\x11\xcf\x8b\x64\x19\xdf\x73\x7e\xb3\x93\x87\x8d\x20\x4b\x37\xf9\x1e\x8c\x83\xa2\x70\x5c\x02\x7e\x3a\x89\xa3\x9b\x04\x9e\xc6\x71\x14\xc3\xe9\x18\x42\x94\x45\x1d\xcb\x7a\x18\x46\xf4\x49\x61\x05\x42\x31\x04\x09\x18\xfc\xc7\x36\x28\x0b\x29\x11\x84\x9b\x11\x13\x8a\xbb\xf0\xe0\x81\x3d\xe0\x83\xb2\x6e\x23\x8d\xce\xc9\x73\x97\xb8\x13\x16\x08\x9a\xf9\x58\x79\xc6\x71\x80\xf2\xac\x13\xb4\x77\xb9\x7b\x91\x95\x86\x4f\x9f\xe8\x3c\xcc\xea\x37\x38\x23\xdd\x09\x83\x99\x0a\xd4\xea\x0e\xf3\x74\xca\x2c\xeb\x9f\x40\x11\x26\x2e\x0a\xe2\x6b\x45\x2f\x05\x46\x7f\xd5\xd4\xac\x82\x0b\x03\xf3\x4c\xd0\x74\x20\xd0\xe3\x43\xbc\x9c\xdb\x82\x90\x5d\x9e\x5e\x70\x85\xbc\xf9\xbd\x51\x34\x9b\x7b\x29\xd1\x4a\x56\x15\x66\xb2\xd6\x50\xd0\x89\x24\x2d\x7e\x55\xd5\x4b\x25\xc4\xd6\xd9\x99\xfb\xba\x8c\x15\xd1\x3e\x9c\x73\x61\xa0\x99\xf6\xd2\x3c\x73\x59\xfe\xb4\xd2\x40\x80\x59\x6a\x11\xae\x60\xf1\x96\x13\x4e\xe4\x04\x5c\xf6\xc6\x96\x58\x0c\xef\x2e\x0b\x95\xf1\x33\xab\x45\x8d\x10\xce\x6c\x4f\xc3\x70\x9c\x2c\xd7\x37\x52\xf0\xf9\xd2\x39\xfa\x45\xf3\xf6\xa0\xd3\x6b\xf5\x46\x87\x24\x81\x7e\xef\xa0\xd7\x3d\x18\x1f\x8c\xdf\xef\xf0\xb3\x6f\xd2\x0c\xff\x91\x75\xdc\xc1\x5a\x55\x11\x35\x27\xa2\xba\x58\x6f\x04\xd0\x6c\x21\xa2\x61\xea\x37\xa4\x68\x99\x44\x92\x1c\x6a\x66\x5d\x5b\x37\x2e\x3e\xb2\x69\xb7\x76\x49\x92\x8f\xf4\xcb\xe5\xe2\x1a\x45\xe1\xfd\x86\xfd\x2d\x6f\x1d\x0b\x92\x6a\xee\xc5\x09\xaa\xe5\xe4\xda\x12\xbe\x59\x71\x92\x33\xd3\x67\xe0\xac\x51\x11\x70\x55\xa0\xb9\x59\x2a\xc8\x28\xca\xe5\x25\xe4\x9d\x4f\x00\x5d\x9b\xe2\xde\x45\x92\x3d\x8f\x1c\x6c\xd8\xda\x79\x87\x92\x94\x3a\xe8\x7a\x53\x8b\x70\x2b\x34\x76\xd1\x7c\x0f\x83\x01\x64\xb2\x0a\x7e\xf8\xa1\x88\xd0\x45\x8b\x95\xe1\x32\xcc\xe5\x24\xe1\xe9\x2d\x75\x37\x30\x63\x04\x79\x71\x5e\x12\xa2\x05\x4c\x98\x83\x7d\xbb\x0e\x5d\x8d\x0b\x6b\x01\x0b\xe6\x93\x41\xc0\x13\x3f\x91\x0c\xa0\xb9\x6e\xa9\xe1\x39\x69\xb9\x69\xf2\x25\x2c\x55\xcd\x79\x79\xb4\x7a\x2b\x4a\x5f\xa5\x01\x46\x6d\x5f\xa9\x4b\x26\x0d\xed\x36\x7c\x75\x97\x12\x2e\xb3\x6e\x62\x6f\x3e\x27\x7b\x3a\x2f\x65\xd8\xca\x36\x69\xf0\xb0\xde\x99\x48\x31\x8e\x9c\x85\x9d\x36\xba\x4a\x29\x60\x52\x16\x63\x45\xf5\x6a\xd5\xd8\x17\x6b\xf7\xfd\x10\x2a\x3e\xdf\xe7\x9b\x76\xf6\xdc\xf0\x2a\x53\x8d\x11\x8d\x86\x5c\x0b\x7d\xca\xdd\xc2\xf8\x90\xf9\x76\x58\x2c\x35\xae\x99\x30\x4c\x71\xba\x08\xd2\xfd\xd2\xe6\x45\xc3\x75\xcb\xe5\xd4\x60\xbe\x00\x98\x91\x58\x8c\xa7\x4d\x04\x93\xcb\x8e\x18\x2c\xf6\x14\x43\xdc\x4d\x86\x1e\x75\xb5\x66\xdf\xb3\x88\x9b\x7f\xd1\xa0\xe9\x6a\xb5\xe6\xf8\xaf\xa1\xd4\x60\x19\xa6\xb6\x89\x97\x65\x8d\x88\x48\x56\xb6\x09\xe7\x82\x93\x39\x98\x80\x36\xd7\x78\x16\x51\xde\x64\xa4\xfd\xa2\x88\xd4\x85\x18\xd1\x68\x8e\x4b\x98\x93\x15\xa1\xe8\xf4\x71\xe2\xd6\x1b\xfb\x7e\xf9\xb0\xf7\x4f\x6d\xf7\xaf\xa5\x80\xb2\x51\x16\xb3\x42\x95\x78\xc6\x1a\x21\xae\xa4\xe7\x66\xe1\x13\x44\x7c\x5a\x46\xa9\xb5\xaa\xba\x27\x84\x3a\x59\x63\xd4\xed\xcd\x08\x9e\xb9\xea\xe3\x95\x4e\x04\xce\xd3\xe9\x13\xcb\x69\x52\xae\x4a\x5d\x4e\xcf\x32\x57\xe2\x67\x57\x96\x62\x78\x06\x08\xc2\x34\x82\x6b\x4b\x71\xe9\xe0\x89\xb1\xb3\xb9\x3c\x3d\x6a\xca\x1a\x20\x3e\x5d\xe6\x2a\xe2\x90\x29\xab\xc5\x1e\x59\x2a\x4a\x07\x4c\xc5\xdb\xb9\x96\x9a\xd2\x61\x13\x9e\x31\x63\x8b\x9d\x46\xaf\xd5\x2e\xa5\x5e\x30\x87\xbe\x44\x70\x95\x38\xf9\xd0\x39\x55\xc0\x17\x57\x35\x64\xae\x15\xda\x46\xc1\xad\x44\xfa\x99\x46\xd6\xd0\xde\x7f\x0d\x9d\xa3\x10\x7e\x5c\xe4\x9e\xfd\x86\xf4\x8c\x2c\x5f\xae\x7d\x69\xe5\x61\x41\x15\x4b\x2b\x9f\x43\x2c\x4a\xad\x14\x2a\x74\x2d\xcb\xec\xba\xd1\x2d\xea\x15\x5f\x40\x25\xd0\x06\x71\xd5\x84\x57\xfd\x5a\xb4\x02\x66\x12\x95\x6c\x9f\xb2\x34\x30\xb4\x28\xaf\xee\xcc\x85\x90\x18\xb1\xe4\xde\x66\xf2\xd6\x8a\x07\x93\x93\xe6\x45\x5e\x66\x8f\x9c\x76\x92\xcb\xfc\x45\x09\x9c\x29\x1a\x92\xb8\xcd\x1b\xc3\xad\xcb\xb9\x68\x6f\x33\x56\x68\xe5\x92\x50\x1c\x02\xbd\x37\x05\xb1\xd8\x7b\x49\xb2\x98\x21\x3f\xef\xe9\xe4\x4d\x63\xe4\xf9\x77\x92\x52\x93\xb3\x9a\x2c\xc2\xd1\xc4\x0b\xaf\x56\xc9\xdc\x52\x6d\xc9\xb0\xcb\xfb\x4a\x56\x0b\x2c\xe8\xc9\x4d\xe5\x38\x41\x6b\x5b\x57\xf5\xf6\xf3\x45\xb8\xae\x36\xbe\xa1\xdd\x66\x69\x36\x53\x93\xfa\x1b\xce\x95\x90\xcd\x6b\x75\xe3\xba\x90\xed\x25\x1b\xbb\x2c\x30\x18\x50\x93\x70\x94\x42\xaf\xcb\x32\xa8\x42\x04\x76\xee\x31\x4c\x45\x7e\x59\x6a\x99\x3c\x0a\xc0\xf9\xac\x8c\xb5\xe2\x7a\x44\xe6\xf8\xb8\xa2\xfb\xc2\x4b\xb5\x67\xec\xb2\xfe\x0c\x1f\xfd\x31\x71\x66\xa0\x7f\x97\xf4\x64\xa8\xe2\xad\x6b\xf6\x66\x78\x49\xd1\xc1\x68\xad\xe6\xc4\xf0\x04\xa3\x43\xfc\x37\x31\x72\x58\x6a\x9e\xfe\xca\xed\x9e\x73\x16\xa4\x7f\xf5\xc3\x3f\x0e\x89\x79\xf1\xb2\x1f\x44\x9b\xc6\xcd\x93\x06\xdd\x43\x2f\x64\x69\xae\x11\xf5\xd3\x0d\x42\x76\x17\x92\xf9\x7f\x32\x37\xee\x2b\x14\x92\xeb\x90\xee\x90\x45\x8e\x6b\x0d\x60\x9a\x18\xd9\x1d\x6e\xd9\x92\x49\x4e\x4d\x24\xb5\xfe\x18\x7a\x5d\x72\x99\x93\xbc\xe0\xb7\x08\x4f\xc7\x10\xcd\x02\xac\x75\xd6\xc1\xa3\xaf\x6e\x82\xe9\x14\x86\x48\x34\xee\x43\xec\x85\x7e\x34\x9b\xea\xb7\x14\xeb\x31\x2d\x52\xef\x5a\x46\xd6\x3a\x9c\xfe\x5a\x27\x6c\x82\x91\xfa\x86\xd6\x01\xca\x68\x7e\x79\x11\x90\x66\x50\x03\xdd\xa2\xd1\x22\x45\xb5\x07\x3c\x3d\xc5\x83\x3a\xb4\x0f\x7a\xfb\x9d\x76\x1d\x5a\xed\xa3\xfd\x4e\xdb\x41\x91\x5f\x53\x4b\x75\x78\x80\x49\x8c\xff\x6f\x48\x87\xa4\xb3\xd2\x51\xf0\xf2\x5a\xc3\x67\x5d\x9d\x4f\x9f\x3a\xe3\x76\x7a\xae\x65\xbf\x2c\x4e\xd9\x8e\x71\x9d\xc3\xa1\xe8\x77\x4e\x2e\x1f\xa5\x43\x4a\x0a\xbf\xd6\xeb\xc2\x3e\x1c\xad\x92\xdf\x88\x4a\x36\x49\xac\x99\x15\x6c\x9a\xa8\x92\xa6\xf3\x17\xf6\x2b\x91\x5a\x88\x8b\x9c\x5d\x46\xb4\xcf\x3b\x8d\x51\x34\x9b\x2f\x48\x6c\x07\x4e\x58\xd2\x61\x7b\x9e\x4b\x3c\xbb\x52\x77\xc4\xc8\xed\xd4\xd2\x21\xee\x47\x74\xd7\x90\xe3\xa3\x31\x04\x77\xf8\xa8\xc2\x43\xf3\xa1\x2a\xae\x2f\x4e\x52\x07\xa2\x7f\x0e\x31\x52\xce\xd8\x06\x61\x05\xb3\xa0\xb0\xe1\x89\x5d\xe9\x97\x39\x5a\x55\x99\x37\x73\xe7\xac\x3e\x5b\x0d\xf9\x22\x52\x58\x08\x55\x1b\x92\x87\xba\xad\xc6\xfa\x0f\x68\x15\x49\x73\xc4\x09\xad\xfa\x9d\xea\x14\xe1\x2b\x3a\xb0\x7d\xf9\xe4\xd9\x67\xc0\x1a\x15\xbf\x7b\xa5\x52\xd8\xb2\x5d\x0f\x5f\xfa\xcc\xca\xca\x5a\x8e\x69\x15\x84\x73\xbb\x90\xff\xd1\x1f\xf7\x25\x25\x4d\xc3\xca\xeb\x3a\xaf\x65\x51\x57\xd6\xa1\xaa\x7d\xdd\x07\xb8\x46\x45\xf2\x1b\xb1\xa9\xfe\xcd\xce\x71\x15\x12\xc9\xe9\x08\xf3\x01\x1f\x56\x97\x2c\x90\x9b\x47\xa2\x0e\xff\xb9\xa7\x63\xd4\x4c\xb1\xf8\x9a\xac\xa6\x05\xb5\x2a\x4a\x5d\xce\x08\x55\x7a\x31\x6e\x17\xab\xc4\x5c\x15\x96\xba\x4b\xc7\x49\xe8\xa9\xec\x37\x55\x6c\x9c\xe2\x65\xd1\x7d\x1a\xa5\x86\x91\x08\x0d\xa2\x5d\xe5\x50\x6b\xd8\xc3\x88\x3a\x9d\x27\xe7\xcc\xc4\xfa\xe3\x7c\x39\x46\x6c\x89\x05\xf2\x88\xc9\xc7\xcf\x46\xfc\x5e\x07\xb7\x78\xeb\xca\x6a\x5b\x47\x47\x32\xec\xd1\xfb\x5e\xb9\x66\x9d\xcc\xc5\x56\x5b\xcf\x26\x8e\x10\xbf\xd2\xd5\x6f\x83\x67\x8a\x7f\x85\x65\xf0\xaf\x7c\xb4\xa8\x5a\x6e\xaa\x9e\x2d\xae\x6d\xe9\x59\x3b\xba\xe5\xb3\xc5\x4d\x2f\x93\x8e\x47\x8b\x5f\xe7\x4a\xf9\xf5\x9c\x2f\xde\xef\x8a\x5d\xcf\x79\x6f\xbb\xd8\x48\xbe\xc0\x12\x5e\xf9\xd0\x55\x7f\x0c\xaf\x5a\xc1\xe5\xa1\x5a\x66\x11\x77\x3d\x85\xd5\x1c\x93\xe4\x1e\xf0\xc8\xd4\xd9\xe3\xcf\x3b\xbc\xd0\xe7\x3a\xfc\xe7\xfb\xc6\xfe\x28\x8a\xd1\xf7\x7d\x38\xe8\x7c\x7e\xcf\x6c\x1c\x07\x9d\x3e\x5c\x64\xb3\x2e\x46\xff\x5e\x04\x31\xaa\xc3\x2c\xf2\x17\x53\x54\x07\x74\x3b\x8f\xe2\x34\x7f\x79\xed\x9f\x52\x2a\xa4\x38\x8a\xd2\x3a\x8c\xbd\x51\x1a\xc5\xe5\xa4\xc2\xd2\x49\x16\x03\x04\x83\xc1\x00\xbe\xa7\x9b\xa0\xef\x95\x53\x98\xcc\xa8\xd9\x2c\x0a\xff\x75\x5e\x7e\x49\xd1\x6a\x08\x60\x19\x58\x8e\x43\xe9\x82\x30\x3b\xcb\x92\x50\xa1\xe1\x4a\x29\x26\xbc\x27\xdf\xc3\x0f\x3f\xb0\x17\x0d\x6f\xe6\xeb\x30\x3b\x7e\xfd\xa4\xfc\x9c\x56\xab\x5d\xbc\xcf\x08\xa1\xc6\x41\x0d\xf3\xf9\x34\x1a\x7a\x53\xa8\x0d\xe3\xe8\x26\x41\xb1\x22\x74\x23\x26\x72\x83\x4b\x5b\x53\x57\xa5\xa1\x67\x8c\x5a\x88\xc7\x95\x2f\x5f\x56\xc9\x1e\x0a\xa1\x0e\x98\x57\x60\x14\xcd\xe6\x51\x88\xc2\x72\x12\x8f\xa2\x28\x27\xc7\x67\x19\x8a\xe2\xeb\xa7\x4f\x72\xe6\xac\xd7\x5e\x3a\xa9\x67\x01\xed\xd5\x64\x7e\xf8\x7f\xfc\xff\xff\x7f\x0c\x9b\x57\xd1\xc8\x9b\xc2\x3c\x9a\xde\x8d\x83\x29\x44\x63\xa0\xaa\x0d\xb3\x30\x8a\x72\x1a\x43\x1a\x4b\x6e\x3e\xc8\xd7\x2a\xa0\xa4\x5d\x47\x44\x91\x67\xfa\x32\x6a\xcb\x24\xa8\xb2\x45\x47\xc3\x0f\xc6\x25\x8b\x5c\xe5\x59\x0c\x31\x8f\x1a\x45\xc6\xb3\xc6\x3c\x8e\xd2\x08\x97\x83\x01\x44\xc3\x0f\xc6\xd2\x0c\x22\x0c\x20\x44\x37\xb8\x27\x15\x60\x87\x8b\xe9\xd4\x45\x7a\x65\x58\xab\x0a\xa9\xf2\x20\xd3\x1c\xc8\xee\x26\x5d\xc1\x4d\xcb\x9d\xea\x56\x3f\xc9\x7d\x15\x0c\x63\x2f\xbe\x5b\xf6\x14\x79\x1a\x0c\xc9\x29\x32\xfd\x5b\xb1\x6d\xac\xa5\xf1\x33\x39\xac\x91\xf3\x21\xf1\xa6\x10\x84\x13\x14\x07\xda\x54\x86\xda\x08\xaf\x18\xa2\x08\xed\x4a\x7f\x14\x67\x80\x85\x8f\x57\xc8\x70\xc4\x13\x8f\x92\xd0\x41\xe2\xd2\xfc\xc4\x4b\x79\x77\x58\x18\x62\xba\xf3\x30\x9a\xc7\xc0\xa0\xb6\x83\x72\x0b\x14\x5d\xa3\x38\x0e\x7c\x94\xc0\x5b\x6a\x8e\x0e\x50\x42\x15\x8e\xf9\x1d\x04\x21\x09\x02\x86\x24\xc4\x56\x68\x99\x53\x8a\x37\xfd\x6e\x5d\x80\x8d\xdb\x1f\x87\xfa\xeb\x48\xa2\x84\xd9\xe8\xf5\xdd\x3b\x2a\x18\x72\x9b\x0b\xc7\x9b\x4e\x72\x6c\x9c\x00\x4d\xfd\x3e\x3c\xb8\xf6\xa6\x0b\xf4\xc0\xe4\xdb\x6c\xc7\x0b\x7f\x66\x28\x9d\x44\xbe\x73\xec\x49\xa9\xa6\x29\xbd\xbb\x2d\x10\x0f\x18\xf6\x33\x40\xce\x66\x31\x49\x72\x57\x6d\x39\x2f\x3a\xe5\x83\x9a\x7b\x37\xa1\xb9\x94\xb4\x62\x60\xad\x9d\x1e\xba\xd9\xa3\xeb\xb2\x06\x8e\x17\x57\x33\x14\x5a\xae\x72\x62\xad\xc9\x1d\x6d\xc8\x16\x1c\x66\x61\xca\xea\x5a\x2e\x7e\x1a\x4f\x79\x21\x77\x8f\x81\xc7\xb9\x09\x44\x58\x32\x43\xf8\x61\x10\xa7\xd4\x0c\xaf\x89\x97\xbc\xb9\x09\x99\x30\xb8\xab\x3d\xc0\x50\x1e\xec\x88\x00\x2c\xf8\x27\xd1\x0c\x79\x79\xfc\xa0\x52\xc7\x29\x04\x67\x66\x54\x40\xf8\x3f\x93\xc5\x1c\xef\xaa\xc2\x20\x6d\x78\x78\x17\xcb\x74\x39\x2f\xbe\x5a\xe0\x21\xb3\x12\x13\x34\xcb\x6e\xee\xbd\x03\xc1\xb3\xc0\x6f\xf1\x83\x04\x32\xd5\x20\xa0\x27\xa7\x9c\xf7\xa8\x80\x33\x43\x93\xa9\x93\x53\x32\x5c\x54\x1e\xe0\x27\xd2\x63\x14\xa3\x70\x84\x5b\x26\xa3\x37\x37\xc9\xb4\x12\x41\xd9\x2e\xdb\xda\x92\x93\x56\x03\x46\x5b\x27\x38\x2c\x8b\x4f\x89\x7c\x48\xe4\x65\x8f\x1e\x31\x2f\x42\x4a\x5e\xc2\x48\x54\xb6\x99\xf3\xf2\x1d\x73\xbe\x20\x0b\x1b\x15\x03\x3b\xc2\x91\x08\xef\xde\x49\x46\x1a\x02\xb0\xb6\xb3\x5d\xe1\xca\x00\x80\xfb\x60\xb0\x3c\xd1\x30\x60\x8b\x1d\x77\x65\x58\x7a\x15\xa0\xf5\xdd\xd7\xa6\x32\x22\x44\x2c\x49\xc9\x7d\x4d\x55\x45\x9e\x6b\x49\x76\xf0\x67\x05\xf9\xe1\x34\x07\x78\xdd\x8d\x4d\x02\x53\x68\x49\x07\xae\x82\x37\x6c\x7d\x61\x59\xed\xc9\x64\x21\xb7\xba\x7d\x1f\x92\x68\x86\x60\x1a\x5d\x05\x23\xb8\x99\xa0\x10\xee\xa2\x45\x2c\x6e\x7e\x7b\x78\x73\x4b\x1b\xba\xe7\xf4\x94\xeb\xd4\xac\x8a\x41\x00\x5d\xf5\x20\xa0\x02\xb6\xd1\x30\x53\x9b\x7f\x36\xa9\x36\x55\xea\xc2\x6a\xcc\x77\x12\xcd\xf1\x6e\x60\x9e\x6d\x0c\xd8\x86\x60\x03\xfb\x10\xa9\x11\x72\x1a\x92\xdb\x8c\xcc\xc8\x39\xde\x3d\xf3\x21\x13\x78\x54\x6f\x5b\x46\x91\xdf\x20\x53\x10\xa4\x72\x5e\x8e\x82\x7e\x56\x71\x2a\x12\x4a\x71\x7f\xa4\x9f\xbd\x19\xf1\xf8\xad\x00\x03\x98\x16\x99\x55\x29\xea\x91\x32\xf0\x1d\x67\x8d\x0f\xb3\xda\x85\x5c\xf5\x3d\x0c\x24\xbc\xf2\xaf\x5c\x94\xbe\x35\xe8\x7c\x4f\xe1\x26\x0a\x1f\xa4\x74\x87\xcc\x63\x55\x49\x69\x9f\xa6\x51\x34\x07\x6f\x18\x5d\x5b\x94\x2f\x33\xbd\x1e\x70\xc8\x0f\xdc\xa8\x45\x56\x41\x81\x8d\x4c\x24\xf1\xd4\xae\xf0\x6a\x5f\xad\x26\x46\x84\x7d\x83\x90\x8c\xb8\x90\xaf\x43\x82\xa8\xb4\xad\xd1\x34\x0a\xb5\x47\xd3\x0e\x30\xd7\xb5\x60\x11\x3c\x60\x90\x29\x1c\xe4\xc1\x0a\x6a\x12\xae\x5e\x49\x4b\x62\xd4\x11\x9b\xb6\x6c\x53\xc1\x97\x4c\xeb\x25\x97\x6a\xf6\xca\x6a\x3e\xa8\x21\x78\x24\x82\x5c\x21\x8b\xd3\x52\x91\xf0\x4b\xe9\xbb\xdd\x41\xab\xc2\xa0\x0b\x77\xe0\x7c\xb2\xa9\x24\xb8\x0a\x83\x71\x30\xf2\xc2\x94\x25\x07\x0b\x28\x79\x09\x78\xda\x66\x15\xa3\xe3\x6f\x52\x14\x3d\x6a\x79\x94\x9f\x6c\x30\x24\xb9\x19\x5d\x1d\xc5\x21\xb7\x6c\xe7\x48\x2e\x5f\xe3\xa8\x30\xae\xa0\xbc\x7a\x22\xa8\x5f\x70\xcc\xb0\x0e\x04\xb2\xb4\xa4\xc7\x60\x55\x37\x08\x39\x1e\x62\x4e\x41\x2d\x79\xab\x3b\x38\x29\xb8\x03\xbb\x68\xde\x36\x9b\xcd\x56\xb3\xdd\xec\xd4\xa1\x79\xdb\xec\x36\x0f\x9a\xbd\xe6\xe1\xfb\x2f\xd2\x48\x1d\x7a\x2b\x87\xe0\x66\x79\x75\xf8\x88\x1b\x45\xda\x0d\x8b\x5e\x47\xe4\x19\xff\x41\xff\x7e\xfa\x44\x33\x66\x1a\x74\xe6\x31\xd4\x04\x5f\x7d\x37\xb0\x9c\xaf\xc9\x1f\xd2\x9c\x74\x3f\x80\x7f\x5d\xf9\x6e\x60\x11\x30\xe5\xdd\x29\x0a\xaf\xd2\x09\xbb\x7d\xa0\x6d\x40\x23\x97\xd7\x10\x78\x3c\x13\x0d\xeb\x09\x37\xfe\x34\x1c\x45\x3e\x9e\xd5\x88\x7e\x29\x4e\x6a\xf2\xd8\x9c\x3f\x4e\xb0\x27\x0a\x47\x8d\x17\xe8\xb6\x3a\x2e\xb6\x28\xe4\x2b\xc9\xc3\xb5\x45\x02\xcf\xa6\x64\xc5\x30\xe0\x8a\xc0\xa9\x55\x62\x80\x2b\xaa\xe7\x48\xfe\x4b\x3a\xd6\x5f\x3f\xaa\x12\xfa\x9b\xb1\x80\x53\xe0\x6f\xce\x2e\x9f\x3e\xc1\x0b\x74\xeb\x1c\xeb\x7b\xd9\x19\x30\xf2\x52\x14\x32\x1d\x35\x3f\x05\x1c\xd6\x77\x70\x9a\x09\x92\xb7\x5f\x36\xd2\xef\xd8\xa2\x25\x4d\xb9\xf9\x1c\x85\xcb\xf8\x6f\x2a\xbd\x0a\x57\xc5\x7d\x15\xe6\x16\xbd\x6c\xf1\x30\xc4\xe2\x89\xe1\xf6\xa0\xee\x2e\x0a\x81\x50\x5c\x3b\x68\x8e\xd8\xf5\x84\x8d\xc6\x03\xfd\x5b\x69\x8d\xb1\xc4\xb7\x4a\x27\x9e\x08\xab\x9a\xcd\x23\xb7\x9a\x41\x72\x9e\x49\xff\xdc\x6a\xe0\xd0\xe8\x79\x7e\xe1\xa0\xed\x4a\xf5\x8d\x14\x39\x99\x7a\xb3\x39\xa0\x5b\x92\x3f\x6c\x18\x98\x28\x43\xdd\xfd\x70\x79\x8b\x63\x04\x75\xf3\x1b\xd9\xa2\x5f\xe7\xba\xfd\x0f\x87\x1c\xd5\x04\x2e\xde\x34\x86\x88\xe8\x7a\xe0\xa5\xe0\x41\x1a\xcc\x2c\x9b\x6b\x55\x96\x6c\x99\x70\xff\x84\x60\x77\xd7\x69\x63\xcd\x49\x8e\xab\xc1\x00\x77\x81\x8d\xf9\x45\xc0\x53\xf8\xe2\x3f\xb5\x76\x17\xf6\xa0\x16\xd0\x5e\x3d\x84\xa3\x9d\x9d\x2c\xb5\xaf\xad\x0d\xc1\x7c\x17\x79\x0a\xed\x42\x20\xf2\x04\x7f\x1a\x64\x68\xfc\xf8\x23\x6f\x4f\x51\x5e\x20\xb0\xc2\x7e\xdf\x49\x85\x91\xc7\x86\x8a\x99\xb5\x8d\x8d\x5b\x02\x73\x57\xd2\x0d\xa0\x3c\x68\x2b\x10\xc7\x32\x5d\x84\x52\xb7\x3b\xc8\x77\xcb\x36\x7f\x26\x5e\x10\x7a\x43\x93\xf1\x41\xda\xd3\xaf\x75\x09\xa4\x91\x8c\xf1\x3e\x2a\xb7\xb7\x0a\xca\x5e\x6d\xa2\xce\x86\x17\x0d\x49\xe8\xa8\x8b\xeb\x16\x0a\x5c\x6d\x99\x2c\x9b\x96\xe5\xa1\xbc\xfd\xb0\xe5\x10\xd0\x0b\x77\xbb\x70\xb6\x6c\x83\x2e\x04\x70\x9e\x43\x7c\x40\x24\x0d\xfd\x10\xe9\xd0\x69\x63\xe9\x90\xe4\x44\xad\x45\x26\xe4\x76\x20\x03\x78\xed\xa5\x93\xc6\x08\x05\xd3\x0c\xca\xbe\xfe\x2a\xf5\x92\xaa\x97\xda\x32\xb8\x92\xce\xa2\xb9\x5e\x61\x34\x0c\x6e\xf2\x76\x20\x33\x04\xca\x9c\x6d\xb4\x04\xea\x39\xbb\x8a\x05\x50\x6e\x9a\x98\x92\x68\xff\x73\xf9\xa5\x75\x75\x69\xd1\x32\xc7\xb3\x1b\xf6\x4d\xb7\x8b\x50\x18\xc8\x86\x98\x45\x52\x99\xc7\xc1\x74\xca\xb3\x40\xb2\x88\x0c\xc4\x24\xb4\xbc\xa2\xce\xed\x50\xa1\xca\x06\x28\x37\xe1\x14\x01\xa5\x32\x9f\xb2\x16\x56\x9a\x02\xf7\x75\x1d\xc8\xc1\xaa\xc4\xa2\x5a\xb4\xaa\x1b\x8f\x68\x4d\x79\x02\x84\x76\xab\x91\x2c\xba\x2d\x36\x22\x5c\x34\xce\xfb\x7d\xce\x2e\x6f\xdc\x92\x8c\x5f\xde\xc0\x00\xff\x6b\x51\x2a\x68\xd9\x3f\xb1\xd6\x73\xdb\xf1\x7c\xd4\x3b\x1a\x9a\x12\x6b\x8b\x2a\x5e\xf2\x11\x64\x09\xef\xea\x9d\x50\xd1\xc5\x8a\xa2\x56\x6b\xde\x3e\x6a\xf6\x1e\xc1\x43\xdc\xff\x3f\x89\x0e\xfb\xec\xd9\xb3\x67\x3b\xb0\x4b\x1f\xfc\xf4\x13\x34\x6f\x5b\x4d\xa2\xde\x62\xcc\x1c\xd4\x5b\x4a\x9f\x5a\xf3\xb6\xdb\x3b\x68\x52\xc0\x37\x45\xc0\x37\xcb\x00\x26\x63\x86\x92\x05\x09\x9d\x52\x23\xe8\xfd\xf8\x23\x85\x02\xbb\x40\x86\xcf\x19\x16\x83\xb3\x3f\x20\xf5\xd9\xc7\xbd\xde\xee\x00\x9a\x8d\x03\xa7\xf2\x64\x6c\x58\xb5\x87\xd4\xe5\x9f\xcf\x8b\x1d\xf8\x09\x1a\x07\xf0\x5f\xd0\x82\x3e\xec\xb5\x56\x51\xe1\xcd\x52\x3a\xaf\x83\xd7\x21\x1e\x79\xa3\x09\xa2\xba\x78\x58\x4d\x0b\xc7\x50\x2e\xf1\xcc\x89\x6b\x35\x0a\x06\x3e\x7d\x82\x5c\xaf\xb0\xee\x21\x51\xd5\xc1\xbd\x86\xc2\x19\xc0\x65\x5c\xa3\x95\xc9\x7c\x39\x1c\x1e\x5a\x28\x42\x17\xaa\xf9\x22\x99\xd4\x6a\xa2\x6e\xd6\x30\x7c\x82\xe6\xaa\x69\xa6\x43\x74\x23\x85\xa1\x21\x3e\x63\xcc\x90\xcd\x04\xd2\xc6\x32\xd1\x31\x6b\xea\xb2\x4e\xf7\x28\x1c\x11\xa7\x7b\xfa\xb7\xa2\xd3\xfd\x0b\x74\x5b\xb6\xd6\x56\x69\x1f\x03\x18\x50\x2c\x1a\xf4\xfb\x6a\xa9\xe6\x8a\xa6\xea\x09\xba\x5d\xd9\x5c\xed\x60\xa4\x5b\x53\x02\xb9\x95\xb0\xbd\xaf\xf5\x7d\x82\x6e\xcb\xd9\xe2\xd8\x78\x4a\x26\xda\xcc\x34\xb7\x96\x1c\x71\xeb\xb6\xf4\xdd\x2c\x65\xb0\x4b\x56\xb2\xb8\x51\xb6\x35\xb7\x30\x41\xb7\x27\x13\x2f\x16\xaa\x8a\xae\xac\xca\x7c\x92\x54\x32\x6b\xe1\xca\xc3\x80\x9a\xb3\x6e\xd6\x68\xca\xe2\x3d\x60\xf2\x97\x34\x81\x01\x76\x77\xb2\xb3\x85\x56\x6f\xc7\xb6\xaa\xa9\xc0\xe0\xf6\x9b\x63\x77\x38\x2e\x82\x5c\xb4\xf3\x21\x0a\xc2\xda\x03\x6d\x98\x95\x55\x13\xcd\x49\x93\x9d\x8a\xaa\x75\x9c\x27\x70\x61\x42\xe7\xe4\xea\x32\x45\xb3\x1d\xf9\xeb\xec\x43\xb8\x9c\xa2\x57\xc8\x85\x28\x5b\x3d\x41\x1c\x05\xe5\x2a\x9c\xac\x32\xe0\x3c\x8d\x5f\x71\x1b\x0c\xfd\xc9\x6c\x32\x6b\x11\x32\xb9\xcd\x90\xae\xa0\x4a\xc2\xc8\x98\x31\xd5\xb0\x6d\x15\x34\xb2\x70\xe9\x10\x13\x36\xa1\xde\x69\x98\x32\xaa\x35\x92\xc5\x30\x49\xe3\x5a\x50\x87\xf6\x4e\x1d\x5a\xbd\x1d\xc9\xb6\x8d\x05\xd0\xd1\x8e\x2d\x80\xdf\x6a\x2a\x5b\x8e\xe0\xfb\xa0\x3d\x99\x5a\x39\xe8\xde\x2b\x2f\x0d\xc2\xd6\x6a\x2a\x14\x83\xc1\xb5\x28\xf1\x73\xbd\x8a\x14\x03\xfb\xed\xe8\x52\xab\x22\x7c\x5f\x62\x6a\x4a\xf0\x56\x6b\x54\xb4\x4f\x5b\xa5\xca\x80\x99\x93\xbc\xa3\x34\xfe\xc6\xf5\x2a\xa9\x13\x54\x27\xa2\x3c\xd3\x18\xc7\xd1\x0c\x3f\x3d\x89\x7c\x44\xd4\xa4\x95\x55\x22\xb9\xa5\x4d\x6b\x45\xb9\x69\xbb\x7e\xc5\x48\xcc\xae\xb5\xc8\x88\xbf\x85\x7a\xc4\x84\x0e\xd5\x90\x64\xe9\xb4\xba\x92\x24\xa0\xad\x47\x4f\x12\xe0\x84\xaa\x24\x9e\x7c\x15\xda\x52\x01\x3f\x37\xf1\x51\x10\x19\x9f\x06\x12\xd9\x1a\x23\x36\xcf\x8f\xd3\x5a\xc0\xc5\x46\x51\x59\xb2\x9f\xf1\xad\xa6\x2c\x15\x7a\xb5\x31\x5d\xe9\x97\x77\xcf\xf6\x8e\x56\x53\x95\x7e\x49\xc7\x47\x42\x51\x62\x3f\xd6\xab\x26\x51\x24\xbf\x1d\x2d\x69\x45\x7c\xef\x4b\x58\x2d\xd2\xf1\x91\x5a\x45\xc2\xa3\x7a\xdf\x0a\x52\x1a\xdf\x39\xde\xc3\xf0\xd1\x28\xf2\xd1\x2f\x67\xa7\x27\x3c\x62\x50\x0d\x25\x23\x6f\x8e\x6a\x06\x55\xcf\xbc\x9e\xc3\xc8\x4b\x47\x13\xa8\x21\x07\x2f\xe6\x38\xba\x21\x13\xfb\x69\x1c\x47\x71\xed\xc1\x6b\x6f\x3a\x8e\xe2\x19\xf2\x19\x5b\xf8\x5e\xea\xe9\xc3\xcc\x6e\xcc\xe7\x38\x3f\x8b\xd6\xaf\x03\x30\xe6\x59\x07\xff\xff\x2d\x14\x00\x32\xa5\xe8\xf2\x9f\xcd\xbb\xd5\x17\x7f\x06\xcb\xc5\xf3\x37\xa7\x82\x2c\x42\x36\x45\xa8\x3b\x70\x6e\xf2\x70\x98\xda\x29\xb2\x7a\x98\xfc\x61\x42\xb3\xcc\x0f\x17\xe3\x31\x8a\x91\x4f\x13\x50\xca\x81\x50\xd1\x6c\x3e\xd5\x9c\xfe\xab\x61\x4a\x57\x5b\xef\xb2\x7c\x96\x30\x5b\x24\x29\x0c\x11\x04\x78\x58\x67\x28\x4c\x91\x0f\x41\x48\xdc\x62\xc2\x51\x8c\x52\x11\x4a\x62\xa9\xeb\x51\xdc\xa3\xe1\x72\x16\x84\x8f\x49\x5f\x44\x72\x97\xcc\xb3\x81\x20\x93\xd0\xb0\x43\xc9\x24\x5a\x4c\x7d\x8c\xd0\x47\x34\x4f\x61\x11\xce\xe3\x68\x84\x48\x94\x04\x76\xd3\x86\x92\x44\xf2\xd2\x6f\x56\x58\x9b\x1f\x33\x7a\x3e\xc6\x4d\x1e\x0b\x6a\x8a\xf8\x4b\xba\xd7\x2b\x5e\x89\x3a\x43\x09\xe2\xf7\x1c\x0a\x23\xf9\x20\x21\x12\x90\x75\x8b\x84\x82\x48\x13\x1e\x3f\x05\xf0\x34\xfd\xd2\xae\x43\x43\x25\x11\x1a\x31\xee\x43\x75\xdf\x21\x52\xad\x8a\x57\x1c\xbb\x3e\x06\xe4\xe2\xb2\xcd\x3f\xf8\x92\xd0\x6e\xa0\x52\x1c\x4d\x4b\x0a\xad\x1b\x3e\xf1\x52\x8f\x1b\x01\x74\xe7\xed\xcb\x2d\x33\xc7\xbe\x9f\x10\xa4\x08\x7e\xdc\x99\xbf\x3c\xf4\x8c\x99\x57\xd7\xe1\x44\x90\x63\xd2\x20\x9e\x5f\xbc\x65\xe6\xd6\x0f\x54\xa2\xb2\xe8\x0a\x74\x15\xa4\xa1\x47\x3c\xe9\x3a\x20\xbd\x4a\x4c\xd6\xab\xaf\x83\xeb\x2e\x29\xfa\xb5\x07\x66\x35\xc1\x09\xc6\xf2\x6a\x1a\x83\x20\xb3\x31\xc6\xc7\xc6\xca\xe5\xe8\xb3\x82\xd2\x75\x39\x7f\xa2\x34\x00\x2c\x77\xa2\x1e\xac\x1c\x2b\x93\xf0\x7e\x85\xd4\x73\x6c\xb2\x48\x4b\x2d\xe9\xc5\x0a\x09\x7e\x8e\x09\x5d\x5c\x26\x29\xbf\x89\x61\x6b\xb1\x34\x35\x77\x07\x04\x6f\xdb\x15\x85\xe5\xe6\xe9\x5b\xb6\xba\x24\xe0\x5d\x7b\xc1\x94\xa4\x88\xa1\xe2\x98\xac\x49\xd5\xe7\xc0\x3b\x29\xd6\x49\x10\x5e\x47\x1f\x51\x02\x97\x7e\xc4\xda\x21\x8c\x59\x8b\xc6\xe3\x04\xa5\x3b\x75\xb8\x99\x04\xa3\x89\x72\x05\x1e\xde\x39\xae\xc0\x66\x6c\xb8\x98\x18\x46\xd1\x14\x79\xe1\x67\xf0\xa3\x67\xd3\x45\x32\x81\xdf\x26\x28\xa5\x91\xd5\xa7\x7c\xf9\xf5\x42\x12\xa5\x9e\xc8\x5e\xf6\x28\x5b\x8c\xc5\x2a\xbc\xc6\xa0\xe3\x62\x61\x27\xc3\xfb\xe5\x55\x51\x81\xc0\x13\x3a\x2d\x74\xd2\x83\x95\x73\xb9\xd9\x56\x15\xe4\x77\xdf\x3d\x18\xe3\xf1\xa8\x9e\x5d\x8c\x83\xc8\x89\x24\x3a\xb8\x6b\x32\xab\x33\x61\x91\xcd\x60\x8b\x41\x1d\x17\xe1\x17\x92\xc8\x88\xba\x58\xe1\x71\x41\xe9\x42\x91\xcb\x44\xe7\x55\x33\x15\x96\x21\x29\x1e\xb8\x56\xe4\xad\x66\x90\x1c\x52\x6f\x9d\x44\x8b\x30\xe5\x13\xc4\x22\xab\x71\x6b\x21\x19\xfb\xe4\x8c\x64\xc4\x1d\xe4\x3b\xbc\x5f\xc0\xc5\x80\x38\x16\xfb\x2e\xe3\xcb\xb0\x3c\x8b\x16\xa1\x0f\x8b\x39\x0d\x2d\x36\x9a\x2e\x7c\x54\x98\xdc\x66\x10\x05\xac\x33\x87\x7c\xf9\xc5\xea\x59\x4a\x05\xa6\x7e\x74\x13\xca\xb8\x46\xe1\xf4\x0e\xc6\x0b\x21\x9d\x2c\x49\xf6\xf7\xf7\x61\x8a\x12\x1a\x9a\x4d\xbd\xaf\x20\x42\x36\x46\x33\x2f\x08\xf3\x1b\x89\xea\x74\x98\x79\xb7\xb5\x1c\x1d\x88\x67\x1f\xec\xb1\xa9\x92\xdb\xe9\xd4\x57\xf0\xf9\x13\xcc\x46\x4d\xc1\x2e\xbc\x46\xe6\x1f\x47\x36\x87\xe2\x43\x90\xa7\x87\x0b\x87\x13\x0e\x75\x62\x70\x5c\x32\x4f\xa1\x20\xac\xc9\xb8\x3c\x84\x6e\x3d\xc7\xf9\xd6\x0b\x7d\x6c\xbd\xb4\xf2\x2a\x9e\x16\x52\x4b\xee\x01\x87\xe8\x1a\x4c\xad\xe6\xec\xfb\x8f\x32\xfd\xc4\xd3\x5d\x49\x3e\x38\xb9\x32\x63\xec\x51\x3c\x8e\xe2\x99\x58\xc0\xf7\xb2\x7d\x3b\x89\x79\x66\x07\xc2\xa4\x6e\x5e\x6f\x10\x12\xb6\xce\x90\x5b\x47\xe0\x4e\x7a\xff\x4a\x5a\x8d\x09\xb7\x99\xab\xe5\x96\x3a\x59\xe6\xff\x46\xef\x6a\xcc\xe9\x65\x8d\xba\x4c\x50\x1b\xae\x39\xd1\x0f\x7b\x03\x99\xaf\x56\x98\x41\x3c\x53\xa2\x6b\xf7\xf4\x07\x11\xf9\x0e\xd7\x65\xfc\xbe\xc8\xcd\xa4\xa5\x93\x93\x55\x8e\x55\xf4\x05\xae\x24\x69\x14\xa3\x6f\xe4\x7e\xd2\x65\x49\x37\x92\x30\xb7\xf2\xd6\x72\xd7\x93\xf2\xeb\x8a\xd2\xea\x55\xd1\xa5\x5b\x18\x19\x27\x5e\x82\xf7\x02\xd5\x4d\x8a\x0a\x43\x5f\xa6\x4b\xe5\x6d\x7c\x72\x40\x1e\xca\xcf\xac\x55\x9a\xce\x08\x25\x10\x85\x92\x4d\xaf\xd5\x83\xda\x41\xab\x4d\xee\x81\x2a\x92\x2e\xe8\x3d\xbd\x29\x50\x6e\xcf\x13\x3f\xd5\x86\xbd\x2f\x96\x88\xd3\x94\xe6\x52\x36\x2d\x2e\x97\x93\xd2\x14\x70\x89\x52\x79\xf9\x9d\xe3\xca\xe9\xaa\x30\x02\x40\xf3\xf4\x2e\x9b\xb5\x6a\x65\xdf\x71\xc1\x14\xb9\x7c\x4a\xe7\x2f\x8e\xdb\x07\x3d\x7b\x78\x24\xc7\x88\x42\xeb\x4f\x7b\xc4\x13\x1e\x2d\x99\xfb\xe8\x1c\x89\x38\xdc\x6e\xc6\x54\xb3\x85\x77\xd9\xeb\xdd\x99\xe5\x9b\xcb\x99\xfb\x37\x71\xb3\x59\xf1\xa5\x4c\xda\x84\x08\xb2\x99\x5f\x5f\x5a\x23\xa8\x48\x93\xf9\x25\xca\xac\x3f\x17\x35\x50\x46\x7b\x8b\xfa\xc9\xd5\xce\xb3\x0d\x70\xc2\x2f\x73\x9f\xc8\x79\x99\x15\xc8\xdd\x59\x91\x49\x77\x9d\x66\x70\x06\x92\x36\x5a\x4c\x79\xbb\x72\xa4\x1b\xba\xae\xb0\x30\x37\x4b\x8b\xd8\x35\x70\xf0\x82\xf4\xaf\xf6\x80\x75\xce\x66\x20\xcf\xd7\x5a\xde\x1e\x4e\x01\xc8\x53\x20\x47\x6f\x07\x39\xe8\x60\x35\x66\x56\xfb\x3c\x64\x1b\xe3\xb3\x01\xc7\xfb\x7b\xdc\x59\x5b\x1b\xb2\x6d\xcf\xbc\x29\xbe\xbf\x08\x19\xcf\x82\x90\x2d\xee\xbc\x57\x4e\xeb\x29\xfc\x1c\x11\x42\x78\x29\xa9\x37\x66\x50\xb2\xfc\x91\x10\x24\x80\xc6\x63\x34\x4a\x83\x6b\x34\xbd\x03\x0f\x7c\x94\xa4\xf1\x82\xfc\xae\x93\xad\xff\x5e\x14\x8e\xd0\x4a\x19\x27\x1d\x27\xa8\x1c\xc6\x91\xa2\x9a\xa5\xd7\x26\x25\xd6\x67\x77\xc6\x04\xbc\x27\x25\x04\x06\x7c\x06\xf2\xd1\x70\x31\x2a\x6b\x6a\xba\xce\x79\x03\x88\xe5\x05\x00\x07\xb1\xb4\x08\x78\xa6\x18\x63\xb3\x99\xc7\x1d\x3c\x58\x45\x88\xae\x96\xcd\x9a\xb0\xe4\xb2\x2a\x0d\x01\x5f\x61\x9f\xc9\x0c\x60\x95\x29\xb8\x72\x65\x99\x22\xb6\x62\x7d\xc0\x5b\xa9\x7d\xe8\xb4\x57\xb4\x47\x24\xec\xe4\x20\x1b\x74\x7a\xfd\x95\x10\xe1\x81\xc8\x94\x11\x84\x29\x8a\xc7\x9a\x3b\xc2\xe0\x24\x2f\xf8\x02\xcb\xe8\xcb\xe7\x2d\xd5\x1e\x59\xaa\x38\x0f\x26\x68\x3a\x47\x31\xde\x6b\xac\x20\x1e\x9e\xb1\xbe\xb0\xe0\x8c\xc5\x2e\x7e\x43\xbe\x55\x74\x43\x53\x0c\x80\x41\x49\xd9\xb8\xa4\x54\x7b\x41\x48\x56\x53\xed\x84\xaa\x9f\x41\xc9\x20\x73\xd7\xd2\x48\x93\x2e\x3e\x57\x25\xe9\x61\x4f\x1f\x0b\x79\x2b\x1d\x93\x69\xc4\x44\x87\xab\x66\xd2\x8d\x41\x34\xce\xf7\x0d\x19\xee\x94\x13\x05\xaf\xc2\x2f\x5e\x1f\x9f\x7c\xb9\x89\x82\x37\xe2\x3c\x50\x34\x6e\x99\x4d\x98\xed\x64\xf1\x62\x78\x31\xf3\x46\x4e\x13\x66\xe6\x8d\x36\x31\x69\x04\xd8\xb5\x4d\x9c\x8f\xc8\x7e\xfe\x21\x4d\x9c\x93\x4b\xd2\x0d\xcc\x17\x74\xf6\xd0\xc6\x29\x9c\xf5\xcc\xa2\xd5\x4d\x94\xe2\xdc\x64\xc9\xb8\x13\xb8\x8b\x24\xf0\x04\xfb\xa2\x89\x3c\xc1\xc8\x72\x52\x4a\xdb\xfb\xda\x4b\x27\xa5\xd4\xbd\x72\x0d\xc6\x12\xff\xd4\xa7\xf6\xcd\xd2\xf9\x76\xff\x6a\xe9\x7c\x59\x17\x6a\x3c\x7b\x71\xc9\x21\xf6\xcb\xe5\xf7\xe5\x28\x6c\x2c\xcf\xaf\xe8\xb3\x9c\xef\xb7\x52\x92\x5f\x51\xa9\x9c\xec\xd7\x96\xf2\xd6\xec\xb5\xc1\xf3\xa8\x4a\xcc\xa8\x2c\x22\xa7\x3e\xd5\x94\x31\x24\x06\xd0\x42\x95\x62\xbb\x54\xce\xa5\xda\xeb\xae\x76\xd7\x86\xc1\xe0\xb7\x6d\xc4\xcf\xf5\xde\xb7\x61\x60\xbf\x9d\x0b\x37\xab\x22\x7c\x5f\x8b\xf2\x90\xe0\xad\xbe\x73\x43\xfb\x74\xdf\xb7\x6e\xee\xfb\x5a\xb2\xa9\xee\xcc\x9b\x8b\x1d\xe6\xcc\x9b\xaf\x31\x16\xb3\x2a\x36\xaa\x19\xb6\xcb\x15\x47\x3a\xda\xeb\xb8\x20\x0d\xbb\x03\xe8\xb8\xdd\x91\xbe\x4b\x51\x6b\xdd\x97\xa4\x39\xe0\x76\x06\xb8\x16\xc0\x2e\xb4\x76\x14\xe0\xf9\x9b\x65\x1b\xe9\x14\x1a\x69\x6b\x1b\x69\xab\x1b\xb1\x37\x93\xc6\xc1\x7c\x4a\xbc\x63\x6a\x94\x5e\x3f\xfe\x48\xe2\x63\x7c\xa2\xbf\xdb\xf8\xf7\x11\xfe\x49\xf0\xb1\x82\x14\x83\xf7\x81\x0e\x5e\xed\x03\xfc\x88\xf1\xfa\xe1\x07\x20\x78\x7e\x80\x87\xd0\x6c\x1c\x1e\x48\x63\xba\xf3\x4f\xf8\xe0\x18\x24\x5b\xe2\x22\x7a\x43\x7d\xe6\xcd\xc9\x95\xd5\xe3\xb4\x56\xe3\x3d\x21\xa4\xe9\xc1\x43\xa8\x75\x60\x0f\x3e\xec\x30\x7a\x74\xc6\xd6\x88\x40\xe6\x68\xcc\xc6\x49\x70\xec\xfb\x30\xf7\x7c\xbc\xb8\x99\x27\x02\x2b\x84\xfb\x00\x03\x90\xf0\xef\x19\xc3\x8f\x90\x2c\x5e\x59\x55\xfb\x25\xdf\x49\x30\x45\x50\x93\x09\xc6\x42\xee\xba\x84\x43\x07\x15\xad\xe5\xe6\x37\x44\x48\xb6\xbe\xc9\x4d\x6f\x3a\x3c\x40\x6e\xf9\x5c\xff\xd5\x40\xb1\xca\xad\x65\xad\xfe\x5b\xdc\x0e\x64\x8b\x3f\xbd\xb4\x20\x6b\x09\xab\xdf\x10\x14\xd0\xd6\xb4\xee\x0b\x78\x22\x3e\x80\x78\x92\xc5\x07\x30\x01\x28\x2f\xe3\xa6\xd2\x31\xba\x46\x71\x82\x5e\x4b\x95\xb2\x47\xd6\xcc\x44\xdf\x65\x65\x1d\x6c\x07\x86\x96\x6c\x0b\x38\x28\xd6\x01\xbc\x0c\x60\x61\xc7\xa8\xe2\x2c\xf1\xb3\x56\x2f\xb8\xac\x64\xe1\x09\x3e\xec\xbc\x87\x01\x7c\xd8\x9c\x48\x3f\xbd\x0a\xa3\x18\x7d\x55\x52\x5d\x6a\xea\x34\xf4\x49\x74\xcb\x8c\xe1\x02\xfc\xe4\xcd\xb8\x8a\x9c\x96\xda\xa7\xf0\xbe\x1b\x0c\x60\xaf\x55\x61\x7d\x90\x79\x5f\x86\xb4\xb9\x61\xb1\xaa\x9b\x4c\x48\x92\xc9\xff\x2a\x8a\xe6\xd9\xac\xaf\x17\x91\xae\x4b\xfc\xa5\xd7\x73\x4d\x8e\x7a\xde\xbc\x0f\x0f\x8e\x1f\x9f\x3c\x79\xfa\xec\xf9\x8b\xd3\x7f\xbd\x7c\xf5\xfa\xe7\x37\x6f\xff\xaf\xb3\xf3\x77\xbf\xfc\xfa\xdb\xef\xff\xf7\xff\x78\xc3\x91\x8f\xc6\x57\x93\xe0\xc3\xc7\xe9\x2c\x8c\xe6\xff\x8e\x93\x74\x71\x7d\x73\x7b\xf7\x67\xb3\xd5\xee\x74\x0f\x7a\x87\x47\x8f\x76\xf7\x07\x8a\x9c\xad\x6a\xe3\x95\x10\x65\x95\x7b\xa7\x1b\x52\xa7\xf8\x23\x99\xef\xb7\xe1\xf6\xa4\x4a\x6f\x2f\x60\x64\x0f\x50\x82\x39\x32\x70\xd2\x51\x58\x14\xa4\x04\x6b\xf8\x92\x94\xc8\xe6\x83\x1c\xca\x04\xf6\xa0\xb5\xf3\x9e\xc4\x31\xc9\x74\x7e\x7d\x7e\xa0\x42\x13\x6d\x97\x26\x76\xde\x73\xcd\x53\xde\x57\x58\xdb\xa0\xda\x7d\x98\xcb\xb3\xf0\x09\x2b\xe3\xa4\x6b\x9f\x68\xfb\x72\x04\x96\xd0\x3d\xd5\x02\x90\x5b\x0d\xb8\xf8\xee\xee\x12\x41\x17\xd4\x8f\xd9\xf4\x2a\x65\xf0\xb3\x06\x27\xfe\x5c\x34\xb8\xba\x18\x5b\x25\x8d\xc0\x60\x77\xe5\x16\xc1\x3e\x1c\x74\x32\x1b\xec\xc1\xd6\x06\xbb\xb5\xc1\x6e\x6d\xb0\xf4\x43\xc3\x1d\xb5\x7a\xf0\xf8\xe9\xca\x21\x8f\x5a\xbd\xc7\x4f\xe5\xa8\x47\xad\x5e\xfe\x17\x79\xbb\xfe\x30\x48\x14\xf9\x6f\xc7\x32\xbb\x06\x9c\xef\x31\x1c\x52\xab\xa7\x8d\x87\xd4\xea\xfd\xdd\x4d\xb3\xab\x1b\x44\x09\x85\xd7\x65\x0f\xb5\x87\xc8\x25\x17\x61\x22\x1f\xbd\x8d\x82\x30\xd5\xd9\x44\x5b\x3d\x8d\x4d\xd4\x6a\xb0\xcc\x7a\xa3\x8f\x1c\x29\x9a\x5f\x39\x7c\xa4\xd4\xda\xa6\xcd\x43\xc5\x39\xbc\x91\xe0\x51\x64\xaa\xad\x4b\x64\xfc\x2d\x6c\x44\x54\x08\x89\x10\x52\x5c\x56\xad\x25\x86\x14\x01\xb6\x9e\xf8\x91\x1c\x9a\xd8\x22\xf3\x07\x5f\x45\xf4\xc8\x3c\x76\xd5\x83\x47\xb6\xc8\x4e\x45\x74\x29\x1f\x3a\x12\x6f\x58\x32\x79\xd2\xc6\xf2\xc4\x90\x8d\xc9\x71\xe2\xeb\x36\x1d\x05\x3a\x1b\xb6\x5d\x6b\x09\x1a\xd9\xea\xc1\xab\x15\xb4\x28\x49\x53\x7a\xb5\x31\x4d\xe9\xd5\x37\xa8\x29\xad\x82\xf3\x7d\x6b\x4a\x4a\x09\xf5\xea\xe9\x56\x51\x32\x60\xf6\x2d\x28\x4a\xc9\x8d\x37\x7f\x1a\xfa\x81\x17\xd6\xaa\xea\x4c\x36\xd3\xcc\x5f\x5d\x69\x7a\xf5\x65\x95\xa6\x55\xa4\xc7\xdf\x47\x69\x7a\xf5\xb4\xa0\x36\x6d\x75\x26\x5d\xc1\xcd\xe9\x4c\x92\x50\xa9\xa4\x3e\x7d\x43\xfa\x93\x60\x0f\xa9\xaf\xb8\x35\x2d\xb5\x18\x8a\x54\xca\x32\xaf\x13\x2a\x49\x9b\x4d\xfc\x7f\xe2\x92\x42\x5f\x62\x42\xb2\xb7\xf4\x9d\x5a\xd6\x2e\x6f\x70\x26\x33\xa5\xb2\xbd\xb9\xb7\x46\x7b\x73\x1d\x16\xa1\x8f\xc6\x5f\xa7\xd9\xb9\x0e\xd2\xa3\x64\xe2\xb5\x0a\x8f\x26\x33\x6f\xf4\x35\x18\xa7\xeb\xc0\xf1\x23\xdf\x08\x5a\x5b\x83\xf5\x4a\x06\xeb\xc7\x5e\x82\xb2\x10\xba\x5e\xa2\x0a\x6e\xb2\xac\x61\x3b\xe7\xc0\xaf\x29\xf5\xfa\xc9\x01\x81\x48\xee\x33\xbc\x7e\x72\x50\x69\xef\x46\xae\x70\x7f\x44\x77\xe0\xa3\x38\xb8\xa6\x17\x63\x05\x61\x49\x2c\x47\x2f\x4c\xc9\xad\xb3\x28\x24\x77\xff\xc8\x9d\xf5\xa7\xbf\xbe\xbd\x24\x3a\xe4\xbb\xe8\x25\x52\x6f\xf2\xe0\xe6\xe6\xa6\x11\xcd\x51\x98\x24\xd3\x46\x14\x5f\xed\xfb\xd1\x28\xd9\x1f\x11\x72\xef\x17\xea\x4f\xd2\xd9\xd4\x71\xa3\x88\x3b\xfc\xf4\x7a\xfe\xf2\xc9\xb3\xac\xcf\xe2\xf7\x8a\x61\x8a\xab\x05\x35\x31\x28\x66\xa5\xb0\x30\x1f\xd1\x9d\x08\x0a\x83\x89\x9d\xe0\x1f\x41\xc8\xe3\xc1\x48\xd9\xac\xb3\x18\x30\x5d\xa8\xb5\xda\x47\xda\x10\x30\xa5\xb6\x34\x57\xa1\xe4\xa0\xd9\xe4\x4a\x94\xd4\xc4\xeb\x27\x07\x55\x7a\x11\xa4\xec\x22\x74\x31\x37\xb7\xf4\x22\x8d\x60\x4e\x6f\x89\xca\xe1\x6c\x2a\xaa\x53\xa5\xc0\x30\x06\x6d\x82\x91\xb6\x0f\x98\x58\xec\x92\xa7\xae\x2c\x25\x0d\xe9\xb7\xa1\x54\xd6\x1b\x2d\xe6\x9f\xd7\x1f\xa9\x46\x33\x07\xef\x2b\x74\x0d\x92\x90\xb9\x87\xeb\xe2\x1f\xfd\x71\x29\x60\x0d\x9d\xe6\xf6\x80\x35\x15\x80\xfc\x27\x63\x9f\x23\x7a\x39\x6b\xad\x20\xeb\x79\x5e\x6a\x36\x9b\xa6\x46\xd6\x14\x68\xc7\x1e\x36\x47\x55\x6b\xb9\x0d\xee\x13\xcc\x23\x84\x99\x31\xfb\xe2\x5d\x3a\x78\x30\xf7\x92\x04\x0b\xb6\x75\x86\x4b\xe0\x30\x69\xe8\x5c\x5b\x03\x06\x40\x89\x37\x4d\xe1\x98\xfc\x59\xdf\x9e\x98\x4c\x15\x3a\x87\xef\x63\xae\x20\xac\x51\x7c\xf4\xc7\x0d\x1a\x17\x03\xd5\x38\x81\xea\xa4\x9f\xd5\xe3\xc9\x51\x30\x32\xd3\x15\x20\xae\x65\x57\x9b\xe7\x51\xdb\x1e\x16\xcb\x4e\x26\xc0\xcd\x60\x45\xb8\x2b\xcc\xf8\xec\x7a\xb4\x24\x33\xac\x8d\xb8\xc4\x8b\x22\xb1\x7b\xe9\xa0\xbf\x24\xd4\x2f\xed\x22\xed\xe1\xa9\x1c\xc3\x10\x8b\x56\x44\xa0\x49\xf1\xc4\xc9\x1a\xc9\x55\x0f\x4a\x0f\xf6\xcb\x52\x47\x5a\xcf\x69\xb5\xec\x81\xad\x5b\xcf\x99\x22\x83\xdb\xd5\x17\x64\xae\xf8\x85\xce\x71\x77\xfc\x1f\x39\xd2\x56\x33\x02\xde\x38\x91\x38\x0f\x4e\xee\x99\xf9\xf0\x3f\xb4\xde\xf2\x4e\x99\x20\xc7\x53\xce\xa2\x8b\x30\xe8\x7c\xc2\x48\x57\x89\x8d\x73\x31\x8f\x62\x16\x99\xcb\x5c\x1c\xb3\xac\x18\x1a\x73\xd1\x9c\xe5\xa6\x45\x2d\x37\xd2\xb0\x3a\x59\x6d\x80\x87\xd5\x50\x04\x53\x71\x22\xa7\xb2\x8b\xa6\xc2\xd6\x10\xae\xd2\x6c\x60\x11\xf6\xad\x88\x18\xc6\x54\x82\x26\x59\xf1\xf9\x14\xb2\x85\xc7\x16\xf9\xa6\x38\x90\x8d\x5d\x0c\xaf\xbc\xee\xea\x02\x58\xae\xbc\xde\xae\xb0\xd6\xae\x31\xca\xa2\x25\x20\x94\xa6\xf7\xcb\xad\xe6\x1a\x60\x5a\xd3\xb6\xa6\xbc\x7e\xd5\x57\x57\x80\xdc\x6a\x2f\x34\x50\xaa\x7c\xba\xac\xf8\xd5\x40\xd4\xc1\x41\x37\x5e\x05\xa4\xb3\x6e\xac\x3c\x2e\xce\xf6\xfc\x3a\x1d\xc5\x1c\x49\x85\x0d\x7d\x5e\x75\x27\xf1\x53\xdc\x75\xa8\x62\xdc\x07\x47\x63\x2a\x6d\xd3\xd1\x92\x9a\x19\xe9\xfa\x70\x70\x94\x19\xef\xfa\x70\x78\x98\x59\x59\x0f\xff\x96\x56\xd6\x51\x30\x9f\xa0\x78\xef\x6b\x71\xfb\x25\x83\x23\xe3\xf4\xcd\x9b\x55\x09\x23\x04\x21\xd2\xd2\xe5\xcb\xd8\x57\x4f\x08\x4d\xdf\xe2\xa5\x22\x11\xa6\x53\xf9\xa1\xa3\x5b\xb0\xaa\xcc\x0b\x72\x61\x88\x7a\x9f\xbc\x50\x5e\xd1\xa1\x90\xc6\x51\x3c\xf3\x52\x02\x8c\x7e\x55\x2e\xd7\x0c\xe2\x33\x52\x22\x65\x81\x98\x69\xf9\x06\x6d\x69\x15\xbf\x96\x14\x2f\x87\xa4\xdb\x29\xba\x4d\x21\x1a\x83\xc7\x7e\xc3\x9c\x12\x87\xc5\x55\xa2\x51\xc9\xd0\xad\xe7\xa3\x51\x30\xf3\xa6\xd3\x3b\xea\x98\x83\xfc\x95\xfd\x5f\x64\xb2\x7f\x66\xad\xb3\x91\x79\x27\xd0\xcb\xa3\xb3\x26\x7f\x98\x35\xf7\xe7\xbe\x4e\xa2\x27\xe8\xb6\xec\x43\x9c\xb1\x88\xe4\x1b\x23\x13\x77\x3d\xee\x31\x39\x88\x0e\x41\x96\xe4\xf2\x8d\x8c\xf3\x1a\x69\x44\xbb\x50\x7b\x81\x6e\x37\xe6\x30\xa1\x1e\x6f\x89\xff\x65\x3f\x8a\x35\xf1\x5d\xc1\xa3\x22\x08\xe7\x8b\x74\x13\xcc\xc7\xf9\x3b\x3f\x9b\xd6\x38\x81\xee\x8b\xbb\x47\x05\x51\xad\x60\x70\xea\x69\x21\xa6\xc1\xea\xae\x16\x64\x94\xec\x19\x0f\x32\xc6\x19\x40\x86\x07\xad\x6c\xd8\x2c\x72\xed\x2d\x37\x15\x98\xa5\x37\x83\xd9\x97\xe0\x6b\x2d\xbc\x1a\x3f\x81\xdc\x6f\x37\x15\x32\x23\xa7\x45\x8d\x94\xb4\x91\x3e\x1c\xb4\xa9\x8a\x52\x3e\xa5\x3f\xda\xde\x0a\xdb\xde\x0a\xfb\x6b\x1c\xb2\xbb\x28\x7d\xa5\x0c\xf5\xce\xc7\xef\xee\x16\x1a\x12\x6f\x52\x1c\xbd\x56\xb9\x4c\x46\x6a\x0e\xe4\xf8\x84\xab\x9f\x6c\x9b\x0e\x1e\x49\x04\xc4\xa5\xd7\x4a\xe7\x53\x67\x2b\xa4\xb2\xfd\xe8\x23\xa2\xbe\xd5\x09\x1a\xc5\x28\xbd\xa7\xd3\x15\xbc\xf9\x7e\xa1\xce\xa0\x41\x28\xc7\x16\x03\x55\x70\x4c\x1a\x4b\x72\xd5\xf3\x3e\x39\x2e\xa5\x43\xee\x60\xeb\x19\x89\x38\x1f\xa1\x31\x2c\xc4\xcf\x62\x34\x59\xd7\x20\x57\x9b\x4a\xef\x4a\x4c\x4a\x15\xb2\xbb\x52\x13\x94\x94\xdc\xd5\x44\x7c\x87\xa0\x0a\x8e\x07\x34\x94\x64\x8f\xa5\x6c\x8c\x8c\x88\xae\xf9\x18\x0b\x00\xb8\xd1\xb9\x08\xd7\x21\x37\xe3\xf1\x74\x1a\xdd\x80\x17\x0f\x83\x34\xf6\xe2\x3b\x60\xc7\x29\x1f\xd1\x9d\x25\x85\xdc\x47\xd9\xde\xfd\x93\x12\x23\x47\xf2\x17\x4f\x05\x56\x1c\x83\x0a\x31\xda\x70\x1f\x5c\xa3\xb3\x91\xec\x57\xc4\x72\x1e\xc5\x10\x84\x21\x8a\x49\xfa\xd7\x68\x91\x12\xdd\xdb\x94\x20\x8d\x24\xcf\xa3\x67\x7e\x74\xfe\xb0\x1f\xb4\x79\x63\xfa\x2e\x7e\xb8\x26\xd7\x0e\x54\xb5\xd7\xc1\x96\x91\x74\x62\x18\xb9\x9e\x15\x06\x52\xa5\x40\xae\x64\xc4\xe8\xf7\x37\x67\x84\xc7\xa8\x6b\xd8\xdc\xf3\x61\x14\x85\x49\xea\x85\x26\x1c\x55\x8e\xc4\x05\xbe\x73\x3b\x93\x12\x1d\xbd\x08\xde\xc3\x1f\x03\x68\xde\x1e\x8c\xe8\x7f\xb6\x40\x2f\xa5\x8a\x9d\x1e\xfd\x6f\xb9\xb3\xa3\xa8\x70\x6a\x14\x14\x7e\xab\x66\xd5\x37\x97\xc3\x88\xa8\x23\x5f\x43\x06\x23\xb1\x2a\x7f\xb1\x2c\x46\x6e\x4e\x0d\xca\xd5\xd5\x36\xcc\x84\xc2\xb6\x15\xdb\xe1\xc4\x34\x7f\x04\x9d\x49\x98\x0d\x66\x32\x22\x0c\xf1\xcd\xe6\x31\x7a\x7d\x7c\xc2\xb2\x18\x91\x7e\x04\x44\x6c\x2d\x15\x8f\x7e\x4d\xcc\x5c\x35\xa1\x51\xa9\xe6\xfd\x24\x35\x92\x99\x9d\x63\x52\x2d\x71\xd1\x57\x93\x60\x88\x30\xc2\x36\xc1\x90\x43\xd3\x9a\xe3\xf3\x25\xf7\x93\xeb\xd8\xa7\xe1\x55\x36\x9b\x11\x95\x92\x0c\xe9\x6b\x57\x4a\x34\xa4\x07\x73\x8f\xc9\x86\x36\xb8\x72\x9d\xd0\xd3\x72\x32\xe8\x16\xdd\x12\xab\xd9\x2f\xd4\x89\x98\x9c\x13\x14\x39\xaf\x84\xf2\x60\x14\x1a\xcb\xd4\x76\xae\x72\x73\xcf\x21\x81\xe2\x8e\x5b\x56\xa2\x99\xa7\xd3\x31\x75\x1e\x3e\xb9\x07\x65\x5b\xaf\xcb\x8d\xaa\x47\x7f\xcb\xb3\xfe\xdb\x5e\x77\x4f\xf1\x78\x1a\x0c\xf7\x30\xa2\x3e\xb9\x90\x9a\x14\xde\xa2\x70\xb4\x47\xae\xf1\x29\x9e\xd3\x80\x75\x85\x17\x33\xff\xc0\x7e\x93\x2b\x99\x78\xed\x83\x22\x48\xfc\xb0\x5d\x04\x97\x4c\xbc\x83\x56\xbb\xfc\xb0\x73\xa4\x28\xd9\x29\x3c\x8a\x83\x39\x9a\xf9\xad\x5e\x53\x79\x91\x2c\xf7\x68\x3e\xfc\xe8\x8f\x8b\xed\xa0\xeb\xf9\x47\x7f\x6c\x72\x99\xc8\x77\x3d\xf2\xd1\xde\x68\x3c\x54\x3e\x4e\x63\xcd\xe3\xbd\xab\xa9\xe7\xcf\xbc\x50\xf5\x3a\x52\x03\x43\xa3\xe2\xe3\xb9\xe7\xef\x79\x61\x12\xdc\x3e\x6a\x17\x89\x80\x5f\x05\x49\xd4\x6a\xb6\xda\x45\x8a\xb3\x57\x8f\x0e\x1f\x1d\x16\x47\x08\xbf\xfa\x13\xc5\x11\x0b\xbe\xa9\x78\x1b\x6a\xde\xd1\x83\x95\xbd\x09\xba\x2d\xbc\xf0\x50\x91\xb9\x68\x68\x6d\xbf\xf4\x3c\x1e\x15\x07\x37\xf6\x86\xc3\x20\x55\x3e\xdc\x9b\xa2\x2b\x6f\x74\xf7\x35\x9c\x4f\x90\xb3\x21\x31\xcd\xc8\xaf\xe2\xec\x22\x0f\xb3\x49\x25\x7e\xb2\xb9\x44\x7e\xe3\x29\x54\xb8\x63\xc8\x26\x8c\xf8\xde\xee\x8a\xef\x78\x7a\xf0\xef\x78\x56\x88\xef\xf4\x5b\x36\x07\x32\x3f\x28\xf2\x8d\x71\x3c\xc5\x80\x32\x7a\xc9\xfd\x86\xa2\xc3\xd9\x5a\xfa\x95\xc6\xf9\x5f\x82\x89\xb3\xa7\x51\xae\x06\x66\x59\xda\xac\xcc\xa9\xe2\x89\x60\x50\xf9\x09\xe5\x4b\xf1\x44\x66\x47\xf1\x30\xcc\x3f\x92\x98\x8f\xfc\xc6\x3c\x47\xbe\x64\xac\x46\xa9\x32\x62\x84\x62\x8c\x25\x7d\xe7\xfc\xb4\xb1\xc3\xa6\xdc\x21\x93\x2c\xbd\x37\x71\xf8\xe4\x9e\x64\x28\x7b\xce\xe8\xd6\x87\x83\x56\x3d\xf7\xb4\x74\x16\x9a\x7f\x2b\x1c\xee\x72\x8f\x25\xee\xee\xc3\x41\xb7\xfc\x92\xce\x84\x3e\x1c\x1c\x14\xde\x51\xa6\xec\xc3\x41\x2f\xff\x42\x1a\xe4\x3e\x1c\x1c\xe6\x5f\x66\x8e\x7e\xb9\xc7\xc5\x79\xd8\x87\x5e\x33\x5f\x02\xcf\xba\x3e\xf4\x0a\x5d\x16\xcc\xdf\x87\x5e\x5b\xf5\x2a\x8d\xf1\xab\xae\xfa\x95\x98\x16\x7d\xe8\x75\x14\x45\xf0\xac\xe8\x43\xef\x40\xf1\x2a\xa2\x6d\x16\xba\x9e\x9b\x3d\x7d\xe8\x1d\x96\x5f\x8b\xa9\xd4\x87\xde\x91\xf2\x35\x9d\x57\x7d\xe8\x3d\x2a\xbf\xce\xe6\x53\x1f\x0e\x9b\xe5\xf7\xf2\x24\xec\xc3\x61\x81\x5a\x4c\xa6\xf4\xe1\xb0\x40\x2b\x36\xcd\xfa\x70\xd8\x55\xbd\xe0\x73\xae\x0f\x87\x05\x32\xe1\x89\xda\x87\xc3\x02\x85\x32\xa1\xd6\x87\xc3\x02\x89\x84\x6f\x67\xe9\x31\x96\x9a\x7d\x38\x3c\x2a\xbf\x38\xc0\xd4\x3a\x7c\x54\x7a\x81\x69\x7c\xd4\x2c\x3f\x3e\xc2\x80\x8e\x5a\xa5\x17\x58\x16\xf7\xe1\xa8\xd0\xf9\x4c\xf6\xf4\xe1\xa8\xd0\x3f\xb1\x5a\xf4\xe1\xa8\xcb\x26\x23\xd7\x53\x7b\xcd\xad\x4f\xc1\xd6\xa7\x60\x59\x9f\x82\x93\x09\x1a\x7d\xc4\x94\x24\x62\x8f\x86\x9b\x49\xc0\x8b\x11\x24\x8b\x39\x1e\x18\xa4\x48\x9f\x2d\x11\x9e\x6c\xb4\x69\x2e\x79\xf8\x6e\x00\x0f\x78\x8b\xfa\x53\x44\xba\xd6\xa8\x23\x66\x28\x51\xfc\x1a\x82\xe1\x2a\x11\x3b\x43\x63\x14\x23\x62\x57\x8a\x83\x2b\x62\xea\x09\xc2\x40\xb1\xf9\x27\xb1\xaa\x16\x73\x14\x93\x63\x63\xf9\x0e\x1b\x2e\xaf\x03\x7f\xbc\xb8\x9a\xa1\x30\x2d\x94\x86\x34\x82\x89\x17\xfa\x53\x94\x1b\x32\x5d\xa3\x43\x65\x93\x39\x6f\x7e\x02\xc6\x1c\xcc\x4b\x3a\x83\x1e\x92\xb1\xa6\x17\x43\x82\x30\x3d\x52\x57\xe0\x1c\xc2\x48\xca\xcd\xbd\x79\x86\x31\x1b\x3a\xb3\xda\xf4\xc4\xfc\x17\xdc\x1a\x79\x20\x63\xac\x37\x0b\x58\x7b\x12\xa5\x13\x14\xb3\xe0\x75\xd7\x01\xba\x71\xe9\x93\x13\xbe\x52\x6f\x4f\x39\xce\xf0\xe9\x93\xbe\x2e\x9f\x4c\xa4\x8b\xe4\x24\x96\x83\xfa\x0e\x0b\x34\xe1\x1d\x4e\x24\x9a\xba\xa1\x52\xd5\x1d\x63\x8b\x5a\x6c\x5b\x3d\x3b\xba\x7a\x0c\x96\xaf\x7d\x1a\xa6\x9d\xf6\x2a\x4d\x2f\x5f\xfb\xd9\x34\xf2\x56\xad\xde\xeb\x92\xe7\xea\xba\x6b\xe0\xf3\x06\x9d\x76\x75\x90\x1f\xdd\xa5\xe8\xcd\x78\x9c\xa0\xb4\xf4\x98\x86\x52\x5a\x66\x72\xbc\xa0\xa2\x25\x43\xa2\xea\xfc\xce\x6a\xae\xc7\x58\x9a\xb5\xf2\x58\x74\x0c\x06\xea\x0e\xdb\x6c\xa8\x4f\x6f\xd3\xd8\x1b\xa5\x24\x9b\x98\x63\x40\xc2\xca\x31\xba\x54\xf8\x56\x8f\xd4\x45\xf3\x30\x64\xb0\x2e\x82\xf7\x52\x16\x86\xc0\x29\x01\x83\x35\xc5\x8c\x70\x55\xa3\x07\x8c\x59\xbc\x39\x7d\x35\xb1\x8a\x35\x46\xde\x74\xca\x74\x11\x16\xc7\x4b\xd5\x73\x2d\x0b\x6a\xb5\x26\x09\xc1\xa7\xb8\x08\x6e\x07\x42\xbc\x97\xd3\xad\xae\x65\xdc\xbc\xf9\x7c\x7a\xc7\x90\xf3\xe2\xab\x05\x5e\x46\xb5\x9e\xfd\xce\x41\xc5\xd8\x62\xda\x98\xc7\x51\x1a\xe1\xce\xca\xcb\xea\x72\xee\xc5\x7a\x35\xc3\xc1\x40\xdd\x6b\x6d\x15\xff\xad\xe2\x6f\x53\xfc\x49\x62\xe9\x6f\x2c\xcd\x44\xe6\x05\x7a\x99\xe5\x49\x5f\xc5\x75\x98\x28\x7d\xd4\x51\x0a\x52\xf5\xd9\x37\x86\xf5\x8e\x0b\x7c\x1d\x6b\x93\x13\x38\x83\xcf\x95\x6d\xc3\x05\x9a\x45\xa3\xd7\xb5\x2f\x11\xef\xf0\x1a\x30\xa0\x03\xda\xf0\x86\x09\xfd\x92\x04\x21\xcb\xf3\x89\xd7\x83\xe6\x6d\xab\xc9\x3e\x3b\xf0\x49\x9b\x2c\x49\x25\xf2\x6a\x9a\xa3\x38\x9d\xdb\xf5\xeb\x27\x07\x05\xff\xe3\x2a\xae\xd7\xc5\x48\x6a\x30\x60\x83\x6e\x71\xbd\xbe\xf4\xa3\xb3\x4a\xee\x44\xd9\x11\x2b\x53\xaf\xf2\x1b\xa1\xda\x85\x79\x4d\x6e\xde\xf6\x0e\xbb\x07\xed\x4e\xb3\x55\x87\xe6\x2d\x1a\x8f\x7c\x6f\x78\xf4\xc8\x10\xd2\x8a\x56\x7a\x74\x34\xf4\xfc\xd1\x18\xd5\xc9\x88\x74\xda\x07\xdd\xc3\x9e\xbe\xce\xfb\xea\x5e\x43\x97\x7e\xf4\x36\x8e\x46\x28\x49\x88\x9b\x9b\x4c\x8e\xd7\x75\x88\x88\x56\x68\xd5\xbf\x6e\xbc\x39\x20\x12\x1c\xb3\x9a\x8a\xd3\xea\xb9\x29\x34\x6e\x3e\x94\xc0\xfd\x28\x09\xd2\x97\xb8\x1d\xfa\x15\x76\x21\xb0\x78\x16\x12\x56\xba\x94\x6a\xbe\xbe\xe0\x3f\xd4\xd3\x58\xfe\x48\x65\xf1\xbc\x32\x17\xc6\x9f\x5a\xad\x26\x35\xc6\x53\xd3\xca\xcf\x88\xea\xd6\xdd\x29\x44\x07\x05\xc3\x9e\x42\x0b\xbb\xdd\x55\x01\xcf\x22\x3c\xb3\xb8\xa4\x66\xc8\x5f\xc0\x2b\xfb\x45\xce\x8f\xc1\xc1\x8f\x35\x37\x66\x4d\x69\xcc\x60\x17\x9a\x26\x6d\x3b\x57\xb1\x95\xaf\xd8\x72\xae\xd8\xce\x57\x6c\x3b\x57\xec\xe4\x2b\x76\x9c\x2b\x76\xf3\x15\xbb\xce\x15\x0f\xf2\x15\x0f\x9c\x2b\xf6\xf2\x15\x7b\xce\x15\x0f\xf3\x15\x0f\x9d\x2b\x1e\xe5\x2b\x1e\x39\x57\x7c\x94\xaf\xf8\xc8\x9d\x01\x0a\xac\xd3\xaa\xc0\x3b\x45\xe6\x71\xe7\x9e\x56\x81\x7d\x5a\xee\xfc\xd3\x2a\x30\x50\xcb\x9d\x83\x5a\x05\x16\x6a\xb9\xf3\x50\xab\xc0\x44\xad\x03\xb3\x60\xdc\xdf\xc7\x2b\xe4\xc7\x20\xbc\xc2\x70\x02\x6f\x3a\xb4\xed\x94\x3d\xbc\x76\x5f\x58\xa9\x3f\x24\xc5\xac\x94\x1e\x91\x62\x56\xaa\xfa\xa4\x58\xc7\xda\x97\x93\xcc\xbd\x50\x5f\x0e\xf7\xe0\xd9\xb3\x9a\x57\x87\x61\x1d\x46\x75\xf0\xeb\x92\x80\xaa\xc3\x61\x1d\xde\x5d\x34\xb5\x8b\x34\xfe\xf8\x14\x82\x5f\x07\x01\x24\x1b\x81\x3a\xb4\xda\x18\x44\xcb\x08\x62\x44\x41\xd0\xe6\x29\x94\x4c\x66\xd5\xa1\x45\xb0\x68\x1b\x41\x0c\x29\x08\xd1\x09\x4f\x02\xd1\xa9\x43\x9b\x60\xd1\x31\x82\x30\x91\xa2\xcb\x48\xd1\x5d\x9a\x14\x07\x9c\x14\x07\x4b\x93\xa2\xc7\x49\xd1\x5b\x9a\x14\x87\x9c\x14\x87\x4b\x93\xe2\x88\x91\xe2\x68\x69\x52\x3c\xe2\xa4\x78\xb4\x34\x29\x5a\x4d\x4e\x8b\x96\x99\x3b\x4d\xc4\x68\xb5\x38\x35\x5a\x66\xfe\x34\x91\x03\xf7\x84\x22\x62\x66\x50\xe3\x34\xe9\x88\x79\x62\x66\x51\x23\x49\xba\x82\x24\x66\x2e\x35\x92\xe4\x40\x90\x84\xf2\xa9\x99\x28\xcf\x9f\x6b\x88\x52\x87\x03\x02\xc4\xcc\xa9\x3e\x05\xa1\xa4\x49\xaf\x0e\x8f\x08\x08\x33\xa7\x8e\x28\x08\x35\x45\xb0\xf8\xe9\x12\x20\x66\x66\x1d\x52\x20\x4a\x8a\x34\xeb\xd0\x6e\x12\x18\x66\x6e\x35\x91\xe3\x80\x91\xa3\x6d\x97\xa4\x3a\x72\x60\x86\x27\xf4\x68\xdb\x45\xa9\x96\x1e\x07\x9c\x1e\x6d\xbb\x30\xd5\xd1\xa3\xcb\xe9\xd1\xb6\x4b\x53\x1d\x3d\x1e\x71\x7a\xd8\xc5\xa9\x96\x1e\x5d\x4e\x0f\xbb\x3c\xd5\xd1\xa3\x23\xc8\x61\x17\xa8\x3a\x72\x1c\x09\x72\xd8\x25\xaa\x76\xb6\x74\x38\x3d\xec\x32\x55\x47\x8f\x36\x27\x87\x5d\xa6\xea\xc8\x71\xc8\xc9\xd1\xb1\x8b\x54\x1d\x39\xb0\x14\xa3\xf4\xe8\xb4\x1c\xe4\xc7\x8b\x17\xda\x09\x43\x31\xb1\xcb\xd4\x17\x2f\xd4\x04\x39\xaa\x03\x9e\xff\xef\x2e\x3a\x76\x91\xfa\xe2\x85\x41\x80\xf4\x08\x10\xbb\x48\x7d\xf1\x42\x43\x12\x3c\x63\x3a\x04\x88\x99\x55\x4d\x04\x69\x71\x82\xd8\x05\xaa\x8e\x20\x5d\x41\x10\xbb\x44\xd5\x11\xe4\x50\xd0\xc3\x2e\x50\xb5\xf4\x68\x0a\x7a\xd8\x25\xaa\x96\x1e\x1d\x46\x90\xae\x5d\xa4\xea\x08\xd2\xe4\x04\xe9\xda\x45\xaa\x8e\x20\x1d\x4e\x90\xae\x5d\xa2\xea\x08\xd2\xe3\xf4\xe8\xda\x25\xaa\x8e\x1e\x8f\x38\x39\xec\x12\x55\x47\x0e\x3c\x75\x19\x3d\xec\x22\x55\x3b\x63\x0e\x04\x41\xec\x32\x55\x47\x90\xb6\x20\xc8\xa1\x83\x0c\x39\x3d\xd5\xee\x5e\x28\x22\x76\xa1\x7a\x7a\xaa\x26\x09\x66\x77\x22\xcc\xba\x76\xa9\x7a\x7a\x6a\x50\xcb\x88\x74\x3f\xb0\x8b\xd5\xd3\x53\x35\x45\xb0\x56\x46\x86\xe6\xc0\xae\xa8\xea\xe8\x81\xc7\x97\x10\xe4\xc0\x2e\x54\x75\x04\xe9\x70\x82\x1c\xd8\x85\xaa\x96\x20\x4d\x41\x10\xbb\x50\xd5\x11\xa4\x25\x08\x62\x97\xa9\x3a\x82\x1c\x71\x7a\xd8\x65\xaa\x8e\x1e\x84\xdd\x29\x41\xec\x42\x55\x47\x90\x9e\xa0\x87\x5d\xa8\x6a\xe9\xd1\x11\x04\xb1\x0b\x55\x1d\x41\xba\x8c\x20\x3d\xbb\x4c\xd5\x12\xa4\xc5\x09\xd2\xb3\x0b\x55\x1d\x41\xda\x9c\x20\x3d\xbb\x50\xd5\x11\xe4\x11\xa7\x47\xaf\x63\x91\x21\xc4\x1b\x20\x45\xf1\x0c\xf9\x81\x97\xb2\xc0\x32\xe4\x1a\xbc\xbe\xce\x8b\x8b\x26\xb1\x9b\x93\xbf\xbb\xe0\x99\x0e\x9c\x68\xf9\x16\x2b\xdf\xc2\xe5\x87\xf6\xf2\x6d\x56\xbe\x8d\xcb\x8f\xec\xe5\x3b\xac\x7c\x07\x97\xf7\x8d\x07\x60\xa6\x83\x95\x67\x8a\x5b\x80\x6b\x4a\x73\xe9\x7b\xa9\x27\xec\xe5\xf8\x87\xcd\xcc\xe5\xa5\x9e\x88\x46\xef\xa5\x9e\xa3\x79\x3d\x7c\x1c\xa4\xc9\xbb\x28\xf5\xa6\xa2\xb1\xf0\x89\x97\x7a\x34\x40\xc2\x43\x50\x85\x61\x2a\xd5\x7f\x85\xc6\x29\x6f\x56\x04\x57\xa0\x75\x8d\xa4\x38\xf6\x7d\x60\xbe\xdf\x86\xf9\xc3\x3b\x76\x91\xb5\xf5\xd3\x4f\x3f\xc1\x01\xf1\x7b\x69\xde\x1e\x35\x33\x77\x97\xac\xc4\x3f\xa0\xd3\x36\x33\x72\xbe\xf7\x2f\x60\x00\xe4\x94\x74\x3c\x8d\xa2\xb8\x26\x91\x65\x3f\x77\x54\xea\x42\x0e\x52\xef\x15\x0c\xa4\x5f\x26\xf9\x20\xfa\x57\xab\xd5\xb2\x0e\xec\x42\xaf\xbb\x43\x3a\xfa\x88\x64\xd9\xeb\xee\x50\x93\xac\xfd\xfc\x89\x83\x61\xfd\x12\x87\x4f\xf2\xc3\xe5\x4e\x9f\xca\x90\xd9\xd1\x53\x11\x74\x85\xb3\x27\xa3\xe8\xac\x42\x9a\x6e\x55\xd2\xbc\x52\x91\xe6\xd5\x3a\x48\xf3\x4a\x49\x9a\x57\xcb\x90\xc6\x4c\x1c\x39\x94\x49\x4d\x50\x8b\xa7\x6c\xd8\x85\xd6\x8e\x4b\x88\x22\x72\x01\x98\xde\x3f\x27\xf1\x91\x6c\xf1\x4c\x2e\xe7\xf4\x3c\x79\x6d\x71\x71\xd8\x99\x7b\x76\x36\x68\x99\x65\x2f\xd8\x0d\x62\xb7\x50\x38\x4b\x1f\x5e\x3b\x78\x5a\x80\xab\x7f\xa2\x40\x9d\x9c\x3d\xbf\xb8\x70\x38\x74\x7e\xc1\xbc\x38\x6a\xb5\x17\xf2\x19\xf2\x8b\x55\x0f\x8f\x5f\xe4\x4e\x8d\x5f\xe8\x8e\x8b\x57\x38\x0f\x3e\xa3\xbe\x6b\x94\xa5\x58\xec\x76\x9f\x8c\x98\xbe\x1a\xbf\xbf\xad\x1f\x7d\xfd\x4a\x4c\xae\x8c\x3b\xaf\xc1\xe4\xec\x88\x44\x9b\x12\x9e\x24\xe4\x67\xe6\xa7\x68\xd4\xc4\x48\xd1\xcb\x12\xcb\x3a\x85\x8a\xe2\x31\x7c\x71\xd1\x8a\xb7\xd4\xcb\x8f\x45\x77\x0b\xb6\xf6\xdb\x3a\x24\x75\xd0\xfb\x74\x90\x75\x0a\x06\xe0\xc1\x2e\xd4\x6a\x43\xf8\x81\x2a\x4c\xb5\xff\x8d\xbf\xfa\x3b\x58\x98\xde\xc2\x2e\xa4\x1a\x04\x45\xfe\xd3\x10\x73\x51\x42\x85\x1c\xcd\x31\xdd\x69\xc3\x1e\x24\x3b\x04\x84\xca\xc5\x4b\xc9\x37\xa2\x1b\x05\x7b\x5f\xf5\x6e\x10\x3d\xae\x36\x82\x1f\xe0\x7f\xdf\x63\x37\x0a\x36\x87\x4a\xdd\x18\xc2\x1f\x30\x82\x3f\x70\x4f\xee\x09\xfb\xc2\x66\xa7\x12\xf6\x18\xf3\xda\x10\x3e\xdd\x07\xf9\x75\xbe\x67\x5c\x32\x67\x3d\x4c\x23\x12\x1a\x86\x46\xa0\x78\x20\xe2\xb3\x07\x78\x53\x33\xf6\xd4\x71\x86\xaa\x66\x6a\xe1\x61\x5c\x0a\xb1\x91\x88\x9c\x70\x07\xaf\x89\xe5\x52\x11\xca\x97\xca\x81\xc2\x24\xa2\x70\x58\x7e\xfd\xe4\xc0\x12\xa6\xc5\x5c\xd7\x1c\x9b\x45\x9d\x80\x24\xe7\x1e\x78\x49\xa3\x80\xbe\x40\xd3\x39\x8a\x6b\xaf\x9f\x1c\x54\xf3\x5a\xd4\x72\xce\x8b\xd7\xc7\x27\x5f\x09\xdf\xac\x1c\xa7\xb5\x1a\xe7\xe9\xa2\x08\xdd\x37\xe7\xd1\x98\x32\x82\x7b\x5e\xcc\xbc\x11\xe6\x20\x46\x41\x7d\xc4\x57\x35\x13\xb1\xea\x65\x46\x9a\x79\xa3\x3c\x33\xc9\xf5\x3e\x53\xf7\x69\x17\x57\xfe\x62\x3a\x60\x17\x07\xfe\xf6\xdf\x32\xc2\xcc\x36\x9b\xcc\x26\x5c\xfe\xcb\x12\xef\x21\x8b\xa5\x0f\xcf\x10\xf2\x87\xde\xe8\x23\xcb\x28\x37\x8b\xfc\x92\x5c\x2b\x4e\x1a\xc1\xd6\xa4\xf0\xc9\xb3\xc7\x78\xcf\x62\x51\xc5\x89\x37\x3e\x29\x99\xbb\xdf\x42\x1c\x94\x29\x26\xaf\x31\x30\xe6\xe0\xad\x96\xdb\x27\xcf\x1e\x37\x9e\x86\x34\x77\x35\xb9\x00\xf0\xec\xb1\xc5\x23\x7c\xae\x71\x83\x66\x97\x92\x1c\x5d\xa1\xdd\xb6\xb4\x2c\x67\x04\xdf\x21\xd0\x9f\x36\xbf\x37\x29\x5e\x31\xad\x90\x8b\x57\xac\xaf\xcc\x13\x55\xbf\x44\x77\x49\x1a\x23\x6f\x76\x1c\xfa\x8c\x34\x8a\xcb\x57\x11\xbb\x08\x28\x60\xd7\x59\x6b\xd6\xed\xfc\x19\x9a\x21\x92\x5e\x9a\x5c\x02\xa3\x2c\xc2\xb2\xbe\x91\x68\x93\x21\xba\x4d\xe9\x63\xbb\x05\x01\x5d\x3f\x66\x49\x0b\x09\x5a\x8d\x64\x1a\x8c\x50\x8d\xe3\x26\x9c\x10\x05\x92\x55\xee\x64\xe9\x19\xe6\x09\xda\x32\xcc\xfa\xc6\x92\x5c\xba\x9c\x04\x49\xf5\x91\xbc\x7f\x5e\x7e\x97\x75\x7b\x88\x46\xd1\x8c\x45\x9a\xc4\x8c\x19\x44\x8b\xa4\x3a\x1b\x0b\x42\xac\x6f\x63\x6f\xa0\x45\xcd\x4a\x00\xc3\x05\x7d\xb3\xb9\x8a\x58\xc1\xae\xb3\x68\xd8\xd7\x5a\x52\x16\x52\xcb\x52\x1c\xd5\x45\xf1\x2a\x1d\x5c\x5b\xed\x32\x02\x0a\x0c\x20\xb8\x66\xac\xd4\x74\x11\x4c\xd1\x35\x82\xd3\x5f\x89\x1d\x2f\x59\x0c\x13\xf4\xef\x05\x0a\x53\x47\x7b\x26\xe9\xad\xb8\x3a\xbf\xf4\x3d\xd4\x62\x07\x0a\x3c\xe2\xce\x17\xf8\xc3\xe6\x33\xa2\xc3\x4d\x00\xd4\x04\xf0\x3a\x18\x68\xb2\xbf\x0f\x8c\x49\xd4\xef\x55\xa6\xce\xa1\x7b\x7c\x6f\x7a\xf7\x38\xbb\x77\x43\xe2\x74\x0b\xcc\xa8\x61\xd3\xb1\x9f\xca\x9d\x3c\xd7\x96\x9f\x3d\x5e\xee\xa2\x2c\x57\x43\xd6\x92\x85\xa7\xd7\xd9\xea\xdd\x5b\xbd\x7b\x7d\x7a\x37\xfc\xf7\x3c\x46\x09\x8a\xaf\x8b\xbb\xdc\x87\x70\x12\x2d\xc2\x14\xc5\x92\xe6\x4d\x4c\xe7\x5e\x1a\x0c\xa7\x6c\x4d\x86\x27\x31\x3c\x8e\x03\x2f\x84\xe7\x34\x0c\x18\x8c\x83\x29\x42\xe1\xa8\x51\xdc\x65\x3f\x14\x09\x73\x49\x1e\xe2\xc2\xe4\x78\x77\x56\x2a\xfe\x2f\x2f\x84\x17\xf1\x62\x78\x07\x1f\x26\xf8\x4f\xe3\x06\x0d\xff\xfb\x6a\xe6\x05\xd3\xc6\x28\x2a\xca\x74\xcb\x16\xe0\xdd\x19\x47\xcf\x71\x27\x20\x57\x58\x7e\x43\x20\x5a\x0a\xc2\xd1\x6f\x51\xec\x93\x25\x52\x2b\xca\x30\xfb\xd6\x48\x11\xa0\x87\x29\xec\xf4\x63\x87\xb0\x31\xfd\xf6\x1f\xd8\xdf\x8f\xae\x51\x3c\x9e\x46\x37\x16\x4d\xac\x85\xfb\xca\xa1\xb5\x7a\x1c\x9a\x4d\x81\x6b\xcb\xd5\x8e\x5c\x6b\x75\x98\xae\x95\x15\xd7\x57\x20\xd9\xd8\x5b\x52\xa7\xf6\xf7\x81\xf7\x09\x86\x2d\x7d\x45\xcb\xa9\x17\xe9\xb1\xc9\xc9\x42\x34\xde\x96\x29\x6a\x2e\x4f\x00\xb7\x1d\x00\x0b\xe0\x9d\x6a\xc0\x49\x03\x1d\xc7\x06\xec\x2b\xbe\xfc\xd9\xdd\x1d\x76\x5c\x80\x9a\x8b\xb8\xb7\xb9\xbb\x3b\x6c\x5b\xda\x33\xb4\xe5\xd6\xce\xee\xee\xb0\xb5\xec\x39\x20\x61\x50\x33\xa1\x49\x91\xdd\x01\x61\xd0\x1f\x7f\xc4\xd3\xc6\xa9\x70\x9b\x1e\x86\x3a\x94\xd5\x8e\x88\xbd\xfb\xa2\xb9\xe6\x6d\xb3\xc5\xce\x4b\xab\x69\x70\x4c\x31\xc1\x80\x2a\x1f\xc3\x04\xe1\x88\xad\x07\xb5\x11\xfd\x6b\x16\x64\xac\x10\xf5\xb3\xe2\xf2\x2f\x7b\xb8\xc3\xa4\x9a\x6d\xaf\x8a\xb5\x4d\xe2\x6c\x04\x81\xb4\xb6\xd0\x35\xa4\xb4\xf8\x3c\x48\x80\xb4\x90\x40\x14\x4e\xef\xe8\x0a\xf5\xe4\xb7\x37\x67\x4f\xe0\x03\xfc\x08\x9a\xc8\x58\xf8\xc3\xf1\x6a\xa9\x90\xd5\xfb\xc1\x99\xc9\xcc\xea\xbb\x52\x1a\xcb\xd1\x9c\xf5\x48\xac\x41\x0d\xcd\xe3\xaf\xdc\x54\xb0\x92\xa5\xc0\x54\xb5\xb8\x15\x34\x62\xc6\x74\x18\x81\x9a\x18\x14\x63\xbf\x5d\x37\x91\xe0\xb6\x91\x84\x8c\xc7\x8a\x98\x54\xd8\x56\xc2\x8a\x5b\x4b\xa8\xb6\xbd\x04\x9b\x3c\x55\x88\x04\xab\x7f\x9b\xbc\x1b\x65\x95\xe4\xce\xeb\xaa\x2e\xbf\xf7\x04\x87\xfd\x27\xac\xbe\x07\x85\x15\xf6\xa1\xa0\x17\x25\x15\x6c\x89\x99\x5c\x90\x4d\x8a\x42\x74\x28\x2b\xf1\x9d\xaa\xa8\xbb\xca\x06\x57\x06\xb2\x86\x7d\x6e\x77\xbb\xcf\xdd\xee\x73\x37\x79\xbe\x54\xda\xdd\x56\x3c\x57\x7a\x77\xe6\xbe\x9b\x5c\x69\x1b\xa9\xd0\x0c\x8a\x2a\xc1\x56\x17\xd8\xea\x02\x5f\x40\x17\xd0\xbd\xba\xcf\x95\xfd\x34\x1c\xc5\x88\xc4\x1a\x66\xad\xda\xd5\xfc\x8c\xdf\xf6\x80\xde\xeb\xd0\xbc\x21\xfe\xca\x9f\xa0\xb9\x55\x2e\xce\x96\xd2\x2a\x56\x53\x27\xd6\xa3\x47\x1c\x6c\xf5\x88\xad\x1e\xb1\x41\x3d\xe2\xe9\x14\x8d\xd2\x38\x0a\x83\x11\x9c\x44\x3e\x1a\x46\xd1\xf2\xbe\x2a\x4f\x4f\x5c\x7d\x55\x68\xc9\xe5\x75\x8a\xa7\x27\x79\x5f\x15\xfc\x7b\xd3\x3a\x84\xac\x10\xe4\x05\x7e\x1e\xc6\xca\xc7\xd5\xb8\x33\x39\x71\xf5\xa5\x3b\xe7\xa3\xcd\x75\x8e\x49\xcb\xa7\x27\xab\x9c\x46\xd2\xda\x6b\x90\xae\xbd\xad\x74\xdd\x4a\xd7\x0d\x4a\xd7\x37\x8b\x74\xbe\x48\x57\xf7\x02\x7c\xe3\xec\x05\xf8\x66\x45\x2f\xc0\xe2\x6e\xed\x4d\xc1\x2b\xf0\xcd\xd7\xef\xe4\xf5\x55\xec\xd6\xca\x7e\x2b\xe2\xc9\x3d\xec\xd8\x0c\xd8\x7c\xb3\xbb\xb6\xad\x51\x35\x7b\xa4\xee\xeb\x9b\x82\x83\xa6\xd3\xbe\xe7\xcd\x4a\x7e\x42\x6f\xd6\xe5\x27\x74\xb8\x5d\x99\xb7\x2b\xf3\x06\x57\xe6\xe3\x9f\xcf\x4f\xe1\xf7\xc6\xa3\x76\x87\x47\x4c\x80\x24\xc5\x52\xf7\xaa\x74\xaf\x47\xbb\x34\xcf\x3d\xbf\x71\x1c\x26\xc1\xef\x18\xca\x40\xd5\xf7\xb9\xe7\xcb\x4b\xa0\xef\xa5\x9e\xe4\x5a\xea\xe2\x52\xaa\x11\xa7\x3c\x42\xc5\x79\x76\x5f\x3d\x77\x7f\x5d\x23\x56\x72\x0b\x20\xaf\x98\xad\x88\xa6\xbb\xed\x84\xf1\x17\x61\x2a\xc8\x65\xc8\xe4\x42\x6e\x31\xbe\xa5\xe5\x4a\xad\xd0\x07\x7b\x79\xf4\xff\x51\x78\x6f\x46\x83\x26\x03\x98\x7a\x09\xcd\x27\x03\xf3\x28\x09\xf4\x71\x66\x31\x36\xb8\x2c\x06\xfc\x36\xe2\xa4\x12\x4d\xef\x16\x50\xdd\x83\x96\xa9\xf5\xb7\x9e\x22\x2b\x1b\xf0\xf8\x01\xa3\xa9\x37\x9b\x6b\x93\x76\x67\x71\x44\x2e\x64\x84\xb2\xac\x33\x79\x4c\xb2\xac\x33\x72\x69\x5b\xfe\x99\x7c\x1c\x83\xdd\x02\x50\xd5\x79\xbe\xf2\x1e\xf8\x22\x54\x30\xaf\x89\x63\x9f\xa3\x14\xc2\x05\x71\x81\x8f\xc6\x79\x2e\xa1\x0e\x0f\x62\xbc\xdc\x99\x46\x22\x58\x2d\xdf\xaf\x3d\x68\xed\x70\xc2\x59\xbc\xb6\x32\x95\xc5\x18\x1b\xa5\x00\xdf\x81\x6e\x05\xe9\xe4\xb0\x46\x72\x89\x71\xfb\xa8\xdd\x59\xcf\x3a\x79\xb4\x5d\x27\xb7\xeb\xe4\x06\xd7\xc9\xd3\xf3\x37\x40\xb2\xd5\xae\xb6\x4c\x9e\xb2\x9c\xb7\x9b\x5a\x26\xbf\x8d\xe5\x2e\x13\x2f\x55\xd6\x3b\xcb\x8a\x13\x85\x23\x2f\xad\xa9\xf3\x5a\x35\x62\x2f\xf4\xa3\x59\xad\xb4\xc2\xed\xec\x58\x2a\xd2\x2b\xba\xb5\x8b\xd2\x8a\xd4\xee\xbe\xaf\xe3\xfa\xdb\x85\xe4\xbe\x17\x12\x3e\xa7\xd6\xb3\x90\x3c\xda\x2e\x24\xdb\x85\x64\xb3\x0b\xc9\xfe\xe9\xd3\x13\x78\x74\xf8\xe8\x70\xaf\x05\x6c\x4a\xc0\x6b\x94\x4e\x22\x1f\xda\x55\x57\x13\x92\x22\x7d\xdd\xab\xc9\xb1\xef\xd3\x00\x78\x7a\xe1\xe2\x20\x73\xb9\xe8\xc4\x90\xe8\x47\x48\x4c\x6d\xcb\xff\x83\xe2\x08\x23\x4e\xee\x4c\xc6\x28\xd1\x2c\x68\x39\x2a\xe0\x3a\x8c\x8c\xf8\x77\xa9\xa3\x9b\x93\xd0\x4c\x0a\xfe\xc9\x90\xd6\x8b\x42\x2d\xbe\xa4\x49\xda\x8e\x83\xb8\x8d\x42\x04\xb3\x28\x46\x74\xb7\xb7\xb7\x47\xa8\xe4\x32\x50\x5c\x0a\xef\xed\xad\x55\xec\x12\xe6\x5b\x8b\xd8\x3d\x5c\x67\x06\xf9\xad\xd8\x2d\x77\xe2\xef\x2e\x76\x8f\x21\x8c\xa2\xf9\x6a\xca\xfb\xcf\x7c\xda\x3a\xc9\x5b\xe5\x48\x38\x0a\x1e\x75\xdd\xe5\xe6\xa9\xc0\x7a\x3d\x13\x75\x9d\x19\x5f\xb7\x13\xb5\xdc\x89\xbf\xfb\x44\xfd\x1f\x69\x31\x5d\x6e\x9a\x4a\xeb\xeb\xb7\xb4\xcd\x5e\xd9\xa0\x2a\xdb\x3a\x4b\xdb\xee\x5a\xcd\xbc\xf1\xde\x81\x4f\x9f\x8a\x8f\x36\xa7\x38\xd9\xe9\x58\x31\xec\xf4\xfe\x3e\xfc\x82\x91\xd0\x03\x0c\x4a\x81\xa4\xa9\x81\x5b\x55\xfe\x66\x12\x4c\x11\xd4\xbe\xab\x65\xb1\x6f\xb3\xa4\xe8\x24\x96\x60\x29\x09\xba\xb8\xe6\x6b\x3c\x0a\x0d\x94\x0a\x18\xe8\x0f\x39\x8b\xc1\x78\x49\x9e\xdd\xf5\xe9\x70\xd2\x54\x59\xcf\xea\xf0\xf7\x0c\x27\x96\x4c\xbc\x56\xe1\xd1\x64\xe6\x8d\xbe\x9a\x95\x82\xe0\x47\xbe\x11\xb4\xbe\xcd\xc5\xc2\xe6\xf5\xf3\xc5\x92\x89\x3f\xf6\x12\x24\x12\x83\xe3\x1f\x9a\x72\xd5\x93\x8e\x97\x13\x89\xab\x4a\x9d\xbf\x38\x6e\x65\x99\xab\xf1\x2f\x4d\xc1\x17\xaf\x8f\x4f\xb2\x82\xf8\x57\xa5\x40\x95\x6f\xbd\x24\xc1\x52\x77\x0f\xf7\xd1\x87\x97\xe8\x0e\x9e\xa0\x38\xb8\xf6\x68\x70\x60\x3e\x1a\xed\xe5\xd2\x6f\xbf\x7d\xfc\xf2\xc9\xb3\x76\x86\x9e\xf8\x8d\x5b\xb3\xb8\x56\xa9\x31\x06\x76\x21\x28\x1c\x07\x57\x8b\x98\x62\x19\xcd\xf1\x9f\x44\x85\x18\x68\xe2\x3f\x02\x0b\x80\x19\x47\x73\x14\xa7\x77\xf0\x1f\x6a\x83\x25\x91\x2f\xc9\x3a\xfe\x6e\x42\x5c\x92\x20\xc1\x3f\x02\x7a\x11\x3b\x81\x34\x12\x61\x95\x1a\xf0\x04\x8d\xbd\xc5\x34\xed\x43\x17\x6a\xad\xf6\x11\x0c\x83\x34\xd1\x05\x64\xcf\xb5\x45\x23\x43\x7e\x66\x51\x64\x45\x58\x56\x14\xb3\x18\x5a\x12\x68\x3c\xf0\x55\xd0\x0f\x52\x44\x89\x92\x10\xb0\x99\x65\x59\x7a\x91\x46\x30\x47\xf1\x38\x8a\x67\x52\x43\xda\x56\x14\x23\x8b\x3f\xa3\xf1\x55\xdf\x65\x14\x81\x3a\x64\x9d\x93\x3c\x17\x98\x4a\xfb\xd0\x69\x1b\xf2\x9a\x53\x42\xd0\x8e\x1b\x8a\x65\xdd\xd1\xa2\xfe\x79\x47\x1b\xf2\xdb\xc4\x5a\xa7\x61\x90\x06\x24\x2f\x47\x02\x1e\x84\xe8\x66\x7a\x07\xd4\xc8\xe5\x13\x86\xf0\xb3\xd9\xc1\x65\xd5\x32\x8c\x47\x23\xaf\xbe\x21\xab\xd9\x67\x4c\x4d\xa8\xbd\x21\x6c\xec\x4d\x77\xc8\xd0\x8d\x54\x2c\xce\x83\xac\x8d\xa3\x98\x98\x85\x32\x64\x96\xc0\x41\x1f\x31\xd5\x5c\x11\xb8\xd7\x9f\x3f\x96\xbd\x2f\xa5\x09\xce\xad\x82\xda\xcb\x44\x55\x80\xfc\x27\xe3\x9f\x23\xea\xfc\xb5\x56\x90\xf5\x3c\x2f\x35\x9b\x4d\x53\x23\x9a\xd9\x10\x84\x41\x2a\xab\xc6\xa3\xf1\x95\xdd\x03\x1d\x0f\xfa\x40\x7c\xe5\xf3\x08\x57\xad\x1c\xc3\xde\x2c\x2b\x89\x07\x09\x8d\x50\xe7\x2a\xee\x97\x67\xe9\x72\x30\xe1\x39\x6b\x93\xb0\x35\xff\xa1\x6d\xc0\x00\x28\xf1\xa6\x29\x1c\x93\x3f\x4b\xe0\xa7\x09\x4b\xcc\x43\x1d\x69\x62\x1c\x5b\x80\xae\x3c\x87\x10\x56\x1c\x3e\xfa\xe3\x06\xcb\x74\x50\xe3\x04\xaa\x93\x7e\x56\xe6\x44\x06\x46\x66\xc6\x02\x44\x47\x67\x64\x7d\x21\xe2\x8b\x9c\xe3\x5d\xfb\xbd\xbf\x20\x25\x8a\x8a\x19\x28\x0b\xc5\x4c\x02\x45\xb3\x99\x8a\x27\x06\x5d\x12\xea\x82\x75\x1c\xae\x19\x12\x11\x4e\x13\x4b\xd9\x32\x25\xd1\xe1\x7f\x49\xc6\xa1\x74\xac\x61\xcb\xd8\x43\xf6\xd2\xa7\xa1\x8f\x6e\x55\xb5\x2f\x9a\xb7\xec\x4c\xc4\x96\x9f\xb3\x42\x66\x27\x81\xaf\xd8\x38\x8b\x27\x62\xfb\xec\x86\x32\x07\x90\x3d\x71\x02\xc0\x35\xa4\x01\x66\x82\x06\xfb\x65\x73\x26\xcf\xb4\x0f\x5a\x2d\x7b\x50\xc5\x41\x5c\x5f\x90\x6d\xe6\x0b\xd4\xe1\xb9\x6c\x7e\xe4\x48\x5b\x5d\x98\x05\x85\x60\x40\xd8\xb1\xb1\x98\xfb\x78\x28\xc9\xcc\x69\x8c\x59\xc6\xae\x5a\x46\x32\x13\x87\xe0\x0f\x01\x12\xa3\x04\xa5\x96\xec\x1e\xe0\xcc\x06\x39\x3c\x73\x83\x68\x1d\xbf\x72\xd5\x57\x94\x42\x03\xe9\x11\xa3\x9a\x0b\xb6\xa7\x62\x18\xed\x6d\x06\x72\xe2\x37\xd6\x9e\x05\xd5\x9c\x4f\x7a\x8b\xfa\xa4\x4b\x9c\xe3\xe4\x94\x0e\x64\x79\xce\x35\x4d\xc6\x44\x8c\xa5\xfc\xd2\x36\x9a\x50\x75\x44\xa1\x4a\x8a\x1f\x50\x50\x8a\x8f\xaf\xfc\xcc\x21\x73\x91\xd4\xf6\xef\x6f\xce\xf2\x04\x20\xb1\x96\x2c\x11\x6a\xf9\x47\x8c\xc0\x07\x7a\x2b\xe0\x03\xbf\x15\x20\x31\xcf\x3f\xe1\x83\xe3\x38\xe0\x4f\x56\xfb\xe2\x03\xb9\x20\x50\xea\xed\xc5\x07\xd3\x45\x01\xfe\xb1\x85\x42\xb3\x51\x47\x12\x9b\xec\x84\x9b\x60\x66\xe3\x80\x82\xf8\xbc\x68\xbe\xdf\xdd\x5d\xee\x4a\x87\x84\x80\x64\xfb\xe3\xd2\xd5\x96\x79\x8b\x69\x33\x19\x90\x95\x2f\x2b\xea\x2c\x04\x6b\xd3\x1f\xab\xe6\xb3\x70\xd6\x1b\x57\xd0\x19\xd7\xb5\x15\x0b\x12\xa6\x79\x69\x37\x63\xd5\x92\x65\x58\xb4\xd2\x7b\xce\x99\x41\xb5\x56\xb1\xc3\xa2\x9b\x2b\x17\xcd\xb5\x1a\x88\x3a\x38\xec\xfd\x56\x01\xe9\xbc\xf7\x53\xe7\xfa\x10\xd6\x2c\x9d\xae\x5d\x07\xd3\x46\x90\x0d\x7d\x7e\x6b\x8a\x2b\x54\xd8\x0b\x7c\x5e\xee\xfa\x12\x6d\xd3\x31\x8f\x48\x66\x53\xee\xc3\xc1\x51\x66\x6b\xee\xc3\xe1\x61\x76\x28\xf0\xf7\x8c\x75\x8c\xc2\xd1\xde\xd0\x4b\x50\xaf\x5b\x78\x31\xf3\x0f\x8a\x45\xaf\xe7\x1f\xfd\xf1\x37\x70\xe4\x2c\xf5\x89\xfc\xc6\x5d\xa1\x2f\x68\x0f\xfe\x22\xe7\xd2\x5f\xcd\x51\xc3\x39\xb9\x1e\x79\xc2\x6f\xf7\xd2\x53\x04\xf9\xa1\xf3\x41\x82\xb2\x13\x67\x68\x91\x78\xc3\x29\x62\x39\xa7\x34\x7d\x39\x87\x01\x5c\xa8\xf4\x2e\xda\x92\xe9\xed\x73\xfe\xd2\x5d\xa7\x38\xf3\x86\xc3\x20\x05\x76\x29\x97\x5d\x6c\x36\x9f\x30\x28\xe1\x90\x3c\x0c\x41\x02\x1e\x4c\xd1\x95\x37\xba\x83\x6b\x14\x27\x24\xdb\xd6\xc4\x4b\x21\x44\x57\x53\x34\x4a\x91\x8f\xd7\xea\x51\x14\x5e\xa3\x38\x25\x5a\x0c\x5e\x29\xd2\x08\xa6\x41\x9a\x4e\xd1\x1e\xcd\xee\xa9\xd6\x0e\x48\x03\x28\x8e\xa3\x18\xfc\x08\x25\xe1\x83\x14\xbc\xf1\x18\x8d\x28\x1c\x8a\xf8\x83\x04\x12\x34\x5a\xc4\x41\x7a\xa7\xb2\x58\x3f\x84\xe1\x22\x85\x20\x25\x00\x78\xed\x20\x4d\x44\x80\xee\x60\x1a\xa4\x2c\x02\x6a\x94\x62\x42\x04\x78\x51\x9e\xa1\x90\x2a\x13\xca\x73\x0d\xdd\x81\x0b\x25\xec\x2b\x4a\x0c\x71\xec\x52\x78\x2a\xf3\x96\xc5\x7c\x7f\xe9\x47\x67\x78\xab\xb3\x81\x1c\xd5\x2f\xe5\xeb\xd9\x4e\x96\x87\xec\x4a\x3a\x31\x21\xe8\x53\x4b\x40\xc1\x74\x10\x30\x83\x10\xd6\x8f\x90\x93\x59\xe8\x77\x81\x1c\xfe\x76\x61\xde\x13\xbc\xbc\x68\xbe\xaf\x43\xed\xe5\x45\xe7\x3d\x8b\x44\x0c\x9f\xf0\x4f\xe6\x47\xd0\xea\xed\x18\x4e\x32\x28\x80\x16\x05\xd0\xcc\x03\xe8\xb8\x03\x68\x53\x00\xad\x3c\x80\xa6\x3b\x80\x0e\x05\xd0\xce\x03\x68\x09\x00\xfa\xfa\xe6\x64\xb4\xaa\x71\xe0\x31\xd4\x5c\x46\xe2\x44\x8c\xc4\x89\x7d\x24\xca\x1d\x90\xc6\x80\x11\x84\xba\x70\x8c\xc7\x24\x23\xb7\xe8\x25\xcd\x85\xdb\x6c\xe2\x37\x36\x62\x95\x47\xba\x93\x6f\xa5\xa5\x6c\xa5\x5d\xb9\x95\xa6\x76\x34\x25\x78\x85\x56\x3a\x95\x5b\x69\xe9\x86\xbc\x2e\xc1\x2b\xb4\xd2\x2c\xb4\xb2\x02\x77\x9c\x78\x71\x7c\x07\xc3\xc0\x60\x1c\xa1\xe3\x3f\x64\x01\xc1\xcd\xb6\xdf\x94\xb2\x1a\x16\xcf\xc9\x5d\x92\xa2\x19\x8c\xa3\x45\x0c\x69\x30\x33\x71\xda\x0a\x19\x9c\x43\x74\x9b\x9e\x63\xc1\xe2\x96\x02\xd8\x96\xfe\xf8\x75\xe4\x07\xe3\x3b\xba\xbc\xd0\x69\x52\x11\xed\x23\x37\xb4\x4f\x2e\x68\x7c\x86\xdf\x2f\x6a\x01\xec\x02\x49\x61\x70\x68\x89\xd0\x60\xa6\xfc\xaf\x90\xa0\x74\x31\xd7\x17\x72\x8c\xdf\x51\xcd\x0a\x7a\xfa\x2b\x0d\xea\xe1\x6a\xfa\x3c\xfd\xf5\xb2\x09\x03\x38\xfd\xf5\xa2\x69\x33\x33\xb1\xe2\x2d\x5a\xbc\x65\x4f\xba\x2d\xcb\x3a\xc2\x75\xc9\x62\x78\x8d\xb0\x0e\xeb\x62\x1f\x6d\xd2\x7c\xdd\x04\x41\x91\xb0\x9b\xfc\xd2\x65\xec\x96\x8b\xb3\x8c\xdc\xa2\x7c\x95\x94\xdc\x02\x85\xb6\x40\xa1\x95\x43\xa1\x65\x43\xa1\x95\x47\xa1\xb5\x3c\x0a\x24\x17\x46\xd0\xe4\x32\x08\x03\x0c\xda\x79\x11\xe4\x02\xa6\x43\xc0\xb4\x25\xc9\x16\x34\xf3\x22\xcb\x65\x34\xd9\x64\x74\x5d\xaf\x80\xcc\xab\x26\x35\x6b\xda\x92\x44\x9c\x60\x49\x8b\x0b\x9a\x92\x24\xd0\x82\x6d\x5a\xd0\x96\xb1\xe1\x04\x0b\x6b\x5c\xd0\x96\x4a\xe2\xe4\xa2\xeb\x8a\xe3\x81\x2b\x8e\x3d\x57\x1c\x0f\x33\x1c\x1d\xcf\x17\x2a\x4b\x73\x58\x4d\xa2\x43\x75\xa9\x0e\x96\x84\x19\x1a\xe3\xac\xf6\x40\xff\xd2\x8f\xde\x6a\x42\x4b\xbd\xae\x1a\x56\xca\x59\xd3\xad\xbe\xb6\xea\x8b\x6b\xe8\xe7\xa6\x27\x92\x31\xce\x82\x36\x11\xd1\xae\xaf\x79\x4e\x13\x57\xfc\x4e\x26\x1e\xd4\x7e\xc7\x3c\xcb\xa5\x07\xf9\x2d\x94\x35\xc3\x00\x9e\xd3\x84\x12\xbf\x93\xb9\x86\x2b\x1d\x16\x80\x1c\x38\x01\x69\x53\x20\x5d\x06\xa4\x55\x00\x72\xe8\x04\xa4\x43\x81\xf4\xde\x0b\xf4\x73\x40\x5a\x12\x90\x8d\x68\x34\x98\x77\x6e\xbc\x39\xd0\x8d\xb1\xb9\xec\x39\x56\x23\xc8\xa2\x41\xbe\x89\x45\x83\xfc\xd2\x2d\x1a\xf6\xe9\x27\xc1\x63\xab\x8a\x00\xa8\x5a\x55\xac\xfd\xb1\x86\xbf\xc2\x9f\xd7\xc5\xd8\x55\xe7\xcb\xc5\xac\xd2\xce\x6a\xe1\x7a\x9f\x73\x85\x53\x97\x0d\xae\x69\xc1\x5e\x97\x94\x53\x98\x7e\x2d\x89\xac\xc4\x0c\xd4\x6f\xd8\xdd\x42\xef\xe4\x25\x84\xae\x94\xb4\x4f\x33\x39\xf0\x9f\x7b\xd7\x08\xa2\xa9\xef\xb4\xa6\x2e\xa9\xde\x9e\x5c\x52\xa6\x3c\x31\xe6\x13\xd4\xa2\x78\xe2\x4d\x47\x8b\x29\x96\x43\x21\xba\x71\xc2\xf3\x84\x4a\xa0\x1a\xf9\xbb\x0b\xcd\xdb\xae\xdf\x21\xff\x83\x5d\xbe\x77\x21\xb1\xa9\x35\xc8\x9c\x50\xd9\x53\x23\x7f\x71\x75\x56\xbb\x03\xbb\x50\xa3\x40\x31\xe3\x37\x77\xe0\x47\xa8\x9d\x5c\x4a\xbf\xff\x0b\x5a\xd0\x87\xe6\x8e\x19\x7a\x9b\x41\x6f\x53\xe8\x0c\x78\x97\x41\x6f\x15\xa0\xb7\x2a\x42\xef\x30\xe8\x9d\x52\xd7\x6b\xb4\xcd\x1c\xf4\x76\x45\xe8\x5d\x06\xbd\xab\xa4\x4c\xa7\x00\xbd\x53\x11\xfa\x01\x83\x7e\xa0\xa4\x4c\xb7\x00\xbd\x5b\x11\x7a\x8f\x41\xef\x29\x29\x73\x50\x80\x7e\x50\x11\xfa\x21\x83\x7e\xa8\xa4\x4c\xaf\x00\xbd\x57\x09\x7a\xb6\xe1\xa6\x0d\xe4\x40\x1d\x16\x41\x19\xe3\x8a\x88\xc9\x84\xb5\x86\xab\xbd\x0d\x4c\x77\x5c\xe3\xea\x96\x2c\x99\x01\xa6\x05\x9b\xf6\x66\xa3\x43\x14\x26\x69\xbc\x18\xa5\x30\x09\xae\x26\xe0\x85\x3e\x4c\xa3\x1b\xf0\xe2\xab\x05\x89\x4f\x4f\x82\x3d\xfe\x7b\xe1\xc5\xda\xdb\xde\xa2\x65\x0f\x06\xb8\x79\xbe\x43\xb1\xd8\x30\xaf\x86\xb4\x38\x5d\xd1\xed\xb6\x11\x4e\xbd\x1c\x9a\x31\x4a\x16\xd3\x14\xa2\xb1\x2b\x8e\x13\xba\x44\xd7\xae\x3c\x78\x08\x57\x1e\x8d\x01\xd2\x3a\xdc\x81\x5d\xa0\x8f\x86\xec\xd1\x01\x79\x34\x24\x8f\x6c\x3d\x99\x52\xa0\x52\xdf\xa9\x81\xe8\x21\x5c\xdd\x12\xe6\xda\x21\xbc\xc8\x0b\x88\x8d\x97\x54\xc0\xd6\xff\x17\xb8\xd7\xbf\xbf\x39\x03\x63\x0e\xc5\xe7\x54\xdc\x5f\x4d\xe0\x0f\xb8\x9a\xae\x2c\xf1\xed\x56\xe2\xdf\x99\xbc\x7f\x4e\xe5\x7d\xad\xf6\x3c\xd3\xec\xb0\xae\xf2\x5c\xd2\x1e\x77\x58\x81\x5e\xbe\x40\x2f\x2b\x60\x9a\x88\xbf\xb3\xa5\xe1\x39\x5d\x1a\x6a\xb4\x49\xa1\x65\x3d\xe7\x6b\x01\xd1\xb2\x76\x01\x37\x6c\x06\xd7\x66\xe0\xda\x1c\x5c\x2b\x8f\x57\xab\x84\x78\x33\x5f\xa0\xe9\x8a\x78\x87\xb5\xd4\xe1\x2d\xb5\x73\x88\xb7\x0b\x88\xb7\x2c\x88\x77\x19\xb8\x2e\x07\xd7\xc9\xe3\xd5\x29\x21\xde\xce\x17\x68\xbb\x22\x7e\xc0\x5a\x3a\xe0\x2d\x75\x73\x88\x77\x0b\x88\x77\x2c\x88\xf7\x18\xb8\x1e\x07\x77\x90\xc7\xeb\xa0\x84\x78\x37\x5f\xa0\xeb\x8a\xf8\x21\x6b\xe9\xf0\x7d\x8e\xe7\x8e\xf2\x2c\x27\x10\x3f\xd0\x22\xae\x9c\x2b\xba\xb3\x3c\xae\x41\x0a\xcd\x93\xb8\xc6\xe4\x8e\xc7\xe8\xc9\x23\xf5\xf4\x1a\x7b\xa3\x52\x3c\x68\x58\xbf\x8b\x0a\x6d\x3b\x45\xb7\xa9\xec\x13\x22\x1f\x84\xf1\x60\xba\xb5\x19\x4a\x12\xef\x0a\xd5\xf1\x8e\x93\x7a\x6c\x98\xfd\x4c\xe6\x53\x0f\xf7\xe4\x36\x05\x2d\x68\x16\x54\xbe\x96\x61\x61\x83\xae\x74\x30\x31\x9d\xdb\x5d\x52\x87\x91\x17\x68\x3a\x47\x71\x4d\x2e\x59\x3a\x20\x77\xf3\x09\x91\x41\xac\x72\x4f\xb4\xe8\x43\xd0\x87\x83\xae\xec\x3e\xd0\x87\x83\x9e\xf0\x2b\xe8\x43\xaf\x95\xf9\x90\xfc\x3d\xf3\x88\x6d\x7d\x48\xb6\x3e\x24\x5b\x1f\x92\x35\xfb\x90\x38\xca\xd8\xcc\x67\xa2\xe8\x2d\xf1\x37\xf2\x93\x70\x32\xf6\xad\x60\x4f\x7c\x29\x6c\x84\x2f\x73\x36\xc2\x97\x2b\xdb\x08\x5f\xe6\x6d\x84\x2f\x4d\x36\x42\x1d\x18\xdb\xb9\xe6\xd6\x8b\x64\xeb\x45\xb2\xf5\x22\xb1\xb6\xb2\xf5\x22\xd9\x7a\x91\x6c\xbd\x48\xf4\xc5\xb7\x5e\x24\x5b\x2f\x92\xad\x17\x89\xad\xe0\xd6\x8b\x64\xeb\x45\x22\x7d\xb6\x5e\x24\x5b\x2f\x92\xad\x17\x49\xe1\xb3\xf5\x22\xd9\x7a\x91\x28\xab\x6f\xbd\x48\x74\xd0\xb7\x5e\x24\x3a\xe8\x5b\x2f\x12\xf6\xd9\x7a\x91\x6c\xbd\x48\xb6\x5e\x24\x5b\x2f\x92\xad\x17\xc9\xd6\x8b\x84\xfc\x6b\xf3\x22\xd9\x88\xff\xc8\x7a\x3d\x47\x5c\x7c\x46\x56\xf2\x16\xb9\x27\x3f\x91\x83\xad\x9f\xc8\xd6\x4f\x64\xeb\x27\x52\x2c\x72\xdf\x7e\x22\x3a\x47\x8d\x93\x6e\xa5\x48\x1f\x3a\x37\x8d\x93\xae\xe4\xa3\x41\x7e\x7c\x1d\x0e\x1a\x34\xee\x94\x70\xd1\xb0\x28\xbc\x1f\xb3\xc8\x9d\xae\xfe\x1c\x24\x60\x95\x1c\x21\x4e\xce\x7c\x6d\xb6\xb5\x86\x41\x0a\xc9\x30\xba\x35\x37\x70\x2e\xf0\xd7\xba\xf0\xf0\x8f\x6a\x77\xd5\x3e\xe8\xb9\x99\x04\x99\x99\x2f\x58\xe1\x7c\xf0\x25\xba\xb3\x1d\x10\xe6\x51\xac\xf3\xd0\x85\x95\x30\x65\x64\xc7\x44\xe6\xe1\x5d\x03\xf8\x87\x3c\x12\x0e\xc7\x47\x0c\x00\x56\xa7\xf8\xa8\x5f\xe4\x80\x96\x93\x8d\xe4\x5e\x97\xf2\x8e\x58\x6d\x94\xb8\xab\xb5\x0f\xb0\x4b\x29\xbd\xcb\x31\xd8\x81\x7f\x90\xae\xbb\xc4\x1f\xbd\xf1\x0c\xa4\xe5\x3d\x23\x9a\x85\xd9\xac\x09\xd9\x78\x9f\xdb\x23\x3a\xe2\x22\x98\x09\x57\xe0\x8c\x13\xeb\x39\x37\x65\xf1\x40\x30\xfb\x07\xe6\x0a\xa0\x6c\x6d\x73\x87\x2a\xdc\x40\x4c\x8c\xc3\x3c\x9d\xc0\x4b\x7e\x4e\x81\xd9\xc4\x7e\x5a\xa4\x47\x4f\x44\xb7\x6b\x1f\xf4\xdc\x6c\xc3\xcd\xea\x56\x61\x25\xd6\xab\x5a\x88\x25\x29\x64\xb0\x10\x67\xc3\xa7\x13\x23\x3c\x5c\x29\x1b\x64\x93\x5d\x49\x0e\x2e\x2c\x1d\x13\xe9\xe1\x7e\x94\x3b\x6c\x60\x1f\x21\x83\x42\x2a\x7c\x42\x7a\x6e\x12\x5a\x44\x4f\x40\xce\x9b\x61\x17\x5a\xd9\x94\xd5\x95\xcd\xcd\x75\x97\x19\x6e\x9d\xdd\x8e\x33\xdb\x6d\x56\x4b\x33\x5a\x5f\x28\x4f\xce\x4f\x18\x68\x8d\x89\x2e\x5c\x9f\xf5\x8a\xec\x3f\xa9\x80\x0c\x89\x3c\x5c\xc2\x38\xf3\x0b\x89\xe3\x6c\x71\x86\xc9\x04\x84\x8e\xb7\x32\xc1\xa1\xe7\x2b\xb6\x55\xca\xf5\xed\x6f\xb5\x3d\x3e\xe9\xae\x7b\x6f\x7c\xd2\x5d\xdb\xc6\xb8\xac\x38\x16\x76\xc5\x27\xdd\x6a\xf1\x71\x89\x77\x47\x80\xfc\x35\xea\xb9\x4f\xe2\x68\x9e\xd3\x75\xd9\x03\x42\xd9\xaf\x30\x69\x8e\x8f\xd1\xcb\xe7\x9b\x29\x9c\xbb\x63\x6e\xc5\xa5\x44\xc6\x19\x68\x3d\x52\x9c\x46\x6a\x09\x03\x3c\xe5\x0c\x26\x81\x94\x2d\xc3\x20\x4b\x71\x73\x7d\x7d\x33\x86\xf4\x30\x95\xf7\x0a\x18\x29\x5e\xa9\x82\xa7\x02\x1e\x55\x47\x35\x56\x78\x73\xe3\x5e\x61\x5d\xf6\x27\xa2\xd2\xee\xed\x59\x35\xd9\x25\x15\x0c\x30\x9c\x4c\x3b\xeb\x0b\x7f\x11\x69\x86\xc7\x69\xfd\x12\x8d\x40\x5d\x9f\x54\x63\x22\xc2\x2c\xd9\x70\xa1\x65\x0d\x7e\x27\xdd\x7b\xb2\xf6\xf5\xd6\x68\xed\xfb\x3a\xcd\x7c\xf7\x6f\x8c\xfb\x36\xcd\x6a\xfb\x0f\xc9\x72\x84\x12\x14\x5f\x17\xe7\x7b\x6d\xb4\x03\xed\x66\xab\x0d\xc3\x3b\x38\xf9\x7f\xff\x1f\x3f\x0e\x46\xf0\x1a\x25\x61\x30\x6d\xc0\xf1\xf4\xff\x63\xef\xed\x97\xe3\xb6\x91\xc5\xd1\xff\x7f\x55\xe7\x1d\xb0\xb9\xb5\xf6\x28\x1a\x4b\x04\x3f\x41\xc7\xca\x39\xb2\x34\x8a\x74\x22\x5b\xbe\x92\xbc\x4e\xca\xe5\x78\xf9\x01\x6a\x98\x50\x43\x2d\x87\xb2\x46\x49\xbc\x75\x5f\xe3\xbe\xde\x7d\x92\x5b\x68\xf0\x73\x86\x24\x00\x8d\x14\x3b\x1b\x4f\x2a\x16\x67\x08\x74\x37\x1a\xdd\x8d\x06\xd0\x40\x27\x28\x8b\x2f\xa6\xf9\x1c\x15\x95\xc3\xad\x15\xfb\x75\x4a\xc3\x78\x9e\x67\xb1\x7f\x0d\x74\x78\xb3\x10\xee\x7b\x8f\x67\x68\x9e\x5e\x67\x01\x85\x5f\xfc\x78\xe6\x65\xb7\xcc\x56\x5f\xce\xc7\xc5\x1d\xae\x19\xfc\x4d\xaf\x73\x26\x0b\x71\x14\x07\x30\xe0\x8e\x91\x97\x51\x74\x45\xb3\xcb\x38\xcf\x69\x88\xae\xb2\xf4\x43\x1c\xd2\x90\x5f\x55\xcb\x6c\x5f\x94\x26\x49\x7a\x13\xcf\x2e\x50\x90\xce\xc2\x98\xdb\x45\x56\xe9\x92\xe6\x4f\x57\xc8\x7b\xb2\x44\xe0\x1c\x36\x72\x39\x65\x41\x1a\x52\x74\x79\x3d\xcf\x99\x16\x7b\xf1\x0c\xc0\x7b\x7e\xfa\x81\xbd\xba\xba\x85\xa6\xa3\x59\x9a\xc7\x01\x1d\xf3\xcb\xeb\x93\x78\x0e\x3b\xc1\x4d\xcc\xb3\x70\x89\xac\x30\x9e\x07\x89\x17\x5f\xd2\x6c\xd9\x1a\xaf\x12\x13\xcf\x9a\xbc\x29\x89\xb9\xca\xd2\xf0\x3a\xa0\xf7\x4e\x0f\x2a\xda\x18\xa6\xc1\x75\x75\x71\x2e\xab\xb1\x9d\x66\xc5\xa5\xba\x97\x5e\x4e\xb3\xd8\x4b\xe6\x35\xe7\xa1\xbb\xa0\x5a\x83\xf4\x55\x41\x38\x3f\x3c\x3a\x43\x67\x27\x07\xe7\x6f\x76\x4f\x27\xe8\xe8\x0c\xbd\x3a\x3d\xf9\xc7\xd1\xfe\x64\x1f\x3d\xff\x11\x9d\x1f\x4e\xd0\xde\xc9\xab\x1f\x4f\x8f\xbe\x3b\x3c\x47\x87\x27\xc7\xfb\x93\xd3\x33\xb4\xfb\x72\x1f\xed\x9d\xbc\x3c\x3f\x3d\x7a\xfe\xfa\xfc\xe4\xf4\x0c\x7d\xb5\x7b\x86\x8e\xce\xbe\x82\x17\xbb\x2f\x7f\x44\x93\x1f\x5e\x9d\x4e\xce\xce\xd0\xc9\x29\x3a\x7a\xf1\xea\xf8\x68\xb2\x8f\xde\xec\x9e\x9e\xee\xbe\x3c\x3f\x9a\x9c\x8d\xd1\xd1\xcb\xbd\xe3\xd7\xfb\x47\x2f\xbf\x1b\xa3\xe7\xaf\xcf\xd1\xcb\x93\x73\x74\x7c\xf4\xe2\xe8\x7c\xb2\x8f\xce\x4f\xc6\x80\x74\xb5\x1a\x3a\x39\x40\x2f\x26\xa7\x7b\x87\xbb\x2f\xcf\x77\x9f\x1f\x1d\x1f\x9d\xff\x08\xf8\x0e\x8e\xce\x5f\x32\x5c\x07\x27\xa7\x68\x17\xbd\xda\x3d\x3d\x3f\xda\x7b\x7d\xbc\x7b\x8a\x5e\xbd\x3e\x7d\x75\x72\x36\x41\xac\x59\xfb\x47\x67\x7b\xc7\xbb\x47\x2f\x26\xfb\x5b\xe8\xe8\x25\x7a\x79\x82\x26\xff\x98\xbc\x3c\x47\x67\x87\xbb\xc7\xc7\x9d\xad\x64\xb4\xb7\xda\xf8\x7c\x82\x8e\x8f\x76\x9f\x1f\x4f\x38\xa6\x97\x3f\xa2\xfd\xa3\xd3\xc9\xde\x39\x6b\x4e\xfd\xb4\x77\xb4\x3f\x79\x79\xbe\x7b\x3c\x46\x67\xaf\x26\x7b\x47\xec\x61\xf2\xc3\xe4\xc5\xab\xe3\xdd\xd3\x1f\xc7\x05\xcc\xb3\xc9\xff\xfd\x7a\xf2\xf2\xfc\x68\xf7\x18\xed\xef\xbe\xd8\xfd\x6e\x72\x86\x46\x02\x96\xbc\x3a\x3d\xd9\x7b\x7d\x3a\x79\xc1\x68\x3e\x39\x40\x67\xaf\x9f\x9f\x9d\x1f\x9d\xbf\x3e\x9f\xa0\xef\x4e\x4e\xf6\x81\xd1\x67\x93\xd3\x7f\x1c\xed\x4d\xce\xbe\x41\xc7\x27\x67\xc0\xad\xd7\x67\x93\x31\xda\xdf\x3d\xdf\x05\xc4\xaf\x4e\x4f\x0e\x8e\xce\xcf\xbe\x61\xcf\xcf\x5f\x9f\x1d\x01\xd3\x8e\x5e\x9e\x4f\x4e\x4f\x5f\xbf\x3a\x3f\x3a\x79\xb9\x81\x0e\x4f\xde\x4c\xfe\x31\x39\x45\x7b\xbb\xaf\xcf\x26\xfb\xc0\xdd\x93\x97\xd0\xd4\xf3\xc3\xc9\xc9\xe9\x8f\x0c\x28\xe3\x01\x30\x7f\x8c\xde\x1c\x4e\xce\x0f\x27\xa7\x8c\xa1\xc0\xa9\x5d\xc6\x82\xb3\xf3\xd3\xa3\xbd\xf3\x66\xb1\x93\x53\x74\x7e\x72\x7a\xde\x68\x23\x7a\x39\xf9\xee\xf8\xe8\xbb\xc9\xcb\xbd\x09\x7b\x7b\xc2\xa0\xbc\x39\x3a\x9b\x6c\xa0\xdd\xd3\xa3\x33\x56\xe0\x88\xa3\x7d\xb3\xfb\x23\x3a\x79\x0d\x4d\x66\x7d\xf4\xfa\x6c\xc2\x1f\x1b\x12\x3b\x86\x9e\x44\x47\x07\x68\x77\xff\x1f\x47\x8c\xec\xa2\xf0\xab\x93\xb3\xb3\xa3\x42\x4e\x80\x65\x7b\x87\x05\xbb\xbb\x32\x71\xf7\x6e\x68\xbc\xf0\xf2\xe9\xa7\xdd\xd4\x50\xcf\xc1\xca\x33\x70\x56\xc5\xf9\xd7\xf5\x8e\xc8\x42\x2c\x90\x37\xcb\xe7\x28\xf7\xfc\x2e\xef\x93\xc1\x7a\xff\x6b\xd2\x99\x28\xac\xdb\xd1\xd6\xc6\x08\x8f\x91\x3e\x46\xc6\x18\x99\x63\x64\x8d\x91\x3d\x46\xce\x18\x91\x31\x72\xc7\x08\xb3\xd7\x78\x8c\xb0\x3e\x46\xd8\x18\x23\x6c\x8e\x11\xb6\x7a\x0e\x6a\x39\x00\x01\x8a\xf1\x9a\x36\x14\x66\x90\x59\x7d\x0d\x20\x5a\x80\x0c\xe0\x60\x86\xa5\x1b\x94\x51\xa0\x36\x01\xa4\xcb\xe1\x90\x92\x54\x07\x80\xd9\x05\x2e\x0c\x40\x71\x5f\xc2\x4f\xcc\xeb\x17\x24\x69\x1c\x8c\x5e\x91\x6a\x00\x38\x06\x1e\x57\xcd\xef\x03\x65\x42\x7d\x0b\x00\x3a\x1c\x8a\x5e\x13\x8a\x01\x18\xe1\xb8\x8a\xa6\x63\xe3\x5d\x97\x87\xcd\xbb\x29\x53\xe8\xa6\x82\x3e\xa7\x64\xa3\xce\xd1\x14\x8d\xb0\x9b\x0c\x62\xf4\x18\x03\x0c\xb1\x79\x55\xa3\x84\xc6\x00\x58\x8d\x66\x58\x4d\x16\xb9\x05\xcf\x7b\x78\x6b\xf1\xaa\x15\x1b\x4d\xa0\xc5\x2d\x3b\xb7\xc9\x22\xad\xa4\xb6\x1b\x14\x81\x9a\x15\x1b\xa1\xbf\xac\x92\xdf\x25\x18\xa3\x62\x3d\x27\xb6\x87\x2a\xbd\x60\xbe\x56\x02\xe4\x4d\x72\x8a\xce\xad\x24\x99\x33\x0a\xa8\xed\xef\xa6\xb9\x8a\x36\x01\xd9\x05\x13\x19\x19\x15\xe2\x52\x04\x6b\x1d\x2a\xf4\xcc\xed\x57\x02\x4e\x2f\xa9\xc5\xdc\xad\x64\xb5\x90\x3e\x6c\x55\x90\x9d\xa2\x58\x6f\x57\xe1\x4a\x54\x8a\x8e\x72\x8b\x1a\x85\x68\x91\xea\x7d\x41\xbb\xc3\x9e\x06\x80\xe9\x8d\xa6\x9a\x15\x2d\x85\xdd\xa8\x74\x89\x34\x40\x76\x03\x2b\xb4\xdb\xaa\x14\x87\x54\x4d\x29\x49\xa9\xd8\xc6\xa5\x8a\x41\x1e\xe8\x30\x15\xbd\xe2\xe4\x36\x7b\xc7\xaa\xc8\x71\x4a\x43\x58\xf5\xaa\xc9\xc9\xb1\x07\x5a\x62\xb4\x3a\x88\x34\xfb\xc7\xa9\xf8\x5a\x59\x07\x78\xd7\x0b\xac\x34\x4c\xb8\x64\xa3\x5d\xd3\x50\x6a\x6d\x69\x71\x8d\xe1\x0e\xb3\x4a\x59\x6c\xb6\xc5\x6e\x69\xac\x5e\xfd\xb1\x4a\x2b\xd0\xcb\x33\x6b\xa5\xbc\xd9\xec\x3a\xbb\x82\x51\x8a\x2e\xee\xcb\x4e\x09\x5d\x36\xed\xd6\xb1\x2a\xb5\x25\x33\x1f\x0b\x6b\x97\xe8\x8e\xeb\xba\xec\xd9\x9e\xec\xbb\x93\xe7\xbb\x98\x3d\x93\x03\xfc\xfc\xf9\xde\xfe\x1e\x7b\xde\x75\x2d\xe3\x60\xdf\x9c\xf4\x4b\xc7\xb4\x5b\x3a\xb4\x85\xa5\xed\xea\xe4\xf9\xc4\x06\x54\x7b\xe6\xfe\x3e\xd6\x4d\x40\xb5\xef\x68\xc6\xe4\xc0\x60\xcf\xce\xae\xbd\xef\xd8\x13\x20\xa1\x24\xad\xa7\x5d\xbd\x81\x0a\x47\xaf\x26\x2f\xf6\xb1\xad\x41\x16\xea\x3b\xae\xde\x56\x30\xea\xf5\xdb\xc6\x4f\xdc\xd3\xb8\xef\x58\x05\xbe\x2b\x01\x44\x77\x33\xd0\x76\x4c\x4b\x37\x34\xe8\x93\xc9\xc1\xde\xfe\xee\x73\x02\x8c\x72\xc9\xf3\xdd\xfd\xbd\x83\x09\x7b\xc6\x9a\xa1\x5b\xa6\x03\x4c\xde\x33\xf6\xf5\x09\x3e\xd0\x3a\xfb\x0a\xdd\xcf\xf6\xa8\x70\xa3\xea\x4e\x57\x52\x60\xc9\x0d\x7e\xb5\xe3\xc1\x9c\x6c\xd8\x19\xaa\x0f\xf7\x48\x44\x00\xbc\x78\xdf\xa8\x59\xee\xfb\xbe\x17\x04\x96\x23\xd9\x9d\xf8\x06\x40\xb4\x83\x46\xc3\x85\x11\x3f\x0f\xd5\xa0\xa8\x0a\xea\x6c\xfc\xb6\xd6\x59\xab\x36\xec\xe2\xc4\xd5\x12\xf0\x95\x73\x57\xc3\x90\xef\xb0\x3c\x8d\x54\xce\x2d\x1e\x56\xfb\xc4\x4c\x7b\xa4\xa2\x71\xc0\x20\xbe\x9f\x26\x72\x85\x33\x28\x9c\x49\x15\x86\xc9\xc1\xfb\x5f\xe5\x20\x83\x8b\xfa\xfe\x57\x39\xc8\xe0\x28\xbd\x9f\xcb\x41\x86\x41\xfa\xfd\x3c\x93\x48\x33\xba\xbd\xcd\xcc\xcd\x2f\xf1\xec\x82\xd5\x8c\xd9\xc4\x47\x10\x2c\xe5\x25\x63\xe4\x27\x63\x14\x24\x63\x14\x26\x63\x44\xfb\x22\xed\xab\x0a\xd9\x18\xf9\xd9\x18\x05\xd9\x18\x85\xd9\x18\xc1\x3c\xad\xbf\x8a\xc7\x88\xf7\x58\x73\x0f\x87\x8f\xe6\xfb\x19\x24\xa1\x85\x82\x78\xa8\x60\xc0\x0a\x06\xbc\xa0\x3e\x54\x30\x64\x05\x43\x5e\xd0\x18\x2a\x08\x33\x4f\xca\x0b\x9a\x43\x05\xf9\x42\x73\x99\x55\x72\x98\x4f\x43\xa1\x33\x9d\x47\x62\xe0\xef\xe6\x0e\xc2\x42\x5b\x99\x33\xeb\xe2\x25\x68\xb3\x79\xb6\xf1\xd7\xe4\x6d\xfc\x6e\x30\x68\xbd\xfc\xc0\x85\x0d\x88\x87\xa2\x4b\x9c\x99\xce\x19\x51\x11\x1e\xd5\x52\xb2\x81\x36\xd1\x34\x11\xdf\xb4\xd0\x58\x36\x67\xf8\x0c\x5d\x01\x9f\xbe\x8a\x6f\x50\x2a\x3a\xf0\x99\x44\x01\x9f\xb1\x8a\x6f\x50\xb8\x3a\xf0\xd9\xa6\x02\x3e\x73\x15\xdf\xa0\x8c\x36\xf0\xfd\xb6\xbd\xcd\x71\x3e\x23\x9a\x02\x46\x6b\x15\xe3\xa0\xb0\x23\x71\x52\x5f\x26\x88\xb9\x84\xc4\xb1\x72\x59\x9a\x27\xa3\x7c\x8c\xe6\x4c\x4e\x45\x87\xee\x41\xc2\x99\x54\xd3\x44\x46\xa2\xc1\xbe\x0c\x5a\x2e\xf6\x01\x0d\x0f\x45\xa5\xc0\x60\x00\xb1\x8c\x55\x58\x78\x2f\x05\x58\x22\x5f\x04\x15\x0c\xdb\x70\xec\x0d\xaa\x34\x3b\x5b\xd2\xec\xec\x41\x35\xdb\x1a\xd5\xe6\x1c\xe4\x22\x7b\x58\xcd\x36\x57\xf1\x3d\xb4\x66\x2f\xe3\x7b\x50\xcd\xd6\x57\xf1\x3d\xb0\x66\xe3\x55\x8c\x9f\x44\xb3\x33\x25\xcd\xce\xa4\x34\x1b\x86\xe6\xae\xa5\xe0\xe6\x07\x06\xf0\x50\x54\x0a\xfc\x01\xae\xd9\x99\x94\x66\x83\x47\x22\x82\x0a\x7e\x8b\x20\x4e\xb6\xef\x15\x44\x84\x37\x32\xc1\xc3\x8c\x11\x8e\x28\x0e\xcc\x2d\x19\x03\x0f\xf9\xc1\xc1\x80\xf9\x00\xa1\x90\x91\x87\xc5\x71\xc3\x43\x7e\x3e\x30\x4c\xa4\xd8\x7f\x58\x9c\x2a\x3c\xe4\x67\xfd\x28\xab\xe5\x49\xd4\x32\x8a\x5a\x70\xa4\x0f\xbc\x14\x5f\xa2\x96\x59\xd4\x82\xa3\x97\x3e\xab\x15\x48\xd4\xd2\x86\x82\x94\x07\xe7\xc4\x07\xf1\xcc\x4b\x20\xdc\xf6\xde\xcf\x1f\x84\x5e\xee\x55\xd3\x18\xf6\x45\xe0\x51\xb3\x22\xe5\x11\x04\xf6\x2c\xe1\xe7\x43\x24\xeb\xf3\x38\x9f\x9f\xa7\x39\x0c\x7e\x1c\xd9\x6c\xdf\xcb\x3d\x7e\x2e\xe1\x6b\x44\x04\x68\xa1\xfe\x31\x8d\xf2\x12\x6d\x95\xf5\x9e\xd7\x1d\x64\xc5\x6e\x18\xa2\x2b\x2f\x0c\x07\x0f\x29\x57\x0d\x7b\x5b\xe3\x62\x73\x4e\xeb\x1d\xfa\x7d\x07\x69\x0b\xa2\x35\x02\x49\xab\x12\x7f\x67\xc3\xc9\x90\x5b\x5f\x41\x1d\x8d\x46\x75\xb5\x4d\xb0\xd2\x0c\xbc\xbb\xc1\xc0\x9a\xcc\x12\x62\x53\x62\x26\x5e\x82\xe1\xbc\xac\x66\xe1\x8d\xdf\xee\x36\x0b\x5f\x81\x5b\xcc\xc0\x97\x00\x2b\xcc\xc0\x45\x6c\xa9\xbb\x70\x07\x8d\x2a\x3e\x6d\x25\x74\x76\x91\x4f\x79\x14\xf3\xd7\xc8\x14\x1e\xcf\x66\x56\x28\x62\xfa\xc1\xaf\x36\x11\x46\xf0\x5f\xf1\xf5\xa5\x91\x30\xc0\x4e\x52\x81\x8a\x85\xb3\x7a\x1d\x40\x20\xc9\x87\x68\x07\xb5\x96\x0b\x1e\x66\x29\xcb\x52\x5e\xc9\x12\x2f\x48\x1d\xc2\x4a\xd4\xa1\xe8\x6e\x03\x24\xbb\x04\x75\x58\x5d\xdc\x73\xd8\x5c\x51\x3a\x5c\x77\x29\xe9\xb0\xb5\x86\x74\xd8\xb7\x78\xb4\xc6\x71\x91\x53\x1e\xdf\xc6\xe5\xae\x48\xb8\x1f\x42\xb7\xf6\x57\x2b\x62\xe2\x06\x44\xa4\x7f\x0c\x08\x92\x74\x26\x6f\xfd\x21\x1e\x91\xd5\xa8\xd7\x8c\xe1\xab\x5c\xe0\x26\x2f\xfa\x7e\x45\xae\x39\x0c\x81\xde\x14\x8d\x84\xa2\xfd\x81\xf6\x2b\x3f\x75\xc5\x10\xa2\xe6\x99\x91\x08\x8f\x16\x63\x74\x3b\x46\xbf\xf6\xb6\xbc\x40\x3d\x1a\x2d\xe0\x92\xaa\x5b\xf8\xf7\xd7\x8d\x6e\x7a\x3b\x3b\xb8\xc6\xa6\xcb\x63\x63\xe8\x1e\x31\x74\xfc\x26\xc2\x7f\xf3\xaf\xbf\x6e\xac\xc4\xe5\x49\xa0\x35\xd4\xd0\xfe\x8e\x46\xff\x66\x88\x1b\x2d\x55\x44\x68\x2a\xb7\xf3\xd7\xa2\x9d\xb7\xf0\xed\xdf\xd0\xd0\x3b\x20\xb6\x54\xbb\x93\x21\xfc\xbd\x89\x50\x15\x25\xf8\xd2\x8b\x31\x9a\x09\x31\x2e\x98\xf1\x98\x01\x3a\x7e\xca\x6e\x64\xe8\x6c\xd0\xef\x6f\xe7\xea\xcf\xd2\xc1\xcb\x65\xec\xf2\x14\xf4\x74\xbd\xd8\xe5\x2b\x2f\xf3\x2e\xd1\x6f\xd5\x1e\xce\xef\xf3\x3c\x8b\x67\x17\x1f\x51\x11\x76\x0c\x51\xf6\xe5\x73\x9e\xf2\x61\x48\x1e\x7c\xc1\x9f\x1a\xfe\x47\x00\xa8\x08\x65\x9e\x7b\x79\x1c\xc8\x97\xbf\x6b\x40\x76\x61\xbf\xea\x10\xe4\x72\x23\x6d\xf4\xb8\x60\xc1\x63\x41\xf0\x75\x3f\x84\x9b\x92\x03\x2a\x51\xd6\xab\x1b\x79\x4b\x11\xd6\x65\x81\x35\xc3\xe1\x4b\x89\x3a\x7c\xb1\xbb\xf7\x99\xc8\xd3\x00\xa8\x5f\xe8\x2d\x80\x99\xd3\x20\xa3\x39\x9c\x4f\x5e\x5b\x22\x59\xcb\x3f\x47\x89\xbc\xf4\x82\xa6\x3c\x1d\x5e\x7a\x41\x2d\x53\xcd\xa3\x01\x0a\x62\xd5\x02\xb2\x2a\x5a\x97\x5e\xd0\x25\x5e\x4d\x18\x1f\x79\x10\x9e\x54\x18\x7f\x09\x43\x10\xcc\x5f\xc6\xed\xd7\x61\xf8\xce\x97\x30\xfc\x2f\x61\xf8\x9f\xf7\xed\x16\x9f\x45\x20\xe8\x52\xae\x94\x1e\x4a\xd5\xf3\x9d\x9c\x1d\xee\x3e\xc1\x6b\x44\xa6\x9c\x1d\xee\xe2\x3a\x28\xa5\xf8\xf6\x07\xc4\xa3\xcc\xe8\x4d\x23\x26\x25\x9e\xc5\x79\x5f\xc0\x57\xf9\x69\xc7\xab\xd0\x28\x08\x3d\x9f\xb8\x82\x14\x04\xda\xc2\x25\xbe\x17\x06\x11\x6d\x05\xb3\x88\x2a\x05\x46\xa8\x53\x1c\x75\x9c\x81\x2f\x3f\x0f\x19\x03\xd3\x87\x73\xdd\xf8\x85\x41\xc8\xaa\x3b\xf6\xe2\xbd\x74\x56\xcc\x17\xef\xa4\xc3\xa4\x56\xbc\x8f\x0e\x6b\x94\xe2\x5d\x74\x56\x8c\xd6\x7b\xe8\x83\x4d\x96\xda\x45\xef\xdd\x25\x97\x58\x84\x51\xdc\x09\x7b\xc3\x97\x4d\xda\x57\x05\x8b\x77\x27\xfa\x07\x81\xe5\x4f\x79\xf5\xc0\x9b\xb7\x31\x7a\x82\x8c\x77\xe8\xa7\xe2\x91\xd4\x8f\xd8\x6c\x3c\xdb\xa2\x0d\x9c\x06\xd9\xa3\x19\xdc\x2c\xc7\x17\x17\x61\x7a\x65\x60\x89\x6b\xcd\xc5\xab\x53\xb0\xd7\x30\xf2\x18\x74\x0b\xa0\x7b\x7c\x15\xc9\x81\xbb\xe6\x28\xda\x04\x0a\x24\x37\x25\x75\x85\x2d\xad\xd1\xc8\x47\x8f\x50\xc0\xe7\xa7\xec\x31\x04\x8c\xda\xc2\xf2\x78\x60\xa3\xe2\x7e\xa1\x0a\x6a\x1f\xfd\x84\x02\xf4\x13\x0a\x39\x46\x9b\x86\x2e\xf5\x3d\xd1\x55\xf9\xcb\x3b\x86\x77\x6c\x2c\x6f\x2b\x7b\x0a\x8a\x56\x3f\x41\xda\xc2\xd1\xa8\x69\x1a\xba\x29\x47\xc3\xf6\xd7\x15\x19\x44\xdb\x40\x5f\x6f\xdf\xa9\xed\x0c\xaf\x61\xb9\xa1\x41\xf5\xa1\x0d\x0c\x24\x23\x4b\xcc\x2c\x74\x5d\xb9\xd0\xfc\x30\x0b\x13\x88\x76\xe4\x10\xd0\xf8\xec\x19\x32\xb4\x82\x5d\x20\x8f\x22\x61\x67\xb6\x50\xd4\x08\x6f\xcd\xeb\x6e\x94\x37\xf2\x8a\xdd\xab\x72\xcf\xcb\x93\xdf\xc5\x83\xbd\xbf\xc1\xbb\xa7\x51\x6b\xff\x0e\x76\xfd\x02\xf9\x9d\x3b\xd8\xef\x0b\xe5\xf7\xec\x60\xa7\x8f\x0e\x95\xff\xb2\x15\x27\xae\xff\x97\xd8\x8a\x63\xf3\xe2\xad\x28\x49\xd3\xac\xb9\x0d\xb6\x0d\x1e\x62\xf1\xb9\x37\x84\x70\xe3\x6b\x8d\x45\x61\xef\x6c\x65\xeb\xec\x93\xee\x9a\xad\xb1\x33\x22\xdc\x42\xfb\xab\xef\x8f\x2c\xff\xfc\x65\x91\xf9\x93\x2f\xe9\x2d\x2d\x11\xb3\x89\xf1\x9d\xd7\x97\xa1\xf2\x5d\x96\x96\xdb\xd3\xf1\xf6\xaa\x32\x7b\xf7\x65\x41\x59\x96\xc0\x3f\xff\x82\x32\x08\xd1\x1a\x6b\xc9\xdd\xb2\x54\x2f\x23\x97\xf2\xd4\xac\x29\x79\x09\x0c\xab\xaa\xbc\x70\x4c\xfe\x92\xb7\x35\xcf\xa7\x9e\x6e\xd9\x9f\xc3\xa2\x32\x5c\xab\x53\x90\xf3\x65\x81\xf9\x0f\x5e\x60\x5e\x5d\x34\xee\x2a\x75\x76\xb8\xab\x5b\x76\x6b\x75\xb6\xef\x36\xc7\xa1\xe5\x61\x5d\x37\xd7\x5b\x20\x66\x00\x5a\x44\xc0\x77\x4e\xcd\x67\xb7\x48\x1c\x60\xcd\x72\x69\x48\xc6\x48\x5b\x18\xb6\x13\x84\x96\xe6\xc0\xb3\xe6\x68\x61\x88\xe1\x39\x72\x34\x6a\xb9\x86\x78\xf1\x38\x8a\x02\x4d\xf3\x0d\x58\x71\xb6\x89\x45\xb0\x85\xf9\xb3\x19\xb9\x24\xf2\x00\x98\x4f\x23\xcf\x8c\x3c\xf3\xbe\xd7\x8a\x95\x27\xa4\x0d\xd7\xa3\xe8\x9b\x06\x14\xd9\x0b\xe8\x60\x64\xae\x26\x21\x4f\x76\x04\x53\x0e\x89\xd8\xa7\x2f\x5e\xef\x9f\xd9\xeb\xd5\x75\x73\x1d\xbf\x97\x55\xbf\xa3\xe7\xdb\xb2\x32\x2b\xbe\xaf\xae\x2b\x5e\xc8\xf9\xc5\xfb\xfd\x93\x7b\xbf\x4c\x94\xd6\xf3\x7f\x3b\x25\xaa\xe5\x01\x17\x52\xd5\xac\x2d\xef\x03\xeb\xba\xe8\x2e\xc4\xd6\xb5\x87\x85\xef\xf5\x14\x39\x6e\xed\x15\xbb\x5f\xc2\x29\xbe\x84\x53\x88\xbc\xdd\x2f\x77\x6b\x55\x69\x2c\x62\xe6\xdc\x54\xf7\xfa\xa1\x2c\xbd\x9e\x85\x28\x68\x5f\xba\xd5\xc3\x8a\xc3\xbe\xa4\x16\xec\xe5\xf7\x03\x91\x18\xe5\xc6\x31\xad\x11\xad\x96\x12\x4d\x4e\x50\x33\x84\x35\x9e\xbf\xca\xe2\x4b\x3a\xea\x0f\x61\x2d\xe9\x9a\xff\x2b\xcb\x5f\x96\x2b\xd8\xec\xcb\x68\x36\xb4\xa4\x59\x6d\x5e\x73\xd1\x44\x3b\x48\xff\xa6\x7c\x7e\xb6\xc3\xa1\x95\x3f\x48\xee\x67\xff\x6d\x34\x43\x7f\x2f\xaa\x6c\x48\x6d\x2f\x16\xa6\x32\xf2\x92\x79\xdf\xd2\x68\xf9\x19\x4e\x73\x2d\x74\x43\xf3\xec\x7a\x60\xf1\x55\xd0\x09\x17\x34\x3f\xc8\x3c\x78\xf6\x92\xe7\x71\x3e\x17\x74\x47\x15\xc7\x3c\x43\x4f\xd0\x68\x06\x39\x07\x37\xd0\xd7\xad\x4d\x84\xa1\xcd\xa8\x3e\x82\xca\x4d\xfa\xbe\x6c\xe7\xf0\x1e\xe4\x65\x20\xd3\xc0\xcd\x34\x4e\x28\x1a\x15\xe5\x84\x67\x47\x61\xa7\xb6\x12\x42\x29\x39\xa8\x40\xcb\x9d\x82\x3d\x7c\xcb\x2b\x40\x32\xc5\x15\x4e\x83\x38\x5f\xa5\x37\xa3\xd9\x18\x61\xb4\x8d\xf4\xce\x90\xec\xe6\x47\x70\x72\xf4\x7b\x25\x74\x46\x4f\xe0\x79\xf3\xc3\xe1\x6d\x6e\xde\x75\x73\x76\xd6\x5b\xb5\x6b\x86\x34\xea\xa1\xe8\x81\x23\xc9\x74\xcb\x5e\x73\xa9\x60\x75\xbd\xe2\x93\xc5\x93\x1d\x6e\xcd\x93\x38\xa0\x23\xad\x57\x98\xbe\xc4\x6a\x35\x8b\x7d\xea\x58\xad\xa1\x62\x11\x14\xb3\x44\xc5\x2e\xa0\xd8\x60\xd4\x12\xcc\x30\xa0\x98\xf3\x70\x01\x62\xb6\x64\x36\xfc\xcf\x31\x40\xec\xc2\xbb\xbc\xf4\xb4\x45\x15\x26\x86\x07\xb9\xbe\x5a\x13\xa2\xb5\x4a\x20\xcf\x9e\x21\x9d\x07\x6d\x95\xbf\x7c\xfb\xed\xb7\xc8\xd9\xd8\x40\x3f\x89\x61\x22\x38\xf5\xd7\x00\x85\xcd\x15\x50\x98\x28\xc0\x6a\x56\x34\xc4\x26\xbf\xd5\x2e\x5c\x73\x44\x78\x71\x43\xbb\x62\xcd\x10\xcc\x5b\xd1\x60\x08\x5e\x94\xe9\x92\x55\x39\x52\xc0\x32\x56\x61\xb9\xaa\x1c\x29\x2b\x0a\x72\x26\x97\x9f\x42\x0a\x8b\xde\xde\x2c\x98\x02\x89\x61\x8b\x06\x6f\x4a\xc7\x0f\x4a\x05\x00\x06\x90\x62\x9a\xa2\x47\x28\x82\x23\x63\xff\x66\x8f\xdd\x89\x10\x96\x6a\x5e\x7a\x90\x0f\xc9\x43\x8f\x90\x0f\x55\x3d\x1e\xe3\xf6\x13\x2a\xa2\xdd\x84\x0d\x06\xa7\x3b\xbe\x28\xe5\xda\x6b\x84\x7d\x15\x61\x88\xfc\x94\x1e\x7f\x83\xdd\xc6\x1b\x6c\xb4\x5e\xb5\x2a\x89\x1d\x9c\x0a\x31\x97\x1f\x0a\xaa\xc4\xd3\xf6\x52\x80\x61\x17\xd0\xf9\x1b\xdc\x78\x83\x71\xf3\x95\xd3\x78\xa3\x5b\x12\x9e\x0e\xc4\x5c\x32\xac\x53\xb4\x59\x92\xb0\xc9\x3a\x61\x13\x7d\xcf\xb3\x30\x49\xc4\x5b\x02\x10\x1d\xed\x94\xcc\xdb\x64\x7d\x21\x44\xcd\x3a\xfa\x42\x00\x99\xd9\xf8\xa1\x4c\xe8\xec\xc3\x86\x0b\xd1\x5c\x03\x32\xf0\x85\x68\x13\xe5\x58\xe6\x0e\x10\xd9\xc8\xc0\xa1\xd4\xe6\x48\x21\x0a\x70\x94\x33\xae\xe7\xba\x88\xb6\x2f\x11\x81\x2b\xe5\xe5\x23\x02\x79\x79\xab\x28\x0f\x49\xb9\x23\x71\x79\xbb\x28\x0f\x59\xb7\x2f\xc4\xe5\x9d\xa2\x3c\x98\xc8\xe9\x97\x08\xc5\x2f\x11\x8a\x5f\x22\x14\xbf\x44\x28\x7e\x89\x50\xfc\xb2\x57\x7b\xcf\x7b\xb5\x96\xbd\xd6\x5e\xad\x65\xdf\x79\xaf\xb6\xb9\xcc\xb3\xba\x57\x6b\xd9\x5f\xf6\x6a\x65\x09\xfc\xcf\xd8\xab\xb5\xec\x75\xf7\x6a\xbb\x24\xaa\xbd\x57\xcb\xa5\xaa\x59\x5b\xe1\xc4\x7b\x47\x2c\x95\x44\xd4\x22\xd1\xfe\x92\x51\x8b\x0b\xdb\x7c\xf2\xb9\xec\xde\xc2\xde\x79\x45\xd0\x97\xbd\xdc\xcf\x7e\x2f\x77\x61\x43\xf4\xe0\xd6\xc2\xee\x3a\x39\xc8\xca\xfc\x60\x9b\x45\xe2\x6c\x28\xbd\xd5\x93\x1a\xf9\xee\x69\x97\x7a\x18\x78\x7a\x78\xf2\xfe\xe4\xe0\xe0\x6c\x72\x7e\x36\xb4\x17\xfc\xea\xe8\xfd\xd1\xcb\xfd\xc9\x0f\x93\xc1\x52\xa7\x27\xaf\x5f\xee\xbf\xdf\x3b\x79\x79\x76\xbe\xfb\xb2\x06\xd8\xa7\xaa\xb0\x7f\xbc\xb7\xde\xfe\x71\x03\x52\x36\x4d\xcb\x1c\x04\x03\x9b\xd2\x25\xad\x0b\xb4\x83\xf0\x18\xdd\xca\xa4\x2a\xcf\xf9\xaa\x76\x8e\x9e\x21\xdd\xfc\x06\xe5\x82\x55\xed\x06\x4f\xdf\x2e\xd0\x26\xb2\xd0\xd7\xe8\x96\x5f\x06\x97\x97\x77\xff\xc1\x93\xbe\x01\xfb\x7c\xe8\xef\xc8\x1e\x9e\x33\xc0\xc4\x8f\xde\xfc\x80\x76\xd0\x2d\xfa\x3b\xb2\x44\x73\x44\x7a\xf3\x23\x43\xa7\xa3\xaf\x11\x23\xc0\x60\x04\x6c\x08\x2a\x2e\xf8\x0e\xd2\x0f\x03\x45\x6e\x79\x91\x1f\x55\xb7\x73\x1b\x9d\x74\x15\xa3\x78\x16\xd2\x85\xa8\x8b\x2a\xde\x2f\x38\xef\x17\xfc\xda\xbf\x85\x80\xf5\x55\x35\xde\xaf\xe8\x96\x57\xbb\x95\xd8\x87\xa8\x45\xbc\xdd\x69\xb7\x68\x13\x8d\x3a\x58\xc9\x7a\x71\x88\x9f\xfd\x39\x82\xc5\x92\xdc\x0e\xe1\xe8\x17\xe2\xe3\x83\xb3\x53\xd6\xd0\x85\xd6\x77\x2a\xba\x6b\x67\x46\x97\xd8\x99\x61\x55\x80\x8c\x52\x3f\x5f\xcc\x6f\x06\x54\xa5\xb3\xca\x71\x55\x45\xa2\xc3\x7e\xe6\xf4\xfd\x8c\x9e\x21\xe7\x1b\xf4\xb3\xe4\xc6\x11\x30\x00\xae\x51\x14\x5f\xd6\x5f\xd2\xe8\xc7\xf9\xab\x74\x0e\xb9\x33\x99\x92\x60\xf4\xec\x19\xfa\x79\x03\x3d\x41\xa2\x93\xe5\x25\xd2\x26\x00\xe9\x2b\xb7\xd9\x67\x85\x3b\x3f\xed\x20\x40\xdf\x80\x28\x41\x43\xfb\x74\x79\x93\x9a\x6f\x77\x80\x1c\xb9\x83\xe6\x2b\x14\xbd\x68\x50\xd4\x02\xfb\x44\xb0\xb6\x53\x11\x36\x5c\x44\xb8\xd1\xd1\x50\x81\x19\x5d\xe4\x20\xde\x0a\x32\x20\x79\x55\xf7\xf6\x36\x7a\x95\xc5\x97\x71\x1e\x7f\xa0\xe8\x2a\x4d\x6e\x67\xe9\x65\xec\x25\x28\xfd\x40\x33\xf4\xdd\xc1\x48\xdf\x78\x8a\x16\x3f\x11\xb4\x89\x16\x3f\xd9\xf0\xaf\x05\xff\x9a\xcc\x76\x8b\xc1\x17\x3a\xc9\xc9\xe2\xb7\x41\xfc\x84\xb4\x85\x23\x79\x6f\x81\x44\x03\x0a\xc8\x3b\x42\x81\xbd\x6b\x28\xd1\xd2\x38\xce\xf7\xba\x0a\x0f\xa5\xcc\x9d\xb4\x2c\x39\xe3\x15\xe9\xee\x8d\x38\xe8\x58\x61\x91\x8f\xf5\x98\x83\xc9\xc8\xe9\xe5\x55\x9a\x79\xd9\x2d\x5f\xc9\xef\x71\x6e\xce\xfb\x9c\x15\xa9\xc8\xb4\x2e\xcb\x29\x71\xf3\xec\x79\x27\xbb\xee\x85\x19\x03\x51\x2b\xc6\x7a\x31\x2b\x46\x2b\x62\xc5\x90\x8d\x57\xe9\xa6\x88\x53\xb5\x97\xce\xa2\xf8\xe2\x3a\xe3\x91\x89\xe9\x15\xe4\x2a\xee\x22\x0b\xf5\xcc\xb0\x51\xb1\xc4\x90\xa5\x57\x34\xcb\x6f\xd1\x6f\xb3\xeb\x4b\x9f\x66\x1f\x51\x7a\x9d\x5f\x5d\xe7\xc7\xb0\xdc\xda\x5b\x0f\xc1\x92\x41\x48\xe7\x71\x46\x43\xc4\xab\xa2\x34\x62\xa6\x76\x5e\x26\x45\xe6\x80\x7a\xd7\x37\x4a\x40\x27\xb3\xa4\x94\xb3\x46\x9a\x6a\x2f\xa3\x4f\x91\xae\x9b\x63\xa4\x5b\xf6\x18\x19\xc4\x1c\x23\x0b\xeb\x43\x80\xf6\x69\xe4\x5d\x27\xf9\x53\x56\xae\xaf\x58\x47\x1f\xb1\x4f\x10\x5d\x3c\xad\x56\x4b\xa3\x0b\x41\xbf\xb0\x4f\x93\x4b\x03\x18\x3f\x6e\x0c\xed\xb7\xa8\x45\x23\xc1\x7e\x6d\xee\xe5\xb4\x5a\x99\x2d\xbf\xbd\x7d\x27\xe1\x02\x28\x2a\x1a\xfb\x00\x7c\xae\x70\x33\x7a\x53\x29\x1d\xc4\x3e\xdd\xf9\x0e\x64\x20\x1d\x16\xe6\xcf\xe2\x5f\x61\x8b\x14\xdb\x9a\x86\x9e\x20\xe6\x04\xc2\x4b\xd6\x03\x4d\xfe\x32\x3f\xde\xe8\x0b\x56\xfc\x63\x22\xac\x04\xbb\x5b\x1d\xdd\x22\xdc\x68\x2a\x39\x70\xec\xcd\x60\xab\x63\x89\x2f\xdb\x10\x9e\x39\x48\xdc\xae\x3f\x4f\x33\x5f\xad\xeb\x97\xf0\x3e\x44\xd2\xba\x17\x7a\xdc\x0e\x21\x62\x1d\x2b\xb5\x9d\xff\x42\x8f\x71\x47\x55\xe6\x19\x48\xdf\x1b\x2e\xbc\xf2\x9c\x7d\x38\x89\x92\x99\xeb\xf4\x66\xca\x3a\x7d\xdd\x5c\x75\x7a\x2b\x49\x9d\x7e\xef\xd9\xe9\x8a\xe6\x61\x95\xf6\xe1\x56\x03\xf1\xda\x2d\xc4\xed\x26\xe2\xbb\xb6\x51\xd8\xe1\x5c\x03\xaa\xd5\xf4\x78\x96\xa7\x5c\x17\xc5\xb2\x96\x78\xb0\x45\x56\x9a\x38\x01\x47\x59\xe9\xad\x69\x7c\x31\x65\x2e\x3c\x6b\x92\x4c\xf9\x24\xbd\x29\x8a\xaf\x11\x5d\x71\xca\xfc\xbe\x01\xad\xab\x94\x9c\x4f\x6b\x41\xd1\xf9\x23\x9f\x8a\xc2\xb3\x9c\x8e\x9f\x4f\x69\xee\x0d\x97\xba\xe3\xc2\x41\x03\xc9\x8b\x78\x81\x82\x34\xb9\xbe\x9c\x01\x93\x04\xf6\x04\x95\x81\x3e\xc5\x04\x79\x8c\x1a\xf3\x5e\x51\xc5\x35\x96\x2b\x9a\xb8\x5b\x92\x52\x2f\x5e\x48\x10\xc0\x3e\xe5\xbc\xaf\x92\x20\xd9\x7a\xc7\x8d\x7a\x49\x7a\x23\x37\x41\x94\xea\x82\x73\xa1\x7b\xbf\xfc\x01\x77\x9f\x75\xf9\xf9\xdb\x85\x4c\xc3\xcf\x17\x5c\x59\x76\xa0\xf9\x72\x15\x98\xb6\xec\x40\xb3\xd7\x0b\xcc\x5f\x5f\x44\x25\xc7\x3a\x54\x31\xc6\x04\xce\x8c\x98\x6c\x98\xb0\x7a\x25\x1b\xbe\x79\xbe\xc0\x75\x55\xac\x5a\x95\xeb\xc4\xf9\x02\xcb\xca\x55\x51\xef\xb8\xaa\xc7\xe5\x4a\x5a\x6f\xe7\xd7\x19\x98\x93\x78\x76\x51\xe8\xb0\x9a\xfa\x9e\x2f\xcc\xc2\x88\xa2\xd1\xa8\x68\x40\x75\xa3\x63\x41\x58\x71\xad\xa3\xcc\x82\x08\x40\x3e\xae\x20\x83\xb9\xe5\x80\x8f\x97\x00\xbf\x50\x03\xfc\x59\x18\x8e\xd6\x90\x23\xa9\x46\x68\x69\xe8\x91\xd0\x26\x74\x3f\x2b\x4b\xa7\xd3\x14\xbd\x8a\x25\x35\x93\x91\x78\x04\xeb\xd4\x3b\x08\x7f\xd3\xf8\xca\xa7\x26\xd5\xf7\x07\x53\xd9\x56\xcf\x54\xe8\x64\x55\x8f\x55\xe0\x02\xad\x62\xd4\xcb\x9a\xc7\x75\x4d\x39\xb3\x0e\x83\xfb\x34\x3d\xe1\x3e\xf1\x4e\x6b\x03\xa4\x45\xbb\x14\xa7\x4e\x53\x98\xab\x48\x8e\xbd\x71\x84\x46\x35\x6e\xa5\xe5\xd8\x86\xde\x8f\x4a\x8e\x3d\x7b\x56\xb7\x04\x74\xb3\x64\x48\x9d\x2b\xa3\x7e\x2f\xa3\xa9\xa8\x6d\x06\x2a\x78\x9d\x88\x5e\xac\x81\xa8\xbd\x2a\x5c\xb3\x44\x79\x4d\x78\x99\x2d\x05\xb5\x0d\x90\xb0\x1e\xbc\x4a\xb4\x6d\xde\x0b\x77\x5e\x08\xf0\x1d\xaf\x81\x4f\xda\x01\xc9\xbc\xd9\xfc\x2a\x9d\x4b\x8b\x21\x0c\x5c\xaf\xe2\x63\xae\xb6\xe7\x6f\x1b\xbb\x49\xb5\x06\x48\x39\x26\x1c\x88\xaa\x77\x52\xd4\x52\x71\x51\xa4\x2c\xe6\x55\x8c\xbc\x1c\x1c\x15\x18\x69\xc4\xb3\x96\x73\x0d\xda\x2f\x4c\x5c\x5a\x2d\x4a\x68\x95\x99\x13\xd6\x39\xd7\x4a\xb6\xf0\x9a\x32\x86\xed\x5c\x2b\x98\x52\x54\x91\x71\x28\xb6\xb7\xd1\xde\x54\x76\xa8\xb8\x9b\x13\x77\x0f\x03\xb8\xda\xa0\x82\x1a\x06\xbe\x1c\xd9\xaa\x11\x5f\x41\x53\xef\x3e\x30\x95\x10\xce\x2b\x25\xb9\x5b\xf5\x05\xae\x00\x8c\x1a\x6e\xe9\x86\xaa\xfb\xc2\x81\xe9\x4b\xc0\xf4\x55\x60\xd2\xdd\xc1\x3c\xd0\x2c\xbd\x91\xec\x8d\xa4\xa1\xe6\xe7\xb5\xce\xff\x84\x46\xff\x2e\xda\xc8\x7f\x78\x54\x52\x09\x5f\x65\xad\x6a\x52\x9b\x83\xf3\xca\x34\x34\x61\xb3\xef\x35\xe8\x24\xed\xdd\xaf\x69\x7e\xee\xc1\x0f\x3b\x4a\x45\x53\xf8\x15\x31\x93\x32\x26\xad\xed\x27\xe6\x84\x2c\x6d\x62\xc1\x7b\xa5\x55\x94\x16\x44\x19\x5b\xd3\x74\x6c\xdb\x95\xc5\x7e\xd4\x1d\x22\x09\xfe\xec\x07\x25\x86\x2a\x3d\xf4\x31\x89\xa1\xba\xd5\x12\x37\x83\xb1\xba\xee\xfd\x35\x5f\xea\x1f\x5e\xf8\xbe\x8f\x83\x16\x78\xdd\x73\x16\x70\x82\x22\xa0\x71\xd2\x3e\x00\x81\x37\xd0\x76\xbb\x91\x1b\xe8\xeb\xe5\x1f\x80\x10\x88\x8a\xa8\x8e\x7d\xfc\x69\x4f\x45\x3c\xd8\x1e\x49\x73\x0f\xa8\x6c\x7a\xe7\x06\x11\xda\x16\x4a\x5d\xb3\x78\xb9\xe3\xb2\x0a\x7f\x5b\x7c\xc8\xe7\xec\x5f\xd7\x94\xfe\x3a\xb0\xc0\x5c\xc6\xbe\x97\x4a\xd9\xb9\x45\x5e\x7e\xba\xb6\x68\x56\x48\x7d\x88\x4d\x1a\xb5\xe5\xef\xbb\xcd\x7a\x7b\x67\xbc\xf7\xb7\x91\x53\x13\x25\xb7\xd9\xd1\x98\x01\x91\x95\x29\xd6\x9d\xb7\x3c\x1a\x50\x8b\x5d\x8f\x26\xd8\x7b\xde\xdc\xa9\x39\x2a\xdf\xe4\xe3\xe5\x26\x1f\xdf\x47\x93\x8f\x57\x9a\x7c\x7c\xf7\x26\x8b\x65\x82\x2b\x5e\x61\x47\xf2\x14\x65\x34\xcf\x62\xfa\x81\x0a\x0e\x60\xa1\xe2\xce\x49\x6e\x30\xaf\xae\xe7\xd3\x92\x54\x11\xa7\x3b\x6a\xbd\x18\xae\xf5\x80\xc9\x7f\x3b\xae\x1f\xa9\xe8\x1b\xaf\x9a\x32\xf5\x0b\x49\x1e\xe2\x98\x99\xdc\x88\x50\x1c\x3a\xeb\x18\x20\xaa\xfb\x55\x14\x4d\xe8\x1d\x02\x1c\xca\xc7\xc6\x49\xb7\xbe\x5a\x32\x17\x45\x7d\x39\x04\xf7\x1f\x7b\x08\xce\x58\xe7\x08\x9c\x71\xd7\x03\x70\xc6\xc0\xf1\xb7\x9e\x9b\x46\xbe\x1c\x7e\x5b\x85\xfa\x1f\x71\xf8\xcd\x58\xf3\xe8\x5b\x87\x2c\xb5\x0e\xbe\x19\xeb\x1d\x7b\x33\x54\x2e\x29\xad\x0e\x5a\x3d\x45\xc4\xac\x8f\xc1\xe1\xbf\xf8\x31\xb8\xe5\x3b\xfd\x2d\xac\x7f\x7e\x67\xe3\xca\x5b\x66\x19\x71\x7f\xfa\x73\x72\x0f\x79\x46\xee\x5e\x4f\xa8\x15\x45\xeb\x13\x75\x65\xf9\x7b\xc9\x07\x60\x61\xbd\x15\xad\x6c\xe1\xee\xe5\x98\xc1\x70\x69\xb2\x66\x3e\x00\x06\xa0\x15\x32\x4d\x8a\x7b\x95\x2d\xac\x3f\xf8\x25\x7f\x4d\xfe\x4a\xa5\x04\x58\x89\x8d\xd5\x16\x81\xef\xfb\x6e\x68\x85\xe3\x46\xbe\x80\x8d\x71\x57\x49\x5b\x77\x3d\xdd\xd5\xbd\x66\x36\x81\x0d\x41\xda\x80\x0e\x30\x2e\xb6\x5c\x0d\x5b\x5e\x33\x11\x41\x37\x42\x6c\xe9\x11\x0d\x78\xfa\x82\x32\x4d\xc1\x1d\x10\xda\x8e\x61\xe8\xb6\xcd\xb3\x1d\x14\x49\x0c\xba\x11\x12\xea\x9b\xa6\x47\x9c\x66\x8a\x83\x3b\x20\x0c\x7d\x2d\xd0\xa9\x16\x36\xb3\x23\x74\x23\x34\x1d\xdf\x32\x09\x0e\x9b\xb9\x13\x06\x66\xa0\x9f\x24\x79\x02\x13\xe4\xfb\x48\x9e\x80\xbb\xb3\x75\x94\x9f\x2f\xd9\x13\x86\xc1\xff\xf9\x27\x23\x64\xad\xec\x09\xac\xfa\x5d\x27\x24\x4d\x9b\xbc\x3a\x25\x21\x5f\xb2\x27\xfc\xc5\x26\x25\x64\xdd\xec\x09\x9d\x12\xd5\x9e\x98\x90\x35\xb2\x27\x18\x44\x35\x7b\x02\xf3\x6b\x9f\x22\xa2\x0f\x4d\x54\xf4\xbf\xf8\x44\xe5\x73\x9b\x93\x7c\x99\x87\xac\x75\x57\xc7\x27\xbc\x79\xe3\x9e\xe6\x35\x1d\x3d\x57\xf2\xb6\xc0\xf0\xbe\x3c\x35\xda\xe7\xae\x15\xc6\xa3\x7d\xc8\x74\xcb\xbb\xba\x4a\x6e\x47\xc5\x8f\x63\xe4\x65\x17\xd7\x97\x74\x96\x77\x2e\xb5\x77\x2e\x11\x37\xaf\x0d\xe9\x6e\x0d\x24\x85\xe8\xa6\x69\x89\x76\x6d\x61\xea\xc4\xd3\x23\x17\xfc\xf8\xd0\xd1\x89\x47\x75\x7d\x63\xbc\x5a\xce\xc1\x86\x63\x9a\x2e\x64\x15\xd3\x0d\x1a\xd9\x56\x10\xf6\xb9\xdf\x2b\x95\x7d\x2b\xd0\x22\x3f\x88\x58\x65\x1a\x98\xa1\xe1\xeb\x51\x17\x12\xea\xfa\x56\xe8\x7b\x16\x2b\x47\x30\x71\x43\xdf\x0f\xa4\x91\x18\xae\x65\x07\xba\xe5\xc3\x54\xc2\x30\x89\x6f\x19\xa4\x0b\x89\xe5\x46\x18\xe3\x08\x5a\xe2\xdb\x9a\x15\x6a\xb8\x77\xe6\xb2\x52\xd9\xd5\x8d\x88\xe8\x9e\xc9\x2a\x7b\x11\x76\xcd\xc8\xf5\xbb\x90\x78\x3e\x0e\x2c\x1a\x42\x4b\x42\xcf\x0e\x09\xc6\x44\x1a\x49\x48\x34\xc7\xf3\x78\x9f\x78\x86\x66\x68\xba\xd9\xd9\x27\x58\x27\x86\xe5\x6b\xd0\x12\xd3\x72\x34\x3b\xf2\xa9\x34\x12\xdd\x34\x30\xb1\x7c\x0a\x95\x29\x35\x7d\x9d\x04\x9d\xec\xb2\xb4\xc0\x09\x03\x03\x5a\x62\x45\x91\x6f\x52\x5d\x1a\x89\xa3\xfb\xd4\x0a\x1d\x60\x57\xa4\x3b\x3e\x71\xed\xce\x8e\x27\x5a\x48\x7d\x1c\x01\x31\x86\x8f\x6d\xd7\xf6\x7b\x27\x77\xab\x7d\xe2\x87\x81\x66\xf3\x24\x77\xba\x15\x38\x58\x37\xac\x2e\x24\x01\x76\xfd\x08\x73\x62\x82\xc8\x76\x75\xdb\x35\xa5\x91\x50\xd3\xf5\x6d\x37\x00\x5e\xbb\x34\xc2\xa6\x17\x76\xf6\x09\x8d\x7c\x6a\x3a\xc4\x86\x96\x10\x33\xd2\x2d\x6a\x48\x23\xd1\xa2\x00\xbb\x61\x00\x95\x89\x4f\x82\xd0\xf2\x3b\x5b\xa2\x9b\x5a\xe0\xe1\x20\x60\xe5\x1c\xc7\x0b\xdc\xc0\xb6\xe4\x3b\x3e\xa4\xae\x1e\xd8\xa0\x8c\x96\xab\xfb\x9a\xee\x74\x22\x31\x3d\xc7\x24\xa6\x07\x6b\x01\x36\xf5\x6c\x6a\x12\xf9\x96\x58\x81\xaf\x79\x6e\x08\x14\xfa\xa1\x89\x23\x3f\x34\x3b\xcd\x8a\x1d\xb9\x84\x84\x80\x84\x18\x18\x5b\x86\x2f\xdf\x12\x97\x18\xd4\xc2\x96\x0e\x66\x85\xda\x76\x18\x79\xdd\xca\x48\x0c\x1c\xd8\x36\xcc\xe4\xf5\xd0\x37\x0d\x1d\x6b\xf2\xb6\x4b\xd3\x0c\xdd\x09\x40\x19\x5d\x12\xf9\x3a\x36\x3a\x45\xd8\x8f\x2c\xd7\x89\x82\x22\xd5\x22\x8d\x34\x4a\xe5\xa5\x2b\xb0\xa9\xa6\xf9\x11\x28\x99\x11\x7a\x84\x44\x41\xa7\x74\x85\x96\xe7\xb8\xd8\x04\x24\xae\xa1\x79\x9e\xa3\xcb\xb3\x4b\xb3\x03\xcf\x36\x2c\x10\x61\xaa\x69\x06\xd1\xbb\x95\x11\x9b\xba\xab\xbb\x7c\x4d\x46\xf3\x34\x6a\x53\x47\x9e\x5d\xba\xe3\x3b\x9a\x47\xc0\xf0\x99\x76\xa8\xeb\x51\xd4\x69\x56\x74\x8a\x19\x2b\x81\xad\x56\xa0\xdb\x81\xab\xdb\xd2\x48\xcc\x50\x0f\xec\x30\x02\xe9\xb2\xbc\xc0\xd4\x3d\x1a\x76\xda\x2e\xc3\x20\x5a\x88\x81\xad\x6e\xe8\x5a\xbe\x11\x46\xd2\x48\x6c\x4b\xf3\x1c\xc3\x32\xb9\x32\x7a\x91\x6d\x84\xb4\x5b\x84\x6d\x4f\xf3\x7c\x18\x77\x8c\xc0\x71\x7c\xdd\x93\x37\xf5\x04\x07\x7a\xe0\xea\xdc\x0a\x3b\x34\xf4\x28\xb5\xbb\x90\xb8\xba\xa3\xeb\x01\x67\x2b\x36\x89\x6e\x58\x86\x2f\x8d\xc4\xd3\xfd\x88\x12\x8f\x8f\x13\x41\x84\x35\xc3\xee\x54\x46\x8f\x60\xcf\xb6\x4d\x68\x89\x1f\x98\xba\xa1\x69\xf2\x56\x38\xd0\x4d\x9f\xf8\x8e\x06\xe3\x84\x16\x11\xd7\x71\x71\xa7\x15\x76\xec\xc0\xc2\x1e\xf4\x89\x66\x5b\xa6\x4f\x0d\x79\xe9\x0a\xb1\xab\x53\x82\x5d\x40\x62\xd3\xc8\xd2\x71\xe7\x18\x1f\xda\xae\xab\xd9\x3a\xf4\x9d\x65\xd9\x96\xe7\x2a\x68\x7c\x64\x6a\xd4\xb0\x38\xaf\x2d\xc7\xc1\xba\xa6\x7b\x9d\x7a\xa2\xd9\x9e\xa7\xf1\x16\x1b\xba\xef\x87\xd8\x97\xef\x78\xec\x7a\x66\x80\x31\x98\x7a\x9f\x84\x7a\xa8\x05\x9d\x2d\xc1\xd4\x70\xec\x40\xe3\x7a\x82\x4d\xec\xf9\x96\xbc\x15\xd6\x1d\x93\x38\x8e\x09\x7a\x12\x46\x84\x52\xdf\x75\xbb\x90\x18\xa6\xaf\xf9\x81\x0f\x2d\xa6\xd8\xf5\x4d\xa2\x20\xc2\x86\x8b\x03\x2d\xf0\xa1\x43\x03\x2b\x70\x2d\xcf\x36\x3a\xc7\x13\x1a\x12\xcf\x33\xc1\xd4\x53\xc3\xc4\xc4\x0b\xe4\x45\xd8\xf2\xdd\x20\xf0\xcc\x88\x8f\x78\xb6\x41\x0d\xa7\x13\x89\x4d\x74\x6a\x47\xdc\x90\x86\xb6\xaf\xfb\xc4\x93\x67\x97\x63\x92\x88\xe8\x14\x94\xd1\x0a\x69\xe4\xeb\xdd\xb6\xcb\x21\x9e\x65\x1b\x7c\x04\x35\x0d\xec\xe8\x91\x2d\x2f\x5d\xc4\x0c\x88\x43\x30\xf7\x20\x71\xa4\x79\xbe\xd3\x69\xea\x49\x10\x38\x9a\xce\x3b\x1e\x7b\xb6\x69\xb8\x54\xde\x17\x76\x35\x9f\x46\x51\xe4\x71\x6f\xdd\x36\x30\xd5\x3b\xa5\xcb\x33\x2d\xcd\x0e\x28\x68\x7c\x48\x89\xee\x87\x54\xde\x17\xf6\x69\xe4\x7a\x46\xc4\x47\x3c\x3d\xb0\x1d\x17\x77\xfb\x5d\xb6\x83\x1d\x12\xf1\x61\xda\x70\x74\xcb\xd0\xe5\x3b\x3e\xf0\x74\xc7\xa0\x01\xf4\x09\xf5\x74\xdb\xc6\x6e\x67\x9f\x84\x98\xd8\x3e\xe1\xc3\xaf\xce\x04\x52\xef\xdf\x00\x59\x75\xda\xbc\xd0\x73\xc2\x10\x94\x31\x08\xa9\x46\x7d\xdc\x69\xea\x23\xcb\x09\xcd\xc8\x89\x0a\x87\x83\x86\xd8\x91\xd7\x13\xcd\x8e\x34\xdb\xe1\xfe\x94\xa3\x63\xc7\x8e\xfc\x4e\xb3\xa2\x79\xb6\xe1\x84\x01\x28\xa3\xa7\x07\xc4\x25\x9e\xfc\xc8\x88\xb1\x11\xb9\x44\x33\x8b\x4d\x0b\x57\x0b\xbd\xce\x96\x60\xdf\xc1\x9a\x6f\xf0\xf1\xc4\xc0\x81\xe9\x60\xf9\x3e\xd1\x49\xe8\x3b\x4e\x64\x71\xe9\xd2\x4c\x27\x24\x9d\xe3\x89\xa1\x07\x9e\xe7\x3b\x20\x5d\xa6\x16\x38\xba\xe9\xca\x2b\xa3\x11\xb8\xd4\xa7\x1a\xb0\x0b\x5b\x81\xeb\x53\xbf\xb3\xe3\x4d\x03\x87\xb6\x13\x40\x8b\xdd\x00\x6b\x5a\x68\xca\xeb\x89\x19\x04\x56\x68\xf2\x49\x50\xe0\x1b\xd4\xd4\xfd\xce\xe1\x97\xb9\x76\xba\xeb\x82\x21\x8d\x02\xdb\x72\x28\x1b\x12\x64\x6d\x57\x14\xf8\x76\xe4\x71\x07\xc1\x0b\xed\xc8\xa3\x9d\x2d\xb1\x03\xd3\xc4\x2e\x01\x24\xa6\x67\x3a\x16\xc1\x4e\xc7\x0a\x52\xef\x6d\x9d\xcb\x97\x70\x75\x2f\x0e\xbc\xb9\xef\xfb\xb6\x88\x26\x8e\x92\x7b\xd3\xba\x6f\xeb\xfd\x1f\x74\xdf\x96\x85\xf5\xf5\x36\x90\x3b\x76\xb1\xff\xb8\x2c\x71\xf7\xb1\x81\x6c\x7b\x9a\x4b\xcb\xed\x55\xc3\x0f\x02\x57\xeb\xd9\x40\xf6\x7d\xdb\xf1\x28\x77\x7a\x88\x19\x78\x9e\xd3\xef\x60\x0e\x20\x34\x02\x9b\x46\x86\x03\xb6\x3e\xa2\xae\x19\x11\x66\xeb\xbb\x4a\x7a\x96\x19\x45\x96\x01\xfa\x6d\x45\x38\x34\xec\xe8\x2e\xfb\xb9\x16\xd6\xa8\xa5\x73\x93\xec\x85\xd4\x26\x7a\xd8\xb3\x81\xec\xfa\x9a\x65\x13\xae\x5e\xba\x6f\x50\x3b\xc0\xbd\x93\x81\x01\x84\x38\x22\x46\xe8\x72\x6d\x8e\x7c\x13\xfb\xa1\xdd\xd3\x42\xcb\xa7\x5a\x10\x72\x67\x15\x1b\x0e\xd5\xb1\xe3\xde\xfb\x06\xf2\x1f\x75\x37\x95\x72\xfa\x3f\xa8\xa4\xc9\x65\xf4\x3b\xc4\x72\x29\xfd\x0e\x75\xb9\x9c\x7e\x87\x86\x5c\x52\xbf\x43\x53\x2e\xab\xdf\xa1\x25\x97\xd6\xef\xd0\x96\xcb\xeb\x77\xe8\xc8\x24\xf6\xe3\x1c\x84\x1c\x80\xc2\x53\xb4\xbc\x6c\xc2\xcb\x0e\x1f\x64\xe3\x0c\x07\xb0\xc2\x2b\x3f\x78\xd9\x84\x97\x95\x00\xab\x03\x58\x5d\x0a\xac\x9e\xf0\xb2\x12\x60\x0d\x00\x6b\x48\x81\x35\x12\x5e\x56\x02\xac\x09\x60\x4d\x29\xb0\x66\xc2\xcb\x4a\x80\xb5\x00\xac\x25\x05\xd6\x4a\x78\x59\x09\xb0\x36\x80\xb5\xa5\xc0\xda\x09\x2f\x2b\x01\xd6\x01\xb0\x8e\x14\x58\x27\xe1\x65\x45\xe7\x6f\xee\x90\xfa\x93\x8b\xb9\x88\x02\x8f\x4b\xf8\x50\xee\x1f\x38\x1c\xc8\xa5\x5b\x04\xcd\xe7\x82\x2d\x82\x16\x70\xa1\x16\x41\x0b\xb8\x3c\x8b\xa0\x85\x5c\x96\x45\xd0\x42\x2e\xc6\x22\x68\x94\x8b\xb0\x08\x1a\xe5\xd2\x2b\x82\x16\x71\xc9\x15\x41\x8b\xb8\xd0\x8a\xa0\x5d\x70\x81\x15\x41\xbb\xe0\xb2\x2a\x82\x36\xe5\x72\x2a\x82\x36\xe5\x22\x9a\x08\x93\x30\xc9\x5e\x88\xa6\xe8\xe9\x22\xd9\x9c\xba\x25\xc1\x6f\x62\x9e\xaf\x53\xea\x6a\xc2\x09\xf8\x9c\x65\x94\xc9\x70\x71\xc5\x9c\xad\x9c\x16\xc6\xe4\x37\x71\x79\xe2\xbd\x99\xc0\x15\x7d\x8d\x74\x99\x24\xae\x35\xac\x84\xc3\xe2\xe7\xdb\x97\x41\xc1\x6d\x8c\xf7\x9a\x13\x76\x7b\x1b\x7d\x07\xa9\x3e\xe5\xe8\x5b\x37\x7d\xec\x62\x5a\xe5\x16\x5d\xa8\x5c\xde\x53\x54\x49\x1a\xb5\xe5\x2f\xf0\xe1\x35\xa6\xcd\xd4\xb5\x53\x9e\x4a\xb3\x99\x70\x36\x81\xe4\x9f\x65\x72\xcd\x56\x39\xb2\x52\x0e\xce\xee\xfd\x84\xda\xc5\x1c\xd9\x8b\xb3\x78\xad\xa4\x49\x50\xb2\x4a\xd0\xb4\x8b\xa0\x64\x95\xa0\x69\x93\xa0\x76\x39\x67\xb5\x9c\x4c\x8e\x50\xd4\x10\x0c\x89\x7b\xc1\x3f\xac\x9f\x45\xb7\x96\x0b\xac\x2e\x17\xb8\x96\x0b\xac\x2c\x17\x78\xda\xcc\xe0\x3b\x2d\x93\xec\x36\xf2\xee\x26\x45\x2a\xde\x06\x7f\x71\xd1\x39\xed\x62\x70\xa8\xd3\x6d\x08\x46\x09\x6f\x25\x95\xd1\x30\x45\x49\x93\xa2\xa4\x83\xa2\x69\x17\x45\xc9\x0a\x45\xd3\x16\x45\x6d\x80\xf6\x0a\x3c\xdd\x96\x97\x0c\xa5\x24\xc1\xb2\x96\xcf\xa9\x84\xc7\x91\x15\x9e\x37\xb1\xc3\x6d\xaf\xd0\x13\x5b\xaa\x95\x14\xb5\x64\x2f\xe1\xe3\xd5\xb0\x5d\x1b\x3e\x61\xf2\xe3\x66\x35\x4e\x24\x16\xba\xa1\xcb\xf5\x92\xb2\x9e\x1a\x9d\xb5\x99\x4c\x58\x47\xc4\xce\x90\x9b\xd0\xae\x5a\xdb\xe7\x29\xaf\x3a\x85\x24\x21\x0c\x26\x13\x1b\x6d\x03\x3d\x2b\x0d\x4a\xf5\xcb\x7f\x23\x8c\x9e\xa2\xc1\x83\xa2\xab\xf4\xb1\x7f\x4b\x69\x51\x23\x8f\xfd\xbb\x59\x29\x6f\x0f\x75\xf8\x3e\xa8\x83\x2e\xb8\x03\x6d\xbc\xcb\x57\x29\xe3\x5d\xba\x4a\x97\x18\x43\xed\x5d\xbc\x89\x65\x04\xa8\xf2\x20\xde\xc4\xa2\x06\x48\x26\xe9\xe6\x59\xba\xa7\xe8\x11\x8a\xa6\x45\x9e\x6e\xf6\x45\x7c\x6d\x0f\xaf\x0e\x36\x8d\x26\xac\x7a\x52\x54\x67\x5f\x2e\x12\xc9\x44\xdf\x80\xde\x63\x18\x7d\x8e\x1e\x9e\x03\xfe\xec\x17\xcf\x72\xa0\x80\x14\x8f\x61\xf7\x39\x29\xf0\x1c\xf0\x67\xbf\x78\x56\x48\x1d\xce\x07\x10\xaf\xb0\xb6\x7c\x68\xf6\x12\x9e\x81\xb5\x48\x11\x3e\x6d\x26\x16\x4f\xda\x99\xc5\xa7\x8d\x34\xfa\x5e\x39\x6c\x4b\xe7\x0f\x2f\xbc\x88\x12\x68\x81\x7d\xda\xc2\x9e\x34\xb1\x4f\xdb\xd8\x93\x26\xf6\xa9\x2a\xf6\x62\xf0\xa4\xc5\xb0\xc9\x2f\x23\xa0\x7c\xc0\x24\x65\x9a\xf2\x69\x99\xca\xbf\xf1\xd2\xac\x5f\x32\xfc\x46\xf9\x8e\x67\xaf\x95\xc7\xcf\x5b\x5f\x54\x2c\xf1\x4f\xdb\xf8\x93\x16\xfe\x69\x1b\x7f\xd2\xc4\x3f\x6d\xe0\x17\xce\x2a\xc4\x49\xd4\xc5\x8d\xf8\x9e\x4d\x63\xbe\x97\xbb\x03\xe4\x7b\x30\x30\xdf\xc7\xb2\xb7\x7f\x7c\x0f\x86\xec\xfb\x58\x6a\xec\xf8\x00\x59\xe1\x59\x85\x69\x52\x35\x49\x64\x39\x78\x25\x46\xd5\xb4\xe6\x03\xb7\x7b\x39\x6e\xda\xbd\xa9\xaa\x31\xae\xc9\x61\xff\x32\xce\xca\xd3\x92\x03\x09\xc1\xb4\x8b\x90\x60\x6d\x4a\xbe\x17\xda\xd3\x65\x4a\xbe\x8f\xbb\x28\xf9\x3e\x5e\x97\x12\xb1\x65\x5f\xa6\xe4\x4d\x27\x25\x6f\x3a\x29\x11\x8b\xff\x72\xfa\x7f\x09\x5a\x60\xf1\xb2\x34\x5b\x50\x49\xaa\x09\xb0\x3e\x5a\xda\xda\x4d\x3e\x1a\x40\x3b\xf4\x66\x3b\x4a\xb8\x77\x68\xcb\xeb\xab\xd0\xcb\x29\xba\x91\x5f\x7d\x63\x1f\x18\x12\x85\xaa\x08\x23\xdf\x85\xa8\x9d\x30\xb4\x47\x22\x58\xb0\xe8\x13\x89\x60\xc1\x7a\x14\x15\xc1\x82\xe5\x28\x2a\x82\x05\x4b\x60\xa3\x90\xf5\x56\x8e\x13\x51\x32\x7c\xa8\x01\x43\x42\x38\x85\x1a\xbc\x9f\x68\xb3\x9b\xc2\x95\x1e\x92\x81\x0a\xeb\x7f\x81\xa8\x4d\xb0\xfc\x17\x88\xda\x04\x2b\x93\xbe\x08\x16\x2c\x4c\xfa\x22\x58\xb0\x66\xea\x89\x60\xc1\x92\xe9\x60\xee\x75\xf6\x81\x65\xda\x11\x57\xee\x5c\x97\x62\x36\x2c\x00\x8f\x38\x9f\x99\x9a\x6c\xd6\xae\x00\xe7\x76\xbe\xea\x07\x8b\xe0\x8a\xee\xd1\x39\x9a\xe5\x34\xbb\xa4\x61\xcc\x54\x06\x76\x5e\xe1\x26\xfb\xfe\x3a\xcd\x1d\x16\x46\xee\x21\x28\xbf\x37\xe8\xf9\x1d\x56\xf7\xa1\x8e\x0e\x41\xe9\x3d\xde\xb8\x43\xad\xd9\x3a\x6f\xb5\x71\x43\x30\x1b\x5b\x32\x00\x18\x38\xed\x0f\xd3\x81\x6b\x3a\x80\xcb\x7e\x41\x47\xcb\x84\xfa\x6a\x74\x34\xf6\x70\x00\xb0\x0e\x43\xdc\x30\x1d\x7a\x4d\x87\x3e\x2d\x7d\x8d\x11\xd4\x6d\x0c\x6f\x6a\x74\x34\x36\x7d\x00\xb0\xc1\xe8\x08\x87\xe9\x30\x6a\x3a\x0c\x46\x42\x58\xd0\x61\x08\x74\x7c\x08\x66\x63\x97\x08\x00\x9b\x8c\x0e\x3a\x4c\x87\x59\xd3\x61\x32\x12\x68\x41\x87\xd9\xa4\x83\xaa\xd1\xd1\xd8\x56\x02\xc0\x16\xa3\x23\x1a\xa6\xc3\xaa\xe9\xb0\x18\x09\x51\x41\x87\xd5\xa4\x23\x52\xa3\xa3\xb1\x0f\x05\x80\x6d\x98\x29\x0f\xd3\x61\xd7\x74\xc0\xd4\xf3\xa2\xa0\xc3\x6e\xcd\x8c\xd5\xe8\x68\x6c\x5c\x01\x60\x87\xd1\x31\x1d\xa6\xc3\xa9\xe9\x80\xa5\x83\xc2\x0d\x63\x75\x87\xfc\xc1\x3f\xe6\x18\xf8\x27\xb8\x09\x74\xb8\xda\x43\xdf\x05\x3a\xc8\x8a\x7b\xb9\xc9\x93\x68\xeb\x5e\xe5\xd9\xbe\xc0\x93\xcd\x60\x61\xba\xc6\x24\xe5\x19\xbf\xae\xd8\xd0\xde\xa1\x1d\x04\x57\x7e\x46\x49\x9a\x66\xa3\x06\xdb\xb6\x91\xb6\xc0\x5a\xf1\xb9\x47\x94\x18\x12\xaf\x55\x78\x04\x80\x3f\xdf\x0b\x42\xf7\xd2\xd9\x07\x9a\xf1\x4c\x80\x28\x4f\x91\xa1\x3f\xf1\xe3\x9c\xb9\xba\x21\xf2\xe0\xb4\x9f\x4f\xa3\x34\xa3\xc5\xb9\xbb\x41\x59\x68\x9c\x63\x6f\x04\xb5\xe4\xe9\x0f\x86\x2e\x26\x64\x8d\xeb\xf7\x86\x6e\x50\xf8\x43\xaf\xd4\xeb\xab\x5d\x5c\xa2\xb7\xca\x9b\xfa\x3e\x3b\x61\x23\x07\x2f\xad\xeb\x6d\x65\x75\xb5\xed\x53\x84\x35\xdd\x84\x64\x7d\xab\x25\xbf\x5c\x2a\xf1\xe7\xb9\x54\xc2\xc2\xfa\x3a\x97\x4a\xb0\xea\x77\xbc\x54\xa2\x15\xa7\xb9\x72\xa9\x84\x85\xf5\x2f\x97\x4a\xc8\x12\xf8\x1f\x71\xa9\x04\x13\xa5\xf5\x2e\x95\xe8\x94\xa8\xd6\xa5\x12\x85\x54\x35\x6b\xcb\x5f\x2a\xb1\x7c\x3d\xd6\xdd\x6e\xbb\x33\xfe\x92\x97\x48\xd0\x59\xf0\xc4\xf7\xe6\xd4\x36\x97\x5e\x5c\x86\xd6\x72\xd1\x0f\x57\xbf\x84\xd1\xd2\x8f\x41\x7c\x35\xa5\xd9\xe7\x75\x0f\x45\xa3\x4d\xf0\x9d\x35\x85\xbf\xe0\x2d\x80\xe7\x26\xe1\x5f\xee\xab\x58\xeb\xbe\x8a\xf6\x8d\x11\x49\xec\x0b\x6f\x8c\x80\x80\xeb\x3d\xe8\x81\xaa\x4e\xe3\xb7\x5e\x5a\xc4\xf7\x4c\x6c\x6f\xa3\x57\x34\xbb\x04\xa7\x72\x6f\x9a\xc6\x01\x45\x18\x05\xc3\x37\x3e\xbc\xda\xc3\xfd\x77\x3e\x58\xce\x18\x99\xee\x18\x99\x78\x8c\x0c\x63\x8c\x74\x6b\x8c\xb0\x33\x46\xee\x18\xe1\x9e\xf8\x77\x8b\x8c\x91\xa5\x8d\x91\xa9\x8f\x91\x61\x8e\x91\x6e\x8f\x11\x26\x63\x84\xb5\x31\xd2\xfb\xea\xb8\x63\x64\xe1\x31\x32\x8d\x31\x32\xac\x31\xd2\x9d\x31\xc2\x0c\x07\x43\xdb\x53\xc7\xd6\xc6\xc8\xd2\xc7\xc8\x34\xc7\xc8\xb0\xc7\xc8\x36\xc6\xc8\xb2\xc6\xc8\x74\xc6\xc8\x70\x7b\x2a\x19\x78\x8c\x74\x63\x8c\xb0\x35\x46\xce\x18\xd9\xfa\x18\x59\xe6\x18\x99\x90\xe0\xbb\xaf\x0e\x23\x5c\x1f\x23\x6c\x8e\x11\xc3\x83\xc7\x60\x4b\x4d\x6b\x8c\x0c\xa7\xa7\x8e\xee\x8e\x91\x8e\xc7\x08\x33\xa2\xc6\x48\x27\x63\xa4\x6b\x63\x84\x19\xbd\xab\x35\x7a\x8f\xe5\x2c\x77\xa6\x2e\xee\x4c\xbd\xbf\x33\x59\x03\x58\xe7\x31\xae\xea\xec\x99\xd1\xd6\xd3\x66\x4e\x33\x63\x93\x5d\xb4\x44\xeb\x6b\xaa\x51\xf4\x15\x6b\x1b\xef\xee\x3e\x4e\x62\x1b\xb8\xce\x7a\x17\xb8\x61\xf4\x4b\x04\x13\x38\xd6\xbb\xac\xc3\x0c\x87\xf7\xaa\xd5\x4b\x2d\x13\x37\xad\x10\x21\x8b\x4b\xaa\xd9\x47\x04\x93\x17\x26\xd2\x06\x93\x39\x9b\xcb\xa8\xd5\x27\x64\x4c\x30\x98\x20\x33\x81\x66\x42\xc6\xfa\xb5\x6b\x6e\xd1\xdb\x83\x7b\xd7\x97\xd7\x89\x97\xc7\x1f\x28\x62\x33\xce\xf9\x34\x8e\x72\x51\x27\x3e\x3f\x3a\x7f\x7f\x76\x78\x74\x70\x7e\xc6\xfa\x92\x75\x16\x70\x16\x18\x0b\x6a\x84\x0b\x69\xc4\x85\x36\xe2\x42\xda\xf4\x42\x43\x81\xc1\xa4\x97\xa6\xb3\xe7\x27\x3f\xd0\x39\xf2\x66\x61\x91\xa7\xf7\x0a\xc4\x8c\x27\xf2\x17\xd0\xc6\xea\xbe\x7f\xd5\x2f\x63\x03\xb3\x4b\x6d\xa1\x3d\x85\xb5\x12\xa2\x6b\x7d\xc2\xc4\xcb\x95\x4b\x19\xbc\xb8\xa0\xb0\xde\x2a\x4c\x34\xad\x4f\xa4\x78\x71\xa3\x51\x7c\xb8\xa4\xd9\x2c\x29\x20\xc2\x6a\x13\xa1\x0b\x88\xb0\xdb\x0d\x14\x15\x77\x96\xf8\x21\x20\x86\xb4\x08\x1f\x06\xed\x2e\x53\x32\x0c\xda\x6b\x16\x17\x15\xf6\x97\x7b\x66\xb8\x78\xb0\x44\xca\x60\xe1\x70\x99\x25\xc3\xc5\x69\xa3\xf8\x30\x15\x51\xbb\x85\xc3\x60\x89\x2c\x54\x4c\x94\xe4\x43\x27\x0a\x44\x18\x44\x41\x5b\xcc\x65\x42\x04\x72\x4d\xe4\x75\xc0\x26\x4a\x8a\xe8\x10\x59\x45\x24\x44\x49\x46\x5d\xa2\x20\xa3\xde\x32\xd1\x02\x91\x5e\x26\x65\x98\xf2\x80\x28\x49\x69\x48\x14\x34\x80\x12\x79\x2d\x8f\x96\xe9\x10\x48\xab\x94\x28\x15\x56\x1a\x8b\x3b\x50\x6f\x94\x94\x10\x3a\xa3\x55\x5c\x48\xad\xd9\x86\x2e\xe2\x9b\xd5\x2c\x2e\x94\xe9\x66\x1b\x05\x65\x9d\x36\xd9\x92\xb6\x03\x4b\x9a\x04\x77\xb9\x95\x52\x86\x1a\x4b\x4a\x9e\xdf\x66\x8a\x40\xaa\x5b\x4c\x91\xb2\xd3\x58\x52\x77\xe9\x52\x71\x19\x53\x8d\xc5\xe6\x97\x28\xb1\x1a\x13\x25\x56\xeb\x44\x49\x5c\x0d\x22\x2f\x52\xe6\x12\x68\x19\x5b\x2d\xdb\xe5\x36\x91\x55\x5f\x87\x28\x08\x07\x21\x0a\x3d\xe8\x12\x25\x95\xf1\x88\xbc\xe0\xf9\xcb\x7d\x2e\x70\x3e\x96\x29\x19\x2e\x1e\x12\x59\xd1\xa3\x44\xc1\xdc\x44\xcb\x92\xd7\x31\xd5\x40\xc5\x56\x48\xd7\xef\x62\xef\xdb\xd4\x34\x62\x6a\xbd\x93\x39\xd4\xb4\xec\x45\x79\x29\xf7\xbb\x12\x3a\x19\xcb\x5e\x12\xa2\x89\x08\x31\xdb\x84\x08\xcb\x5b\x6d\xf8\x42\xe2\xed\x36\x7c\x61\x79\xa7\x2e\x2f\xa0\xa4\x39\xe0\x0a\xc1\xba\xcb\x64\x08\x80\x7b\xcb\x6c\x91\x73\xc4\x0b\x62\x04\xc0\x83\x56\x87\x0a\x0a\x87\x75\x61\x29\xc3\xce\x49\x16\x00\x8d\x96\xa5\x44\x6a\xbe\x23\xdd\x89\x98\x28\x70\x43\x27\xb2\x3d\x6e\x2c\x93\x21\x92\x6d\xb2\x24\xab\x22\xd9\x26\x6a\x9d\x6e\x13\x05\xc5\x74\x88\x9a\x62\x12\xa2\x20\x24\x2e\x91\x14\x12\x8f\xc8\xdb\x1d\x7f\x99\x62\x39\x03\x2f\x6d\x4a\x42\xa2\xa0\xc0\x74\xb9\x2f\xe5\xec\xbc\x94\x36\x34\x17\x43\x04\x25\xb1\xa2\x79\xc5\xba\xa2\xe1\xc1\x86\xa2\x81\xc5\xa6\xa2\x2e\x63\x4b\xda\xc4\x62\x5b\xc5\xac\x61\x47\x61\x50\x6b\x4e\xd7\xc5\x90\x5d\x95\x21\x10\x7b\x2a\xf6\x15\xfb\x8a\x23\x20\x0e\x54\x06\x1e\x1c\x2a\x0e\x80\x98\x2a\x0e\x3d\x38\x5a\x96\x18\xa1\xb8\xab\x98\x67\x8c\x55\xac\x1c\xd6\x15\x8d\x06\x36\x14\x2c\x12\x36\x55\x07\x22\x4b\x71\x08\xc0\xb6\xf4\x68\x84\x1d\xc5\xe1\x02\x13\x45\x1b\x86\x5d\x45\x8b\x8a\x3d\xc5\x11\x12\xfb\x2a\xa3\x0c\x0e\x64\x87\x19\x1c\xaa\x98\x5f\xaa\x38\x34\xe2\x68\x69\xe4\xb8\x6f\x17\x1e\x6b\xa6\xc4\x80\x21\x66\x83\xde\xea\x0d\x2c\xe5\xba\x73\xfc\x22\x0a\xcc\x46\x59\x4d\x50\xd6\x5a\x12\xa2\xe1\xd2\x76\xab\xe3\x44\x74\x38\xad\xd2\x6a\x6e\xa4\x9c\xcf\x5e\x53\x22\xe3\xb1\x97\xdc\x93\xf1\xd6\x6b\xaa\x87\xdb\x18\x2c\xf5\xe2\x70\xe9\xb0\xd5\x33\x32\x2e\x3b\x40\x96\x70\xd7\x0b\x98\x62\x0e\xcb\xb2\x0c\x13\x15\x36\xe8\x44\x4e\xe8\x0d\xa2\x22\xf4\x26\x51\x11\x4e\x8b\xc8\x33\xd7\x26\xf2\x2a\xe2\x34\xca\x0e\x97\x24\x44\xae\xcb\x5c\x22\xdb\x65\x1e\x51\x51\x21\x9f\xc8\x1b\x89\x80\xc8\xab\x44\x48\x54\x84\x9c\x12\x15\x23\x11\x11\x15\xb5\x6f\xb9\xd8\x12\x42\x8c\x15\x4c\x21\xd6\x15\xb4\x13\x1b\x4a\xe6\x1b\x9b\xd2\x5a\x8a\x2d\x25\x23\x8b\x6d\x25\x0b\x8e\x1d\xd9\x51\x9a\x28\x59\x36\xec\x2a\x99\x6f\xec\x49\x5a\x37\xec\x2b\x98\x6e\x1c\x28\x8d\x38\xb8\x69\x8e\x05\x64\x50\x85\xf1\x17\x47\x4a\x43\x48\xe1\x72\x4b\xb3\x0e\x4b\xdb\x23\xac\xab\x99\x7c\x43\xd2\x8a\x63\x53\xc1\x7c\x62\x4b\xc9\xe6\x63\x5b\xda\x38\x62\x47\x69\x8c\xc2\x44\x61\x80\xc0\xae\x92\x15\xc3\x9e\x82\xe9\xc5\xbe\xd2\xb8\x86\x03\x25\xf3\x8b\x43\xa5\x31\x03\x53\xc9\x41\x0b\x47\xed\x11\xe3\xbe\xfd\x6a\xa2\x71\x72\xc5\xf6\xbf\x9c\x6d\x62\xcd\x94\x70\xb1\x4b\x46\x08\xca\x1a\x35\x64\x53\xac\x84\xa6\x9c\xa2\x58\xed\x9e\x10\xfb\xd6\xd5\x44\x5a\x44\xae\xa3\xb5\xa6\x72\x72\x7e\x5f\x19\x99\x22\xf6\xac\x6b\xd9\x14\xfb\xd5\xbc\xac\xd8\xa7\xae\xbb\x4d\x26\x12\xa5\xee\x0a\x01\xec\xb0\x61\x0d\x25\x3c\xea\x52\xfd\xc5\x3e\x75\x2d\x94\x12\xfc\x95\x96\x49\x4c\xe4\x58\xa6\x13\x15\x26\x18\x44\x85\xc1\x26\x91\x17\x4b\x8b\xa8\xb0\xc2\x26\x52\x7a\xe1\x10\x15\x65\x23\x44\xb6\xdf\xdc\x36\xb1\x32\xce\xb5\xa4\xa4\xfb\x44\x56\xca\x02\xa2\xa2\x6d\x21\x91\xd7\x63\x4a\xe4\xcd\x59\x44\x54\xac\x09\xd6\x14\xcc\x09\xc6\x0a\x56\x0d\xeb\x4a\x66\x0d\x1b\xb2\x1e\x88\xb4\xd6\x63\x4b\xc9\x9c\x60\x5b\x53\x91\x21\xec\x28\x8d\x48\x98\x28\x59\x16\xec\x2a\x99\x6e\xec\x29\x0d\x62\xd8\x57\x18\x1f\x71\xa0\x64\xc3\x71\xa8\x30\x8c\x60\x2a\x39\x8e\xe0\x48\xda\x84\x73\xaf\x5a\x9e\x17\x58\xc9\xce\x61\x5d\xce\xd0\x61\x43\xc1\xd4\x62\x53\xc1\x18\x60\x4b\xc9\xce\x60\x5b\x6d\x94\x72\x24\x87\x29\x4c\xd4\x0c\x8d\x2b\x6d\xcb\xb1\xa7\x34\xa6\x61\x5f\x69\xc0\xc4\x81\xb4\x41\xc7\xa1\xd2\xa8\x82\xa9\xc2\xb0\x82\xa3\xb6\x4d\xbf\x7f\xa7\x5a\x68\xf7\x4b\x1e\xc8\x44\x9a\x54\xad\x12\xba\xd2\x4f\x1b\xa1\xe1\x42\x4f\xba\x28\x0c\xeb\xfb\x42\x7f\xba\x9a\xbf\x09\xca\xda\x25\x60\x99\xc2\x4e\xdd\x38\x41\xc9\xa6\xfc\xca\xb8\xd2\x75\xdb\x24\x7c\xe9\x9a\x6b\x02\x2a\xfc\xba\x71\x62\x92\x83\x56\x61\x91\x43\x22\x65\xd7\x68\x53\x70\x04\xe8\xa3\x56\x47\x88\xd7\xa8\x65\xe1\x62\xa2\xd0\xc1\x3a\xd1\x64\x14\xc2\x20\xf2\x0a\x61\x12\x59\x85\xb0\x88\x82\x8c\xdb\x44\xa1\xb7\x1c\xa2\xa0\x6a\x84\x28\x88\xa3\x4b\x64\xe4\xc0\x23\x0a\x72\xe8\x13\x79\xed\x0d\x88\x82\x36\x84\x44\x41\xc2\x28\x91\x57\xe0\x88\xc8\x9a\x05\xac\x49\x9b\x47\x8c\x35\x29\x59\xc4\xba\x8a\x1d\xc5\x86\x8a\xbd\xc3\xa6\x82\x25\xc5\x96\x8a\x01\xc1\xb6\x8a\x45\xc7\x8e\x82\x3d\xad\x7c\x34\x29\x71\xc3\xae\x8a\xe1\xc1\x9e\xc2\x58\x88\x7d\x15\xcb\x8e\x03\xe9\xd1\x05\x87\x2a\xa3\x00\xa6\x72\x4e\x68\xa4\x60\xad\xc1\x6f\x96\x97\x23\xac\xa0\x4d\x58\x57\xb0\xae\xd8\x50\x31\x9a\xd8\x54\x31\x84\xd8\x52\x19\x66\x6c\xb9\xa1\x03\x3b\x2a\x86\x1b\x13\x79\xd3\xe2\xaa\x18\x59\xec\x49\x19\x6e\xec\xab\xd8\x4c\x1c\x28\x98\x6e\x1c\x4a\x0f\x8c\x98\xaa\x0c\x49\x38\x5a\x1a\x14\xee\xdb\x5b\x2e\x58\xd1\x77\x74\x15\x95\x96\xbe\xa4\x41\xe4\x2f\x73\x98\xba\x98\xbf\x46\x5d\x56\x17\xe1\x37\xeb\x9e\x10\xd2\x60\x35\xf9\x25\xf2\x96\xab\x92\xc3\xf8\x9d\x96\xc2\x4b\x79\x73\x42\x2f\xb9\xd1\xa0\x61\xe4\x5e\x49\xa6\xb0\xe9\x7e\xa1\xb4\x22\xcf\xb8\xd1\x47\xc3\x65\xc3\x06\xea\xe1\x92\xb4\xe2\xe5\x70\xb9\xa8\xd5\xeb\x22\x4e\x4a\x0b\x13\x26\xf2\xc2\xa4\x13\x09\x2e\x19\x44\x56\x8a\x4c\x22\xcb\x23\x8b\xc8\x6b\x9c\x4d\xe4\x25\xde\x21\x72\xbc\x27\x44\x56\xde\x5d\x22\xa7\xef\x1e\x91\x17\x63\x9f\xc8\xcb\x5d\x40\x24\x74\x28\x24\xf2\xa2\x44\x89\xac\x0e\x45\x44\x5e\xd5\xb1\xa6\x60\x97\x30\x56\x50\x3c\xac\x2b\x58\x47\x6c\x48\x2a\x1f\x36\xa5\x6d\x23\xb6\x14\x4c\x1e\xb6\x35\x85\xb1\xc4\x91\xb6\x67\xd5\x6c\x57\x82\x07\xae\x94\x07\xe0\xc9\x58\x48\xec\x2b\x0c\x63\x38\x90\x1c\x1b\x71\xa8\x60\xf3\x31\x55\xb0\x93\x38\x92\x1e\xc8\x98\xa7\x2b\xc1\x00\x2c\xad\x2e\x58\x97\x36\x94\xd8\x50\xb0\x7f\xd8\x54\x30\x80\xd8\x92\xb4\x80\xd8\x56\x18\x27\xb0\xa3\x34\x00\x29\x58\x23\xec\x2a\xd8\x42\xec\x49\x1a\x63\xec\x4b\x8f\x44\x38\x90\x1e\x0a\x70\x28\x63\x8b\x31\x55\x31\x9b\x51\x7b\xd8\x78\x18\x97\x56\xa0\x07\x65\xf3\x4d\xad\xef\x56\x21\x54\xb8\xb4\x85\x5b\x2f\x70\x66\x0b\xc4\x22\x70\x66\x35\x01\x11\x95\xb4\x24\xd8\x6e\x73\xb4\x02\xdf\xb5\x9e\xf2\x0c\x97\x24\x0d\xa6\x88\xdc\xd7\x8a\xcf\xc3\x20\xbd\x86\x71\x18\x2e\xe9\x37\x18\x23\x5a\xda\x6d\xcc\xe1\x04\xee\x2b\xef\xbb\x61\xcc\xb4\xee\x3b\xd1\xa2\x6e\x63\xb6\x3d\xcc\x49\x59\x96\x63\x22\xee\x64\x9d\xc8\xca\xaa\x41\x64\x79\x68\x12\x49\x45\xb1\x88\x6c\x07\xda\x44\x96\x3b\x0e\x91\xe9\x16\x42\x24\x55\xca\x25\xb2\x62\xeb\x11\x59\xc9\xf1\x89\xa4\x80\x07\x44\xc6\x3c\x84\x44\x56\xeb\x29\x11\x6b\x74\x44\x24\x05\x16\x6b\xd2\x12\x8b\xb1\xb4\xe9\xd4\xa5\x6d\x27\x36\xa4\x6d\x0f\x36\x65\x4d\x0a\xb6\xa4\xcd\x14\xb6\xa5\x8d\x0a\x76\x64\x2d\x78\xe1\x1b\x09\x8b\xb9\xd2\x96\x1e\x7b\xb2\x16\x08\xfb\x12\x06\x1f\x07\x32\x03\x76\x28\x6d\x45\x31\x95\x36\xe2\x38\x92\x1a\x2d\xb1\x26\x2d\xc1\x58\xda\xf8\x61\x5d\xde\xea\x1a\x12\x5a\x86\x4d\x69\x83\x86\x2d\x59\x73\x8a\x6d\x29\xdb\x87\x1d\x69\x4b\x8e\x89\xb4\x85\xc6\xae\xb4\xfd\xc3\x9e\xc4\xc0\x84\x7d\x69\xb3\x86\x03\x59\x6b\x8e\x43\x29\x8b\x8a\xa9\xb4\xd5\xc7\x51\xc3\x98\xdf\xb7\xaf\x49\x98\x03\xad\x0b\x08\xa8\x3a\x68\x78\x9c\x7f\x5a\x07\xb6\x0c\x0f\xf3\x1c\xde\xf0\x00\x5f\xe0\x14\x14\xb3\x38\x4e\x5d\xd8\x06\xbb\x2a\x28\x1a\xdc\x8b\x56\x88\xfd\x23\xa2\x89\x79\xe2\x56\x3c\x16\x0d\xed\xbc\xbd\xa2\x62\x7e\x85\x58\xd0\xde\xe0\x29\xbf\x3c\x65\x78\x58\x17\xeb\x08\xad\x3a\x55\x00\x2b\x2a\x1a\x20\x28\x56\x06\x3e\x49\x71\x99\x17\xc5\xb2\x7d\x5c\x94\xd7\x65\xe5\xba\x28\x6f\xc8\xca\x6d\x51\xc6\x94\xed\xfc\xa2\xbc\x25\xd7\xb5\x45\x69\x5b\x89\x8f\x8e\x84\x2a\x15\x45\x89\x6c\x4f\x16\xe5\x5d\x19\x09\x2a\xca\x7a\x72\xda\x5a\x94\xf6\x65\x75\xb1\x28\x1f\x88\xc5\xb4\x28\x19\xca\xaa\x47\x51\x9e\xca\xaa\x67\x51\x3e\x92\xb3\x84\xe5\xec\x5c\x68\x58\xb1\x2c\x27\xb0\x2e\xd1\xd1\xd8\x90\xd1\x2a\x6c\xca\x36\x1a\x5b\xb2\x22\x83\x6d\x59\xc6\x63\x47\x92\x85\x32\xe3\xb7\x2b\x27\x76\xd8\x93\xd5\x58\xec\x4b\x8f\x89\x81\xac\x55\xc2\xa1\x9c\xfe\x63\x2a\xa3\x70\x38\x52\xb3\x56\xf5\x42\x91\x54\x69\x2c\x6f\x97\xb1\xae\xa6\x6c\xd8\x50\xb1\x6c\xd8\x94\xb6\x6c\xd8\x52\x33\xc8\xa5\xb0\x4a\xb3\xd0\x51\x1b\x50\x30\x51\x1b\xb0\xb0\xab\x66\x99\x4b\x71\x96\xb5\x5a\x5c\xa8\xa5\x4a\x06\x2a\x43\x15\x17\x6c\xc9\x36\x52\x95\x11\xa2\x14\x72\xa0\xa4\xc7\xe3\x5d\xfd\xb9\xf7\xf2\xe6\x17\xde\xfc\x97\x39\xca\xa7\x5e\x8e\xe6\x34\xa1\x41\x0e\xd9\x44\xce\x9e\x9f\xfc\x80\xe2\xd9\xd5\x75\xbe\x5a\xa9\xba\xb6\xf9\xc5\xee\xd9\xf7\xfd\x37\x37\xd7\xb7\xcf\x8d\x51\x7d\x8b\x05\x7b\xd6\x8a\x2f\xf0\x5c\x7c\xe9\x69\x38\x2b\xc0\x4b\xf0\xc2\xfc\x4b\xf9\xcc\xbe\x8c\x1b\x8c\x91\x6e\x76\x4f\x72\x95\xfd\xc9\x19\xcf\xfd\x83\x78\x3e\x03\xe4\x25\x17\x69\x16\xe7\xd3\xcb\xce\xfc\x22\x1d\x49\x3a\x18\x6f\x18\x94\x9d\xe2\x7a\xfd\x2d\xfe\xa5\x71\x1b\xff\x16\x5d\xe4\x74\x16\x8e\x7a\x66\x1f\xef\xc3\xf4\x94\xce\x69\xfe\x00\xd9\xd7\x7e\xa1\xb7\x55\x1e\xa5\x5f\x68\x57\x32\x81\xa5\xd2\x65\xea\xb5\x5f\xe8\xad\x44\xe6\x35\x46\x08\x17\x20\xcb\x46\x7e\x9c\xcf\x91\x17\x04\x69\x16\xc6\xb3\x0b\x94\xa7\xe8\xd5\x5e\xcf\xf4\xac\x81\xf0\x79\x0c\xb9\x36\xde\x0e\x25\x6e\x8e\xd2\x0c\x8d\x58\xf9\x18\xed\x20\xed\x1b\x14\xa3\x67\xc8\xb2\xbf\x41\xf1\xe6\xe6\x20\x9f\xda\x68\x5e\xa5\x0c\xd1\xab\x3d\xfc\x36\x7e\x87\x9e\x20\x2c\xc8\x08\x5a\xd0\xc6\x0a\xef\xa0\x51\xc9\x9a\xb7\x35\xac\x22\x79\x1b\xfb\x33\x32\x30\x7a\xd2\x40\x03\x59\xdb\x36\xd0\xa3\x41\x24\xa2\xf4\xa0\xbb\xf3\x39\xbd\xf4\x13\x8a\xb0\x8d\xe6\xd7\xfe\x2f\xf4\x56\xd0\xd9\xf3\x6b\xff\x7b\x7a\x3b\xaf\x3a\xbc\xfe\x2e\xc7\xdd\xd9\x19\x54\xe0\x3c\x2e\xbf\x3c\x43\xd8\xae\xbe\x49\xf0\x7b\x7b\x1b\xed\x41\x7a\x9a\x82\x66\x71\xef\xcc\x4b\xac\x05\xbd\x6f\x0b\x64\xef\x4a\xc2\x85\x08\x4b\x65\x10\xe3\xf2\xe3\xfc\x0c\xae\xcf\xdf\x69\xdc\x90\x5f\x21\x94\xc2\xc5\xe5\xdd\x24\x9d\xf2\xde\x73\xd7\x66\xf9\xe9\x12\x64\xdd\x94\x13\xe4\x36\xfe\x28\x4b\x2f\xc1\x6a\x27\x34\xca\x91\x4e\x40\xd3\x19\x45\x62\x20\x9c\xcd\x6f\x47\x31\xda\x46\x36\xe4\xb0\x85\x0c\x84\xa5\xbc\x8f\x46\xaf\xf6\xf4\x42\x45\x36\xd0\x66\xc5\xb2\x0d\xf4\x77\xa4\x93\x77\x90\xa4\x10\xc4\x3d\x46\x7f\x47\xb6\x38\x2b\x74\x0f\xdd\x59\x7c\x31\xbd\x1b\xe1\x26\xe4\xc1\xac\xa9\xdf\x68\x91\xaf\x13\x78\xcd\x1b\x81\x36\x91\x6e\xf6\xb4\x64\xa3\xab\x29\x43\xe8\x07\xf5\xb5\x6c\x65\x3c\x0b\x28\xa2\x5e\x30\x2d\xc4\x1f\xc5\x73\xe4\x5d\x5d\x25\x31\x0d\x99\x84\x78\x33\x44\x17\x57\xde\x2c\xa4\x61\x99\x40\x10\x46\xdc\x01\x1f\xa0\x80\xcc\x78\x56\x80\x0c\xbc\x19\xf2\x29\xf2\xb3\xf4\x17\x3a\x43\xf1\x2c\x4f\x11\xe1\xa9\x83\xe7\x68\x1e\x78\x09\x47\xc5\xc1\xcf\xc5\x90\x6f\xa6\x71\x30\x45\x5e\x92\xa4\x37\x73\x40\xc3\x70\xe4\x29\x43\x71\x3d\xa7\x21\xba\x89\xf3\x69\x7a\x9d\x73\xc2\xe7\x71\x3a\x1b\x86\x58\xf4\x12\x64\x9a\x1c\xd5\x5f\x9e\x3d\x63\x9d\xf0\x7b\xf3\x27\x66\x39\x0d\x2c\x62\x7b\x4b\x67\x30\xd7\x19\x47\x5e\x65\x0a\x74\x60\xc5\xeb\x67\x30\xda\xa3\x98\x4b\xc6\xd7\x88\x89\x94\x21\xd1\xff\x12\x88\x9c\x66\xbb\x9d\x77\x45\x1e\xcc\xdf\x9b\x3f\x31\xe4\xba\x33\x84\x4e\x34\x34\xec\xf1\xac\x8f\x28\x9e\x7d\xa0\xd9\x9c\xca\x0d\x0f\xf1\xec\xc3\xd9\xd2\x08\xd1\xfa\x49\x79\x08\xc6\x92\x43\x70\x8d\xa5\xd9\x0d\xf3\xb7\xd8\x62\xaa\x37\x84\xb5\x87\xe5\xfd\x59\x1c\xe9\x2c\xc8\x6e\xaf\x72\xf0\xbd\x9a\xee\xd4\x8b\x31\x4a\xa3\x68\x4e\xf3\x41\x62\x8b\x24\xb5\xe9\x5e\x05\xa3\xae\x38\x6e\x0f\xaa\xea\x69\x76\x43\xfa\xd0\xa4\xd5\x7c\xbe\x53\x12\xe0\xbd\x61\xf2\xc6\x65\xbf\x89\x5c\xd3\xef\x68\xde\x37\x8d\x68\xb7\x26\x01\x64\x68\x07\xbd\x78\xcb\x51\x0c\x89\x02\xaf\x92\x2d\x57\x41\x9b\x08\x0f\x8f\xdb\x90\x68\x3d\xce\x63\x2f\x69\xe6\xa8\xe9\x2f\x4f\x17\xc1\xd4\x9b\x5d\xd0\xe3\xd3\x3a\x65\x29\xe4\xce\xd1\x16\x5a\xc4\xff\x1b\x52\xdd\xee\xea\xd8\x2e\xe7\x2f\x51\x14\x49\xd5\x3f\x3d\x6e\xd6\xd7\x59\x75\xa3\xf8\xa8\x57\x27\x1c\x7b\x14\xb1\xff\xef\x40\x3d\xab\x6e\x15\x1f\x71\x7e\xda\xf4\x7a\x16\x0e\xd8\xa2\xca\x94\xf0\xc4\x41\x60\x4e\xf8\x23\x37\x29\xf0\x2c\xe7\x69\x4a\xcc\x82\x50\x9f\x97\x09\x68\x86\x24\xae\xac\x59\x89\x69\x53\x6a\x25\xea\x65\xed\x7a\x59\x59\x4f\xd8\xaa\x03\x1a\xcf\x73\x9a\x54\x4a\x28\x46\x15\x01\x17\xd5\x7d\x4f\x22\x3f\x8e\x46\xcc\xc9\xe2\x99\x9b\xde\xc6\xef\xde\x8e\x46\x45\xf3\x7e\xaa\x47\x55\x36\xe1\xa9\x16\x09\xe0\x3b\xe4\x26\x17\xf1\x58\x30\xae\x2e\xd9\x8a\x4c\x86\xfd\x4b\xc6\x22\x29\x49\x8d\xd6\x18\x73\x5f\xcf\xc2\x14\xcd\x6f\xbc\x2b\xee\xc3\x26\xde\x3c\xe7\xa2\x3a\x3c\xea\xe6\xf2\x82\xb3\xd4\xd2\x25\xb1\x91\x6c\x6b\x2e\x52\x4f\xc8\xf5\xbe\x8e\x29\x5c\xb1\x06\xa2\xea\x6b\xda\xa2\x35\x2d\xe1\xba\x86\x58\x30\x0e\x08\x16\x47\x72\x94\x5e\xe7\x83\x63\x61\x35\xf8\xc9\x0b\x4a\x6b\xf0\x93\x93\x94\xfe\x91\xff\x17\x7a\xcb\xd3\x5e\xdb\x3c\xe9\x75\x6f\xc1\xf8\x83\x5c\xb9\x46\x26\x6d\x5b\x35\x8f\xf6\x36\x3a\x63\x1a\x56\x4c\xad\xb3\x74\x3e\xaf\x27\xb9\x90\x6b\x0e\x66\x8d\xb0\x30\xb5\x5a\xbb\x72\x5a\xea\xae\x1b\x95\xbe\xcb\xa5\x37\xff\xa5\xd7\xce\x95\x7a\x3a\x1a\xb5\x54\x90\x59\xaf\xd2\x35\xfb\xa9\xc5\x64\x66\xe9\x18\xc4\x1e\x66\xb7\x54\xf2\x27\xae\x93\xfd\xe5\x92\xba\x1c\x9b\x2d\x70\x8c\x1d\x35\x3a\x0d\xd4\x4a\x9b\x4f\x8f\xef\xd6\xe6\xac\xbf\xcd\x89\x7c\x9b\x13\xc9\x36\x67\xeb\xb4\x59\x3a\x4f\xf6\xbc\x4c\x94\xcd\x97\x76\xd7\x4b\x95\x7d\xd7\x9c\xd0\x1c\x77\x4e\x17\x79\x33\x33\xf4\xfe\xe4\x6c\xab\x98\xac\xb4\x52\x43\x8f\x51\x10\x5d\x08\x92\x95\x5f\x25\x1e\x6b\xc0\x22\x47\xcb\x10\x8b\x39\xc6\xa8\xc6\x29\x02\xda\x99\x74\x7a\x75\x01\xbb\x9d\xc4\x7c\x7f\x72\xa6\x96\xc1\xfc\x3c\x8b\xaf\x12\xfa\xe4\x7e\xd6\xda\x39\xb0\xd6\x8a\x7b\xf3\xa7\xbf\xc8\xba\x7b\xb1\xc8\xca\xda\x1c\x43\xb6\xcd\x80\x0e\xd0\x54\x4c\x1f\xe9\x1c\xa3\x1d\x56\x67\x8b\xf7\xe7\x84\x4b\x60\x9a\x8d\xaa\x7c\xc3\xc5\x9b\x6a\xc1\x7b\x6b\x9e\xc4\x01\x1d\x69\x63\xa4\x6f\xac\xa4\xa7\xee\x44\xa1\xdf\x11\x85\x3e\x46\xa6\x24\x0a\xe3\x8e\x28\xcc\x31\xb2\xfb\x51\x3c\xf0\xb2\x02\x9d\xe3\xad\x26\xa0\x46\x6d\x29\xb6\x6e\x35\x57\x10\x14\x2b\x1b\x8a\x98\x1f\x78\x19\x83\x11\xb4\x46\x6b\xf4\x75\xf8\x88\x15\x31\x4b\x78\x4e\xd8\xd5\x3f\xbd\xeb\xf4\x1f\x30\x28\x56\x66\xfc\x9e\x87\xc6\x1a\xee\x3d\x0d\x90\x7d\xe3\x4d\x7b\x98\xac\x4a\xad\xe4\xab\x1f\x6d\xf0\x4e\x6c\xff\x9c\xd1\xfc\x3a\x9b\x75\x90\xdd\xea\xf0\x8f\x95\xfd\xfa\x38\x46\xbf\x2d\xe5\xe1\x7f\x0a\x09\xaf\xcb\x5c\xfe\x4f\x21\xc9\x78\x3b\x9f\xff\x53\x48\x56\x5e\xa7\xf2\x7f\x0a\x09\xac\x8b\x1c\xff\x4f\x91\x8d\x3f\xbe\x2b\xb6\x0c\x88\xf9\x14\xbd\xad\x15\x3c\xa3\xff\xba\x8e\x33\x3a\x46\x97\x69\x78\x9d\xd0\x31\xa2\x8b\xab\x34\xcb\xdb\xcb\x81\xdf\x34\x52\xea\x67\x69\x9a\x8f\xcb\x04\xfe\x2b\x56\x21\x8e\xd0\x28\xbf\xbd\xa2\x69\x54\x02\x42\x3b\x3b\x3b\xe8\x2b\x2e\x8c\x5f\xf5\xe5\xe3\xdf\x4b\x2f\x2f\xd3\xd9\xff\x9e\xad\xbe\xe4\x64\x6d\x55\xc0\x6a\xb0\x25\x0d\x65\x13\x46\x25\x7f\x56\x46\x82\x8f\x88\x26\x73\xda\xa4\x2d\xa4\x51\x3c\xa3\x9c\xb4\xb2\x69\x5f\xa1\x47\x8f\x8a\x17\x5b\xde\x65\xd8\x47\xea\xee\x8b\xfd\xd5\xdf\x79\xb5\xd1\xdb\x92\x84\x77\x35\x87\xba\x69\xe9\x86\xfd\x5d\x92\xfa\x5e\x82\x46\x7e\x96\xde\xcc\x69\xb6\xd1\x31\x31\x28\xdb\x9c\xa6\xf9\x56\x29\x52\x2b\x38\x1a\x62\x55\x4c\x70\xeb\xfe\xab\x2a\x31\x1a\xda\xf5\x1a\xbd\x7c\x3d\xe3\x4d\xea\x65\xc3\x80\xc7\xc4\x34\x76\xaf\xa1\xa8\x1d\x0a\x08\x45\xde\x27\xb1\xcf\x8a\x6d\x25\xb1\xdf\x53\xe6\xb9\x37\xa7\xe0\x0f\x26\xb1\xbf\xc5\xbe\xf4\x94\xfb\xc1\xd0\x2b\x27\xa1\x2a\x5f\xfd\xa2\x64\x59\x17\xb6\x89\x66\xde\x25\x9d\x5f\xf5\xd9\xcc\x1e\x07\x76\xef\x3d\xab\xca\xda\xc3\xff\xfe\xf6\x51\x09\xef\x2e\xb2\x4d\xd8\x39\x64\x7e\xa2\x0a\xde\x1f\x6c\x93\xb5\x14\x9a\xbd\xb0\xcd\xad\xe2\x0b\xe3\x96\xc0\x53\xee\x26\x85\x93\x53\x2c\xae\xc7\xbf\xd2\x39\xf2\xd0\x8c\xde\x24\xb7\x88\x9b\xc1\x50\x44\x28\xea\x19\x27\x38\xe0\xff\xb9\xf2\x32\xef\x12\xfd\x36\xbb\xbe\xf4\x69\xf6\x11\x4d\xe3\x8b\x29\x3a\x9f\x52\xfe\x60\xe8\xb0\x4a\xd0\x0b\x76\xa5\x7a\x92\xde\x40\x6d\xf6\x57\x54\xb9\x1f\x68\xff\x80\x37\x5c\x11\x15\x7d\xb0\xa8\xfb\xa0\x34\xf3\x65\x57\x94\x3e\x2b\x8f\x7f\xd2\x74\xcd\x80\xb5\x26\x53\xb3\x34\x5b\xeb\xdf\x2e\xec\xea\x6d\x04\xbb\x6e\x71\x6b\x66\xc3\xb8\x36\x66\xad\x17\x3b\x68\xc0\xe0\x1d\xe0\xb3\xc8\xa3\x62\xdc\xdc\x61\x50\xfb\xbc\xa7\x3e\x57\x67\x48\xa4\x9e\xc7\xf9\x4d\x3c\xa7\xe8\xe5\xc9\xf9\x1c\xd0\xdc\x55\x82\x8a\x11\xf5\xb7\x42\xf2\x3f\xa2\x5d\x26\xa0\xac\x13\x9e\x40\x2f\x14\x9e\x8f\x17\xe5\x34\x43\x33\x7a\xe1\xe5\xf1\xec\xe2\x13\x48\x05\xa0\xa6\x4c\x2a\x0a\xf9\xd8\x9a\xa5\xf9\x48\xb9\xcb\xb7\xb7\xd1\x2c\x95\x9b\xce\x6e\x6f\x03\xe2\xa2\xa7\xff\x5d\x75\x7b\x0f\xca\xa2\x38\xef\xed\x7f\x97\x3d\xdf\x3b\x47\xdd\xde\x2e\x9d\x99\x82\xf3\xa5\x6c\xd7\x42\xd8\x8f\xa8\xdf\xe1\x96\x91\x99\xdd\x97\xfb\x0d\x99\x81\xe0\x05\xf0\x76\xaf\xbc\x39\x04\x33\xac\x65\x8a\x2a\x39\x02\xd8\xcc\x9a\x54\xa2\x94\xa7\x0c\x75\x89\xef\x0f\x12\xd9\xdd\x97\xfb\x9f\x46\x60\x79\x04\x4b\x2d\xae\xde\x2c\x1c\x79\xb3\x34\x9f\xd2\xac\x20\xfc\x2e\xc2\xeb\xcd\xc2\xa6\xf0\x32\x0e\x4a\x0a\x70\x6d\xb6\x1e\x71\xc6\xcb\xca\x72\x65\xc4\x8a\x7a\x9f\xa9\x54\x9f\x9c\x7e\x2a\xa1\x3e\x39\xfd\x83\x65\xfa\xe4\xf4\xd3\x88\x74\x9a\xb5\x24\x3a\xcd\xee\x41\xa0\xd3\x6c\x6d\x79\xfe\xfd\x8e\xf2\xfc\xfb\x67\x2d\xcf\x3f\x7c\x3a\x81\xfe\xe1\x0f\x97\xe8\x1f\x3e\x95\x48\x2f\x96\x64\x7a\x71\x2f\x42\xbd\xb8\x07\xa9\xfe\xe9\x8e\x52\xfd\xd3\x67\x29\xd5\x10\x55\xda\x94\xe7\x19\xdf\xc1\x2c\x16\xda\x12\x1a\xe5\xeb\x4f\x7f\x66\x20\xc9\xfc\x1b\x4a\xa3\x0a\xc3\x9c\x21\x7f\x68\x11\x06\x24\x9f\x46\x88\x01\x75\x4b\x8c\xe1\x97\xe3\x91\xde\x1f\x7c\x30\x20\xbd\xbc\x72\x53\x80\x67\x43\xd2\x1b\x47\x68\x34\x43\xcf\x90\xa1\x4b\xca\xf8\xa8\x16\xf2\x67\xcf\xd0\x0c\x42\x3f\x2b\xf1\xe5\x67\x05\x74\xf4\x04\xcd\x7a\x77\x21\xba\x05\x9f\xc1\x1a\x10\xdb\xde\xb5\xa2\x55\x02\x9b\x20\x47\x33\xf4\x84\x35\x4d\x86\x94\xbe\xf8\x22\x86\xfe\xcf\xa3\x8b\x10\x40\xf0\x45\x19\xef\x59\x19\x4f\x47\xea\xeb\x13\xa5\x2e\x9e\x3e\x80\x2e\x72\x89\x6d\xeb\x5d\x43\x15\x4b\xed\x94\x54\xc5\x95\xd1\x0b\xc0\xad\xa9\x8d\x0d\xfd\xae\x60\xca\xa9\x63\x41\xce\x9f\x44\x1f\x4f\xd3\xdc\xcb\xe9\xa7\x1a\x1c\x33\xc0\xfe\xd0\x0a\x09\x58\x3e\x8d\x42\xf2\x06\x36\x15\x32\x4b\xef\x3c\x36\xb2\xaa\xd2\xda\x58\x70\x08\x24\xb8\x18\x91\x67\x1b\x6c\xda\x54\xff\x72\x3a\xb2\x4d\x91\x82\x3d\x8c\x54\x3d\xb0\x99\xff\x6b\x8a\xd5\x1d\xad\x3c\xab\x79\x77\xa9\x3a\x5d\x91\xaa\xe3\x07\x93\xaa\xdd\x30\xfc\x54\xb3\x52\x2f\x0c\xff\xe0\x59\xa9\x17\x86\x9f\x68\xed\x30\x5c\x5a\x3b\x0c\xef\x65\xed\x30\x54\x5e\x3b\x5c\xf6\x12\x36\xab\xc9\x25\x9c\x2b\x1c\x1e\x85\x03\x2f\xcb\x6e\x59\xf5\xd2\xbf\xd0\x36\xd0\xb3\x25\x97\x43\xdb\x40\xff\x8d\x30\x7a\x2a\x82\xb5\x3a\x75\xd8\xac\xe7\xc7\x68\x93\xe3\x2a\x69\x7a\x88\x91\x7d\xf5\x8d\x62\x94\xc9\xee\x0c\x79\xb0\xa5\x9a\x46\xcd\x4d\xbf\xce\x1d\xb6\x9e\x50\x92\xab\x2c\xbd\xa2\x59\x7e\x8b\x7e\x83\xad\x58\xae\x27\x73\x50\x94\x0a\xf4\xca\x9e\x59\x21\xd2\xdd\x78\x9a\x30\x4b\x8b\x3e\x8f\x2f\x9e\xdf\xb2\x91\xa3\x6d\xd8\xe7\xf1\xc5\x2c\x8e\xe2\xc0\x9b\xe5\xc8\x87\xf7\xf1\xac\x61\x09\x80\x80\x3b\x6c\xb5\xd6\xdb\xcc\x25\xc1\xe5\x2f\x0f\xb8\xe9\x3a\x4c\x71\x5f\x0f\xa0\x96\xb1\x6a\x75\xc1\xe8\xe4\x8a\x29\x95\x97\x6c\xb4\xfa\x59\xa9\x33\x50\xe7\xf8\x5a\xf5\x46\x03\x85\x54\xc7\xd0\x7e\xf1\x12\x34\x70\x5d\xeb\x75\xd3\xec\xd6\x65\x0e\xb4\x62\x0f\xfb\xad\xd8\x3d\x22\xe9\xb9\x88\xa3\x80\xc5\x3e\xaa\xfb\xcc\x7d\x07\x86\xa5\x20\x62\x82\x5d\xec\x61\x1f\xae\xff\x08\x70\x88\x29\x8e\x3a\x82\x53\x1a\x00\xdf\x7d\x61\x93\x14\x9b\xc6\x08\x6b\x6b\xc7\x00\x80\xd2\x8c\x2b\xb5\x1b\x8c\x04\xb8\x29\x22\x92\x61\x50\x2a\xbf\xf0\xbf\xbf\xff\x2e\xba\x98\x81\x4d\xd5\x2b\xe5\xfe\xdb\x0e\x1a\x8e\x12\x6a\x7e\xb8\x9f\x57\x56\xdd\xa9\x68\x1d\x3a\xb9\x35\x38\xdf\xee\x03\xcc\x0d\x48\x42\x67\x17\xf9\x14\x7d\x8d\xc8\x7d\x1e\x43\x1e\x32\xdd\x7b\xe9\xec\x03\xcd\xca\x25\xaa\xc6\x60\x59\x58\x56\xe6\x0c\x96\x77\x03\xac\x65\xca\x4b\x6f\xb0\x92\xc3\x56\xa8\xd3\x47\x74\xde\x1e\xde\x1e\xcf\x51\xe8\xe5\x1e\xf2\xe6\xf7\x84\x7f\xed\xdd\x8b\x76\xa8\xd6\xa2\x31\xa4\x6e\xe5\xe9\x0f\x86\xae\x1e\x20\x01\xd5\x1e\x20\xdc\xbf\xa0\xad\xad\x2e\x82\xa0\xff\xb2\xce\x31\x97\xc0\xaa\x81\xa5\x4c\x0a\x0f\x00\x70\x39\x12\x20\xe1\x3c\xbc\xd3\xf1\xfe\x36\x81\xf2\xb7\xed\xd4\x21\x4e\x25\x84\xb7\x83\x87\xfc\xd9\xa7\xa4\x73\xeb\xea\x7a\x3e\x1d\x95\x33\x03\xe6\xbb\x8a\x6e\x65\xe8\xae\x39\xe0\xef\x22\xd1\x59\xcf\xd2\x8f\x6e\x88\x5f\x69\xb9\x4b\x64\xe3\xb6\x35\x51\x0f\x1d\x1f\x34\x10\x80\x8b\x69\x61\x90\x5e\x81\xa7\x25\xe1\x88\x22\xa5\x99\x61\x65\x01\x28\x0a\x92\x74\x76\x97\x95\x8c\x75\xb5\x1b\xf0\x2e\xab\x35\xfc\xa8\xae\xd6\x50\x4d\x5a\xad\x9b\xd8\xc1\x0d\xe7\x2c\xa8\x0e\x79\x0a\x4f\x75\xee\x41\xe5\xaf\x40\xc9\xbf\xe2\x5d\x32\x8c\xad\x1c\x3d\x39\xa2\xd5\x81\xb5\x3c\x63\x23\x89\x19\xae\x97\x29\x83\x39\xb9\xcf\x2d\x81\xbf\xb2\x33\x37\x6d\x23\xd3\x57\xb1\xcb\x24\xdc\xa8\xda\x83\x9b\x42\xfd\x4b\xb4\x6f\xe3\x77\xa2\x4e\x96\x54\x4f\x80\xd2\x1f\x74\xb8\xf2\xd3\xdd\x62\xf0\x05\x91\xf7\x65\x90\x7d\x1d\x33\x6f\xa9\xc7\xcc\x6f\x7f\xfd\x37\x34\xcd\xf3\xab\xf9\xd3\xed\xed\xcb\x7c\x3a\xdf\xf2\xe9\xf6\x75\x1e\x91\x9f\xe7\xe8\x83\xbe\x85\xb7\x74\xe4\xdf\xa2\xff\xb9\xf4\xf2\x69\xec\xcd\x5b\x3a\xb0\x1c\x6d\xdf\x11\xa9\xbd\xbd\x8d\xf6\x69\xce\x6f\x5e\xa2\x94\x49\x43\xec\xf9\x09\x9d\xa3\x7f\x16\xa4\xfc\xb3\x5d\x1e\x6e\x13\xc8\x28\x9d\x54\xc1\xf3\x2b\x41\xfa\xe8\x31\x97\xb9\xc7\xe8\xd1\xa3\xf2\xe7\x15\xc1\xed\xc1\x8b\xfe\xc9\x39\xd1\x83\xf5\x05\xbc\xac\x91\x5e\x16\xdf\xdb\x38\x8b\x5f\x1f\x3d\x5a\xed\xe6\xe5\x43\x00\x3b\xad\xc6\x54\x75\xe5\xc9\xbd\x80\x40\xfb\x7f\x8e\xf9\xa1\xff\x97\x69\x48\xb7\x7e\x9e\xa3\x34\x43\xcf\x79\xe4\x7d\x1c\xc5\x34\x44\x41\x1a\xd2\xf1\x0a\x44\x6f\x16\xa2\xeb\x39\x45\x71\xce\x9c\xaa\x7f\xb2\x1e\xea\x69\x77\x11\xce\x5f\xb5\xfb\xa2\xf8\xde\x6e\x37\xff\x75\x49\x8e\x99\xa7\x5d\x83\xd8\xaa\x6a\xee\x34\x01\xff\xfe\x7b\xe3\xdb\xd6\x4d\x3c\x0b\xd3\x9b\xa5\x32\x9d\xaa\xcc\x48\x46\xcd\x52\xab\xe7\x07\x96\x5a\xfd\xf5\x93\x7b\xfb\x30\x49\x5f\x65\xd7\x3c\xcf\xe2\xd9\xc5\x41\x96\x5e\xee\x4d\xbd\x6c\x2f\x0d\x99\xb8\x9c\xc1\x8f\x5b\x51\xe3\xd7\xae\x2e\x3e\xf7\x7e\xa1\x33\xde\x93\xcb\x0a\x77\x75\x3d\xbb\x65\xbd\xd8\xae\x53\xa9\xd7\x75\x30\xd7\x43\xca\x0a\x8c\x38\x01\x9d\xec\x82\x30\x32\x38\xee\xdf\xe7\x6f\xc1\xd8\x93\x5e\xcf\x72\x9a\xf5\x6c\xe1\xc1\xc2\x64\x69\xaa\x39\xae\x7e\x5b\xcd\x0a\xc3\xc5\x5f\x3d\xef\xe8\x22\xcf\xbc\x8e\x77\x37\xd3\x38\xa1\x68\x54\x52\xf2\xac\xc0\x38\x70\x58\x3c\xb9\xa6\x35\x3d\x41\xc1\xe3\xdd\xbc\x04\xb1\xb9\xd9\x67\xcf\x99\x7c\xf2\xea\xdf\xee\x20\x6d\xb1\x4f\x34\x8d\xc9\x32\xff\xe9\x19\xfc\xf4\xfc\xe0\x80\xfd\x24\x4d\x0d\xe2\xbd\x09\x8b\xa3\xf3\xeb\x2c\x4b\x2f\xbc\x9c\x8e\x41\xdd\xf2\x29\xcd\x28\xdc\xb9\x86\x66\x74\x91\x23\x46\xa9\x17\xe4\x34\xeb\x87\x04\x3c\xba\x5b\xdb\xca\xf6\x8d\x38\x8c\x47\x48\x5b\x1c\xec\x69\xda\x06\x53\x5c\x6d\xb1\x0f\x8f\xbf\x31\x52\x93\xf4\xa6\xa6\x74\x78\xc4\xe4\xf2\xc3\xbd\xd9\x51\xc1\x39\x06\xd8\x38\x38\xd8\x80\x4b\xd4\xb4\x0d\xb4\x89\x1a\x18\xe1\xc5\x66\x99\x0f\x61\x70\x58\x95\x9a\x27\x6f\x6f\xa3\xeb\xd9\xa5\x97\x07\x53\x1a\xd6\x44\x7f\x83\xd2\x59\x72\x8b\xbc\xab\x2b\x0a\x6c\x8e\xe7\x60\xf4\xd0\xf5\x2c\xce\xc7\x28\x9e\xa1\xc0\x9b\x53\x58\x98\x63\x7c\x17\x62\xa8\xea\xb2\xbe\xca\xcb\x33\x1d\x15\x36\xe6\xf4\x7a\x8d\xaf\x57\x5e\x3c\xd0\x83\xcb\x6c\x03\xa6\x89\xe6\x0d\x45\xe7\x3e\x79\x72\x87\x79\xbf\x90\x8f\xd2\xd4\x74\x79\x2a\x1d\x66\x98\x3b\x25\x1c\xaa\xd0\x04\xaf\x6b\xe7\xe8\x0c\xec\x1c\xf8\xb5\xbd\x66\xae\x32\x51\x7c\x46\x32\x68\xa1\xe2\x59\x48\x17\x68\x07\x3d\xe9\xba\x0d\x55\x64\xc2\x2a\x7b\xfa\xf8\x71\xbf\x21\xdb\xdc\xe4\x38\xa4\x0d\x19\x50\xfd\x16\x2a\xf5\x4d\x4d\x1b\x86\x8b\xe9\xf5\x01\x53\xb3\xc1\x59\x05\x2b\xfa\x64\xa7\x54\xc4\x01\xb1\x2a\x9a\xb4\xb9\xd3\x31\x98\x95\x28\xbf\xfd\x16\x61\xad\xd4\x6f\xf4\x7b\x61\x39\x87\x84\xba\x6c\x1a\x37\x3c\xe8\x77\xd4\xb2\x1d\xf2\xf2\x27\x47\x61\x17\x25\xeb\x4a\x6e\x25\x85\xc1\x94\x06\xbf\x9c\x05\x5e\xe2\x65\xff\x60\xc8\x46\x4c\x20\x5f\xa5\xf1\xac\xfb\x70\x39\xeb\xa9\xaa\x44\x7b\x98\xa9\x7f\xe6\x43\xcd\x50\x2f\xe6\xd3\x2c\xbd\x41\x93\x2c\x4b\xb3\x51\x3f\x9b\x1f\x1f\xb3\x29\x58\x6d\x9a\x5e\x6f\x3e\x46\x9b\x35\x9e\xad\x3c\xe5\xce\xc8\x08\xdb\x1b\x5b\x79\xfa\xfa\xea\x8a\x66\x7b\xde\x9c\x8e\x36\xd0\xe6\x00\x54\x66\x07\x67\x69\xce\xac\x1e\xb4\x9b\xf7\xde\xe3\xee\x1a\x12\xcc\xff\x83\x3d\xb3\xba\xeb\x60\xf1\xe2\xf9\x6d\xde\xe8\xb4\x31\x0f\xf4\xea\xf6\x30\xb9\x80\x74\x88\xd9\xa8\xd9\xa7\xdf\x96\x20\xb8\x38\xc3\x5e\xe8\x82\xac\xe8\x43\xbf\x48\x71\xa3\xb6\x57\x42\x94\x90\xa8\x06\xfa\x47\x85\x01\x38\x38\x20\xc5\xe0\x5e\x8c\xeb\xf8\x89\x7f\x9b\x53\x34\xa7\xff\xba\xa6\xb3\xa0\x67\x64\xef\x6f\x62\x4d\x84\x9c\x36\x81\xff\x7b\x7b\xe9\xa7\x49\x9f\x3d\xec\x23\x9c\x68\x6d\xc2\x75\x19\xc2\x2b\x54\xe2\xde\xb1\x79\xcf\xe0\xa2\x67\xf6\x3a\x2d\x55\xe3\x74\x75\x07\x8d\xe0\xbd\x34\x69\x34\x64\x68\x1c\x30\x15\x3d\x26\x4f\xa1\x55\x58\xe7\xcd\xd2\x8a\x66\x4d\x7a\x0d\x70\x01\x74\x73\xa7\x47\x01\x3a\x2f\x17\x1e\xe2\xc7\x44\x5b\xe1\x87\x79\xcf\x7d\x86\x49\xd1\x3a\x87\xb7\xee\xe0\xce\xad\xc3\xbd\x21\x8b\x77\xe3\xcb\xea\x4f\x35\x9c\xae\x76\xb5\x99\xd7\x6f\x1e\x50\x43\x1b\x01\xa0\xb4\xf9\xb8\xce\x23\x52\xf8\x45\x82\xf9\x5f\x45\xcb\x1c\xed\x74\x4c\x1b\x45\x33\xbe\xba\xfa\x9a\x3e\x55\x05\xa8\xe7\x3d\x93\x25\x3e\x52\xdd\x97\x6f\x55\xf7\x42\xb3\x19\xc3\x4e\x56\x83\x8a\xcd\x9d\x01\x23\xad\xe4\x6d\xd4\x40\x3f\xed\x72\x45\x25\x3d\x19\xf5\xc2\xbd\x74\x96\xc7\xb3\x6b\xb8\x71\x11\xb4\xa0\x77\xe0\x61\xe4\x1f\x01\xcb\xbf\xdd\x81\xb6\xec\xb1\xa9\x8a\x94\xdb\xf2\xf8\x68\xf6\xc1\x4b\xe2\x10\xaa\x71\x19\x79\xdc\xc3\xbb\x3e\xa9\x69\x53\x89\x38\x05\xb0\x3a\xff\xb6\x22\xec\x5d\x61\xa4\x3a\x20\x57\x65\x36\x37\x3b\x17\xb3\x0b\x63\xb7\x84\xe5\x11\x1f\x33\xf8\xbc\x99\x8d\xb1\x3d\x6d\x2d\xd7\x7e\xbb\xea\x1b\x5d\xe4\x74\xdf\x1f\xb7\x8d\x8e\x22\x74\x43\x11\x9b\xca\x5e\x5f\xa1\x29\xcd\xe8\x18\xc5\xf9\xff\xf7\xff\xfc\xbf\xa5\x13\xd6\xc4\x00\x6d\x5a\x85\xd2\xc9\xf7\x95\x7a\x2b\xec\xef\xb7\x31\xdc\x50\x9c\x81\x61\xea\x96\x8e\x52\x6f\xfb\x74\x9e\xbd\xd3\x07\xde\x19\x03\xef\x4c\x09\x3b\x22\x94\xd7\x3f\x52\x5c\xdb\xa8\x77\x64\x54\xa5\x10\x9f\xc8\x4b\x3a\xef\x07\xe9\x13\x96\x53\xea\x85\x28\x8a\xb3\x79\xde\x23\x0a\xd0\x27\xf7\xae\x2a\xab\xfe\x25\x1a\xcd\xd2\x55\x11\x9b\x77\x44\x8e\x80\x9a\x71\xb2\x1e\x15\x2a\x55\x78\x12\x83\x9c\xe9\x13\xae\x3e\xce\x08\xdd\xc8\x25\x3a\x26\xa5\x8e\xef\xf5\x93\x02\x42\x8c\x76\x7a\x8c\x66\xcf\x38\xd2\x1c\x7b\x9a\x08\x31\x5f\x34\x83\x44\x11\x7d\xda\x81\xba\xa7\x90\x03\x66\x08\x35\x4d\x51\xff\x30\x8b\xa4\xd6\x8a\xee\x68\x47\x2a\x04\xf2\xbd\x65\x2c\x4b\xd3\xa5\x77\x8b\xe2\x59\x90\x5c\xc3\x62\xdc\x95\x17\x67\xcd\x25\x3f\x09\xb9\x3a\x28\xfb\x73\x72\xcf\xfd\x09\xd6\xea\xbe\x84\x40\x2b\x56\x4e\x75\x38\x01\xc4\xe9\x69\x0a\x45\x97\x59\x44\xdd\x42\xa1\xc1\x44\x6a\xa0\x37\xd5\xe7\x23\xe8\xcf\x29\x4c\xc2\xd9\xc8\xb2\xa8\x90\x42\x54\x0e\x3e\x07\x51\x81\x11\xef\xde\xe4\xcb\x01\xf9\xd2\x16\xcb\x12\xa6\x2d\xb4\xbd\x0d\xf4\x7b\x7f\x77\x8d\x38\xf1\xbc\x68\x25\x8f\x5d\x43\x31\xea\x91\x47\x58\x6b\xec\x58\xe9\xc2\x9a\x70\xc5\x52\x56\xe8\x24\x65\xa2\x53\xf4\x5e\x9f\x1f\x3c\x21\x28\x84\xad\x55\x1a\x8a\x9d\xa1\xd2\x1b\x29\x6f\xe3\xea\x7a\x07\x63\x7c\xcf\x3b\x18\x4f\x57\x46\xd3\xd6\x34\xae\x98\x8f\xd5\x93\x84\x4e\x26\x55\x44\xb4\x67\x71\x8d\x5a\x3d\x23\x3a\x50\xd7\xf4\x05\xfa\xe7\x71\x0d\xef\xa5\x77\x2b\xb0\x35\x9d\xec\xdd\x52\xcc\x2f\xaf\xfa\x27\x70\xa3\xfc\xf2\x0a\xed\x2c\xb9\x97\x1b\xe8\x6f\x3b\x3b\xdc\x13\x12\x4f\xe8\x8a\xe0\xaa\xfc\xf2\x4a\x6d\x22\xd6\xd8\x4d\xa8\x61\x09\x65\xe0\xe1\xf7\x90\x99\x18\xa0\x9d\xae\x66\x3f\xfe\x40\xb3\x79\x9c\xce\x1e\x3f\x45\x8f\x21\xf6\xe2\x71\x47\x9c\xef\x63\xde\xa6\xc7\x4f\x1b\xcb\x02\x5d\xc5\x38\xc7\x8b\x62\xfc\xcb\x52\xd3\xbb\x36\xa9\xcf\xd2\x4b\x8a\x76\x5f\xec\x23\xff\x3a\x4e\x42\x94\x5e\xe5\xf1\x65\xfc\x2b\xcd\xe6\x63\x94\xc4\xbf\x50\x94\x6d\xfd\x3c\x1f\xf3\x71\x06\x62\x74\xe6\x57\x34\x88\xa3\x38\x60\x66\x3e\x8c\x41\xcc\xaf\xbc\x3c\xa7\xd9\x6c\xbe\x02\x1b\x00\xe4\x53\x8a\xa2\x34\x49\xd2\x9b\x78\x76\xf1\xb4\x5d\x86\x99\x98\x0e\xd5\x5e\xba\x0b\x11\x3d\x2e\x35\xea\x71\x67\x14\x46\xab\xfc\x96\x77\x19\x2e\x45\x32\xac\xd6\xa8\x8b\xb6\xdf\x75\xca\x66\x71\x87\xa2\x44\xb8\x57\x29\x87\x79\xd4\x15\xde\xbb\x1a\x13\x54\x2f\xc8\x2d\x85\x8d\xfc\xad\xf1\x7d\x6b\x96\x86\xf4\xfc\xf6\xaa\x5b\x71\xca\xca\x3c\x94\xa5\x58\xba\x8b\x67\xcd\xd0\x91\xd3\x78\x76\x91\xfe\xef\x19\xfa\xa0\x6d\x91\x2d\xad\x67\x57\xa0\x86\xd1\xb8\xd2\xb2\xaf\x21\x85\x5f\x50\xa2\xf2\xb2\x9b\xa9\x97\x2c\xa1\x72\xb6\xb4\x27\xdd\xa8\x60\x9b\x8d\x1f\x0d\x2b\x2e\x23\xec\x2b\x35\xf5\xe6\x27\x37\xb3\x57\xe5\xa9\x9a\x9d\xa2\xda\x56\xfb\xf7\x1e\x00\x55\x40\x19\xa4\x45\xe3\xbd\x32\x38\x40\xb5\xa1\xf2\x00\x3d\x56\x09\xae\x6c\xdd\x60\xdd\xd2\xec\xa6\xb7\xbf\xf0\x94\x81\xac\x04\x3c\xdf\x7d\xcf\x75\x89\x9f\xa7\xd3\x78\x96\x32\x6e\x7a\xe8\x86\xfa\xa8\xb8\x73\xb3\xc3\xea\xa5\x69\xbe\x55\xd8\x96\x8e\x9e\x6a\x19\x3a\x7e\xdd\xe6\x46\x1d\xf1\xf7\x71\x8c\x7e\xab\xc3\xc8\x6c\xf5\x30\xb2\x95\xfb\x4f\x7f\x78\x71\x7c\x98\xe7\x57\xa7\xcc\x43\x9b\xe7\xdd\x88\xbe\xf2\xe3\x0b\x7e\xd8\x66\xeb\xe7\xf9\x57\xea\x48\x1f\x5f\xcf\x29\x2c\xc9\x06\xf9\xe3\x96\x31\x5b\xa1\xe6\x79\x7c\xf1\x12\x10\x7d\xc3\xb8\xfa\xf3\x7c\xca\x5c\x95\xf8\x62\x96\x66\xf4\x69\x12\xcf\x68\x1d\x8a\xd7\x26\xf0\x86\xfa\xc6\x1d\x08\x63\x72\xf6\x86\xfa\xdc\x31\xe4\xb7\xbe\x3e\xde\xda\x4e\x62\x7f\x9b\x01\x7c\xcc\xf9\xbe\xbd\x8d\xc2\x74\x96\xa3\xf4\x03\xcd\xb2\x38\xa4\x65\xdc\x55\x19\xfe\x55\x83\x6b\xdc\x08\x5b\x44\x4f\xb1\xe1\xf3\x71\x75\xac\x01\xe2\xb3\x5a\x05\xb6\x38\xf6\x76\xa9\x15\x71\x6f\x95\x05\x82\x1b\x42\xf3\x71\x90\xa1\x45\xe1\x9a\x67\x5f\xd5\xed\xfb\xea\x29\xd2\xf5\x8f\xef\xfe\xeb\xff\x00\x2f\xc7\xe8\x2d\x67\xe3\xbb\x0d\xd6\xe6\xff\x0b\xcd\xd3\xeb\x2c\xa0\x2f\xbc\xab\xab\x78\x76\xf1\xfa\xf4\x78\x87\xbd\x7d\x92\xc0\x99\xe9\x9f\xe7\x5b\x97\xde\xd5\x7f\xfd\x9f\xff\x3f\x00\x00\xff\xff\xe0\x84\x9e\x85\x62\x22\x08\x00")
+var _web3Js = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\x03\xec\xbd\x7d\x77\xdb\x36\xf2\x28\xfc\xff\x3d\xe7\x7e\x07\xc6\xf7\xde\x95\xdc\x28\xf2\x6b\xd2\x44\x6d\x9a\x75\x9c\xa4\xf5\x6e\x12\xe7\x38\x4e\xbb\xfb\xf3\x7a\x73\x68\x89\xb2\xd8\xc8\xa4\x1e\x91\x8a\xe3\x36\xfe\xee\x0f\x06\x6f\xc4\xcb\x00\x04\x25\xd9\x49\x5b\xe9\xec\x36\x26\x39\x00\x06\x83\xc1\x60\x30\x18\xcc\x4c\x93\xff\x6f\x96\x4e\x93\xe8\x71\xd4\x1e\xce\xb2\x7e\x99\xe6\x59\x94\xb4\xcb\x4e\x94\x75\xa2\xe9\x7a\xf4\xfb\xff\xfe\x5f\x11\xf9\xc9\x4f\x45\x3b\xef\x44\x33\xf9\x1e\x7e\xe9\x30\x6a\xdf\xc9\x4e\xf2\x53\xed\xad\xfc\x52\x62\x5f\xe0\xf7\x31\x9e\x46\x31\x69\xb7\xbc\x9a\x24\xf9\x30\x9a\x0a\x44\x1e\x47\x6b\xa2\xb9\xb5\xe8\x6f\x7f\x13\x1f\xbe\xb3\x6b\xa0\xf5\xcf\x00\x26\x5e\x27\x60\xe5\x6c\x9a\x45\x31\x20\x78\x67\x73\xdd\x01\x9e\x4a\xc0\xd4\x0d\x08\x98\x0d\x09\x66\x59\x72\x19\x3d\x9f\x4e\xf3\x69\x7b\x6d\x3f\xce\xb2\xbc\x8c\x86\x69\x36\x88\x2e\xf2\xc1\x6c\x9c\x44\xad\xb5\xe8\x6e\x94\x93\xff\xaf\xb5\xd6\xb0\x5a\xca\xd1\x34\xbf\x8c\x86\xdd\x7e\x3e\x00\xf2\xae\xbd\x3a\x7c\xf6\xee\xe5\xf3\xf7\xaf\x0f\x8f\xdf\xbf\x38\x7c\xf7\xfa\xd9\x5a\x27\x1a\xea\xa5\xae\xf5\x47\x40\x63\x0c\x68\x10\x0a\x92\x7f\x7e\x4f\x3e\x4d\xf2\x69\x59\xf4\xa2\xdf\xaf\xaf\x8d\xf6\x80\xc8\x27\x9b\xa7\xdd\x7e\x3c\x1e\xb7\xc7\x5d\x0e\xd9\xa9\xc6\xad\x9d\x38\xc7\x20\x83\x31\x80\xf2\x5b\xa7\x27\xc9\x29\xd2\x11\x4e\xb0\xa2\x9d\x45\x4f\x08\x74\x2f\x4a\xd6\x0d\xbc\x3b\xd1\x98\xfc\xaf\x6a\x36\xe9\x44\x82\x83\x2a\x48\xa5\x77\xbc\x46\xe8\x98\x28\xc4\x3e\x12\x18\xf6\x07\x20\x96\x36\x66\x8e\x61\x3e\x8d\xda\x50\x34\x27\x45\x37\xbf\x23\xff\x7c\x1f\x4d\xbb\xe3\x24\x3b\x2f\x47\xe4\xe9\xee\xdd\x75\xd2\x8b\x29\x30\x24\x2f\x20\xba\xf6\xbf\xff\xd7\xf5\x7a\x9b\xd3\x67\xab\x17\x9d\x54\x74\xe3\x0d\x74\xf8\xa8\x93\xbe\x31\x7c\x35\x72\xb2\x6f\xa2\x2b\xa4\xed\x13\x9d\x40\x08\xe5\xd7\xfa\x79\x56\x94\x71\x56\xae\xf5\xa2\x72\x3a\x4b\x3a\x08\x48\x9a\x4d\x66\x65\x41\x00\x4e\xec\x8f\x8e\x6a\x65\xd9\x2c\xbe\x48\x48\xc9\xb5\xf7\xf9\x65\x96\x4c\xd7\x90\xea\x25\x28\xd0\x18\x40\xe3\xc1\x60\x9a\x14\xc5\x1a\x0e\x7a\x6d\xbf\x3e\xc5\x90\x16\x0d\xd3\x7f\x31\x80\x7c\x56\x2e\xde\xad\xfc\xbd\xab\x7e\xab\x5b\x67\x57\x65\x52\xec\x6c\x2f\xd8\x2d\x51\x9b\xe4\x3e\x73\x0a\x7c\x3d\x23\x7e\xcb\x94\x91\x43\xe2\x62\xb4\xe5\x8c\xf8\x2d\xb2\xf0\x6a\xac\xa3\x9a\xb1\x26\xdd\x2b\x13\xd2\xbb\x2f\x3b\xda\xab\x99\xed\xea\xcb\x72\x47\x1b\x66\xd5\x6a\x62\xb3\x2a\x94\xa1\x1e\xc6\xe3\xe2\x4f\x37\xd6\x84\xca\xc9\xf4\x63\xed\xca\xbd\x9a\x49\xac\x4b\x0d\xa9\x5b\xcc\xce\x8e\x92\xf3\xb4\x28\xa7\xf1\x6a\x46\xf1\x2a\xfe\x70\x33\xca\x51\x45\x18\x0e\xc9\xe5\xe1\x17\x52\xc8\x09\xcf\x65\xc5\xb0\x5e\x45\x5b\x8d\x33\xde\xa7\x46\x38\x4c\x7d\xb3\xdc\x42\x64\xb9\x03\x5d\x24\xe5\xdb\x06\x72\xe6\xeb\x18\x6f\x6f\x8f\xfe\x60\x7d\xf9\x83\xf3\x6e\xbc\x0c\x9e\x5d\x04\x81\xc9\x34\xbd\x88\xa7\x57\x61\x74\xc8\xf3\xf1\xf2\xe6\xcd\x1e\xef\xd4\x8a\xcd\x6e\x9e\xcd\x3c\x7b\xc8\xa6\x68\x34\x1f\xe8\xfd\xb0\x0d\xec\x6a\xa0\x79\x9f\x1a\x52\x78\x90\x16\xf9\x65\xf6\xa5\xa8\x1b\x67\x79\x76\x75\x91\xcf\x8a\x9b\x22\x6f\x9a\x0d\x92\x4f\xc9\xc0\xbd\x49\x91\x90\x5f\xc3\x8c\xab\xb0\x75\x11\xc3\x46\xf7\x32\xcd\xbe\x8c\x96\xba\x37\xa3\xa3\xfe\x9c\xe0\x3c\x40\xf9\x47\x34\x9b\x7c\x84\xf9\xbb\x62\x0e\xe5\x77\x5b\xcc\x71\x96\x0e\x06\xcb\x61\x8e\x5b\x42\xf8\x63\x3c\x9e\x85\x11\x78\x96\x66\xe5\xf6\xfd\x07\x4b\x62\xe6\xd7\xc9\xe5\xd3\xf4\x2f\xc3\xc6\x5f\x68\xad\xd9\x1f\xc5\xd9\xf9\x5f\x47\x58\x7c\x15\xb2\x22\x14\x59\x97\xe5\xd8\x42\x76\xb9\x8b\xc8\x1b\xb6\x81\x98\x9b\x33\xaa\xc7\x53\x71\x20\x7d\xdd\x89\x7e\xbf\x16\x8d\x6e\xff\xb9\x8e\x88\x57\x07\x86\x2b\x2b\xa8\xdd\x99\x1b\xb5\x8e\x11\x4a\xa1\xf3\xd2\xc2\x62\xb9\x82\xe1\xcb\xee\x4b\xfe\xe4\xf3\x79\x75\x4c\x58\x55\xf1\x87\x9b\xce\x0d\xc7\xfa\xeb\x39\x26\xfc\x63\x90\x77\x75\x66\xb4\x1a\xe7\x3a\x1c\x96\x62\x77\x6f\x38\xc0\xdc\xe6\xbd\xb2\xd4\xfd\x61\x77\xb1\x75\x7b\x95\x9d\x5b\xdf\xab\xdc\xe8\x4c\x1d\x4e\xf3\x8b\x2f\x3c\x51\xcb\xfc\x0b\x1f\x90\x7d\x29\x73\xd6\x5f\x65\x35\x58\xf1\x18\x15\x9c\x44\x4e\xf4\xcb\x83\xb0\x6d\xda\xcd\x51\xe2\x4b\x31\x7b\xda\x8f\x27\xc7\x7f\x11\x86\x0f\xe4\xb6\x25\x9f\x11\x26\x93\xbc\x48\xe7\x3b\x82\x9d\xc4\x57\xf1\xd9\x38\xf1\x6c\xa4\xff\x9c\xca\x49\xb0\x64\xfa\x2a\xce\x5d\xbe\xd4\xd4\xdd\x13\x03\xf7\xcc\xc3\x62\x7f\xc2\xa3\x81\xaf\x82\x3b\x42\x91\x5d\x8e\xcc\xf9\x73\x33\xf2\x8a\x7f\x75\xc0\xaf\xe9\x68\xeb\x8b\x69\x68\x7f\x20\xfe\xf5\xea\x4f\x2b\x06\x5e\x31\x70\x28\x03\x7f\xf1\xdd\xc8\x1f\x68\xd2\x1d\xd4\x6d\x5c\x16\x32\x27\xed\x36\x37\x27\x89\xeb\xf4\x1c\xb8\xdd\xea\x6e\x0c\xf3\xe9\x45\x5c\x96\xc9\xb4\x68\xa9\x57\xe7\x01\xf2\x6d\x3e\x4e\x07\x69\x79\x75\x4c\xb0\xd4\x0b\x01\xde\x14\xbc\x2a\xb0\xf1\xcd\x37\x0a\xfe\xdf\x68\x85\xb9\x2f\x6f\x94\x16\x51\x1c\x4d\xa6\x79\x0e\xe5\xa3\x72\x14\x97\xa4\xd2\x09\x1c\x60\x64\x25\xf9\xc4\xa1\xe0\xa3\x56\xd5\x41\x19\x11\x14\xfb\xa3\xa4\xe8\x69\xef\x79\x01\xec\xdd\xc9\x29\xfa\x76\x17\x7f\x7d\xea\x00\xdf\x71\x7d\x38\x3d\x79\x40\x3e\x75\xa2\x6e\xb7\xab\x7e\xdf\x70\xd3\x4f\x90\xe0\xb1\x12\x08\xc0\x8a\x03\x50\x8e\xd2\xa2\xfb\x9e\x0a\xca\x17\x62\x58\xa0\x44\x97\x0d\xd2\x01\x7c\x38\xc8\xca\xef\xb0\x52\x6c\x9f\x86\x15\x3b\xa4\x5f\x38\x02\x4a\xd9\x6b\x6d\xfc\x10\x64\xbb\x64\xa8\x4a\x36\x56\x2c\x02\x83\x0a\xd3\xfe\xfd\x5a\xe5\x17\x6f\xf1\x2e\xdd\x3d\x4f\x67\xfd\x32\x07\xc4\x10\xd8\x70\x54\xba\x69\xc1\xf9\xb1\x22\x24\xcc\x37\x8b\x98\x3c\xa0\xc0\x9d\x3b\xf0\xb5\x4b\x19\xa8\xbd\xc1\x07\xb0\xfd\x9f\x93\xf6\xc9\xe6\xbd\x47\xa7\xdf\xac\xff\xe7\x74\xfd\xc9\xc6\xba\x93\x2c\x96\x01\xd6\x87\x3c\x4c\xcf\x35\x75\x4a\x91\xb9\xfd\xa8\x13\xad\xb1\x09\x43\x1e\xb6\x76\xe5\xec\xbd\xff\x87\x99\xbd\x4f\xf3\x7c\x5c\x33\x75\xc1\xf5\x3f\x7c\xde\x02\xb4\xf5\xc2\x98\x69\xf4\xd5\x2e\xf2\xce\x9c\x92\xf4\xa5\x35\x51\x39\x68\xd3\x59\x4a\xbb\xba\xf0\x14\x85\x5a\xe6\x9a\xa3\x46\x41\xf7\x04\x05\xc0\x79\x67\xa7\x5e\xd6\x33\x35\x39\x36\x41\xb5\x2c\x34\x29\xff\x0b\xa3\xa5\x4f\xc9\x6f\xfe\xef\xbc\x73\x52\xc3\xba\xc9\x84\x7c\xf0\xc7\x99\x90\xa0\x53\xc9\x19\x59\xe2\x53\xb2\x1c\x25\x11\x55\xbe\xe8\xbc\xec\x86\x4d\x4c\x80\xb7\xdf\x98\x73\x8b\xbe\x33\xe7\x26\x03\x44\x41\xed\xd9\xc9\x81\xd1\xe9\x19\x71\x95\xd1\x7e\xf7\x10\x6f\xd4\xac\xde\x37\xbf\x29\x41\x16\x9f\xe0\x50\xcd\x7c\x33\xdc\x28\xe9\x99\xe2\x00\x39\xf7\x1c\xd7\x0b\xfb\x26\x39\x47\x28\xac\x9e\x05\xa7\x39\xd4\xc6\x26\xf9\xef\x5b\x9d\xeb\xf5\xa5\x4d\x79\xad\x0f\x4d\xe6\xfc\xb7\xcd\xe7\xfc\x06\x9f\x97\xc7\x64\xbc\xa3\x61\x3a\x4e\x60\x22\x4e\xe2\x69\x19\xe5\xc3\xe8\x32\x39\xdb\xe9\xfe\x5a\x74\x05\x2e\xfc\x19\x40\x86\xd3\x24\x89\x8a\x7c\x58\x5e\xc6\xd3\xa4\x17\x5d\xe5\xb3\xa8\x1f\x67\x84\x52\x03\xb8\x4f\x99\x9e\xcd\x4a\x52\x53\x19\xc5\xd9\x60\x83\x0c\x12\xc1\x20\x1d\x5e\xb1\x4a\xc8\xdb\x19\xd9\xf3\x4c\xe9\x94\x26\x1d\xba\x28\xa0\x29\x78\xf8\xf1\xf5\xbb\xe8\x25\x51\x40\xc8\xb7\x1f\x93\x2c\x99\xc6\xe3\xe8\xcd\xec\x6c\x9c\xf6\xa3\x97\x69\x3f\xc9\x8a\x24\x8a\x09\x6e\xf0\xa6\x18\x25\x03\x32\x5d\x58\x85\x50\xf4\x05\xa0\xf3\x96\xa3\x13\xbd\xc8\x49\x0b\x31\x50\x81\x74\x3a\x25\xdf\xa7\xd1\x47\x42\x39\xa0\xca\x8e\x68\x8c\x57\xd9\x89\xf2\x29\xab\xa6\x4d\x04\x0e\xe9\xc6\x34\xca\x27\x50\x72\x9d\xe0\x7e\x15\x8d\xe3\xb2\x2a\x8c\xd1\xa1\xea\xee\x20\x4a\x33\x5a\xf3\x28\x17\xf2\x8b\x74\xf5\x32\x1d\x8f\xa3\xb3\x24\x9a\x15\xc9\x70\x36\xe6\xe3\x44\xc0\xa3\x5f\x0e\x8e\x7f\x3a\x7c\x77\x1c\xed\xbd\xfe\x77\xf4\xcb\xde\xd1\xd1\xde\xeb\xe3\x7f\x7f\x47\xc0\xcb\x11\x99\x74\x11\xec\xa0\x68\x65\xe9\xc5\x64\x9c\x92\xba\x49\xbf\xc8\xce\xab\xbc\x22\xe8\xb3\x3a\x5e\x3d\x3f\xda\xff\x89\x14\xda\x7b\x7a\xf0\xf2\xe0\xf8\xdf\xa4\x1b\xd1\x8b\x83\xe3\xd7\xcf\xdf\xbe\x8d\x5e\x1c\x1e\x45\x7b\xd1\x9b\xbd\xa3\xe3\x83\xfd\x77\x2f\xf7\x8e\xa2\x37\xef\x8e\xde\x1c\xbe\x7d\xde\x25\xb3\x21\x01\xd4\xb8\x5e\x53\x4f\xef\x21\x1d\x3b\x42\xd1\x41\x52\xc6\xe9\xb8\xe2\x84\x7f\x93\xf1\x2e\x08\xa6\xe3\x41\x34\x8a\x3f\x26\x64\xdc\xfb\x49\xfa\x91\xe0\x19\x47\xfd\x7c\x72\x15\x3c\xa2\xac\xb6\x78\x9c\x67\xe7\xb4\xef\x92\xe5\xa2\xe8\x60\x18\x65\x79\xd9\x89\x0a\x82\xf2\xf7\xa3\xb2\x9c\xf4\x36\x36\x2e\x2f\x2f\xbb\xe7\xd9\xac\x9b\x4f\xcf\x37\xc6\xac\x86\x62\xe3\x07\x82\x95\x2a\x25\xcd\xb5\xe6\xef\x94\xad\x21\x58\xda\x94\xd4\xac\x7f\x8a\x67\x84\xde\xd3\xe8\x15\xe1\x9a\x0f\xd1\x3f\xf3\x32\xb9\x4c\xfb\xbf\x45\xdf\x5f\xc0\xf3\xdf\x93\x72\x34\x48\x3e\x12\x69\x73\xf1\x83\x5e\x8a\xf0\x56\x12\x6d\x6f\x6e\xdd\x37\xe4\x74\x93\xa5\x34\x64\x6f\xa5\x94\xe6\x9a\xbe\x77\x15\xe6\xea\x9e\x52\x0a\x34\x11\x6f\x11\xb2\xfd\xd2\x4b\xa4\x59\xe9\x2d\xf0\xce\x2a\x31\xab\x2b\xf2\xec\x8a\x88\xcd\xb4\x2f\x16\x2b\xa5\xe8\x80\x7d\xa1\x82\xd4\x5b\xc5\x5b\x32\xc7\x08\x87\x68\x85\x0b\xfa\xce\x5b\xec\x28\x89\x0d\x82\x4c\xc9\x1b\x7f\xff\xec\x32\xb3\xda\x42\x48\xd7\xaa\x3e\xe9\xc5\xd2\x82\x93\x43\x5b\x75\x0a\xa5\xb2\x0e\xd5\x70\x5c\x6b\x90\x0a\xd9\x95\x95\xd1\xf5\x93\x15\xfb\xfc\xd9\x36\xcc\xe0\x85\xf6\x88\x44\xb9\x62\xa5\x9c\x0b\x95\x4b\x6f\xdb\x87\xd9\xa4\xa8\x6b\x5c\x16\x30\x41\x37\x88\xca\x3c\x4a\x32\x98\x71\x1b\x83\x84\x46\x29\x14\x18\xc0\xd2\x12\x33\x89\x0f\xe2\xd5\xdc\x63\x39\x74\x1d\xd6\x9a\x4a\x30\x28\x58\x38\x94\x1d\xfa\x8d\x47\xf8\x73\x2b\x27\x66\xcf\xe8\x02\x78\x41\xa6\x7c\x3e\x40\x7a\xc3\x1c\x54\xc8\x0c\x8e\x98\x76\x9a\x6b\xe3\xaf\x56\xa4\x4b\x0a\x5e\xe1\x7b\xce\x19\x26\x70\xf4\x77\x4a\x8f\xe8\x77\xc6\xe0\xd7\xf6\xa6\xf3\xef\x6c\xdc\x0b\x02\xa2\x34\x78\xad\xc3\xd0\x80\x90\x04\x84\x86\x94\xbc\x8e\xf8\x63\x0a\x02\x94\x69\xc6\x30\x79\x28\xe2\xb0\x72\xc3\xe2\xe8\x20\xba\x46\x70\x45\x51\x52\xf1\xb7\xc6\xc1\x1a\x06\x18\xba\x42\xdf\x1d\x28\x23\xd3\x25\xe2\x98\x48\xc1\xb6\x52\x09\x1a\x41\x92\x33\x7c\xc9\xb5\x34\x8b\x51\xe9\x90\xae\x9f\x6c\x9e\x6a\xc3\x0a\x3f\x1a\xbd\x53\x45\x00\xad\x9f\x45\xd1\x64\x51\x38\x5b\x69\xf6\x31\x26\xf0\x15\x9f\x42\x6b\x77\x7a\x51\x2b\xba\x1b\xa1\x0d\x9b\x6d\x22\xd3\x33\x7c\x52\x39\x26\x4f\x34\x19\xc7\x44\xa7\xa0\x2c\x52\xcb\x62\xac\xc8\x1b\x13\x36\x80\xc3\x38\xc0\xe1\xd9\xaf\x49\xbf\xbc\x76\x35\x2a\x38\xb1\xaa\x89\xb5\x38\x70\x15\x08\x60\x2b\x05\x67\x8b\xab\x3a\xac\x46\x97\x1c\xa4\x0c\xa5\x94\x27\x8a\x38\x94\x22\xfb\xaf\x13\x5a\xee\xb4\x81\x4c\x73\x91\x9f\xe8\x99\x54\x0d\x66\x32\xab\xc9\x08\x14\x28\x85\xa9\xc0\x65\x23\xe0\x05\xb0\xdb\x73\x13\xdf\x8d\x63\x33\xfa\x17\xb6\x78\xe5\x23\x60\x8b\x59\x73\x7e\x17\x62\x82\x9f\x27\xa5\x2a\xa5\x0a\x2e\xa6\xad\x09\x0a\x15\xf0\x0e\x40\x59\xad\x2e\xb2\xbd\x9a\xb4\x5d\x2b\x23\x3d\x2c\xf1\x49\x0b\x6d\xa5\x63\x4d\xb4\x59\x2f\x4e\x68\xd9\x53\xb6\xba\x8a\x27\x5b\x9e\xa0\xb8\x72\x3d\xe5\x70\x38\x2c\x92\xd2\x42\x98\xec\x79\x66\xfd\x44\xc1\x39\xee\xf7\x3b\x51\x28\xe2\x94\x98\x25\xd9\xac\xf4\xc9\x38\x94\x2f\x69\x44\x59\xa3\x89\xae\xf9\xbd\xed\xeb\x83\xa8\x74\x0a\x72\x3e\x19\xbc\xb5\xeb\x7e\x15\x97\xa3\xee\x70\x9c\x13\xc1\xd7\xb6\x9a\xbe\x1b\xed\x6c\xad\x47\x1b\xd1\xce\xf6\x3a\xe1\xbb\x9d\x6d\x8b\x20\x0a\xb5\x49\x47\x09\x7c\x5b\x6a\x14\xda\x68\x39\x28\x1e\x3d\xb1\xab\x83\xdf\xce\x76\xd4\xc3\xbf\x38\x3a\x62\x8d\x5d\x27\xda\xc4\x87\x8f\x28\xce\xb3\x71\x29\x98\x94\x71\xc5\x2b\xf2\x26\xfd\x85\xec\x38\xd8\xa0\x0a\x8e\xd7\x3a\xd0\x91\x4c\xda\xd1\x59\xc0\x6e\x86\x13\x84\xb5\x54\x6b\x10\xc1\xe7\xa1\x81\x12\x36\x21\x03\xd1\xc3\x26\xac\x24\xc2\xda\xda\x77\xc8\x74\x4e\xc6\x43\x4e\x20\xab\x6f\x7c\xd5\xce\xa7\xcf\xe3\xfe\xa8\x6d\x4a\xe9\x14\xe5\x6a\x1a\x11\xdb\xc5\x16\x15\x4b\x9c\xae\xa3\xa5\x19\x45\x29\xbe\x77\xed\xf3\xa2\xb6\xde\x59\x31\xcd\xb1\x79\x20\xba\x07\x2a\x08\x74\x91\xc3\x9a\xe3\x4e\x51\xb2\x91\x14\xe4\xa5\x0f\x16\x03\x60\x6d\xe9\x82\x82\xe0\x9e\xf0\x18\xd1\x64\x42\x6d\x23\x65\x88\x1c\x1f\x93\x6d\xb6\x83\x04\x1b\x1b\xd1\x20\xcf\x5a\x25\x9c\xd3\x45\xbc\x1e\xb2\x3a\x69\x8d\x74\xa3\xb4\x6c\x15\x64\x07\x4d\x36\x29\x83\x2b\xb2\xcb\x9d\x65\x65\x32\xa8\x23\xe9\x4d\xd3\xc2\x52\x8f\x78\x77\x8e\x0f\x9f\x1d\xf6\xa2\x61\x7a\x4e\xf6\x54\x11\xd8\x39\xb2\xa4\x00\xcb\x09\x18\x37\xae\x8a\x5a\x59\xfc\x65\x38\xf1\x6b\x66\x20\x7d\x58\xb1\x50\xf6\xd7\x08\x55\xf5\xd2\x3e\xc9\x25\xc7\x6c\x9a\x0c\x63\x6a\x6f\xbd\x1c\xe5\xe3\x84\xf5\x8c\x28\x22\x77\x42\x85\x9b\x47\xae\x99\x6b\x25\xa7\x5a\x87\xa8\x35\x4e\x69\xa6\xcb\x2b\x73\xbc\x6b\xf7\xbb\x3e\x15\xa2\xed\xb1\xa6\x8b\x1f\x21\x0b\x43\x2e\xba\x8c\xab\xc9\x47\x9e\xdd\x1c\xc4\x38\xfd\x75\x7c\x91\x98\xab\x7b\xf5\x05\xdd\xe3\xd8\x95\x20\x4b\xba\x5f\x5d\xa8\x5a\xf0\x55\x2e\x57\x08\x3e\x00\xd8\xd6\x4a\xfc\x42\x88\x24\xea\x9d\x4c\x93\x8f\x69\x3e\x2b\x24\xb2\xdb\xdf\x01\x01\xc9\x9e\x21\xcd\x1c\x04\x83\x5f\xdd\x40\x2a\x9d\xf2\xa2\x00\x3f\x19\xbe\x1f\x22\xff\x6f\x7d\x47\xfe\xf9\x5e\xf4\x52\x06\xf1\x4f\x21\x88\x7f\x4d\x3d\xf0\x33\xba\x43\xa6\xdd\xdd\xb6\xa0\x58\x1a\xdd\x8b\xb6\x4e\x61\x53\x1a\x7d\xfe\x1c\x6d\x3a\x88\xad\xfe\x3c\xcb\x1c\xe7\xb0\xbb\x04\xdb\x6f\x5c\x23\x7f\xd7\x44\x07\x54\xb6\xda\x25\x51\xfc\x10\x07\xa0\x9a\x4f\xd7\xeb\x6d\x5b\x2c\x8b\x1f\x19\xd4\x61\x3a\x25\xfb\x91\x64\x9c\x5c\x24\x59\x09\xd6\x06\x46\x5d\x32\xc5\x3f\xa4\x13\xb2\x54\x2d\xc6\x4d\xda\x30\x6e\x62\xc3\x08\xf4\x0f\x1b\x4a\x9a\x24\x64\x40\x98\x8b\x34\x1b\x8f\xa5\x68\xe2\xe3\xe1\x96\xb6\xe2\x57\xb7\x98\x56\xec\xe9\x90\x6f\x84\x59\xee\x12\x66\x11\x62\x8e\x3c\x98\xe8\xf8\x86\x6f\x9e\xf1\x71\x89\x7a\x6f\x61\xae\xa2\x20\xb3\x91\x31\xe3\x12\xa5\xea\x1f\x4c\x4c\x52\x45\xda\x45\xeb\x65\x49\xaf\xd0\xa9\x21\xb0\xb3\x84\xed\x66\x90\xb0\x85\x5f\xfd\xec\x0a\x17\x92\xa4\xc9\x7e\x3c\xee\xcf\xe0\xfc\x4c\xa8\xae\x60\xa5\xe0\xd8\x11\x49\x90\x5c\x2c\x43\xd4\x82\x98\x3d\xf9\x73\x08\xdc\x75\x67\x69\x54\x9b\x86\xdf\xf2\xe4\xe6\x1f\x46\x66\x7e\x85\xf2\xd2\x68\x06\x55\xaf\x39\xfe\x0b\xd8\x24\xf9\x41\x0a\xf7\x81\xc9\x1b\xd9\x86\x59\xd9\x05\x6c\xc3\x02\xc0\xf6\xa8\x91\x86\xc9\x1a\xf3\x71\x80\x35\x52\xc1\x12\xd9\x23\xd0\xa6\xbd\xd6\x60\xa5\x7c\x65\x0d\x66\xc5\x98\x2a\xbb\x20\xe9\x1b\x9a\x83\xeb\x88\x5e\x6b\x0d\x0e\x20\x3a\xaf\x83\x6e\x9f\x29\x6a\x15\xf1\x9b\xda\x82\x55\xea\x61\xa6\x27\x9c\xfe\x73\x98\x82\xcd\xe2\x6c\x3a\xab\x05\xd9\x6c\x2e\x50\xa3\x97\xd3\xf4\x76\x53\x46\x64\x46\x97\x36\xed\xbe\x90\x3d\xb8\x69\x53\xd4\x8c\xd9\x94\x71\xd6\x73\x8d\x45\x45\x83\x5a\x23\x20\x3a\x22\x4c\xa6\xdf\xa4\x69\xbd\x91\x45\x1a\xb1\xe4\x60\x1b\x32\x8e\x75\xed\x8a\x44\x74\x8b\x62\x76\xc1\xdc\x45\x10\xd5\x82\x6f\x3e\xec\x72\xbc\x7e\xb2\x86\xc0\x0a\x24\x9f\xa8\x1e\x11\x28\xc6\x05\x8a\x3a\x21\xc5\x1e\xc7\x4d\x42\x82\xf2\x34\xb9\xc8\x3f\x52\xe7\x9b\xa8\x3f\x9b\x4e\x61\x7b\x24\xf7\x46\x39\x7d\xcd\xfb\x42\x36\x4d\xd0\x3d\xa4\x4b\x85\x5d\x71\xc0\xe9\x81\x18\x95\xe6\xa7\x08\x5a\xa7\x09\x99\xcc\x0a\x96\xc2\xe8\x6f\x0d\xe9\x51\x77\xc6\xef\xb7\xfe\x88\xf5\x00\x61\x77\xf4\xa8\x5a\x29\x43\x55\x12\xf5\xa0\xdb\x71\xe2\xec\xec\x24\x60\xd6\xe7\x9e\x0a\xaa\x73\x23\xed\x74\xfb\x44\xaf\xc7\xf4\x7e\xe4\x4e\x3f\xed\xf5\x8e\x1f\x0e\x7c\x7c\x6a\x81\x40\x99\xad\x83\x79\x17\x02\xa4\xba\xee\xd4\xa3\x16\x04\xc5\x56\xb5\x5a\x30\x70\xc3\xa9\xef\x03\x83\xaa\x80\x4e\x75\x49\x63\xb9\x59\xd2\xe1\x91\x20\xe0\x5a\x59\x01\xaf\x49\x27\xab\xb5\x5e\xb4\xdb\xd1\x3e\xd0\x5c\x00\xbd\xe8\xbe\xf1\x16\x3a\x4c\x5e\x3f\xd0\x5f\xab\x5e\x4d\xe4\xeb\x43\xfd\xab\xe1\xc8\xa9\x7d\x4b\x69\x40\x86\xad\x4d\xfd\x2d\xb8\x1f\xc1\xeb\x6d\xfd\x35\xf3\x7f\x82\x0f\x3b\xfa\x87\x19\xaf\xc6\xc0\x76\x26\xea\x79\xc0\x7b\x2f\xbc\x46\x1f\x7e\x55\x9e\xe2\x35\xde\x63\x0b\xba\x3a\xab\xb5\x7d\x87\x15\xaf\xf3\x78\x76\x54\xe0\x76\x7c\x56\x0b\xcc\xeb\xff\x8c\xd7\xe1\x71\x83\x36\xb0\x6c\x54\xeb\x32\x9c\xa2\xc3\x3d\xa1\x83\x10\x52\xbc\xdc\xfc\x2c\x20\xc4\xff\x74\xe6\xf6\xbe\xf1\xfa\x5e\xa3\x84\x6b\xe2\x82\xfd\x68\xe5\x82\xbd\x72\xc1\x5e\xb9\x60\xa3\x2e\xd8\xd5\x04\xba\x55\x3f\xec\xa7\xe9\xf9\xeb\xd9\xc5\x19\x15\xe6\x72\xed\x39\x4b\x49\x27\xe0\x25\x41\xc5\x5a\xb0\x66\x25\x21\xb4\xb6\x52\x91\xe5\x13\xde\xb1\xff\x5a\xf0\x7d\x14\x96\x88\xe7\x61\xea\x76\x50\x16\x86\x0e\x65\x39\xa4\x1b\x77\xbe\x1e\xba\x89\xc9\x16\x27\xc2\xfa\xb0\xa0\x45\xf4\x32\x38\x58\x29\x40\xf2\x56\x37\xa6\xe8\xd4\x03\x1e\xd0\xcd\xbe\xdf\xc0\x98\xb2\x22\x64\xe6\x64\xc9\x39\x81\xfb\x48\x66\xa0\x48\x5d\x0e\xbe\x04\xe5\x65\x4e\xfe\x4b\xa8\x3d\xb1\x76\x52\xb4\x38\x70\x95\xac\x62\x38\xce\x49\x15\x84\x75\x26\x39\x69\xa9\xc3\x9c\x75\x60\xd6\x0d\xf2\xcb\xac\xd6\x46\xa2\x1b\x60\x3d\x56\x90\xcf\x6c\x04\x3f\xcb\xb1\xbc\x16\x1d\x87\x49\x9e\x25\xe0\x48\x06\x34\xa8\x98\x6c\xa0\xd7\x66\xb9\xd1\x52\xf2\x5f\x1b\x8c\xa3\x8e\x93\x8e\x9b\xb6\xee\xd0\xa6\xad\xc5\x47\xe2\xd6\x65\x23\xdf\xee\x77\xdf\x1c\xbc\x3d\x7e\xff\xf4\x80\xcc\xc1\x57\x4f\x9f\x1f\xbd\x3f\x82\xec\xec\x07\xaf\x7f\x7c\xff\xea\xf0\xd9\x73\xcc\x16\x22\xcf\x16\x28\x07\x75\x27\xf1\xe0\x65\x32\x2c\xdb\xec\xa9\xcc\x8f\x2f\xf3\x62\x5f\x8e\x0b\x47\x83\xbc\xe7\x3a\xf5\xd6\x83\xf5\x4e\xf4\x60\xd7\x71\xc2\xaf\xea\x1b\xb4\xf3\x6d\xd6\x5a\xb8\xa3\xa4\xce\x78\x96\x59\xaa\x6e\x84\x9f\xda\x76\x2c\xdd\xd2\xb5\xc4\x11\xb3\xd5\x45\x7c\xcc\x10\xa2\x97\xf9\x4f\xc9\x27\x41\xdb\x62\x76\x46\x16\x97\xf6\x36\x36\x58\x63\xc3\x95\x8e\xd5\x23\x0e\x22\xef\x46\x0f\x76\xc0\x8f\x0e\x1b\x0f\x73\x90\x8f\xd2\xf3\x51\xc9\xcb\x77\x48\xbd\xdf\x7c\x9d\xa3\xc8\x95\xa3\x5b\x1f\x4c\xe7\x16\xe0\x06\xc6\x54\x58\x50\xf4\xc1\xb4\x8f\x36\xbe\x22\x06\x30\x4e\xb1\x58\xf3\xeb\x5d\x26\x1f\xef\x46\x8b\xf1\x47\xdd\xf2\xc2\x76\xa1\x4d\xc4\xc0\x5b\xab\xc4\x0d\xb2\x8e\xbc\x38\x34\x07\xd3\x40\xcc\x9d\x77\xe5\xf0\xe1\x8a\x6f\x6e\x80\x6f\xcc\xa0\x09\xb5\x8b\x87\x19\x64\x41\xf0\x0c\x7c\x48\xe2\x6c\xa9\x8b\x87\x19\x36\x21\x80\x65\x5a\x9b\x8b\xfd\xe0\xa2\x09\x6b\x27\x7a\x12\xb5\xb6\x5a\x51\x8f\x54\xd9\xba\xa5\x45\xa0\x6e\xb0\xc0\x72\xa4\x15\xff\x19\x0a\x14\x11\x6c\xf4\x2e\xc0\x6b\x99\x6d\x90\xce\xae\xa2\xed\xff\x5e\xc0\x86\x53\x5e\x4f\x88\xa1\x89\x32\x39\x27\x3a\x7f\x93\xd1\x3e\x32\x1b\xac\xd3\x06\x97\x38\xfa\xfc\x02\x60\xdd\xe8\xf3\xa1\x30\x26\x11\x8c\x8c\xc4\x4a\x2a\x68\xe9\x45\x52\x18\x9f\xb6\xd7\xbb\x93\xfc\xb2\xbd\xb5\xfd\x70\x7d\x3d\x7c\xd8\xf6\x47\x49\xff\x03\xf8\x96\xa8\xe3\xa6\x28\xf2\xb5\x34\x2e\xc8\xb6\x27\x19\x1c\x14\xaf\x91\x02\xd6\xc1\xa3\xac\x7e\x94\x7c\xe2\x1d\x75\xd0\x59\x4c\x42\x6a\x79\xa1\xf8\x95\xfa\xfe\x22\x07\x0b\xc0\x65\x0a\xfb\x59\x88\x72\xe5\x19\x09\x13\xc3\xf0\xa1\xc0\x88\x2f\x84\xf6\x66\x27\xda\x22\xca\x31\xd1\x90\x2b\x75\x79\x7b\x5d\xfb\xba\x1e\x3d\x7e\xfc\x18\xa6\x5e\xe3\x49\x34\x05\x29\x7c\x2f\x1e\x53\xcc\x23\x66\x41\xac\x0e\xea\x8d\x0d\x98\x87\xf5\x99\x85\xd1\xb9\x11\xd2\x78\xd9\x7d\x41\x0b\x86\xa3\xda\x25\x79\x70\x93\x3b\x25\x3a\xf3\xd3\xf3\x88\x6d\x88\x6b\x67\x89\x44\x53\x1b\x1a\xfc\xb6\x16\x94\x62\x6c\xf4\x98\x61\xac\x9c\x42\xb5\xe1\xb2\x6a\xb4\xb6\xb9\x66\x9d\x0e\x82\xf7\x90\xe4\x74\xd8\x92\x0a\x46\xb2\x51\x64\xd0\x69\xc9\x38\x4e\x6e\x64\x3d\x7b\xd8\x88\x3b\x67\x19\x7c\xc6\x79\xcb\x77\x42\x84\xf0\x17\x63\xa9\x8b\x34\x9b\x99\x13\xbc\x35\x5c\xf0\xd7\xa2\x75\x8b\xca\xb7\x10\x4f\x93\x06\x28\x2e\x99\xa7\x67\x4d\x99\xfa\x5d\x18\x57\x7b\xf9\x39\x69\xc4\xd0\x26\x8a\x1e\x56\x7e\xb7\x5c\x5e\xfe\x72\xc3\x62\xae\xd3\xb5\xc3\xe2\x5e\x67\xc3\x87\x85\x8e\x8a\xb2\x99\xd4\x87\xc1\x44\xc9\x33\x0c\xd6\xc2\xeb\xbd\x00\x6a\xc8\x22\x0e\xdc\x1d\xa4\x1f\x53\xa2\x78\x3c\xbd\xf2\xac\xb7\xcb\x9e\x0c\x8d\xc9\xfe\xee\xe6\xe9\x6e\x21\xe5\xe3\xff\xf9\x29\xff\xee\xa6\x48\x6f\x7b\x61\xb1\x66\x25\xf5\xc3\xf7\x0f\x55\x30\xb2\x05\x48\x2e\x14\x1c\x9d\x15\x9c\xe4\x37\xd1\xf3\x50\xdf\xda\x6d\x78\x89\x8f\x08\x1e\xaa\xb6\x2c\xb8\xfd\x20\x1b\x8e\x27\x4c\x79\xe3\x71\x48\x97\x37\x50\x0d\x0c\x4c\x4a\x50\xa9\x10\x15\x68\x9c\x0c\xab\xa1\x00\x1d\x35\xc4\x3c\x61\x69\xb9\x70\x7c\xc9\x82\x23\xc0\x5f\xae\x8d\x04\x07\x86\x56\xec\x3a\x7d\xa3\x6b\x19\xad\x68\xf3\x9d\x08\x3d\x53\x85\xd2\x3c\x94\x19\x9c\x10\x3b\xa2\x4d\x7d\xb3\xbe\x81\xd9\x20\x8a\xf4\x37\xbe\x34\x15\xd4\x03\x84\x57\x74\xb2\x65\x39\xf7\x70\x5e\x6a\x6d\x7e\x82\x6d\xa7\xcd\x52\xdd\x02\x4e\x76\x40\x2d\xde\x26\x54\x80\x7a\x97\x38\x71\x1b\xf3\x83\x6a\xfe\xbb\x41\xb6\x58\x7c\xac\x9d\x76\x4a\xb7\x3e\x21\xed\x46\x86\xcc\x64\x63\xc2\x3d\x49\xcc\x41\x79\xb0\xbb\xce\x75\xc3\x32\xe7\xf0\x70\x57\xdb\x34\x35\x61\xa3\x6c\xd4\xc8\xb6\x3f\x0f\x76\x3b\x1c\x91\xe5\x0d\x73\x23\xbb\x20\x23\x9f\xcf\x30\x78\x33\x23\x1c\x17\xfd\x34\x6d\x36\xc6\x88\x41\xf1\x8b\x8e\xae\xb0\x6f\x53\x43\x65\xd8\x18\x2f\x6f\x90\x91\x88\xc5\xb5\xa3\xbc\x87\x44\x39\x76\x0c\xb3\x73\xad\xad\x1b\x56\xbb\x09\xcf\x88\x62\xa1\x8c\xe7\xd9\x00\xe0\xe3\xb3\xb6\xf9\x69\x8d\xcc\x3e\x6e\x8c\xa0\x63\xcc\xfe\x96\x5e\x95\xbb\x64\xcc\xd5\x57\x0d\xa2\x06\x1a\xf8\xe9\xb6\xa8\x9e\xf1\xdc\x71\x02\x53\x81\xd5\xb3\xde\xb8\x0b\xa8\x82\xae\xe7\xfa\xe0\x2e\xce\xc6\xa9\x67\xbf\xf2\xa0\x48\x94\xa4\x9e\xf9\xc2\x0d\x0e\x0a\x6d\xcf\x7c\x81\x82\xcb\x0d\x44\xcf\x7c\xe1\x01\x7f\x67\xc1\xbf\xf3\x17\x50\xf1\xa9\xde\xf8\x5a\xb0\x4b\xbc\xab\x29\xa2\x92\xa8\x7a\xe3\x2b\xa0\x0e\x9f\xf2\xca\x53\x04\x1b\x78\xfb\x8b\xa7\x02\x7d\xe8\xd5\x77\x9e\x42\x7c\x86\xf6\xfc\x72\xa4\x9a\x2b\xcc\x5f\x4b\x77\xc3\x00\x2f\xad\x87\xe0\xb3\xa5\x79\x72\x90\xb7\xdb\x9b\xd4\x93\x8b\xce\x67\x00\xda\x22\x8f\xaa\x73\x08\x8d\xfa\xaf\x3e\x4b\x57\xaf\xad\xcd\xaf\xca\x71\xd2\x17\x62\xf7\x80\x5d\xc6\xf5\x84\xbc\x4e\x09\x84\x75\xfd\xc7\x15\x58\xd7\xf4\x29\x21\xcf\x46\x48\x5c\x78\xb3\x6b\xbf\x32\x23\xe7\xc2\x3b\x2b\x9c\x2e\x03\xc4\x83\xe9\x92\x6f\x46\x28\x5d\xf2\xe6\xc1\xae\x5d\x83\x15\x5c\x97\x65\x42\x08\xc6\x00\xea\x6c\x1c\x6f\xdb\xb4\x23\xdd\x6a\x44\x7c\xbd\x9c\xdb\xcb\x92\xc0\xcd\xeb\x87\xaa\x15\xf5\xb8\x9f\x32\x54\x42\xea\x58\xcc\xd9\x94\x8c\x93\xd8\x11\x3d\x59\x56\xf4\x5d\x15\xf7\x26\x8e\x9f\x5b\x5b\x2b\xcf\xcf\x95\xe7\xe7\xca\xf3\x13\xf5\xfc\x64\xda\xf2\x6d\x3a\x7d\x86\xb8\x70\xd6\xaf\x9d\xcc\x47\x33\xa7\x57\x5b\xab\x68\xa7\xfa\x92\x64\x6c\x92\x2e\x47\x49\x26\xc3\xe3\x74\xd8\x2d\x50\xd8\x31\xca\xc8\x92\xd6\x0e\xc6\xb1\xa0\xd8\x17\x61\xf9\xb9\x81\x23\x2c\x0e\x5d\x25\xc4\xf6\x84\xfd\xfb\xf9\x73\xd4\x6a\x61\x8b\x49\x2e\xae\x6e\xb3\x3f\xbe\x53\x02\xda\x90\x79\x64\x60\xb8\x48\xdc\xd4\xf3\xa4\x54\xef\xe8\xd1\xdb\xa5\xad\x42\x84\x39\xa2\xb2\xae\x76\xf7\xa8\xec\x67\xd9\xbd\x33\xc7\xf6\x4f\xd8\xc4\xab\xf6\xd4\x66\xa2\xb6\xe8\xd9\xba\x83\xf8\x1a\xe1\xd5\x9b\xb1\x66\xfb\x61\x77\x10\xe8\x95\x64\x6d\x2b\x8e\x3a\x05\x2d\x42\xdd\xfe\x34\x81\xc9\x20\x26\xbd\x1e\x64\x97\x4d\x69\x22\xfa\x87\x09\xbd\x6d\xc8\xc6\xb7\x96\xda\x97\xf2\x3a\x3e\xba\x45\x17\x44\xe6\xdc\x22\xee\x2c\x5a\x4c\xe3\x71\xc3\xa0\x1a\x07\x86\x6b\x3c\x61\xe2\xd6\xc6\x34\x60\x94\x2e\xf1\x00\x53\x8e\xc9\xe2\x74\xa4\xa9\x66\x91\x9c\x68\xcb\x1a\xac\xfc\xe2\x2c\xcd\xec\x50\xc8\x65\x4e\x26\xc9\x48\x3f\x77\x8f\x92\xf3\x2e\x93\x24\x84\x26\x49\x46\x05\x08\x59\xe0\xe9\x3d\xef\xda\x11\xe4\x0d\x85\x58\x58\x14\xe2\x5f\x8e\xd2\x3e\xac\x00\x02\xf3\x06\xb5\x70\x27\x28\xc2\x81\xac\x10\x5d\xfc\x1b\x0e\x9f\xa0\x4e\xf0\x41\x88\x6f\xe8\xa4\xb9\x93\x9d\xe9\x2f\x38\x80\xfd\x78\x3c\xe6\x43\x28\x9c\x12\x18\x1d\x46\x71\x25\xc9\xc0\x48\x6e\x64\x25\xa1\x5e\x30\x04\xa6\x03\xff\x8a\xb9\x40\x73\xe8\xf9\xdc\x61\xd0\x21\x95\x57\xa0\x1c\x13\x0c\x73\x82\x0b\x20\x3a\x1e\x8c\xdc\x2b\xd1\xf8\xbc\xbf\xf3\xf8\x31\x55\x24\x87\x64\xcc\xc2\xa3\x5a\xd4\xd1\xb7\x8a\xb2\xcd\x9b\xe1\x2e\x69\xf5\x16\x46\x06\xbf\x57\x20\x87\x04\x96\x79\x90\x1d\xd7\xd9\x26\xe3\xb9\xc4\x8e\xd6\x70\x10\x19\xef\x50\x3a\x56\x21\x09\xd7\xc1\xe1\x0f\xfc\xfd\x82\x7c\xfe\x95\x41\xf0\x7b\xfe\x2f\x36\x12\xb0\x6e\x33\xe3\xa6\xdc\x8f\x84\x85\x1a\xa9\x2c\xa2\x35\xa3\x20\xd4\x0d\xe1\x2b\x16\xab\xab\x35\x1c\x90\x55\x73\x84\xce\xe2\x86\xe3\x52\xe1\xe1\x1f\x14\x1a\x2b\xdc\x1c\x11\x6f\x3c\x72\x29\x65\x82\x1c\x72\x94\xf1\xe2\x4c\xd2\x5e\xee\x28\x69\x3a\x4e\xf0\x30\x29\x8a\x49\xcd\x38\x89\x17\xb1\x70\x07\x0c\x18\xb0\xe4\x62\x42\xd6\x37\xef\xb9\x4a\x80\xb2\x15\x2e\x94\xf4\xc9\xa4\xac\x03\x3d\x5d\xff\x5d\x8c\xda\x22\x28\x7b\x83\x70\xec\x7e\xda\x36\x24\x0d\x6f\x3f\x98\x2a\x9a\x93\xc0\x5d\x44\x21\x5d\x36\x65\xaa\x30\x34\x4d\x02\xa6\xbf\x24\x9b\x78\x54\xcb\xa0\xc1\x6d\x4e\x34\x6a\x9c\xfa\x82\xa3\x37\x24\x6c\xd5\xba\xad\x74\x30\x33\x8d\x4e\x5a\xb2\x45\x9a\x4d\x06\xec\xb2\x1b\x0f\x03\xa3\x03\xc0\xe6\x8d\x0c\x95\x12\x18\x8b\xd5\x25\xf4\x7e\x1e\x4d\xdb\x2c\xc2\xea\x7a\x23\x02\xef\xf0\x32\x7a\xec\x0a\x5c\x13\x82\x1f\x95\x5f\x4c\xd9\xa9\x13\x60\x0a\x7f\xd0\x02\xdf\xd9\x20\x48\x94\xac\x0a\x45\x88\xb8\x51\x75\x0f\x29\xad\x76\xfe\xee\x63\xfb\xc4\x99\x87\x1d\xf1\x84\x1a\x61\x45\x2a\x55\xbe\x6d\xab\xe0\x14\x4d\x3b\xa0\x0c\x19\x1d\xce\x85\xc9\xc7\x64\x7a\x55\x8e\xf4\xe0\xb4\x4a\x1b\x2a\xbd\xed\xd8\xed\xe2\x2e\x85\x9b\xe2\x5a\x54\x30\xfc\x60\xdd\x44\xb7\x73\x43\x8d\x7a\x8e\x1f\x49\x9b\xad\x16\x72\xd4\x5b\x3d\x3b\xad\xa0\x6f\x38\x73\xe0\xc7\x29\xd5\xb9\x49\x65\xfa\xdc\xfe\xc3\x1c\x84\x50\x4f\x37\xff\x49\x08\x38\xce\x85\x1f\x85\x58\x57\x23\xe0\x85\x71\x9c\x40\x5f\xed\x22\xef\xcc\x73\x07\xfa\xd2\x3a\x8d\xe0\xa0\xf8\x81\x08\x7c\x34\x4e\x44\xe0\x95\x75\x24\x02\x2f\xad\x33\x11\x78\x89\x1d\x8a\xb8\xf1\x98\xeb\x58\xc4\x72\x2f\x9c\xeb\x5c\x04\x6a\x99\xeb\x60\xc4\x28\xe8\x3e\x19\x01\xc0\x79\x8f\x46\xf4\xb2\x9e\xb3\x11\x8e\x4d\x50\x2d\x0b\x9d\x8e\xc0\x70\x39\x4e\x47\xe6\xce\x10\xac\x21\xdf\xe8\x74\x64\xe7\xab\x12\x11\xce\xfc\x62\x0b\xf2\x28\xab\x67\x2e\x2e\xb5\x8a\xba\xf9\x94\x81\xce\xcb\xa9\x66\x69\x0f\xaf\x4a\x9c\x02\x6b\x5a\xec\x34\x8f\xed\x17\xe6\x8c\x1d\x83\xe0\x72\x7b\x51\x63\x0c\x3a\x35\x9a\x18\xb7\x96\xf6\x5e\x9c\x27\x38\xe2\x4d\x88\x22\xbe\xd5\x53\x49\xf8\x46\xd6\xd0\xe0\x4c\x6f\xfd\x64\x5a\x42\x5c\x4a\x73\x51\xf5\x2c\x19\xda\x80\x31\x0f\x8e\xd0\x09\xc9\xa0\xbb\xfa\xfb\xc0\x29\xc9\xcb\x1a\x1f\x16\xf0\x97\x1b\x24\x70\xc8\x0a\x86\xcd\x74\x48\x5b\xd5\x7b\x39\xe0\xf9\xd9\xa2\xf3\x14\xce\x1c\x4d\x07\x64\x74\xd7\xc4\x26\x19\xba\x63\x52\x5d\x9a\xb1\x1d\x12\x33\x14\x56\xb7\xf4\xe8\x48\x32\x04\x2c\xdc\x42\xef\xec\xa9\x65\xe6\x10\x05\x2c\x0b\xdb\x5a\x89\xee\x30\x73\xa2\xca\x4f\x2f\xa7\x29\x19\x04\x7a\xed\x80\x21\x0c\xfe\x74\x50\xd7\x52\x86\xe5\x92\x1e\x15\x17\x6a\x00\x46\xea\x3d\x5a\x99\xbe\x08\xe3\xb2\xd1\x31\xd9\xb7\xc6\xfe\x85\x9d\x55\x05\x8f\x93\x7d\x96\x65\xa0\xe4\x3b\x45\x74\x0c\x09\x12\x27\xb2\x66\x70\xc8\xa6\x0a\x0e\x82\xc1\x41\x3e\x2d\x68\x22\x1a\x7e\x06\x01\xe4\x02\x81\x99\xb0\x73\xf6\x71\xfa\x81\xec\xfe\xa3\x82\x74\x0a\x72\x83\xf0\xe8\xff\x14\xb2\xab\xd7\x28\xae\x6a\xd2\x09\xc8\xc2\x3d\xb3\xf0\xb0\xac\x7d\x88\xe6\xdd\x3a\xd9\x3a\x6d\x9d\xae\xeb\xe5\xe0\x17\x1a\xcf\x91\x63\xae\x8f\x28\x3c\xa9\xc9\x66\xea\xb6\xc7\xcc\x85\x9e\xd1\x8d\x7a\x6d\x6e\x75\xa2\x7b\xf4\x0a\xe9\x26\x45\x73\x0b\xdb\x3d\x63\x48\x5b\x9b\x3d\x11\xd5\xb3\x23\xe2\x80\xd6\x6e\xd6\x45\x18\xd0\x6f\x44\x09\xa4\x6d\xde\xe9\x18\x1c\x1e\xe2\x82\x30\x0a\x0d\x81\x49\x6f\x68\xef\x6c\x73\x93\x37\x1c\xf4\x23\x58\x77\x20\x82\xf9\xd2\x64\x1c\x5f\x1c\x84\x6d\xd0\x3a\xb2\x8a\x92\x73\x7d\x07\xb5\x06\x45\x4e\x4e\xd7\xa2\xc7\x3f\x50\xc1\x84\x7c\xdc\x65\x5f\x1b\x9e\x95\xec\x59\x4d\xcf\x2d\x27\x47\x49\x7d\xc7\x42\x64\xa2\x8a\x59\xfd\xf4\xab\x52\x19\x88\xe0\xa8\xf8\xb4\xc1\x3d\x8f\x89\x76\xa5\x94\xfd\xdb\xdf\x22\xf5\xf9\x44\xf9\x5b\x49\x9d\x71\x2a\x94\x31\x99\xd5\x7c\xe3\x7c\xe9\xac\xc1\x25\x59\x33\xce\xb0\xc6\x5e\x67\x0d\x93\x71\x1c\x9c\xa1\xa4\xac\x58\x3a\x63\xb8\xba\x15\xc2\x17\x0a\x5e\xb7\xcf\x16\xb7\xc5\x17\x7a\x38\xe1\x6a\x49\xc3\x18\x41\x37\x72\xac\x51\x2f\xcd\x6d\x36\xd4\x86\xb5\x63\x8d\x3b\x76\x6e\x71\x4e\xd8\xda\xb5\x8b\x6e\x9f\x9e\xec\xf0\xc2\xd6\x47\x56\xc8\x2e\xb3\x75\xea\xfa\x82\x7c\xf0\xa8\x02\x74\x50\x97\xa2\x0b\xa8\xe4\xe2\x54\x9c\x47\x01\x50\x10\xba\x31\x5e\x03\xab\xb4\x02\xe3\x33\x69\xca\xb5\x76\x4e\x2e\xa4\x8b\x71\xd8\xe5\xf8\xad\x39\x39\x96\xeb\x0d\x96\x4d\xd0\xcb\xa6\x94\xb7\xbc\x9c\xca\x9f\x6d\x20\x85\x5f\xf9\xa3\x0d\xe3\x69\xe3\xc4\x81\x00\xca\xa4\x55\xca\x8d\x39\xb9\x53\x42\x30\x22\x99\x80\xf5\x5c\xa9\x65\xfc\xa9\x57\x48\x79\x1c\xf5\x71\x5c\x94\xd1\xc9\x29\xa8\xc2\x46\x93\xd1\x42\x5c\x7b\x27\x90\x6d\x8d\x0d\x08\xfc\x5c\x41\xe3\xa9\x91\xa3\x8a\x34\x42\x1f\x25\x53\x87\x70\xfd\x9c\x77\xf3\x14\xb3\x86\x5c\x7e\x4d\x97\x15\x88\xd9\x33\x48\x86\xf1\x6c\xac\x9f\xc1\x15\x33\xd8\x13\x4a\x15\x72\x8d\x07\x9b\x86\xbb\x11\x34\xac\x75\xb8\x16\xb6\xb4\xcd\x6a\xa5\x7b\x2d\xbc\x35\x6d\x6a\x19\x9a\xeb\x46\xb4\xc8\x91\x2b\x0e\x45\x15\x29\x52\xd4\x8b\x11\x2a\x02\xf8\x4c\x3e\x59\x03\x11\x40\x68\x0f\x52\x01\xfe\x39\x5d\x3b\x45\x66\x3d\x07\xc5\x3e\xd2\x6a\xb2\xd9\xb8\xfe\xca\xbc\xc2\x83\x73\x8e\x9a\x99\x93\xc4\xd5\xef\x50\xc9\x20\xa6\x70\x88\x68\x60\x0a\x06\xcf\xd3\xc2\x78\xb6\xa0\xbe\x74\x40\x16\x8d\x80\xf0\x5f\x5d\xae\x46\xfa\x84\xe5\x4b\x4d\xfb\x3f\x27\xcc\x1a\xf9\x9f\xd3\xf5\xc5\x74\x61\x7e\x3c\x29\xa3\xa7\xd6\x0e\x05\xe6\x42\xc0\x47\x41\x24\xdb\xb1\x2a\x9a\x43\x70\x8b\x50\x5d\x21\x5e\x36\xc6\x00\x21\x1e\x09\xdc\x75\xd2\xa9\x4c\xf8\x13\x77\xea\xde\x1c\x3c\x53\x9a\xcc\x91\xe6\x14\xc6\xa1\xc9\xe5\xe4\x7d\x5c\xf5\xbe\xe5\x77\x18\x34\xfb\xd5\x24\x4e\xb3\xf3\xd5\x41\x0a\x09\x25\x4f\x9d\x58\x64\xdc\xc5\x65\xfc\xb8\x13\x33\x05\x49\xd5\x45\x1a\x1c\x70\x32\x2b\x46\x6d\x2b\x63\x99\x88\xd9\x27\xd2\x8f\xf9\x1a\x83\x1e\x0f\xad\xd4\xb9\x1f\xbd\x49\xbf\xd4\xc6\x95\x94\x5d\xed\x8f\x1d\xa5\xe3\xeb\xd8\x81\x3e\xfc\x90\x63\xfa\xaa\xe2\xc5\x52\x11\x72\x46\x51\x53\x10\xde\x04\x9f\xd0\x3e\x5b\x6a\x33\x1f\xd7\xaa\xcc\xd7\xc0\x27\x58\x8e\xb7\xd0\xdc\x6e\xce\x61\x06\xa6\xa1\x39\xd7\x42\x46\x7b\xb9\x63\xed\x50\xa6\x30\xa3\xbf\x26\x74\xb0\x54\x7c\x73\x26\xfc\x62\x3e\x64\x10\x06\x34\xd0\x0b\x95\x95\xf3\xca\x63\xc4\x4d\x1e\xf7\xac\xc7\x5d\xea\xe7\x89\x5d\x22\x16\x0b\x86\xdd\x5c\x82\x9e\x13\x44\x15\xf4\x5a\x42\xac\x60\x81\x7f\xeb\x22\x9f\xaa\x21\xaa\x43\x16\xdb\x6c\xf2\xa0\x18\xb4\x13\x42\x37\xe7\x84\xff\x26\xda\xa6\xbe\xb4\xeb\x22\x71\xa6\x39\x0c\x46\x0b\x52\x58\x78\x2b\x57\xf1\xb0\x5a\xf0\x4a\x0e\x5a\x92\x08\x3a\xea\x10\xa9\x56\x73\x17\xfc\xc9\xa8\xd1\x97\xaa\x5a\x05\x80\x10\x75\x7d\x08\x47\x59\x62\xe6\x6b\x3d\x58\x50\x3a\xf9\x0b\x63\xd9\x56\x2b\xe9\xa9\xb8\x7f\xa9\x59\x56\xa3\x30\x12\xd3\xa0\xf2\xa4\x8c\xab\x21\x35\x98\xae\x33\x6f\xe7\xce\x16\x44\xd5\xdd\xd9\x5e\xc7\x1c\xf1\xb4\xd6\x34\x59\xdb\x54\xda\x82\xa3\x8c\x0f\x5d\x80\xbd\xfb\xd8\x0f\xd3\x4c\x58\xeb\x19\xea\x14\x76\xb9\x1b\xa5\x5f\x42\x72\x7f\xad\xab\xb4\xfa\x6b\x3a\xef\xc4\x3d\xb9\xc0\xdf\xd7\x3e\xed\x56\xb3\xee\x8f\x3f\xeb\xb4\x57\xd6\x9c\x53\x4c\x1c\xcb\x9c\x73\xdc\x02\x33\xef\x82\x4a\x7f\x4b\x5c\x55\x75\x74\xe6\x6a\x88\xb3\x04\xca\xd5\x63\x8b\x8b\x71\x09\x10\x20\x4d\x26\xdc\xfb\xc6\xbe\xb6\x56\xd7\xa1\xf6\x16\x04\x63\x57\xb1\x84\xb9\x44\xa3\x44\x6d\xba\x78\x4b\x4b\x69\x68\x78\xb3\x68\x01\xe8\x02\xd9\xcb\xe4\x1c\x6d\x84\x8c\xcb\x06\x9a\xf4\xb5\x8b\x05\x12\x42\xe5\x1d\x39\xc5\xb7\xad\xc9\xa4\xed\x0a\x02\x3b\xda\xc0\xb1\x2b\xc0\xa5\xab\x0a\x1c\x53\xf9\x74\xdd\xff\xaa\x9c\x1d\x7d\x1e\x5d\xef\xea\x23\xc3\xcc\x1a\x85\x86\x31\xc3\xee\xd2\x17\x86\x23\xf1\x0c\x89\x0e\x33\xc3\xc2\xc3\xcc\xd0\xe8\x2c\x33\x5f\x80\x98\x99\x1d\x21\x66\x86\x85\x88\x99\x61\x31\x62\x66\x8e\x20\x31\x6e\x3c\xe6\xf2\x87\xb6\xe2\x0d\xdf\x6a\x9c\x98\x77\xa1\x81\x62\xde\x2d\x10\x29\x46\x2f\xeb\x71\x31\x7d\xe7\x8b\x15\x63\xd4\xb2\x98\x7b\xe9\xec\x26\xa2\xc5\x68\xe8\x37\xf2\xfb\x7c\xf0\xc7\x91\x11\x01\x97\x26\x66\xcd\x6e\x4d\x58\xd1\x89\xd9\x1b\x73\x82\x61\x17\x27\x66\xe8\xcd\x89\x19\x7e\x65\x61\xe6\xbd\x3b\x31\x43\x2e\x4f\xcc\xd0\xdb\x13\x33\xf4\xfa\xc4\xcc\x75\x7f\xc2\x87\xcd\x7c\x12\xe3\x0b\x5f\xa1\x78\x17\x7c\x87\xe2\xdd\x22\x97\x28\xde\x05\xdf\xa2\x78\xe7\xbd\x46\x61\xd6\xb3\xa0\xe0\xf0\xdc\xa4\x58\x40\x72\xcc\x7f\x97\xe2\xdb\xe6\xa2\xa3\x35\x23\xdb\x03\x38\x10\xeb\x97\x2d\xda\x28\x51\x59\xcf\xe1\x4c\xea\x63\x34\xc8\x13\xea\xdb\x49\x43\x09\x41\xee\x16\xc8\xa6\x17\xfd\xeb\xd5\xcb\x9f\xca\x72\x72\x44\x2a\x4f\xd4\xfb\x99\x74\x8b\x41\x50\xca\x87\x06\x08\x0b\x43\x2d\x63\x02\xb4\x2c\xe2\x72\xb4\xba\x66\xb1\xe8\xf7\x6b\x95\x82\x6c\x27\x13\x5a\x56\x7f\x41\x37\xb6\xbf\x16\x23\xd0\x58\xd2\xf3\x2c\x9f\x26\xbd\xb1\x16\xc3\xe2\x5a\xbf\xd5\x56\x91\x74\x8e\x1c\xc8\xab\xe0\x5d\xab\xe0\x5d\x7f\xbe\xe0\x5d\x3c\x60\x17\xbf\x08\x81\x47\xec\x32\x16\xf3\x68\xf9\x11\xbc\x4c\x75\xe4\x1d\x5c\x3a\x55\xdf\xe8\x15\xb2\x69\xca\x02\x68\x34\xaa\x13\xee\xa9\x88\x69\xef\xa9\xbf\x3f\x8e\x8b\x22\x3a\xa1\xf5\x9f\x72\xda\x18\x10\xd5\x4a\x65\x21\x00\xc2\x76\x43\xe8\x5f\xfc\x7c\x09\x64\x64\xe4\xcb\x0e\xaa\xeb\x02\x61\x29\x6c\xf5\x32\xb4\xf6\x77\xaf\x0f\x8e\xdf\x82\x51\x4d\x97\xa7\xad\xcb\x24\x6d\x19\x11\x57\x5b\x1f\xb0\x97\xaf\xb0\x97\x3f\x62\x2f\x8b\xdf\xe2\xb3\xdc\x7a\x4b\x3a\x9a\x25\x57\xf6\xeb\xe4\x82\xac\xcd\xd3\x59\x62\x7d\x99\xa4\x7d\xfc\x43\x16\x67\xf8\x87\x8b\xb4\x3f\x75\x7d\x19\x93\x21\x76\x55\x86\x57\x84\x57\x82\xa0\x59\x94\xd6\xcb\x73\x22\x68\x06\x36\x09\x51\xd8\x1f\x51\xbc\x8e\xd1\xb7\x6f\xf0\xb7\x58\xb5\xff\x83\x82\xfe\x1b\x7d\xfb\x1a\x7d\xfb\x0c\x7d\xfb\x33\xfa\xf6\x1d\x7d\x5b\xbd\x3c\xf5\xeb\x3f\xc6\xaa\x4e\x39\xf4\xcd\xde\x33\x60\xfa\x5e\xb4\xb3\xdd\x41\x3e\xbf\x25\xd3\x63\xef\xf8\xdd\xd1\xf3\xf7\x2f\x9f\xbf\xfe\xf1\xf8\xa7\x5e\xb4\x8b\x81\x51\x3e\xef\x29\x7f\x63\x40\x8e\xa9\xd6\x8b\x7e\x37\x9e\xab\x4c\xbe\xf4\xc3\xfb\x67\x87\xbf\xbc\xbe\xc6\x6a\x7c\x73\xf8\xf2\x25\x14\x3b\x3e\x78\xf5\x9c\x2c\x49\x44\x4d\xdb\xdc\xdc\x84\x00\x6f\x06\x30\x77\xb1\x7b\x3a\xce\xfb\x1f\x7a\x51\x0b\xd6\x46\x7b\xec\x38\xd0\x5e\xbf\x4f\x16\xe1\xb2\x57\xc5\x57\x32\xf5\x4b\x45\x65\x0c\x8c\x54\xbc\xca\x4a\xbf\x52\x6f\x56\xea\x0d\x1e\x9b\xb4\x18\xc5\x3b\xb7\x1a\x9a\x74\x7f\x7a\x35\x29\xf3\x7f\xbc\x55\xd7\xf2\x3e\x7d\x77\x0f\xc9\x45\x0f\xe8\xa1\x90\x1b\xf0\xc5\x58\xf7\x2d\x89\x6b\x07\x0f\xa5\x3c\x6c\xbb\x36\xc3\xbe\x8e\x7f\x83\x4b\x21\xfc\xcf\xae\x08\x64\xca\x76\x77\xa3\xe4\x93\xbd\xaf\x13\xa5\xb5\xb4\x06\x3f\x44\xdb\x50\x8d\x99\x83\x71\x5b\x64\x2b\x72\xd4\xc3\x3a\xad\x84\x30\x75\x26\xdd\x85\x1f\x1a\xad\x86\x15\x16\x34\x86\x0e\x74\x7f\x4a\x3e\x75\xe9\x31\x52\xdb\x8a\x07\xc8\xab\x41\xcd\x00\x40\x5f\x41\x36\x04\x57\x66\x33\x61\xa7\x1d\xbd\x68\xfb\xfe\x03\xa3\x56\x25\x3c\x9b\x2b\x0c\x0b\x15\xe1\x72\x40\x89\xfc\xbe\x0f\x7b\x7e\x7d\x84\xc9\xdb\x87\x55\xa8\x95\xed\x39\x62\xce\xaf\x24\xf9\x4a\x92\xff\x25\x24\x39\x0b\x99\x78\x5b\xa2\xfc\xab\xde\xa3\x5a\xcd\xd4\x6d\x51\xc5\x73\xf8\x6e\x53\x2d\x61\x2e\x52\xdd\x0d\xbe\xaa\x5a\x90\xb3\x72\xf8\x50\x85\x84\x67\x64\xf3\x3a\xcb\xd2\xf2\x55\x3c\xb1\xf7\x0c\x2d\xbe\x09\xa4\xe9\xb1\xcd\xed\x08\x6c\x4b\x7b\x90\xc2\x17\xdd\xda\xc2\x17\xc8\xb4\x6d\x7e\xfc\xa7\xef\xe3\x59\x7c\x76\x16\x9f\x27\xce\xef\xd5\x46\xd6\x05\x71\xa1\x54\x8f\x7d\x7f\x55\xf3\x7d\x9c\x7f\x4c\xc6\x71\x3f\xf1\xc1\xc8\x4d\xb3\x07\xe6\x5c\x6f\x07\x03\xf9\xb1\x1e\x84\x8c\x6b\x96\xe5\x59\x0d\x94\xdc\xab\xd7\x83\xd5\xb5\x47\xad\x0a\x1a\x0c\x4a\x64\x69\x03\x08\x03\xad\x07\xe3\x86\x0b\x13\x0e\xaf\x51\x98\x19\x82\xa1\x83\x20\xa9\xdd\x00\x01\xc4\x60\x3f\x78\x80\x31\x78\x66\xaa\x08\x87\xbf\xf0\xd7\x8f\x36\x51\x5b\x04\x2b\x55\x86\x94\x82\x82\xa6\x4a\x25\x9e\xdc\xee\xdd\x55\xc0\xca\x49\x3c\xe0\xd7\x83\xe8\xcd\x9c\x4f\x93\xa4\x0f\xab\xbb\x7d\xa9\xd6\x90\xe1\xcc\xcd\x9b\x87\xe8\xd5\xbf\x19\x9e\xd9\x55\xed\x67\x10\x32\x74\x30\x50\xb7\xf1\x88\xd7\x77\x7f\x44\x9e\xfb\x70\xf2\x23\xce\x56\xa9\x3b\x1c\xaf\x46\x59\x87\xfd\xad\x12\x21\xdd\x51\xee\xf4\x45\x9b\x3a\xb8\x1d\xdd\x15\x52\xac\x45\x22\xc5\x5a\x6d\x26\x3c\xde\x73\x6d\x63\xc1\x0a\x75\x68\x0f\x8a\x0e\x45\xc1\x17\xb3\x9b\xb9\x81\x52\xe0\xe8\x1e\x6f\xb1\x2b\x1d\xad\xb6\xd6\xbb\xbf\xe6\x69\x46\xb3\x73\x47\x4f\x68\x65\x51\x0f\xf2\x2a\x43\x04\xd3\xc2\x1f\x22\xeb\x56\x06\xfe\x08\x08\xf6\xd7\x1c\x79\xda\xf5\x79\x87\x9e\x77\xe7\x2e\x4b\x85\x38\x2f\x13\xcc\x73\xa9\x43\x0f\x97\x4c\xf5\x0f\x7a\xa5\x83\x26\x96\xb7\x93\x47\xd6\x32\x01\xcb\xb5\x18\xc2\x02\x64\xb3\x40\xea\xaf\x19\x07\x35\x07\x25\x12\x94\x1c\x10\xf4\x5d\xd8\x60\x41\x67\xdf\x31\xad\xaa\x1a\x19\x52\x8c\x8e\xc4\xc6\x46\xf4\x22\xcd\x06\x11\x0b\xc3\x61\x76\x90\x97\x27\x8d\x93\xc2\x6b\x66\xee\x72\xe9\x68\xdb\x89\xc0\xf7\x80\x54\x29\x6e\xcc\xd9\xa6\x03\xf8\xc8\xb6\xea\xb0\xdf\x0d\xd8\xe9\x43\xc5\xa6\x3b\xb0\xb1\x9f\xa7\xde\xbe\xdc\xc9\x97\xfb\xef\x6e\xa3\x75\x01\xa2\xfc\x7e\x8a\xf4\x16\xad\x10\x6a\xa7\x80\x8d\x99\x6e\x5d\x45\x9e\x95\x25\xd8\x6e\x22\x01\x7c\xe0\x77\x36\x4d\xe2\x0f\x48\x61\x20\x1c\xc1\x8a\xc7\x9e\x03\xae\xda\x27\x2c\xbd\x0f\x7e\xc1\x50\x67\xa8\xa1\x01\x78\x52\xf8\x13\x93\x2a\x17\x67\x72\xc6\x53\x0b\x72\xf9\x1e\x54\xf2\x75\xb1\x39\x45\x69\xc5\xe7\xf3\xf0\xf9\x92\x58\x95\x54\xb3\x30\x77\x22\x09\x7b\x21\x28\xd6\x30\xfd\x44\x2d\x3e\xd1\x26\x19\x52\xc2\x12\x54\x52\x87\xa6\x11\x26\xfd\x09\x94\xca\xde\xb5\x98\xd9\x85\xe2\x31\x5d\xb7\xc3\x32\x44\xdb\xbc\xcc\x12\xd5\xd6\x67\x15\xe6\x38\x9b\x2b\xaa\x35\xf4\x8c\x6d\xa9\x90\xe0\x77\x1b\x0d\x21\x21\x6a\x04\x74\x10\xfe\xc6\x6e\x4d\x90\x1a\xe4\xf5\x67\xd7\xed\x50\x85\xdd\x00\xbc\xcf\xf9\x65\xaf\x6c\xa7\x4b\x97\xa4\xf4\x42\x0b\x0d\xc0\x38\x48\xb4\xb4\x16\x08\x2c\x74\x93\xb0\x72\x26\x14\x86\xef\xa3\x6d\x48\xa1\xb1\x09\xb7\x03\x40\x51\xc8\x02\x79\x99\xa7\xe6\x25\xd5\xdd\x16\x4b\xbb\xf2\x4d\x3b\x79\x3a\x54\x06\x7f\x55\x4c\x6d\x4b\x69\x8c\xab\xbf\x3c\xc3\xfe\xe1\x78\xce\x48\xfb\x35\x9c\x8d\xc7\x92\xc8\x1b\x60\x4e\x2e\xd9\x85\x60\xba\xf0\xff\x5a\x10\xca\xc7\x67\x69\x2d\x9f\xc9\xa4\x3b\xc7\xf9\x0b\x52\xa3\x33\x14\x90\xb8\x46\x0c\x15\xdf\x33\x2a\xb6\xa3\x4a\x30\xe4\x2c\xdc\x7c\xcb\xbb\x8d\x87\xc6\x44\xd0\x2e\x7a\x6c\x05\x1f\xba\xd4\x63\x93\x68\x00\xc9\xa7\xc3\x61\xbb\xd5\x26\x8b\x31\xa4\x28\xba\xb7\xe5\x8b\xe1\x23\x0b\x86\x5c\x77\x01\xa7\x47\x8e\x13\x2d\x47\x27\x84\x99\xa7\x21\xf5\x35\x97\x76\x4b\x76\xa5\x44\x6b\x8b\xef\x75\x1c\x17\x5b\x24\x8a\x84\x69\x48\xaf\x20\x8d\x87\xc0\x83\xbc\x58\x0f\x4f\x6e\xe2\x92\x5c\x83\xb4\x98\x8c\xe3\x2b\xc6\x38\x34\x83\x57\x56\xc2\x76\x54\x12\xbe\x96\x7f\x92\x4f\xb4\xc0\x33\x56\x51\x50\x24\x29\x68\x48\x67\xdc\x1a\x99\xa4\x61\x09\xe2\xc1\x60\x7b\x08\xf1\x72\x41\x76\xbd\xe9\x64\x7c\xd5\xe6\xb7\x38\xd6\xa3\x7b\x3f\xc8\x97\x1e\xbe\xb3\xf1\x0f\x0b\x8b\x26\xef\x3f\x59\xac\x87\x0f\x25\x87\x67\x6c\x49\x84\x87\x11\x16\x8a\x87\xf2\x00\x59\x82\xc5\xb6\x05\x17\x32\x49\x17\x88\x8b\x3b\xce\x63\xb8\xcc\x2f\xce\xf1\x10\x39\x80\x76\xf3\xb8\x62\xe3\xda\x80\x3a\x1b\xd1\xf1\xe1\xb3\xc3\x5e\x74\x11\x7f\xa0\x27\x7d\x69\xf6\x71\x36\x86\x23\x9e\xb3\x31\x12\x70\x6b\x99\xf4\xa8\x4c\x01\x66\xbc\xac\x2d\xf2\x7f\xe5\xf3\xfa\x7a\x97\xac\x55\x60\x1e\x27\x93\xa3\x45\x73\x5e\x80\xa9\x60\x2d\x78\x5e\xec\xe7\x19\x21\x66\x59\xf0\x93\x63\x32\x2b\xe8\x8e\x89\xec\xc6\xd2\x0b\xb2\x64\x1a\x0b\x61\x9a\x85\xae\xde\x65\xfe\x8c\x55\xe1\x99\x0c\x9f\xd9\x0a\xfd\x59\x9e\xb7\x5c\x7b\x65\xaa\x77\x6b\xc4\x5b\xb3\x8f\xfc\x9d\xd1\xce\x73\xd9\x2c\x07\x24\x6b\x20\x7f\x0e\xdf\x81\x3a\xc8\x37\xc7\x86\x13\x16\xad\xdb\x24\x99\xd2\x5e\x00\xd1\xa8\xae\x20\xce\xc4\x6c\xd2\x21\xca\xb8\xbc\xcf\xcc\x4f\xcd\x0c\x05\x03\x1d\x13\x0e\x3a\x4e\x8a\xe2\x78\x14\x67\xed\x4d\x9a\x1b\xee\x1e\xbb\xf8\xca\x6f\x20\xf3\x19\xb2\x05\x7c\xde\x52\xbf\x04\x8f\xd9\xde\x8c\x66\xa3\xe4\x03\x07\x47\xe0\x2c\xbe\x35\xcf\xef\x95\xb9\x07\xb1\xeb\x1c\xc5\x3d\xb2\xf7\xa6\x07\xda\x6c\x7a\xa4\xc3\xab\x82\xa7\xcd\x2d\xc8\x9a\x43\x24\x76\xe2\x2e\x5b\x4d\x99\x9f\x4c\x33\x82\x7f\xec\x3f\x73\x95\x64\xfe\x59\xf3\x13\x55\x40\xb5\xc1\xb7\xe5\xe0\x37\xfc\x46\xc1\x45\xfc\xa9\x4f\x13\xf1\x7d\x4a\xcb\xab\x5e\xf4\xd0\x38\xbb\x85\x1f\xa8\x23\x69\xc1\xb3\x31\xd2\xda\x90\x7d\x90\x08\x2a\x57\x31\x60\xfb\x2e\x80\x5a\x5c\xc1\x6b\x53\x79\x2d\xb0\x3e\x67\x75\xfc\xea\xc6\x47\x60\x7a\x30\x5b\xb0\x31\x6a\xf9\x6b\x85\x5d\x6a\xfb\x1f\x6f\x0f\x5f\x77\xe5\xe8\x32\x5c\xac\x26\xe0\x22\x34\xe4\x87\x83\xbb\x62\x59\x72\x4e\x98\xe6\x63\xc2\xb9\x1a\xb2\x1b\x15\x05\x2c\x23\x10\xf2\x7d\x76\x3e\x72\xcc\x78\xd9\x6f\x3e\x5d\x68\x43\x1e\x9f\xa3\x6a\xa5\xb9\x47\x6d\x30\xbe\xdd\xa7\x9b\x52\x26\xa4\xbc\x4e\xaf\xb5\x10\xda\xc0\x47\xeb\x9a\x95\x56\xe7\x9d\xb4\x78\x91\x66\x69\x99\xb8\x46\xd4\xc0\x95\x6e\xa8\x30\x4c\x5d\xbb\x0c\x4f\x07\xfd\xa2\xe1\x88\x2b\x18\x4c\x14\x80\x11\x26\xa3\xeb\x7e\xf4\x4b\x52\xbf\x91\x20\xea\xe4\xcf\x50\xee\x70\xf8\x8e\x94\xf2\x2a\x81\xb4\x5a\xf0\x1e\x61\x7f\x48\x71\x44\xfe\xec\xc8\x13\x0c\x3b\x2b\x6e\xa5\x18\x56\xf2\xbf\xc2\x55\xd6\xd7\x66\x08\xaf\xeb\x65\x69\xa6\x81\x22\x4a\xa6\x53\xa2\x3f\xa6\x0a\x78\x5a\x80\x17\x0a\x41\x61\x3a\x25\x73\xa1\x77\xe9\x91\x18\x7a\x17\x35\xd1\x01\x55\x59\x7c\x3a\x63\x50\xf4\x9f\x27\xf4\x1f\xb2\x0e\xbc\xcc\x2f\x93\xe9\x7e\x5c\x24\x6d\x2a\xc3\xe9\xc1\x31\xb2\x80\x00\xf4\xcf\xdc\x9d\x8d\xbb\x43\x9c\xc0\xbf\x66\x00\x30\xe0\x28\x05\x56\x4d\xcc\x8a\xce\x1b\x96\x71\x01\x4e\x61\x9e\x03\x29\xda\x2d\xea\x17\x46\x51\xa4\x57\xc1\xfe\xd3\x22\xa4\xff\x94\x16\x65\x41\xa6\x2c\x91\x63\x05\xdd\x78\x52\x72\xe5\x99\x24\xf4\x30\x1f\x8f\xf3\x4b\x30\xe6\x42\xc9\x02\x96\x21\x43\x42\x70\x9c\x3b\x34\xee\x64\x07\xb9\xbb\x8f\x07\xe9\x05\xc4\x2a\x91\x27\x3b\x46\x03\xef\x07\xf3\xf1\x31\xd1\x58\xa9\x0c\x62\x4b\x36\xf5\x73\x4b\xe9\xe5\x36\xb9\xee\x31\xbe\x83\xd5\x8f\x06\x13\x65\xdc\xc6\x86\xc8\xc9\xe9\x6f\xf2\xa2\x48\xcf\xc6\x8c\x6f\x68\x64\x54\xf3\x26\xcc\xdb\x03\xd8\x69\x11\x46\xa6\x7f\xc2\x3e\x56\x7c\x7d\x3e\x1c\xa6\xe7\x57\xfc\xe1\xd0\x62\xed\x7b\x11\xf8\xa8\xf0\x47\xea\x54\xc2\x10\x82\x1f\xf7\x41\x31\xe0\x2f\x2a\x78\x70\xff\xa8\xc0\x23\xe1\x31\x62\x14\x38\xaf\x0a\x80\xe3\x85\x52\x80\x3b\x75\x54\xdf\x8c\x92\xf7\xee\xc9\x47\xea\x3d\x51\x15\xa5\xee\x19\xda\x37\x5f\xd1\xf1\x38\xad\x8a\x32\x07\x0b\xf5\x9b\x51\x54\x41\x50\xa9\x85\x3d\x9a\xb4\x53\x60\x23\x03\x54\x79\xa0\x4e\x0f\x26\x19\x6d\x31\x73\x2f\x42\x32\x72\xdf\x8b\x4a\xeb\xa5\x53\x91\x35\xc4\xa5\x61\x92\xfb\x2c\xf7\xc2\x8c\x3d\xc1\xab\xf2\x2c\x91\xec\xda\x11\xef\xf9\x69\x09\x11\x57\x71\xf4\xd3\xf3\x7f\x51\x0b\xa2\xd8\x99\xdc\xa0\x78\x95\xfa\x96\xb0\xf8\xfc\x02\xc9\x3e\x98\x8e\x18\x2b\xce\x61\x4c\x77\x50\x93\x5b\x83\x7c\x88\x0b\x32\xd7\x60\xc2\x57\x31\x7a\x45\xc7\x6a\xb4\x71\x42\x34\x7d\x57\xca\xa9\x81\x4a\x56\xa6\x62\x43\xb3\x42\x46\xaa\x5a\x39\x2b\xba\xde\x1d\xa4\x1f\x53\xb2\x4b\x7e\x7a\xd5\xd6\x25\x37\x93\xd6\x4e\x3d\x5c\x55\xba\x78\x55\x44\x7c\xab\xcd\xf5\xd4\x27\x45\xb7\x5f\x48\x46\xc5\x6c\xbc\x10\x31\x45\x66\xee\x4a\x2a\xdd\x94\x54\x5a\x09\x34\xf9\xd2\xb1\x2f\x0b\x12\x67\xca\x9e\xfc\xf6\x05\x1b\xc8\x8f\xaf\x54\xb4\x51\xf2\x2d\x5b\xb0\x95\xe9\x45\x52\x7c\xbd\x42\x2d\xe3\xe7\x45\x20\xca\xa4\x5d\xbf\x60\x36\x44\xd0\xba\x22\xe9\x4d\x1c\xc0\x7d\x12\xed\x66\x3c\x58\x71\x9d\x70\x53\x02\x56\xab\x58\xcf\x51\xad\x0c\x3e\x5f\xd5\x84\x42\x5a\xa3\xac\x7a\x4d\x9b\x63\x1d\x6a\x54\xb8\xaf\xd5\x0b\x3f\x69\x74\xe2\x7f\x7c\xfe\x1c\x6d\x22\x1b\x00\x64\x70\xdd\x7b\x7a\x06\xe0\x30\x37\xf0\x41\x17\x78\xff\xed\x6f\xa2\x0f\xd8\x36\x18\xd0\x31\xbf\xaa\xdb\x70\x6f\x06\x05\x4d\xd1\xe7\xb5\x48\x63\x2e\xa9\x84\x5a\x73\x31\x7f\x06\x67\xa2\x05\xac\x46\x95\x8d\xe7\xda\x44\x64\x03\xce\xcc\x08\x23\x4b\x36\x66\x60\xc3\x88\x6f\x27\x85\xe9\x83\xdf\x1e\xa2\xb0\xe5\x65\xde\x2a\x22\x36\xd8\x17\xc6\x91\x87\x83\xf3\x8f\x2f\xf3\x62\x1f\x2d\x50\xcb\xfe\x75\x4c\xed\x65\x65\xbd\xdd\x10\x7e\x86\x72\x67\xca\x04\xc0\x64\x16\x8d\x61\x67\x1d\x74\x01\xd7\xc9\x92\xaa\xe9\x33\x9c\x77\xd6\x86\x0b\xfe\xd6\x28\x97\x75\x27\xe3\x59\x51\xe1\xc2\x9f\x03\x73\xcb\xc8\x62\xe1\xa6\xf3\x51\xd2\xff\x50\x08\x4b\x04\x5b\x83\x84\x47\x55\xc1\x63\xb5\x8c\xaf\x68\xca\xbd\xc1\x60\x4a\xe8\x52\xcb\x30\x6c\xf2\xf6\xcb\x3d\x1b\xde\x5a\x49\x79\x9d\x4a\xdb\x20\x1d\x63\xa4\xa0\x9a\x8d\x23\x89\x33\x1f\xe7\x18\x08\x68\x8c\xc3\x1b\x74\x1d\x47\x6c\xfc\x77\xf3\x13\x84\xd5\x89\xef\x0d\x4f\x7f\xdf\xdd\xbc\xfe\xbf\x1b\x69\x17\x2e\x49\xcb\x72\x4b\xa2\x6b\x23\x72\x7e\x41\x42\x36\x21\x21\xb5\x29\x6e\xfc\xb7\xbd\xf9\x69\xfd\x89\x97\x86\xe8\x94\xda\xd8\x88\xfa\x40\x32\x2e\xbe\x20\x43\x0c\xf4\x85\x65\x11\xe4\xf7\x74\x2e\x68\x90\x2f\x50\xd3\x30\xf2\xe9\x23\x69\x26\x4b\xa1\x23\x56\x19\x3f\x51\x3c\x75\x34\x61\x55\x51\xc0\xf6\xee\xbd\x40\xc1\x5c\xbd\x39\x18\xb2\xf3\x0b\xc8\x85\x58\x5c\xc0\x7f\xfb\xf1\xa4\xa0\xda\x26\xf9\x5b\x3c\x77\xe4\x41\x98\x9e\x3a\x4e\xef\x8c\x91\x13\x58\xe9\x0b\xde\xf6\xa1\xd4\x0c\x19\x4d\x93\xb8\x3f\x22\xcd\x15\xee\x16\xd2\x82\x31\xec\xec\x82\x0f\x39\xc6\xf1\xb4\xdd\x25\x4d\x00\x86\x19\x69\xef\x02\xee\xf2\x05\xcf\x05\x03\xcb\x2f\x31\x27\x0c\x14\x82\xe6\x06\x19\x93\x7d\xdf\x48\xc4\xb2\x2e\xfe\x97\xad\x82\x20\xb6\x59\x0e\xfb\x53\x5c\xd0\x80\xcb\x70\x51\x58\x14\xd7\xec\xbb\xb6\x32\x8e\xf9\x36\xed\x6e\xba\x7d\x9a\x78\x22\xd3\xac\x1c\x45\xe3\x84\xc6\x66\xab\xd2\xe3\xce\x26\x93\x64\x0a\x5d\x12\x63\x0d\x50\x83\xf4\x3c\xa5\x1e\x01\xf0\xe1\x22\x9e\xc0\x98\x6f\xd9\xf5\xc2\x54\x6c\x4b\x2f\x52\xa5\x3f\x34\xdd\x03\x59\x0d\xa3\x1f\xa2\x6f\x41\xfb\xe3\x9f\xc8\x6b\xd2\xb5\x77\xd0\x22\x37\x5d\xc3\xc1\x7d\xf5\x91\x4e\x5a\x7f\x85\xdf\x3f\x46\x6a\x54\x8d\xe1\x46\x8d\x75\x79\x51\x31\x41\x03\xbf\x6b\xe7\xbc\x51\x0a\xa3\xc9\xbe\xdd\x73\xea\x15\x37\xd6\x88\xb9\x13\x3c\x71\xca\xfc\x66\x27\x4e\xc8\x29\xe7\x3c\xf3\x46\x39\x2c\x94\x53\xc4\x0c\xf0\xc6\x71\x68\xb5\x2c\x2e\xb7\x67\x95\x36\xd2\x0b\xce\x31\x0c\xba\x6f\xf5\x11\xea\x0e\x9a\x7e\x02\xc5\x3a\xf7\x42\xb1\xb4\x8c\xaa\x2b\x3b\x30\xb9\x1e\x81\x1d\x62\xc8\x32\x22\x0b\x4f\x19\x39\x33\xf1\x89\x57\x37\xef\x9c\x9c\x6f\xf6\xf2\xee\x63\xe7\xfc\xc4\x26\x86\x73\xe5\xaa\xad\x7b\xde\x69\x66\x54\x1a\xbe\xf7\xaa\xf6\x5a\xda\x02\x46\x48\x4d\xf6\x54\xe9\x20\xda\xde\x64\x81\xbd\xef\x71\x5f\x20\x86\x2a\xbb\x60\xbe\xf9\x29\x62\xce\xb7\x01\xb3\xb3\xc1\xac\xf4\x4e\xc0\x48\x84\x6e\x44\xd7\x54\xfb\x26\x43\xc3\xa9\x68\x68\xd6\x7e\x1d\x48\x64\x9e\xb3\x68\xce\xc7\xc9\xae\x7e\xe3\xbf\x3e\x7d\xcc\xd7\x08\x77\x41\x09\x6c\x4b\x2f\xc4\x6f\x13\xb6\xa9\x1f\x86\x6c\xad\x0a\xf9\xd1\x21\x2b\x63\xf3\xb3\x6b\x91\x2f\x50\xd8\xf0\x8a\x6a\xa7\xe8\x4d\x1c\xe8\xd0\x7b\xfc\xd6\x27\xaf\x07\x4a\x90\x52\x83\x9b\x8d\x18\xf2\xae\xcd\x92\xe8\x5a\x56\x94\x71\xd6\x07\x19\x5d\xd5\xf2\xf9\xb3\x3d\x56\xbc\x3a\x1a\xd8\x85\xfe\xa5\x45\x56\x45\xdf\x32\xa7\x53\x2a\xf2\x65\xdd\xad\x65\x0c\x86\x30\xc6\x35\x1f\x89\xb7\x6e\x57\xf7\xc5\x87\x81\x55\xde\x60\x0c\xf8\xe2\xc8\x3b\x46\x09\xc5\xba\xd6\xba\x81\x21\x60\xd8\x2d\x85\xfe\xa2\x7f\xf3\x8c\xc0\x0b\xc4\x27\x78\x89\x63\x20\xaa\x5f\x6c\x14\x44\xd1\x70\xdf\x68\x37\xb1\xa0\x4f\xe5\x3c\x94\x62\xc4\xb8\x21\x3a\x1d\xf2\xde\x36\x95\x17\xa0\x5f\x83\x33\x06\xcd\xfa\xdd\xb6\x85\x08\xbd\x95\x4b\x4d\xbe\x08\x59\xb9\xa7\xd8\x12\x88\x7a\xc6\xfa\x38\x97\x30\x66\x45\x6f\x4a\x14\x33\xa0\xc5\xd8\x8f\xf7\x6e\x19\x84\xa2\x49\x65\xe6\x21\x93\x3b\xc1\xfd\xe2\x44\xb2\x93\xd4\x37\x5d\xab\x68\x0d\x73\x93\xc7\xb4\x67\x30\x75\x90\xde\x76\xc9\xad\x49\xe7\xa0\xcf\x3f\x0a\x87\x0c\x43\x76\x53\x0d\xc9\x03\x55\xd7\xde\x7d\x2a\xa7\x57\x1e\x95\xea\xce\x1d\xea\x49\xc5\x22\x96\x21\x57\xfd\xae\xc9\xd6\xbe\xec\x8f\xa2\xb6\xed\xa6\xac\xd4\x82\x9a\xe1\x96\x45\xf4\x98\x93\xfd\x39\xb0\x66\x42\xb6\xc3\x67\x10\xd9\x32\x1a\x25\x31\x44\xfd\x22\x0f\xf9\x45\xbd\xbf\x2f\x99\x70\x00\xe8\x55\xbd\xc1\x07\x59\x24\xa8\xa5\xd5\x42\x00\xb3\xd2\x79\x66\x17\x34\xcb\x69\x35\x5a\x2a\x43\x78\x13\x66\x55\xbd\xbf\xb5\x5d\x99\x55\x59\xb9\xc6\x83\xe0\xb6\x85\xb2\xda\xd5\xca\x31\x53\xa8\x0d\xe5\x43\x01\xb3\x60\xa2\x13\xb5\x59\x92\xeb\x50\x0e\x19\xe7\xb0\x69\x9b\xa4\xfd\x10\x7e\x38\x06\xc0\x60\x7e\x28\x6d\xe8\x46\x8c\x40\x5b\xd3\x18\x81\xd6\x18\xc6\x08\x0f\x76\x2b\x3e\x60\xc5\x96\xc8\x07\xb4\x72\xb5\x6e\x8c\x0d\x2c\xa0\x5b\xe0\x82\xba\x88\xbe\x7c\x47\xd7\x13\x7f\x74\xac\xcf\x34\x88\x48\x4f\xfe\x65\x00\xd0\x9d\x60\x8f\xfd\x63\x7d\xe2\x1e\xcd\xbd\xea\x4f\x03\x44\x71\x7b\xee\xa9\x0f\x56\x4d\xe0\xd0\xde\xe3\xff\x5a\x1f\xa9\xb7\x75\x4f\xfc\x81\x34\xc1\x4a\x8b\xbf\x10\x00\x5e\x83\xfc\xd3\x6c\xc2\xbe\xe8\xd8\xc3\x5e\x1a\xc5\xec\x7b\x6a\x3d\xe4\x1d\x5e\x48\xdc\xfa\xea\x99\x2f\xac\xde\xff\x92\xd0\xbe\x93\x7f\x90\x8e\xd1\x8f\xfc\x0f\xab\xa4\xdc\x26\xf6\xd4\x07\x0b\x4c\x3f\x5b\xee\x59\x6f\xec\xf1\x60\x76\x81\x5e\xf5\xa7\x01\xa2\xec\xa1\x7b\xea\x83\x05\xa6\x99\x50\x7a\xe6\x0b\x0b\x5c\x01\x74\x81\x18\x96\xd5\x9e\xfd\xca\xea\x8e\x55\xc4\x7a\x65\xb5\x22\xf6\x45\x3d\xe5\x6f\xb4\x73\xd9\x79\x4f\xfe\x65\x01\x30\xd5\xaf\x27\xff\xb2\xa9\xc8\x84\x66\xaf\xfa\xd3\x26\x09\xa8\x6e\x3d\xf1\x87\xf5\x19\x94\x9f\x1e\xff\xd7\xae\x1e\x96\xaa\x9e\xf8\xc3\xfa\x4c\xa5\x71\x4f\xfc\xd1\xc1\x24\x10\x4f\x92\xc2\x43\x0b\x42\x52\x14\x08\x97\xea\x0d\x81\x4d\xbe\x43\xe0\x04\x08\xa2\x7a\xbf\x0a\xa2\xba\xd5\x3c\x88\x6a\x9d\xe4\x5b\xe3\x01\x44\x01\x83\xcd\xee\xf6\x66\x77\x6b\x4d\xe9\x02\x9a\x74\x64\x7b\x7b\x15\xcb\x75\x15\xcb\x75\x15\xcb\x15\x8d\xe5\xca\x2b\xd6\xbf\x38\xd2\x8f\xfc\x23\x19\x12\xfe\xbe\x8a\x7e\x49\xc7\xfd\x0f\xa4\xf5\x5f\xc9\xb3\x33\xb2\x6b\xd3\x6c\x25\x14\x3e\x25\x73\xe5\x10\xb6\xaf\x31\x05\x27\x8f\x1e\xf8\x17\xf1\x19\xc0\xff\x9c\x9f\x13\x05\xaf\x4c\xc6\x63\x42\xce\xef\x87\xf4\xa5\xa7\xd4\x8f\xf1\xc7\xe8\x97\x9c\xa8\xc1\xdf\x9f\xd7\x47\xa5\xdd\x35\x14\x5b\x5d\xb5\xe5\xd9\x90\x5e\xc5\x59\x7c\xae\x07\x71\xed\x6e\x00\x5d\x37\xa6\x0c\xe0\x82\x01\x58\x31\x5a\x0f\xce\xa8\x11\xc4\x2c\x95\x92\xd7\x16\x2c\x24\xa1\x40\x60\x99\x4a\x5f\x6c\xd0\x60\x8e\x66\x99\x67\x4f\x3d\x25\x06\x67\x76\x26\xbb\xd1\xc8\x53\xa0\x18\x8d\xac\x12\xaf\x13\x1f\x52\x59\x62\xe3\xf4\x86\xc8\x0c\x1a\x24\xc5\xd3\x17\x0e\x62\xe3\x47\x66\xfb\x85\x0f\x43\xf8\x6e\x97\x4a\xca\x92\xac\xd3\x05\x52\xb0\xe0\x9f\xac\x32\x42\x2c\x6a\x45\x84\xb8\x03\x1b\x08\x12\x6f\x97\xc8\x01\x1d\x9e\xbe\x62\xff\x0d\x08\xe4\xcb\xa0\x65\xac\x79\x15\x98\xe8\x92\x49\x36\x40\xf0\x67\x1f\x2c\xf8\xa7\xd4\x6c\x61\x83\x9f\xc1\x7b\x7b\x44\xa6\x44\x30\x4f\xcb\x2b\xa4\xc0\x84\x7f\xb2\xca\x40\x2e\x30\x52\x0e\x2e\x7d\x60\x7c\x0f\x62\x69\xc2\x3f\xdb\x5c\x3f\xe9\x7b\x8a\xa6\x93\xbe\xb3\x64\x68\x76\x9e\xaa\x94\x5c\xf5\x7f\x21\x75\xb7\x45\xc5\x8e\x9c\x7e\x53\x73\x3a\x83\xf7\xa3\x3e\xc7\xab\x2a\xbe\x43\x6a\xe8\xcf\xa6\x53\xa2\x59\x2b\xbd\x13\xe0\x18\xf4\x84\xcd\x68\x68\x04\x26\x37\x4d\x28\x8d\x56\x3b\x38\xe3\x60\xcf\x9e\xba\x81\x0a\x3a\x77\x69\x0e\xc0\xd1\xc8\x0d\x96\x25\xa2\x4d\x32\x75\xdd\x60\x93\x6a\x96\x52\xfc\xf8\xa3\xbb\xc0\xd9\x6f\xbf\x89\xe6\x61\x12\x7a\xf0\xac\x66\x23\x85\xe6\x8f\xd6\x39\x3a\x05\xae\xa6\x21\xb2\xe3\x8e\x27\x64\x93\x24\xe6\x24\xff\xd7\xd8\x79\xa3\x5d\xe3\x43\x82\xe8\x96\xf0\x53\x39\xbb\xa7\x3d\x75\x6c\x60\x85\x95\x7b\xea\x43\x00\x1a\xef\xe5\xa4\x66\x7f\xb8\x29\xc6\x21\xdb\x08\xb2\x7c\x7e\xa6\x09\xd9\xde\x54\x7f\xb7\x0d\x8f\x74\x2d\x0d\xe4\x35\x4f\x4a\x08\x4a\x2f\xd1\x64\x2a\x6a\xe4\x4c\xb1\xa2\xe1\xcd\x2b\x70\x98\x37\x3e\x92\x05\x93\xab\x9e\x54\xba\x05\x44\x34\xcc\x33\x4b\x12\xb6\x51\xa6\x55\xa5\xcc\x37\x9a\xd2\x6a\x2d\x4b\x98\xc9\x5e\x84\x21\x9a\x83\x7e\x74\xf4\x21\x49\x26\x07\xc5\xdb\xab\xac\x4f\x18\x3e\x0c\x5f\x5a\x89\x51\x70\xde\x49\xe5\xc5\x56\x95\xac\x4f\x11\x0f\x6b\x03\x5c\x04\x74\x60\x89\x00\xe8\x93\x0f\x58\x04\x2a\x13\xe0\xf4\xd9\x57\x80\x87\xeb\x13\xf0\xf0\xe8\x06\x57\x43\xa1\xb1\x12\xf2\x8d\xbf\x90\xd6\x8a\x78\xe1\x43\xab\x0a\x63\x22\x30\xe3\x6f\xfc\xed\x98\xc5\x94\x77\xbe\xd6\xd4\x31\x11\xed\x05\x8d\x0d\xbb\x06\x25\x8a\x90\x27\x3f\x7a\x2a\x38\x7f\x76\x17\x50\x1d\xa6\x59\x11\xf9\xc6\x57\xc8\xf6\x8c\x13\x85\xf7\x9d\x8e\x4b\x56\xbf\x5c\x95\x58\x5f\x7c\x98\x1c\x3c\xdd\x7b\xad\x34\x0f\x8f\x6e\xf0\x2a\x0c\x37\x83\xe7\xcf\xde\x02\x22\x7a\xb3\x2c\x41\x5f\x18\x7a\x89\x29\xdd\x98\x36\x68\x07\x7c\x76\x65\x11\xd2\xfd\x7b\xa8\xcf\x9e\x59\x64\x1e\x17\xb0\x41\x3a\xa5\x67\x88\xfd\x78\x42\x2f\xed\x7b\x1d\xac\x10\x56\x3a\xd8\xdf\x7b\xa3\xf5\x03\x6a\x42\xef\xb0\xa4\x6c\xeb\x03\xd2\x0a\x76\x41\x0c\xf0\x3b\xb4\x93\x00\xda\xe5\xa8\x78\x24\x1a\x0d\x9e\x2d\xd7\x40\x0d\x0b\x17\xf9\x4e\xec\x15\x95\x6a\x3b\x5c\xeb\xc5\x56\x5c\x0a\x43\x0d\xbc\x2d\xa1\x7a\x64\xf9\xc0\x4a\xd8\x27\x7e\xe7\xd4\x95\xb8\x07\x19\x33\xce\x76\xde\xf7\xc7\x29\x59\x54\x7e\x66\xe5\x5a\x76\x89\xeb\x75\xa4\x9a\x79\x30\x4a\xca\xcb\x7c\xfa\xa1\x16\x29\x02\xf7\x9e\x97\x71\xc1\xea\x79\xae\x7b\xa6\xe0\xbb\xb9\x3e\x24\xfc\xc4\xb3\xb6\x13\xa0\x46\xbf\xa7\x6c\xd8\xcf\xc7\x3f\x2f\xbd\x37\xfa\xbb\xd3\xd0\x05\x95\x08\xb6\x3c\xcb\x58\xd0\xfb\x10\x56\x6c\xa3\x6a\x07\x78\x88\x20\xef\xd5\xda\xdb\x9e\x58\xed\x06\x4e\x2c\x9c\xa5\xd8\x1c\xd6\xe3\x44\x2f\x7f\xd1\xdc\xdc\xa6\x6e\x5a\x73\x58\x05\xed\x2a\x02\xef\x5a\x4b\xeb\xb5\xa6\x6e\x75\x99\x6d\x19\xf9\x48\xff\x4b\xbe\x6e\x6f\xea\x5f\xd5\x3d\x38\x7c\xde\xd2\x3f\x57\xdb\x5c\xf8\xb8\x8b\x7c\x64\xca\x34\x7c\x7d\x88\x7c\x55\x37\xae\x6b\x46\xaa\xcc\x35\xc5\x3c\x03\xdf\x76\xb0\x6f\xd5\xee\x15\x40\x30\x04\x2a\xfb\x0b\x40\x7c\xeb\x81\x00\xce\x06\x18\x0c\x51\xc5\xc4\x02\x20\x8f\x7c\xd5\xf0\xcd\x1b\x81\xdb\xdd\xf4\xc0\x91\x1d\x24\x80\x60\x14\xd5\x0c\x2c\x00\x84\xd1\x45\x18\x0b\xe0\xfb\x7d\xe4\xbb\x6e\x0c\x03\xa8\x07\x08\x94\x50\x65\xe1\xbb\x46\x1c\xef\xf1\x03\x67\x34\x69\xf1\xdf\x59\x59\xfc\x57\x16\xff\x95\xc5\x1f\xb5\xf8\x43\x58\x5a\x88\x5d\xba\xec\x0c\x6e\x7e\x5b\xb9\x65\xf0\xf4\x5a\x3c\xdf\xe6\xe3\x74\x90\x96\x57\xcf\x3f\xb2\x7b\xd1\x8a\x95\x90\xe2\x6e\x15\x10\xb7\x02\xa6\x86\x0d\xb6\x7a\x1f\x60\xb1\xf5\x9b\x6c\x5f\x50\x87\x28\xa3\x7a\xfa\xce\x02\xbd\x84\x15\x28\x31\x50\x11\x42\x94\x7f\x44\x92\xb1\xed\x8d\xc7\x5a\xc7\x75\x2d\x56\xb7\x0d\x74\xa8\x2b\x5e\x27\x72\x5d\x66\x70\x98\x34\xf5\x17\xa8\xb9\xe9\x57\xe6\x59\x07\xff\xa0\xdf\xad\x3b\x47\x4e\xc5\xc0\xea\x8f\xa2\x8c\x30\xe7\x22\xdd\xbf\xd1\xb1\xd9\x11\xe9\x52\x1f\xcb\xbf\x3e\x7f\x8e\x7e\x37\xad\x6a\x5a\x2c\xd0\xdf\x75\x44\xe0\x77\xd2\x82\x1d\x0a\xcd\x0c\x0d\x57\xa1\xc8\x5e\x9a\xfe\x79\xda\x65\x63\xa8\x04\x97\x1e\xfa\x1c\x7c\x38\x0a\x27\xc3\x53\xea\xe6\x2b\xaf\x69\x99\x4e\x3f\xeb\x5d\xc2\x7a\xcf\x63\xa2\x3c\xd5\x57\x0c\x48\x43\x85\x8f\x15\x36\x66\x71\xaf\x29\x8e\x6c\xc7\x2f\xd5\xd6\x76\x85\x82\xe5\xb1\x88\x05\x25\xa1\xd1\x4c\xab\x41\xd3\x06\x11\x01\xa7\xbd\xac\x89\x74\xea\x1b\x58\x96\x5f\x45\x1b\x58\x22\x21\x62\xab\xeb\xf0\xb2\x0b\xff\x21\xa0\xd5\xdf\x64\x64\x5b\x66\x90\x3e\xfa\x95\xba\x5e\x15\x02\x96\x3f\x11\xe8\x93\x53\xab\x0f\xf4\xc8\x04\xd0\x12\x8e\x67\x4a\x91\x93\xcd\xd3\x6e\x01\x02\xd3\xce\x74\x0b\xc5\x2e\xb8\x72\x5c\xcd\x04\x9b\x3d\x7e\xf5\xb1\x87\xda\xee\x63\x7e\x9b\x8e\xef\x32\x6c\xc7\x23\x52\x95\x3d\x82\x04\x43\xab\x47\xd4\x33\x8e\xe2\x46\xda\xa6\x17\xc9\x21\x62\x53\x09\x91\x90\x06\x0c\x67\xf0\x06\xa4\x6d\x3f\xb1\x51\x83\xab\x0e\x39\x51\xd0\xc9\xca\x99\xb5\x5b\x6a\x51\x16\xc3\x1a\xd6\xb6\x71\x7e\x6e\x5d\x0e\x54\xba\x05\x14\xb4\xdd\xda\x1c\x64\x17\x56\x48\x95\x43\xda\x98\x3c\xea\x30\xdc\x3b\x3a\x4f\x3a\xcc\x00\xb4\x6a\x91\xbd\x87\x72\xd4\x7c\x72\xe7\x53\xd2\x07\x95\x0c\x11\x3c\x1d\x1a\xb2\xfd\x2c\xee\x7f\xa0\x23\x6c\x0f\x81\x30\x1a\x09\xdf\xa0\x76\x3c\x3d\x9f\xd1\x2b\xfb\x27\xf2\x2f\x25\x82\xb6\xe3\x66\xad\x68\x04\x04\xa8\xbf\x3c\x32\x1e\x80\x86\x05\x0b\x9c\xb6\xe5\x08\x6f\x5a\x09\x4f\xb8\x91\x10\x32\x86\xb9\xe0\x7f\x9e\x04\x05\x31\x28\x09\x50\x29\xae\x44\x11\x36\x3c\xdd\xb3\xd4\x71\xa0\xa1\xec\x2c\xd9\x72\xda\xce\x3b\x3c\xff\x62\x07\x5d\xb2\x3a\x62\x2d\xa5\xc7\x65\xed\xf5\x4e\xd5\xa6\x32\x5c\x73\x71\x02\xa9\x84\x88\xe7\xe3\x7c\x5f\x44\xe2\x57\x59\x42\x84\xe7\x47\x8d\x57\x15\x0f\x31\x3a\xb1\x47\x77\xaf\x45\x65\x5d\x82\xaf\x5c\xd8\x79\xa9\xf0\x5d\xb5\xd5\x17\xcf\x16\xdb\xbf\xc7\xae\xdb\x64\xd3\xa9\x06\xef\x8d\xdd\x29\x13\x85\xf0\xc1\xd8\x6f\x56\xcb\x16\x6c\x46\x8d\xda\x0c\xbd\x07\x36\x76\x3b\xe6\x7e\x6d\x77\xb5\x5f\x5b\xed\xd7\x56\xfb\x35\x74\xbf\x46\xcd\x59\xb7\x96\x6d\x1b\xe4\x1b\xb8\x95\x4e\x27\x7d\x7d\xfb\xf2\x2b\x7b\x69\x3b\xa7\x40\x70\x63\x63\xab\xc3\xde\x21\x3b\x1c\xdb\xf8\x08\x64\xc3\x37\x2f\xd6\xde\x85\x52\xf8\x7d\xfd\x0e\x86\x43\x00\x4e\x27\x6e\x83\x6d\x48\xe2\x16\xa2\x91\x88\x24\x40\xb0\x64\xf1\x8a\x69\xca\x51\xda\x11\xfe\x42\xad\x47\xa7\x37\xbf\x71\x41\xea\xd1\xdf\x23\xa9\x7e\x80\xe0\xb4\xbe\x12\xb9\x5f\xa5\x30\x09\x25\xa1\xba\x8c\x0d\x06\xd8\x36\xd1\x4b\x53\xb2\x0c\xce\x8a\x91\x04\x5d\x88\x46\x62\x31\x6c\x46\x11\x5e\x2a\xb0\x8f\x98\xd2\xe6\x08\xe8\x28\x87\x5e\xeb\xaf\x87\x4d\x2a\xdf\x80\x6c\xc0\xac\xdd\xa2\x50\x47\x69\x8d\x70\x74\x87\xef\x8a\xec\x0d\x2a\xfc\xf8\x37\x3a\x0f\xd8\x5f\x62\x73\x62\x43\xf2\x31\xd0\x53\x1a\xf1\xd7\x10\xc4\x6e\xc0\x93\x2d\x9a\x25\x59\x69\x65\x83\x56\x9c\x50\xe0\x53\x74\x43\x4c\x87\x13\xdb\x86\xb2\xb2\x75\x0d\x81\xa6\x29\x50\xe5\xcd\x74\x3d\xfa\xb1\x59\xf4\x0e\x17\x22\x44\x5d\xfe\x19\x2e\x28\x1d\x25\xc5\x84\x68\x91\x09\x6f\xdd\x1d\x74\xc6\xea\x28\x8e\x42\x9b\xc9\x98\xee\x41\xf6\x11\xad\x1e\x21\x86\x24\x8a\x0f\x77\x67\x7b\x2c\xba\xba\x45\x12\xa6\xfa\xd0\x00\x9f\xd8\x07\x8e\x4f\x97\xa3\x45\x43\x7f\xaa\x2f\x5c\x78\x5e\x63\x63\x89\xed\xf6\xe5\x83\x5f\x79\xa4\x9c\x6d\x28\x8c\x6b\x42\x50\x83\x46\xf7\xa0\x03\xba\x1a\x17\x44\xa0\xc5\x29\x6e\xfc\xf7\x57\xda\xd9\x4a\x3b\x5b\x69\x67\xa8\x76\x26\xf7\x76\xb7\x69\x4c\x6f\x6a\xba\x86\x4d\xf9\xd4\x80\x2f\xf8\x5e\x72\x83\x7e\x5c\xdc\x02\x2f\x0a\x28\xc1\x25\x54\x43\xb9\x08\x16\x61\x16\xdb\x53\xb6\xc4\x0a\xbc\x3c\xa4\x30\x94\xc8\x20\x8d\x84\x99\x96\xd5\xb8\x1f\x54\xdf\xaa\x0f\x22\xc6\x4a\xee\x57\x05\xdf\x58\xe5\xa4\xee\x46\x6f\x66\x5d\x9b\x99\x1f\xcd\xcf\x7e\x24\xcc\x0c\x79\x78\xf3\x7a\xf0\xa2\xb3\xb4\xc3\x6b\x72\x9d\xdb\x13\x10\xc4\x6a\x89\x65\x75\x54\x0a\x51\x5b\x27\x28\x5b\x2c\xba\x82\x82\x77\x0b\xdc\x10\xd4\x54\x8c\x8a\xd5\x89\x21\x82\xa7\x71\x26\xba\x87\xae\xe1\x04\xa1\x80\xa7\x7b\x84\x97\x75\x2a\x11\x05\xc2\x52\x3f\x32\x64\xea\xb0\x83\x82\x2e\xd5\x8e\x85\xd8\x82\x79\xc2\x8d\x62\x6c\x60\x58\x19\x39\x1c\x98\x29\xd7\x32\x68\xcf\xb7\x09\x11\x68\x16\x3c\x16\x3b\x33\x58\x05\xc6\x61\x20\x15\x88\x49\x59\x54\xe6\x2e\x94\x6b\xc5\xc7\x6b\xd9\x48\x03\xde\x37\xb8\x19\x6f\x36\xcc\xca\x56\x59\xcc\x96\xc0\xcb\x58\xa0\x1a\x94\x05\xea\xea\x55\xad\xda\xd2\x06\x2c\x51\x7d\x0f\x16\x4a\x71\x2c\x57\x75\xc0\x11\x64\x34\xfc\x7c\x68\x68\x59\x2a\x2b\xba\x85\x6b\x84\xa1\x9c\xc6\xa4\xee\x9c\x6c\xc6\x24\xf9\xed\xf2\x98\xd9\x66\x03\x33\xae\x58\x77\x96\xca\x6d\x6c\x75\xac\x3f\x98\xe3\x8b\x1f\x3f\x2c\x91\xcb\xa0\xc1\x4f\xb6\x3d\x9c\x61\x5d\xcf\x61\xa4\x46\x3f\xe3\xe8\xe0\xdc\x25\x60\xfe\x09\xc1\x8e\x79\xea\xb0\x5f\x60\x76\xe0\x51\x58\x6c\x3b\x7e\x93\xd9\xd1\x6c\x7e\xc8\xf0\xcd\xf4\xa2\x84\xe0\xb7\xf3\x84\x30\x11\x73\x7e\x1a\x5f\x11\x8d\x76\x32\xce\xaf\x08\x3c\xbf\x4f\x41\x03\xb6\xf4\x47\x71\xea\x49\xea\x28\x66\x10\xad\xff\x45\x3e\x15\x3d\xf1\xc5\x4c\x14\xa6\x23\xef\x1c\x12\x42\xea\x5a\x1e\x95\xe8\x60\x32\xcd\xdb\x3b\x71\x8c\xed\x8b\xe4\xe1\x40\x0f\x9d\x70\xc6\x59\x9a\x3a\x0c\x4c\x21\x9d\x51\xad\x72\x13\x71\xd4\x14\x05\x5f\x10\x55\x90\x9a\xb6\x78\xa4\x0c\x1d\x72\x63\x83\xec\x5d\x52\x76\x78\x49\x77\x09\x93\xd2\x6e\x66\x28\x5c\x36\x0c\xb6\xe4\x73\xbc\x05\xdb\x30\x7a\xf4\x54\xc7\x65\xd4\xa2\x91\xd0\x78\x5e\x1a\x7a\xee\x00\xa2\xd0\xc1\xbb\x77\x2d\xb4\x15\xf4\x8b\x32\x9f\xb0\xd3\x2d\x38\xbc\x8d\x87\x80\xe9\xfd\x4d\xc6\x30\x05\xd1\x47\xd2\x8b\x84\x6c\xd9\x1c\xc7\x7a\x80\x10\x23\xe2\x0f\xa4\x50\x8d\x3d\x86\x75\xb7\x0b\x0d\xfe\xc2\xdb\x6b\x7b\x0c\x69\xea\xcf\xd2\x9b\xb4\x4e\x1a\x23\xc5\x83\x9f\xf8\x0d\x43\x92\x2f\xfc\xe6\x9f\xca\xee\x52\x25\xd0\x93\x22\x9e\x9e\xa5\xc7\x0c\xff\x3e\xcc\x53\x9a\x4c\xef\x0c\x36\x96\x64\x87\x6e\xd2\xb2\xe5\xb5\x06\x25\x63\x2c\x7a\xac\xfa\xb3\x12\xf9\xcd\x8d\x87\x21\x73\xd4\x5f\x15\x33\xd6\x43\x6f\x9d\x8b\x89\xe0\x39\xae\x10\x38\x62\x93\xa0\x92\xc0\x0a\x72\x10\xf7\x56\x63\xf2\x8e\x98\x33\xb5\x66\x38\x66\x06\xa4\xb0\x0e\xf6\xf7\x97\xc7\xf1\xde\x87\x83\x65\x5e\x6d\xb7\xaf\xcb\x13\x03\x51\x50\xb7\x6b\xb1\x14\x3f\x57\x0e\xd9\x07\x48\x0e\x59\x5f\x8f\x75\xce\x26\x3a\xfc\x1d\x8a\x46\x58\x05\xf0\x63\x92\xd5\x3b\x19\xd4\xdf\x02\x33\x54\xfd\x79\x67\xab\xfa\x6b\x3c\x73\xd5\x1f\x93\x3d\x03\x99\x2c\xfc\x87\x68\x27\x90\x11\xc4\x0f\x3c\x58\xb8\x53\xca\x38\x3f\x57\x26\x14\xdd\xb8\x8b\x25\xf4\x4e\xcb\x56\x53\xbc\x9d\x32\xb4\x0a\x6a\x51\x40\x59\xac\x51\xb5\x04\x59\xa6\x62\x08\x85\x11\x52\xd0\xf0\x43\xed\x28\x3e\x27\x2b\x3b\x9f\xe8\x97\x09\x35\x5a\x85\xd7\x8c\xef\x90\x9c\xfa\x4b\x4d\x55\xa6\x22\xec\x51\xf6\x6a\xba\xdb\x67\xb9\x1b\xb8\x9b\x0a\xac\xb0\xa0\xc9\x14\x09\xa9\x90\xe8\x41\xe9\x45\x83\x2e\x36\x90\xf8\xe6\xcf\xb0\xbc\x37\xef\x8d\x3f\x0c\xf7\x8d\x61\xab\x26\x7c\x55\xd4\x44\x6e\x93\x52\x16\x09\x32\xdb\xc9\xec\x93\xc9\x5f\x99\x76\x49\x4d\xc5\xe7\x31\xe1\xac\x0b\x58\xde\xbb\xfe\xc5\xcb\xfc\xd5\x2f\x66\xe6\x0f\xc9\x52\xbb\x0c\xa4\x03\x71\x46\x8e\x39\x2c\x90\xba\xba\x3c\x75\x38\xcb\x62\xc7\x2b\x4b\xdd\x4a\x2b\xa7\xc6\x62\x3e\xbe\x88\xc1\x9a\x76\x25\x23\x74\xd6\x6e\x07\x8c\x82\xf3\xef\x92\x4d\x0c\xb4\x0b\xd4\xd4\x68\x41\xca\xe3\x07\xc6\x3c\x48\x01\xfc\x83\x9d\xa1\x92\x72\xe0\xc6\x76\x96\xda\xfa\xb9\x46\xa4\x20\x42\x55\x06\x07\x48\x12\x58\x6d\x9c\xcc\x7a\xac\x7a\x05\xc1\x48\x25\xf6\x37\x41\xa8\xec\xea\x5a\xe5\x6b\xc3\x2c\xbb\x25\xbc\x00\xe3\xb1\x39\xf1\x83\xeb\xd8\x0e\xa9\xc3\xdc\xb7\x69\x3a\x24\x8f\xe1\x2a\x8e\xd8\x06\xee\x6a\xbc\x3b\x3b\x0e\x2a\x77\x77\x95\xc5\x45\xbc\x92\xad\x23\xcc\x48\x4b\x6f\x20\x83\x0d\x63\xe4\x3d\x81\x67\xe3\x8e\x6b\x60\xdf\x3a\x34\x30\xb6\x1b\x94\xa6\x1b\x75\xc2\xb4\x25\x07\x76\x24\xb7\xe1\xd2\x9f\xac\x59\x34\x8a\x6b\xe5\x50\x89\x37\x54\x39\x43\xfe\x7e\xfd\x1d\x94\x12\x84\xbe\xe3\x40\x8c\xd3\x16\x6d\x94\xda\x9f\xa6\xf4\xd2\x3d\x9d\x85\xea\x7d\x5e\x38\x44\xa2\x47\xc7\x95\xb3\x26\x26\x8a\x5c\x4e\xa5\xd4\x1f\x34\xc0\x95\x14\x7e\xba\x3b\x29\xa1\x57\x3e\xc1\x93\x52\xb8\xfa\x30\x8e\xe9\x14\x47\x9b\xad\xc1\x99\x31\x73\x1b\x6a\xa0\xe1\xac\xef\xc8\x2b\xe1\x40\x10\xf6\xde\x89\x78\x35\x18\xcd\xf1\x06\x24\x78\xf9\x2e\xcb\x4b\xff\x43\xb4\xe9\x6c\x89\xf3\x98\x98\xad\x7b\x42\x68\x35\xb0\xf5\x89\xdf\x42\xa7\x25\x0a\x75\x5d\xeb\x92\x38\x33\x40\x2e\x30\xa8\x7d\xbf\xa3\x77\xa7\x3b\x89\xaf\xe2\xb3\xb1\x7f\x9f\x64\x6f\x63\x99\x27\x38\x38\xbe\xb0\x04\x8a\x20\x8f\xb3\x3c\xbb\xc7\x6b\x53\x69\xe6\x5c\xd1\xf1\x95\x14\xe7\x34\x9a\x48\x04\x5c\x5f\xf1\x73\xae\xb6\x98\xe4\x1d\x4a\x29\xac\x45\x31\xe6\xf4\xae\xc0\xdd\xc7\xac\x46\x94\x52\x9a\x2a\xe7\xde\x9d\xa8\x66\x24\xcd\xaa\x27\xf6\x10\xf1\x20\x33\xed\x7e\x44\x25\xba\x24\xba\x8e\xd8\xa6\xe0\x15\x4b\xd9\x45\x1d\x8b\x94\x7d\x7a\xe5\x6d\x6e\x78\x17\x8d\xc8\x26\xdd\x3b\x80\xd0\x25\x02\x59\xbb\x1b\x54\x5d\x64\xbc\x7e\x30\x41\xfa\x31\x6c\x7f\x06\x03\xe6\x55\xa1\x2c\x55\x80\x6d\x0d\x1a\x0e\x43\x04\x61\x00\x28\x5c\xab\xc6\x3b\x37\x22\xc3\x74\x0a\x4e\x78\xb5\xfb\x90\xb9\x37\x10\x0e\x43\x27\x66\xdc\xf4\xbb\x19\xa1\xaf\x9b\x67\x0c\x82\xb9\x33\x62\x94\xab\x65\x2b\x17\x46\x8d\x87\xb1\x7e\xf8\xe6\x21\x5d\xb0\x84\x97\x87\xae\xac\x9c\x69\xca\xb7\xc6\x50\x68\x28\x60\x5d\x7a\xc6\x6e\x15\xd1\x57\xfc\x11\x77\xce\x5f\xa6\x5e\x5f\x6f\xdd\x6f\x7e\xda\x85\xe8\x70\xbc\xb5\x6b\x5d\x63\x1e\xda\x38\x6c\x40\x98\x27\x43\xbb\xd2\xdc\x08\x74\x7f\xad\x0d\xe5\x48\xc7\x68\xab\xcd\xf5\xae\x8d\x8d\x79\x0e\x4d\xc8\x00\x44\x71\xbf\x4f\xb3\xad\x81\xb7\x28\xe1\x5d\xb0\x77\x07\x28\xfb\x8e\x63\x46\x07\x01\x19\xa3\x5d\xdb\x12\x1c\xd5\xa6\x7d\x9a\x74\xf4\x3b\xa6\xc6\x87\x2a\xd4\xe0\x5e\x54\x55\x05\x0b\xc5\x24\x2e\x0a\xb2\x65\xd6\xea\xaa\x72\x4d\x00\x68\xa1\x08\x39\x7d\x51\x90\xf3\xc6\xb1\xbd\x33\x86\x4a\xbb\xde\xa2\x0f\xb8\x30\xae\xd6\x9c\xcb\x04\x6b\xe2\xd5\xd5\x53\xec\x6c\xa6\x1c\x29\x29\x70\x20\xc3\x46\x02\x5e\x57\xd2\x03\x50\xa2\xd9\xb1\x4a\x9e\x25\xfd\x78\x56\x40\x60\xd2\x68\x40\x9a\x27\x14\xcc\xa8\xc7\x75\x31\xc9\xd3\x31\xf3\x5d\xcb\x88\xd2\x46\x38\xde\xce\x30\xd9\xc4\x9a\xd6\xcc\x62\x66\xa9\x13\x91\xf1\xf3\x2c\x36\x2a\x98\x23\x7b\x9b\x2d\xe5\xfc\xa2\xe9\x47\x38\x73\x04\x5a\x80\x22\xd4\x89\x2e\x47\x69\x7f\x44\xdd\x06\x41\xf2\x11\x62\x31\xdd\x24\x82\xc4\xca\xf3\xf8\x41\x71\xa1\xd9\x98\xe7\x2a\xd9\x5b\xe3\xa7\x1d\xba\x11\x9b\x63\x8f\x65\x57\xd0\x60\x83\xb3\x94\xcd\x4d\xc0\xc6\x06\xbd\x78\xb7\x98\x7a\x1c\xa6\x1a\xeb\xb7\x98\xbb\xc6\xbd\xce\x85\xd6\x43\x9f\x4d\xc1\x6f\xe0\x6a\xb4\x22\xd6\xc9\xfb\x00\x3b\x18\x6e\x00\xab\xbb\x4b\x5f\x63\x0a\xb3\xd5\x24\xe4\xc2\x25\x1b\x42\xcf\x05\xfa\x0a\x48\xb1\xac\xe1\xc3\x63\xf9\x78\x1b\xf3\x52\x02\x33\x5f\x6f\xd3\xe3\x73\xad\x17\x7d\xdb\xc1\x2f\x03\x82\x27\xb8\x74\xc0\x84\x57\x3b\x1d\xe3\x5a\x20\xbd\xf4\xc7\x49\x08\xce\xe2\x5b\x95\xb3\xf8\x83\x95\xb3\xf8\xca\x59\x7c\xe5\x2c\x8e\x3a\x8b\xf3\x3b\x2b\xb7\x75\x97\xaf\x2e\x97\x02\xbf\x3b\xc3\xe2\x4b\x1c\x0e\x85\x27\xd7\x1e\x59\x5d\x7a\x75\x76\x5e\x65\xbb\xc0\xcd\x49\xbc\xb6\x88\x45\x65\xa2\x49\xec\xc5\xba\x0c\x2b\x85\xa8\x5d\x56\x6c\x99\x94\xae\xcd\x90\xb1\x3a\x7a\x47\x6f\xf6\xd9\x42\xb8\x14\xdc\xa8\x75\x8e\xe9\x43\x09\x0d\x1b\x43\x50\x24\x2d\xf0\x83\xdd\x50\xdc\x78\x18\xb6\x14\xd2\x71\x54\x48\x8d\x72\xe4\xfe\x1d\x8e\xd8\xfe\xe1\xeb\xd7\xcf\xf7\x8f\x0f\x0e\x5f\x47\xcf\x8f\x8e\x0e\x8f\x7a\x44\x8a\x8b\xd3\xb6\x3e\xab\x9c\x59\xe5\x06\x49\x04\xa1\x1e\xa1\x6a\xf2\x4f\xcb\x3e\x62\x73\x60\x58\x05\xdd\x6d\x4c\x34\x19\x86\x8e\x59\x09\x4b\x98\xde\x29\xab\x35\xb4\x75\x71\x2d\xab\xa7\x5d\x50\x64\x57\xa1\x10\x1c\x68\x08\x0e\x32\x55\xe3\x73\xb8\xeb\x77\xe7\x0e\x8f\xe7\x02\xca\x17\xff\xbb\x4b\xa7\x90\xfd\xa6\x2b\x8a\x3d\x89\xd0\xd7\xbd\x48\xf2\x00\x24\x7d\xa3\x43\x3d\x95\xc8\x01\x65\x69\x2e\x38\x16\x44\x93\x2c\x13\x02\x4b\xc4\x5c\x61\x11\x8a\xb7\x51\x47\x92\x8a\x57\x8e\x99\x6b\x97\x4a\x14\xc4\x9b\xbf\x96\x61\x8e\x0f\x5e\x3d\x27\xd2\xbb\x17\x71\x57\x31\x60\x6b\xe8\x09\x59\xcc\x08\x87\xc0\x3f\x71\x7f\x04\x02\xd1\x1e\x2d\x4c\xa7\xd0\x73\xa6\x7c\xbb\x5a\xc6\x57\xcb\xf8\x6a\x19\xc7\x97\x71\x1a\x8a\xe6\x4f\x76\xe1\x6b\x8e\x08\x6a\xcd\x62\xba\xdd\x6c\xfc\x34\x2b\xaa\x31\x08\x21\x69\x2d\x10\x0c\xc6\x66\x8f\xba\x73\xe5\x21\x90\x28\x1e\xbe\xbd\xa3\x79\x1f\xee\xf6\xe3\xb2\x4d\xc4\x65\x30\xe5\x74\x11\x05\x64\xe9\xac\x23\x4f\xd8\x29\x38\xe5\x44\x8b\x06\x6d\x4d\xdf\xc7\x59\x9e\x5d\x5d\xe4\x33\x89\x8c\x7c\x31\x87\x2d\x41\x8c\x07\xd8\x89\xd9\x30\x90\x67\xb6\xc9\xa7\xd7\xab\x6a\xad\x08\x16\x94\xb4\x11\x40\x76\xb5\x6b\x22\x6f\xfa\x44\x8b\x01\xd3\x0e\x5b\x4d\x13\x56\x64\xa0\xf0\x04\xbd\xb2\x9d\x18\x31\x1a\x44\x8e\x4b\x61\x88\xa0\xf9\x78\x41\x18\x99\xed\x29\x9c\xa2\x71\x89\x1a\xbc\x1c\xca\xe8\x91\xb1\x59\x93\xce\x84\xc7\xca\x90\xdb\xc7\xd4\xb6\xb3\x8e\x52\x34\xed\xf2\xba\xe9\x99\x33\xff\xdb\x54\x00\xcc\xab\x4f\x35\x35\x22\xb7\xea\xe6\x72\x90\x52\x07\x9b\xcd\xbc\x01\x4b\xa9\x48\xc3\x3f\xd7\x0e\xf5\xa0\xca\xbf\x88\x8f\x95\x48\x5f\xea\xaf\x3b\x60\xc0\x94\x96\x82\x62\x25\xb3\xc9\x66\xa7\x89\x6c\x57\x53\x72\x39\xf4\xa2\xd2\x2c\x88\x58\x25\x4f\x3d\x19\x42\x29\xb4\xd6\x40\xbe\x9e\x8f\x46\x22\x31\xe6\xd2\x09\x54\xa4\xe7\x59\x4c\xda\xab\x27\x10\x0a\xe9\xa2\x10\x0a\x1c\x40\x21\x59\x2e\x88\x44\x34\x5e\xe0\x72\x28\xc2\x6f\x41\x0b\x59\xc0\x57\x0f\xf0\x5d\x16\xf6\x69\x02\x94\x67\x70\x9c\x0e\x89\x7e\x02\x2f\xf9\xb1\x5a\x51\x69\x2b\x3c\xdb\x50\x61\x6a\xc0\x70\x14\x70\xc2\x0b\x20\x42\xf8\xe9\x55\x39\x62\xa7\x85\x17\x67\x29\x95\xdc\xf9\x39\x8d\x0c\x4f\x3b\xc2\x13\x14\x37\x1c\x11\x24\xf2\x28\x47\xd9\x9d\x6f\x41\x4a\x54\xf9\x17\x16\x71\x65\x15\xa8\x74\xf1\x40\xa5\x32\xce\x27\x12\xd8\xb3\x36\x96\xa9\x36\x64\xe0\x26\x65\xe8\x2b\x9e\x6e\xf1\x86\x59\x60\x24\x9e\x57\x83\xe5\xd6\x11\x13\xb8\x6d\x07\xeb\xc4\x4e\x71\x38\x83\x1c\x8b\x7e\x2c\x6d\x25\xc7\x52\x4b\x87\x2d\xe3\x34\xa3\x1d\x75\xf0\x92\xfc\x7b\x92\x76\x41\xc0\xb8\x1c\xfc\x38\xb4\xca\x37\xc0\xcb\xd5\x6b\x38\xdb\xa8\xbb\x90\x8f\x9c\x7f\xa0\x54\x13\x8d\xea\xe7\x6b\xb4\x2d\xb7\xf7\x20\x6f\x83\x42\x19\x44\xf8\x18\xe2\xc1\xc7\x87\xd8\xba\xd4\xdf\x66\xca\x4e\x27\xfa\xe8\xf4\x78\x73\xf8\xb0\xcc\xdd\x10\xed\x68\xf3\x09\xa2\xf3\x6d\x3f\xcf\xfa\x71\xd9\xd6\xb8\x0f\xab\x23\x24\xbc\x6f\xfd\xe2\xc2\x83\xfc\xba\x17\x97\x7a\x55\x2e\xa9\x5d\x48\x06\xc6\x11\xb4\xbd\x42\x70\x31\xca\xdd\xa6\xe9\xa6\x9f\xd5\x3b\x90\xa8\xfd\xed\x6f\xd4\x7c\xa9\x63\xda\x54\x19\xf4\x84\x34\xd6\xe9\x7b\xb3\x41\x8d\xe3\xe9\xb9\x21\x56\xaa\x9d\xd8\x13\xad\x8e\x9e\xfa\xc4\x03\x1e\x6f\x61\x11\x5c\x39\x5d\xf8\xa9\xbd\x6c\xc0\x8c\x8d\xc1\xd8\xc9\x97\xf7\x5e\x6d\xc9\x0a\xad\x4c\xe4\xd4\xaf\x79\x9a\xb5\xd7\xd6\x3c\x38\xc8\x60\x27\x6c\xaa\x30\xa2\xab\x47\xdf\x74\x13\xd5\x06\x31\xb8\xde\x51\x11\xc7\x4f\xfd\xc9\xa8\x1f\x68\x9d\x93\xe3\xe1\x0b\x00\x5d\x95\x0a\x44\x87\xde\x4e\x26\xf8\xe8\xcd\xe1\x28\xc9\x55\x5f\x59\x3d\xf3\x59\x49\x96\xcf\x97\xf9\x79\xb5\x6c\x9a\xc1\x8d\x19\x99\x99\x51\x9b\x5b\x1f\x58\xb0\x5d\x65\x9b\xe1\x80\xaf\x96\x4a\xda\x71\x77\xd4\x6f\x06\xcd\x3c\x2d\xb4\x15\x8b\xec\xc6\x67\x84\x58\x8a\x23\x51\xbf\xdf\x89\x78\x42\x1a\x94\x23\x08\xc0\x09\xff\xce\xd6\x18\xa0\x21\x7f\x16\xd3\xf0\x89\x3e\xe8\xdd\x62\x94\x0e\xcb\x36\x84\x47\x33\x07\x40\x7c\x72\x1b\xe2\x49\x7b\x96\xe5\x9d\xe8\x55\xf6\x10\x0c\x92\x71\x52\x26\xa2\xb3\x48\x48\x6b\x1d\x80\xb1\xf4\x8d\x89\x50\xc5\x73\x82\x5f\x53\xe7\x62\x0c\xb4\x3f\xb6\xe9\xa8\xd7\xc6\xed\x28\x8a\x37\xa7\x8e\x6b\x58\x36\xd5\xb9\x91\x00\x8e\xa6\xd2\xfd\x87\x0e\xbf\xbd\x3d\x4f\xf8\x6d\x6f\x8d\x5b\x01\x17\x27\x1c\x75\xd2\x7a\xe5\x8e\x05\x0f\x0c\x19\xa0\xc9\x9a\x81\xc0\xad\x5d\x12\x22\x3f\xbf\xfa\x88\xe0\xf5\x13\x53\xbd\xec\x3b\x57\x1c\x1c\x23\x1e\x09\x3a\xf3\xa4\x07\x69\xc3\x89\x74\x7b\x41\xcb\xa1\x8c\x6e\x05\xab\x5d\x74\xc4\xcd\x14\x8a\xc4\x89\x02\x79\x8a\xcf\x44\x0c\x12\x8d\x8c\x4e\x87\x51\x7f\x44\x0b\x9f\xc8\x85\x99\xe1\x77\xea\xec\x2d\xee\xa1\x59\xe3\x60\xa5\x0d\xca\x77\xbe\xe8\xeb\x88\xab\x95\x0e\xb0\x48\x78\xf6\x9b\x0b\xc3\xfe\xb0\xf9\xa1\xef\xd2\x92\xef\x6c\xd4\x1c\x69\xbd\x62\xb3\x0b\x39\x12\x0a\xcc\x68\xae\x25\x33\xa7\x39\x90\x8f\x0f\x9f\x1d\x42\x40\x56\xe6\x3d\xdf\x89\x8a\x9c\xfb\x17\x4b\xd7\x90\xca\x73\x39\x66\x27\x13\x5d\x5a\x30\x2d\x5b\x05\x11\x59\xe0\xd4\x1e\x4f\xaf\x40\x48\x5c\xc4\x1f\x92\xa8\x80\x79\xd2\xa2\x19\xe6\x68\x16\xc2\x08\xb2\x30\xb2\x6d\xc9\x05\x51\x16\xd2\xc9\x58\x49\xb8\xac\xa3\x2c\xb3\x41\xd7\x44\xc6\xde\xf8\x26\x42\xef\x57\xde\xb7\xae\x6c\xb2\x5a\xb5\x1a\x25\x66\x8e\x0b\x51\x15\x22\xc9\x80\xad\xfa\x8e\x05\x4b\x56\xd4\x15\x44\xf5\x86\x11\xbe\x03\x5d\x39\xb1\x0b\xe1\x62\x41\xfc\x5c\x85\x5c\x4b\x1a\xfc\x1c\x77\x6e\xf4\x4e\xf1\x50\xe2\x58\xdd\xd8\x4a\xe9\xbd\x9a\x83\xd6\xdc\xc4\xe8\x51\x21\xc1\x27\xa8\x93\x26\x16\x24\x62\xb8\x63\x5f\xbc\x64\x65\x20\x72\x21\xe1\x5e\xce\x7a\x3f\x7c\xd7\x9a\x78\xf9\x22\x29\x8f\xb4\x75\xb9\x8d\x85\x67\x6f\x6a\x43\x09\x64\xb7\xd4\x11\xa9\x51\x27\x53\x05\x8c\x50\xaa\x96\x71\xe1\x27\x80\xe6\xa7\x96\xac\xe1\x66\xe8\xa5\x3d\x5a\x5b\x95\xe4\x53\x57\x13\xcd\xd5\xc3\x77\x16\xa0\x10\xc8\xf4\x5f\xfb\xb3\x94\xbe\xec\x0f\x1b\x40\x91\xb9\xe2\x4f\xd7\xd6\x49\x4b\x16\x6e\x5e\x29\xb2\x16\x5d\x46\x6b\x63\xb5\x5d\x33\x97\x8b\xca\x71\xd9\x5c\x04\xab\xb5\x0f\x9e\x59\x98\x6b\x2d\x55\x65\xb5\xfc\x3d\x5a\xf9\x3c\xad\x7c\x9e\x56\x3e\x4f\xdf\x08\x3f\x27\x1e\x95\x0a\x77\x74\x2a\x7a\xda\xdb\x28\xfa\x47\x32\x24\x5c\x7d\x15\xfd\x92\x8e\xfb\x44\x19\xfa\xfe\x57\xf2\xec\xf4\x7b\x8a\x1a\xfa\x49\x51\xf8\x94\xcc\x90\x43\xb8\x59\x12\x53\x70\xf2\xe8\x81\x7f\x11\x9f\x01\xfc\xcf\xf9\x39\x59\xbf\xcb\x64\x3c\x26\x44\xfc\x7e\x48\x5f\x7a\x4a\xfd\x18\x7f\x8c\x7e\xc9\x89\xa4\xfb\xfe\x7c\x51\x9f\xad\x1b\x4a\x49\xe9\xde\xca\x92\x3d\x21\x99\x69\x10\xa0\x2a\x3a\x4f\xe9\x04\xa0\x79\xe8\x60\x4b\x1b\x8d\x88\x2a\x28\x32\xc4\xc3\xf4\x89\xc7\x44\xd9\x25\x9b\xe5\xfc\xb2\xa0\x56\x04\x76\x0a\x51\x78\x62\x54\xf2\x8d\xeb\xab\xf4\x53\x32\xb8\x66\xe0\xb8\xbd\x88\x9f\x9b\x3b\x36\xb6\xd0\xcd\x32\x17\x99\xf9\x94\x43\x1b\x7a\x0a\x82\x1b\x7e\xf4\x63\x27\x30\x90\xc3\x16\x8f\xcc\x91\xea\x43\x4b\x9e\x53\xb5\x10\x4b\x8c\x7e\x20\x65\xea\xc9\xec\x60\x8c\xa1\xdd\x96\xa7\x31\x0e\x34\x98\x09\xf3\x70\x48\xcf\x08\xd7\x69\xdb\x9b\xee\x16\x69\x11\x73\xb1\x44\x63\x34\x69\x3e\x12\x60\x02\x7c\x57\x0e\x1f\xb6\xad\xb3\xa1\x6b\xbe\x77\xd9\x60\x2b\x8e\xf0\x64\x30\x6f\x66\xe5\xf2\xfc\x97\x5b\x2d\x3a\xc0\x06\x84\x3b\xc8\x32\x02\x97\x03\x89\xbc\x89\x41\xe4\x56\x2a\x12\x1c\x94\x8c\xe3\xdf\x88\xc8\xce\xe3\x41\x34\xb8\xca\xe2\x0b\x32\x42\x79\x06\xae\x4e\xd0\x1c\x1f\xff\xaa\x25\xc6\x4d\x20\x37\x85\x5d\x84\x82\x89\x0b\xb1\xa2\x79\x1b\x4c\xe5\x84\xf3\xa4\x3c\x94\xd6\x2c\x24\x21\x1f\x8c\x33\xb2\x07\x0a\x0f\xf2\xa7\xda\x0d\xf9\x08\x0b\xeb\x55\xc0\xe1\x79\xdd\x79\xaf\xfb\xc0\xdf\x84\x2c\x88\x74\xee\x8f\x58\x94\x71\x87\x59\x92\xac\x17\xcc\xee\xd5\xf3\x04\xab\x60\xfb\x4c\x70\x27\x61\xe6\xe9\x0e\xf5\x7c\xe9\xb3\x99\xcf\xec\x57\x64\xaa\x7b\xed\x86\xd5\xa9\x93\xf1\xc2\x95\xb0\x26\xa0\x2c\x76\x5e\x14\x72\x0a\x6b\x9c\xf9\xb2\x72\xeb\xd1\x13\xd6\x3b\x5a\x2b\x17\x16\x70\x36\xc0\xff\xe4\x60\x3e\x55\x19\xff\x24\x24\x94\x1b\x2d\xd6\x9b\x9e\xd1\x3b\x24\x48\xad\xf8\xc1\x4c\xad\xc0\xe1\xc9\x03\x5c\xe6\x6a\xcd\x1e\x40\x7e\x4e\x53\x41\x8b\xdb\xdb\x7e\x44\xa8\x13\x46\xaf\x91\x6f\x46\x57\x96\x5b\xf7\x22\x3e\x4f\xd5\xbc\x94\xc3\x32\x8e\x6d\xe1\xd9\x24\x28\x46\xa3\x56\xcf\x3b\x82\xce\xe9\xa9\x56\xee\x5a\x28\xf7\x06\x03\x76\x77\x5a\x9e\x04\xc0\xb9\x75\x01\x37\xaa\x67\x13\xfa\x81\xef\xaf\xa9\xcc\x4c\x49\x8f\x60\xb9\xcf\x3f\x72\x95\x9b\x67\x5e\xea\x6a\x4c\xa6\x5c\x9c\x7e\x99\x9f\x17\x7b\xe5\xdb\x92\xec\x01\x54\x00\xe3\xc4\xa5\x48\xc6\x43\xe4\xb3\x98\x42\x78\xc0\xe6\x0d\x4b\x78\x2a\x8d\x69\x02\x14\xaa\xf7\x5c\xf5\x17\xa1\x55\x48\x0d\xcc\xe8\x0e\xf5\x18\xf1\x55\xa8\x9d\xb0\xa2\x51\x61\x74\x28\xe2\xa6\x1d\x43\xb8\x42\xc3\x5d\xaf\x84\xa5\x10\xa4\x61\x35\xb2\x37\x44\x3a\xe0\xb7\x68\xdc\x1b\x7b\x82\xf4\x20\xcf\x5a\x3c\x60\x11\xa0\xcf\x87\x83\x5e\xe2\xa1\xee\x6c\xe2\xa5\xf0\x1f\xa7\xd1\x39\xbb\xdd\x6e\xf4\xeb\x8c\xa5\x6d\x83\xc6\x61\x51\x73\xdb\xaa\xea\x02\xec\x84\x04\xd7\x71\x26\x98\xb2\xbd\x5c\x64\xaf\x6b\x6c\x36\x0c\x0a\xb5\xf8\xb0\xcb\x47\xc1\x41\x81\x58\x7c\x02\xc7\x9d\x25\xad\x17\x0b\xe5\xa6\xfa\xf2\x73\x71\x92\x8f\xc7\x8c\xbb\xc3\xe7\xa1\x39\xc5\xaa\x3a\xac\xe9\x85\xe8\xaa\xf4\xd0\x2c\x7b\x25\x2f\xb0\x69\x1c\x9e\xd7\xf1\x38\xe7\xbe\xdc\xcd\x7f\xc2\x49\x14\x66\x90\x20\x18\xc6\x13\xde\x40\x15\xe2\xa7\x27\x52\x5b\x8e\xa1\xae\x29\x6f\x2f\xc8\xd7\xd5\x55\x41\x4a\x92\xea\xec\xf1\x89\xf1\xa2\xaa\xad\x27\x0a\x79\x18\x7f\x39\xf4\x85\xdf\x32\x27\xdd\x5c\x16\x40\xda\x15\x33\xd1\x21\x48\xf2\x37\x84\xb1\x41\x5e\x23\x1d\x60\xd3\xa7\xc7\xca\x82\xfd\x24\x81\xb3\x69\x6a\x0e\xea\xc2\x7c\xa0\xa4\x41\x94\x85\x09\xbf\x9c\x7b\xc2\x68\x4f\x67\xcd\xc1\xe0\xd4\x80\xbc\xee\x44\xda\xf7\x4e\x35\x63\xf8\x17\x35\x08\x36\x3b\xb0\xa3\xd3\x4d\x57\xeb\xf4\xae\x6a\xd7\x8b\x1c\xdb\x88\x4e\x64\x9e\x23\x4b\xd1\x82\x1c\x20\x77\xb8\xf1\x63\x1f\xbc\x34\x48\x25\xf4\xf6\xe5\xbe\x2f\x74\x0e\x5d\x5a\xd8\x71\x23\xe6\xe3\xa4\xd1\x11\x3b\xc2\x98\xcb\xa8\xef\x34\xc6\xd7\x9b\x95\xf1\x73\x00\x1d\x4f\x6f\x06\x0d\xf8\xe1\x89\x5a\xeb\x2f\x33\x55\x1b\xa7\x6a\x07\x68\xec\xfa\xb0\x62\x16\x15\xf5\x17\x58\x11\xc1\x67\x9e\x78\x1c\x72\xaa\x47\x8f\xed\x0d\x90\x08\x22\x06\x1a\xd2\x7e\x2d\x60\xb5\x5e\xb8\x61\x54\x17\x09\xf9\x77\x7d\x6f\x21\xb8\x19\xf7\x90\x50\x49\xd8\xb1\x57\x99\x14\xe7\x95\xfa\xf5\xa5\x5e\xf0\x9d\xf9\x97\x94\xb3\x39\x16\x13\x81\x1a\x37\xe9\x78\xa6\x9d\x91\xcd\xc9\x8b\x8a\xa7\x9a\x1a\x1c\x11\x29\xeb\x3d\x01\xd4\xa4\x19\xb0\xe4\xc0\xb9\xff\x04\xb5\x5b\x09\x25\x39\x4d\xe8\xe9\x32\x6c\xe0\x27\x44\xa5\xa5\xf7\x1c\x24\x87\x41\x30\x6d\x1a\xba\x09\xe6\x92\x1d\xa0\xb7\xaa\x91\xd0\x0c\x4a\x41\x69\xd0\xaa\xd7\x99\x1d\x58\x22\x34\xcb\xe2\x02\x5c\xd8\xe9\xd1\xb5\x13\x7f\x93\xc1\x9b\x8f\xbd\x54\xeb\x09\x5c\xd3\xe1\x47\x51\xc0\xaf\x00\x28\x1d\xaf\x2e\x0c\x46\x63\x75\xfb\x12\x8f\xc9\xa8\x0f\xae\xaa\x50\x73\xd5\x4e\x06\x09\x0b\x2c\xdb\x5f\x80\xef\xf5\x8d\x98\xd8\x7c\x35\xa7\x03\x4c\x03\x03\x17\x99\x62\x01\x33\x32\x8a\x5f\x25\x75\xd8\x52\xe9\xa3\x1a\x5d\xfd\x41\x99\x66\x54\x49\x2f\x2e\x92\x41\x4a\x94\xea\xf1\x55\xed\xd4\xec\xcf\x31\x0f\x55\x95\x95\xb6\xd8\xc6\x5c\xb4\x34\xea\xd4\xab\x39\x4e\xbf\x76\x63\xf1\xd5\x95\x04\x46\x21\xc5\x97\xea\xd2\x4a\x46\xee\x54\xec\xf4\x75\x82\x5d\x12\x51\x3b\x62\x6f\x8c\xb5\xc5\x07\x25\x91\xc1\x35\xdc\x2b\xc9\x43\x1d\x65\x98\x31\x87\x2d\x4b\x2b\x9f\x8b\x28\xaa\xf6\xd5\x80\x36\x96\x8e\x99\x4f\x84\x8a\xa9\x53\xa2\xf1\x02\x4c\x83\xc3\x5d\x90\x2d\x9f\x98\xec\x71\x71\x95\xf5\x6d\x82\x7b\x95\x72\x6c\x3d\x9d\x65\x34\x50\x99\x46\x53\x45\x2d\x75\x8d\x84\x7b\x35\x50\xaf\xee\x36\x69\x2b\x78\xef\x6c\x0d\x16\x88\xc0\xa0\x31\xf2\x2b\xa8\xa8\x73\xad\xac\xcb\xa9\x4c\x18\xfa\x55\xdd\xad\x20\x32\x90\x07\xc3\x6a\x61\x4a\x0b\x19\x57\xe6\x2a\xe1\x21\x75\xe1\xc4\x17\x89\x65\x5f\x55\x70\x39\x4a\xa8\xaf\x28\x27\xe3\x7a\xc4\x16\xb7\x82\x14\x74\x2c\x6f\xa8\xfe\x66\x4f\x60\xb3\x98\x7f\xcd\xc7\x86\x98\xb7\x61\xb2\x91\x9d\x42\xfe\x2b\x8c\xf1\x6c\xec\x53\x09\x92\x86\x41\x9f\x2c\xb0\x41\x49\x88\x54\x71\xef\xd9\x8e\xd3\xea\x7a\xb0\x6c\x7b\x50\xa7\xe8\xd7\x25\x72\xc7\x0b\x05\x6c\x95\x9d\x74\x99\x83\xb3\xad\xc8\xea\x7c\x2f\x7a\xf0\x8c\xbd\xe8\x71\xae\x6f\xaf\x77\x99\x8a\xd6\x8f\x45\x3a\x15\x1a\xf3\x16\x62\x11\x10\x99\x46\x6a\xc9\xf2\x59\x21\x73\xab\x70\x57\x41\xd0\x03\xcd\x10\xd8\xac\x1a\xbe\x85\x43\x63\xb3\x23\xb4\xa1\xb1\x30\xf3\xea\x8a\x47\x08\x03\x7b\xee\x5d\x40\x55\x0d\xd8\x64\x19\xac\x81\x99\x19\x9b\xaf\x79\x96\x8b\x11\x1b\x2e\xcb\xc5\x28\xcc\xc1\x48\xba\x0f\xed\x6c\x36\x77\x1f\x6a\x41\xa4\x5b\x38\x22\xed\x97\x2d\xdd\x4a\xca\xfe\x5e\xb9\x15\xad\xdc\x8a\xfe\x3c\x6e\x45\xca\x54\x95\x2e\x46\xd5\x81\xe2\x72\xe2\x29\xf1\x52\xf3\x78\xff\x04\x44\x54\x9c\x2f\x16\x53\x36\x4c\xcf\xd1\x02\xec\x93\x55\xe2\xe0\x2c\xce\x74\x9f\xa1\x94\xbc\xa9\xf1\xfe\xe1\x17\x59\xe8\x01\x1e\x25\x6a\xc4\xae\x16\x32\xff\x9f\x33\x82\x01\x0b\x7e\xa8\x16\xd2\xbb\xcf\xcf\x4d\x58\xb1\xa7\xe9\xb9\x71\xae\xab\x03\xf3\x73\x14\x76\xec\xf7\x99\x81\x7e\x96\x85\xae\x75\x60\x19\x38\xbd\x02\xf0\xdf\x23\xa3\x27\x29\x0e\x3c\x34\x8d\x97\xf5\xc9\x1f\x71\xa4\xcc\x65\x25\x02\xde\xb9\x36\x50\x5b\x6d\xf1\x86\x08\x4c\xe6\x4d\xa4\x1c\x70\x6b\xed\x9e\x55\xef\x5d\x8d\x9f\xa9\x45\x61\xc7\xcc\xb3\xcd\x82\x9f\x85\xf5\x8d\x1b\x80\xf0\x8f\x49\x3c\x25\xd2\xc6\x55\x94\xc6\xef\x48\x7f\x4b\x06\xf8\xe7\x22\x1e\x26\xee\x04\xf4\x66\x7f\x5f\xe7\x6f\x18\x26\xb7\xd0\xe7\x9b\xee\x16\x38\x28\x3c\x4b\x86\xf1\x6c\x8c\xfa\x29\x04\xf7\x0d\x34\x42\xb3\x7d\xe9\x6e\xe6\xf3\x22\x62\xd3\xbb\x3b\x50\x70\x70\x6f\xf5\x94\x72\x6e\xd7\x0a\x15\xcd\xfa\xce\x7f\x99\x5e\x3b\x23\x8d\x30\x95\x1b\xea\x75\xcc\x30\x0d\x1f\x5f\x13\x0a\x5c\x10\x41\x85\x1c\xf8\x29\xf9\xd4\x90\x84\xc6\x7c\xf8\x7a\xc9\x68\x20\xfa\xf5\x90\xd2\x5c\xa7\x18\x09\x0b\xe5\xa6\x15\x44\x0a\xd6\x92\xd2\x80\x0f\x40\x5f\x3a\xb3\x4a\xe7\x54\x58\xc9\x7e\x7a\xfe\xaf\xda\x05\x8c\xd6\x0a\x7b\x7d\xff\xd2\x25\x5c\x00\xb4\x04\x7c\xee\x8b\xd0\x45\xfd\x8a\x65\x37\x8c\x1d\x47\x22\x8e\x03\xaa\x33\x96\xe2\x5b\x47\x1f\x89\xf0\xd3\xe5\xc8\x5e\x9f\x26\xfe\x46\xad\x8e\x6a\x49\x5f\x6e\x02\xd1\x12\xc5\x98\x87\xd2\xc7\x7d\xc3\x6a\x77\x5c\x6a\xb3\x65\x4e\x1a\x8d\xe8\x05\xb9\xe8\x22\x3d\x1f\xb1\x2d\xae\x4c\x98\xc8\x4f\x63\xdc\x58\x95\x79\x2d\x4e\xa4\x89\x3a\x8c\x4e\x5a\xe7\x31\x99\x19\x44\x11\x85\xf8\x49\xe4\x6f\xf8\x87\xb2\x10\xfc\x91\xe5\x19\xf9\x80\x44\x53\xfa\x90\xe0\x97\x81\x8c\x78\x4a\x04\x6c\x8e\x88\x4a\xae\xca\xb5\x5a\x1f\x2b\xee\xbf\xcf\x92\x7e\x7a\x11\x8f\xdb\x2a\x40\x50\x90\x18\x87\x83\xdc\x57\x31\x29\x95\x7c\x55\x5f\x64\x6e\x62\xed\x3b\xa7\x28\x32\x4c\x4d\x27\xa8\xa7\x8a\xb0\x99\xf7\xf5\x4d\x35\x17\x3e\x93\xf8\xea\x82\x45\x04\x31\x91\xe2\x5f\xdc\xa8\x70\x80\x5a\x7c\x44\x13\xe1\x48\x0d\x93\x84\x23\x54\x7e\x82\xac\x65\x11\x79\x61\xe3\xb1\xb1\xa1\xc0\x83\x71\x4c\xd9\x2f\xa8\x35\x21\x06\x29\xbd\xa0\x73\xf6\xd2\xe2\x2b\xa9\x25\x5a\xba\x09\xa9\xc5\x37\xbb\x96\xd8\xa2\x6e\x83\x05\xbf\xe8\xc0\x65\x56\xe0\x16\xb8\xb9\xb4\xfa\x84\x0b\x27\x01\x50\xbb\xd9\xad\x95\x4f\xe5\x27\x54\xc1\x2c\x3f\x75\x55\x1d\xf3\x8e\x30\x58\xdb\x23\x67\x40\xca\x28\xc1\xb9\x18\x39\x1d\x00\x8b\xf0\x40\x20\x14\x02\xd3\x60\x3d\x35\x2d\x5a\xe0\x68\xb3\x26\x94\x75\x54\xf8\xa9\x4b\x67\x01\x5e\x9a\x7e\x42\x8a\x40\x56\x72\xb4\x00\xf9\x80\x83\xd3\x39\xa8\x94\xa9\x84\x81\xf2\x1d\x29\x2a\x2e\x12\xa1\xe5\xd0\x20\x6f\xc2\x58\x6d\xdf\x87\x15\x4f\xf3\x70\x3c\x35\xfa\x4d\xca\x25\x71\xfe\x11\xab\x2d\x6c\x02\xf0\xa6\x97\x37\x0b\xcc\xd6\x8d\xc0\xdb\xf4\x23\x3a\x23\xf8\xb7\xd0\x69\x81\x81\xdb\x5c\x83\x40\x61\x13\x44\x80\x35\x99\x25\xce\x32\x6e\x2c\xea\xe6\x8b\x80\xeb\xcf\x2e\x66\x63\xa2\x0d\x7c\x4c\x7e\x8c\x8b\x77\x05\x8d\x09\xe4\xaa\xd3\x82\x75\x55\x7a\x5e\x5b\xd5\x79\x55\x81\x4d\x22\xdd\x51\x58\x14\x81\x13\x25\xd7\x66\xb5\x82\xa0\x96\xd1\xea\xb1\xf9\x11\x14\x12\x72\x0d\x4a\x2d\x72\xde\xc4\x11\x5a\x78\x1e\x53\xce\x5a\x64\xe6\x52\x6b\x4a\xd8\x64\xa5\x6d\x2d\x38\x55\xf5\xe6\x6c\xa3\x08\xb2\xd7\x05\x65\x4c\xc4\xa4\xa7\xa9\x6d\x4c\xab\x34\xf9\xd1\xb2\xc0\x41\x2f\xd3\x8b\xb4\x44\x98\x4c\x07\x30\x47\x49\x7e\x75\xb0\xa8\xf6\x1d\x2f\x5c\xa4\xbf\x61\x0b\x4d\xf5\x11\x2f\x06\x99\x58\x8a\x32\xbe\x98\x38\xcb\x4a\x08\x4c\x72\x30\x90\xac\x56\x5c\x69\x70\xae\x96\x2a\x53\x37\x86\xe9\x20\x1d\x0e\xd3\x3e\xd9\xad\x5c\xa1\x4b\x96\x09\x64\x62\x0b\x89\x3a\x59\x7f\xf2\x32\x1e\x3f\x0b\xa9\xcc\x80\x0c\x11\x0c\xbc\x60\x25\xe8\x1c\xe2\xc1\x86\x43\x94\xd9\xb4\x4c\x70\x9b\x88\x68\xdb\xbc\xcb\x44\x0b\x78\xdc\xee\x34\x71\x82\xa9\x6e\xac\x86\x45\xe4\xca\x99\x61\x33\x9e\x4f\xaa\x10\xe9\x16\x28\x3e\x54\xa1\xe8\x15\x1e\xa4\x4a\x64\xcf\x6d\x4b\x10\xb3\x6d\x54\x8a\xa8\xad\x6a\x32\x04\x13\xe6\x30\x52\xe4\xbd\xb6\x08\xdb\x14\x36\x20\x90\x49\x62\xd6\x81\x4c\x47\x00\xb1\x56\x59\xbc\xad\x80\x75\x1b\xad\xcd\xd1\x2a\xf9\xbf\xaf\x35\xf1\xd9\xd1\x8a\x2c\xed\xda\x68\xe9\xde\x15\x73\x99\x86\x2e\x47\x69\x01\xab\xd3\x04\x12\x92\x2d\xc3\x36\xf4\x86\x54\x34\x87\x51\x28\x80\x07\xeb\xac\x42\x5a\xcb\x1a\xff\x4d\x44\x22\x37\x4b\xf8\xc1\x17\x30\x48\xd0\xdb\xf2\x8f\x35\xab\xb8\xfa\xc9\x1c\x5f\xfa\xad\x2c\xc7\xe8\xa6\x59\x7c\x44\x0b\x41\x28\xb1\xe3\x1c\x52\xb2\xe1\x96\x06\x13\x08\xad\x64\x32\x4d\xf3\x69\xaa\x48\x69\xab\x06\x01\x61\xb3\x0e\xe9\xf4\xd0\xbe\x93\x1a\x19\xa2\x93\xc9\x6d\xd6\x13\x16\x34\x18\x15\xb9\x0a\x00\xc1\x45\x7d\x7a\x12\x9d\x28\x8f\xa7\x51\xcf\xf6\x08\xb5\x84\x25\xa0\xc6\x8e\xbc\xd9\xe9\x37\x84\xb5\xa0\x41\x07\x2c\x8b\xa1\xaf\xe9\xe0\x3b\xec\xe0\xc6\xcf\x98\x3d\xca\xb3\xf1\x15\x4b\xf9\x5d\xe2\xf7\xee\xc5\x3d\x77\x76\x9f\x1d\x8b\x1d\x21\x2e\xbb\x93\x9e\x1a\xc1\x1f\xd0\x6b\xee\x6e\xeb\x09\x74\x65\xe1\xa5\x42\x7a\x8c\xd0\x79\xcd\x2f\xac\x05\x2e\x1e\xc1\x33\x78\x41\xa5\xb3\xf1\x7c\xa5\x83\x9c\x7c\x9a\xa4\xd3\x2b\x44\x62\x2a\x5f\xd1\x49\x53\x30\xdb\x24\x5a\xac\x40\xec\x91\xd6\x1c\x37\xca\xf8\x66\x38\x9d\xba\x98\xca\x6a\x40\x20\xea\x98\x2a\x76\x8e\xe2\x4b\xc1\xdb\xfc\x8d\x1f\x5e\x69\x70\xaf\xe8\xa7\xa9\x25\xc3\xac\xd2\xaa\xae\xf6\x8f\x22\xcf\xf4\x12\xd8\x85\x73\xd9\xcf\xaa\x4d\x9a\x72\x91\xb0\x46\x91\x78\x85\x26\x29\xbc\xd8\x94\xa7\x12\x4a\x95\x49\xf5\x22\x09\x13\x3a\x6e\xe8\x79\xa4\x88\x71\x9e\xca\xe8\xbe\xcc\x29\x2f\xd7\x37\xd3\xa0\xae\x4d\x19\x57\x9e\x32\x5a\x9a\xf9\x21\x81\x63\x2b\xb8\x24\x49\x58\x44\x69\x01\x50\xc2\x0a\x3f\x43\x86\xcd\x36\x4d\x97\xce\xdf\x3c\x4b\xa7\x70\xaf\xd0\x7b\x06\xcd\x53\x24\xd0\x12\xbc\x0d\x3b\x63\x7a\x75\xec\xad\xaa\xe9\x7d\xd1\x3b\x89\x9c\xaf\x21\x3c\x53\x08\x52\x75\x93\x4a\x39\xf6\x8e\xba\x0d\x33\xa1\xe9\x29\xcc\xf3\xa9\x8a\xd2\x9a\x2b\x98\x19\x47\xa6\x92\x80\x6f\xaf\xb2\x3e\xe1\x76\x97\x59\x0c\xcd\xaf\x4a\x27\x81\x27\xf7\xaa\x2b\xae\x39\xd2\x0b\x1e\x20\x9d\x5e\x25\x02\x34\xe2\x82\x19\x02\x50\x6b\x10\x0e\xea\x88\x57\xcf\xe3\xc4\x07\xd4\x68\x42\x3a\x2a\x1c\xa5\xe7\x23\xb2\xdf\x0e\xa8\xd0\x84\xc4\x26\x9d\xd6\x97\xb7\x59\x9c\x8e\x03\xfb\x5d\xc1\xfa\x3b\x1e\x52\xa7\x05\xea\xef\x7a\x48\x95\x16\x28\xbe\x39\xe7\xd0\x1f\xb2\xfc\x32\x7b\x5b\x82\x67\x97\x2f\xa1\x8e\x02\xe6\x6e\x59\xad\x0b\xd9\x28\x73\xa8\xc9\x0c\xa2\x5a\xd5\xd5\xa5\x42\x85\x1b\xee\xbc\x61\xfc\xeb\x32\x68\xd7\xf9\x98\xf5\x6a\x21\x3a\x48\x85\x9e\x9a\x42\xab\x70\xf9\x2d\xf5\xfc\x9f\xb1\xaa\x34\x87\x96\x1e\xf2\x0e\x2b\x84\x99\x43\x7a\xee\x4f\x58\x15\xe6\xb2\xd5\xc3\x5f\x63\x45\x35\x0d\xb1\x87\xbc\x33\x0a\xb9\x5c\x4d\x7b\xce\x2f\x68\x05\x78\xa7\xdd\xdf\xea\x2a\x31\x4f\x3f\x90\xba\x4c\x10\xbc\x63\x9a\x95\xb6\x87\xbe\x45\x0b\xaa\x86\x99\x1e\xf2\x0e\x2d\x64\x10\x1f\x79\x89\x16\x33\xd7\xb4\x9e\xe3\x3d\xbe\x3e\xea\x37\x36\x98\x3f\x06\x44\x56\x7f\xd8\xc1\x03\xaa\xc3\x45\x0e\xd0\x37\xe0\x0a\xc7\x4e\x75\x85\x63\x6b\x15\x01\x76\x75\x55\x63\x75\x55\x03\xbf\xaa\xc1\xe7\xc5\x92\x13\x5f\xfb\x2f\x5b\xcc\x91\xc8\xba\xe9\xfd\x0c\x1a\x0e\xc2\x88\xd1\xca\xde\xdd\x4e\x8e\x6c\x37\xdd\xeb\x73\x58\x93\x65\x78\x03\xc2\xa1\x1d\xeb\x1e\x2f\x82\x42\x72\xcc\x42\x32\x5b\x8b\x9b\xbf\xba\x45\x85\xcc\xce\xb0\x94\xd6\x00\x09\x5b\xe1\xb4\x30\x77\x0f\xec\x3b\x5b\xfb\x79\x1e\x64\x25\x85\xf5\x72\x13\x11\x57\xed\xf1\xa5\x52\x6d\x90\xbd\xba\xb1\x16\x21\x5a\x47\x19\x53\x63\x11\x6d\x4e\x3c\xa3\xc0\x93\xf8\x2a\x3e\x1b\x27\x02\x96\x3f\xa2\xa0\x37\x94\xcf\x5b\xd7\x74\x4d\x2e\xd0\x92\x37\x51\xa7\xc2\x2a\x0c\x8b\x6a\x3e\x98\x9e\x23\xd9\x52\x1d\xc9\x9a\x68\x9e\xa5\x80\x14\x4d\x62\xb7\x0e\xc0\x93\x7c\xd2\x5e\xff\x8e\x86\x3e\xa5\x4b\x20\x0b\xf1\x01\x89\xca\x68\x42\xee\x3b\xce\x8d\x6a\xd3\xee\xa9\x3a\x7a\x58\x17\xd5\xce\xfc\x60\x71\xb9\xf8\xf2\xb7\xbf\x45\xd2\x3a\xce\x83\x2c\x35\x27\x85\x19\x77\xd3\xb3\xa1\x68\x57\x64\x5b\x02\xdd\xdc\xe9\x92\x78\xa4\x61\x90\x42\x4a\x4c\x1b\x7e\x9c\x4f\x8d\xc9\x22\xab\x15\xac\xf2\xfd\x7c\x3a\x0d\x49\x9e\x44\xad\x21\x64\x65\xd8\x23\x98\xa2\x26\x64\x99\x8d\x9d\x57\xae\x03\x51\xfb\x4a\x11\xfd\x4e\xad\x2b\xd7\x80\x13\xd1\x20\x58\xa4\x01\x87\x04\xf4\x30\x86\x8a\x4b\x3d\x47\x54\xe6\x36\x06\x4e\x87\xc1\x72\xd7\x8c\x5d\x27\x0a\x3c\xb8\x05\xb5\xc7\x6b\x91\x9b\xa9\xae\x43\x33\xd0\x48\x7a\x52\xd5\x28\xa6\xe1\x83\x08\x31\x90\xa0\x36\x9c\x6b\xee\xb4\xdb\x26\xe7\xad\xcb\x54\xb2\x60\xa4\xb3\x4b\xc2\xcf\x38\x84\xe7\x65\x58\xa2\xc3\xfa\x42\xd5\xb1\xbf\x56\xd0\x2e\x55\x1b\x5c\x8c\xda\x15\x05\x45\xc5\x54\x01\xaf\x08\xc7\x54\x73\x44\xfe\x00\x8b\x1b\x5b\xcf\xbb\x07\xcc\xda\xc6\xf0\x3b\x1c\x8a\xb1\x87\xfa\x6d\x83\x63\xf3\x29\x61\xa4\xf6\x13\x86\x6e\xea\xf1\x8d\x32\x2c\x3a\x05\xca\xfc\x0d\x2b\xe8\xe3\x7f\x6b\x85\x47\x32\x9b\x3a\xd2\xfc\x11\x15\x52\x60\x86\xb8\xd4\x87\x4d\x0d\x89\x63\xd8\xbc\xa8\xc2\xbd\x59\x61\xef\x1a\x09\xd2\xf9\xe5\x68\x85\x01\x5a\xa8\xce\x6a\x4b\x30\x52\xa5\x01\xeb\xa9\x51\x48\xf3\xac\xf7\x65\xa6\x16\x80\x3c\x4f\xac\x23\xd9\x34\x96\x33\x98\x07\x67\x50\x88\xd3\x89\x30\x54\xe6\xf4\xa7\xb6\x39\x99\xc6\xd8\x12\xe3\x2b\x91\xab\x65\x61\x14\xd2\xca\x10\x50\x53\x73\x18\x27\xca\xa2\x1a\x27\xba\xee\xa7\x82\xd2\xdd\xae\xb4\xaa\x75\x9e\x0a\x76\xb3\x13\x3d\xf4\x59\xde\x3d\xed\xcf\xb2\x09\xd1\x89\x0e\xd9\x11\xaa\x76\x95\x84\xbe\xc2\xcd\xf0\x8e\x6f\x15\xa2\x75\xd6\xcb\x5c\xb4\xc7\xfe\x90\x93\xe7\x71\xb4\x1d\x3d\x11\x2f\x45\x9a\xdb\x48\x98\x52\xbe\xb3\x67\xa6\x4c\x49\xeb\xca\x72\xab\xea\xd1\x1d\x5e\x8f\x83\xdd\xb8\x19\x56\xcd\x61\x49\x90\xe1\x59\xe3\x37\xe1\x28\xbf\x61\xd2\x54\xd0\x38\x79\x18\x3c\x76\xad\x45\x6e\x41\x6b\x39\x10\xb4\x13\x54\x16\x76\xbb\x5d\x21\x0e\xf7\xcd\x6a\x5d\x5a\x85\x15\x9c\xfb\x80\xe9\x3a\xe3\xb8\xa8\x96\x65\x50\x33\x62\x59\x55\x87\x45\x35\x36\x5b\xd0\xea\x65\x71\x77\xa4\x81\x23\xd6\x62\xc0\x80\x4b\x8d\x16\x18\x59\x80\x4d\xe2\x82\x4e\xce\x51\x62\x54\x46\xd7\x38\x5a\x8e\x0f\x84\x7f\xf2\x71\x16\x3a\xbb\x2a\x93\x39\x16\x00\x8a\xb9\x77\xc6\xf1\x84\xd5\x20\x72\xe9\x9a\xa5\xce\x59\x60\x4c\x5a\x45\x95\xfa\x74\x3d\x54\x57\x12\x9b\x83\x9a\xdb\x2c\x08\xaf\x2b\x99\x5f\x79\x52\x48\x6d\x5f\x83\x0a\x75\x9a\x05\x53\xdf\x19\xa8\x65\x55\x3d\xdc\x59\xbe\x3a\xf0\x66\xe9\x29\xc5\xda\x29\x0b\x60\x67\x2d\x77\xbc\xf1\xcc\xaa\xa3\x40\xb9\xd6\xc0\xc6\x9b\xd1\x94\xb7\xd7\xd1\x10\xf7\x04\xf2\xa1\xe5\x55\x39\xd6\xc6\xa7\x39\x9a\x24\xd6\x1d\x55\x2c\x08\x2d\x24\x7c\xa9\x47\x38\x2a\x21\x4c\xc5\x15\x7b\x2d\xa8\x67\x87\x39\xcc\x5a\x87\x57\x02\x59\xd6\xc9\xc4\x11\x0c\x96\x22\x3d\xbd\x72\xb9\x88\x2a\x85\x69\xcc\xa2\x00\x8a\x51\xaa\x11\x1c\x69\xea\x0f\x77\x2c\x6b\x36\x73\x1f\x47\x58\x50\x6a\x34\xd6\xb6\xd9\x6b\x81\x9a\x57\xa5\x6e\xba\xf6\x87\xd8\x95\xea\x35\x01\xbd\x92\x39\xd6\x77\x03\x8b\xbf\x88\xcc\x09\x90\x19\x6a\x11\x98\x18\xbc\x08\xbf\x87\xf4\x43\xb4\x49\x4d\x0f\x9a\xb5\xc9\xb3\x43\x52\x7c\x12\xf6\xe3\x8c\x45\xe6\xcb\x06\x3c\x3b\x12\xb8\xe9\xe7\xd9\x3d\x61\xb3\xaa\x42\x7f\xd6\x49\x88\x7a\x49\xa6\xca\x20\x26\x2c\x8c\x11\x6f\x3b\xbc\x82\xac\xb6\xea\xab\x58\x4e\x7e\xe8\xa4\x28\xd3\x0b\xb2\x11\xf8\x31\xa6\xe7\x2e\xcd\xe7\x84\x52\x41\xf3\xf9\xa0\xb6\xbe\xd4\xb9\xf0\x65\x99\xb7\x29\x93\x28\x64\x98\x87\x41\x90\xe2\xf3\x30\xc7\x91\xc0\x2b\x89\xd8\x2e\x6d\x10\xd1\x4d\x1d\x3f\xbc\x31\x95\x50\x94\x1b\xc8\x16\xeb\x19\x29\xe3\x57\xd6\xcc\x16\x9a\xf3\x0d\x6f\xe6\xc6\x79\xa6\xe1\xd8\x0b\x47\x36\x2e\xbb\xa0\x6f\xcb\xda\xae\xf2\xc4\xe0\x11\x6c\xf4\x6a\x47\x41\xc9\x22\xee\x1f\x09\xb5\x56\x31\xce\xd8\xcc\x0f\x1b\x15\x3d\xc1\x7a\xfd\xfe\x95\x19\x40\x84\xf2\xa9\xa4\x62\x57\xf6\xb4\xcb\xa2\x1f\x3d\x73\x0a\x22\x9e\x48\xb8\x5e\xc3\xc3\xa2\xbe\xc5\xc9\x26\x1a\x6c\x4e\xb3\x63\x91\x1b\x7e\x31\x82\x55\xc6\x6f\x20\xd9\x74\xd2\x17\x39\x0f\x0a\x66\xed\x6b\xbe\x28\xf0\xf2\x18\x09\x6b\x5d\xa3\x3d\x94\xe2\xd5\xfe\x71\x97\x0a\xb3\x00\xf7\xfa\x7d\x6c\xef\x5a\x58\xae\x10\x11\x19\x1b\x65\x05\x64\x69\x11\xd9\x4e\x8c\x23\xbc\x27\x2c\x17\xdf\x7b\xe8\x57\x2b\xea\xf1\x27\x43\xaf\x68\x21\x59\x50\x04\x21\x7a\x55\x2a\x11\x1b\x48\xa6\x4a\xe1\xdd\x37\x93\xa4\xc0\x8f\x75\x54\xa4\x5c\x33\x96\xb6\x85\x58\x36\xf9\x94\xf4\xc1\xbb\x24\x5c\x61\x61\x05\xe6\x50\x56\x78\x4b\xb5\xdc\x57\x6a\x1a\xfe\x1d\x7d\x30\xac\xd1\x84\x30\xf6\xa0\x9c\x96\xe8\xc6\x22\xe2\x61\x0a\xaa\x8f\xb5\x3a\x85\x31\xae\xdd\x78\x32\x19\x5f\xf1\x88\xec\x41\x33\xa2\x56\xf7\xe0\x19\xd7\x50\xae\x94\x31\xd0\x17\x6b\xb7\x39\x23\xb0\xdc\x2f\x8a\xc4\xcf\xa5\xc5\xaa\x96\x27\x44\xde\x98\x7d\xa4\x80\xb4\x98\x89\x8f\x73\x08\x2e\xb3\x7e\x8d\x87\x04\x96\x28\x2f\x55\x4c\xc7\x25\x11\x7d\xd4\xa5\x83\x5a\x46\x00\x54\xa2\x52\x8d\x68\x5f\x5f\x8e\x9b\xc2\xaa\x51\xac\x2d\x61\xef\x69\x51\x2e\xac\xad\x47\xdf\x0b\xb0\xde\x56\xaf\xea\xcb\x57\x3a\xa1\x88\x92\x0e\x8f\xee\x72\xd4\x18\xa6\x69\x2c\xb4\x9c\xf2\xca\x3a\x45\x63\xba\x3d\x1f\xae\x13\x05\xf2\x14\xcf\x82\x8a\x41\x82\x65\x84\x61\xec\x3f\xa6\x41\x0b\x9f\xb0\x75\x45\x2c\xfb\xeb\x6a\x6d\x74\x56\xa6\xd3\xfe\x6c\x1c\x4f\xef\x90\x9f\x6b\x2e\x59\x5e\xc0\x26\xdf\x4a\x68\xe1\x86\xa8\xbb\x28\xad\xf5\xa2\x6f\x55\x3f\x44\x38\x85\x00\xe7\xc4\x47\x1e\xe7\x44\x76\x62\x09\xcf\x0f\xfc\x51\xa7\xb7\x57\x2e\x8b\x2b\x97\xc5\x95\xcb\xa2\x4c\x5a\x0f\xa5\x78\xe4\xfc\xe0\xd4\xf5\x5f\x63\x2e\xfa\x40\x07\xc9\x1a\xef\x45\xc8\xca\x1d\xc1\xbd\xc1\x78\x0a\xf3\x03\x96\x78\x08\x39\xaf\x12\x09\x58\x18\x02\x3d\x24\x70\xab\x24\x4a\xb2\x8f\x05\x2f\x76\x06\xbe\x34\xaa\xc3\xb5\x92\x0b\xe8\x92\xac\x11\xf9\x25\xb5\x87\x2a\xa9\xd7\xc1\xe2\xc8\xbe\x74\xff\xf5\xea\xe5\x4f\xa4\x11\x9e\x96\xce\x12\xf6\xfa\x67\x82\x3e\x5a\x8c\x4a\x68\x9e\xec\x3b\x3d\xcf\x72\x90\x3a\xe3\x14\xd2\xb7\x92\xf7\x19\x11\xad\x8a\xc4\x46\x13\x3a\x58\xcd\x48\x2a\x7d\xba\x18\x03\x11\xf8\x2a\xdf\x5a\x0f\x6e\x59\x36\x68\x8e\xc4\xbf\x7e\x3a\xda\xd6\x5a\x18\x4d\xb7\x5b\xeb\xee\x8a\xdc\xba\x1a\xe0\xf1\x46\x8c\x8e\xed\xf5\x49\x75\x5f\xd8\x73\xd2\x94\x56\x2c\x1b\x2a\xf4\xc5\xa1\x64\x01\x6e\x5a\x8d\xaa\x26\x35\xca\xc1\xbf\x13\x82\x92\x10\x09\xd4\x81\x26\xa6\x1d\x7a\xc4\x48\x78\xc6\x0e\x4f\x4b\xd7\x52\x28\x42\xea\xa0\xff\x7c\xfe\x1c\xb5\xf8\x34\x1d\xe7\x04\x1d\x78\xd9\x7b\x78\x7f\xf7\x7e\xeb\x3b\xa4\x24\x6f\x06\xf4\x06\xfe\x17\x29\xbf\x89\x41\x02\x1e\xe0\xf2\x58\xe0\x99\xf1\x04\x82\xe0\x6f\xca\xff\x5c\x48\x11\x26\x6c\x30\x81\x35\x04\x8c\xe0\x3a\x1f\xd4\xea\xc1\xbc\x28\x02\x2e\xb5\xe0\xa7\x79\x3e\x4e\xe2\xec\x9a\xba\x5d\xc1\x24\x12\x9a\x66\x35\xb4\x66\x4e\x23\xc5\x7c\xa2\xe3\x53\x13\x37\x5d\x1d\x67\x45\x99\xd6\x91\xd4\x7d\x76\xa0\x65\x54\x8f\xe6\x58\xa2\xe6\x5a\xbc\x14\xfc\x2a\x2d\x9a\x12\x93\xcc\x09\x4b\x27\x54\xc0\x54\x96\x50\x38\xc4\xd4\xf3\x3c\x29\x97\xb4\xd6\x34\x52\x61\x1e\x5d\xe6\xfe\x8b\x21\x41\xd6\xee\xac\xdd\x22\xab\xe5\x71\xab\x53\xf1\x78\x87\x8d\x0a\x1a\x02\x4f\xf2\x28\x11\x78\x1a\x47\x3a\x4f\x6b\x61\xe5\x01\x67\x9f\xa7\x71\x41\x56\x42\x70\xf8\x01\x8c\x9f\xce\x40\xee\x2a\xf5\xdd\x8d\x5a\x3d\xe9\x0d\x24\x2b\xed\x96\x39\x8f\x49\xd3\x3a\x8b\x8b\xe4\xc1\x2e\x9a\xf2\x45\x74\xa7\xca\x06\xfa\x53\x12\x13\x46\x68\xb7\xf6\xe8\xb2\x97\xfe\x16\x33\xab\x05\x45\xa6\x26\x8c\xa6\xbb\x32\xd8\x91\x91\x95\xe2\x1e\x98\xd2\x20\x34\x25\x6c\x5a\xd3\x3e\xad\x7a\x03\x3c\x91\x2d\xdc\xa4\x7b\x88\xe0\x27\xd9\x64\xe3\x89\x7a\x11\x7f\x48\x68\xae\x1c\xcc\x5e\x86\xce\x4f\x10\x96\xe8\xac\x14\x5e\x20\x13\xcc\xd9\x4e\x4c\xbe\x2a\xb0\x1d\x78\x54\x34\x9b\x74\x54\x4c\x6b\xbe\xf4\xfc\x9c\xc2\x33\xd9\xc4\x3c\xd0\x27\x6c\x9b\xb9\x50\x5a\x33\xd1\x71\x58\x5d\x0d\x1d\xd9\xc7\xd1\x3b\xf6\x05\x65\x1e\xa2\x8d\x4b\x1c\xec\xeb\xd5\xe2\x94\xda\x99\x21\x14\xf3\xa4\x24\xbc\x90\x25\xec\x8c\x4d\xce\x9b\x20\xb7\x01\xe9\x77\x24\x90\x15\x79\xdc\x8f\x93\x4f\xb6\xc8\x71\x76\x94\x57\xa2\x04\x12\xe0\x37\x9c\x9d\xdd\x0b\xee\xda\x11\x47\xa8\x8d\x61\xb8\xa4\xeb\xa5\xf5\x16\x79\xca\xf0\x71\x63\x8e\xdf\xb3\xd7\x93\x10\xb6\xe7\x30\x62\x5b\x7b\x5d\x99\x75\x09\x03\x9d\x9f\x13\x0d\x71\x10\x11\x4e\x06\xc6\xa6\x8a\xbb\xcc\x69\x06\x24\x6f\x3e\x3b\x28\x96\xd8\x14\x51\xce\xe2\x9a\x4f\x16\xea\xdc\x8c\x58\x80\xb9\xac\xcf\x68\x76\xd0\x02\x6e\x09\xf7\x47\x71\x76\x5e\x63\x9d\x84\x1f\xbb\xfb\x2c\xb8\x80\x94\xa6\x77\x8c\xa9\x87\xdb\x2e\xac\x00\xe6\x62\x06\x5a\xf1\x96\xd3\xdb\x23\x84\xfd\x5d\xe5\x84\xa7\x08\xf7\x5e\xc1\x01\x3d\x3e\x2c\x8c\x12\xa1\xb3\x46\xfc\x02\x5c\x58\xe0\x27\xdd\x58\xe6\x9f\x47\xb2\x45\x57\xdf\x4c\xcf\x17\x37\xe2\xb5\xb9\xd1\x2b\x8e\xa8\x94\x90\x1a\x46\xd0\x5b\x2f\xba\x95\xf8\x3b\x66\x55\xb4\x55\x2d\xc6\x16\xb2\x5f\x58\x82\x9b\xe8\xfb\x84\xf8\xfc\xee\xf0\x95\x17\xe3\xf8\x0a\xe4\x06\x8b\x03\x46\x05\x19\xc8\x82\x60\x39\x96\x16\x1c\xb1\xc4\xb1\x38\x4b\xbd\x5a\x1c\x93\x99\xfa\x35\x58\x29\xb2\x56\x19\x0d\xe3\x94\xe8\x0f\xdd\xe8\x10\xac\x42\x97\x29\xd8\x38\x60\x41\x6d\x26\xb0\x14\x74\xfc\x8c\xe2\x18\x53\x69\xe2\x6d\x3b\xa6\x50\x3a\xe8\x45\x8f\xe4\x0f\x39\x11\x82\x1f\x28\x58\x64\xeb\xd7\x8b\x5a\xdb\xdd\x4d\xec\xfc\x09\x7e\xe2\x4c\xab\x95\x25\xe5\xfb\x71\x5a\x10\x75\x0d\x72\x0b\x39\xa0\xe5\x61\xd4\x29\x32\x89\x7c\x6e\x83\x84\xda\xcd\x96\x59\x71\x99\x09\x88\x1f\xca\x5e\x96\xed\x55\x1d\x1e\xc3\xee\x6a\x18\x4d\x61\x03\x4e\xfe\x7e\x48\xff\xd6\xb6\xfb\x60\x7c\xfd\xb6\x32\xa1\xee\xac\x4c\xa8\x2b\x13\xea\xca\x84\x8a\xde\xfa\xa6\x41\x99\x6e\xf3\xc6\xb7\xbc\x3f\xa6\xda\xd2\xce\xd2\x73\x76\xb1\x90\xa0\x62\x5c\x9a\x66\x5e\x04\x83\x97\xc9\x50\x5f\xc0\xd9\x8a\xd9\x61\x0e\xee\x0e\x35\x92\x2b\x42\x0c\xd4\x90\x48\x97\x23\xe8\x7e\x5b\xbf\xd4\xf0\x3d\xab\x8e\xf4\x66\xdb\x13\xfa\x86\x5e\xea\x81\x5d\x7c\x58\x30\xa5\x79\x76\x0a\x6f\xb8\xc5\x8a\xc8\x8e\x83\xa7\x7b\xaf\x39\xc7\x0d\xa2\x47\xdf\x12\x46\xba\x98\xcc\x58\x4e\x57\x82\x2d\x79\xfb\x11\x02\xa3\x51\x87\x9b\x74\x4a\x56\xc5\x5d\x48\x3a\x3b\xa5\x8b\x32\x73\x2b\x1b\xb0\xcb\x06\xe2\x62\xb1\x80\x1e\x27\xec\xa6\x7b\x99\x6b\x2d\xb3\x71\x28\xa2\xf6\x1e\xe9\xe6\xd6\x66\x27\x7a\x0a\xff\x6e\x75\xa2\x6e\xb7\xdb\x89\xfe\x87\x3c\xec\xdc\x5f\x07\xdb\x49\x54\x4c\x92\x7e\x3a\x4c\xd9\x0c\x3f\x78\x7b\xb8\xb5\xf3\x60\xeb\x41\xfd\xd5\x8d\xb4\xc8\x29\x24\xef\x22\xba\x43\x11\x3e\x45\x34\x42\x19\x20\x0b\x44\xc0\xb4\x84\x42\xf7\xa1\xe3\x5b\x85\x81\x05\x6f\xc6\x05\xd5\x71\xd0\x18\x0b\xda\x44\xf9\x09\x08\xd2\xda\x6b\x75\x81\xbc\xfb\xf9\x20\xd9\x2b\xdb\x9b\xd8\x11\x2a\xd0\xa8\xf5\x3f\x36\x9c\x61\x67\x62\xc1\xd7\xe8\xfc\x2b\xf3\x77\x93\x49\x32\xdd\x8f\x0b\xe4\x84\x55\x81\x2b\x66\x67\x84\x97\xdb\xbb\xeb\x22\x9a\x1a\x7f\x41\x46\x69\xd7\xe9\x14\xc3\x00\x89\xb8\x2c\xdb\xad\xd6\xba\x71\x39\x1e\xf7\x9d\x10\x91\x19\x60\x27\xe2\xed\x2e\x45\x10\x92\xb3\x03\xf0\x0f\x8f\x09\x89\xc8\x76\x89\x3e\x7c\xff\x38\xfa\x1f\x5f\xaa\x70\x84\xbb\x38\x73\xe1\x45\x64\xca\x3d\x52\xf7\x3d\x52\xfa\x2e\x29\x8d\xed\x0a\xbc\xf9\xb0\x79\x25\xe6\xf5\x2b\x5a\xd0\x98\xc4\xeb\xdd\x5f\xf3\x34\x03\x8a\x05\xcf\xd9\xfd\x78\x0c\x47\xcd\x20\x3f\x80\x17\x5f\x1d\x3e\x83\xe9\xba\xb5\x29\xa4\x3e\xbf\xd9\x43\xe7\x33\x32\x7d\xbe\xdd\x7c\xb0\x5b\x3f\x7b\x88\x10\x78\xf4\xed\xd6\x66\xed\xac\x71\x4c\x16\x24\x7d\xa7\x31\x35\x78\x03\x61\x53\x62\x9a\x5c\xc4\x69\xc6\x0e\x1d\x00\x08\x51\x41\x45\x88\x6b\x87\xf4\xe5\xe5\xab\xeb\xa1\xb8\xe0\x3d\xe3\x97\x73\xaa\x02\xf2\x66\xdf\x23\x5c\x87\xad\x10\xa3\x5b\xe0\x83\xac\x64\xe1\xc6\x3b\x64\x44\xd6\xa3\xff\x07\x43\x73\xd7\xaa\x8e\x05\x1a\xe7\xd7\x8d\x03\x6d\x40\xb2\x7a\x59\x59\xd5\x44\x30\xef\xd4\x87\x02\x61\x77\x8e\xa9\x34\xe0\x17\xc7\xa9\x27\x22\x7d\xc1\x6f\xdf\xf3\x85\xce\xcd\x43\x35\x7c\x62\x70\x02\x4f\x53\x5b\xc3\x06\xfc\xc6\x6a\x25\xa5\x9a\xf5\x59\x18\xb5\x82\x3b\x9c\x80\xde\x9a\xcc\x2e\x44\xc4\x8b\xda\x19\x03\xa5\xf6\x6c\x58\x8b\x1a\x48\x7d\xd5\x5e\x14\x68\x71\x2d\x57\x22\xff\x21\x0d\xc0\x76\x95\x56\xa3\xd0\x78\x9c\x71\xf1\x54\xc4\xe3\x54\x6e\xd9\xb3\x02\x84\xa7\x1e\x60\x8b\x0d\x9c\x17\xec\x3c\x80\x7b\xcf\xa4\x70\x75\x8e\xb0\x83\x02\x13\x3d\x6a\x90\xb0\x53\x35\xaa\x50\xb5\x59\x69\x52\xf7\x7d\x87\x51\x5f\xf6\xe5\x29\x04\x08\x65\xe5\xf5\xe5\xaa\x81\x7c\xe4\x41\x95\x15\x59\xf8\x14\x88\x09\x9e\x6a\x8a\x9a\x03\x0c\x15\xd1\x0c\x6b\x64\xa3\xcd\x85\x24\x3b\x7e\xd0\x6f\x3c\xbe\x19\x27\x04\x01\x50\x86\xf9\xb6\xe2\xfb\xf4\x87\xb5\x73\xaa\x4e\xb3\xd0\xe1\xc3\x6a\xbc\x8a\x51\xcc\xf6\x1b\x54\x23\xfa\xd4\x1f\xcf\x8a\x94\xaa\xe7\xd4\x54\x95\x9e\xa5\xe3\xb4\x94\xda\x39\xe9\xe9\x87\x8d\x33\xa2\x2e\x91\x9d\x2e\x51\xd1\x3f\xa6\x7d\xa1\x32\xc5\x2c\xf3\xdb\xda\xf7\x1b\xe9\x0f\x5d\x27\x2f\xc3\xde\x24\x11\x91\x1c\xa8\x4e\x32\x24\x1b\x57\xd8\x04\x8c\xcf\x21\xf8\xf6\xe8\x82\xec\x1b\x8a\x3e\xd9\x1e\x31\xd9\xcf\x95\xf8\x24\xeb\x5e\xa6\x1f\xd2\x09\xd9\x2a\xc6\x54\x93\x87\xa7\x0d\x22\x58\x92\x69\x16\xb3\x8b\xfd\xef\x9f\x12\xd4\xde\xf3\xfc\x73\xef\x19\x83\xfc\x9f\x1f\x79\x97\xb3\xf3\xf7\xd0\xd7\xf7\x34\x5c\xc7\xfb\x41\x7a\x9e\x06\x04\x23\x10\x43\xeb\x9d\x19\x67\x42\x0b\x13\xc3\x25\xc2\x63\x93\x3f\x3d\x8a\xd9\x5c\x73\xe6\xa9\x29\x74\xce\x5c\x6b\x0f\x67\x91\x7d\xa6\xa7\xb4\xfe\xf5\xbc\x65\xad\x32\xe6\x12\xc5\xd7\xb6\xb6\xa1\xfe\xb5\xd5\xaa\xee\x12\xdd\x9e\x2a\xf7\xb4\x61\xd4\x17\x1a\x08\xfc\x0c\xe8\x4b\xaa\x6c\xb3\x9d\x40\xfb\xd1\x43\xa2\x96\xc8\xb6\xd6\xc5\xcd\xf3\x7b\xdb\x4e\xbd\x4c\x86\xdd\xd5\x5b\x57\x6a\xe7\x38\x2c\x70\xbc\xc0\x85\xa8\x42\x7f\x3a\xc5\xce\x53\xd8\x47\xa7\x59\x51\xa6\xe5\x8c\xdd\x8c\x26\x3b\x84\x74\x40\x78\x16\x34\x92\xfa\x1c\xe2\xac\xde\x83\x6c\x90\x9a\x51\x5f\x1c\x41\x29\x8a\x8e\xd8\xec\xc9\xa8\x14\x34\xc2\xc9\x9a\x82\xc4\x1a\xc5\x62\xad\x42\x63\x6d\x49\xb2\x58\xc7\x56\xbf\xc5\xef\x48\x08\x89\x8a\xbf\xd6\xf3\xe3\x9f\x60\xb0\x45\x80\x07\x95\x80\xca\x5b\x89\x7f\xf8\xc8\x1d\x8f\x72\xdf\x52\x28\x02\xf0\xb0\x71\x63\xab\x3c\x58\x59\x58\x6c\x61\x65\x89\x0c\xd8\x7c\xd1\x10\xce\xf5\x9b\x2e\x5b\x95\x40\x0c\xb3\xc2\x20\xcb\x82\xf0\x30\x6c\x3c\x3b\xaf\x03\x25\x88\x74\x98\x7e\x99\x46\x4a\x78\xea\xd4\x98\x0c\xca\x38\xa5\x55\x6c\xea\x85\xce\x9f\x25\xa5\x29\x0d\x1a\xc4\x35\x42\xe9\x2a\x25\x21\x4e\xb0\x4e\x94\x07\x19\xac\x29\xd9\x54\x43\xb5\x4d\x40\x17\xff\x6e\xfc\xf7\x5f\xcf\x4f\x36\xef\x3d\x3a\xfd\x7d\xfb\xba\x4d\xb8\x17\xfe\xde\xbb\xf7\x3f\xa7\xbf\x6f\xed\x5c\x7f\x96\x0f\x3b\x9b\x9d\x9d\xad\xeb\xf5\xff\xbb\xd1\x2d\xe9\x01\x9b\xd4\xe8\xf0\x70\x40\x2e\x29\xaa\x94\x63\x91\x81\xb6\x96\x38\x16\x3c\xee\x14\x35\x2d\x06\x8e\xc8\x33\x44\x38\xdd\xd4\x90\x3c\xb3\x45\x8b\x6b\x4c\x2a\x32\xa9\xa1\x34\x76\x76\xc1\x59\xca\xf1\xed\xfe\x0d\x10\x92\x3c\x22\xe2\xdb\x41\x4a\x5c\xd2\xdf\x14\x31\x51\x49\xdd\x8c\x9c\xdb\x9b\x0b\x91\x0c\x6e\x7d\xb1\x9d\x15\xd0\xae\x98\x5d\x68\x25\xde\x15\x7c\x8b\x4f\x66\xc2\xbd\x47\xdf\xde\x23\xbb\x65\x01\x47\xad\x6b\xb4\x2f\xfd\x7c\x1c\xb5\xc9\xb6\x7e\xe3\xe0\xf9\x7e\x04\x7b\xfb\xde\xf6\xe6\xe6\xce\x7a\x2d\xb1\xd1\x16\x6d\x6b\x17\x06\xe6\xa6\xa9\x80\x6e\x4a\x51\x6e\x5f\xda\xee\x44\xdb\x8b\x5f\xa3\x53\x97\x4b\x5c\xd7\x88\x92\xf3\x6e\xf4\xaf\xa3\xe7\x3f\xd6\xb3\x64\x55\x57\x0d\xa1\xea\x5b\xf5\xb0\xa2\x52\x36\x98\x72\x15\xff\xb6\x69\xf2\x17\x8b\x9a\xdf\x82\xb5\x0e\xee\x9c\xb5\x16\x26\x69\x7f\x9c\xd2\xd0\x34\xb2\x5f\xf4\xb4\x20\xcd\x9c\xf4\x01\x02\xff\xb8\xf7\xf3\xe1\x8b\x7f\x1f\x1e\xfd\xb3\x9e\x17\x69\xf5\x75\x9c\x68\xe2\x10\xc8\x92\xac\xd8\xf2\xc8\xba\xb5\xb5\x5c\xa2\x72\x19\x14\x6a\x66\xf0\x98\x0f\x6c\x62\xb9\xeb\x76\x13\x2c\x46\xac\x09\x78\xb6\x60\x46\x2e\x6f\x66\x0e\xcd\x74\x60\xd3\x72\x17\xb3\xa9\x39\x4d\x14\xc2\x8a\x60\x1b\x1d\x94\x41\x14\x26\x07\xdd\x4e\xb1\xf5\x60\x9d\xc8\x16\xcb\xae\xec\xb2\xb5\x79\x46\xd7\xa0\x96\x68\xa1\xa1\xc4\x73\x56\x6f\x9d\x53\x1f\x30\x68\xed\x84\x5a\x3d\xc6\x5a\xeb\x45\xfa\x73\x75\x1e\xbd\xbb\x3a\x8f\x5e\x9d\x47\xaf\xce\xa3\xe5\x95\x9e\x74\xd2\x6f\x7a\xa3\xe7\x86\x6e\xdc\xac\xc1\xe5\x99\x82\xe6\x1d\x5a\xb3\x4e\xa1\x97\x1c\x78\xdc\xac\xfe\x60\xd2\x47\xaf\x70\x4c\xe2\x72\xd4\x21\x12\x17\xbf\x0d\xfb\x9e\x9a\x4b\xdd\x01\xba\x84\x37\x9e\xb8\xa9\x8f\x06\xe9\xe4\xde\xe8\xd4\x83\x1d\xfe\xb1\xfd\xd8\xe8\xe5\x57\xe9\x47\x46\x57\x80\x52\xbc\x68\xff\x0e\x65\x7a\x55\x2d\x48\x42\x2b\xa3\x82\x6e\x9e\xb5\x5b\x94\x16\x2d\x2d\x42\x98\xeb\xd2\x68\x91\x83\xec\x65\xe1\x8b\x0e\xde\xec\x47\x95\x4b\x1b\x0b\x6a\x44\x6a\xb1\x32\x22\xc2\xef\x3d\x93\xec\xdc\x61\xcf\xbe\x31\x10\x88\x68\x36\xd0\xd0\x44\xb1\x0c\x6b\x4a\x7f\xb9\xb1\x11\xbd\x3c\x78\x7b\xfc\xfc\x35\x95\x05\xfb\x87\xaf\x5f\x3f\xdf\x3f\x3e\x38\x7c\x1d\x1d\x3d\x7f\xfb\xe6\xf0\xf5\xdb\xe7\x6f\xeb\x91\x83\x90\x2a\x1a\x76\xf0\x02\x8f\x42\xfc\x0d\xbf\xa7\x74\x11\x7f\x02\x27\x82\x71\xf2\x29\x2d\xaf\x7a\xd1\x03\x63\x12\x18\x3d\xa2\xe7\x5b\xd2\xcb\x13\x6a\xaf\x96\xef\xf5\x75\x24\x11\xaf\x92\x84\xca\xae\x14\x7e\xd4\xb2\xa3\x04\x69\xc3\xa1\x08\x71\x40\x1b\xa3\xd2\x75\x00\x07\x86\xfd\x11\xc1\xbc\x24\x5b\x19\xb6\xf9\x62\x6b\x37\xc8\xf3\x01\x5e\x01\x96\x88\x9c\x62\xe6\xf5\x72\xe5\x0e\x21\x76\xb7\x50\xdf\x7e\xac\xcd\xf7\xf8\xc4\x3b\xa1\x11\x70\xd2\xc1\xa9\x27\xe5\xb0\xac\x06\xa8\xc3\xe1\x1d\x2e\xb4\xf0\x43\xdd\xf7\xe8\x07\xef\x31\xb8\x6c\x81\xf7\xd5\xd9\x86\xd3\x4d\x97\x06\xb4\x9e\x26\x5a\x04\x4b\xf7\x20\xb8\x08\x02\xb4\xf0\xe1\xe8\x29\xd7\x06\xd6\xf1\xb9\x07\x8b\xdf\x20\x19\x27\x44\xea\x7b\xaa\x72\xf7\xdd\x7a\x35\x77\xfc\xbd\x5f\x40\xbd\xa0\x13\x89\xb3\x2e\xc3\x83\x1a\xbc\xd9\x15\x80\x8c\x05\xfb\x86\xe8\x88\xb0\x2a\xa7\x65\x57\xa3\xbd\xd8\x65\xe8\xb3\x51\x05\x30\x8c\xb8\x9e\x28\x52\xca\x42\xa3\x28\xcc\x7a\xcd\xda\x0a\x84\x4a\x14\x73\xf1\x41\x8e\xfe\xd9\x0c\xfd\x99\xe5\x0a\xe6\x39\x17\x2d\x01\xf8\xec\xf9\xbd\xfd\x9f\xde\xbd\xfe\xe7\xf3\x23\xbb\xfe\x41\xd2\x1f\xcd\xb2\x0f\xc9\x80\x87\x31\x30\x3a\xc5\x7f\x64\x58\x27\xe3\xb8\x9f\xb4\x37\xfe\x73\x7d\xf2\x9f\xec\x3f\xd3\xd3\x27\xff\xf9\x7d\xe3\xbc\x13\xb5\xae\x3f\xdf\xbb\xf7\xf9\xf7\xd6\x3a\x4d\x31\x89\x30\x9a\x5a\xf2\x3f\xa7\xa2\xec\x89\x28\x7d\x0a\xc5\x4f\x44\xf9\xd3\x93\x9a\x1a\xac\xf2\x5a\xf1\xba\xd2\x55\xfb\x6a\xeb\xb2\x71\xac\x34\x77\xea\x01\xb8\x96\xbd\x92\x69\xc4\x43\xe4\x99\x1c\x55\x64\xa5\xd8\xa0\x9e\x54\x09\x8f\x51\x4b\xe3\xe0\xd2\xca\x6c\xd0\x6a\x7e\x03\xd4\x3e\x00\x39\xe4\x1b\x0f\x87\x6d\x00\x47\x77\x23\x1e\x14\x0c\xdf\x70\x4a\x8f\x3b\xe7\x5a\xe1\xb9\x0e\x81\x5d\x85\xa0\xdd\x46\x2b\x32\xbc\x9b\xf1\x2a\x4d\xf4\x1f\xbb\xd1\xe7\x94\xa4\x39\x02\xe5\x8d\x58\x9a\xd5\x25\xeb\x27\x63\x9a\x47\x5b\x44\x95\xc2\x0b\xf7\xc7\x49\x3c\x15\x37\x0f\x8c\x86\xf9\x6b\x97\xe8\xc3\xa1\x69\x68\xd3\x52\xd4\x58\xa3\xd0\xe8\x55\x39\x15\x1b\xf5\xe7\xbd\x67\x25\x08\x8f\x15\xbc\x06\x97\x99\xcd\x4d\xa2\xa4\x33\x4f\x04\xd7\x70\x22\xb1\xb3\x69\x71\x07\x13\x73\x4a\x0b\xda\x83\xb4\x2d\x12\xce\xca\x34\xe8\xe5\xcc\x0c\xd9\x03\xbf\x39\xc9\x6e\x73\x86\x93\x65\xab\x64\x8b\x8e\xb9\xa2\x4a\xce\xee\x64\x56\x8c\x5c\xf7\xde\x9c\xa7\xcb\x6a\x0d\xd6\x22\xe5\x5a\xa6\x7e\xe4\xfa\x56\x3c\x18\xd0\xb8\xdc\xf2\x76\x58\xae\x2d\x5b\xd5\x5e\x82\x9d\x3a\xaa\xa2\x9f\xe9\x69\x62\x3b\xcd\x0d\x6b\x29\x4b\x3d\xcd\x97\x16\xaa\xc4\x09\x97\x0a\xf9\xf6\x60\xc0\x4a\x91\x65\x90\xec\x50\x1d\xab\x1f\x41\xec\xc8\x40\xa2\xd9\x22\x87\x54\x30\xc7\x05\xb5\x94\xf9\xd0\x5c\x71\x0d\x0d\x4e\x6e\xf8\xd3\xc9\xe6\xa9\xad\x4e\x51\xb7\x3a\xd6\x83\xaa\x18\x7f\xa1\x17\x65\x2f\xf1\x4d\x09\xaa\xbc\x90\x0a\x05\xa6\x41\xbb\x3f\x28\xd3\x95\xb8\xc8\xe6\x74\xf6\xc0\x99\x43\xc8\x10\x55\x6e\x91\xe1\x4e\x44\x7c\xcc\x01\xdb\xdc\x52\x9b\x08\x3d\x0f\x06\x0d\x71\x80\x8f\x23\x9f\x91\xcd\xc6\x2e\xe8\x1a\x17\x98\x42\xda\x40\xf0\x0f\xc9\x15\xb3\xf4\x60\x64\x70\x5e\x03\xc4\xc1\xbb\xa3\xb8\x38\xbc\xcc\x08\x52\x93\x64\x5a\x5e\xb5\x49\xdd\xee\x4d\x84\x83\xf0\xa4\xcc\xa9\xfb\x62\x56\x8b\x74\x86\x6c\x6c\x5b\x96\xc3\x89\xf8\x71\x3d\xd6\x53\x79\x80\x53\x6b\xa8\x1c\xd8\x57\x33\xfe\xf0\x7c\xb4\x91\xb2\xfd\x27\x83\x5b\x94\x30\x59\x69\x37\xf0\xb9\xea\xb8\xde\x55\x3b\xcc\xc1\xf7\xb0\x10\xeb\x87\xa5\x5c\x82\x6e\x30\x4d\x38\xe6\x1d\xc6\xad\x7a\x3f\xce\x73\x35\xe8\x07\xfc\x68\x48\xab\xf7\xe6\x56\xfb\x72\x9a\x96\x34\xc4\xb2\x4b\xf0\x2b\xb0\x86\x5d\xe4\xbd\xcf\x30\x22\x52\xf9\xdc\x71\x35\xe8\x36\x78\xa3\xe4\xf3\xde\x4a\xb7\x3b\x8a\xb5\x9a\xbc\x75\x05\x7f\xa8\x14\x32\x74\x59\x9b\x93\xe0\x92\xe8\x0d\x68\x0e\xbf\x1a\xb2\xfb\xa8\x2e\x3a\x33\x50\xc2\xa3\x61\x54\xf0\x5d\xce\x5c\x9a\x26\x6a\xc2\x06\x5c\xc7\x9d\x4b\xd1\x42\xb5\x24\xf3\x7a\x8a\x21\x31\x9c\x76\x04\x2b\xaa\x38\x98\x9a\xe1\x36\x68\x15\xc2\x66\x0d\x7c\xa5\x8c\xe5\xee\x6e\xd4\x5a\x8b\x0a\xf5\x0a\x69\x57\xbd\x51\x5a\x2d\x2c\x34\x5c\x0b\x44\x29\x9f\x4d\xe0\x34\x84\x9e\x23\x30\x9f\xaf\x37\xfb\x91\xb4\x16\x63\x11\xca\xe5\x9f\x81\xb3\x65\x9e\x5b\xea\x8b\x88\x96\x06\x5c\xde\x90\xc3\xfd\xc6\x42\xca\xd4\xa1\xb7\x8d\x99\xce\x8f\x68\x4b\x4d\xc2\x69\xdb\x67\x66\xd5\x20\x98\x47\x67\x01\x71\xf2\xaa\x03\xb4\xfb\xab\x03\xb4\xd5\x01\xda\xea\x00\x4d\x1e\xa0\xf1\x5b\xe3\x37\x15\x0e\x6f\x2f\x26\xd2\x39\xfa\xe7\xec\x22\xfe\x48\x38\xe1\xfb\x18\x1e\xff\xfe\x81\x3d\x12\xc1\x1e\x7c\xca\x46\xa4\xe6\x41\x96\x96\x29\x59\xad\x7e\x4b\xa2\x7f\x30\xa4\x81\xd7\xe3\xa8\xa0\x4e\xf3\xc2\x47\x99\x52\x08\x04\x81\x96\xe3\x56\xf1\xb8\x87\xb5\x5b\x94\x7f\x6c\x0a\xe7\x0b\x32\x10\xf1\x79\x72\x30\xe8\x45\x9b\x2e\xd1\x14\xe2\x0b\xc2\xfc\x59\x81\xb6\x66\xc6\xbf\x40\x67\x5b\x7f\xa6\xbf\x2a\x0a\x0b\x07\x27\xbc\xc4\x07\x92\x22\x51\x79\x4c\xa3\xc5\x79\xa2\x40\x76\xbb\xbf\xa3\x18\x90\x87\xa2\x3e\xf1\x49\xa4\x05\xd4\xab\x31\x63\x8f\x2b\xdd\x05\x0c\xdc\x5d\x55\x38\x90\x0f\x81\x23\x5d\x20\x43\xa3\xc3\xf1\xc0\x33\xa5\x31\x18\x64\xe9\x33\x0e\xfd\x04\x5a\x96\x8f\xb4\xbc\x45\x77\x07\x31\x81\x12\x86\x8b\x07\x1f\xc1\x08\x24\x98\x22\x3a\x78\xa6\x83\x88\x2e\x48\xa6\xb9\x7b\xd7\xa5\xa3\x23\x5a\x50\x6d\xb8\x06\x08\xfa\x60\x35\x81\xc0\x89\x90\x0e\x9c\x66\x36\x80\x88\xe2\xc0\xfe\x05\xb3\x81\x19\xcf\x61\xb1\xa0\xe1\xd2\x49\x54\x50\x5a\x1a\x66\x84\x67\x77\x2d\xbb\x73\xc7\x64\xe4\x70\xc2\xba\x15\xe0\x60\x45\x24\x92\x9e\x81\x44\xa8\x4f\xa9\xa0\xb9\x81\x93\xc6\x9f\xa2\x72\x97\x57\x10\x8b\xd0\xad\x9c\x1d\x72\xe8\x27\x12\xad\x2e\x59\xbe\xa6\x57\x6d\x91\x3a\xf2\x2d\x51\xaa\xc6\xc9\x2b\x36\xcc\xe0\x8c\x86\x7e\xa8\x6a\xb2\x18\x4d\xe2\x86\x17\xbc\x10\x35\x23\x9c\x28\xf7\x91\x82\xd1\x5d\x89\x5c\x05\x00\x9b\x59\x4e\x30\x01\x25\xd8\x01\x9c\x6a\x29\x93\x3b\x4b\xf0\xc8\xa1\xa2\x20\xd8\xc8\xa0\x0c\x73\x80\x72\x17\x13\xf0\x7c\x87\xa4\x67\x60\x82\x19\x9c\x67\xe3\xab\xea\x15\xcf\xf6\xab\x0a\x67\x4b\x44\x45\x8b\x26\x3c\x66\xd2\xfe\x8c\xee\xc2\x1a\xcb\xfc\xa7\x50\x2c\x20\xc5\x2b\xef\xb8\x21\xbb\x59\x33\x05\x5b\x02\x79\x9d\x42\xc3\x1d\xac\x53\x13\x36\x17\x01\x6d\x21\xd7\xd7\x89\x3e\x9b\x8c\x07\x2e\xd7\x43\xde\x9c\xd6\x9b\x5a\x79\xae\x76\xc2\x10\xea\x0c\x6b\xd7\xa4\x11\xdf\x8d\x7b\xe6\x01\xac\x6b\x2d\x26\xa2\x50\x57\x2c\x24\xe2\x99\x2f\x28\xe1\x87\xb1\xd6\x46\x84\xb7\x65\x6c\x42\xaa\x0d\xc6\x83\xd5\x06\x63\xb5\xc1\x58\x6d\x30\xd0\x88\x31\x6c\x36\xde\x6a\xcc\x98\xa5\x7b\xde\xa9\xc0\xaf\xc4\x69\x48\xfd\xad\x40\x6a\x15\xc9\x58\x6a\x04\x71\xdd\x0f\x1e\x31\xe3\x09\x4f\x1b\x21\xc0\xe0\x11\xf7\xba\xa3\xc2\xbc\x02\xac\x14\x3c\x34\x72\x32\x4d\x0d\xfd\x42\x24\x0b\x50\xca\xe9\x1f\xb0\xa2\x2c\x87\x25\x56\xd6\xf8\x82\x9f\x24\x51\xc3\xdc\xab\x38\x23\x12\x58\x09\x51\x28\x80\x74\x81\xcb\x68\xaa\x19\xda\x44\x98\xd9\xaa\x06\x45\xc0\x5e\xe0\xa4\xb6\x1a\x9d\x5e\xcc\xa1\xe4\x8a\x6b\x94\x64\xea\x25\x10\xfe\x46\xcf\x52\x65\xec\x2c\xc0\x97\x9d\x06\xc1\x0c\x4f\xe8\x7e\x9e\xd0\x3c\x48\xbe\xb5\xde\x91\x78\xd8\x4c\xa1\x25\xf0\xd2\x71\x72\x2c\xd6\x16\x8d\x39\x1e\x7a\xb8\x01\x2c\x65\xbb\x96\x38\x2b\x2d\xc4\x46\xb4\x2d\xf9\x56\x5e\xb0\x10\xe9\x68\x0a\xd0\x66\xe5\x9b\x05\xc6\x80\xe7\x8d\xaa\x8e\x96\x69\x4c\x07\xa9\xfd\x48\x3a\x75\xa3\x57\xb0\xd2\x41\x70\x41\xca\xd8\x4c\xe9\xa9\x1d\x0a\x23\x2d\xd5\x02\x43\x22\x88\xf2\xf9\x35\xe1\xf3\x2a\x50\x6a\x07\x76\x25\xc9\xa7\x54\xf7\xd9\xf0\x0e\x8b\x81\x53\xfd\xf0\xa8\x7e\x8b\x72\x6c\xd0\xb4\xf6\xf8\x21\xa4\xc8\x66\x0a\xc0\x93\x7c\xd2\x66\x31\xea\x99\xe6\xc0\x8e\xf7\x21\x17\x18\xa5\xf9\x1d\xbd\xf4\x62\x5a\xb3\x7a\x68\xc8\x6f\x19\xaa\x43\xda\xe4\x3a\xad\xd8\xff\xec\x11\x4c\xe7\x18\x44\x7a\x38\x41\x54\x5f\x7a\x34\x71\x5d\xdd\x50\x26\xcb\x60\xe8\xa8\xa9\x18\x84\x0d\x99\x3a\x38\xb0\x8b\x51\xc4\x7b\x70\x50\x62\x76\xa1\xe6\x70\x78\xf4\x66\x9f\x27\x69\x9f\x3f\x4e\x27\x32\x44\x3c\xb9\x1a\x9b\x51\x94\x0b\xa4\xb5\xa8\x76\x48\x58\xd9\x03\x28\xea\x19\x11\x7c\x32\xd9\xdf\x7c\xa4\x57\x5a\x0a\xa3\xfc\x1d\x64\x65\xac\x9b\x19\x36\x59\x51\x19\x89\xd4\x6c\xec\x6c\x64\xe2\x9e\x0e\x5c\xa8\x4d\x3e\x79\x23\x63\xca\xb5\xf7\x49\xf5\x37\x9b\xda\xb4\xec\x29\x88\x59\xe5\x71\x5e\x77\x53\xef\xe8\xb3\xc5\x5e\x7a\x69\x37\xe5\x00\x96\x8c\xaf\xa9\x71\xa7\x2e\xc5\xa1\x83\x09\x0e\x45\x0e\x74\xd4\xbb\xdc\x35\x5e\xa6\xa2\x43\x43\x38\x53\xeb\xc2\x13\x14\x40\xd6\xd9\x9b\x33\xa0\xb7\x61\x1e\xa6\x6b\x9a\x88\xb1\x21\xa6\x5a\x80\xe5\x20\xc0\x68\x60\xd6\x34\x37\x7d\x71\x93\x2d\x3a\xc5\x44\xfe\xc7\xa8\xca\x23\xb6\x2f\xf5\x02\xc4\xd5\x69\xfe\x5c\x91\x5c\x15\xa6\xa5\x14\x29\x80\x96\xa0\x40\xaa\x94\x6e\x57\x66\x01\x94\x35\x90\x69\x29\xac\xae\xd4\xdc\x6e\x7f\xd6\x6d\xae\x41\xc9\x20\x8d\x09\x1b\xae\x20\x8b\x7c\x78\x6c\x18\xf5\xdd\xc8\xd9\xaf\xe8\x98\x00\x84\xa0\xd6\xd9\x2c\x1d\x0f\xe8\xa8\x98\x74\x02\x28\x2b\x87\x1d\xd5\x08\x8e\x0f\x9f\x1d\xde\xb9\x73\x87\xee\xb0\x5b\x45\x34\x3b\x87\xc3\x78\x96\x31\x1d\xf6\xe4\x33\xb8\xe5\x9e\x96\x76\xbb\x99\x92\x1b\x0e\xfe\x16\x7e\xc8\xf6\x69\x3c\x08\x67\x0a\x21\xa3\xbb\xe1\x61\xd3\x49\x0f\x4f\x00\xee\x64\xf3\x14\x3c\xda\xb4\x47\xb2\x11\xb2\xee\x2b\x99\x65\xd8\x1f\x5b\xb4\x30\xf4\xd7\x94\x9b\x4e\x2f\x06\xa3\x61\xac\x6c\xf0\x08\xca\x21\xa8\x77\x5b\x92\x0e\x78\xc8\xad\x2d\xf8\x6e\x39\xf1\x38\xbd\x71\xaa\xfc\xaa\x7c\x5b\x5e\x59\xcf\xe6\x4a\x6c\x29\x46\x4d\xb8\x6d\x78\xd3\x76\xc3\x4f\x9a\xfd\x68\xeb\xfa\x86\xad\x72\xaf\xa8\xbc\x06\x94\xcb\x5e\x4a\x6e\x01\x9f\xff\xb1\x89\x0a\x2b\xc8\x1b\x54\x97\x09\x79\xbd\xc7\xe5\x64\x1c\x10\x46\xde\xee\x92\xd6\x82\xbb\x9b\xee\xb8\xed\xc6\x33\x00\x37\xce\x45\xc9\x51\x82\xb2\x8b\x9d\xf2\xf0\x85\x6a\x36\x4d\xa8\x27\x12\xd1\x33\x65\x34\x75\xe6\xb9\x0b\xb6\x47\xb1\x5f\x4b\x33\x6e\x35\x0e\x0d\xe9\x8e\x26\x39\xe6\x0b\x58\xb7\xdb\x15\xa7\x95\xde\x05\x4c\x39\x80\xb2\x2a\xf3\x2d\x68\xc1\x99\x90\x9d\x29\x89\xe7\x9a\x30\x82\x3b\xf5\xbc\xc5\x2a\xd3\xd4\x8e\x2a\xaf\x22\xdc\x7a\xfd\x4a\xf7\x12\x6e\xee\x3f\xf3\xed\xca\xbc\xbd\x32\x6f\xaf\xcc\xdb\xd2\x7f\x66\x70\x76\x33\xae\x33\x81\xe6\x6d\x69\x81\x56\xed\xdb\x4c\xa4\x22\xf6\xea\x67\x4f\x35\x19\x07\x84\x70\x04\x65\xb5\xcc\xa7\x94\x68\xc6\x6b\x4b\x5d\x66\x0a\xc8\x78\xe8\x72\xab\x66\x88\x15\x6d\xec\xea\x2f\x77\xe7\x70\xeb\xda\x86\x9e\xdb\x86\x86\xb0\x65\x99\x43\x5b\x66\xe3\x36\xd6\x85\x06\x27\x81\x95\xf6\x55\x13\x48\x85\xae\x14\xb3\x52\x06\x11\x01\x4f\x57\x36\x4e\x58\x7e\x0f\xd0\x21\x21\x89\xbc\x28\xe0\xca\x1c\x4f\x60\x06\x67\xef\xbd\x60\x62\xd3\xb1\x83\xf4\xc9\x46\x91\xec\xc5\x9a\xa1\x28\x0b\xf8\x51\xf4\x82\x09\x14\xb7\x83\x50\x24\xbd\xfd\x29\xf9\xd4\x80\x84\x04\xba\x96\x7e\x0e\x98\xc6\xc4\x6b\x80\x19\x83\xae\x25\x5b\x0d\x66\xf5\x34\xe3\x5a\xc1\x09\x52\x87\xe0\x9b\x4e\x35\xee\x1d\x4e\xdf\x0e\xef\x8d\x5e\xea\x34\x5c\xa9\x78\xf6\xd4\x52\x28\xd8\x44\x81\x7c\xd4\x8a\xee\xf0\x70\xa5\x3b\xac\x74\x87\x95\xee\x80\x1e\x8d\x4f\xc8\x40\x2d\xe9\x60\x9c\x97\xfa\x12\x71\x6e\xaa\x54\xf4\x86\x4a\x52\x7d\xb0\x4e\xdc\xb1\x13\xfa\x9a\x43\xfa\x3a\xad\x47\x85\x15\x37\xef\x0c\xe8\x09\x7f\x6d\xc1\xf7\x5d\xa8\xf4\xf3\x6c\x98\x9e\x5b\xf0\x90\xf3\x94\x9e\x4b\xea\xc5\xfa\xfc\xb5\x05\x7f\xc9\xe3\x90\x68\x1e\x06\xfc\xa5\x05\xfc\x82\x9a\xd4\x4c\x5a\xd2\x97\x16\xec\x41\x01\xb7\x61\xd8\x7a\xae\x82\x17\xec\xad\x05\x0f\x6b\xd3\x34\x31\xa1\xf9\x5b\xbb\x76\x16\x5c\x5b\x05\x85\xa0\x67\x16\x1c\xcb\x15\x63\xe1\x2c\x5e\x23\x4a\x29\x4d\x9c\xd0\xe8\xf4\x59\x1e\x71\xf2\x50\x32\xf4\xd8\x63\xf3\x14\x42\xce\x46\xfc\xef\x2e\x3d\x05\x39\x1c\xb6\x5b\x9b\x9f\x5a\x2c\xa4\xec\x26\x1c\x4d\xaf\xc1\x3c\x83\xb5\xf6\x29\xb4\xfa\xf4\xea\xa7\xb8\x18\xad\x45\x3d\xeb\x3d\x3b\x4d\x5b\xf3\x2a\x83\xb4\x4f\x31\xc5\xf7\x05\xc4\x79\xbe\xcd\x7e\xb4\x04\xbe\xc7\x15\x12\x4f\xaf\x28\x0a\xd0\xa5\xbd\x6c\x70\x00\x05\x5b\x10\xd6\xd0\x0d\xca\x7a\x29\x81\xbd\xbd\x25\x3d\x1a\x27\xb7\xde\xbd\x77\xd0\x6a\x5d\xc7\x54\xa0\x26\x5d\x12\xc3\xad\x10\x66\x1f\x02\xaa\xdf\x7a\x2f\x51\x2c\x18\x73\x6a\x1d\x75\xc0\xb1\x3e\x87\x0c\xdf\x17\xe9\xdd\x3b\xd9\xb4\x32\x90\xf6\x00\xaa\x10\x75\x3d\x92\xc8\xbf\x21\x35\xfc\xd5\xf6\xb1\x50\x20\x68\x1f\x6b\x98\x1b\xd9\x8a\x97\x26\x38\xde\x13\x14\xe5\x49\x28\xb6\x93\xc5\x10\x45\xc6\x8e\xe7\x85\x39\xd0\x43\x6b\xca\xcf\x60\xd0\x86\x80\xf1\xca\x74\x80\xe1\xe2\xeb\x0c\xb3\x9c\xb2\x48\x4f\xa6\xfd\x54\x3b\xb0\x67\xdd\xea\x32\x17\x71\x79\x4b\x1f\xd8\xaa\x32\xe7\x76\xc8\x06\x2d\x19\xc6\xb3\x31\x9b\x80\xad\x8e\x49\x29\xc2\xc2\xbd\xba\x23\x17\x91\x85\xaa\xab\x56\x65\x12\xc3\xd8\xfd\x15\x7a\xbd\x1f\xc1\x61\x1b\xa9\x5a\xaf\x93\x50\x81\x00\x22\x43\xc4\x31\xb0\x3f\xaa\x07\x55\x3a\xdf\x34\xa3\x0e\xcf\x2e\xb2\x1c\xfa\xf0\xca\x96\x4c\x21\x5e\xeb\x32\x69\xd4\xc8\x2e\x04\x42\x3c\x1e\xd3\x6b\x45\xe1\xb6\x03\x5e\xc2\x63\x3f\x90\x0b\x84\x1b\x52\x5a\x11\xb0\xab\x46\x9a\x97\x0a\xec\xd2\xa5\xba\xce\x3c\x58\x78\x3a\xa2\x17\x95\xc3\x8a\x09\xf1\x4c\xe1\x40\x26\xbd\x25\xf0\x29\xd2\xa2\xe1\x46\xd1\x53\xeb\x63\xdf\x64\x8c\x60\x09\x14\x6a\x98\x79\x09\x1a\x5c\x63\x22\x2b\xa5\x02\x08\x5d\x03\xfd\xd7\x21\xf6\x71\x5e\xc6\xe3\xe6\xd4\x7e\x97\x01\xee\xc9\x20\x9c\xe2\x6a\x43\x7f\x6d\x92\xbf\x2d\xf3\x29\x59\x4a\xf7\xca\x46\x86\x5b\x5e\x26\x80\xd2\x5e\x58\x69\x26\x0d\x21\x33\x5b\x7f\x99\x0a\x59\xe6\xd4\xd4\xd8\x84\xae\xa1\x04\xe1\x79\x9c\x42\x69\x01\xe0\x01\x64\x70\x81\x7d\x41\x46\x0b\x25\x88\xd0\x03\x82\x17\x18\xa6\xd6\xb8\x48\x22\x77\xe8\xcb\xa6\xc6\xeb\xfc\x0d\xd1\xe2\xc8\xbe\xc2\xe8\x68\x27\x60\x49\x87\x9f\xbc\xb9\x88\x2f\xe6\xd7\x73\xce\x4c\xe8\x6e\xe3\x59\xb9\x3f\x8a\xd3\xec\x28\xb9\x8c\xa7\x10\x9c\xa9\x64\x09\x0a\x82\x59\xd2\x2a\x1c\xc2\xa0\x41\x85\x16\x1a\x20\x0f\x33\x76\xea\x58\x3b\x98\x5b\xdf\x96\x71\x99\x90\xce\x64\xe7\x64\x13\xff\x22\x2e\x4a\x99\xe4\x39\x5c\xba\xa1\x35\x04\xc9\xba\xe0\x92\x82\x90\x5b\x4b\x26\x64\x30\x9d\xe8\x2e\xb9\xd1\x1a\x4b\xe0\xdd\x34\x90\x06\x9d\x65\x33\x0d\xca\x2d\xea\x2a\xb0\x8c\x69\xd9\xb1\xe2\x0d\x79\x96\x87\x8b\x49\x3a\x66\x36\xe9\xf0\x35\x82\x97\x09\x5a\x28\x3c\xb0\x82\x92\x9b\xa1\xd8\xa2\x36\x9e\xa6\xb2\xdc\x2c\xef\xee\x85\xcf\x06\xb6\xec\x49\x30\xb7\xf2\x24\x78\xe7\x59\xd2\x4f\x2f\xb4\x68\x14\xf5\xa4\xac\x6c\x4b\x4d\x89\x58\x95\xac\x9b\x44\x7f\x16\x82\xe9\xb6\x94\x50\x62\x29\xa5\x42\xf4\x78\xd5\x0a\x4d\x2d\x81\x0d\xa9\x56\x2f\x29\x94\x26\x9a\xef\x67\x10\xa3\xfe\x7c\xb4\x90\xc5\xdd\x44\x71\x9d\x20\xfc\x41\x24\xf2\x92\xe8\x7c\x04\x87\xb3\x93\x26\xd3\xd3\x2e\xdc\x8c\xf1\x3c\x85\x96\xc6\x79\xbc\x8d\x45\x08\xd3\x54\x6a\x85\x4b\x7d\x8c\x28\xce\x22\x8d\x58\x8f\x6d\xf9\x96\xbd\x7b\x9e\x47\x9e\x81\x81\xf8\x28\xbe\x9c\x43\xa6\xd9\x25\xeb\x28\x19\x56\xa2\xd1\x5a\x00\x84\x0c\x53\x0d\xa1\xf1\xe3\xe9\x2c\x99\xbf\xb3\x76\xe9\x90\x0e\x87\x95\xba\xd1\x4e\xcf\xd9\xdb\x86\xdd\x5c\x5e\xff\xcc\x79\x81\x49\xd0\xc0\xfe\xa7\xe7\xd9\x3c\xfd\xd7\x8b\xd5\xf6\xbf\x1e\xfc\x0b\xf6\xbf\x49\xa7\x43\x7a\x7a\x0b\xd6\x9e\x70\x06\xe7\xf7\xae\x82\x3a\x08\xb0\x75\x1d\x74\xc1\x2c\xd4\x41\x50\x56\x6e\xd2\x96\x95\x14\x25\x91\xf7\x65\xf2\x63\x1c\xbc\x79\x53\x8a\xd4\x91\xa4\x06\x74\x21\xce\xd6\x28\x73\x63\x6b\x5c\x9f\x6d\x3d\xdf\xe6\xe3\x74\x90\x52\x1f\xa7\x30\x7e\x61\xc5\xba\x05\x2f\x57\xcb\x3b\x7a\x33\x5e\x62\x35\xc1\xfb\xe5\xcb\x97\x4d\x51\x1e\x07\x70\xba\xac\x7c\x69\x88\xbe\x4d\xa6\x93\x06\x16\x3d\x49\x5f\x56\x2c\x94\xbc\x6e\xe8\x66\x48\x33\x9b\xc4\x45\x4a\xf8\xaa\x99\x5d\x9c\x97\x09\xb3\x79\xb8\x61\x17\x9a\x38\x2e\x9b\x70\xf8\x69\x00\x0b\x3a\x2f\x31\x6c\x6c\x4d\xc4\x2b\x08\x21\x4a\x70\xc1\x46\xbb\x8c\x39\x37\xf3\x1c\x1b\x6a\xe1\x6c\xde\x7b\x5a\x2c\xbc\xcf\x4e\xf0\x70\xfb\x97\xdb\x79\xbd\x3a\xd8\x46\x1a\xd0\x0f\x63\x71\x00\xf5\xec\x10\x87\x90\x67\x5e\xf8\x67\x38\x0b\xc2\xbf\xd0\xb5\xcd\x51\xc8\xb2\xcf\xbb\xda\x46\x6d\xd0\x38\x30\x35\x4a\xb9\x90\xe4\x76\x48\x0f\xa6\xe6\x7e\xcf\x03\x5a\x99\xbf\x1c\x44\xad\xaa\xaa\x05\x90\xd6\x8d\x5a\x48\xbe\x6d\xae\x85\x73\x21\xe6\xb8\x8f\xaf\x2c\xf5\xc8\x57\x7b\xfb\xe6\x00\xb2\xb7\x3c\x18\xa0\xae\x34\x3b\xab\xaa\xab\x03\xeb\x9d\xbe\x10\xbb\x21\xc8\xe2\xe7\x29\xce\x16\x1a\x27\x17\x31\xe9\xe5\xf8\x8c\xca\x38\x2f\x2c\xe5\x6f\x1d\xc0\xbe\x5c\x52\x3d\xd3\x8b\x3f\xd2\x57\xcd\xef\x52\xe3\x96\x19\x20\xee\xa4\xc3\x92\xe3\xf8\x50\xae\xd6\x69\x06\xb1\xbb\x30\xf1\xc5\x7b\x42\xe5\xb0\x58\xac\x39\xb4\x0d\x7c\xbd\x8e\xd4\xd0\x00\x91\xc9\xec\xec\x43\x82\x6a\x57\x36\x1a\x1c\x76\xf9\x48\xf4\xc5\xc8\x3e\x6d\x40\x12\xb5\xc8\xf2\x51\x82\xbc\x2e\xbc\x85\x57\x89\x6b\x49\xb5\xd1\xb2\x8b\x2d\x1f\x35\xe1\x5c\x1f\x84\x50\x0d\x70\xbd\x79\x91\x3b\xf8\x3b\x6c\x8a\xcb\xe8\xcf\x79\x5c\xbc\x99\xa6\xb8\x87\x8d\xdd\xa1\x3a\xe8\xc5\xbd\x60\x96\xd1\xa7\x98\xf9\xfc\xa1\x7b\x3c\xbb\x4f\x12\x7a\xf9\x88\x9c\x29\xae\xcd\x41\xb8\x04\x14\x68\xa6\x2d\x2e\xa3\x17\xfd\x7a\xf5\xd6\xee\x49\x60\xa1\xdb\xef\x0d\x75\x63\xed\xe7\xe3\x9f\xd9\x1d\xba\x40\xe1\x6b\x14\xc2\xd0\xaa\x5b\xf0\xc4\x93\xee\x4c\xdb\xed\x57\xd7\x89\x14\x0f\xfd\xb3\x14\x0f\xe3\x43\x00\xf3\xa9\xd8\xf6\x8b\x9b\x48\x34\x60\x44\x27\x82\x42\x86\x53\x8c\x88\x25\xc6\x8a\x85\xa2\x34\x14\x97\x90\xac\x28\xa7\x55\x26\x97\x0e\x8f\xfe\xb3\x0f\x51\x42\xc8\x27\x1a\x3e\x4f\x84\x6d\x72\x2d\xdb\x80\x34\xbb\xe1\x54\x55\x48\x09\xdc\xea\xa0\xee\xfb\x1d\x71\x79\xaa\x0b\x40\xed\xf5\x8e\x2d\x4f\x5e\xe6\xe7\x8a\x3d\x2a\x0c\xbb\x50\x3a\x54\x17\xa6\xea\x35\x12\x91\x41\x87\x0d\x08\x2f\xd9\x3d\x1f\xe7\x67\xf1\xb8\x0b\x23\xd3\x8d\xed\xd7\xcc\x5a\x18\x8c\x4f\xda\x8f\x27\xaf\x17\xc6\x09\x6a\xb1\x30\x62\x2f\x9b\xe2\xa3\x5c\x40\xab\xb0\x71\x86\x01\x52\x98\x40\x5e\x5d\x6b\xe3\xc3\x3e\x47\xbe\x20\xc0\x4d\x42\x5c\x6b\x1e\xe9\x6b\xf6\xad\xbe\x35\xb2\x15\x7f\xd8\x71\x80\x28\x61\x51\x74\x08\x41\x4b\xf8\x76\xdf\xf8\xc6\x18\x0e\xbe\x3c\x32\xbf\x48\x9e\x85\xeb\xd1\x66\x9d\x70\xeb\x01\xde\xef\x18\xef\x95\x0b\xd5\xc6\x17\x3e\x66\xe4\xd3\xee\xae\xf1\x49\x5c\x72\x84\x6f\x26\x82\x5c\x19\x81\x4f\x66\xcf\xc5\x25\x0a\xf8\xa6\x63\x2f\x2e\x2a\xc2\x17\x7e\x51\xbe\xba\xde\xfd\x68\x75\xbd\x7b\x75\xbd\x7b\x75\xbd\xfb\x9b\x80\x2b\xdd\xb7\x13\x1c\x66\x8e\x9b\xd5\x21\xb7\xa5\xf5\x12\xaf\x13\x5d\x57\xb9\xf5\xcb\x78\xb7\x7f\xaf\x6d\xce\x40\x32\x1b\x1b\x1b\x55\x6e\x0d\x2c\x71\xc7\x20\x29\xfa\x64\x36\xc3\x0a\x4a\x9b\x00\x06\x8a\xe2\x49\xaa\x74\xf1\x0b\x9a\x49\x88\xdc\x2b\x93\x2c\x64\xb3\x9b\x25\xe5\xfb\x0a\xfa\x06\x74\xf6\x84\xa8\x72\x2e\x6f\x15\x0b\x93\x5a\xe8\x79\x36\x1d\xa1\xda\xbd\xa5\x97\x90\xe9\xa2\xa9\x25\x2e\x4d\xc3\x5e\xbf\xe5\x42\xbb\xbb\xb9\x5a\x68\x57\x0b\xed\x6a\xa1\xfd\x13\xc7\x51\xb9\xc9\x00\x27\xa1\x31\x5a\xaa\x52\xd5\x6d\x7b\xf2\x0d\xd2\x46\xad\x6e\xdc\xff\xd1\x6f\xdc\xcb\x87\xb9\x6f\x32\x93\xf5\xba\xba\x4c\x1d\x74\xb8\x5c\x95\xf0\x9d\x2b\x73\x16\x7b\xef\x87\xbe\x21\xaf\x42\x22\x86\xc9\xd2\x79\x14\x5f\xfe\x33\x09\x76\xa6\x51\xcb\x84\x74\xac\x0e\xbe\x59\x88\xbc\x65\xfa\xc1\x09\x0c\xeb\x7c\xe1\x1a\xdc\xfd\x5c\xa6\x47\x5c\xd2\x3f\x4a\xfa\xf9\xc7\x70\x5f\x0e\x59\x20\xa4\xd3\x5e\xe0\x66\x63\x32\xa3\x97\x9b\x1b\xce\x0e\xad\x50\x08\xc2\xb5\x05\x1a\x0d\x57\xd8\x40\x35\x19\xae\x9b\xf7\xd0\x95\xfc\x1a\xee\xa5\x3b\x97\x97\x23\xe6\xa5\xda\x84\x12\x73\x70\x43\x43\x5e\x08\xe4\x84\xb9\x1c\xb2\x9a\xdf\xeb\xf4\x6e\x37\x39\x9a\x18\x26\x8a\x60\x44\x3e\x6b\xfc\x8e\xb9\x78\x88\x09\x1c\xee\x4e\x51\xef\x87\xa1\x34\x19\xba\xe5\xfb\x22\x1b\xf3\xbd\xe0\x33\x4e\xc9\x32\x6a\xa9\x79\xb6\xb8\x9e\x4d\xae\xd0\x13\xad\x9d\xae\x69\xfb\xb6\x4c\xdb\xbe\x6d\xef\xd6\x6a\xdb\xbb\xda\xf6\xae\xb6\xbd\xd2\xbe\x5c\x8c\x46\xa1\xe6\xe5\x9a\x3d\x6c\x32\x4d\x66\x17\xd0\xec\x0f\x46\x39\xe7\x8e\xb9\x4f\xc8\xd6\x4d\x73\xc7\xae\xf7\x5b\xe7\xae\xb7\xe9\xe6\xb6\x49\x00\xcc\xda\xc8\x9a\x3a\xf8\xdb\xd1\xe8\xaf\x1c\x0d\xbd\xf9\x56\x51\xeb\x00\xa1\x9e\x7a\x34\x9e\x5c\xf2\x4c\xe3\x2f\x6e\xd5\x59\x80\x4c\x81\x5a\x5f\x01\x02\x03\xae\x02\x4c\x7d\x5d\xd0\x23\xc0\xbb\x43\x6e\xb2\xb4\xbb\x15\x40\x0a\xc1\x16\xf6\x8f\x7e\x9f\x14\xae\x04\x92\xfe\xbd\xaf\x81\x74\x38\x61\xb3\x21\x76\xd8\xe2\x83\x30\x4c\xb3\x61\x1e\x80\x9e\x0f\xec\xc6\x70\x23\x2c\xff\x2a\xfe\xc4\xd9\xf2\x6d\xfa\x9b\xd3\x4b\x4d\x41\x34\xb8\x8c\xe3\x42\xc6\x72\xb0\x4e\xb3\x37\xf9\x2f\x81\xd8\x7a\x61\x6f\x0c\x4b\xb2\x0e\x7c\x38\x9e\xce\xc8\x52\x32\x78\x93\xb8\xdd\xb9\x14\x5c\x03\x4b\xdc\x18\xc6\x04\x90\x6c\x2b\xde\xc4\x69\x08\xb2\xf5\xc0\x37\xc6\xb5\xf1\x60\xf0\x66\x9a\x7e\x24\x0b\xa9\xc3\x3c\x64\xa0\x1a\x04\x7f\x63\x54\x1d\x24\xe3\x84\xb6\x1c\x48\xd8\x20\xf8\x1b\xc3\x76\x14\x17\xe1\xa8\xd6\x03\xdf\x18\x9e\x64\xb3\xc6\xf4\xc8\x30\x16\x08\x01\xbf\x51\x5c\x9b\xf0\x6b\x10\xfc\x4d\x4a\x81\xb7\x57\x17\x61\x98\xd6\xc2\xde\xa4\x0c\x08\xc6\xb2\x16\xf6\x06\x47\x1e\xb6\x3c\x65\xc2\x9a\x87\x1b\x3e\x6f\xe2\xa2\xb8\xcc\xa7\x83\x20\x36\x68\x5a\xf8\x26\xa5\x42\x30\xb5\x6b\x61\x6f\x72\x9e\x05\x63\x59\x0b\x7b\xc3\xeb\x41\x30\xa2\x21\xe0\x75\xb8\xda\xaf\x37\x36\xa2\x62\x76\x46\x5d\x69\x12\x30\xd1\x44\xb3\xac\x7a\xbe\x48\x8b\x22\xcd\xce\xb1\x72\xc1\xbd\x9c\xe4\x85\xd3\x99\x44\xe9\x9d\x0f\xcc\x67\x91\x85\x5f\xc8\x19\x16\x23\x81\xfe\xae\x81\x27\x0a\xd9\xc1\x29\x76\x3c\x69\xa1\x53\xfc\x56\x31\xeb\x9c\xe6\x01\x5a\xd9\xe6\xb6\x57\xb6\xb9\x95\x6d\x6e\x65\x9b\x43\x5d\x52\xce\x7e\xfb\xcd\xe5\x91\xb2\x37\x4e\x3e\x45\x4f\xc1\x7d\xbb\xf8\x2d\x2e\x7e\x4b\xa3\xef\x63\xf2\xe6\xef\xd3\x72\x58\x74\x47\x33\x87\x75\xed\x81\xfa\x5a\x03\x39\x4a\x86\xa4\xae\xac\x4f\xa6\x05\xa0\x5d\x10\xbc\xcf\xd3\xb2\x4b\x08\x78\x35\x03\x73\xdd\x06\x78\xc7\x6c\x9c\xe7\xf7\xe8\xbf\x67\xe3\xfc\x6c\xa3\x20\xc3\x7b\xb1\x91\x66\x84\xd9\xb2\x78\xbc\x01\x44\x48\x3e\x95\xe2\xdf\xee\x79\xfe\x7f\x5e\xee\xa8\x29\xd1\xbe\xa0\x13\x4b\x55\x42\x4a\x9a\xb7\x80\xfd\xca\x72\xb7\xf2\x46\x61\x37\xe5\x92\x92\x68\x90\x1f\x8e\x12\x9a\xa2\x37\xe8\x9c\xd5\x2c\xe7\x39\x6c\x25\xd3\xf8\x7d\x08\xf8\x4d\x05\xbc\xba\xca\xfa\xcf\xb3\xf8\x0c\xd2\x31\x87\x1e\xa5\x57\x45\x6a\xfa\x55\x03\x79\x53\x5d\xba\x8c\x27\x4d\xbb\x54\x15\xa9\xeb\x92\x1f\xf2\x86\xba\x34\xc8\x2f\x33\x9e\x22\x3a\xa8\x3f\x02\xbe\xa6\x33\x3e\xb0\x39\xc2\xf3\x85\xf7\x67\x36\x69\xd2\x1b\x06\x5d\xd3\x17\x37\xd0\x8d\xf6\x64\x9a\x90\x65\x8a\x28\x37\xa1\x7d\x11\xf0\x35\xbd\xf1\x81\xdd\xd4\xb4\x29\x41\x2f\x0a\x9d\x30\x00\x5c\x37\x55\x5c\x30\x37\x3a\x20\xe7\x49\x93\xd8\x96\x35\x5d\x70\x40\xdc\xd0\x08\x90\x82\x0d\x92\xd2\xd6\xa0\xee\x80\xb8\x51\xda\xb3\x5d\x51\x68\x1f\x18\x74\x4d\x37\xdc\x40\xa2\x27\xbb\xcd\x7a\xd2\xcc\xe3\xcc\x7d\xdc\x66\xae\xd9\x98\x1f\x50\xb5\xfc\x61\x5f\xab\x95\x04\xf9\x2a\x44\x33\xe6\xb1\x34\x71\x7c\x10\x42\x03\x6b\x0c\xe6\x22\xf2\x9e\x30\x38\x46\xda\x19\xf6\x56\xdd\xf3\x8a\xdf\x57\xe4\xaf\x34\x4a\x71\x71\xc9\xbb\xc9\xfc\x94\x80\xa5\x28\xa0\xc3\xec\xb3\x40\xfb\xbe\xd3\x48\xad\x7d\x0a\x38\x8f\xcd\xc5\x67\x75\x81\x1d\x8b\xe5\x17\x15\xea\x02\xb5\xb3\x32\xb3\xac\xcc\x2c\x2b\x33\x8b\x74\x81\x12\x3e\x1e\x5f\xf2\x96\x6d\x9d\x99\x63\xce\x8b\x9f\x22\xec\x06\xdc\xff\xe4\xdb\x60\x43\x66\xc3\xed\xd0\x90\x6b\x1a\xcc\x6b\x26\x2c\xed\xa7\x28\x06\x5e\x3d\x04\x9a\xa7\xf8\xb4\x56\x5b\xf8\x15\x64\x38\xfb\xa3\xa8\x0d\xa0\xce\x0c\x3d\xfd\x98\x30\x59\x0b\x26\x59\x51\xb6\x7a\x38\x0c\x65\x10\xd2\x50\xb7\x18\xa5\xc3\xb2\x8d\x59\x17\xc4\x8f\x1a\x75\x98\x3e\x41\xb0\xdb\xf4\x40\xf2\x95\x8a\xc5\x6b\x21\x44\x60\xd9\x43\x98\xe3\x98\xa3\x18\xc3\x75\xc2\xa2\x4f\x7e\x51\x64\x79\x04\x4c\xd5\xef\xdc\x8b\x39\x4f\x6e\xe8\x41\xd9\x6c\xc1\x5d\xdf\xb5\xfe\xea\x1a\xd5\x1f\x65\x0d\x0d\xee\xfe\xf0\x26\x9d\x5a\xa4\xc6\xa9\x3e\x5d\x3e\x6c\xe3\x9a\xa5\x59\x51\x42\x10\xde\x46\x78\x1a\xc5\xea\x02\x60\x06\x80\x37\x0e\xdd\xfa\x32\x3f\x6f\x92\xa9\x06\xc0\x03\xe2\x74\x32\x04\x5d\xc0\xcd\x90\x9c\xe4\xe1\xa1\xa9\x01\x36\x18\x3d\x16\x04\x73\x41\x0c\xbd\x3a\x2a\x6b\x07\xbd\x5d\xa0\x8d\x24\xae\x81\x03\xf9\x30\xdc\x48\x1f\xeb\x54\xc2\xc6\x6b\x00\x78\xf7\xd2\xe5\xcd\xbd\x02\x14\x86\x07\xeb\xcd\x78\x21\x7a\xa7\x2e\xfc\x74\x9f\x09\xcd\x0f\xf4\xd6\x0f\xa0\x03\x26\xa4\x81\x32\x3b\x83\xfe\xb2\x58\x7b\xa6\xb1\x81\xad\x9c\x2a\x1c\x61\x67\x99\x1b\xc3\xd5\x35\xa1\x6f\x04\xd1\xd0\x3d\xac\xb5\xcd\x32\xfa\x00\x62\xa6\x47\xff\x6b\x60\x41\x50\xed\xc1\x7f\xf0\x1d\x1c\xb2\x3d\xab\xf6\x62\xbb\xab\xbd\xd8\x6a\x2f\xb6\xda\x8b\xa1\x47\xde\x22\x8e\xdc\x72\x02\x31\x84\xed\xc3\x58\x14\xbd\xa3\xe4\x1c\x98\x24\x9e\xee\x9d\xa5\xc6\x9e\x4c\x84\x6b\x2b\x36\x7e\xd4\x41\x09\x9a\x79\x66\x1d\x49\x43\x0c\xbc\xa0\xda\x0e\xf6\xf7\xde\x20\x75\x61\xd8\xf1\x70\x7d\xfc\x4a\x25\xa9\xb1\xb5\xf9\xa9\xff\x60\xf0\x68\xb0\xdd\x1f\xec\xee\x3e\x8a\xbf\xbd\xbf\xdb\xdf\x7d\xb4\xbb\xfd\x60\x37\xd9\x7a\xb8\xf9\xa8\x7f\x7f\x33\xd9\xd9\x1d\x3c\xd8\xbd\xff\x60\xfb\xac\x85\xe0\x87\xd5\x17\x6f\xc5\x5b\x5b\x5b\x67\xfd\xcd\x6f\x77\xfb\x8f\xfa\xc3\xf8\xdb\x87\x5b\xc3\xcd\xfe\xce\xc3\xe4\xc1\xce\xd9\xe0\xfe\x56\xff\xd1\xd6\xd9\xc3\x78\xb8\xb9\xd9\x6a\x24\x41\x19\xfe\x3d\x6c\x9f\x17\x9f\xa5\x3d\x84\xfa\x88\xbc\xe7\x11\x05\x7b\x28\x35\x0c\xc5\xce\x28\x0e\xbd\x75\xb7\x6e\x8e\x95\xaf\x6d\x9b\x6e\x46\xcb\xfe\xb5\xc0\xcf\x45\x10\x48\xb0\x13\xad\xd5\xf0\x07\xb8\x57\x55\x0b\xc9\xfd\xd5\x42\xb2\x5a\x48\x56\x0b\x09\x1e\xce\x87\xdb\xc1\x5d\x2b\x89\xef\x62\xe3\x70\x9a\xff\x96\x5c\xc4\x59\x77\x90\xe0\x91\x7d\x6e\x2d\xe8\x9e\x19\x71\x4f\x2f\xa1\xf8\x3e\x59\x97\xe6\x70\xb7\x26\x58\x5e\x09\x34\x87\xa1\x8f\xdf\x21\x60\xec\x30\x43\x01\x64\x2f\x30\xd0\xc2\x04\x2d\x9c\xa0\x46\xb8\x34\xa5\x8c\xf1\x05\x2b\xac\x9f\xf7\x29\x65\xf5\x0f\x58\x51\xcb\x95\x0b\x0e\x06\x9d\x3b\x02\x41\x54\xe5\x92\xa2\xe5\xa0\xa4\x11\x7c\x7a\x81\xd3\xda\x6a\x96\x1e\xdf\xe0\x8d\x9a\x3c\xfc\x96\xcd\x35\x22\x48\x60\x67\x44\xe6\x59\x99\xf3\x88\x28\xec\xe0\x93\x5a\x12\x61\xd6\xb1\x0d\x86\x5a\x56\x67\x3d\xf6\x9d\x97\x3d\x80\xa2\xfa\x77\xba\x89\x8a\x7e\xdf\x03\xa3\xc2\xb5\xfe\x89\x5b\x00\x90\x6f\x1b\x5e\x6a\x29\x6d\x99\x66\x63\x6f\x80\x61\x63\x84\x9f\x60\x6f\x59\x25\x3d\xe8\xfe\x32\x68\xc9\xf8\x8e\xac\x9c\xc5\x6c\x5c\xae\x37\xa6\xe7\x21\x2d\x8e\x12\xf4\xff\x67\xef\xdd\xd7\xda\x48\x92\xc5\xc1\xbf\xf7\x2d\xaa\x7b\x77\xc6\xc2\x16\x58\xa5\x1b\x97\x69\xe6\x1c\xcc\xc5\x30\x06\xc3\x0f\x70\xb7\xe7\xf0\xb9\xf9\x4a\x52\x09\xca\x16\x2a\x1d\x5d\x0c\x74\xdb\xf3\x3e\xfb\x1c\xfb\x62\x9b\x11\x79\xa9\xac\xaa\xbc\x95\x24\x0c\x6e\xab\x66\xda\x48\xa5\xcc\xc8\xc8\xc8\xc8\xc8\xc8\x88\xc8\x48\x1a\xf4\xa6\xa1\xa8\xe2\x47\x17\x92\xd2\xe6\xd2\xbc\xc7\x30\x37\x90\x35\x3b\xeb\xfe\xfe\x77\x8f\xd6\xf2\x7e\xda\xa4\x53\x21\xf3\x0a\x56\xeb\x6e\xd4\x27\x84\xfa\x2f\x25\x04\xd1\xe8\x06\xab\x35\xc5\x48\x90\xe5\x11\xc7\x21\xbc\xa3\x39\xcb\xf9\xe9\x5a\xa2\x67\xc4\x37\x89\x89\x8b\x0c\xf3\xe4\x26\xec\x8f\xc9\xfa\x70\x04\x2a\x05\xb8\x99\x29\xff\x23\x99\xad\xc3\xc4\xc0\xf3\xe3\xb9\x06\xd6\x4f\x9a\x52\x0f\xd9\x1e\x23\xf9\x97\xb7\x84\x60\x5f\xa5\xd3\xc0\x51\x97\xb4\x42\x94\x82\x91\xfb\x68\x66\xb0\xb2\xfb\x56\x48\x1b\x25\x9a\x5a\x32\x1a\x71\x44\xb0\xe4\x05\x3a\x14\x7a\x61\xff\x8a\x2c\xa5\xcb\x9e\xff\x61\x49\xe9\x4f\x61\x9d\xc0\xc2\x83\x78\x50\x5a\xfa\x07\x1c\x75\x60\xa1\x1c\xb0\x6e\xa3\x34\x41\xaa\xff\xe4\xa4\xd2\x1a\x06\x97\x46\x7d\x26\x3d\x22\x83\xec\x38\xa7\xd2\xf1\xa2\xee\xb3\x8a\xfd\xc6\x09\x53\x60\x5a\xa5\x5b\x4c\xaf\xa2\xad\x8f\x4a\xaf\x18\x56\xce\x6f\x72\xe0\x21\x6b\xe4\x06\x9d\x30\xad\x49\xd4\xeb\xbc\x0e\x21\xd1\x7c\xbe\x58\xd8\x27\x7c\x36\x84\xa0\x10\xb8\xc6\x79\x92\xb9\xdf\x46\xe3\x35\x21\xeb\xf4\x88\xc5\x14\xe3\xa2\xbd\x32\x22\x6a\xe2\x98\xa8\x08\xcf\xb2\x7e\x24\x5e\x1c\x96\x39\xa8\x45\x9d\x70\x72\x09\xe0\x26\xfc\x89\x33\xce\x3f\x3d\x5f\xc9\x36\x84\x06\x17\x1c\xc6\x07\x58\x71\x53\xdf\xbf\x7c\xf1\xfe\xfc\xaa\x70\x03\x91\x52\xd9\xb2\x8a\x52\x29\x14\xfd\x6c\x89\xaf\x59\x22\xd0\x11\x5a\xa1\x82\x49\x44\x6b\x90\x46\xca\x08\xa2\x4c\xc7\x25\x4b\x0b\x40\x22\x80\x10\x9d\xd7\xa8\x93\xc0\xde\x0d\x7b\xbe\xf4\x81\xd3\x12\x47\x6a\x8b\x15\x29\x99\x8f\xef\x67\x20\xa5\xd8\x05\xa1\x6a\xa4\x30\x06\xa1\x79\x2f\x10\xd1\x95\xf6\x35\xd9\x66\x8e\x4b\x95\xa5\x95\x71\xfc\x6e\x30\x00\x9f\xd5\x28\x2c\x2d\xf1\x9f\x47\xa0\xe5\x96\x7c\x43\xe6\xfc\x3c\x17\x73\x6e\xb3\xbb\x74\x07\x89\xbe\xc8\xa2\xd3\x15\xe8\x0a\x18\x57\x40\x11\x83\x38\x11\x1a\xb6\xbc\x3a\x95\xc4\xdb\xb4\xfa\xb3\x02\x19\x93\x74\x06\x62\x2a\x00\x36\x12\x88\x54\xdd\xcc\x17\xfe\xba\x94\x8b\x02\x2f\x4c\x29\x3e\xda\xb3\x92\x2b\x89\x44\x74\xb8\xb2\x00\x1e\x13\x65\x10\xa9\xd9\xc9\x53\x96\x70\x09\x87\xc3\xb2\xa7\xd1\x0f\xf8\xc3\xf1\xa5\x85\xd5\x03\xca\x40\xa8\xfc\xd5\x5f\x4d\xa3\x01\x0f\x41\x94\xf7\x96\xcf\x38\xf6\x75\xa5\x15\x11\x7e\x80\x37\x9a\xab\x46\xae\xc2\x69\xf4\x8a\x44\xc3\x6b\x43\x7e\x0e\xb2\x0d\x9c\x90\xdd\xec\xbf\xce\x8e\xdf\x9e\x9e\x6c\x7b\x1c\x95\xdb\xeb\x88\x2c\x51\x60\xbf\xe0\xaa\x08\xd9\xca\xb7\xd0\x65\xc6\x8a\x58\x17\x2b\x45\x39\xb1\x12\xad\xac\xac\x7c\x65\xde\x09\xa3\xfe\xe7\x81\x45\x67\x38\x68\xab\x80\x99\x17\xae\x84\xa6\x36\x16\x0e\xee\x59\x18\xb6\x82\x03\x38\x5f\x49\x5b\x3e\x83\xff\xf4\x42\x75\x17\x2d\x67\x20\x06\x24\xa3\xd9\x94\x50\xb7\x92\x37\x52\x20\xde\x56\xa0\x52\x49\xe8\x5b\x4b\x4b\x66\x1e\x62\x5d\x60\x4c\xc9\xd9\x48\x66\x51\x2b\x2f\x31\x10\xee\x4e\x20\x4e\x72\x85\x15\x2f\x9b\x6f\x3b\xb1\xc6\x35\x17\xd6\xb8\x85\x35\x6e\x61\x8d\x53\x5a\xe3\x98\xc0\xba\x61\x4b\x9e\xc6\x26\xf7\xaf\xb0\x4b\x38\xfb\xde\xfb\x2d\xea\xb5\x3f\x91\x76\x3f\x92\xef\xd6\x2c\xdb\xd3\xe5\xe6\x26\xb5\xc0\xfc\x77\x0c\x17\xbb\x06\x58\xc9\x98\x95\x7b\x96\x8c\xde\xac\xee\xeb\xe0\xb3\xf7\x5b\x4c\x96\x8e\x5f\xae\xec\x96\xc2\x7a\x66\x2d\x48\x6b\xa2\xff\x62\xeb\x46\x2a\x5f\x19\x5b\x4c\x72\xde\x28\x17\xb3\xa2\x5c\xbe\xad\x2c\x4b\x6f\x3b\xca\x15\x0e\x21\xfb\x55\x26\x71\x1a\x7d\x97\x31\x57\x66\xd9\xe2\x60\xfc\x6c\x04\xda\xc9\x20\xee\x8f\x22\xb2\x17\x42\x66\x1f\x04\x78\xc4\x9e\x2c\x4c\xd4\xf3\x0f\xeb\x38\x59\x3c\x3e\x47\x44\x60\x8d\xf2\xd5\x83\xde\x28\xce\xc3\x88\x7b\x3d\x80\x01\x13\x80\x27\xa8\x23\xac\xdc\xa1\xbf\x46\x7d\x42\x73\xb9\x89\x14\x54\x76\xeb\x3c\x0d\x53\x1a\x47\x37\x21\x08\x03\x22\x66\x7c\x32\x0d\x08\x01\x52\x5b\x58\xef\x8c\x80\xe9\x85\xe3\xb8\xaf\x59\xb6\x81\x3c\x06\xab\x21\xef\x97\xda\x76\xc8\x7f\x25\x55\xf8\x47\x95\x65\x13\x10\x45\x97\x5b\x76\xd1\xc4\x5f\x79\x0f\x2c\x06\x4f\xbb\x95\x06\x94\xd3\xeb\x61\xdc\x8f\x27\xa3\xde\x3d\xe6\x3e\x75\xd6\x93\xa0\xb0\x69\x2b\xef\x11\x8e\x0f\xa6\xb1\x92\xa5\x49\x9b\x32\xd6\xf6\x3b\x29\x4a\x43\x0b\x4a\x93\xca\x4f\x29\x52\x2b\x95\x64\x32\xea\xa3\x18\x54\x03\xe0\xe9\x12\xe5\xec\x95\x83\xfe\xe7\xa0\x17\x75\x4e\x58\xc5\x92\x52\x37\xe6\xc9\xe7\xd2\x94\x47\xea\x2b\x23\xe3\x84\xa2\xc6\x26\x37\xd9\x1f\x9e\xd0\x77\xd8\x81\x15\x4a\xcf\x32\xd2\x8b\xc5\x86\xaa\xb6\xfe\xcc\x8e\xb7\x99\x66\x23\xba\xfb\x62\x6d\xe4\x03\xdf\x90\x18\xbc\xdd\x68\xf4\x2b\xf4\xee\x94\xce\xab\x50\xe8\xff\x2a\xf2\x8c\x09\x57\xdc\x7a\x69\xb2\x64\x2b\xda\x7a\xcf\x08\x45\x4b\xaf\xcc\x6c\x51\x0c\x66\x64\x56\xdc\x89\x15\xe4\xd8\xac\x05\x4a\xa8\xc5\xd3\xf0\x2e\x22\x90\x63\x60\xe9\x2a\xbb\x29\x59\x99\xd1\x59\xde\xf2\x39\x31\xf3\x83\xb1\x6b\x9e\x43\xe9\x2e\x98\xc1\x2e\xb2\x97\x05\x12\x90\x32\xda\x7d\xae\xa2\xef\xca\xfd\xac\x2a\x5a\x7d\xea\xc9\x61\x27\x7a\x0e\x8c\x2b\x4e\x02\x1e\x3d\xe8\x96\x9a\x3b\x05\x52\x71\xba\xec\xa3\x55\xf3\xa9\xd8\x56\x19\xaa\xbc\x82\x1a\xca\x49\xc3\xcc\xef\x14\xe4\x03\x4f\x2d\xc4\xe2\x7b\x9c\x5a\x88\xb8\x3c\xbf\xf2\x32\x7c\xba\xd9\xa4\x3e\xc7\xf1\xe0\xd3\x89\x3b\x33\x70\xf0\x4b\x1c\x95\xf9\xcc\xa2\xd1\x14\xd3\x28\x45\x91\x79\xcc\x1f\xa1\x39\x85\x63\xa1\xc0\xc1\xb6\x8c\x1b\x6e\xd8\x46\xc8\x61\xee\x8c\x39\xfb\x98\xd6\xa4\xe2\x93\x41\x80\x4d\x2b\xa5\x76\x6d\x74\x16\x62\x8c\xc1\xb2\xc1\x14\x74\x7b\xd7\xa1\xf4\x49\xbe\xb0\xfb\x7a\x4c\x6f\x8c\xfe\x8a\x2d\x1e\xa8\xb5\x50\xb3\x5c\x51\x14\x13\x61\xe2\x7a\xfc\xc7\x71\x27\xf6\xda\xbd\x30\xe8\x4f\x06\x44\xff\x03\x1c\x60\xe8\xf3\x06\x41\xa7\x91\x92\xa8\xa0\x90\x5c\xb4\x6b\x72\x76\x62\x81\xa0\x66\x28\x61\xc7\x70\x41\xab\x81\x17\xe4\x4f\x80\xb3\xe1\x51\x68\x11\x18\x99\x33\x10\x37\x54\xb0\x37\x92\x8f\x59\xb7\x20\xb2\xc2\x4b\xed\x58\x7b\xb2\x50\x65\x1b\x14\x8d\x6a\xc9\xb0\xcd\x9d\x94\xca\xf9\x24\x45\xb3\x0e\x1c\x18\x0f\xc4\x0e\x11\xf6\x83\xec\xa8\x1c\x1a\x3f\xae\x22\x30\xfb\x44\x76\x47\x25\x40\x31\x31\xa6\x9e\xef\xdc\x46\x5c\x40\x4f\xcf\x4d\x84\x96\xa3\x15\x3d\x85\xa0\x18\xdc\xdc\x0a\x81\xa3\x92\xf4\x3f\x3f\x28\xcc\xc9\xf6\x29\xbc\x1f\x95\x12\x78\x4b\xdc\x4d\xb8\xb9\xb9\xe9\x55\x20\x56\xc0\x3a\x76\xc0\xfc\xc3\x73\x5a\xa0\x94\x2a\xad\x90\xcc\xe6\xad\xea\x94\x03\x9e\xe8\x2f\x44\xaa\x13\x19\x0c\xd6\x00\x3e\xee\x37\x61\xfb\x3a\xe8\x47\xa3\x1b\x6e\x27\x2b\x2a\x94\x11\x64\xd1\x61\xa5\x78\xc8\x03\xfa\x29\x0c\x07\xe2\xfe\xea\x1c\x1d\x5f\x3e\xff\x38\xba\x8e\xfa\x80\xd5\x5d\x3b\xbe\x19\xf4\xc2\xbb\x68\x7c\xbf\xd1\xc8\x98\x83\xe0\x01\x46\x2e\x81\xea\x40\x46\x8e\x9a\x41\xc5\xd8\xa9\x46\x87\xf0\xc1\x30\xbc\x89\x3f\x87\x1e\x1c\xec\xc4\x72\x60\x9d\x6e\x87\x83\x31\xee\xf4\xd9\x2b\x65\x45\x42\xae\x7b\xaf\x1f\x52\xd2\xb6\x42\x06\xa8\x03\xa4\x9b\x10\x68\xf7\x5e\xeb\x1e\x69\x0f\x74\x66\xf7\x44\x23\x43\xff\x06\x0a\x0c\x5c\xd3\xbd\x94\x07\x8b\xf2\x20\x45\x0c\xf0\x2d\x93\xbe\xac\x44\xfd\x4e\x78\x77\xdc\x2d\x61\x3e\x22\x98\x13\x97\xcf\x96\x90\x0d\x97\xd5\x0e\x6b\x78\xa4\x89\x40\x40\x7c\x58\x11\x82\x4a\x7b\xe2\x32\x3f\x83\xa0\xa2\x5a\x87\xc8\x7c\x5d\x4c\xb0\x98\x1a\xcb\x80\x05\xdb\xf4\x0c\x9e\x47\x78\x7b\x2a\xb1\x9a\x39\x0c\xe7\x34\xab\xd8\x81\x42\x83\x17\x4c\x33\x8f\xbc\x66\xaa\x01\x05\x9d\xc8\x74\x4d\x51\x18\x9a\x92\x3c\x4d\x64\x85\x5c\x39\x39\x38\x3b\xbf\x3c\x39\x3e\x3c\x3c\x78\xfb\xfa\xf2\xfc\xe0\x68\xf7\xf8\xdd\xb9\xda\xba\xe2\x32\xf2\x86\xad\x85\x6d\xdf\xf0\x8d\xac\x59\x4e\xdb\x17\xe8\xd8\x0e\x51\x27\x08\x09\x2f\xb2\xb3\x48\x14\x38\xc0\xd4\x6c\xb9\xdf\x0b\x4a\x32\xd1\xd6\xca\x60\x32\xba\x2e\x65\xa7\x3e\xdf\x28\x29\xab\x11\x0c\x68\x2d\x52\xd4\xba\x2f\x03\xfa\x26\x8d\xcd\x69\xd0\x9c\x36\x7b\xa2\xd5\x3c\x5f\x41\x08\x57\x30\x48\xad\x60\x11\xce\x48\x14\xd2\x38\x33\x52\xb3\x2e\x4d\xff\x23\x52\x55\x61\x38\x96\xbc\xad\x99\x34\x7f\x74\x17\x89\x22\x59\x3f\x18\x08\xf7\x02\x21\x44\xa8\x5f\x72\x5a\x5f\x60\xc5\x5c\x9c\xcf\x92\x42\x7b\xcc\xe7\x50\x94\x7f\x2d\xb6\xcf\x8d\x33\x3b\x5d\x4d\xd6\xdf\xf3\xe3\x9d\xe3\x0d\x3e\x3d\xbc\x5e\x7c\xf5\x5f\xda\x4d\x71\x6c\xdb\x16\x3f\xe4\x46\xd8\xc1\xde\x9a\xdb\xbf\x32\x04\xf2\xc8\x62\xe9\x15\xc2\x42\x25\x6b\x3c\x29\x7f\xf0\xf4\x50\x27\x19\x56\x18\x6c\x66\x7c\x8a\x14\x1a\x27\x7f\x90\x53\x3f\x85\xde\x08\x82\x31\x80\x5f\xd9\xfa\x10\x8d\xc8\x82\x09\x2e\x5a\xb6\x44\x83\x07\xb3\x8b\xa9\x2b\x86\x90\xe9\xbd\x97\x51\xd0\xd4\xc0\x81\x8e\x98\x2f\x92\xce\x7c\x82\x87\x16\xfd\xa4\xdf\x2b\x22\xe0\x74\xd3\x4b\x57\x16\xbf\x68\xb4\x05\x0a\x43\x32\x59\x6b\xca\x7d\xf5\xc2\xde\x28\xb4\xc2\xe8\x06\xa4\x94\x32\xb4\x86\xa5\xf1\x70\x1f\x1c\x06\xf1\xa7\x9f\xb4\x78\x4d\x01\x14\x46\x1c\xd9\x4c\x12\x51\x1a\x23\xa8\x86\x10\xc8\xe7\x08\xa2\xc8\xb8\x4c\x61\x29\xc5\x1e\x1a\x49\x83\xa0\x34\x74\xc9\xc9\x3b\x2b\xb5\xd3\xf8\x3a\x58\x62\x69\x53\xee\xe6\xa5\x5c\x58\x4a\x5a\x0d\xd2\x06\xa7\x50\x5f\x2d\x1c\x28\x5b\xa3\x27\xca\x54\x77\xc4\x33\x37\x2d\x7c\xa7\xe9\xb9\x99\xff\x18\xce\x2a\x4b\x79\xa3\x56\xdd\x83\x5a\x72\xd7\x64\x85\xe3\x31\x51\x83\x2d\x89\xc1\x50\x9e\xb3\x34\x24\x98\x6a\x05\xce\x22\xb2\xb4\x2f\x2a\xd1\xcf\x8a\x26\xb7\x62\x8a\x70\x75\x77\x3a\x72\xcc\x32\x14\x4c\x7a\xbd\xb6\x08\xe5\x59\x84\xf2\x2c\x42\x79\x92\x0b\x03\xd9\x56\x7e\x3e\x97\x06\xce\x72\x48\x4e\x73\x07\x7d\xf6\x0a\x7a\xb9\x4a\xf1\x73\x75\x5c\xb4\xf8\x86\xcd\xf0\x56\x87\x6c\x61\x60\x68\x84\x26\x01\x17\x46\x90\x4d\xe3\xc8\x9b\x50\xa5\x9c\x65\x5e\x29\x83\x4a\x1e\x8d\xf1\xd2\x12\x0f\x2f\xa1\xa6\x2a\x0d\x55\xbf\x52\x2a\x93\xbc\x13\x66\xa6\x10\xf9\xd7\x8c\xdd\x1b\xf4\x16\xcd\x7e\x99\xeb\xf9\xdc\x9e\x22\x4b\x60\xcc\xc3\x9d\xcf\xf7\x02\x55\xe2\x3e\xcb\xb9\x91\xaa\xc0\xd4\x68\xe0\x01\x93\xb7\xc8\xaa\x18\x53\x45\x8b\x93\x6b\xa4\x58\x72\x8d\xe1\xd3\xfc\x49\x6b\x08\x5a\x45\xc0\x51\x0d\x7e\xf9\xf2\xef\x7f\xc7\xae\x51\x4b\x3b\x21\x16\x2e\x42\xea\x5e\x72\x6d\x9d\x67\x34\x47\x8a\x28\xeb\x2b\xcc\x5c\xf0\x70\xd7\x7e\x72\x51\x32\x3d\x5b\xc5\xc6\x29\x39\x62\x85\xa0\x95\xfa\xf4\xec\x44\x14\x0a\x73\x2f\x18\x61\xcb\x67\x63\x60\x4d\x38\xfa\x25\xc6\x58\x4f\x7e\xa2\xcb\x43\x0b\x69\xd6\x47\x89\x03\x87\x59\xc8\xca\x37\x24\xfb\xd0\x51\x4c\x8f\x15\x0d\x06\xb8\x98\xa1\x55\x8c\xac\x02\x63\x30\x01\x96\x89\x14\xef\x82\x28\xa4\xa2\x8e\xc7\x6a\x21\x69\x32\xae\x1c\x05\xe2\x3f\x29\x30\x27\x23\xa0\x1a\x19\xcd\x18\xf0\x27\xa3\xb9\x01\xf6\x6a\x8a\x67\x7b\x1e\xd3\x05\xa5\x1f\xde\x8d\xbd\xed\x93\x77\x5e\xfb\xbe\x0d\x4a\x01\xef\x33\x0e\x83\x58\x8a\xb1\x5b\xd8\x7f\x16\x6e\x4e\xb6\xae\x6d\x10\xe4\x23\x4a\x2a\x7d\x73\x92\xfd\xc9\xa0\x3e\x59\x7a\xc5\xf9\x48\x57\x9c\xa8\x3d\x15\x73\xaf\x15\xf4\xa6\x7c\xe2\xae\x81\x2b\x8f\x09\xa4\xdf\x60\x2b\xd9\x73\x11\x92\xeb\x4b\x75\x34\x82\x87\xaf\xd3\x54\x60\x6c\x89\x32\x65\x00\xcb\x45\xb0\x7f\x2d\x27\x9b\x3f\xf0\x79\x09\xf9\xc7\xde\xcb\x96\x6b\x6a\xfa\x43\xe1\x99\x26\x58\xfe\xa0\x50\x62\xc7\x4e\x1f\xd5\x4c\xdf\x32\xaa\x9d\xa7\x9a\xc3\xc3\x59\xdd\x3f\x57\x85\xf6\x02\xd4\xe7\xc4\x5c\xee\xbd\xa0\x8b\xd9\x8b\x17\xaa\x1a\x42\x8c\xa8\x6c\x71\x58\x22\xe8\x74\x44\x30\xbf\x40\x58\x55\x30\xcb\x21\xaa\x7d\xad\xb4\x22\x89\x68\xfd\x74\x09\xe9\xdc\xaa\x56\x9b\x17\xd4\x95\x0f\xf2\x25\x68\xba\x9d\xc7\x01\x51\x22\x7e\xcc\x73\x4c\x9a\x3a\xd4\x4e\xa8\xeb\xfe\xb4\x38\xcb\xcc\xe5\xb0\x45\xca\x4d\x0e\xe1\x25\x2c\x49\xa3\xaf\x1c\x1b\xed\x30\x5b\xb6\x49\x02\x6b\xed\x4e\x33\xb3\xa5\xcc\x5c\xd2\x9e\xec\xa7\xd6\x17\xfb\xa9\xc5\x7e\x6a\xb1\x9f\x52\x1e\x8d\x18\x43\xae\xd6\xae\xfe\x50\xc4\x83\xe4\x1a\x39\x68\x91\x39\x91\xda\x40\x45\xe4\x4d\x3e\xbc\xff\x8e\x3a\xf5\x4c\x49\xac\xce\x08\x42\xe3\x5d\x56\x50\x95\xc4\xca\x1e\x96\x81\xc6\x61\x44\x89\x13\x43\xae\x90\xee\x1f\xdb\x22\xa9\x0a\x8a\x1d\xd2\x19\xe1\xfc\xfe\xd5\x57\x4c\x26\x60\x2c\x00\x3b\x33\xd2\xac\xb2\xcc\xaf\x41\x6f\x12\x7e\x05\x9b\x21\x51\x6b\xa9\xb7\x1b\x1a\x85\x7b\xb2\x5c\x63\x89\xca\xb6\x68\x45\x4c\x98\xcc\x7a\x92\x0e\xf6\x80\x84\x83\x88\x3f\x6c\x1f\xcb\x14\x0b\x83\xce\x80\x86\x79\x3a\xa6\x90\x8f\x11\x68\x59\xca\x9f\x87\x46\x05\x1a\x8a\x71\x13\x6e\xc9\x14\xd6\x0d\x80\xf0\x76\xf3\xd2\xb3\x88\x5a\x61\x69\x13\x2c\x1f\x56\xee\xe4\xb9\xd2\x6b\xc6\x5a\xdb\x21\x7c\x43\xf4\x72\x63\x7a\x02\x4e\x88\xf3\x98\x65\xd7\x4a\x51\x01\x01\xb1\xa6\xe1\x56\xf6\x2c\x45\x9c\x5c\xa4\xc6\xbd\x11\x9e\xf1\x16\xf9\xd0\x30\x93\xb6\x94\xf1\xab\xb4\x84\xad\xb3\x0e\xf5\x47\xe3\x68\x3c\xc1\x3c\x0c\xa6\xe8\xff\x4e\x38\x88\x47\xd1\x38\xd5\x11\xd6\x84\xe8\x01\x02\x6c\x13\x59\xda\x1f\x3b\x44\x88\xba\xa3\x95\x8b\xf8\x64\x0d\x9b\x86\xa0\x30\xbe\x86\x01\x30\x69\xc9\x76\x91\x20\x66\x05\xe9\x44\x07\x0f\x02\xb5\xc3\x21\x11\xf5\x82\xfd\x9c\x25\x84\x60\xa7\x59\x45\xc5\x93\x90\x12\xa2\x37\x33\x89\x0b\x7e\x2e\x16\x98\x09\x5c\xa8\x52\xbe\x70\xd5\x4e\x4b\x64\xc0\x23\xb0\xf3\xbf\x42\xb3\x1b\xb4\x71\xd5\xa4\x22\x98\x6c\xd0\x3f\xb9\xcd\x97\x8a\x77\x8a\x32\x0a\x63\xd9\x84\x4f\xf0\x82\x60\xb2\x90\xf3\x25\xc9\xe3\x4b\x95\x57\xba\x99\x80\x8f\x1c\x62\x40\xe0\xe4\xb1\xe0\xf6\xd6\xfd\x38\x1c\xd5\xaa\x4b\x6c\x47\xf9\xd3\x92\x95\xb7\x58\xcd\x6f\xc9\x51\x23\x3d\x4b\x71\x80\x74\x52\x42\x68\x26\xd9\x2a\x78\x51\x87\x7c\x81\x94\x39\xea\x35\x72\x4a\x3e\xe4\xe4\x76\xe1\x3e\x44\xc7\xb2\x68\x05\xa8\x59\x48\x7a\x86\x7a\x77\x85\x9c\xca\x07\xb2\x44\x2a\x11\xb1\x37\x86\x15\x6e\x85\x0f\x22\x6f\x4d\xc1\xbe\x0f\xc9\xa0\xb9\x4d\x13\x1f\x2b\xc5\x9e\xc9\xa4\x33\xc1\x86\x09\xf7\x50\x20\x5e\xe1\x4b\x72\x45\x6e\xa3\x52\xc4\x07\xc7\x1b\x4c\xaa\xfb\xc5\x37\x5f\xff\x90\xfd\xa0\x31\x28\xbb\x5d\x82\x77\x3c\xbc\x2f\x53\x77\x9b\x72\x3f\x0d\xbb\xda\xb8\xeb\x09\x52\x6c\x6e\x7a\x3f\xd3\x1c\xe5\x3f\xeb\xe2\x10\xb7\xe3\x9b\x9b\xb8\xff\xaf\x33\x85\x71\x27\x4b\xd7\xe4\x13\x43\x85\xf7\xa4\xf4\x33\x50\x76\x18\xfe\xbc\x54\xf6\xa4\x57\x61\xbf\xbd\xdc\x0a\x46\x61\xb3\x9e\xf9\xe1\xa6\xd3\xc8\x16\xfd\x3c\xf8\xd4\xe9\x66\x5e\xb6\xa3\x01\xd9\x9e\x2d\x53\xc8\xb9\xc5\x0d\x9d\xfb\x72\xaf\xa9\x0f\x92\x76\x9a\xd3\xee\x67\xb0\x4c\xd2\x1f\x56\x82\x1b\xb5\xfb\x9b\x10\x61\xeb\x68\x27\xff\x9e\x56\x2b\x5d\xf0\xce\x51\x97\x6d\xd2\x27\xfc\x0e\x5d\xa1\x3f\xd0\x1e\xe0\x67\x19\xf1\x0f\x62\xe0\x34\x3d\x50\x63\x44\xf3\x93\x7a\xa5\x16\x51\x03\xc9\x16\x49\x61\x20\x11\x63\x40\x98\x63\x65\x7b\x78\x3f\x18\xc7\xff\x3a\x33\x05\x6a\xa3\x91\x42\x56\x49\x44\xa5\xbc\xb9\xd9\x66\xef\x24\x18\x92\x75\x60\x38\x6e\x4f\xc6\x23\xd5\x84\x1e\x7a\xdb\x84\x4d\x78\x03\x0a\xed\x0c\x8b\x5c\xf6\xa2\x16\x14\x5b\x21\x7f\x35\x65\xd0\x94\xbf\x8d\xf4\x84\x92\x50\x63\x45\x7a\xa7\x85\x1c\xf4\xae\x62\x04\x0d\x1f\x94\xd6\x55\xd2\x85\xc3\x38\xfe\x04\x9e\x22\xc8\x04\xa5\xe9\xc6\xd9\xab\xe3\xf7\x2a\xd3\x1c\xff\xfd\xe0\xed\xaf\x97\xb6\x32\x67\xef\x5e\x5d\x1e\x1d\xbc\xbf\xac\xb8\x14\xf2\x5d\x0a\x55\x5d\x0a\xd5\xac\x78\xbb\xe0\x25\x17\x34\xe2\x26\x17\x34\xe2\x27\x17\x14\x38\xea\x64\xd3\x00\x8c\x45\x3d\xf3\x38\xb9\x18\xe7\x25\x68\x9d\x78\x02\x27\xd5\x11\x9a\xba\x30\xae\xb2\xba\x2e\xc0\x23\x82\x24\x23\xbc\x1c\x86\xfc\xf9\xc5\xab\x36\x9a\xe4\xc3\x8b\x17\x46\xff\x00\xee\xc7\x48\x61\xbf\xba\x66\xf5\x23\x74\x2e\x22\x08\xdf\x23\xc5\x7f\x41\x37\xa8\xae\x9c\x5e\x8e\x28\xa0\x95\x28\xb8\x25\xef\x77\xaf\x72\xe7\xfb\xaa\x69\x27\xe0\xaa\x7f\x52\x46\xd2\xc1\x43\x48\xfc\x5b\xd0\xfb\xe4\xbd\xde\x2b\x55\x7f\x5f\xd3\xb8\x7e\x80\x68\x77\x86\x1b\x75\xf0\xf7\xc8\x50\x60\x6a\xd2\x4b\x1c\x30\x6a\xc5\x77\xfa\x82\x18\xfc\x08\x38\x12\x3c\x7e\xf7\x4a\x77\x09\xc5\xd8\xe7\xaa\xf4\xb9\x26\x7d\xae\x9b\xdc\x3c\x08\xb1\x44\xfe\xfd\xe7\x3f\xff\xe9\xad\x61\x2d\xf2\xe5\xef\x64\x14\xba\x5d\x3a\x1a\xcd\x9a\xa1\x3a\xc8\x98\x8b\x3b\x18\xc1\xd1\x9d\xa1\x18\x17\x47\x17\x23\x2c\x7b\x67\xf3\xa6\x71\x8a\xdc\x4c\x7a\xe3\x68\xd0\x23\xbb\x5a\x98\x47\x66\xda\xdc\xc1\xec\xee\x5c\xe4\x22\x49\x73\xe5\xea\xb4\x5c\xd5\x5a\x70\x8d\x16\xac\xeb\xc3\x17\x33\xe8\x8e\x26\x2d\x0f\xb7\x0c\x44\x87\x8a\xee\xc8\xf6\xa2\x37\xb9\xe9\x8f\xb4\x02\x42\x6e\x0c\xf4\xe6\x52\x07\xe9\xf3\x1c\xa6\x40\xc5\xe7\x63\xc1\xbe\x92\xff\x55\x4c\x03\x29\xa4\x26\x1d\x8e\xd2\x18\x59\xa2\xbe\xe4\x7d\x81\xcf\x74\x74\x1d\xaa\xfb\x72\x75\xbf\x29\x55\x27\x5f\x1c\xea\x57\xe5\xfa\x6b\x52\xf5\xaa\x91\x0d\x85\xe0\xa5\xd5\xc7\xae\x14\x8f\xfa\x9f\x65\xaa\xc3\xd7\xa9\x28\x4f\xc6\x3a\xa1\x33\x9b\x54\x75\xf6\xaa\xc2\x5f\x54\x53\x43\xe3\x3c\x32\xa9\x35\x8d\xcd\x80\xa2\xc3\x93\x5a\xee\x52\x30\x0a\x8c\x51\x6a\x29\x4c\x01\x71\x1f\xa8\xd4\x2a\xc9\x60\x38\x8f\x16\x3a\xc6\xd1\xeb\xa8\xca\x51\xc7\x1f\xb4\xc7\xa9\x23\xc9\xe5\x87\x89\xc2\xcd\x39\x2c\x42\x08\xaa\x4a\x06\xb5\x73\x01\xff\x23\xcc\xf0\x3b\xf9\xfe\x41\x99\x9e\x32\x55\x8f\x48\xe2\x4d\xac\x74\x17\x19\x0b\xeb\xd7\xac\xdc\xab\xd2\x92\xda\xeb\x4e\xc8\x78\x32\x0c\xdb\x94\x92\x1d\xef\x94\x6c\x19\x99\x02\xa2\x56\x67\x4e\xb7\x8f\xdf\x82\xae\x50\xb9\xab\x54\xca\x84\x51\x2b\x3e\xfe\x5b\xc5\x7f\xeb\xf8\xef\x5a\x19\x19\x18\xfe\xad\xe2\xbf\x75\xfc\x77\x0d\xff\xf5\x5b\xf0\x6f\xad\xa9\xd1\x82\x52\xd6\x17\xfe\x3c\xf7\xb6\x76\xcf\xe8\xe5\xb8\x1e\xdd\x6c\x78\xa0\xe7\x0e\xa3\xf1\xf5\xcd\x8a\xaa\xfc\x4b\x35\xee\x00\x65\x93\x69\xcb\x2b\xf4\x8b\xa4\x5c\x43\x2e\x39\x43\xca\xc8\xcb\x4e\x7c\x0a\x27\xea\x36\x4c\x8e\xd3\x0c\x65\xcf\x3e\x45\x03\x76\x1e\x90\xec\xd8\xfa\xa7\x31\x9a\x8c\xae\x83\x91\xd7\x0a\xc3\x3e\x9e\xd5\x66\xa1\x29\x10\xca\x05\x47\x50\x3a\x51\x07\x7c\x3d\xec\x68\x93\x99\x99\xd1\xd5\x7a\xc9\xa1\xf2\x53\x5c\x97\x04\xcc\xc9\x30\x8a\x87\xa7\xf4\xfc\xdf\xe6\x66\xf2\xde\xca\xfc\xda\x03\x05\xfc\xd1\xaa\x43\xbc\xc7\xfa\x6d\x12\x7f\xf8\x69\x9b\x4d\x35\xbe\xc9\x5b\xcb\x3a\x4a\x4a\xfc\x16\x0f\xf1\x58\x0f\x1c\xdd\xbb\x85\xcf\xf6\x2a\x67\xd1\x1f\x21\xab\x31\x8a\xae\x5e\x81\x7c\xf7\x5e\x7a\x75\x67\x51\x23\x4e\x57\x0f\x91\xec\xe6\xf6\xf8\xd8\xf0\x4e\x25\xdf\x39\x26\x2f\xbc\x66\xf1\xa6\x81\x7a\xa3\xf6\x75\x08\x06\x0b\x0f\x76\xcc\x96\x5e\x8f\x4e\x49\x19\x10\xca\xbc\xfd\x17\xa0\xe0\x3d\x77\xef\xb5\xdc\xa0\x9d\xc2\x1c\x33\x69\x28\xa5\x77\xda\x5d\x06\x3c\xc9\x71\x2c\xc0\x99\xaa\xbc\xf4\xe3\x2f\xac\x1b\xec\xbb\x45\xfb\x85\x07\xa6\x88\xa8\x4b\xc9\x6d\xad\x03\x8f\x84\xee\x05\xd6\xff\x40\xc7\x0b\x99\x8d\xbd\xb1\x08\x70\xb7\xe5\x01\x1e\xae\x29\xe4\x1a\xc5\xe4\xda\xc6\xf1\x91\xfb\xf9\x13\xeb\xe9\xdf\x44\x4f\x9d\xba\x0a\x0f\x19\xe6\x53\x22\x70\x60\xfa\xb8\x55\x18\x1b\x96\x77\xe7\x26\xcf\x88\x72\x55\xb0\x49\x54\xf4\x79\x63\x1f\x24\x3d\x07\x7f\x48\x34\x15\xb6\xcb\xf8\x20\x69\x31\x72\x89\xb5\x54\x01\xec\x02\x83\xcc\x5e\x17\xe9\xc6\x11\x51\x0a\x61\xc9\x74\xed\x06\x59\xd6\x61\x01\xbd\x60\xc3\xf5\x32\x61\xcc\x2f\x5e\x85\xf5\xc9\xc2\x5b\xf0\x48\xd6\x40\x2e\x48\xfe\xe9\x35\x61\x09\xc8\xb0\x01\x11\xff\x64\x9f\x56\x80\x15\x9e\xf6\xb8\x58\xe9\xe2\x32\x74\xba\xf9\x9d\x9b\x7f\x8c\x86\x1f\x88\xd6\xa6\x3b\x73\x25\x35\x3c\x15\x4e\x99\x2d\x87\xbb\x9c\x25\xa5\xdf\x28\x44\x6d\xee\xb5\x9b\xb4\x85\x6a\x89\xc0\x15\xdf\x12\x99\xcb\x5f\x39\x88\x5d\x59\x7a\xb3\x95\x67\x59\xd4\xb7\xce\x2d\xb4\x18\xf1\xf6\xff\xe6\xc8\xbb\x5a\x21\xfa\x6d\xe4\x74\xdd\xda\x4c\xa1\x5e\xff\xe2\xd5\x31\x1d\x01\xfd\xe2\x3a\x7f\xd3\x23\x7f\xc1\xa1\xf1\x7d\x94\x11\x3d\x67\x2a\x18\x1a\x49\xef\x46\xd3\x32\x01\x66\x50\x7a\xa7\xa9\x11\x0d\xa4\xa0\x9b\xe0\x49\x6f\x39\xd5\x62\x24\xdb\x6a\xed\x22\x2d\x4f\x1c\x46\xad\xe0\x4f\x70\x11\x9c\xfa\x97\xb0\xdf\x06\xe7\x00\xee\x36\xe4\x0d\xc4\x51\x99\xe8\x73\x5d\xa2\xf7\x1a\xc7\x98\xce\xed\x4e\xbc\x2d\x60\x24\x15\xcb\x79\x25\xab\x9c\x18\x6d\x92\x8f\x7e\xf2\xb1\x9a\x7c\xac\x95\x51\xc8\x6a\xcf\x34\x68\x3b\xd4\x09\x67\xe9\x10\x2c\x34\xb7\xc1\xc0\xab\x92\x5d\x0f\xec\x7c\xea\xe3\x6b\x07\x3d\x16\xe6\xdf\xd1\x05\x05\x0f\x2a\xac\x69\x00\x53\xe5\xd2\xf5\x6a\x8e\xf5\x6a\x76\x1b\x84\xcb\xc0\xa4\xe7\x4c\x39\x3d\x51\xd2\x5f\xfd\xf4\xd7\x6a\xfa\x6b\xad\x2c\xec\x9d\xd6\x63\x03\x07\x60\xbf\xfa\x7e\x28\xac\x2a\xa7\xe7\xbd\x14\xc1\x95\xcc\x57\xf6\x66\x9b\x0e\x36\xde\x65\x9b\xdc\xa9\x36\x7f\xb6\xa1\x83\x8b\x22\xf0\xc6\x21\x0c\x84\xa2\xdb\x4c\xe8\x8e\xc5\x82\x5f\x91\x86\x00\x24\x9f\xbc\x50\xe5\x2e\x64\xc9\xd5\xf6\xb3\x03\x9f\x81\x60\xe4\x04\x84\x50\x4d\x43\xa8\x66\x21\x58\xcd\xe2\xa3\x5a\x96\x89\x32\x10\x6a\x56\x7b\xf9\x9b\xcc\xbe\xd8\x6e\x13\x94\x95\x15\xeb\x6e\xf8\xd4\xb2\xe3\x17\xea\x14\x1d\x33\xb0\x20\xb2\x8f\xbf\x70\x66\x60\x2f\x1c\xd4\x28\x64\xb3\xa8\x3b\xc6\x59\x5b\xd6\xb8\x00\x9c\x59\x84\xf7\x75\x0c\x6c\x22\x19\x8d\x2b\x42\x6d\xff\x5d\x32\xd5\x97\x08\x3f\x64\x95\xf6\xdf\x25\x53\x7c\x89\x8c\x76\x46\x65\xff\x5d\x32\xb5\x93\x91\x4c\x5e\xe7\x14\xa6\x17\x2f\x6c\x0b\x2f\x22\xea\xa7\x11\xf5\x35\x88\x56\x2d\x88\xd6\x8c\x88\x56\xe6\x80\x68\x35\x8d\x68\x55\x83\x68\xcd\x82\x68\xc5\x88\xa8\x3f\x07\x44\x6b\x69\x44\x6b\x1a\x44\x2b\x16\x44\x7d\x23\xa2\x55\x3b\xa2\x56\xbe\x7f\x37\xc0\x90\xf2\x11\x1c\xec\x31\x17\x46\xa9\x37\xd6\xf9\x4e\x45\x29\xe0\xa5\xb1\xc9\x9c\x8f\xa5\x60\x20\xc7\x55\x5b\x29\xa0\xe2\xd8\xe4\xba\xb4\x5b\x48\x95\xb3\xba\x80\xac\xc7\x49\x5c\xa2\x9b\x67\x69\x06\x67\x37\xde\x8a\x49\x9c\xdd\x79\xe7\xe7\x71\x6a\xeb\x9d\x4c\xe4\xa5\xe2\x7c\x27\x66\x31\xc7\xd4\xd7\x63\x9a\x9f\xc5\x39\x4c\x73\x13\x39\x8d\x69\x65\x56\x4c\xab\x12\xa6\x55\x3d\xa6\xf9\x69\x9c\xc3\x34\x37\x93\xd3\x98\xfa\xb3\x62\x5a\x93\x30\xad\xe9\x31\xcd\xcf\xe3\x1c\xa6\xb9\xa9\x9c\xc6\xb4\xea\x80\xa9\x99\xd5\x43\x7e\xe1\xa5\x5d\x1d\xfc\x60\x9b\xc9\x59\x7d\xd3\x38\xa1\xd3\x9a\x88\x65\x5e\xe7\x34\x52\xdd\xf4\xd6\xab\xa4\xcc\x54\xb4\x01\xb1\x19\xde\x4b\xaf\x56\x55\x78\xe0\x34\xfe\x37\x8d\xc7\x8b\xeb\x98\x42\xbd\x1d\xb1\x44\x5b\xcc\x03\xf6\x6c\xe4\xd1\x50\x47\xa2\x2c\x12\x15\xa7\x1b\xb4\x43\xa5\x2b\x4c\x09\xfc\xbf\xc3\xbb\x00\x82\x92\x9d\x2b\xc0\x83\x79\x0a\xb0\xed\x31\xf8\x5b\x93\x78\x37\x70\xa5\xad\xb0\x1d\x6e\xe9\x86\x9f\x67\x25\x24\x29\x7b\xed\xee\x95\x72\x6f\x99\x40\x1c\xf4\x02\xe8\x00\x01\x98\x85\xc8\xb6\x98\xa5\xa4\x4d\x1b\x50\x85\xdb\x6f\x5b\xe1\xe7\xbb\xa4\x37\x75\xed\x87\xbd\x41\x38\x2c\x91\xdf\x73\x61\x84\xdc\x59\x9a\x7e\xcd\x22\x83\x65\x24\x53\x43\x9a\xdc\x15\x47\xe3\x6f\x53\x81\x91\x1b\x5e\xa3\x4a\xa3\x25\xd9\xb7\x5a\x36\xc0\x92\xbc\xab\xcb\xb1\x95\xe4\x7b\x53\x04\x5d\x6e\x78\x4d\x3f\x09\xb0\xad\xce\x31\xc0\xf6\x69\x46\xd6\x3e\x7e\xfc\xeb\xf7\x19\xc9\x9a\x17\x27\xcf\x3d\x16\x4f\x0a\xbd\xf2\xc0\xd1\x1f\xf7\xf1\x82\xdb\x6c\xb9\xcc\xec\x11\x6c\x0e\x31\xa8\x0c\xc4\x97\x2f\x72\xf4\xa1\xc8\x83\xf4\xc8\x31\xb3\x64\xfa\x24\xc1\xb2\x81\x32\xb9\x19\x94\x03\xff\x1d\x66\xbd\x13\x85\xc5\x1b\x1d\xe4\x49\x17\xcf\x40\xa0\xf0\xd8\xe2\x11\x07\x49\x5b\xca\x9f\xb5\x3d\x09\x31\x13\xc7\x36\x48\x4a\x4d\x99\x77\xe3\xee\x1a\x42\x27\x45\x56\xe0\x8b\xa1\xc7\xcd\xba\x28\x49\xbf\x6a\xdb\xcd\xc6\x06\xab\x4a\xed\x7e\x1e\xbc\xd9\xd9\x4b\x82\x23\xe8\xf7\x62\x21\x1a\xad\x11\x3d\xf4\x02\xe2\x8c\x87\x69\x8c\x43\xb2\xcc\x10\x59\x5b\x60\x69\x12\x77\x60\xfe\xd9\x67\x59\xad\xb9\x2f\x0b\x0f\x66\x8b\xd5\x0f\x7d\x25\xe4\xf5\x0a\xbf\x44\x69\xc3\xab\x7b\x25\xbf\xba\xe6\xb5\x22\x22\xfb\x5c\x81\x47\x9f\x15\xb0\x0f\x7e\x9d\x07\xe8\xcb\xdd\xb7\xdb\x97\xef\xf7\x8e\x4f\x8f\x2e\x8f\x8e\x77\x76\xbd\x2d\xcc\xf7\x38\x0e\xfa\x44\xfd\x0f\x07\x10\x16\xd2\x87\xf4\x2d\xdc\x38\x0c\x73\x8a\x88\x4a\x35\xad\x94\xf0\x77\x76\x9d\xe0\xb3\x85\xd4\x08\x5f\x13\x31\x93\x09\x45\x17\x5f\xd5\xac\x6f\x89\xa0\x51\x73\x0e\xed\xde\x36\x66\x90\x9b\x0c\x31\x20\x93\x9d\x3b\xcf\x89\x28\x51\x5c\x0b\x46\xa2\x92\x98\xdc\x30\xc6\x64\x7c\x43\x18\x55\xa2\x42\x4d\xd8\x61\x6e\x10\xaa\x1e\x14\xc6\x26\xb5\x4d\x29\x08\x03\x0f\xd1\x41\x36\x70\x1e\xf2\x3e\x2f\x69\x75\x43\x63\xb7\x51\x13\x19\x51\x64\xd8\x9c\x89\xfa\x2e\x1c\x61\xa3\x03\x3d\x73\x25\x11\x01\xe6\x0b\x50\x01\x22\x5f\xac\xf5\x78\xc6\x27\xd2\x4d\xaf\x74\x8c\x98\x04\xbd\x25\xac\xdf\x56\x8d\xd4\x14\x84\xd5\xe3\xc0\xaf\xe3\xa2\xdc\xf6\x15\xf8\x9a\x53\x06\xb8\x5b\xad\xe8\x5a\x60\x82\x31\x21\x6a\x17\xaf\xa7\x57\x94\xcd\x15\xe1\x49\x14\x66\x59\xb5\xe5\xc1\x67\x2b\x54\x0b\xdd\xa5\x43\x1d\x0f\x4b\x2c\xc4\x04\x87\x8b\xe8\x8f\x84\x6b\x37\xc8\x7f\xc9\xaa\xa5\x4c\x30\x45\x9b\xd3\x31\x69\xba\x85\x8d\x54\x2a\x78\xa6\x48\x9b\x33\x64\x8a\x44\x23\x0c\x5b\x16\x73\x96\x16\x6c\x16\xb5\xdc\x33\x6d\x9c\xa6\x98\x1c\x0e\xe2\x0c\xeb\x2f\x26\x87\x2b\xcc\x27\x3b\x39\x76\xc2\x87\x9e\x1c\xa2\x85\xf9\x4d\x8e\xf4\xaa\xfc\x50\x93\xe3\xa0\x1f\x8d\xa3\xa0\x47\xb4\x94\x91\x17\x40\xe6\x84\xde\x3d\xeb\x52\x87\xd1\x75\xfa\x89\xc1\x15\x8c\x3b\x48\xaf\x73\x04\x37\x59\xee\xd2\xd4\x31\x78\xc5\x65\xb2\x36\xc5\x43\x79\x32\xe2\x39\x54\xcc\xc7\xc3\xa7\xa6\xd0\x47\xec\x73\xea\xbb\x9c\x8b\x0f\xce\xff\xa5\xfc\x0f\x2a\xd9\x3b\xe7\x99\x11\x11\xd6\x92\xa7\x83\x60\x03\x89\x97\x2d\x1e\xd2\xad\xc1\x00\xf8\x11\x47\xc0\x63\x09\x74\x0d\x9e\x33\x3a\x89\xba\x57\xdc\x57\x4a\x3e\x72\xbd\x8a\x4d\x1c\xb3\x9d\x71\x0c\x5b\x5b\x7a\x0e\x9a\xa0\x4a\x79\x2f\xb0\x99\xd2\xe9\x6c\x4d\x78\x7c\x33\xe1\x77\x83\xb1\x50\x44\x5b\xd0\x60\x20\x17\x13\x68\x44\xa7\x2a\x3d\xf1\x6d\xa3\x02\x46\x8b\x6b\x6f\x0e\x99\x4e\x56\x60\x64\x75\x7a\x1d\xc5\x0c\xa1\x23\x81\x1a\xfa\x5a\xbe\xf1\x0c\x60\x52\xca\xdc\x61\x2d\x93\x0e\x8b\xc6\xe2\xd3\xf0\x72\xc8\xf7\xe8\xb5\x70\xf7\xa2\x2f\xad\xd9\xdd\x60\x93\xf4\x2e\x79\x75\x32\xb9\x4c\x8b\x27\xe1\x10\xd9\x91\x4c\x03\x32\x9d\xc7\xe1\x32\xa3\x7d\x2f\xbe\xd2\xae\xb6\x5e\x12\x54\x72\xfa\x00\x9c\x80\x39\x64\x91\x06\x34\xcf\x03\x13\xe6\x64\xcd\x48\x64\x79\xd8\x99\x83\x4a\xf5\x65\xc4\x52\x44\x40\x63\xcc\xa1\x07\x42\x99\xb7\xcd\x1a\x96\x9a\x9d\x41\x17\x92\x16\x0f\xd1\x06\x4d\xeb\xcf\x72\x5f\x42\x1e\xbc\x6f\x2f\xde\x13\xe2\x6e\x72\x66\x67\xf8\x94\x9e\x01\x8e\xb9\x7c\x46\xc5\x60\xdc\xf2\x5e\x17\x9e\x3b\x0c\xc2\x46\xe6\x72\x37\x3a\x4c\x0e\xa2\x3d\x7d\xc3\x72\xe6\xa1\xec\x1b\x60\x29\x19\xac\x75\xb6\x50\xa4\xbc\xe0\x73\x10\xf5\xe0\x7c\x1d\x3d\xf0\x63\x90\x97\xb2\x06\x76\xc9\xa9\x32\xdf\xf9\xb2\x17\xf5\x99\x8e\x65\x54\x7d\x58\xe3\x7a\x75\xe5\x6d\x3c\x66\x89\xf0\xe8\x65\x14\x14\x6a\xa2\x7c\x40\xfe\xbc\x90\x08\x1d\x32\x1a\x9f\x43\xb2\x72\x06\x04\x3a\x99\x41\x13\xfc\x0e\x59\x22\x82\xce\x32\x91\x22\xe1\x4c\xea\x8a\xeb\x1c\x45\xf4\xbe\xf5\x4c\xa5\x8d\x3e\xb5\xf9\xca\x47\xca\xb0\x3e\x39\x55\x9f\x65\xbe\x0b\x20\xd3\x4f\x78\x0e\x62\xba\x19\xbf\x97\xf0\xc3\x04\x2b\xe8\x4b\x83\x7f\xc6\x11\x30\x3c\x06\x59\xa1\xab\x62\x0b\xaf\x98\x72\xd1\xc5\x4c\xef\xd0\x4f\x26\x86\xc2\x0e\xbb\x51\x8a\xaf\xc6\x7b\x32\x27\x58\x65\x52\x1e\xd4\xf4\x2e\x65\xb0\x3b\x83\x4b\x59\x5b\x92\x9a\xb0\x1d\x0a\x66\xf6\x0e\xa4\x86\xbe\x68\x7a\x17\xbb\xe1\xe9\xa1\xba\x98\x70\x46\x4a\x6f\x76\x50\xcc\x97\x8d\x20\xad\xe2\x8d\x1b\x46\xd8\xb8\x9f\x5f\x87\x92\xce\x4b\xb7\x55\xa4\xe1\x6b\x74\xfe\xc2\x36\x70\x06\x69\xc6\x37\x9f\x5b\x7d\x8e\x3f\x26\xee\xe4\x12\x13\x76\x20\x4c\x64\x2a\x08\xf0\x1d\xd9\x69\xd8\xb9\xd9\xbc\x43\x30\xe3\x4a\xcf\xed\x56\x0b\x0b\xaa\x14\xbc\x0d\xa7\x54\x27\xf0\x88\x62\xa3\xb0\x47\x86\x81\x22\x77\x36\x86\x0b\x10\xae\xee\x4b\x2e\x07\x5f\x25\xc7\x32\xee\xed\x20\xa7\x35\xae\x8e\xcf\x9c\x4e\x5f\x30\x8e\x38\x09\x46\x23\x90\xd2\xe0\x18\xe8\x68\x53\xf6\xc8\x8f\xfb\xd9\x0b\xd6\xc4\x59\x38\x44\xb3\x0f\x68\x48\x6e\x2d\x4c\x29\x4b\xb9\x24\x4b\x52\x5b\x63\x6b\x8e\x47\x88\x5d\x7a\xc4\xe6\x89\xbc\x28\xe5\xa3\x47\x5c\xcf\xae\x25\x37\x3e\xa8\x19\x40\x04\xa8\xd0\x6e\x94\x3d\xb7\x40\x95\xcc\xa3\x17\xd7\xf2\xc3\x26\xfd\x46\x8e\x78\x99\x20\x96\xb9\xf5\x2d\x1d\x2a\x53\xf6\x94\xad\xb9\x9d\x9f\x33\xfe\x6c\x5a\x97\x75\x0b\x5c\x49\x75\x2d\x42\xc1\x60\xa8\xb4\x6f\x99\x7c\x0e\x89\xa4\x9f\xa7\x8b\x19\xb7\x1a\xcc\x11\x2c\x9f\xb4\xae\x55\x97\x5b\x11\x3d\x1c\x9b\xb6\x9f\x50\x3d\x1c\xef\x1e\x95\xbc\xc4\xbe\x57\xaa\x55\xf5\x4e\x62\x8d\x93\xf5\x0c\xfb\x93\x71\xb5\x66\x5f\x3a\xa6\x28\xd8\x53\x68\x7a\x36\xfd\x8e\xef\xbc\x20\xd7\x39\x18\x83\x6c\xfb\xae\xbc\xae\x84\x86\x92\xe4\xa4\x03\xdf\x8b\xfd\xf4\xd3\xb3\x6e\x6f\x32\xba\x7e\x36\x85\xce\x44\x41\x16\xd6\x9a\xc4\x40\x92\xc1\x98\x99\xed\xd0\x2c\xd8\x0f\x88\xa4\x18\xe8\xc2\xeb\x74\x7e\xf3\xcb\x1b\x6a\x52\xdc\x5e\x61\x1f\xfe\xcc\x5f\x41\x61\x6a\x3a\xcd\xf1\xa9\xd4\x17\x08\xd0\xc8\xf5\x1a\xa4\xa4\x18\x38\x66\xf0\xcc\x25\x98\x63\xef\x65\xc7\xf6\x34\xce\x7c\xd9\x71\x87\xe8\x82\xb9\x3d\xd9\x3d\xcf\x4d\xb9\xdb\x4a\x53\xc6\xea\x02\x13\x70\x72\x81\x01\x38\xc3\xbf\x23\x95\x8c\xb3\x17\xd7\xb8\xd0\x13\xba\xfd\x6a\x3b\xe7\x55\xe6\x4b\x42\xf4\x99\x66\xc8\x98\xa7\x13\x39\x81\xed\xec\x29\x13\x70\xb8\x87\x43\x82\xf1\x70\xce\x63\xc1\x83\x89\xcd\x66\xc1\x83\x0f\xcd\x83\x89\xf3\x76\x4e\x3c\xa8\xf4\xd5\x4e\xc1\x83\x02\xce\x43\xf3\xa0\xc9\x47\x3b\x5b\xe8\xc2\x93\xe3\xc1\x07\xe3\x25\x83\xc0\x98\x92\x99\xb2\xee\x4d\x47\x0e\xa2\xaa\x8d\x70\xd9\xb6\x6d\x7b\x2f\x76\xfa\xf7\x33\xe4\xb4\xfc\xac\x63\xab\x99\x55\x14\xa6\x20\xd2\x7b\x4c\xb7\xaf\x03\xd2\xb9\xfe\x55\xf1\x30\xbf\x57\x18\x8b\x7b\xc9\xa9\x0e\x27\x4c\x5c\xb2\x8c\x9a\x3c\x4d\x29\x05\x06\x60\x1a\x39\x5e\x33\x56\x09\x72\x19\x25\x45\x84\xdd\x69\x35\x4b\xe3\xe2\x40\x40\x86\x82\xb1\x0a\xe2\x94\xe2\x4a\x20\x1b\xf9\x6e\xd6\x95\xcc\xe8\x50\x94\x4e\xc4\x2d\x6d\x63\x6e\x18\xa7\x73\x3a\x18\xb3\x43\xcd\x3a\x3c\xb1\xbe\x11\x76\x66\xb6\xd3\xdd\x8c\xb0\xbf\xb3\xcd\x4d\xcc\xf7\x34\x9e\x7e\x71\x4c\x83\xe3\x9b\x27\x76\x7a\x06\x00\xb2\x8f\xb7\x64\x94\xe8\xbd\xdb\xb4\x0f\x78\xb1\x9a\x56\xac\x38\x74\xc0\x2c\x5e\xec\x00\xe0\x41\xfe\x63\x5b\x13\x7a\x56\x1f\xba\x4f\x85\x88\xc8\x5a\x60\xda\x24\xeb\x18\x02\x1e\x19\xae\x2c\x5c\xd2\xd0\x9d\x0e\xfd\x5a\x13\xa8\xc1\x93\x0a\x1f\x91\x45\x93\xc3\x49\xd0\x64\xb3\x2b\x7c\x0c\xe2\x95\xcb\xf9\xcc\xf7\xc7\xa7\x68\xdb\x64\x33\xc8\x5c\xfe\x2e\x1e\x22\x51\x12\xbf\x79\xd9\x4b\x11\xa5\x9c\xe0\x63\x33\x51\x30\x64\xe5\x64\x1a\xa5\xdc\xf0\x59\xd1\x3f\x0d\x6f\x42\xdc\xdf\xa3\x6a\x48\x19\x94\x45\x05\xa1\xf9\x16\xb3\x49\xb6\xd4\x77\x72\xca\x0f\xdf\xeb\x86\x9f\xf9\x2d\xd2\x74\xf1\x1c\xc1\x75\x4c\x25\xde\x39\x71\xb4\xcc\xa9\x97\xba\xc4\x22\xd3\x8b\xb9\x4e\x38\x83\x98\x13\x9a\xd2\x42\xcc\x2d\xc4\x1c\x3c\x3f\x92\x98\x9b\x97\x9c\xc0\x43\xbb\x04\x44\x71\x29\x61\x45\x91\xcd\x4f\x94\xc6\x44\x2a\x3b\x49\x4f\x39\x73\x4f\x5e\x7a\x9a\x00\x14\x15\xe5\x56\xf4\xcf\x13\xc2\xb6\x20\x05\x2d\x93\x0a\x20\x52\xa3\x78\x32\x9a\x4e\x08\x0b\x52\xcf\x55\xcc\x0a\x56\xe7\x44\x28\x69\x7b\x3e\x97\xe4\x31\xb8\x6f\xe0\x5b\x08\x5b\xe8\xcc\xf6\x75\x1c\x13\xae\xbc\x89\xee\x40\xf9\xb7\x50\x0d\x73\x8d\x99\xb7\x3a\x1c\x89\x16\x23\xa9\x05\x03\x86\x05\x99\x2f\xf1\x67\xdc\x44\x82\xa5\x65\x34\x69\x8d\xe0\x02\xd1\xfe\xd8\x6a\x4f\x86\x47\xda\x2d\x89\x63\x85\xa6\x01\x74\x72\x9b\xc9\x7d\xc8\x30\xca\x0c\x11\x06\xa0\xfe\xd8\xba\xa4\x4a\xdb\x9f\x08\x1f\x6b\xf2\x7e\x78\x90\xbf\x92\x93\xe9\xd1\x07\x48\x1e\x89\x30\x2e\xa2\xe9\x72\x42\xab\x7f\xe0\xa7\x9a\x5f\x6d\x2b\x80\xea\xd3\x46\x6b\xf6\xa3\x27\x41\xa7\x03\x4c\x38\xa5\xd5\x7c\x10\x74\xd0\x68\x4e\xff\x16\xb4\x99\x9f\xbc\xd9\x3e\xf3\xfe\xef\xc6\xcb\x55\x6f\xc0\xb0\x18\x31\x2f\x59\x11\x24\x4e\x3e\xb5\x47\xab\xb8\x1b\x26\x50\x56\xf8\xb7\x29\x76\xc0\x84\x14\x2c\xd6\x72\x32\xe2\x77\x8e\x8b\xb4\xd5\xec\xb8\x31\xde\x94\x2a\xf0\x9e\xcb\x51\x16\x6c\x51\x0e\xb5\x84\x6e\x58\x01\xa8\x1d\x61\xec\x9e\x04\x29\x74\x0d\x81\x8e\xc4\x9d\x68\x40\x68\xbc\x15\xed\x3b\x31\x5b\x0a\xfb\x92\x18\x5b\xf8\x94\x44\x56\x95\x0d\xb7\x5a\x68\xa3\x29\x83\x4e\x36\xae\x6a\xee\xcb\x81\x00\x47\x13\x56\x6f\x4a\xe3\xe4\x94\xc6\x79\x42\xc4\x30\x9f\x14\x98\x70\xc6\xdc\x5c\x9f\x4d\xe3\x5c\x6b\xf4\xc5\x32\xb2\x41\x92\x3f\xfb\x6f\x99\x02\x56\x7c\x68\x14\x0e\x47\xc8\x9c\x6f\x16\x93\x44\xd0\x92\xc0\xe5\x98\xc6\x3a\x85\x5e\x92\xf9\x24\xf7\x9e\xa5\x3b\xc9\xbd\xc7\x24\x27\xa9\xb7\xc5\x50\x76\xc6\x56\x79\xcf\xb9\xfc\xa8\x16\x8a\x34\x62\xe4\xd5\x0b\x97\x24\xa0\x72\xab\xf4\xfe\x70\xe9\xcd\x14\x7b\xce\x4c\x6f\x08\x7a\x42\xc8\x70\x53\xac\xdc\x64\x39\x8d\xb6\x35\xdc\x77\xab\xd3\xb1\x53\x13\xd9\x0c\x22\xf9\x82\x31\x6f\x6c\xbe\x16\xfa\x77\xfd\x81\x90\xd2\x28\xe3\xae\x83\x0e\xcd\xcd\xcf\x24\xdb\xe3\x8a\xee\x49\xdf\x24\xbc\x9f\xbc\x68\x45\xf4\x67\x08\x5b\xc5\xfa\x59\xd9\x6a\x13\xa8\x90\x3f\x31\x09\xe0\x48\x09\x3d\xbc\x91\xd1\xeb\x05\xa3\x31\x7e\x2f\x26\x03\x93\x5d\xf4\x45\x29\x2d\xfe\x96\x21\x9b\x3e\xe6\x61\xfa\xc0\x32\x26\xd9\x98\x9f\xa9\xca\x6e\xfc\x9f\xb4\xb3\x99\x93\x59\xaa\x4a\x2a\xa7\xc2\xbc\x62\x0f\x9e\x52\xb0\x8d\x2d\x25\x83\x3d\x0a\x42\x15\x01\xe1\x1a\x6b\xf3\x6d\x93\x19\x80\xcb\xe3\x2b\x75\x92\x9d\x0b\x73\x13\x0d\x04\x41\x8b\x84\x44\x16\xa2\xc8\xbb\x40\x64\x8c\xf4\x55\xcc\x10\x80\x9b\xd5\x9b\xf3\xd0\x71\x62\x17\x9c\xc6\x98\x2b\x81\x11\x55\x3a\xd9\x67\x98\xc6\xd0\x33\xec\x89\xe2\xfa\x54\xfe\x30\x5c\x8d\x28\x7d\xd5\x67\x65\x98\xf2\xfc\x18\xe5\x48\x7d\xc1\x6d\xe9\x50\x5b\x81\xa3\x62\x8e\xd7\xa6\xa4\x8f\x48\x1a\xd6\x74\x61\x42\x00\x7a\x6b\xdd\x8f\xbc\x24\x73\xbe\x42\xd9\x1b\x7a\xfa\xd1\x81\x12\x09\x0f\x9a\x6d\x0d\xb9\x63\x96\x7c\x2f\x9e\x0e\x46\x77\x32\x48\x40\x6b\xa8\x89\xa1\x45\x1a\x2d\x9d\x99\xf0\x14\xbb\xcd\xe0\xe5\x73\x33\x5a\xe9\xc0\xf7\x25\xc2\xd5\x33\x61\x26\x4c\xe8\x2e\x26\x94\x37\x61\x38\x00\x4b\x78\x2f\x84\x35\x2a\xee\xf3\x99\x1e\xf5\xa9\x95\x19\xcf\x25\xa2\xce\x88\x4b\xa3\x79\xf1\x80\x87\x76\xe9\x26\xea\xd3\x23\x8d\xcc\x1a\x6a\xbc\xd9\x4a\xff\x13\x03\x46\x99\x45\xea\x30\xe5\xf3\x1b\x3c\x20\x4c\xad\x82\x84\xf5\xfe\xfe\x77\xab\x87\xde\x98\xe1\xf9\x64\x66\x4b\x73\x82\x6e\xda\x1c\xee\x64\xf3\x34\xe2\x36\x4d\x90\xa5\xdb\x3e\x2f\x51\xb6\xc5\x39\x68\xf6\xca\x36\x29\x39\x4e\xdf\x66\x32\x42\xd4\x28\xd1\x94\x41\x3f\x71\xda\x95\xe0\x2e\x9b\x1d\xa5\xc1\xfd\x31\x7e\x2e\x66\x2d\xe6\x61\xaa\xf4\xa4\x98\x8b\x51\x71\xba\x40\x55\x1d\xb4\x07\x93\x1f\xdf\xb0\x6f\x56\x44\x70\x43\x54\x60\x5c\xa9\x8a\xaf\x42\x64\xea\x43\x5c\x0f\x15\x0e\xcc\x4e\x47\x29\xb4\xe3\x82\xc1\xe8\x90\x7d\x1b\x22\xf1\x51\xb9\xeb\x72\x25\x15\xb7\x73\xe1\x38\x1c\x2a\x35\x3d\xab\x72\x2c\x6d\x03\xa5\x24\x9b\xa0\x94\x0d\x83\x5b\xe9\x95\x35\x3b\x98\x3a\x93\x06\x4d\x1e\x2a\x54\xea\xa2\x90\x92\x00\x31\xa2\x0e\x76\xf8\x7a\x44\x61\x81\xbc\x32\x1e\x4b\xd5\x01\x1d\x05\x3d\xda\x43\xfc\x80\x80\xd1\xb1\x16\xd0\x9b\xf0\x08\xe4\xcf\x54\x83\xe6\x52\xd6\x0a\x9c\x87\xc5\x25\x7b\x75\xe9\xf8\x97\xf9\xca\x40\x37\x6d\x7b\xd6\xde\x9b\xb5\xee\xf6\x75\x78\x33\x7b\x1b\xea\x6d\x16\xf3\x50\xe3\xa9\xe3\xae\x04\xdb\x0a\x6e\x0f\x53\xba\x7c\x05\xad\x83\xfc\x1d\xb3\x23\x75\x2c\xa7\x07\x7f\x9b\xdd\x34\xb4\xe3\xfe\x67\x52\x3d\xb5\x8b\xc3\x09\x22\x4e\xfa\xe1\xf1\x3f\x7a\xc4\xaa\x78\x42\xba\x13\x0a\x2a\x9d\x96\x4e\xbc\x9c\x31\x7a\xdd\x9e\x59\x27\xdd\x95\xe9\x4d\x3f\x22\x79\x8d\x8c\x7f\xe6\x30\x61\xd0\xbf\xe7\x03\x36\x88\x47\xa3\x08\x0e\xc6\x3b\x49\x1c\x0b\x0a\xf3\xc9\x5d\x93\x0c\x44\xfe\x70\x20\xfd\x8d\xdb\x0a\x75\x6b\xe0\x73\xf1\x29\x91\x4c\x1b\xd2\xe7\x24\xb7\x8d\x15\x00\x91\x1a\x1b\xe9\x74\x38\xd6\x2a\x99\x64\x39\xf6\x0a\x20\xaa\x36\xf0\xdf\x02\x95\x84\xe4\xd9\xc8\x67\xfa\xb1\xd7\x66\x9b\xe1\x6c\x64\xac\xbd\xa2\xd8\x21\xa7\x4d\x73\x6f\xb6\xcf\x56\xed\x95\xa5\x95\xb3\x6e\x2f\x2d\x84\x83\xd4\x18\x7d\xb7\x72\x3c\x08\xfb\x67\x67\x87\x46\x10\xb3\xe7\x28\x92\xd9\xd1\xbe\x2b\xb8\x89\xee\x0e\xfa\xe9\x3a\xf3\x3d\x7c\x40\xc5\xdf\x68\x7a\xf9\x87\x70\xac\x02\x24\x2f\x9b\x33\x39\xb0\x34\xe2\x19\x6c\x3a\xc5\x1b\xe5\x27\x3b\x79\xda\x08\x5c\xba\xf1\x73\xd4\x8d\xb2\xc2\x71\x0a\xf8\xe3\x6b\xc8\x99\xec\xed\x0e\x87\x64\x83\x7b\xd0\x25\xa2\x37\xc9\x3a\x96\xf4\xb0\x1f\xd3\x37\x99\x15\x08\xc2\xde\x47\x9e\x21\x7e\xec\x01\x65\x21\x25\x82\x08\x33\x62\x42\xf1\x85\xf7\xec\x99\x3d\xe1\x83\xb2\xee\xca\x38\x3e\xc3\xf7\x2e\x79\x27\x2c\x10\x34\xf3\xb1\xf0\x8c\xe3\x00\xe5\x59\x27\x68\xef\x72\xf6\x22\x29\x0d\x79\xa4\x71\x1e\x26\xf5\x57\x38\x23\xdd\x0b\x83\x99\x0a\xd4\xec\x01\xf3\x74\xca\x4c\x1b\x9f\x40\x11\xc6\x10\x05\xf1\xb1\x60\x94\x02\xa3\xbf\x6a\x6a\x16\xc1\x85\x81\xd9\x13\x34\xdd\x14\xe8\xf1\x21\x9e\x2e\x6c\x41\xc8\xae\x40\x2f\xb8\xfa\xbc\xf9\x65\xc8\x27\x4e\xd4\x52\xd0\x4a\x66\x15\x66\xb2\xd6\x90\xd1\x89\x24\x2d\x7e\x56\xd5\x4b\x25\xc4\xe6\xd9\x99\xc7\x3a\x8c\x15\xd3\x3e\x9c\x71\x61\xa0\x99\xf6\xd2\x3c\x73\x59\xfe\xb4\xd2\x40\x80\x99\x6a\x11\x2e\x60\xf1\x96\x2f\x9c\x48\x09\xb8\xe4\x17\x8b\x75\x1b\x77\x97\x99\xca\xf0\xce\x6a\x51\x43\xc2\x99\xed\x69\x00\xc7\xc9\x72\x2d\x9c\xa0\x2a\x3f\xfa\x45\xe5\xae\x51\x6b\xfa\xcd\xf6\x6a\xbd\xec\x55\xee\x9a\x8d\x66\xbd\xd1\x6d\x74\x3f\x2c\x71\xdf\x37\x36\xc3\xbf\x24\x1d\x77\xb0\x56\x15\x44\xcd\x89\xa8\x2e\xd6\x1b\x01\x34\x59\x88\x68\x9a\xfa\x07\x52\xb4\x4c\x22\x49\x4e\x35\x33\xaf\xad\x1b\x17\x1f\xc9\xb4\x9b\xbb\x24\x49\x67\xfa\xe5\x72\x71\x8e\xa2\xf0\x71\xd3\xfe\xe6\xb7\x8e\x19\x49\x45\xba\x37\x0a\x4b\x29\xb9\x36\x45\x6c\x16\x81\x21\x8b\xa8\x04\x9c\x35\x2b\x02\x54\xf5\xe8\xdd\x2c\x05\x64\x14\xe5\xf2\x1c\xf2\xce\x1e\x40\xd7\xa6\x78\x74\x91\x64\xcf\x43\xc7\x86\xad\x9d\xf3\x70\x34\xa6\x01\xba\x44\xa6\x98\x85\x5b\xa6\xb1\x8b\xca\x07\xb0\x72\x27\xb2\x0a\x7c\x3c\xd9\x32\x3e\x2b\xc3\x65\x98\x8b\x27\x61\xf7\x8e\x86\x1b\x98\x31\xe2\x24\x60\xe2\x3c\x27\x44\x33\x98\xb0\x00\xfb\x2a\x44\xf4\xb9\x1e\x0b\x82\x98\x0c\x04\x8f\x71\x22\x09\x40\x73\xdd\x5c\xc3\x03\x6c\xb9\x62\x8a\x25\xcc\x55\x4d\x45\x79\xf8\xcd\x19\xa5\xaf\xd2\x00\xa3\xb6\xaf\x94\x25\x93\x86\x76\x1b\x3e\x7b\x48\x09\x97\x59\xb7\x43\xc8\xf6\x36\xa4\xc1\x56\x14\x5b\xd9\x26\xed\x05\xa0\x77\x8e\xa4\x1c\x47\xce\xc2\x4e\x9b\x5d\x25\x97\x30\x29\xc9\xb1\xa2\xfa\x69\xd6\xdc\x17\x73\x8f\xfd\x10\x2a\x3e\xdf\xe7\x9b\x76\xf6\xdc\xf0\x2a\x53\x8d\x11\x8d\xa6\x5c\xeb\x77\x28\x77\x0b\xe3\x43\x12\xdb\x61\xb1\xd4\xb8\xde\x84\x61\x98\xf0\x14\xe9\x8d\xdc\xe6\x45\xc3\x75\xd3\xdd\xa9\xc1\x62\x01\x80\x91\x58\x8e\xa7\x87\x48\x26\x97\xb8\x18\x2c\xf6\x14\x43\xde\x4d\x86\x1e\x0d\xb5\x66\x9f\x93\x8c\x9b\x7f\xd1\xa4\xe9\x6a\xb5\x66\xeb\xaf\xa1\xd4\x80\x0c\x53\xdb\xc4\xf3\xb2\x46\x64\x24\xcb\xdb\x84\x53\xc9\xc9\x1c\x4c\x40\x0f\xd7\x78\x92\x51\xde\x64\xa4\xfd\xa6\x88\x94\x85\x18\xd1\x68\x8e\x53\x98\x93\x15\xa9\xe8\xf4\x79\xe2\xe6\x9b\xfb\x7e\xfa\xb4\xf7\xbb\xb6\xf3\xd7\x52\x42\xd9\x38\xc9\x59\xa1\xba\x78\xc6\x9a\x21\x2e\xa7\xe7\x26\xe9\x13\x44\x7e\x5a\x46\xa9\xb9\xaa\xba\xdb\x48\x9d\xa4\x31\x1a\xf6\x66\x04\xcf\x42\xf5\x61\xa5\x13\x89\xf3\x74\xfa\xc4\x74\x9a\x94\xab\x52\x97\xd2\xb3\xcc\x95\xb8\xef\xca\x52\x0c\x66\x80\x20\xcc\x0a\x99\x0b\xe6\xe2\x92\xe3\x89\xb1\xb3\xb9\x3c\x75\x35\x25\x0d\x60\x4c\x97\xb9\x8a\x70\x32\x25\xb5\xd8\x2b\x4b\x45\xc9\xc1\x94\x3d\x9d\x6b\xa9\x29\x39\x9b\x60\xc6\x74\x2d\x76\x1a\xbd\x56\x3b\x95\x7a\xc1\x02\xfa\x46\x82\xab\x84\xe7\x43\x17\x54\x81\xf5\xbe\xa9\xaa\x21\x73\xad\xd0\x36\x32\x61\x25\xd2\x57\x02\xd6\x96\xda\xfb\xaf\xa1\x73\x64\xd2\x8f\x8b\xbb\x67\xbf\x23\x3d\x23\xb9\x2f\xd7\xbe\xb4\xf2\xb4\xa0\x8a\xa5\x95\xcf\x21\x96\xa5\x56\x4a\x15\x3a\x97\x65\x76\xde\xe8\x66\xf5\x8a\x6f\xa0\x12\x68\x93\xb8\x6a\xd2\xab\x3e\x15\xad\x80\x99\x44\x25\xdb\xa7\x2c\x0d\x0c\x2d\xca\xab\x3b\x0b\x21\x44\x23\x96\xdc\xdb\x44\xde\x5a\xf1\x60\x72\xd2\xbc\xc8\xcb\xec\x91\xd2\x4e\x52\x37\x7f\x51\x02\x27\x8a\x86\x24\x6e\xd3\xc6\x70\xeb\x72\x2e\xda\x7b\x18\x2b\xb4\x72\x49\xc8\x0e\x81\x3e\x9a\x02\x2d\xf6\xc1\x68\x34\xb9\x21\x00\x52\x41\x4d\x41\x0f\xae\x80\xb8\x97\x94\x9a\x94\xd5\x84\x70\xe8\x75\xd0\xbf\x9a\xe5\xe6\x96\x62\x4b\x86\x5d\xde\x17\xb2\x5a\x80\xa0\xc7\x93\xca\x60\x72\x9d\xd7\xba\xaa\xb7\x9f\x4f\xfa\xf3\x6a\xe3\x3b\xda\x6d\xe6\x66\x33\x35\xa9\x1f\x73\xae\xf4\x92\x79\xad\x6e\x5c\x97\xb2\x3d\x67\x63\x97\x05\x06\x03\x6a\x12\x8e\x52\xea\x75\x59\x06\x15\xc8\xc0\xce\x23\x86\xa9\xc8\xcf\x4b\x2d\x53\x44\x01\x3c\x6e\xbe\x32\xd6\x8a\xab\x8b\xcc\xf1\x75\xc1\xf0\x85\x37\xea\xc8\xd8\x69\xe3\x19\x3e\x75\xba\x18\xcc\x40\xff\x4e\x19\xc9\x50\x24\x5a\xd7\x1c\xcd\xf0\x86\xa2\x03\x68\xcd\x16\xc4\xb0\x03\xe8\x60\xfc\x26\x20\x07\x52\x13\xd2\x92\x50\xbb\xe7\x80\x25\xe9\x9f\xdd\xf9\xc7\x21\xb1\x28\x5e\xf6\x05\xb5\x69\x68\x1e\x1b\x74\x4f\xbd\x90\x5c\x73\x1d\xd2\x38\xdd\xa8\xcf\xce\x42\xb2\xf8\x4f\x16\xc6\x7d\x15\xf6\xf1\x38\xa4\x3b\x64\x71\xc7\xb5\x06\x30\xbd\x18\xd9\x1d\x6e\xde\x92\x89\x5e\x13\x49\xad\xdf\xf2\x9a\x75\x3c\xcc\x89\x3f\xf0\x53\x84\x07\x5d\x2f\xbe\x89\x40\xeb\x2c\x83\xfd\x19\x7e\xba\x8d\x7a\x3d\x48\x24\xc1\x1b\x27\xcb\x1b\x19\xad\xf8\xa6\xa7\xdf\x52\xcc\xc7\xb4\x48\xa3\x6b\x19\x59\xcb\x84\x04\x65\x64\x13\x40\xea\x3b\x5a\x07\x28\xa3\x75\xf2\x8b\x80\x34\x83\x88\xf2\x18\xb6\x27\xe3\xb0\xf4\x8c\x5f\x4f\xf1\xac\xec\x55\x1b\xcd\x97\xb5\x6a\x19\x4e\x45\x90\xbf\x0e\x8a\xfc\x9c\x5a\x2a\x13\xa9\x4e\x48\x0c\xff\x19\xae\x43\xd2\x59\xe9\x28\x78\x79\xad\xe1\xb3\xae\xcc\xa7\x4f\x99\x71\x3b\xf5\x6b\xd9\x0f\x8b\x53\xb6\x63\x5c\xe7\xe0\x14\xfd\xc9\x29\xe4\x23\xe7\xa4\xa4\xf0\x4b\xcd\xba\xf7\xd2\x5b\x9b\xe5\x7e\x23\x2a\xd9\x24\xb1\x66\x56\xb0\xe9\x45\x95\xbb\x9f\x07\x6f\x76\xf6\x84\xfd\x4a\x5c\x2d\xc4\x45\xce\x0b\x46\xb4\xaf\x10\x64\x72\x33\x98\x60\x6e\x07\x4e\x58\xec\xb0\xd5\xa6\x17\xc2\xec\x1a\xbb\x23\x86\xa7\x53\x73\x4e\x5c\x30\x25\xc8\xf9\xd1\x18\x82\x4b\x7c\x54\x21\xdb\x89\x89\x7a\x50\x5f\x78\x52\x37\x45\xff\x1c\x72\xa4\x9c\xb2\x0d\xc2\x0c\x66\x41\x61\xc3\x13\xbb\xd2\x6f\xe3\x5a\x55\x99\x37\x53\x7e\xd6\x0e\x5b\x0d\xf9\x22\x92\x59\x08\x55\x1b\x92\xe7\xba\xad\xc6\xfc\x1d\xb4\x8a\x4b\x73\x84\x87\x56\xfd\x9b\xca\x8b\xf0\x84\x1c\xb6\x64\xaa\x91\xd5\x9c\xa8\x32\xfc\xec\x95\x4a\x61\x4b\x76\x3d\x7c\xe9\x33\x2b\x2b\x73\x71\xd3\x2a\x08\xe7\x76\x20\x9f\xf4\x66\x43\x52\xd2\x34\xac\x3c\x2f\x7f\x2d\xcb\xba\x32\x0f\x55\xed\x69\x3b\x70\x8d\x8a\xe4\x77\x62\x53\xfd\xc1\xfc\xb8\x0a\x89\xe4\xe4\xc2\x7c\xc6\x87\xd5\xe5\x16\xc8\x87\x47\x02\x6c\xa7\x8f\xe3\x46\x4d\x14\x8b\xa7\x64\x35\xcd\xa8\x55\xf1\xd8\xc5\x47\xa8\xd2\x8b\xa1\x5d\x50\x89\xb9\x2a\x2c\x75\x97\x8e\x93\xd0\x53\xd9\x77\xaa\xd8\x38\xe5\xcb\xa2\xfb\x34\x4a\x0d\x23\x11\x56\x50\xbb\x4a\xa1\xb6\x62\x4f\x23\xea\xe4\x4f\x4e\x99\x89\xf5\xee\x7c\x39\x47\x6c\x8e\x05\xd2\x88\xc9\xee\x67\x23\x7e\x47\xd1\x1d\x6c\x5d\x59\x6d\xeb\xe8\x48\x86\x3d\x7a\xde\x2b\xd5\xac\x93\xb9\xd8\x6a\xeb\x79\x08\x17\xe2\x13\x5d\xfd\x1e\xd0\xa7\xf8\x57\x58\x06\xff\xca\xae\x45\xd5\x72\x53\xd4\xb7\x38\xb7\xa5\x67\xee\xe8\xe6\x7d\x8b\x0f\xbd\x4c\x3a\xba\x16\x9f\xe6\x4a\xf9\x74\xfc\x8b\x8f\xbb\x62\xcb\x63\xb5\xe2\x64\x23\xf9\x06\x4b\x78\x61\xa7\xab\xde\x0d\xaf\x5a\xc1\xe5\xa1\x9a\x66\x11\x77\xf5\xc2\x6a\xdc\x24\xa9\x17\x3c\x33\x75\xf2\xfa\xeb\x12\x2f\xf4\x95\xcc\xdb\x9f\x57\x5e\xb6\xe3\x61\xf8\xf3\x86\xd7\xa8\x7d\xfd\xc0\x6c\x1c\x8d\xda\x86\x77\x91\xcc\xba\x61\xf8\xbf\x93\x68\x48\x86\xf2\x26\xee\x4c\x7a\xe4\x6f\x78\x37\x88\x87\xe3\xf4\xe1\xb5\x7f\x48\x57\x21\x0d\xe3\x18\x9c\x5a\x41\x7b\x1c\x0f\xf3\x97\x0a\x4b\x9e\x2c\x06\xc8\xdb\xdc\xdc\xf4\x7e\xa6\x9b\xa0\x9f\x95\x53\x18\x67\xd4\xcd\x4d\xdc\xff\xd7\x59\xfe\x47\x8a\xd6\x8a\x00\x96\x80\xe5\x38\xe4\x0e\x08\x33\x5f\x96\x84\x0a\x4d\x57\x4a\x31\xe1\x3d\xf9\x19\xce\x6e\xd0\x1f\x56\x82\x9b\x8e\x0e\xb3\xad\xa3\x9d\xfc\x7b\x5a\xad\x74\xf1\x21\x21\x84\x1a\x07\x35\xcc\xd7\xbd\xb8\x15\xf4\xbc\x52\x0b\x0e\x7b\x87\x43\x45\xea\x46\x20\xf2\x0a\x97\xb6\xa6\xae\x4a\x43\xcf\x18\x35\x93\x8f\x2b\x5d\x3e\xaf\x92\x3d\x17\x42\xdd\x03\x5e\xf1\xc0\xf8\x19\xf7\xc3\x7e\xfe\x12\x8f\xac\x28\x47\xf7\x59\x82\xa2\xf8\xf8\xe5\x8b\x7c\x73\xd6\x51\x30\xbe\x2e\x27\x09\xed\xd5\x64\x26\x48\xfd\x5f\x0c\x9b\xc3\x98\x4c\x3a\x6f\x10\xf7\xee\xbb\x51\x0f\xbc\x33\x54\xb5\x61\x16\x46\x51\x4e\x63\x48\x63\x97\x9b\x6f\xa6\x6b\x65\x50\xd2\xae\x23\xa2\xc8\x9e\xbe\x8c\xda\x32\x09\x4f\xee\xb6\x68\xc2\xf3\xc6\x25\x0b\x8f\xf2\x4c\x5a\xc0\xa3\x46\x91\xb1\x07\xc9\xdb\xc6\x31\x94\x23\x3d\x23\x50\x8d\xa5\x19\x44\x52\xb2\x1f\xde\x42\x4f\x0a\xc0\xee\x4f\x7a\x3d\x17\xe9\x95\x60\xad\x2a\xa4\xba\x07\x99\xde\x81\xec\x6e\xd2\x15\xdc\x34\x9d\x57\xb7\xb8\x27\xf7\x30\x6a\x0d\x83\xe1\xfd\xb4\x5e\x64\xa2\x74\xa1\x17\x99\xfe\x2d\xd8\x36\x68\x69\xdc\x27\x07\x1a\x39\x1f\x12\x32\x11\xa2\x3e\x59\x6d\x22\xed\x55\x86\xda\x0c\xaf\x00\x51\xa4\x76\xa5\x5f\xb2\x33\xc0\xc2\xc7\x26\x5d\xcb\x72\xc3\x11\xbf\x78\x14\x53\x07\x89\x43\xf3\x60\x86\x67\xdd\x61\x69\x88\xe9\xce\xc3\x68\x1e\x43\x80\xc6\xc6\xb2\x5b\xa0\x98\xa8\x66\xc3\xa8\x43\x9a\x3f\xa1\xe6\xe8\x28\x1c\x51\x85\x63\x70\x4f\xda\xc7\x24\x60\xa1\x84\xd8\x0c\x2d\x73\x4a\xf1\xa6\xcf\xe7\x05\xd8\xb8\xfd\x71\xa8\x3f\x8f\x4b\x94\x80\x8d\x8e\xee\xcf\xa9\x60\x48\x6d\x2e\x1c\x4f\x3a\xc9\xb9\x71\xa2\xb0\xd7\xd9\xf0\x9e\x7d\x0e\x7a\x93\xf0\x99\x29\xb6\xd9\x8e\x17\x3c\x37\xe1\xf8\x3a\xee\x38\xe7\x9e\x94\x6a\x9a\xae\x77\xa7\xa5\xf4\x7b\x19\x2c\x63\xb8\x19\x8a\x92\x24\x75\xd4\x96\xf3\xa2\xd3\x7d\x50\x83\xe0\xb6\x6f\x2e\x25\xad\x18\xa0\xb5\x53\xa7\x9b\x3d\xbb\x2e\x6b\x60\x6b\x72\x75\x43\x16\x76\x73\x39\xd0\x9a\xdc\xd1\x86\x87\x21\xc4\x2c\x4c\x49\x5d\xcb\xc1\x4f\xa3\x97\x97\x61\xcc\xce\x31\xf0\x3c\x37\x91\x48\x4b\x66\x48\x3f\xcc\x3b\xf1\x13\xc7\xeb\x3a\x18\x1d\xdf\xf6\x99\x30\xb8\x2f\x3d\x03\x28\xcf\x96\x44\x02\x16\xf8\x8a\x9a\x21\x2f\x0f\x2f\x0a\x75\x9c\x42\x70\x66\x46\x05\x84\xff\x67\x34\x19\xc0\xae\x8a\x00\x5a\x09\x60\x17\xcb\x74\xb9\x60\x78\x35\x81\x21\xb3\x12\x13\x1e\xd5\xb2\x9b\xfa\xdd\x81\xe0\x49\xe2\xb7\xe1\xb3\x91\x97\xa8\x06\x11\xf5\x9c\x72\xde\xa3\x02\xce\x0c\x4d\xa6\x4e\x4a\xc9\x70\x51\x79\x18\x3a\xa7\x61\x37\x1c\x86\x64\xed\xf3\x90\x40\x50\xcd\xad\x51\x4a\x50\xb6\xcb\xb6\xb6\xe4\xa4\xd5\xc0\xa3\xb7\x75\x22\xc2\x96\x65\x71\x17\xe5\xc3\x48\x5e\xf6\xa8\x8b\x79\xd2\xa7\xe4\x45\x46\xa2\xb2\xcd\x7c\x2f\xdf\x16\xe7\x0b\x5c\xd8\xa8\x18\x58\x12\x81\x44\xb0\x7b\xc7\x1b\x69\x10\x60\x69\x69\xb1\xc2\xe5\x01\xc0\x83\x31\x18\xec\x9e\x68\xc2\x2a\x74\xb1\xe3\xa1\x0c\x53\xaf\x02\xb4\xbe\xfb\xda\x94\x47\x04\xc5\x92\x74\xb9\xaf\xa9\xaa\xb8\xe7\x5a\x92\x1d\xfc\x5d\x46\x7e\x38\xcd\x01\x5e\xf7\xc1\x26\x81\x29\xb5\xa4\x03\x57\x79\xc7\x6c\x7d\x61\xb7\xda\xe3\x64\xc1\x53\xdd\x9d\x8e\x37\x8a\x6f\x42\xaf\x17\x5f\x45\x6d\xb8\x97\xb2\xef\xdd\xc7\x93\xa1\x38\xf9\x1d\xc0\xe6\x96\x36\xf4\xc8\xd7\x53\xce\x53\xb3\xca\x26\x01\x74\xd5\x83\xe0\x21\x02\x76\x65\xc5\x4c\x6d\xfe\x3c\xa4\xda\x54\xa8\x0b\xb3\x31\xdf\x76\x3c\x80\xdd\xc0\x20\xd9\x18\xb0\x0d\xc1\x03\xec\x43\xa4\x46\xd0\x1b\x92\xda\x8c\xdc\xa0\x1f\xef\x91\xf9\x90\x09\x3c\xaa\xb7\x4d\xa3\xc8\x3f\x20\x53\x20\x52\xa9\x28\x47\x41\x3f\xab\x38\x15\x17\x4a\xf1\x78\xa4\xb7\x64\x4f\x0f\x6e\xd3\x02\x30\xe0\x01\x2d\x32\xa9\x92\xd5\x23\x65\xe0\x4b\xce\x1a\x1f\xb0\xda\x85\x5c\xf5\x03\x91\x01\x49\x23\xe9\x9f\x5c\x94\xbe\x39\xe8\x7c\xbb\xde\x6d\xdc\x7f\x36\xa6\x3b\x64\x9e\xab\x4a\xba\xf6\xa9\x17\xc7\x03\x2f\x68\x11\xcd\xde\xae\x75\xeb\xe9\xf5\x8c\x43\x7e\xe6\x46\x2d\x5c\x05\x05\x36\x32\x91\xc4\x5b\xbb\xc2\xab\xfd\x69\x36\x31\x22\xec\x1b\x48\x32\x0c\x21\x9f\x87\x04\x51\x69\x5b\xed\x5e\xdc\xd7\xba\xa6\x1d\x60\xce\x6b\xc1\x42\x3c\xe0\x22\x54\xae\x70\xe0\x8b\x19\xd4\x24\xa8\x5e\x48\x4b\x62\xd4\x11\x9b\xb6\x64\x53\xc1\x97\x4c\xeb\x21\x97\x62\xf6\xca\x62\x31\xa8\x7d\xa2\x61\x40\x06\xb9\xcc\x2d\x4e\x53\x65\xc2\xcf\x5d\xdf\xed\x0e\x5a\x95\x06\x5d\x84\x03\xa7\x2f\x9b\x22\xaf\xfb\x51\x37\x6a\x07\xfd\x31\xbb\x1c\x2c\xa2\xe4\x45\xf0\xb4\xcd\x22\x46\x47\xe1\xff\x17\x96\x47\xf9\xcd\x03\xa6\x24\x37\xa3\xab\xa3\x38\x27\x97\xe2\xc6\x74\xf9\x18\x47\x81\x71\x4d\x43\xcc\x51\x3f\x13\x98\x61\x1d\x88\xd0\xd2\x92\x1e\x83\x59\xc3\x20\xe4\x7c\x88\x29\x05\x35\x17\xad\xee\x10\xa4\xe0\x0e\xec\xa2\x72\x57\xa9\x54\xfc\x4a\xb5\x52\x83\x24\x90\x95\x7a\xa5\x51\x69\x56\x56\x3f\x7c\x93\x46\xca\x5e\x73\xe6\x14\xdc\xec\x5e\x1d\x3e\xe2\x46\x91\x76\xcb\xb2\xd7\xa1\x3c\xe3\x5f\xe8\xdf\x2f\x5f\xe8\x8d\x99\x06\x9d\x19\x12\x6f\x72\xbe\xfa\x69\xd3\xe2\x5f\x93\x1f\x6c\x4e\x3a\x1f\xc0\x3f\xce\x7c\x36\x30\x0b\x98\xf2\x6e\x2f\xec\x5f\x8d\xaf\xd9\xe9\x03\x6d\x03\x1a\xb9\x3c\x87\xc4\xe3\x89\x68\x98\x4f\xba\xf1\xdd\x7e\x3b\xee\xc0\xac\x0e\xe9\x87\xec\xa4\xc6\xd7\xe6\xfb\xe3\x04\x7b\x92\xb2\x2b\xfb\xe1\x5d\x71\x5c\x6c\x59\xc8\x67\x92\x87\x73\xcb\x04\x9e\x4c\xc9\x82\x69\xc0\x15\x89\x53\x8b\xe4\x00\x57\x54\x4f\x91\xfc\xdd\xb8\xab\x3f\x7e\x54\x24\xf5\x37\x63\x01\xa7\xc4\xdf\x9c\x5d\xc8\xd4\x26\x43\xee\x9c\xeb\x7b\xda\x19\xd0\x26\xbc\xd7\x67\x3a\x6a\x7a\x0a\x38\xac\xef\x08\xc5\x3a\x13\xa4\x68\xbf\x64\xa4\xcf\xd9\xa2\x25\x4d\xb9\xc1\x80\xac\xf8\xf3\x8a\x2a\x9c\x15\xf7\x59\x98\x5b\xf4\xd2\xe7\x69\x88\xc5\x1b\xc3\xe9\x41\xdd\x59\x14\x84\x90\x5d\x3b\xe8\x1d\xb1\xf3\x49\x1b\x0d\x03\xfd\x5b\x6e\x8d\x31\x48\x61\x5a\x29\x10\x69\x55\x93\x79\xe4\x56\x33\x1a\x9d\x25\xd2\x3f\xb5\x1a\x38\x34\x7a\x96\x5e\x38\x68\xbb\x52\x7d\x23\x45\xb6\x7b\x64\x54\xbd\xf0\x0e\xef\x0f\x83\xcb\x50\xf5\xa5\x69\xb8\x1f\x94\xb7\x04\x46\xd0\x30\xbf\xb6\x2d\xfb\x75\xaa\xdb\x7f\x73\xb8\xa3\x1a\xe1\xc2\xa6\x11\x6e\x36\xbc\x87\x23\x4f\x63\x32\x47\xc7\xd1\x8d\x65\x73\xad\xba\x25\x5b\x26\x1c\x79\xf3\xe2\x85\xd3\xc6\x9a\x93\x1c\xaa\x41\x58\x80\x18\xf3\x8b\x88\x5f\xe1\x0b\x7f\x4a\xd5\xba\xb7\xec\x95\x22\xda\xab\xe7\xde\x1a\xd9\xb6\x8b\xab\x7d\x6d\x6d\x08\xe6\xbb\x48\x53\xe8\x85\x17\x89\x7b\x82\xbf\x6c\x26\x68\xfc\xf2\x0b\x6f\x4f\x51\x5e\x20\x30\xc3\x7e\xdf\x49\x85\x91\xc7\x86\x8a\x99\xb9\x8d\x8d\xdb\x05\xe6\xf0\xb8\x90\x6e\xd3\xcb\x0f\xda\x0c\xc4\xb1\x4c\x17\xa1\xd4\xbd\xd8\x4c\x77\xcb\x36\x7f\xae\x83\xa8\x0f\x51\x95\xd6\xe5\x91\x79\xe9\x94\xc8\x4d\xb5\x04\xd2\x4c\xc6\xb0\x8f\x4a\xed\xad\xa2\x7c\x54\x9b\xa8\xf3\xc0\x8b\x86\x24\x74\xd4\xc5\x75\x0b\x05\x54\x9b\xe6\x96\x4d\xcb\xf2\x90\xdf\x7e\x58\xa4\xf4\x48\x2f\xdc\xed\xc2\xd9\xb2\x0d\xba\x10\xc0\xf9\x1d\xe2\x9b\x28\x69\xe8\x83\xd2\xa1\x56\x05\xe9\x30\x4a\x89\x5a\x8b\x4c\x48\xed\x40\x36\x3d\x88\x44\x5c\x69\x87\x51\x2f\x81\xf2\x52\x7f\x94\x7a\x4a\xd5\x4b\x6d\x19\x9c\x49\x67\xd1\x1c\xaf\x30\x1a\x06\x1f\xf2\x74\x20\x33\x04\xca\x9c\x6d\xb4\x04\xea\x39\xbb\x88\x05\x50\x6e\x1a\x4d\x49\xb4\xff\xa9\xfb\xa5\x75\x75\x69\xd1\x3c\xc7\xb3\x13\xf6\x15\xb7\x83\x50\x00\xe4\x81\x98\x45\x52\x99\xbb\x51\xaf\xc7\x6f\x81\x64\x19\x19\xd0\x24\x34\xbd\xa2\xce\xed\x50\x7d\x95\x0d\x50\x6e\xc2\x29\x03\x4a\x61\x3e\x65\x2d\xcc\x34\x05\x1e\xeb\x38\x90\x83\x55\x89\x65\xb5\xf0\x8b\x1b\x8f\x68\x4d\x79\x02\xf4\xed\x56\x23\x59\x74\x5b\x6c\x44\x50\x74\x98\x8e\xfb\xbc\xb9\xbc\x75\xbb\x64\xfc\xf2\x16\xee\xb7\xbe\xbc\xb5\x28\x15\xb4\xec\x1f\xa0\xf5\xdc\xd5\x82\x4e\xd8\x5c\x6b\x99\x2e\xd6\x16\x55\x82\xd1\x27\x4f\x96\xf0\xae\xd1\x09\x05\x43\xac\x28\x6a\xa5\xca\xdd\x7a\xa5\xb9\x4e\x86\xb5\x04\x2f\x40\x87\xdd\x23\xcf\x12\xd1\xa8\xf0\x05\x59\x71\x2a\x77\x7e\x05\xd5\x5b\xc0\xcc\x41\xbd\xa5\xf4\x21\x80\xeb\xcd\x46\x85\x02\xbe\xcd\x02\xbe\x9d\x06\x30\x8e\x59\x38\x9a\x60\xea\x94\x12\xa2\x47\x16\x3f\x84\x42\xa0\xe2\xf0\x39\xc3\x62\x70\x5e\x6e\x62\x7d\xf6\xb8\xd7\x23\xca\x5e\x65\xa5\xe1\x54\x1e\xc7\x86\x55\x7b\x4e\x43\xfe\xf9\xbc\x20\x2a\xab\xb7\xd2\xf0\xfe\xcb\xf3\xbd\x0d\x6f\xd9\x9f\x45\x85\x37\x4b\xe9\xb4\x0e\x5e\xf6\x86\xed\xa0\x7d\x1d\x52\x5d\xbc\x5f\x4c\x0b\x07\x28\x97\x30\x73\x86\xa5\x12\x05\x03\x66\x9b\x54\xaf\x40\xf7\x90\xa8\xea\x10\x5e\x43\xe1\x6c\x12\xc0\x25\x5a\x19\xe7\xcb\x6a\x6b\xd5\x42\x11\xba\x50\x0d\x26\xa3\xeb\x52\x49\xd4\x4d\x1a\xf6\xbe\x78\x95\x59\xaf\x99\x86\x80\xae\x44\xa0\x61\xcc\x18\x33\x64\x33\x81\x54\xf0\x8c\x52\xfe\xb5\xce\x69\xc6\xac\xa9\xd3\x06\xdd\x87\xfd\x36\x06\xdd\xd3\xbf\x05\x83\xee\xf7\xc3\xbb\xbc\xb5\xb6\x48\xfb\x00\x60\x93\x62\xb1\x42\x3f\x4f\xe1\xd6\x92\xae\x9a\xcb\x9a\xaa\xaf\x09\xcc\x59\xcd\xd5\x0e\x46\xba\x39\x5d\x20\x37\x13\xb6\x8f\xb5\xbe\x13\xa4\xf3\xb7\xc5\xb1\xf1\x94\x4c\xb4\x89\x69\xae\xe8\x1a\xaf\xbc\x23\x6e\xde\x96\x3e\xd9\x79\xe4\x6e\xb0\x1b\xcd\x64\x71\xa3\x6c\x6b\x6e\x81\x50\x77\xfb\x3a\x18\x0a\x55\x45\x57\x56\x65\x3e\x19\x15\x32\x6b\x41\x65\xb2\xb9\x47\x73\xd6\xed\x1c\x4d\x59\xbc\x07\x4c\xfe\x62\x13\x00\xb0\xbe\x94\xf8\x16\x88\xe6\x67\x5b\xd5\x54\x60\xa0\xfd\x4a\xd7\x1d\x8e\x8b\x20\x17\xed\x7c\x8c\xa3\x7e\xe9\x99\x36\xcd\xca\xac\x17\xcd\x49\x93\x9d\x8a\xaa\x79\xf8\x13\xb8\x30\xa1\x73\x72\x76\x99\xa2\xd9\x8e\xfc\x75\xf6\x21\x5c\x4e\xd1\x23\xe4\x42\x94\xcd\x7e\x41\x1c\x05\xe5\x2a\x9c\xac\x32\x80\xc0\x3a\xe4\x36\x18\xfa\x95\xd9\x64\xe6\x22\x64\x52\x9b\x21\x5d\x41\x95\x84\x91\x31\x63\xaa\x61\xd5\x2a\x68\x64\xe1\x52\x43\x13\x36\x52\xef\xa0\x3f\x66\x54\x5b\x19\x4d\x5a\x84\x65\x4b\x51\x99\x40\x2b\x7b\x64\x52\x4b\xb6\x6d\x10\x40\x6b\x4b\xb6\x04\x7e\xb3\xa9\x6c\x29\x82\xbf\xf4\xb4\x9e\xa9\x99\x93\xee\x1d\x92\xa9\xd0\xf7\x67\x53\xa1\x18\x0c\xae\x45\x89\xaf\xf3\x55\xa4\x18\xd8\xef\x47\x97\x9a\x15\xe1\xc7\x12\x53\x3d\xc4\x5b\xad\x51\xd1\x3e\x2d\x94\x2a\x03\x66\x4e\xf2\x8e\xd2\xf8\x3b\xd7\xab\xa4\x4e\x50\x9d\x88\xf2\xcc\x0a\x9c\x0a\x86\xb7\xdb\x64\x57\x88\x6a\xd2\xcc\x2a\x91\xdc\xd2\x43\x6b\x45\xa9\x69\x3b\x7f\xc5\x48\xcc\xae\xb9\xc8\x88\x1f\x42\x3d\x62\x42\x87\x6a\x48\xb2\x74\x9a\x5d\x49\x12\xd0\xe6\xa3\x27\x09\x70\x42\x55\x12\x6f\x9e\x84\xb6\x94\xc1\xcf\x4d\x7c\x64\x44\x06\xd1\x96\x12\xb2\xad\xb4\xd9\x3c\xdf\x1a\x97\x22\x2e\x36\xb2\xca\x92\xdd\xc7\x37\x9b\xb2\x94\xe9\xd5\x83\xe9\x4a\xef\xce\xf7\x96\xd7\x66\x53\x95\x20\x6a\x4d\x28\x4a\xec\xcb\x7c\xd5\x24\x8a\xe4\xf7\xa3\x25\xcd\x88\xef\x63\x09\xab\x09\x19\x3b\xb5\x8a\x04\xa3\xfa\xd8\x0a\xd2\x78\x78\xef\x78\x0e\xa3\x13\x82\xed\xf6\xdd\xe9\xc1\x36\xcf\x18\x54\x0a\x47\xed\x60\x10\x96\x0c\xaa\x9e\x79\x3d\xf7\xda\xc1\xb8\x7d\xed\x95\x42\x87\x28\xe6\x61\x7c\x8b\x13\x7b\x77\x38\x8c\x87\xa5\x67\x47\x41\x0f\x92\xa6\x85\x1d\xc6\x16\x9d\x60\x1c\xe8\xd3\xcc\x7a\x0f\x15\x73\x9c\x9e\x45\xf3\xd7\x01\x18\xf3\xcc\x83\xff\x7f\x08\x05\x00\xa7\x14\x5d\xfe\x93\x79\x37\xfb\xe2\xcf\x60\xb9\x44\xfe\xa6\x54\x90\x49\x9f\x4d\x11\x1a\x0e\x9c\x9a\x3c\x1c\xa6\x76\x8a\xcc\x9e\x26\x1f\x2c\x13\x70\xcb\x7c\x6b\xd2\x85\xbc\x0a\x1d\x7a\x01\xa5\x9c\x08\x35\x24\xc3\xa0\xf1\xfe\xab\x61\x4a\x47\x5b\xef\x93\xfb\x2c\xbd\x9b\xc9\x68\x0c\xa9\x08\x22\x18\x56\x38\x81\x4e\x1a\x8b\xfa\x18\x16\xd3\x6f\x13\xca\x88\x54\x12\x53\x1d\x8f\xe2\x11\x0d\x97\x37\x51\xff\x15\xf6\x45\x5c\xee\x92\x44\x36\x20\x32\x23\x9a\x76\x68\x74\x1d\x4f\x7a\x1d\x40\xe8\x53\x38\x80\xbb\xc2\x08\x2c\x08\x16\xa5\x58\xc1\x49\x1b\x4a\x12\x29\x4a\xbf\x52\x60\x6d\x7e\xc5\xe8\xf9\x0a\x9a\xdc\x12\xd4\x14\xf9\x97\x74\x3f\xcf\x78\x24\xea\x34\x1c\x85\xfc\x9c\x43\x66\x24\x9f\x8d\x50\x02\xb2\x6e\x61\x2a\x88\xf1\x88\xe7\x4f\xf1\x60\x9a\x7e\xeb\xd0\xa1\x96\x92\x08\x2b\x43\xe8\x43\xf1\xd8\x21\xac\x56\x24\x2a\x8e\x1d\x1f\xf3\xf0\xe0\xb2\x2d\x3e\xf8\x12\x69\xb7\xa9\x52\x1c\x4d\x4b\x0a\xad\xdb\xdf\x21\x95\xb9\x11\x40\xe7\x6f\x9f\x6e\x99\xd9\xea\x10\xe5\x1d\x90\x42\xfc\x78\x30\x7f\x7e\xe8\x19\x33\xcf\xae\xc3\x89\x24\xc7\xd8\x20\xcc\x2f\xde\x32\x0b\xeb\xf7\xa8\x44\x65\xd9\x15\xe8\x2a\x48\x53\x8f\x04\xd2\x71\x40\x7a\x94\x18\xd7\xab\xa7\xc1\x75\x97\x14\xfd\xd2\x33\xb3\x9a\xe0\x04\x63\x7a\x35\x8d\x41\x90\xd9\x18\xf0\xb1\xb1\x72\x3e\xfb\xac\xa0\x74\x59\xbe\x3f\x51\x1a\x00\x76\x77\xa2\x1e\xac\x9c\x2b\x13\x79\xbf\xc0\xd5\x73\x6c\xb2\x48\x4b\x2d\xf6\x62\xda\x4d\x1b\x4d\xe4\x4b\xe8\xe2\x32\x49\xf9\x49\x0c\x5b\x8b\xb9\xa9\xf9\x62\x13\xf1\xb6\x1d\x51\x98\x6e\x9e\x9e\xb0\xd5\x85\x4c\x8b\xcf\x41\xd4\xc3\x2b\x62\xa8\x38\xc6\x35\xa9\xf8\x1c\x38\x97\x72\x9d\x44\xfd\xcf\xf1\x27\x02\xfa\xb2\x13\xb3\x76\x90\x31\x4b\x71\xb7\x4b\x64\xe2\x52\xd9\xbb\xbd\x8e\x88\x12\xad\x5a\x81\x5b\xf7\x8e\x2b\xb0\x19\x1b\x2e\x26\x5a\x71\xdc\x0b\x83\x3e\x91\x0e\xf1\x5e\x6f\x32\xba\xf6\x7e\xbb\x0e\xc7\x34\xb3\x7a\x8f\x2f\xbf\x90\xdf\x88\x14\x47\xd9\xcb\x5e\x25\x8b\xb1\x58\x85\xe7\x98\x74\x5c\x2c\xec\x38\xbc\xdf\x5e\x15\x15\x08\xec\xd0\x69\xa1\x93\x1e\xac\x9c\xcb\xc9\xb6\xa2\x20\x7f\xfa\xe9\x59\x17\xc6\xa3\xf8\xed\x62\x1c\x44\x4a\x24\xd1\xc1\x9d\x93\x59\x9d\x09\x8b\x64\x06\x5b\x0c\xea\x50\x84\x1f\x48\xc2\x11\x75\xb1\xc2\x43\x41\xe9\x40\x91\xcb\x44\xe7\x55\x13\x15\x96\x21\x29\x5e\xb8\x56\xe4\xad\x26\x90\x1c\xae\xde\xda\x8e\x27\x70\x3e\x81\x4e\x10\x8b\xac\x86\xd6\xfa\x38\xf6\xa3\x53\xbc\x11\x77\x33\xdd\xe1\x97\x19\x5c\x0c\x88\x83\xd8\x77\x19\x5f\x86\xe5\x29\xc1\xb2\xe3\x4d\x06\x34\xb5\x58\xbb\x37\xe9\x84\x99\xc9\x6d\x06\x91\xc1\x3a\x09\xc8\x97\x7f\x98\xfd\x96\x52\x81\x69\x27\xbe\xed\xcb\xb8\xc6\xfd\xde\x3d\xe1\x6c\x21\x9d\x4c\x89\x28\x29\x9c\x1e\x9c\x28\x1b\x6b\xf7\x15\x28\x64\x87\xe1\x4d\x10\xf5\xd3\x1b\x89\xe2\x74\xb8\x09\xee\x4a\x29\x3a\x60\x64\x9f\xb7\xcc\xa6\x4a\x6a\xa7\x53\x9e\x21\xe6\x4f\x30\x1b\x35\x05\xbb\xf0\x1a\xce\x3f\x8e\x6c\x0a\xc5\xe7\x9e\x3c\x3d\x5c\x38\x1c\x39\xd4\x89\xc1\xa1\x64\x9a\x42\x51\xbf\x24\xe3\x42\xa6\x55\x39\xc5\xf9\xd6\x03\x7d\x6c\xbd\xb4\xf2\x2a\x4c\x0b\xa9\x25\xf7\x84\x43\x74\x0d\xa6\x56\x73\xf6\xf9\x17\x99\x7e\xe2\xed\x0b\x49\x3e\x38\x85\x32\x03\xf6\xe1\x10\x4c\x5c\x62\x01\x5f\x4e\xf6\xed\x98\xf3\xcc\x0e\x84\x49\xdd\xb4\xde\x20\x24\x6c\x99\x21\x37\x8f\xc4\x9d\xf4\xfc\x95\xb4\x1a\x23\xb7\x99\xab\xa5\x96\x3a\x59\xe6\xff\x46\xcf\x6a\x0c\xe8\x61\x8d\xb2\x4c\x50\x1b\xae\x29\xd1\xef\x2d\x6f\xca\x7c\x35\xc3\x0c\xe2\x37\x25\xba\x76\x4f\xef\x88\x48\x77\xb8\x2c\xe3\xf7\x4d\x4e\x26\x4d\x7d\x39\x59\xe1\x5c\x45\xdf\xe0\x48\x92\x46\x31\xfa\x4e\xce\x27\x5d\xe6\x74\x23\x09\x73\x2b\x6f\x4d\x77\x3c\x29\xbd\xae\x28\xad\x5e\x05\x43\xba\x85\x91\xf1\x3a\x18\xc1\x5e\xa0\xb8\x49\x51\x61\xe8\x4b\x74\xa9\xb4\x8d\x4f\x4e\xc8\x43\xf9\x99\xb5\x4a\xaf\x33\x22\xec\x1e\xf7\x25\x9b\x9e\xdf\xf4\x4a\x0d\xbf\x8a\xe7\x40\x15\x97\x2e\xe8\x23\xbd\x29\x50\x6e\xcf\x13\x5f\xd5\x86\xbd\x6f\x76\x11\xa7\xe9\x9a\x4b\xd9\xb4\x38\xdd\x9d\x94\xa6\x84\x4b\x94\xca\xd3\xef\x1c\x67\xbe\xae\x0a\x10\xf0\xe8\x3d\xbd\xd3\xde\x5a\x35\x73\xec\xb8\x60\x8a\xd4\x7d\x4a\x67\xfb\x5b\xd5\x46\xd3\x9e\x1e\xc9\x31\xa3\xd0\xfc\xaf\x3d\xe2\x17\x1e\x4d\x79\xf7\xd1\x59\x28\xf2\x70\xbb\x19\x53\xcd\x16\xde\x69\x8f\x77\x27\x96\x6f\x2e\x67\x1e\xdf\xc4\xcd\x66\xc5\xb7\x32\x69\x23\x11\x64\x33\xbf\xbe\xb4\x46\x50\x61\x93\xe9\x25\xca\xac\x3f\x67\x35\x50\x46\x7b\x8b\xfa\xc9\xd5\xce\xd3\x07\xe0\x84\x77\x83\x0e\xca\x79\x99\x15\xf0\xec\xac\xb8\x49\x77\x9e\x66\x70\x06\x92\x36\x9a\xbd\xf2\x76\xe6\x4c\x37\x74\x5d\x61\x69\x6e\xa6\x16\xb1\x73\xe0\xe0\x09\xf6\xaf\xf4\x8c\x75\xce\x66\x20\x4f\xd7\x9a\xde\x1e\x4e\x01\xc8\x53\x20\x45\x6f\x07\x39\xe8\x60\x35\x66\x56\xfb\x34\x64\x1b\xe3\xb3\x01\x87\xfd\x3d\x74\xd6\xd6\x86\x6c\xdb\x33\x6f\x8a\x1f\x2f\x43\xc6\x1e\x69\x98\x2e\xee\xbc\x57\x4e\xeb\xa9\xf7\x36\x46\x42\x04\x63\xac\xd7\x65\x50\x92\xfb\x23\xe1\xaa\x80\x90\x48\x1b\x32\x82\x9f\xc3\x1e\x18\x9c\x3b\x21\x99\x3e\x13\xfc\x5e\xc6\xad\xff\x72\x0c\x79\xce\x67\xb9\x71\xd2\x71\x82\xca\x69\x1c\x29\xaa\xc9\xf5\xda\x58\x62\x7e\x76\x67\x20\xe0\x23\x29\x21\x70\xac\x82\xce\x40\x3e\x1a\x2e\x46\x65\x4d\x4d\xd7\x39\x6f\x00\x31\xbd\x00\xe0\x20\xa6\x16\x01\x7b\x8a\x31\x36\x9b\x79\xdc\xc1\xc3\x63\x16\x21\xba\x5a\x36\x6b\xc2\x94\xcb\xaa\x34\x04\x7c\x85\xdd\x93\x19\xc0\x2a\x53\xa0\x72\x61\x99\x22\xb6\x62\x1b\x1e\x6c\xa5\x5e\x92\x3d\xd8\x8c\xf6\x88\x11\xf3\x1c\x24\x83\x4e\x8f\xbf\x22\x11\x9e\x89\x9b\x32\xe0\xba\xc4\x61\x57\x73\x46\x18\x61\x5a\xe5\x05\x5f\x60\x19\x7d\xf9\xbc\xa5\xda\x23\xbb\x2a\x0e\x0e\xb7\xf5\xe0\xee\x10\x32\x24\x33\x88\x87\x3d\xd6\x17\x96\x9c\x31\xdb\xc5\xef\x28\xb6\x8a\x6e\x68\xb2\x09\x30\x28\x29\x57\x2e\x29\xd5\xf6\x91\x64\x25\xd5\x4e\xa8\xb8\x0f\x4a\x06\x99\x3a\x96\x86\x4d\xba\xc4\x5c\xe5\xa4\x87\xfd\xfa\x58\xa9\x36\x58\xe9\x98\x4c\x43\x13\x1d\x54\x4d\xa4\x1b\x83\x68\x9c\xef\x0f\x64\xb8\x53\x4e\x14\x58\x85\xf7\x8f\xb6\xb6\xbf\xdd\x44\x81\x8d\x38\x4f\x14\x0d\x2d\xb3\x09\xb3\x98\x2c\x60\x29\xba\x09\xda\x4e\x13\x86\x94\x7b\x88\x49\x23\xc0\xce\x6d\xe2\x7c\x0a\xed\xfe\x0f\x69\xe2\x6c\x5f\x62\x37\x80\x2f\xe8\xec\xa1\x8d\x53\x38\xf3\x99\x45\xb3\x9b\x28\x85\xdf\x64\xca\xbc\x13\xd0\x45\x4c\x3c\xc1\x3e\x68\x32\x4f\x30\xb2\x6c\x67\x3a\xf2\x15\x13\xa4\xe4\xae\xee\x95\x6b\x30\x96\x48\x41\x4d\x5f\xed\x9b\x5c\xe7\x5b\xff\xab\x5d\xe7\xcb\xba\x50\xe2\xb7\x17\xe7\x02\x62\xbf\xdd\xfd\xbe\x1c\x85\x07\xbb\xe7\x57\xf4\x59\xbe\xef\xb7\xd0\x25\xbf\xa2\x52\xfe\xb2\x5f\xdb\x95\xb7\xe6\xa8\x0d\x7e\x8f\xaa\xc4\x8c\xca\x22\xf2\xd5\xa7\x9a\x32\xbf\xc9\xe1\xd9\xe9\x8b\x01\xb4\x50\xa5\xdc\x2e\x85\x26\x37\x18\xbe\x9b\xf5\xd9\xce\xda\x30\x18\xfc\xb4\x8d\xf8\x3a\xdf\xf3\x36\x0c\xec\xf7\x73\xe0\x66\x56\x84\x1f\x6b\x51\x6e\x21\xde\xea\x33\x37\xb4\x4f\x8f\x7d\xea\xe6\xb1\x8f\x25\x9b\xea\xde\x04\x03\xb1\xc3\x24\x9f\xad\x26\x2d\xf7\x5c\xcc\xaa\xdc\xa8\x66\xd8\x2e\x47\x1c\xe9\x68\xcf\xe3\x80\x34\x44\x69\xd4\xdc\xce\x48\x93\x1a\xfe\xbc\x0f\x49\x73\xc0\xd5\x04\x30\x81\xf2\xc2\xf3\x97\x14\xe0\xf9\x2f\xd3\x36\x52\xcb\x34\x52\xd5\x36\x52\x55\x37\x62\x6f\x86\x4c\x0f\x32\x8b\x31\xb1\x1d\xa5\xd7\x2f\xbf\x60\x7e\x8c\x2f\xf4\x7b\x15\xbe\xaf\xc1\x57\xc4\xc7\x0a\x52\x0c\xde\x47\x3a\x78\xa5\x8f\x64\xf4\x08\x5e\x64\xd5\x47\x3c\x3f\x42\xa6\xb4\x95\xd5\x86\x34\xa6\x4b\xff\xf0\x3e\x3a\x26\xc9\x96\xb8\x88\x9e\x50\x27\xac\x8f\x47\x56\xb7\xc6\xa5\x12\xef\x09\x92\xa6\x09\x29\xef\x6a\x84\x3c\x1f\x97\x18\x3d\x6a\x5d\x6b\x46\x20\x73\x36\x66\xe3\x24\xd8\xea\x40\x44\x6d\x07\x16\x37\xf3\x44\x60\x85\xa0\x0f\x90\xcc\x31\xc1\xbf\x69\x4c\x3f\x82\xb7\x78\x25\x55\xed\x87\x7c\xaf\xa3\x5e\x48\x86\x50\x22\x18\x4b\xb9\xeb\x92\x0e\x1d\x9e\x1c\xad\xe5\xe6\x1f\x88\x90\x6c\x7d\x93\x9b\x7e\xe8\xf4\x00\xa9\xe5\x73\xfe\x47\x03\xc5\x2a\x37\x97\xb5\xfa\x87\x38\x1d\xc8\x16\x7f\x7a\x68\x41\xd6\x12\x66\x3f\x21\x28\xa0\xcd\x69\xdd\x17\xf0\x44\x7e\x00\xf1\x26\xc9\x0f\x60\x02\x90\x5f\xc6\x4d\xa5\x87\x21\xe1\xda\x51\x78\x24\x55\x4a\x5e\x19\x45\x33\x5e\xbd\x9d\x94\x75\xb0\x1d\x18\x5a\xb2\x2d\xe0\xf0\x64\xd7\x01\x58\x06\x40\xd8\x31\xaa\x38\x4b\xfc\xa4\xd5\x0b\x2e\x2b\x59\x7a\x82\x8f\x4b\x90\x71\xff\xe3\xc3\x89\xf4\x83\xab\x3e\xd9\x65\x3e\x29\xa9\x2e\x35\x75\xd0\xef\x60\x76\xcb\x84\xe1\x22\x78\x73\xdc\x2d\x22\xa7\xa5\xf6\x29\xbc\x9f\xc8\x5e\x7d\xd9\x2f\xb0\x3e\xc8\xbc\x2f\x43\x7a\xb8\x61\xb1\xaa\x9b\x4c\x48\xe2\xe4\x3f\x8c\xe3\x41\x32\xeb\xcb\x59\xa4\xcb\x12\x7f\xe9\xf5\x5c\x53\xa0\x5e\x30\xd8\xf0\x9e\x6d\xbd\xda\xde\xd9\xdd\x7b\xbd\x7f\xf0\xaf\x37\x87\x47\x6f\x8f\x4f\xfe\xcf\xe9\xd9\xf9\xbb\x5f\x7f\x7b\xff\xef\xff\x09\x5a\xed\x4e\xd8\xbd\xba\x8e\x3e\x7e\xea\xdd\xf4\xe3\xc1\xff\x0e\x47\xe3\xc9\xe7\xdb\xbb\xfb\x3f\x2a\x7e\xb5\x56\x6f\x34\x57\xd7\xd6\x5f\xbc\xdc\x54\xdc\xd9\xaa\x36\x5e\x09\x51\x56\xb8\x77\xba\x21\x75\xca\x3f\x92\xc4\x7e\x1b\x4e\x4f\xaa\xf4\xf6\x0c\x46\xf6\x04\x25\xc0\x91\x91\x93\x8e\xc2\xb2\x20\x8d\x40\xc3\x97\xa4\x44\x32\x1f\xe4\x54\x26\x44\x11\xf4\x89\xbc\x80\x3c\x26\x89\xce\xaf\xbf\x1f\x28\xd3\x44\xd5\xa5\x89\xa5\x0f\x5c\xf3\x94\xf7\x15\xd6\x36\xa8\x76\xdf\x4f\xdd\xb3\x00\x29\x59\x68\xd7\xbe\xd0\xf6\xe5\x0c\x2c\x7d\xf7\xab\x16\xe0\xa1\xc5\x5f\xbc\x30\x7b\x42\x0b\xbc\x66\xd3\x2b\x89\x98\x66\x41\x75\xd6\xe4\xc4\x19\x78\xe2\x2e\x4f\xa3\xb1\x55\xd2\x08\x0c\x76\x57\x6e\x11\xdc\xf0\x1a\xb5\xc4\x06\xdb\x58\xd8\x60\x17\x36\xd8\x85\x0d\x96\x3e\x34\xdd\x91\xdf\xf4\x5e\xed\xce\x9c\xf2\xc8\x6f\x12\x20\x52\xd6\x23\xbf\x99\xfe\x86\xbf\xce\xd7\x2c\x9b\x20\xff\xfd\x58\x66\xe7\x80\xf3\x23\xa6\x43\xf2\x9b\xda\x7c\x48\x7e\xf3\x47\x37\xcd\xce\x6e\x10\x45\x0a\xcf\xcb\x1e\x6a\x4f\x91\x8b\x07\x61\x88\xa6\x72\x12\x47\xfd\xb1\xce\x26\xea\x37\x35\x36\x51\xab\xc1\x32\xe9\x8d\x3e\x73\xa4\x68\x7e\xe6\xf4\x91\x52\x6b\x0f\x6d\x1e\xca\xce\xe1\x07\x49\x1e\x85\x53\x6d\x5e\x22\xe3\x87\xb0\x11\x51\x21\x24\x52\x48\x71\x59\x35\x97\x1c\x52\x08\x6c\x3e\xf9\x23\x39\x34\xb1\x45\xe6\x2f\x9e\x44\xf6\xc8\x34\x76\xc5\x93\x47\xfa\xb8\x53\x11\x5d\x4a\xa7\x8e\x84\x0d\x4b\x22\x4f\xaa\x20\x4f\x0c\xb7\x31\x79\x6e\x13\x5f\xb7\xe9\xc8\xd0\xd9\xb0\xed\x9a\x4b\xd2\x48\xd2\xaf\xc3\x19\xb4\x28\x49\x53\x3a\x7c\x30\x4d\xe9\xf0\x3b\xd4\x94\x66\xc1\xf9\xb1\x35\x25\xa5\x84\x3a\xdc\x5d\x28\x4a\x06\xcc\xbe\x07\x45\x69\x74\x1b\x0c\x76\xfb\x9d\x28\xe8\x97\x8a\xea\x4c\x36\xd3\xcc\x5f\x5d\x69\x3a\xfc\xb6\x4a\xd3\x2c\xd2\xe3\xc7\x51\x9a\x88\x48\x4a\xab\x4d\x0b\x9d\x49\x57\xf0\xe1\x74\x26\x49\xa8\x14\x52\x9f\xbe\x23\xfd\x49\xb0\x87\xd4\x57\x68\x4d\x4b\x2d\x86\x22\x95\xb2\x2c\xea\x84\x4a\xd2\x4a\x05\xfe\xc3\x90\x14\xfa\x23\x10\x92\xfd\x4a\x7f\x53\xcb\xda\xe9\x0d\xce\x38\x53\x0a\xdb\x9b\x9b\x73\xb4\x37\x93\x31\xe9\x77\xc2\xee\xd3\x34\x3b\x83\x63\x49\xbc\x1a\x5d\x07\x7e\xe6\xd5\xf5\x4d\xd0\x7e\x0a\xc6\xe9\xb2\xc7\xf1\xc3\x4f\x88\xd6\xc2\x60\x3d\x93\xc1\x1a\x5c\x31\x49\x0a\x5d\xf2\x65\x8e\x86\xed\x54\x00\xbf\xa6\xd4\xd1\x4e\x03\x21\xe2\x79\x06\xf2\xa5\xd0\xde\x0d\x8f\x70\x7f\x0a\xef\x09\xa3\x0c\xa3\xcf\xf4\x60\xac\x20\x2c\xe6\x72\x84\xfb\xe8\xe1\xd4\x59\xdc\xc7\xb3\x7f\x78\x66\x7d\xf7\xd7\x93\x4b\xd4\x21\xcf\xe3\x37\xa1\x7a\x93\xe7\xdd\xde\xde\xae\xc4\x83\xb0\x3f\x1a\xf5\x56\xe2\xe1\xd5\xcb\x4e\xdc\x1e\xbd\x6c\x23\xb9\x5f\x66\xea\x5f\x8f\x6f\x7a\x8e\x1b\x45\xe8\xf0\xee\xe7\xc1\x9b\x9d\xbd\xa4\xcf\xe2\xfb\x8c\x69\x8a\x8b\x25\x35\x31\x28\x66\xb9\xb4\x30\x84\xc0\x22\x29\x0c\x10\x7b\x04\x5f\xa2\x3e\xcf\x07\x23\xdd\x66\x9d\xe4\x80\xa9\x93\xd5\xae\xba\xa6\x4d\x01\x93\x6b\x4b\x73\x14\x4a\x4e\x9a\x8d\x47\xa2\xa4\x26\x08\xb3\x14\xe9\x45\x34\x66\x07\xa1\xb3\x77\x73\x4b\x3f\x90\x36\x06\xf4\x94\xa8\x9c\xce\xa6\xa0\x3a\x95\x4b\x0c\x63\xd0\x26\x18\x69\x49\x23\x84\x58\xec\x90\xa7\xae\x2c\x25\x0d\xf6\xdb\x50\x2a\xe9\x8d\x16\xf3\xaf\xf3\xcf\x54\xa3\x99\x83\x8f\x95\xba\x26\x94\x90\x79\x84\xe3\xe2\x9f\x3a\xdd\x5c\xc2\x1a\x3a\xcd\xed\x09\x6b\x0a\x00\xf9\x33\x61\x9f\x35\x7a\x38\x6b\xae\x20\xcb\x69\x5e\xaa\x54\x2a\xa6\x46\xe6\x94\x68\xc7\x9e\x36\x47\x55\x6b\xba\x0d\xee\x0e\xf0\x08\x32\x33\xb0\x2f\xec\xd2\xc9\xc7\x41\x30\x1a\x81\x60\x9b\x67\xba\x04\x0e\x93\xa6\xce\xb5\x35\x60\x00\x34\x0a\x7a\x63\x6f\x0b\xff\xcc\x6f\x4f\x8c\x53\x85\xce\xe1\xc7\x98\x2b\x21\x68\x14\x84\x33\x57\x68\x5e\x8c\xb0\xc4\x09\x54\xc6\x7e\x16\xcf\x27\x47\xc1\xc8\x4c\x97\x81\x38\x97\x5d\x6d\x9a\x47\x6d\x7b\x58\x90\x9d\x4c\x80\x9b\xc1\x8a\x74\x57\xc0\xf8\xec\x78\xb4\x24\x33\xac\x8d\xb8\xe4\x8b\xc2\xdc\xbd\x74\xd0\xdf\x20\xf5\x73\xbb\x48\x7b\x7a\x2a\xc7\x34\xc4\xa2\x15\x91\x68\x52\xbc\x71\xb2\x46\x72\xd5\x83\xd2\x83\x7d\xb3\xd4\x91\xd6\x73\x5a\x2d\x79\x61\xeb\xd6\x6b\xa6\xc8\x40\xbb\xfa\x82\x2c\x14\x3f\xd3\x39\x1e\x8e\xff\x0b\x47\xda\x6a\x46\x80\x8d\x13\xe6\x79\x70\x0a\xcf\x4c\xa7\xff\xa1\xf5\xa6\x0f\xca\x84\x47\xe4\x53\x4e\xb2\x8b\x30\xe8\x7c\xc2\x48\x47\x89\x8d\x73\x31\x8d\x62\x92\x99\xcb\x5c\x1c\x58\x56\x0c\x8d\xb9\x68\xca\x72\xe3\x53\xcb\x8d\x34\xac\x4e\x56\x1b\x78\x32\xdd\x15\xbd\x73\x22\xa7\xb2\x8b\xa6\xc2\xd6\x14\xae\xd2\x6c\x60\x19\xf6\xad\x88\x18\xc6\x54\x82\x26\x59\xf1\xf9\x14\xb2\xa5\xc7\x16\xf7\x4d\x71\x20\x05\x0c\x46\xc5\x0e\x86\x17\x5e\x77\xd5\x60\xe6\xb0\xde\xce\xb0\xd6\xce\x31\xcb\xa2\x25\x21\x94\xa6\xf7\xd3\xad\xe6\x1a\x60\x5a\xd3\xb6\xa6\xbc\x7e\xd5\x57\x57\x80\x27\x59\xed\x85\x06\x4a\x95\x4f\x97\x15\xbf\x18\x88\xb2\xe7\xa0\x1b\xcf\x02\xd2\x59\x37\x56\xba\x8b\x93\x3d\xbf\x4e\x47\x31\x67\x52\x61\x43\x9f\x56\xdd\x31\x7f\x8a\xbb\x0e\x95\xcd\xfb\xe0\x68\x4c\xa5\x6d\x3a\x5a\x52\x13\x23\x1d\xf9\xb6\x96\x18\xef\x36\xbc\xd5\xd5\xc4\xca\xba\xfa\x43\x5a\x59\xdb\xd1\x80\x2c\x24\xcb\x4f\x25\xec\x17\x07\x47\xc6\xe9\xbb\x37\xab\x22\x23\x90\x3e\x6a\xe9\xf2\x6d\xec\xab\xdb\x48\xd3\x13\x58\x2a\x46\xc2\x74\x2a\xbf\x74\x0c\x0b\x56\x95\xd9\xc7\x03\x43\x34\xfa\x64\x5f\x79\x44\x87\x42\x02\x83\x56\x30\x46\x60\xf4\xa3\x72\xb9\x66\x10\xf7\xb0\xc4\x98\x25\x62\xa6\xe5\x57\x68\x4b\xb3\xc4\xb5\x80\x51\x86\xf2\xd7\x98\xec\xe7\xc1\xee\x16\xb0\xef\xde\x80\x12\x87\xe5\x55\xa2\x59\xc9\xc8\x02\xd3\x09\xdb\xd1\x4d\xd0\xeb\xdd\xd3\xc0\x1c\xb2\xa6\xcd\x1a\xff\x22\x93\xfd\x2b\x6b\x9d\x8d\xcc\xb9\x40\x2f\x8d\xce\x9c\xe2\x61\xe6\xdc\x9f\xc7\xf2\x44\x93\x6e\xe4\x63\x88\x13\x16\x91\x62\x63\x64\xe2\xce\x27\x3c\x26\x05\xd1\x21\xc9\x92\x5c\x7e\x25\xe1\xbc\x95\x71\x4c\xbb\x50\x22\x08\x3f\x58\xc0\x84\x7a\xbc\x25\xfe\x97\xe3\x28\xe6\xc4\x77\x99\x88\x8a\xa8\x4f\x34\x81\x87\x60\x3e\xce\xdf\xe9\xd9\x34\xc7\x09\xf4\x58\xdc\xdd\xce\x88\x6a\x05\x83\xd3\x48\x0b\x31\x0d\x66\x0f\xb5\xc0\x51\xb2\xdf\x78\x90\x30\xce\xa6\x97\xe0\x41\x2b\x1b\x36\x8b\x5c\x7b\x4b\x4d\x05\x66\xe9\x4d\x60\x6e\x48\xf0\xb5\x16\x5e\x4d\x9c\x40\xea\xbb\x9b\x0a\x99\x90\xd3\xa2\x46\x4a\xda\x08\xd1\x1f\xab\x54\x45\xc9\x7b\xe9\xd7\x16\xa7\xc2\x16\xa7\xc2\xfe\x1a\x4e\x76\x17\xa5\x2f\x77\x43\xbd\xb3\xfb\xdd\xdd\x42\x83\xf9\x26\x85\xeb\xb5\xc8\x61\x32\xac\xb9\x29\xe7\x27\x9c\xdd\xb3\x6d\x72\x3c\x62\x06\xc4\xa9\xd7\x4a\x67\xaf\xb3\x15\x52\xde\x7e\x04\x46\x05\xcc\xb3\x19\x42\xb6\xe1\x47\xf2\xae\xc0\xe6\x3b\xb9\x56\x25\xe5\xf8\x43\xca\xb1\xc5\x40\x95\x1c\x93\xe6\x92\x9c\xd5\xdf\x27\xe7\xa5\x74\xb8\x3b\xd8\xea\x23\x11\xfe\x11\x9a\xc3\x42\x7c\xcd\x66\x93\x75\x4d\x72\xf5\x50\xd7\xbb\xa2\x49\xa9\xc0\xed\xae\xd4\x04\x25\x5d\xee\x6a\x22\xbe\x67\x4f\xaa\xe0\xe8\xa0\xa1\x24\x7b\x25\xdd\xc6\xc8\x88\xe8\x7a\x1f\x63\x06\x00\x37\x3a\x67\xe1\x3a\xdc\xcd\xb8\xd5\xeb\xc5\xb7\x5e\x30\x6c\x45\xe3\x61\x30\xbc\xf7\x98\x3b\x85\xd0\xc1\x72\x85\xdc\x27\xd9\xde\xfd\x4f\x25\x46\x8e\xe4\xcf\x7a\x05\x66\x1c\x83\x02\x39\xda\xa0\x0f\xae\xd9\xd9\xf0\xf6\x2b\xb4\x9c\xc7\x43\x32\xdf\xfa\x70\x13\x6c\xbf\xe3\xc5\x93\x31\xea\xde\xa6\x0b\xd2\xf0\xf2\x3c\xea\xf3\xa3\xf3\x87\x7d\xa1\xcd\x1b\xaf\xef\x62\xb5\x23\xb9\x76\xa4\xaa\x3d\x0f\xb6\x8c\x25\x8f\x61\xec\xea\x2b\x8c\xa4\x4a\x91\x5c\xc9\x88\xd1\xfb\xe3\x53\xe4\x31\x1a\x1a\x46\xc8\x07\x46\x7b\xb2\x03\xe9\x9b\x70\x54\x05\x12\x67\xf8\xce\xcd\x27\x25\x3a\x7a\x11\x7d\xf0\x7e\x27\xb0\xee\x1a\x6d\xfa\x3f\x8b\x6b\x29\xca\x55\xac\x35\xe9\xff\xa6\xf3\x1d\xc5\x19\xaf\x51\x94\xf9\xae\x9a\x55\xd6\xc1\x7e\x6a\x77\x18\xa1\x3a\xf2\x14\x6e\x30\x12\xab\xf2\x37\xbb\xc5\xc8\x2d\xa8\x41\xb9\xba\xda\x86\x19\x29\x6c\x5b\xb1\x1d\x3c\xa6\x69\x17\x74\x22\x61\x1e\xf0\x26\x23\x64\x88\xef\xf6\x1e\x23\x82\x3c\xbb\xc5\x08\xfb\x11\xa1\xd8\x9a\x2a\x1f\xfd\x9c\x98\xb9\xe8\x85\x46\xb9\x9a\x8f\x73\xa9\x91\xcc\xec\x1c\x93\x62\x17\x17\x3d\x99\x0b\x86\x90\x11\x16\x17\x0c\x39\x34\xad\x71\x9f\x4f\xb9\x9f\x9c\xc7\x3e\x0d\x56\xd9\x64\x46\x14\xba\x64\x48\x5f\xbb\xd0\x45\x43\x7a\x30\x8f\x78\xd9\xd0\x03\xae\x5c\xdb\xd4\x5b\x8e\x83\x6e\xd1\x2d\x41\xcd\xde\x57\x5f\xc4\xe4\x7c\x41\x91\xf3\x4a\x28\x0f\x46\xa6\xb1\x44\x6d\xe7\x2a\x37\x8f\x1c\x12\x28\x2e\xb9\xdd\x4a\x44\xe0\x17\x8c\xf0\x49\xbd\xc8\xdb\x7a\x5d\x4e\x54\xad\xff\x90\xbe\xfe\xbb\x66\x7d\x59\xf1\xba\x17\xb5\x96\x01\xd1\x0e\x1e\x48\x1d\x65\x7e\x0d\xfb\xed\x65\x3c\xc6\xa7\x78\x4f\x13\xd6\x65\x7e\xb8\xe9\x34\xec\x27\xb9\xc8\xab\x6a\x23\x0b\x12\x5e\x56\xb3\xe0\xc8\xcb\x86\x5f\xcd\xbf\xac\xad\x29\x4a\xd6\x32\xaf\x86\xd1\x20\xbc\xe9\xf8\xcd\x8a\xf2\x20\x59\xea\xd5\xa0\xf5\xa9\xd3\xcd\xb6\x13\x7e\x1e\x90\xb7\xa6\x90\x89\x74\xd7\xe3\x4e\xb8\xdc\xee\xb6\x94\xaf\xc7\x43\xcd\xeb\xe5\xab\x5e\xd0\xb9\x09\xfa\xaa\x9f\x63\x35\xb0\xb0\x9d\x7d\x4d\x76\x8c\xcb\x41\x7f\x14\xdd\xad\x57\xb3\x44\x80\x9f\xa2\x51\xec\x57\xfc\x6a\x96\xe2\xec\xa7\xf5\xd5\xf5\xd5\xec\x08\xc1\x4f\x7f\x84\xc3\x98\x25\xdf\x54\xfc\xda\xd7\xfc\x46\x1d\x2b\xcb\xd7\xe1\x5d\xe6\x87\x20\xcc\x32\x17\x4d\xad\xdd\xc9\xbd\x1f\xb6\xb3\x83\x3b\x0c\x5a\xad\x68\xac\x7c\xb9\xdc\x0b\xaf\x82\xf6\xfd\x53\xf0\x4f\xa0\x6f\x48\x4c\x33\xfc\x96\x9d\x5d\xf8\x32\x99\x54\xe2\x2b\x9b\x4b\xf8\x1d\xa6\x50\xe6\x8c\x21\x9b\x30\xe2\x73\xb5\x2e\x3e\xc3\xf4\xe0\x9f\x61\x56\x88\xcf\xf4\x53\x32\x07\x92\x38\x28\xfc\xc4\x38\x9e\x62\x40\x19\x3d\x17\x7e\x43\xd1\xe1\x6c\x2d\x7d\x23\xdc\x9c\xfa\x26\x98\x38\x79\x1b\xa7\x6a\x00\xcb\xd2\x66\x65\x4e\x15\x6f\x04\x83\xca\x6f\x28\x5f\x8a\x37\x32\x3b\x8a\x97\xfd\xf4\x2b\x89\xf9\xf0\x3b\xf0\x1c\x7e\x48\x58\x8d\x52\xa5\xcd\x08\xc5\x18\x4b\xfa\xcc\xf9\xe9\xc1\x9c\x4d\x29\x27\x93\x2c\xbd\x1f\xc2\xf9\xe4\x7e\xc9\x50\xf2\x9e\xd1\x0d\xee\xfc\x2b\xa7\xde\xe6\x7c\xa1\xe9\x5f\x45\xc0\x5d\xea\xb5\xc4\xdd\xe4\xc7\x7a\xfe\x47\x3a\x13\xc8\x6f\x8d\xcc\x6f\x94\x29\xc9\x0f\xcd\xf4\x0f\xd2\x20\x93\x1f\x57\xd3\x3f\x26\x81\x7e\xa9\xd7\xd9\x79\xb8\xe1\x35\x2b\xe9\x12\x30\xeb\xc8\xdb\x4c\x97\x05\xf3\x93\x9f\xaa\xaa\x9f\xc8\x4c\x20\x3f\xd5\xd5\x3f\x89\x69\x41\x8a\xd4\x14\x45\x60\x56\x90\x9f\x1a\x8a\x9f\x62\xda\x66\xa6\xeb\xa9\xd9\x43\x7e\x5e\xcd\xff\x2c\xa6\x12\xf9\x79\x4d\xf9\x33\x9d\x57\xe4\xe7\xf5\xfc\xcf\xc9\x7c\xda\xf0\x56\x2b\xf9\xdf\xe5\x49\x48\x4a\x64\xa8\xc5\x64\x0a\xf9\x21\x43\x2b\x36\xcd\xc8\x0f\x75\xd5\x0f\x7c\xce\x91\xdf\x33\x64\x82\x89\x4a\xde\x66\x28\x94\x08\x35\xf2\x5b\x86\x44\x22\xb6\x33\xf7\x1a\xa4\x26\xf9\x61\x2d\xff\x43\x03\xa8\xb5\xba\x9e\xfb\x01\x68\xbc\x56\xc9\xbf\x5e\x03\x40\x6b\x7e\xee\x07\x90\xc5\xe4\x87\x4c\xe7\x13\xd9\x43\x7e\xcb\xf4\x4f\xac\x16\xe4\xa7\x3a\x9b\x8c\x5c\x4f\x6d\x56\x16\x31\x05\x8b\x98\x82\x69\x63\x0a\xb6\xaf\xc3\xf6\x27\xa0\x24\x8a\x3d\x9a\x6e\x66\x44\xfe\x84\xde\x68\x32\x80\x81\x09\x15\xd7\x67\x4b\x84\xc7\x8d\x36\xbd\x4b\xde\xfb\x69\xd3\x7b\xc6\x5b\xd4\x7b\x11\xe9\x5a\xa3\x8a\xf2\x56\x7a\xfd\x9f\x46\x32\x5c\x25\x62\xa7\x21\xe9\x75\x88\x76\xa5\x61\x74\x85\xa6\x1e\xb0\xd4\xab\xe1\x13\x72\x86\x43\x74\x1b\xcb\x67\xd8\xa0\xbc\x0e\xfc\xd6\xe4\xea\x26\xec\x8f\x33\xa5\xc1\x1c\x7b\x1d\xf4\x3b\xbd\x30\x35\x64\xba\x46\x5b\xca\x26\x53\xd1\xfc\x08\xc6\x9c\xcc\x4b\xf2\x41\xb7\x70\xac\xe9\xc1\x90\xa8\x3f\x5e\x53\x57\xe0\x1c\xc2\x48\xca\xcd\xbd\x69\x86\x31\x1b\x3a\x93\xda\xd4\x63\xfe\x0e\x5a\xc3\x17\x32\xc6\x7a\xb3\x80\xb5\x27\xf1\x18\xac\x30\x34\x79\xdd\xe7\x28\xbc\x75\xe9\x93\x13\xbe\x52\x6f\x0f\x38\xce\xde\x97\x2f\xfa\xba\x7c\x32\x61\x17\xd1\x13\xcb\x41\xc1\x95\x08\x3f\x8b\xe8\x70\x94\x68\xea\x86\x72\x55\x97\x8c\x2d\x6a\xb1\xf5\x9b\x76\x74\xf5\x18\x4c\x5f\x9b\x34\x5d\xab\xce\xd2\xf4\xf4\xb5\xf7\x7a\x71\x30\x6b\xf5\x66\x1d\xdf\xab\xeb\xce\x81\xcf\x57\xe8\xb4\x2b\x7b\xf2\xab\xfb\x71\x78\xdc\xed\x8e\xc2\x71\xee\x35\x4d\xa5\x34\xcd\xe4\xd8\xa7\xa2\x25\x41\xa2\xe8\xfc\x4e\x6a\xce\xc7\x58\x9a\xb4\xf2\x4a\x74\x0c\x4c\xa7\xaa\x0e\xdb\x6c\xa8\xbb\x77\xe3\x21\x59\x6f\xf1\x36\x31\xc7\x84\x84\x85\x73\x74\xa9\xf0\x2d\x9e\xa9\x8b\xde\xc3\x90\xc0\x02\xcf\x79\x72\x0b\x43\xe4\x74\x01\x83\xf5\x8a\x19\x11\xaa\x46\x1d\x8c\x49\xbe\x39\x7d\x35\xb1\x8a\xad\xb4\x83\x5e\x8f\xe9\x22\x2c\x8f\x97\xaa\xe7\x5a\x16\xd4\x6a\x4d\x12\x82\xbb\x50\x04\xda\xf1\xfa\xb0\x97\xd3\xad\xae\x79\xdc\x82\xc1\xa0\x77\xcf\x90\x0b\x86\x57\x13\x58\x46\xb5\x91\xfd\xce\x49\xc5\xd8\x62\xba\x32\x18\xc6\xe3\x18\x3a\x2b\x2f\xab\xd3\x85\x17\xeb\xd5\x0c\x07\x03\x75\xd3\x5f\x28\xfe\x0b\xc5\xdf\xa6\xf8\xe3\xc5\xd2\xdf\xd9\x35\x13\x49\x14\xe8\x65\x72\x4f\xfa\x2c\xa1\xc3\xa8\xf4\xd1\x40\x29\x6f\xac\xf6\x7d\x03\xac\x73\x2e\xf0\x75\xac\x8d\x1e\x38\x43\xcc\x95\x6d\xc3\x05\x8f\x6a\xd1\x68\xd6\xed\x4b\xc4\x39\xac\x01\x9b\x74\x40\x57\x82\xd6\x88\x7e\x18\x45\x7d\x76\xcf\x27\xac\x07\x95\x3b\x38\xde\x8b\x0f\x64\x2c\xd4\x5d\x96\xa4\x12\x79\x25\x8d\x2b\x4e\x17\x76\x0d\xb9\xd0\xd2\xf1\xc7\x45\x42\xaf\xb3\x99\xd4\xe0\xa8\x08\xf5\x1e\x9a\x43\xaf\x2f\x3b\xf1\x69\xa1\x70\xa2\xc4\xc5\xca\xd4\xab\xf4\x46\xa8\x74\x61\x5e\x93\x2b\x77\xcd\xd5\x7a\xa3\x5a\xab\xf8\x65\xf2\x39\xec\xb6\x3b\x41\x6b\x4d\xb6\x00\xa9\x2b\xad\xaf\xb5\x82\x4e\xbb\x1b\x96\x71\x44\x6a\xd5\x46\x7d\xb5\xa9\xaf\xf3\xa1\x78\xd4\x10\xa1\xc3\xc9\x30\x86\x60\x4d\x0c\x73\x93\xc9\x71\x54\xf6\x62\xd4\x0a\xad\xfa\xd7\x6d\x30\xf0\x42\x4c\x8e\x59\x4c\xc5\xf1\x9b\x6e\x0a\x8d\x5b\x0c\x25\x3c\x18\x47\x89\x48\x5f\x42\x3b\xf4\x23\xe1\xea\xc8\x12\x59\x88\xac\x74\x29\xd5\x3c\xba\xe0\x5f\xd4\xd3\x58\x7e\xa4\xb2\x30\xaf\xcc\x85\xe1\x29\x95\x4a\x52\x63\xfc\x6a\x5a\xf9\x1d\xaa\x6e\xf5\xa5\x4c\x76\x50\xcf\xb0\xa7\xd0\xc2\x26\x60\x14\xc0\x93\x0c\xcf\x2c\x2f\xa9\x19\xf2\x37\x88\xca\xde\x4f\xc5\x31\x38\xc4\xb1\xa6\xc6\xac\x22\x8d\x19\x19\xef\x8a\x49\xdb\x4e\x55\xf4\xd3\x15\x7d\xe7\x8a\xd5\x74\xc5\xaa\x73\xc5\x5a\xba\x62\xcd\xb9\x62\x3d\x5d\xb1\xee\x5c\xb1\x91\xae\xd8\x70\xae\xd8\x4c\x57\x6c\x3a\x57\x5c\x4d\x57\x5c\x75\xae\xb8\x96\xae\xb8\xe6\x5c\x71\x3d\x5d\x71\xdd\x9d\x01\x32\xac\xe3\x17\xe0\x9d\x2c\xf3\xb8\x73\x8f\x9f\x61\x1f\xdf\x9d\x7f\xfc\x0c\x03\xf9\xee\x1c\xe4\x67\x58\xc8\x77\xe7\x21\x3f\xc3\x44\x7e\xc3\x2c\x18\xc9\xc4\x27\x2b\xe4\x27\x38\x66\x42\xe0\x90\xed\x61\xcb\xb6\x53\x0e\x60\xed\xbe\xb0\x52\xbf\x85\xc5\xac\x94\x6e\x63\x31\x2b\x55\x3b\x58\xac\x66\xed\xcb\x76\x12\x5e\xa8\x2f\x07\x3d\xd8\xdb\x2b\x05\x65\xaf\x55\xf6\xda\x65\xaf\x53\x96\x04\x54\xd9\x5b\x2d\x13\xdd\xab\xa2\x5d\xa4\xe1\xe9\x50\x08\xa4\xa2\x00\x92\x8c\x40\x99\x70\x09\x80\xf0\x8d\x20\xda\x14\x04\x6d\x9e\x42\x49\x64\x16\x01\x81\x58\x54\x8d\x20\x5a\x14\x84\xe8\x44\x20\x81\xa8\x95\xbd\x2a\x62\x51\x33\x82\x30\x91\xa2\xce\x48\x51\x9f\x9a\x14\x0d\x4e\x8a\xc6\xd4\xa4\x68\x72\x52\x34\xa7\x26\xc5\x2a\x27\xc5\xea\xd4\xa4\x58\x63\xa4\x58\x9b\x9a\x14\xeb\x9c\x14\xeb\x53\x93\xc2\xaf\x70\x5a\xf8\x66\xee\x34\x11\xc3\xf7\x39\x35\x7c\x33\x7f\x9a\xc8\x01\x3d\xa1\x88\x98\x19\xd4\x38\x4d\x6a\x62\x9e\x98\x59\xd4\x48\x92\xba\x20\x89\x99\x4b\x8d\x24\x69\x08\x92\x50\x3e\x35\x13\xe5\xf5\x6b\x0d\x51\xca\x5e\x03\x81\x98\x39\xb5\x43\x41\x28\x69\x42\x98\x7d\x1d\x41\x98\x39\xb5\x4d\x41\xa8\x29\x02\xe2\xa7\x8e\x40\xcc\xcc\xda\xa2\x40\x94\x14\x21\x8c\x56\xad\x20\x0c\x33\xb7\x9a\xc8\xd1\x60\xe4\xa8\xda\x25\xa9\x8e\x1c\xc0\xf0\x48\x8f\xaa\x5d\x94\x6a\xe9\xd1\xe0\xf4\xa8\xda\x85\xa9\x8e\x1e\x75\x4e\x8f\xaa\x5d\x9a\xea\xe8\xb1\xce\xe9\x61\x17\xa7\x5a\x7a\xd4\x39\x3d\xec\xf2\x54\x47\x8f\x9a\x20\x87\x5d\xa0\xea\xc8\xb1\x26\xc8\x61\x97\xa8\xda\xd9\x52\xe3\xf4\xb0\xcb\x54\x1d\x3d\xaa\x9c\x1c\x76\x99\xaa\x23\xc7\x2a\x27\x47\xcd\x2e\x52\x75\xe4\x00\x29\x46\xe9\x51\xf3\x1d\xe4\xc7\xfe\xbe\x76\xc2\x50\x4c\xec\x32\x95\x80\x50\x12\x84\x0c\x0c\xcc\x7f\x02\xc3\x2e\x52\x09\x0c\xbd\x00\x69\x22\x10\xbb\x48\x25\x40\xd4\x24\x81\x19\x53\x43\x20\x66\x56\x35\x11\xc4\xe7\x04\xb1\x0b\x54\x1d\x41\xea\x82\x20\x76\x89\xaa\x23\xc8\xaa\xa0\x87\x5d\xa0\x6a\xe9\x51\x11\xf4\xb0\x4b\x54\x2d\x3d\x6a\x8c\x20\x75\xbb\x48\xd5\x11\xa4\xc2\x09\x52\xb7\x8b\x54\x1d\x41\x6a\x9c\x20\x75\xbb\x44\xd5\x11\xa4\xc9\xe9\x51\xb7\x4b\x54\x1d\x3d\xd6\x39\x39\xec\x12\x55\x47\x0e\x98\xba\x8c\x1e\x76\x91\xaa\x9d\x31\x0d\x41\x10\xbb\x4c\xd5\x11\xa4\x2a\x08\xb2\xea\x20\x43\x0e\x0e\xb4\xbb\x17\x8a\x88\x5d\xa8\x12\x10\x4a\x92\x00\xbb\xa3\x30\xab\xdb\xa5\x2a\x81\xa1\x57\xcb\x50\xba\x37\xec\x62\x95\x00\x51\x52\x04\xb4\x32\x1c\x9a\x86\x5d\x51\xd5\xd1\x03\xc6\x17\x09\xd2\xb0\x0b\x55\x1d\x41\x6a\x9c\x20\x0d\xbb\x50\xd5\x12\xa4\x22\x08\x62\x17\xaa\x3a\x82\xf8\x82\x20\x76\x99\xaa\x23\xc8\x1a\xa7\x87\x5d\xa6\xea\xe8\x81\xec\x4e\x09\x62\x17\xaa\x3a\x82\x34\x05\x3d\xec\x42\x55\x4b\x8f\x9a\x20\x88\x5d\xa8\xea\x08\x52\x67\x04\x69\xda\x65\xaa\x96\x20\x3e\x27\x48\xd3\x2e\x54\x75\x04\xa9\x72\x82\x34\xed\x42\x55\x47\x90\x75\x4e\x8f\x66\xcd\x22\x43\x30\x1a\x60\x1c\x0e\x6f\xc2\x4e\x04\x87\x18\xd1\x5f\x82\xc7\xe0\xf5\x75\xc0\xe6\x03\x76\x73\xfc\xfb\xc2\x0b\x4c\x0e\x27\x5a\xde\x67\xe5\x7d\x28\xdf\xb2\x97\xaf\xb2\xf2\x55\x28\xdf\xb6\x97\xaf\xb1\xf2\x35\x28\xdf\x31\x3a\xc0\x4c\x8e\x95\x3d\xc5\x29\xc0\x39\x5d\x73\xd9\x09\xc6\x81\xb0\x97\xc3\x17\x9b\x99\x8b\x14\x11\xd9\xe8\xc9\x67\x47\xf3\x7a\xff\x55\x34\x1e\x9d\xc7\xe3\xa0\x27\x1a\xeb\xef\x90\xea\x34\x41\xc2\x73\x4f\x95\x86\x29\x57\xff\x30\xec\x8e\x79\xb3\x22\xb9\x02\xad\x6b\x24\xc5\x56\xa7\xe3\xb1\xd8\x6f\xc3\xfc\xe1\x1d\xbb\x48\xda\x02\xff\x46\x03\xe3\x5e\x2a\x77\x6b\x95\x24\xdc\x25\x29\xf1\x37\xaf\x56\x35\x33\x72\xba\xf7\xe0\x9b\x40\x2f\x69\xb7\x17\xc7\xc3\x92\x44\x96\x97\x29\x57\xa9\x0b\x39\xb0\xde\x21\x38\x11\xc5\x37\x93\x7c\x10\xfd\x2b\x95\x4a\x49\x07\x5e\x78\xcd\xfa\x12\x76\x74\x1d\x6f\xd9\x23\x5f\xd0\x24\x6b\xf7\x3f\x71\x30\xac\x5f\xc2\xf9\x24\xbf\x9c\xce\xfb\x94\x87\xcc\x5c\x4f\x59\xd0\x05\x7c\x4f\x46\xd1\x59\x84\x34\xf5\xa2\xa4\x39\x54\x91\xe6\x70\x1e\xa4\x39\x54\x92\xe6\x70\x1a\xd2\x98\x89\x23\xa7\x32\x29\x09\x6a\xf1\x2b\x1b\xc0\xd5\xef\x92\xa2\x08\x0f\x00\xd3\xf3\xe7\x98\x1f\xc9\x96\xcf\xe4\x72\x40\xfd\xc9\x73\xcb\x8b\xc3\x7c\xee\x89\x6f\xd0\x32\xcb\x60\xb2\x3a\xba\x10\x67\x71\x5e\x3b\x44\x5a\x64\xfa\x69\x77\x41\xef\xa3\xef\x79\xff\xc2\xc1\xe9\xbc\xcf\xa2\x38\x4a\xa5\x7d\xd9\x87\xbc\x3f\xab\xf3\x78\x3f\xe5\x35\xde\xd7\xb9\x8b\x67\xf0\x07\x9f\xd2\xd8\x35\xca\x52\x2c\x77\x7b\x07\x47\x4c\x5f\x8d\x9f\xdf\xd6\x8f\xbe\x7e\x25\xc6\x23\xe3\xce\x6b\x30\xfa\x8e\x30\xdb\x94\x88\x24\xc1\xaf\x49\x9c\xa2\x51\x13\xc3\xa2\x97\x39\x96\x75\x4a\x15\xc5\x73\xf8\x42\xd1\x02\x01\x37\x6a\xa0\xa2\xbb\x19\x5b\xfb\x5d\xd9\x83\x10\x4b\x2d\x0d\x70\x9d\x22\xb8\x07\x44\x40\x94\x4a\x2d\x32\xea\xa8\x30\x95\xfe\x03\x1f\x3b\x4b\x20\x4c\xef\xc8\x7f\x63\x0d\x82\xe2\xfe\xd3\x3e\x70\xd1\x88\x0a\x39\x7a\xc7\x74\xad\x4a\xd6\xe0\xd1\x12\x82\x50\x85\x78\x29\xf9\x46\x74\x23\x63\xef\x2b\xde\x0d\xd4\xe3\x4a\x6d\xf2\xe9\x3f\x8f\xd8\x8d\x8c\xcd\xa1\x50\x37\x5a\xde\xef\x44\xd5\xff\x1d\x7a\xf2\x48\xd8\x67\x36\x3b\x85\xb0\x07\xcc\x49\x17\xbe\x3c\x06\xf9\x75\xb1\x67\x5c\x32\x27\x3d\x1c\xc7\x98\x1a\x86\x66\xa0\x78\x26\xf2\xb3\x47\xb0\xa9\xe9\x06\xea\x3c\x43\x45\x6f\x6a\xe1\x69\x5c\x32\xb9\x91\x50\x4e\xb8\x83\xd7\xe4\x72\x29\x08\xe5\x5b\xdd\x81\xc2\x24\xa2\x08\x58\x3e\xda\x69\x58\xd2\xb4\x98\xeb\x9a\x73\xb3\xa8\x2f\x20\x49\x85\x07\x5e\xd2\x2c\xa0\xfb\x61\x6f\x10\x0e\x4b\xe4\xa7\x62\x51\x8b\x5a\xce\x81\xb4\x2a\x4f\x84\x6f\x66\xce\xd3\x5a\x8c\xf3\x74\x59\x84\x1e\x9b\xf3\x68\x4e\x19\xc1\x3d\xfb\xe4\x3b\x70\x10\xa3\xa0\x3e\xe3\xab\x9a\x89\x58\xf5\x3c\x23\x41\x02\xa1\x14\x33\xc9\xf5\xbe\xd2\xf0\x69\x97\x50\xfe\xec\x75\xc0\x2e\x01\xfc\xd5\x1f\x32\xc3\xcc\xe2\x36\x99\x87\x08\xf9\xcf\x4b\xbc\xe7\x2c\x97\xbe\xb7\x17\x86\x9d\x56\xd0\xfe\xc4\x6e\x94\x83\xc4\x01\xd9\x09\x9f\x9d\x34\x82\xad\xb1\xf0\xf6\xde\x2b\xd8\xb3\x58\x54\x71\x8c\xc6\xc7\x92\xa9\xf3\x2d\x18\xa0\x4c\x31\x39\x02\x60\x2c\xc0\x5b\x2d\xb7\x49\xfd\x95\xdd\x3e\xbd\xbb\x1a\x0f\x00\x90\xef\xe6\x88\xf0\x81\x26\x0c\x9a\x1d\x4a\x72\x0c\x85\x76\xdb\xd2\xb2\x3b\x23\xf8\x0e\x81\x7e\xb5\xc5\xbd\x49\xf9\x8a\x69\x85\x54\xbe\x62\x7d\x65\x7e\x51\xf5\x9b\xf0\x9e\x88\xff\x30\xb8\xd9\xea\x77\x18\x69\x14\x87\xaf\x62\x76\x10\x50\xc0\x2e\xb3\xd6\xac\xdb\xf9\xd3\xf0\x26\xc4\xeb\xa5\xf1\x10\x18\x65\x11\x76\xeb\x1b\x66\x9b\xec\xc3\x4d\x0e\xf8\xda\x6e\x41\x08\x3f\xbf\x62\x97\x16\x22\x5a\x2b\xa3\x5e\xd4\x0e\x4b\x1c\x37\x11\x84\x28\x90\x2c\x72\x26\x4b\xcf\x30\x3b\xe1\x82\x61\xbc\xb9\x8d\x25\x1e\xba\x24\xd5\x8b\x8f\xe4\xe3\xf3\xf2\x79\xd2\xed\x56\xd8\x8e\x6f\x58\xa6\x49\x60\xcc\x28\x9e\x8c\x8a\xb3\xb1\x20\xc4\xfc\x36\xf6\x06\x5a\x94\xac\x04\x30\x1c\xd0\x37\x9b\xab\xd0\x0a\xf6\x59\x70\x62\xf4\x59\x4b\xca\xcc\xd5\xb2\x14\x47\x75\x51\x58\xa5\xa3\xcf\x56\xbb\x8c\x80\x42\x9a\x8f\x3e\x33\x56\xaa\xb8\x08\xa6\xf8\x73\xe8\x1d\xfc\x8a\x76\xbc\xd1\xa4\x35\x22\x3a\x04\x24\x44\x70\xb3\x67\x62\x6f\xc5\xd1\x79\xdd\xf8\x59\xcf\xa1\x66\x3b\x90\xe1\x11\x77\xbe\x80\x87\xcd\xe7\x90\x0e\x37\x02\x28\x09\xe0\x65\xcf\x40\x13\x38\x0e\x4b\x6b\xa9\x7f\x57\x99\x3a\x13\xb1\x61\x35\x79\xd2\xb3\xc7\xc9\xb9\x1b\xcc\xd3\x2d\x30\xa3\x86\x4d\xc7\x7e\x2a\x77\xf2\x5c\x5b\xde\x7b\x95\xd3\xb0\x9d\xb4\x6b\xae\x86\x58\x54\x6c\xb7\x5b\x78\x9a\xb5\x85\xde\xbd\xd0\xbb\xe7\xa7\x77\x93\x0d\x3b\xe4\x49\x1d\x7e\xce\xee\x72\xe1\x9a\xb5\x09\x98\x12\x24\xcd\x1b\x4d\xe7\x64\x0b\xdd\xea\xb1\x35\xd9\xdb\x19\x7a\xaf\x86\x51\xd0\x27\x3d\xc1\x34\x60\x5e\x37\xea\x85\x70\x61\x4e\x76\x97\xfd\x5c\x5c\x98\x8b\xf7\x10\x67\x26\xc7\xf9\x69\xae\xf8\xbf\x08\xb0\xfd\xe1\xa4\x75\xef\x7d\xbc\x86\x3f\x2b\xb7\x61\xeb\xbf\xaf\x6e\x82\xa8\x07\x97\xaf\x16\xdb\x02\x9c\x9f\x72\xf4\x1c\x77\x02\x72\x85\xe9\x37\x04\xa2\xa5\xa8\xdf\x06\xc3\x05\x2e\x91\x5a\x51\x06\xec\x5b\xc2\x22\x1e\x75\xa6\x30\xef\xc7\x12\xb2\x31\xfd\xf4\x27\x61\x1a\xb2\xae\x0c\xbb\xbd\xf8\xd6\xa2\x89\xc1\x39\x21\x01\xcd\x6f\x72\x68\x36\x05\xae\x2a\x57\x5b\x73\xad\x55\x63\xba\x56\x52\x5c\x5f\x01\x6f\x63\xf7\xa5\x4e\x91\x79\xc0\xfb\x44\xd0\xd6\x57\xb4\x78\xbd\xb0\xc7\xa6\x20\x0b\xd1\x78\x55\xa6\xa8\xb9\x3c\x02\xae\x3a\x00\x16\xc0\x6b\xc5\x80\x63\x03\x35\xc7\x06\xec\x2b\xbe\xfc\xbc\x78\xd1\xaa\xb9\x00\x35\x17\x71\x6f\x93\xb4\x57\xb5\xb4\x67\x68\xcb\xad\x1d\xd2\x86\x3f\xad\x1f\x10\x19\xd4\x4c\x68\x2c\xf2\x62\x13\x19\xf4\x17\x38\x2c\x6c\x72\xbc\x25\x85\xab\xd4\x19\xea\x50\x56\x3b\x22\xf6\xee\x8b\xe6\x2a\x77\x15\x9f\xf9\x4b\x8b\x69\x70\x4c\x31\x01\x40\x85\xdd\x30\x44\x84\xb1\xf5\xa0\xd4\xa6\x7f\xcd\x82\x8c\x15\xa2\x71\x56\x5c\xfe\x25\x2f\x97\x98\x54\xb3\xed\x55\x41\xdb\xc4\x60\x23\x02\x23\x59\x5b\xe8\x1a\x92\x5b\x7c\x9e\xc1\x15\xef\x13\xc8\x93\x10\xf7\x7b\xf7\x74\x85\xda\xf9\xed\xf8\x74\xc7\xfb\x48\x34\x4a\x4d\x66\x2c\x78\x38\x5e\xbe\x0a\x59\x7d\x1c\x9c\x99\xcc\xac\xbe\x2b\xa5\x41\x8e\xa6\xac\x47\x62\x0d\x5a\xd1\xbc\x7e\xe2\xa6\x82\x99\x2c\x05\xa6\xaa\xd9\xad\xa0\x11\x33\xa6\xc3\x08\xd4\xc4\xa0\x18\xfb\xed\xba\x89\x84\xc7\x61\x23\x09\x8f\x06\x93\x02\xdb\x4a\x86\xdb\xd4\x5b\x4b\x78\x0a\x6c\x2f\xe1\x31\xca\x53\x85\x48\xb0\xc6\xb7\xc9\xbb\x51\x56\x49\xee\xbc\xae\xea\xf4\x7b\x4f\x78\x6c\xfb\x4f\x78\x66\xdc\x83\xc2\x33\xed\x3e\x14\x1e\x8d\x28\x29\x60\x4b\x4c\xe4\x82\x6c\x52\x14\xa2\x43\x59\x89\xef\x54\x45\xdd\x59\x36\xb8\x32\x90\x39\xec\x73\xeb\x8b\x7d\xee\x62\x9f\xfb\x90\xfe\xa5\xdc\xee\xb6\xa0\x5f\xe9\xfc\xd4\x7d\x37\x39\xd3\x36\x52\xa1\x19\x64\x55\x82\x85\x2e\xb0\xd0\x05\xbe\x81\x2e\xa0\xfb\xe9\x31\x57\xf6\x03\x52\x33\xc4\x5c\xc3\xac\x55\xbb\x9a\x9f\xf0\xdb\xb2\x47\xcf\x75\x68\x7e\xc1\x78\xe5\x2f\x5e\x65\xa1\x5c\x9c\x4e\xa5\x55\xcc\xa6\x4e\xcc\x47\x8f\x68\x2c\xf4\x88\x85\x1e\xf1\x80\x7a\xc4\x6e\x8f\x0c\xf1\x30\xee\x47\x6d\x32\x9e\x9d\xb0\x15\xc7\xd3\xc7\xaa\xec\x6e\xbb\xc6\xaa\xd0\x92\xd3\xeb\x14\xa4\x7e\x4a\x87\x80\xef\x0f\xad\x43\xc8\x0a\x41\x5a\xe0\xa7\x61\xcc\xec\xae\x86\xce\xa4\xc4\xd5\xb7\xee\x5c\x27\x7c\xb8\xce\x31\x69\x49\xfa\x34\x83\x74\xa5\xb5\xe7\x20\x5d\x9b\x0b\xe9\xba\x90\xae\x0f\x28\x5d\x8f\x27\xe3\xc1\x64\x3c\x7b\x14\xe0\xb1\x73\x14\xe0\xf1\x8c\x51\x80\xd9\xdd\xda\x71\x26\x2a\xf0\xf8\xe9\x07\x79\x3d\x89\xdd\x5a\x3e\x6e\x45\xbc\x79\x84\x1d\x9b\x01\x9b\xef\x76\xd7\xb6\x30\xaa\x26\xaf\xd4\x7d\x3d\xce\x04\x68\x3a\xed\x7b\x8e\x67\x8a\x13\x3a\x9e\x57\x9c\xd0\xea\x62\x65\x5e\xac\xcc\x0f\xb8\x32\x6f\xbd\x3d\x3b\xf0\xde\xaf\xac\x57\x6b\x3c\x63\x82\x47\xe6\x24\x91\xba\x57\xb9\x73\x3d\xda\xa5\x99\x54\x5c\xd9\xea\x8f\xa2\xf7\x00\x65\x53\xd5\x77\x52\x42\x5e\x02\xc1\x13\x2c\x85\x96\xba\x84\x94\x6a\xc4\x29\xcf\x50\x71\x96\x9c\x57\x4f\x9d\x5f\xd7\x88\x95\xd4\x02\xc8\x2b\x26\x2b\xa2\xe9\x6c\x3b\x32\xfe\x84\x48\x79\x4e\x2e\xc3\x4d\x2e\x78\x8a\xf1\x84\x96\xcb\xb5\x42\x5f\x2c\xa7\xd1\xff\x5b\xe6\x77\x33\x1a\xf4\x32\x80\x5e\x30\xa2\xf7\xc9\x78\x83\x78\x14\xe9\xf3\xcc\x02\x36\x50\x16\x00\x9f\xc4\x9c\x54\xa2\xe9\x17\x19\x54\x97\x3d\xdf\xd4\x3a\x29\xab\xfe\x0d\xe9\xdf\x86\x6b\xa0\xb4\x97\x76\x27\x79\x44\x2e\x64\x84\x92\x5b\x67\xd2\x98\x24\xb7\xce\xc8\xa5\x6d\xf7\xcf\xa4\xf3\x18\xbc\xc8\x00\x55\xf9\xf3\x95\xe7\xc0\x27\x7d\x05\xf3\x9a\x38\xf6\x35\x59\xe1\xfa\x13\x0c\x81\x27\xa2\x29\xc5\x25\x34\xe0\x41\x8c\x97\x3b\xd3\x48\x04\x2b\xa5\xfb\xb5\x0c\xb6\x4d\x46\x38\x4b\xd4\x56\xa2\xb2\x18\x73\xa3\x64\xe0\x3b\xd0\x2d\x23\x9d\x1c\xd6\x48\x2e\x31\xe0\x76\xd0\xf9\xac\x93\x6b\x8b\x75\x72\xb1\x4e\x3e\xe0\x3a\x79\x70\x76\xec\xe1\x6d\xb5\xb3\x2d\x93\x07\xec\xce\xdb\x87\x5a\x26\xbf\x8f\xe5\x2e\x11\x2f\x45\xd6\x3b\xcb\x8a\x13\xf7\xdb\xc1\xb8\x94\xda\xf1\x27\x17\xad\x0c\x83\x7e\x27\xbe\x29\xe5\x56\xb8\xa5\x25\x4b\x45\x7a\x44\xb7\x74\x91\x5b\x91\xaa\x75\xc2\xdc\x7e\x6e\xd2\xc1\xb3\x58\x48\x92\x61\xf9\x06\x0b\x09\x9f\x53\xf3\x59\x48\xd6\x17\x0b\xc9\x62\x21\x79\xd8\x85\xe4\xe5\xc1\xee\xb6\x07\x77\x9b\x2f\xfb\x1e\x9b\x12\xde\x51\x38\xbe\x8e\x3b\x5e\xb5\xe8\x6a\x82\x57\xa4\xcf\x7b\x35\x81\xfc\x79\x98\x00\x4f\x2f\x5c\x1c\x64\x2e\x17\x9d\x00\x89\x3e\x42\x62\x6a\x5b\xfe\x9f\x70\x18\x03\xe2\x78\x66\x72\x18\x8e\x34\x0b\x5a\x8a\x0a\x50\x87\x91\x11\xbe\xe7\x3a\xfa\x70\x12\x9a\x49\xc1\x3f\x18\xd2\x7a\x51\xa8\xc5\x17\x9b\xa4\xed\x38\x88\x5b\xc8\x2d\x75\x43\xa6\x03\xdd\xed\x2d\x2f\x23\x95\x5c\x06\x8a\x4b\xe1\xe5\xe5\xb9\x8a\x5d\x64\xbe\xb9\x88\xdd\xd5\x79\xde\x20\xbf\x10\xbb\xf9\x4e\xfc\xe8\x62\x77\xcb\xeb\xc7\xf1\x60\x36\xe5\xfd\x2d\x9f\xb6\x4e\xf2\x56\x39\x12\x8e\x82\x47\x5d\x77\xba\x79\x2a\xb0\x9e\xcf\x44\x9d\xe7\x8d\xaf\x8b\x89\x9a\xef\xc4\x8f\x3e\x51\xff\x47\x5a\x4c\xa7\x9b\xa6\xd2\xfa\xfa\x3d\x6d\xb3\x67\x36\xa8\xca\xb6\xce\xdc\xb6\xbb\x54\x32\x6f\xbc\x97\xbc\x2f\x5f\xb2\xaf\x1e\x4e\x71\xb2\xd3\xb1\x60\xda\x69\x02\xf3\x1d\x20\xa1\x07\x18\xe5\x12\x49\x53\x03\xb7\xaa\xfc\xed\x75\xd4\x0b\xbd\xd2\x4f\xa5\x24\xf7\x6d\x72\x29\x3a\xe6\x12\xcc\x5d\x82\x2e\x8e\xf9\x1a\x5d\xa1\x91\x52\x01\x83\x47\xe3\xe4\xcc\x26\xe3\xc5\x7b\x76\xe7\xa7\xc3\x49\x53\x65\x3e\xab\xc3\x8f\x99\x4e\x6c\x74\x1d\xf8\x99\x57\x90\x3b\xee\xc9\xac\x14\x88\x1f\x7e\x42\xb4\xbe\xcf\xc5\xc2\x16\xf5\xf3\xcd\x2e\x13\x7f\x15\x8c\x20\x68\x86\x5e\x0c\x0e\x5f\x34\xe5\x8a\x5f\x3a\x9e\xbf\x48\x5c\x55\xea\x6c\x7f\xcb\xf7\xc4\xcd\xd5\xf0\x4d\x53\x10\xd2\x2a\x26\x05\xe1\x9b\x52\x7e\xea\x12\x55\x9e\x04\xa3\x11\x48\xdd\x65\xe8\x63\xc7\x7b\x13\xde\x7b\x3b\x90\xfc\x21\xa0\xc9\x81\xf9\x68\x54\xa7\xbb\x7e\xfb\xe4\xd5\x9b\x9d\xbd\x6a\x82\x9e\xf8\x0e\xad\x59\x42\xab\xd4\x18\x53\xac\xb7\xe3\x7e\x37\xba\x9a\x0c\x29\x96\xf1\x00\xfe\x8c\x54\x88\x61\x71\x2d\x98\xff\x1e\x0c\xe3\x41\x38\x1c\xdf\x7b\x7f\x52\x1b\x2c\x66\xbe\xc4\x75\x1c\x32\x56\x42\x16\xcc\x11\x7c\x89\xe8\x41\xec\x11\xe4\xb8\xe2\x69\x95\x56\x08\x9d\xba\xc1\xa4\x37\xde\xf0\xea\x5e\xc9\xaf\xae\x79\xad\x88\x08\x3d\x97\xb6\x68\x66\xc8\xaf\x2c\x8b\xac\x48\xcb\x0a\xe9\xb4\x30\x87\x96\x04\x1a\x06\xbe\x08\xfa\xd1\x38\xa4\x44\x19\x21\xd8\xc4\xb2\x2c\xfd\x40\x1a\x21\xb5\xba\xf1\xf0\x46\x6a\x48\xdb\x8a\x62\x64\xe1\x69\x77\xaf\x36\x5c\x46\x11\x1e\x46\x53\xd2\x08\xa1\xd2\x4b\xaf\x56\x35\xdc\x6b\x4e\x09\x41\x3b\x6e\x28\x96\x74\x47\x8b\xfa\xd7\x25\x6d\xca\x6f\x13\x6b\x1d\xf4\xa3\x71\x84\xf7\x72\x8c\xbc\x00\x6e\x72\xef\xdd\x7b\xd4\xc8\xd5\x41\x86\xe8\x24\xb3\x83\xcb\xaa\x69\x18\x8f\x66\x5e\x3d\xc6\xd5\xec\x2b\x50\xd3\x2b\x1d\x23\x1b\x07\xbd\x25\x1c\xba\xb6\x8a\xc5\x79\x92\x35\x08\xd6\x02\xb3\x50\x82\xcc\x14\x38\xe8\x33\xa6\x9a\x2b\xc2\x83\x51\x7f\x9d\xae\x1c\x7d\x29\x4d\x70\x6e\x15\xd4\x1e\x26\x2a\x02\xe4\xcf\x84\x7f\xd6\x68\xf0\xd7\x5c\x41\x96\xd3\xbc\x54\xa9\x54\x4c\x8d\x68\x66\x43\x44\x98\x46\x56\x8d\xc9\x78\xda\x23\xd0\x61\xd0\x37\xc5\x47\x3e\x8f\xa0\xaa\x4e\x7d\x9c\x8a\xa1\x59\x04\x09\xcd\x50\xe7\x2a\xee\xa7\x67\xe9\x7c\x32\xe1\x01\x6b\x13\xd9\x9a\x7f\xd1\x36\x60\x00\x34\x0a\x7a\x63\x6f\x0b\xff\x4c\x81\x9f\x26\x2d\x31\x4f\x75\xa4\xc9\x71\x6c\x01\x3a\xf3\x1c\x0a\x41\x71\x20\x1c\xbb\xc2\x6e\x3a\x28\x71\x02\x95\xb1\x9f\x85\x39\x91\x81\x91\x99\x31\x03\xd1\x31\x18\x59\x5f\x08\x63\x91\x53\xbc\xab\xdd\xb1\x31\x98\x20\x53\x51\x51\x31\x03\x65\xa9\x98\x31\x51\x34\x9b\xa9\x30\x31\xe8\x92\x50\x16\xac\xe3\x70\xcc\x10\x45\x38\xbd\x58\xca\x76\x53\x12\x1d\xfe\x37\x38\x0e\x39\xb7\x86\xed\xc6\x1e\xdc\x4b\x1f\x90\x0d\xcc\x9d\xaa\xf6\x05\x5c\xaf\x81\x8f\xed\x7e\xce\x02\x37\x3b\x09\x7c\xc5\xc6\x59\xbc\x11\xdb\x67\x37\x94\x39\x80\xe4\x8d\x13\x00\xae\x21\x6d\x02\x13\xac\xb0\x6f\x96\x3a\x92\xf6\x41\xab\x25\x2f\x6c\x74\x91\x03\xc4\xf5\x05\xd9\x66\x3e\x43\x1d\x7e\x97\xcd\x2f\x1c\x69\x6b\x08\xb3\xa0\x10\xc1\x13\xd8\x71\x65\x32\xe8\xc0\x50\xe2\xcc\x59\xe9\xb2\x1b\xbb\x4a\x09\xc9\x4c\x1c\x02\x0f\x02\x81\xac\x6d\x63\xcb\xed\x1e\xac\xbb\x0e\x6c\x90\xc2\x33\x35\x88\xd6\xf1\xcb\x57\x3d\xa4\x14\xda\x94\x5e\x31\xaa\xb9\x60\x7b\x20\x86\xd1\xde\x66\x24\x5f\xfc\xc6\xda\xb3\xa0\x9a\x8a\x49\xf7\x69\x4c\xba\xc4\x39\x4e\x41\xe9\xf0\x64\x9a\xc6\x31\x11\x63\x29\xff\x68\x1b\x4d\x78\x0a\x8d\x28\x3c\xce\x57\xfc\xc0\x93\xa5\x14\x1f\x5f\xf9\x9d\xc3\xcd\x45\x52\xdb\xef\x8f\x4f\xd3\x04\xc0\x5c\x4b\x96\x0c\xb5\xfc\x11\x23\xf0\x91\x9e\x0a\xf8\xc8\x4f\x05\x48\xcc\x43\xde\x3a\x8e\x03\x3c\x49\xed\x8b\x8f\x78\x40\x20\xd7\x5b\xf2\xde\x61\x18\x6c\xa9\xd0\x6c\xd4\x91\xc4\x26\xf3\x70\x23\x66\x36\x0e\xc8\x88\xcf\x8b\xca\x87\x17\x2f\x0c\x55\x0c\x58\x4a\x08\x48\xb6\x3f\x2e\x5d\x6d\x37\x6f\x31\x6d\x26\x01\xe2\x6e\x72\xd4\x1c\xa0\xd0\x59\x08\xe6\xa6\x3f\xaa\xc1\xcf\x41\x6f\x9c\x41\x67\x9c\xd7\x56\x2c\x1a\x31\xcd\x4b\xbb\x19\xd3\xf4\x7e\x3a\xad\x54\x03\xec\x5b\xdd\x99\x41\xb5\x56\xb1\xc3\xa2\x9b\x2b\x17\xcd\xb5\x18\x88\xb2\xe7\xb0\xf7\x9b\x05\xa4\xf3\xde\x4f\x7d\xd7\x87\xb0\x66\xe9\x74\xed\xb2\x67\xda\x08\xb2\xa1\x4f\x6f\x4d\xa1\x42\x81\xbd\xc0\xd7\xac\xdd\xd5\xed\xf8\x12\x6d\xd3\xe2\x0d\xe0\x86\xff\xc4\xa6\x4c\xbe\xad\x25\xb6\xe6\x0d\x6f\x75\x35\x71\x0a\xfc\x98\xb9\x8e\xc3\x7e\x7b\xb9\x45\x44\x61\xb3\x9e\xf9\xe1\xa6\xd3\xc8\x16\xfd\x3c\x20\xfb\xbc\xef\xc0\xe5\x2c\xf5\x09\xbf\x43\x57\xe8\x0f\xb4\x07\x7f\x11\xbf\xf4\x93\x71\x35\x9c\xe1\xf1\xc8\x6d\x7e\xba\x97\x7a\x11\xe4\x97\xce\x8e\x04\x65\x27\x4e\xc3\xc9\x28\x80\x2c\xd0\x74\x96\x68\xfa\x72\x46\xe0\x5c\xa8\xf4\x2e\xda\x92\xe9\xd7\xd7\xfc\x47\x77\x9d\xe2\x34\x68\xb5\xa2\xb1\xc7\x0e\xe5\xb2\x83\xcd\x66\x0f\x83\x12\x0e\xde\xc3\x10\x81\xa1\xb6\x17\x5e\x05\xed\x7b\xef\x73\x38\x1c\xe1\x6d\x5b\xd7\xc1\xd8\xeb\x87\x57\x90\xf0\x83\x2c\xa3\x64\xad\x26\x8b\x38\xf9\x71\x8c\x5a\x0c\xac\x14\xe4\x55\x2f\x1a\x8f\x7b\xe1\x32\xbd\xdd\x53\xad\x1d\x60\x03\xe1\x70\x48\x16\xf9\x4e\x1c\x8e\xfa\xcf\xc6\x5e\xd0\xed\xc2\xd5\x5d\x00\x87\x22\xfe\x6c\x04\xf7\x64\x4d\x08\xea\xf7\x2a\x8b\xf5\x73\xaf\x35\x19\x93\x85\x06\x01\xf0\xda\xd1\x78\x24\x12\x74\x47\x04\x0f\x96\x01\x35\x1e\x03\x21\x22\x58\x94\x21\x4d\x52\xa0\xf5\x6b\xe8\x1c\x2e\x94\xb0\x87\x94\x18\xc2\xed\x92\x79\x2b\xf3\x96\xc5\x7c\x7f\xd9\x89\x4f\x61\xab\xf3\x00\x77\x54\xbf\x91\x8f\x67\x3b\x59\x1e\x92\x23\xe9\x68\x42\xd0\x5f\x2d\xc1\x10\x11\xa6\x83\x88\x19\x84\x40\x3f\x0a\x9d\xcc\x42\xef\x05\x72\xf0\xe9\xc2\xbc\x27\x78\x73\x01\x41\xaf\xa5\x37\x70\x21\x38\xcd\x44\x0c\x17\x12\xbe\xb9\x60\x71\x04\xe4\xbb\xc1\x93\x41\x01\xf8\x14\x40\x25\x0d\xa0\xe6\x0e\xa0\x4a\x01\xf8\x69\x00\x15\x77\x00\x35\x0a\xa0\x9a\x06\xe0\x0b\x00\xfa\xfa\xe6\xcb\x68\x55\xe3\xc0\x73\xa8\xb9\x8c\xc4\xb6\x18\x89\x6d\xfb\x48\xe4\x3b\x20\x8d\x01\x23\x08\x0d\xe1\x80\x5b\x6a\x2b\x95\xa4\x97\xf4\x2e\x5c\xb8\xbd\xb6\xdb\xb5\x11\x2b\x3f\xd2\xb5\x74\x2b\xbe\xb2\x95\x6a\xe1\x56\x2a\xda\xd1\x94\xe0\x65\x5a\xa9\x15\x6e\xc5\xd7\x0d\x79\x59\x82\x97\x69\xa5\x92\x69\x65\x06\xee\xd8\x0e\x86\xc3\x7b\x70\x9e\x5a\xdc\x25\x97\x2d\x8f\x26\x04\x37\xdb\x7e\xc7\x94\xd5\x40\x3c\x8f\xee\x47\xe3\xf0\x86\xec\xd0\x26\x64\x8b\x16\xdd\x98\x38\x6d\x86\x1b\x9c\xe1\xae\xa3\x33\x10\x2c\x6e\x57\x00\xdb\xae\x3f\x3e\x8a\x3b\x51\xf7\x9e\x2e\x2f\x74\x9a\x14\x44\x7b\xcd\x0d\xed\xed\x0b\x9a\x9f\xe1\xfd\x45\x09\xe2\x8d\xf0\x0a\x83\x55\x4b\x86\x06\x33\xe5\x7f\x25\xcb\xe0\x78\x32\xd0\x17\x72\xcc\xdf\x51\xcc\x0a\x4a\x9a\xc5\xa4\x1e\xae\xa6\xcf\x83\x5f\x2f\x2b\xa4\xc6\xc1\xaf\x84\x87\xdd\x8a\xfb\xb4\xb8\x6f\xbf\x74\x5b\x96\x75\xc8\x75\xa3\x49\xeb\x73\x08\x3a\xac\x8b\x7d\x14\xd0\x2a\x95\x4a\x88\xa0\xb8\xb0\x1b\xbf\xe9\x6e\xec\x96\x8b\xb3\x1b\xb9\x45\xf9\x22\x57\x72\x0b\x14\xaa\x02\x05\x3f\x85\x82\x6f\x43\xc1\x4f\xa3\xe0\x4f\x8f\x02\xde\x85\x11\x55\xb8\x0c\x02\x80\x04\xad\x94\x08\x72\x01\x53\x43\x30\x55\x49\xb2\x11\x98\x29\x91\xe5\x32\x9a\x6c\x32\xba\xae\x57\xf0\x6c\x83\x6c\x04\xb3\xa6\xed\x92\x88\x6d\x90\xb4\x50\xd0\x74\x49\x02\x2d\x58\xa5\x05\x6d\x37\x36\x6c\x83\xb0\x86\x82\xb6\xab\x24\xb6\x2f\xea\xae\x38\x36\x5c\x71\x6c\xba\xe2\xb8\x9a\xe0\xe8\xe8\x5f\x28\x2c\xcd\xe1\x99\x41\xa2\xc3\x53\x50\xaa\xc3\x63\xba\x30\x43\x63\x9c\xd5\x3a\xf4\x89\xde\x7d\xa2\x49\x2d\x75\x54\x34\xad\x94\xb3\xa6\x5b\x7c\x6d\xd5\x17\xd7\xd0\xcf\x4d\x4f\xc4\x31\x4e\x92\x36\xa1\x68\xd7\xd7\x3c\xa3\x17\x57\xbc\xc7\x89\xe7\x95\xde\x03\xcf\x72\xe9\x81\xdf\x85\xb2\x66\x18\xc0\x33\x7a\xa1\xc4\x7b\x9c\x6b\x50\x69\x35\x03\xa4\xe1\x04\xa4\x4a\x81\xd4\x19\x10\x3f\x03\x64\xd5\x09\x48\x8d\x02\x69\x7e\x10\xe8\xa7\x80\xf8\x12\x90\x07\xd1\x68\x80\x77\x6e\x83\x81\x47\x37\xc6\xe6\xb2\x67\xa0\x46\xe0\xa2\x81\x9f\xc4\xa2\x81\xdf\x74\x8b\x86\x7d\xfa\x49\xf0\xd8\xaa\x22\x00\xaa\x56\x15\x6b\x7f\xac\xe9\xaf\xe0\x39\xca\xe6\xae\x3a\x9b\x2e\x67\x95\x76\x56\x8b\xd0\xfb\x54\x28\x9c\xba\x6c\xf4\x99\x16\x6c\xd6\xb1\x9c\xc2\xf4\x6b\xb9\xc8\x4a\xcc\x40\xfd\x86\xdd\x2d\xf5\x4e\x5a\x42\xe8\x4a\x49\xfb\x34\xed\x80\x40\x7b\x01\x1c\x71\xec\x75\x9c\xd6\xd4\x29\xd5\xdb\xed\x4b\xca\x94\xdb\xc6\xfb\x04\xb5\x28\x6e\x07\xbd\xf6\xa4\x07\x72\xa8\x1f\xde\x3a\xe1\xb9\x4d\x25\x50\x09\xff\xbe\x20\xbc\x59\xef\xd4\xf0\xff\xe4\x0b\xdb\xbb\x60\x6e\x6a\x0d\x32\xdb\x54\xf6\x94\xf0\x2f\x54\x67\xb5\x6b\xe4\x4b\x89\x02\x05\xc6\x27\x7b\xaf\x5f\x48\xa1\x4b\xe9\xfb\x7f\x79\xbe\xb7\x41\x3e\x98\xa1\x57\x19\xf4\x2a\x85\xce\x80\xd7\x19\x74\x3f\x03\xdd\x2f\x08\xbd\xc6\xa0\xd7\x72\x5d\x2f\xd1\x36\x53\xd0\xab\x05\xa1\xd7\x19\xf4\xba\x92\x32\xb5\x0c\xf4\x5a\x41\xe8\x0d\x06\xbd\xa1\xa4\x4c\x3d\x03\xbd\x5e\x10\x7a\x93\x41\x6f\x2a\x29\xd3\xc8\x40\x6f\x14\x84\xbe\xca\xa0\xaf\x2a\x29\xd3\xcc\x40\x6f\x16\x82\x9e\x6c\xb8\x69\x03\x29\x50\xab\x59\x50\xa6\xf9\x9e\x4c\x26\xd0\x1a\xae\x96\x1f\x60\xba\x43\x8d\xab\x3b\x5c\x32\x23\xa0\x05\x9b\xf6\x66\xa3\x43\xdc\x27\xca\xc5\xa4\x3d\xf6\xae\xa3\xab\x6b\x2f\xe8\x77\x3c\xb8\xd2\x2e\x18\x5e\x4d\x30\x3f\x3d\x26\x7b\xfc\xdf\x49\x30\xd4\x9e\xf6\x16\x2d\x07\xa4\x65\xd2\x3c\xdf\xa1\x58\x6c\x98\x57\x2d\x5a\x9c\xae\xe8\x76\xdb\x08\xa7\x5e\x0a\xcd\x61\x38\x9a\xf4\xc6\x10\xd6\xed\x88\xe3\x35\x5d\xa2\x4b\x04\xd9\xe7\x04\x63\x9a\x03\xc4\x5f\x5d\x22\xd4\xa2\xaf\x5a\xec\x55\x03\x5f\xb5\xf0\x95\xad\x27\x3d\x0a\x54\xea\x3b\x35\x10\x91\xba\x77\xc8\x5c\x4b\xc8\x8b\xbc\x80\xd8\x78\x49\x05\x6c\xfd\xdf\x87\x5e\x43\x00\x8b\xf1\x0e\xc5\xd7\x54\xdc\x93\xa2\xbf\x13\xac\x66\x96\xf8\x76\x2b\xf1\x7b\x26\xef\x5f\x53\x79\x5f\x22\x1f\x56\x65\x23\xda\x6b\x49\x7b\x5c\x62\x05\x9a\xe9\x02\xcd\xa4\x80\x69\x22\xbe\x67\x4b\xc3\x6b\xba\x34\x94\x68\x93\x42\xcb\x7a\xcd\xd7\x02\xd4\xb2\x5e\x78\xd0\xb0\x19\x5c\x95\x81\xab\x72\x70\x7e\x1a\x2f\x3f\x87\x78\x25\x5d\xa0\xe2\x8a\x78\x8d\xb5\x54\xe3\x2d\x55\x53\x88\x57\x33\x88\xfb\x16\xc4\xeb\x0c\x5c\x9d\x83\xab\xa5\xf1\xaa\xe5\x10\xaf\xa6\x0b\x54\x5d\x11\x6f\xb0\x96\x1a\xbc\xa5\x7a\x0a\xf1\x7a\x06\xf1\x9a\x05\xf1\x26\x03\xd7\xe4\xe0\x1a\x69\xbc\x1a\x39\xc4\xeb\xe9\x02\x75\x57\xc4\x57\x59\x4b\xab\x1f\x52\x3c\xb7\x96\x66\x39\x81\x78\x43\x8b\xb8\x72\xae\xe8\x7c\x79\x5c\x83\x14\x9a\x27\x86\xc6\xa4\xdc\x63\xd4\xf3\x48\x23\xbd\xba\x41\x3b\x97\x0f\x1a\x01\x29\x81\x4f\x1d\xa2\x42\xdb\x1e\xc3\x3d\xf4\x52\x4c\x88\xec\x08\xe3\xc9\x74\x4b\x37\x64\x97\x1d\x5c\x85\x65\xd8\x71\xd2\x88\x0d\x73\x9c\xc9\xa0\x17\x40\x4f\x08\x64\x2d\x68\x96\x54\xbe\x94\x60\x61\x83\xae\x0c\x30\x31\xf9\xed\x2e\x69\xc0\xc8\x7e\xd8\x1b\x84\xc3\x92\x5c\x32\xe7\x20\x77\x8b\x09\x91\x41\x58\x22\x43\x8c\xe7\x44\xb3\x31\x04\xe4\x5d\x5d\x0e\x1f\x20\xdf\x9b\x22\xae\x80\xec\x6f\xfc\x24\x86\xe4\xc7\xbc\x47\x6c\x11\x43\xb2\x88\x21\x59\xc4\x90\xcc\x39\x86\xc4\x51\xc6\x26\x31\x13\xd9\x68\x89\x1f\x28\x4e\xc2\xc9\xd8\x37\x83\x3d\xf1\x8d\xb0\x11\xbe\x49\xd9\x08\xdf\xcc\x6c\x23\x7c\x93\xb6\x11\xbe\x31\xd9\x08\x75\x60\x6c\x7e\xcd\x45\x14\xc9\x22\x8a\x64\x11\x45\x62\x6d\x65\x11\x45\xb2\x88\x22\x59\x44\x91\xe8\x8b\x2f\xa2\x48\x16\x51\x24\x8b\x28\x12\x5b\xc1\x45\x14\xc9\x22\x8a\x44\x7a\x16\x51\x24\x8b\x28\x92\x45\x14\x49\xe6\x59\x44\x91\x2c\xa2\x48\x94\xd5\x17\x51\x24\x3a\xe8\x8b\x28\x12\x1d\xf4\x45\x14\x09\x7b\x16\x51\x24\x8b\x28\x92\x45\x14\xc9\x22\x8a\x64\x11\x45\xb2\x88\x22\xc1\x7f\x6d\x51\x24\x0f\x12\x3f\x32\xdf\xc8\x11\x97\x98\x91\x99\xa2\x45\x1e\x29\x4e\xa4\xb1\x88\x13\x59\xc4\x89\x2c\xe2\x44\xb2\x45\x1e\x3b\x4e\x44\x17\xa8\xb1\x5d\x2f\x94\xe9\x43\x17\xa6\x41\xc0\x24\x31\x1a\xf8\xe5\x69\x04\x68\xd0\xbc\x53\x22\x44\xc3\xa2\xf0\x7e\x4a\x32\x77\xba\xc6\x73\x60\xc2\x2a\x39\x43\x9c\x7c\xf3\xb5\xd9\xd6\x0a\x89\x57\x47\xad\xf8\xce\xdc\xc0\x99\xc0\x5f\x1b\xc2\xc3\x1f\xd5\xee\xaa\xda\x68\xba\x99\x04\x99\x99\x2f\x9a\xc1\x3f\x08\x19\xe7\x2c\x0e\xc2\x34\x8a\x65\x9e\xba\xb0\x10\xa6\x8c\xec\x40\x64\x9e\xde\x15\xee\x01\x91\x46\xc2\xc1\x7d\xc4\x00\x80\x3a\xc5\x47\xfd\x22\x05\x34\x7f\xd9\x48\xea\xe7\xdc\xbd\x23\x56\x1b\x25\x74\xb5\xf4\x91\xe8\x65\x67\x74\x13\xcb\xc0\x2d\x11\x50\xd0\x75\x97\xfc\xa3\xb7\x81\x81\xb4\xbc\x67\xa8\x59\x98\xcd\x9a\xf0\xb0\xf1\x3e\xb3\x67\x74\x84\x22\xc0\x84\x33\x70\xc6\xb6\xd5\xcf\x4d\x59\x3c\x12\xcc\xfe\x91\x85\x02\x28\x5b\x7b\x38\xa7\x0a\x37\x10\xa3\x71\x98\x5f\x27\xf0\x86\xfb\x29\x80\x4d\xec\xde\x22\x3d\x7a\x22\xbb\x1d\x19\x70\x37\xdb\x70\xa5\xb8\x55\x58\x89\xf5\xac\x16\x62\x49\x0a\x19\x2c\xc4\xc9\xf0\xe9\xc4\x08\x4f\x57\xca\x06\xd9\x64\x57\x92\x93\x0b\x4b\x6e\x22\x3d\xdc\x4f\x72\x87\x0d\xec\x23\x64\x50\x9f\x0a\x9f\x3e\xf5\x9b\xf4\x2d\xa2\x27\x42\x7f\x33\x5c\xff\x93\x4c\x59\x5d\xd9\xd4\x5c\x77\x99\xe1\xd6\xd9\xed\x38\xb3\xdd\x66\xb5\x34\xa3\xf5\x85\xd2\xe4\xfc\x02\x40\x4b\x4c\x74\x41\x7d\xd6\x2b\xdc\x7f\x52\x01\xd9\x47\x79\x38\x85\x71\xe6\x1d\xe6\x71\xb6\x04\xc3\x24\x02\x42\xc7\x5b\x89\xe0\xd0\xf3\x15\xdb\x2a\xa5\xfa\xf6\x43\x6d\x8f\xb7\xeb\xf3\xde\x1b\x13\x88\xf3\xda\x18\xe7\x15\xc7\xcc\xae\x78\xbb\x5e\x2c\x3f\x2e\x46\x77\x44\x61\x67\x8e\x7a\xee\xce\x30\x1e\xa4\x74\x5d\xf6\x02\x29\xfb\x04\x2f\xcd\xe9\x00\x7a\xe9\xfb\x66\x32\x7e\x77\xe0\x56\x28\x25\x6e\x9c\xf1\xfc\x75\x85\x37\x52\x4b\x18\x78\xf0\xca\x19\x20\x81\x74\x5b\x86\x41\x96\x42\x73\x1b\xfa\x66\x0c\xd7\xc3\x14\xde\x2b\x00\x52\xbc\x52\x81\x48\x05\x18\x55\x47\x35\x56\x44\x73\x43\xaf\x40\x97\xfd\x27\xaa\xb4\xcb\xcb\x56\x4d\x76\x4a\x05\x03\x1e\x9d\x67\xda\x59\x5f\xf8\x8b\x48\x33\x18\xa7\xf9\x4b\x34\x84\x3a\x3f\xa9\xc6\x44\x84\x59\xb2\x41\xa1\x69\x0d\x7e\xdb\xe9\x84\xe4\xdf\xce\xda\xd7\x9c\xa3\xb5\xef\x69\x9a\xf9\x1e\xdf\x18\xf7\x7d\x9a\xd5\x88\x7c\x81\xe5\x88\x88\xdd\xe1\xe7\xec\x7c\x2f\xb5\x97\xbc\x6a\xc5\xaf\x7a\xad\x7b\x6f\xfb\xff\xfb\x7f\x3b\xc3\xa8\xed\x1d\x85\xa3\x7e\xd4\x5b\xf1\xb6\x7a\x3d\x6f\x18\x5d\x5d\x93\x51\x61\x95\x21\xaf\x7c\x06\xc0\x69\xd8\x89\x20\x8b\x7c\x6b\x82\x78\x80\xd7\x14\xf2\xbd\x47\x7d\x6f\x14\x4f\x86\xed\x10\xdf\xb4\xa2\x7e\x30\xbc\x07\x59\x7d\x43\x90\xa6\x39\x5c\x87\xf8\x37\x26\xe2\xed\x06\x55\x83\x36\x2e\xb8\x65\x2f\x18\x86\x70\xf1\xda\x4d\x34\x86\x94\xb4\x64\x15\xfd\x1c\x75\x20\x37\x2d\xa4\xaa\x05\xd9\xd7\x8d\x7b\xbd\xf8\x16\x2e\xb4\x6d\xc7\xfd\x4e\x44\xe5\x22\x54\xba\x21\x4b\x51\x0e\xbd\xe5\x0c\x82\x23\x74\xe4\x52\xcc\xda\x71\x87\xd4\x9a\x8c\xc6\x30\x8b\x89\xf8\x41\xf0\x41\x0b\x6e\x97\x6f\xc7\x83\x7b\xec\xba\xd7\x8f\xc7\x51\x9b\xcc\x1f\x4c\x5e\xdf\x23\x90\x00\x80\xdc\x72\xbf\x93\x41\x8b\x34\xd7\x26\xd2\xec\x86\x48\x16\x2b\x32\xa4\x51\x89\x36\x1c\x19\xd2\xe9\xce\xa4\x1d\xce\x1d\x1f\x8f\xf5\xb1\x13\xb7\x27\x22\x71\x2e\xd4\x78\x49\x46\x83\x26\xd5\xbd\x21\x82\x70\x18\x05\xbd\x51\x42\x79\x1c\x2e\xac\x26\xa1\x9e\x67\x84\xf3\xfd\x83\x33\xef\xec\x78\xef\xfc\xb7\xad\xd3\x5d\x8f\x7c\x3e\x39\x3d\xfe\xf5\x60\x67\x77\xc7\x7b\xf5\x6f\xf2\xe3\xae\xb7\x7d\x7c\xf2\xef\xd3\x83\xd7\xfb\xe7\xde\xfe\xf1\xe1\xce\xee\xe9\x99\xb7\xf5\x76\x87\xbc\x7d\x7b\x7e\x7a\xf0\xea\xdd\xf9\x31\x79\xf1\xf3\xd6\x19\xa9\xf9\x33\xfe\xb0\xf5\xf6\xdf\xde\xee\xfb\x93\xd3\xdd\xb3\x33\xef\xf8\xd4\x3b\x38\x3a\x39\x3c\x20\xc0\x08\xf4\xd3\xad\xb7\xe7\x07\xbb\x67\x65\xef\xe0\xed\xf6\xe1\xbb\x9d\x83\xb7\xaf\xcb\x1e\x01\xe0\xbd\x3d\x3e\xf7\x0e\x0f\x8e\x0e\xce\x49\xb1\xf3\xe3\x32\x36\x9a\xaf\xe6\x1d\xef\x79\x47\xbb\xa7\xdb\xfb\xe4\xeb\xd6\xab\x83\xc3\x83\xf3\x7f\x63\x7b\x7b\x07\xe7\x6f\xa1\xad\x3d\xd2\xd8\x96\x77\xb2\x75\x7a\x7e\xb0\xfd\xee\x70\xeb\xd4\x3b\x79\x77\x7a\x72\x7c\xb6\xeb\x41\xb7\x76\x0e\xce\xb6\x0f\xb7\x0e\x8e\x76\x77\x56\x48\xeb\xa4\x45\x6f\xf7\xd7\xdd\xb7\xe7\x70\x0f\xdf\xe1\xa1\xb2\x97\x80\x7b\xaa\x8f\xaf\x76\x09\x92\x5b\xaf\x0e\x77\x69\x4b\xa4\x97\x3b\x07\xa7\xbb\xdb\xe7\xd0\x9d\xe4\xd3\x36\xa1\x1c\xc1\xef\xb0\xec\x9d\x9d\xec\x6e\x1f\xc0\x87\xdd\xf7\xbb\xa4\x33\x5b\xa7\xff\x2e\x33\x98\x67\xbb\xff\xe7\x1d\x29\x44\x7e\xf4\x76\xb6\x8e\xb6\x5e\x93\xbe\x95\x2c\x24\x21\x63\xb2\xfd\xee\x74\xf7\x08\x70\x26\x74\x38\x7b\xf7\xea\xec\xfc\xe0\xfc\xdd\xf9\xae\xf7\xfa\xf8\x78\x07\x09\x7d\xb6\x7b\xfa\xeb\xc1\xf6\xee\xd9\x3f\xbc\xc3\xe3\x33\xa4\xd6\xbb\xb3\xdd\x32\x69\xe1\x7c\x0b\x1b\x26\x20\x08\xa9\xc8\xcf\xe4\xf3\xab\x77\x67\x07\x48\xb4\x83\xb7\xe7\xbb\xa7\xa7\xef\x4e\xce\x0f\x8e\xdf\x2e\x91\x9e\xff\x46\xc8\x42\x70\xdc\x22\x55\x77\x90\xba\xc7\x6f\xb1\xab\x84\x42\xc7\xa7\xff\x06\xa0\x40\x03\x24\x7e\xd9\xfb\x6d\x7f\x97\xbc\x3f\x05\x82\x22\xa5\xb6\x80\x04\x67\x84\x62\xdb\xe7\x72\x31\xd2\x1e\x21\xe0\xb9\xd4\x47\xef\xed\xee\xeb\xc3\x83\xd7\xbb\x6f\xb7\x77\xe1\xd7\x63\x80\xf2\xdb\xc1\xd9\xee\x12\x19\xab\x83\x33\x28\x70\x40\x9b\xfd\x6d\x8b\xb4\xf9\x0e\xbb\x0c\x63\x44\xb0\xa2\x1f\x25\x8e\x2d\xe3\x48\x7a\x07\x7b\xde\xd6\xce\xaf\x07\x80\x36\x2b\x4c\xc6\xfe\xec\x80\xf1\x09\x92\x6c\x7b\x9f\x91\x5b\x75\x13\xb7\xd6\xa1\x71\x14\x8c\xaf\x1f\xd7\xa9\x51\xfc\x0e\x56\x7a\x03\xa7\x28\x4e\xbf\xce\xe2\xfa\x60\xb1\x40\x41\x9f\x2c\x29\x63\x38\x29\xab\x86\x75\xf9\x07\x84\xbb\xe4\x2f\x0a\x53\x2b\xda\x95\xb2\xe7\x97\x3d\xa2\x48\x11\xed\x89\x28\x4b\x8d\xb2\x47\x54\xa4\xd5\x32\xdc\x5b\xb1\x4e\x7e\x82\x9f\xc9\xef\x3e\x29\xe0\x93\x12\x3e\x29\xe2\x37\x34\x07\xb5\x56\x11\x02\x16\xa3\x35\x9b\x58\x18\x20\x43\xfd\x0a\x42\x6c\x60\x63\x08\xc7\x87\x56\xd4\xa0\x6a\xac\xe9\x3a\x82\x5c\xa7\x70\xd6\x38\xaa\xab\x08\xac\xc9\xda\xf2\x11\xa8\xaf\xbb\xf0\xd3\xa7\xf5\x19\x4a\x15\x0a\xa6\x2a\x50\xad\x21\x38\x00\xef\x8b\xee\xeb\x40\xd5\xb1\x7e\x03\x01\xae\x52\x28\xd5\x04\x51\x1f\x81\xad\xd1\xb6\x58\xd7\xfd\xda\x07\x95\x86\x4d\x87\x69\x58\x60\x98\x18\x7e\xab\x9c\x8c\x55\xda\x0c\xeb\x44\x53\x26\x10\xe0\x53\x33\x10\xa4\x49\xab\xd6\x38\x34\x00\xd0\x90\xba\xd1\x90\x49\xb4\xce\x68\xae\xa1\x6d\x83\x56\x15\x64\xac\x23\x2e\xeb\x7c\x70\x65\x12\x55\x38\xb6\x6a\x50\x6b\x58\x53\x90\x11\xc7\xab\xc1\xe9\xcd\xc1\xd4\x04\xe9\x29\xb2\x1a\xac\xaa\x8c\xf8\x15\x0e\x90\x76\x69\x95\x0d\xae\xe0\x64\x4a\x28\xc4\x56\x3f\x4c\xa3\x22\xb3\x09\xd1\x66\x44\x04\x34\x44\xc3\x9c\x05\x93\x39\xc4\xe6\xd9\xba\x7e\x12\x50\x7c\xd7\x12\x36\x5f\x17\xbc\xca\xb8\xcf\x6f\x08\xc8\xab\xac\x98\x76\xa8\x7c\xc1\x2a\x6c\xa0\xd6\x59\x0d\xc6\x5a\x6b\xe2\x77\x86\xfb\x2a\x7c\x32\x00\xab\x4a\x5d\xad\x0b\x5c\x98\xdc\x10\x73\x69\x4d\x02\xa9\x06\xc6\x66\x77\x43\x4c\x9c\x35\xd1\x15\x8e\x8a\x20\x1b\xe5\x2a\x80\x6c\x18\xb0\x22\xf3\x8a\xa2\x2b\x8f\x4e\x43\xa0\xb3\xca\x05\xa1\x18\xd5\x3a\x45\xa7\x69\xe8\x49\x2d\x35\x40\x6b\xf2\xf8\xac\x0a\xba\x0a\xe9\x80\xbf\x69\x81\x71\xc1\xe4\x73\x32\x36\x13\x1c\xf8\xac\xe5\x12\xb7\x66\x1e\xb0\x06\xe7\x45\xb9\x2f\xcd\xd4\x8c\xad\x8a\x3f\x0d\x2e\x05\xb4\x34\x6b\xe4\xca\xd7\xe5\xa1\x6b\x0a\x18\x9c\x75\x7d\xdd\xed\x94\x38\x64\xd7\xea\x39\x26\xae\xb6\x04\xf1\x71\xd7\xd8\x5a\xab\xae\xae\xaf\xaf\xc3\xe7\xe6\xee\xce\xfa\xee\xab\x2d\x1f\x3e\xaf\xed\xf9\xaf\x5e\x6d\xef\x6c\xc3\xe7\xad\xf5\x46\x6d\x6f\xa7\xbe\xab\xe7\x8e\x6b\x35\x77\x10\xf0\x95\xad\xea\xda\xab\xdd\x26\x36\xb5\x5d\xdf\xd9\xf1\xab\x75\x6c\x6a\x67\xb5\x52\xdb\xdd\xab\xc1\xe7\xd5\x2d\xf2\xad\xb9\x8b\x28\x70\xd4\x34\xfd\xd2\x06\x2a\x1c\x9c\xec\x1e\xed\xf8\xcd\x0a\xde\x42\x3d\xa5\xf5\x56\xc0\x48\xec\xb7\xd2\x2b\xaa\x69\xcc\x3b\x56\x81\x7a\x25\x10\x69\x35\x01\x9b\xab\xf5\x46\xb5\x56\xc1\x31\xd9\xdd\xdb\xde\xd9\x7a\xb5\x86\x84\x5a\x5f\x7b\xb5\xb5\xb3\xbd\xb7\x0b\x9f\xfd\x4a\xad\xda\xa8\xaf\x22\x91\xb7\x6b\x3b\xd5\x5d\x7f\xaf\xa2\x1c\x2b\x78\xe6\xe0\x1e\xb5\x3a\xaa\xa6\x4a\x49\xe1\x3b\x3a\xf8\x8b\x1d\x0f\xa6\x68\xa3\x67\x28\x39\xdc\xe3\x10\x01\x70\x74\x29\xd5\xe4\x7e\xdf\x4b\x4b\x60\xb9\x44\x02\x73\x21\x09\x20\xf8\x02\xcd\x85\xe1\x29\x95\x4a\x12\x46\x22\xa8\x53\x7a\x37\xd3\x59\xab\x34\x6c\x76\xe2\x2a\x03\x3c\x77\xee\xca\x0c\x79\x0a\xf3\x34\x3c\xce\xe7\x16\xf7\x85\x9f\x18\x66\x8f\x53\x34\x0e\x0a\x44\x22\x16\xdd\x0a\x0f\xb1\xf0\xd0\xa9\x30\x6e\x0e\xc8\x16\xc1\xad\x30\x42\xfe\xc3\x0d\x32\x2a\x4a\x44\x5d\x72\x2b\x8c\x90\x47\x43\x87\x6b\x46\x09\x9d\x89\xb8\xf9\x04\xc6\x18\x52\x33\x82\x8d\x8f\x25\x58\x2a\xe8\x95\xbd\x16\xf9\xaf\x4d\xfe\xeb\x90\xff\x42\x5d\xa4\xbd\xa8\x30\x24\x15\xc8\x7f\x6d\xf2\x5f\x87\xfc\x87\xfb\x34\x7d\x95\x00\x90\x0f\xa0\xbb\xfb\xe6\xa3\xf9\x2d\x28\xd8\xa2\x05\x7d\x53\xc1\x36\x14\x6c\xd3\x82\x55\x53\xc1\x0e\x14\xec\xd0\x82\x35\x53\x41\xdc\x79\x86\xb4\x60\xdd\x54\x90\x1a\x9a\xf9\xad\x92\x66\x3a\x99\x42\x67\x94\x47\x62\xf0\xef\x8b\x4d\x08\x35\xb0\xc8\x4a\xf0\x87\x94\x08\x4d\x5f\xc8\x67\x1b\xff\xe8\x5d\x44\x1f\x8c\x41\xeb\xfc\xc1\x84\x0d\x1e\x0d\x45\xb7\xb4\x44\x5b\x23\x48\x75\xfd\x52\xc2\x25\x10\x68\x7e\xdd\xb3\x67\x5a\x90\xcc\xe6\xd0\x5e\xad\x5a\xa0\xbd\x6a\xbe\x3d\x23\x57\x28\xda\xab\xaf\x15\x68\xaf\x96\x6f\xcf\xc8\x5c\x8a\xf6\x9a\xf5\x02\xed\xd5\xf3\xed\x19\x79\x54\x6a\xef\x4f\xc2\x86\xd8\xe6\x2f\x6b\x95\x02\x2d\x36\xf2\x2d\x1a\x99\x1d\x5b\xb4\x33\xe2\xd8\x81\xe3\xa0\xdc\x30\x1e\xf7\x4a\xe3\x32\x11\x7d\x10\x1e\xe3\x50\xa1\x04\x5c\x1d\xf6\x5c\x38\x1a\xe5\x8b\x51\x72\xc1\x83\x33\xbc\x63\x2b\x85\x02\x03\x91\x05\x52\xf9\xd6\xbc\x14\x28\x89\x5a\x36\xa8\x28\xd8\xcc\xb1\x37\xf0\xd0\x99\x3d\xcc\xcc\xec\xe1\x83\xce\x6c\xc2\x17\x42\x9c\x23\x5f\x0c\x1f\x76\x66\xd7\xf3\xed\x3d\xf4\xcc\xce\xb6\xf7\xa0\x33\xbb\x9a\x6f\xef\x81\x67\xb6\x9f\x6f\xf1\x51\x66\xf6\xb0\xd0\xcc\x1e\x3a\xcd\x6c\x5c\x9a\x55\xa6\x60\xf9\xc1\x05\xbc\x63\x2b\x85\xfa\x00\x9d\xd9\x43\xa7\x99\x8d\x1a\x89\x0d\x2a\xea\x2d\x96\x38\x59\xdd\x4f\x18\x11\x2e\xdd\x04\x8f\x3b\x46\x3c\xa2\x68\xd8\x5b\x02\x01\xf7\xe9\xc1\xc1\x36\xe8\x00\x1d\x2b\x21\xf7\xd9\x71\xc3\x7d\x7a\x3e\xb0\xd3\x73\x22\xff\x3e\x3b\x55\xb8\x4f\xcf\xfa\x85\x50\x2b\x70\xa8\x55\x63\xb5\xf0\x48\x1f\x6a\x29\x2d\x87\x5a\x75\x56\x0b\x8f\x5e\xb6\xa0\x56\xdb\xa1\x56\xc5\x14\xa4\x6c\xdc\x13\xef\x45\xfd\xa0\x87\xe1\xb6\x73\x3f\x7f\xd0\x09\xc6\x81\xd8\xc6\xc0\x17\x8b\x46\x0d\x45\xf8\x11\x04\xf8\xec\xa0\xe7\x63\x24\xeb\xab\x68\x3c\x3a\x8f\xc7\xb8\xf8\xd1\xc6\xfa\x3b\xa4\x3a\x3d\x97\xf0\xdc\x5b\xb3\x34\x8b\xf5\x0f\xc3\xee\x98\x37\x2b\x6e\xbd\xa7\x75\x8d\xa4\xd8\xea\x74\xbc\x41\xd0\xe9\x18\x0f\x29\x8b\x8e\x5d\x24\x6d\xc1\x9e\xb3\xf1\x01\x42\x4b\x2b\x77\x6b\x15\x29\x90\x54\x94\xf8\x1b\x2c\x27\x26\xb5\x5e\x40\x25\x5b\xdc\xa4\xda\x0b\x94\xd2\x00\x7e\x7d\x09\xc0\xd6\x41\x12\xfa\x75\x87\x9d\x38\x07\x43\x69\x29\x76\xe1\xd2\xbb\xe9\x76\xe1\x39\xb8\x6c\x07\x9e\x01\x5c\x60\x07\x6e\x23\x4b\x32\x84\xa4\xd3\x82\x4e\x2b\xbd\xb0\x7f\x35\xbe\xa6\x51\xcc\xcf\xbd\xba\x6d\x6c\xc1\x26\xe6\x75\x61\x7e\xd0\xd4\x26\xd6\x08\xfe\x01\xb5\x2f\x95\xac\x01\x76\x8e\x13\x88\x19\xce\x12\x3b\x80\x85\x93\xc1\x68\x90\x32\x17\x3c\x8c\x29\xab\x51\xd8\x92\x65\x37\x48\xed\xa3\x25\x6a\xdf\x96\xdb\x40\x42\xdd\x5c\x68\x5f\x24\xee\xd9\x97\x2d\x4a\xfb\xb3\x9a\x92\xf6\x53\x36\xa4\x7d\x9d\xf1\xc8\xbc\x0c\x1a\x87\xe5\x94\xc6\xb7\x51\xbe\x6b\xe3\x2e\x3b\xec\xe0\xb0\xea\xab\xb1\x98\x38\x03\x8b\xe8\xd7\x80\x76\x2f\xee\xbb\x4b\x7f\x8c\x47\x84\x1a\x89\xcd\x18\xbf\xba\x05\x6e\xd2\xa2\x97\x39\xbe\xa6\x30\x2c\xf3\x86\x75\x12\x8b\xea\x7a\x99\x7f\xfd\x55\x15\x43\x08\x8f\xe8\x30\x51\x1b\xef\xca\xde\x7d\xd9\xfb\x43\xdb\x73\xd6\x74\xa9\x74\x87\x49\xaa\xee\xf1\xdf\x3f\x96\xd4\xf8\x2a\x07\x38\x69\xad\xea\xde\x1a\x34\xf7\x77\x68\x8e\x66\x22\xfc\x0f\xfd\x4a\xda\x55\xd1\xd8\xd2\x6c\xad\x58\xb3\xa4\xbd\xff\x40\xc3\x52\x4f\x0b\x36\x58\x2f\xdc\xcf\x3f\x58\x3f\xef\xf1\xdb\x7f\xb0\xa3\x53\x34\xdc\x28\x3a\x9c\xd0\xe0\x17\xb9\xc1\xa2\x4d\xa2\x2e\x4d\x1a\xed\x5b\x5b\xbc\x03\xe1\xd1\xc7\xe6\xe8\x29\xbb\x52\xad\x0a\x8b\xbe\xbe\x9f\xf9\xd7\xce\xc1\xcb\x3c\x76\xf9\x1a\xe7\xe9\x6c\xb1\xcb\x83\x60\x18\xdc\x78\x7f\x0a\x1f\xce\x17\x08\x4c\xeb\x5f\x7d\xf5\x58\xd8\x31\x46\xd9\xf3\xcf\xa4\x5d\x9c\xd5\xee\xe0\x19\x7d\x12\xf8\x5f\x11\x60\x41\x28\x90\xda\x24\x6a\xbb\x97\x9f\x36\x20\x9b\xc9\xaf\x24\x04\x99\x3b\xd2\x4a\xcf\x18\x09\x9e\x59\x82\xaf\xf5\x10\x6e\x39\x05\x8a\x44\x59\xe7\x1d\x79\x99\x08\x6b\x5e\x60\xc6\x70\x78\xce\x51\xfb\x47\x5b\xdb\x4f\x84\x9f\x0c\xa0\xe0\xfc\x33\x80\x19\x85\x84\x18\x63\x3c\x9f\x3c\x33\x47\x42\xcf\x9f\x22\x47\xde\x04\x6d\x99\x9f\xf6\xc9\xf7\x84\xa7\xe4\xa3\x01\x05\xd8\x2a\x05\x24\xcf\x5a\xe4\x57\x15\x7b\xc9\x30\xbe\xd2\x20\x3c\xa7\x30\x7e\x0e\x23\xc5\xa1\xf9\x60\x7e\x1e\xb7\x9f\x84\xe1\xaf\x2e\xc2\xf0\x17\x61\xf8\x4f\x3b\xbb\xc5\x93\x08\x04\xcd\xdc\x95\xa2\xc1\xd4\x2b\x7c\xdf\xc9\xd9\xfe\xd6\xb2\x3f\x43\x64\x0a\xa9\xef\x27\x41\x29\xec\xdb\x37\x88\x47\x81\x64\x68\x49\x4c\x0a\x5c\xde\xa0\x0b\xf8\xe2\x4f\x3a\x5e\x25\xec\xb6\x3b\x41\x6b\x6d\xdd\x72\x05\x01\x04\xb3\xb4\x82\x4e\xbb\x1b\xa6\x82\x59\x6c\x95\xda\xb5\x4e\x35\xf4\xbb\x8a\x33\xf0\xfc\x79\xc8\x18\x18\x5d\x9b\xb3\xc6\x2f\x18\x21\x17\xf5\xd8\xdb\x7d\xe9\x50\xac\x65\xf7\xa4\xe3\xa6\xd6\xee\x47\x47\x1b\xa5\xdd\x8b\x0e\xc5\xc2\xc4\x87\x6e\xec\xb2\x93\x17\x5d\xeb\x25\x77\x30\xc2\x14\xf4\x84\xfd\x46\xcd\x26\xe9\x54\xc1\x76\xef\x84\x7e\x11\xc8\x3e\x3c\xf5\x00\x69\x88\xec\x7b\x20\xa1\x3b\xfb\xb8\x96\x7c\xf4\xeb\xd2\xe7\xa6\xcd\x81\x23\xa1\x5d\xea\x63\x66\x39\x6a\x5c\xc4\xed\x55\xcd\x77\x48\x6b\x6e\xb7\x4e\xa1\xaf\xa1\x14\x00\xf4\x06\x42\x0f\xa8\x15\x69\x15\x73\xcd\x85\xe4\xbf\xdf\xec\x49\x47\xf8\x50\x54\x0b\xb8\xb4\x4a\xa5\x16\xd9\x0b\xb7\xe9\xfe\x14\x3e\x76\xb0\xc5\xca\x5d\x23\xa0\x81\x8d\x05\xfd\x85\x45\x9a\x6e\x91\x41\x68\x93\xff\x3a\xb4\xc5\x66\xd8\x59\x0f\x5b\x81\x2d\x55\x7e\xd6\x63\x38\x65\x67\x69\x5f\xe1\x53\x9b\xf5\x7a\x19\x62\x29\x2b\x61\xbd\x5e\xab\xd6\xdd\x70\x78\xf9\x5c\xa0\x01\x6e\xc4\xe7\x2f\xa7\xea\x3b\xb4\x5b\x6b\xac\x77\x6a\x61\xd5\xe4\xc0\xc0\x76\x6d\xbc\x04\x62\x41\x95\x72\x41\x7e\x40\xc2\xb4\x6d\x1e\x39\x0f\x71\x24\xec\x58\xab\x30\x72\x21\x3f\xda\x98\x1d\x64\xa1\xad\x13\x81\xdd\x8d\x67\x14\x69\x85\x1d\x79\xcc\x7b\xc5\x7d\x5e\x81\xbb\x17\x0f\x7d\x7f\xc6\xdc\xd3\xb4\x7c\x55\xf6\xfa\xb5\xdd\x3d\x77\xe8\xef\xeb\xb8\xfb\xec\xd0\xd3\x17\x9a\xca\x2f\x5c\x71\xf6\xfa\x3f\x84\x2b\x0e\xf6\xc5\x2b\xdd\x5e\x1c\x0f\x65\x37\xd8\x4b\xd4\x10\xd9\x33\xb7\x06\x31\xe3\x6b\xd2\x4a\x01\xdf\x59\xce\x75\xf6\xa8\x5e\xb3\x19\x3c\x23\x56\x17\xda\x8f\xee\x1f\xc9\xbe\x5e\x18\x99\x1f\xdd\xa4\x97\x31\x11\xc3\xc6\x78\x6a\xfb\x32\x56\x9e\xc6\xb4\x9c\xde\x8e\xa7\xad\xca\xf0\xdb\xc2\xa0\xec\x8a\xe0\xf7\x6f\x50\x46\x26\x9a\xc1\x96\xac\xe6\xa5\xc4\x8c\xcc\xf9\x49\xae\xe9\x98\x04\x06\xaa\x16\x36\x1c\xaf\xfd\x90\xd9\x9a\x47\xd7\x41\xb5\xd1\x7c\x0a\x46\x65\x4c\xab\xc3\xd0\x59\x18\x98\xbf\xb1\x81\x39\x6f\x34\x56\x95\x22\xf3\x0a\xd2\x75\xca\xd6\x59\x5d\x36\x47\x93\x79\xb8\x4a\xf4\xe9\x99\x0c\xc4\x00\x20\x85\x04\x7e\xa7\xd8\x3c\x39\x23\x71\xdb\xaf\x34\xd6\xc3\xce\x1a\xd8\x7b\x6b\xcd\xd5\x76\xa7\x51\x59\xc5\xcf\x95\xd5\x4a\xa7\xe3\xe3\xe7\xee\x6a\x25\x6c\xac\xd7\xec\xc6\xe3\x6e\xb7\x5d\xa9\xb4\x6a\x68\x71\x6e\xae\x35\xd6\xfc\x86\x4f\x3f\xd7\xbb\xeb\x6b\xdd\x00\x81\xb5\xc2\x6e\x50\x27\xff\x9f\xb7\xad\xb8\xf0\x86\x54\x52\x3d\xd8\xd8\x48\x50\x5c\x13\xd0\xe1\xca\x2c\x36\x21\xcb\x9b\x96\x2d\x87\x43\xec\xd3\x42\xeb\xfd\x9e\xb5\x5e\x32\xd7\x67\xd1\x7b\xa1\xfa\x94\x9a\x6f\x4a\xca\xe4\x74\x5f\xf2\xeb\x42\xfb\x75\x45\xf0\x2f\xa1\xfd\x02\x2b\xcd\xa6\xff\x2a\x39\x2a\xa5\x01\x33\xae\x92\x6b\xbb\xeb\xc0\xd5\xaa\x2d\x17\x62\x2a\xed\x21\xd3\xbd\x36\xbc\xd5\xf5\x44\x2b\x5e\x5f\x84\x53\x2c\xc2\x29\x6c\xda\xee\x22\xb7\x96\xb8\xc6\x22\x02\xe5\x46\xe4\xf5\xf3\x86\x31\xd9\x08\x42\x7a\x40\x39\xe9\x96\x86\x14\xfb\x9e\xe6\x52\x0b\xf8\xf1\x8d\x21\x12\x83\x3b\x8e\xc3\xa4\xa1\x7c\x29\xdb\xe6\x04\x1e\x51\x24\x1a\x9d\x0c\xa3\x9b\xb0\xa4\x0f\x61\xe5\x78\x8d\xfe\x77\x38\x7e\xcb\x2d\xd8\xf0\x85\xd4\x31\x98\x34\x85\xf3\x9a\xb2\x26\xa9\x58\xfd\x07\xff\xfc\xcb\x26\x85\xc6\x5f\x38\xfa\xb3\x7f\x2a\xf5\xbd\xbf\xb1\x2a\x4b\x4e\xee\x45\x26\x2a\xbb\x01\x99\x5b\x76\x1f\x9e\xe1\x27\xab\x1a\x3a\x1e\x4e\x0c\xc6\x57\xcb\x20\x5c\x85\xe3\xbd\x61\x80\x9f\x83\x1e\xd8\xeb\x2d\xc3\x21\xe2\x98\xfb\x70\x45\x47\x1f\xef\x1c\x84\x93\x2d\xb2\x13\xc1\xe4\x8c\xd2\x21\xc4\x9d\xf4\xba\xdb\xce\xf1\x77\xe4\x17\x4f\x7f\xd3\xc0\xed\x75\xd4\x0b\x09\x56\xb4\x9c\xf5\xec\x28\x7a\x6a\x05\x13\x3a\xf1\x81\x00\xed\x76\x0a\x76\xff\x82\x56\xc0\xcb\x14\x73\x94\x46\x76\x1e\xc4\xb7\xa5\x7e\xd9\xf3\xbd\x97\x5e\x55\x19\x92\x2d\x3f\x96\x93\xa3\x6f\x0a\x35\x57\xd3\x04\x9e\xcb\x0f\x85\xf7\xe2\xc5\xb4\xce\xd9\xbe\xb6\xaa\x6a\x87\x54\xd2\x60\xf4\xc0\x91\x64\x60\x6e\x98\xcd\x54\x90\xb7\x57\x3c\x5a\x3c\xd9\xfe\xca\xa8\x17\xb5\xc3\x52\x45\xcb\x4c\x8b\x58\x2d\xb9\xd8\x63\xc7\x6a\x99\x8a\x75\xb1\x58\xc3\x56\xec\x0a\x8b\x19\xa3\x96\x70\x87\x81\xc5\x56\x1f\x2e\x40\xac\x59\xff\x7e\x03\xc4\xae\x82\x9b\x9b\xa0\x72\x27\xc2\xc4\x7c\x23\xd5\xf3\x35\x31\x5a\x8b\x03\x81\xd3\x7a\x34\x68\x8b\xbf\x01\xff\x38\x44\x6e\xfd\x6e\x87\x09\x4f\x0a\x94\x5f\xcf\x81\xf2\xd7\x0a\xc0\x92\x2b\xd6\xec\x22\x3f\xd5\x2f\x3f\xa1\x88\x35\x71\x43\xba\x62\x42\x10\x9f\xf6\x42\x22\x88\x7f\xc7\xaf\x4b\x2e\x4a\x11\x06\xab\x96\x87\xb5\x5e\x94\x22\xbc\xa2\xe5\xce\x64\xfe\x30\x2e\x64\xa3\xfd\x82\x11\x05\x2f\x86\x65\x1d\x7e\xe1\x1c\x3f\xe8\x14\x00\xd8\xc6\x2b\xa6\x43\xef\xef\x5e\x17\x8f\x8c\xfd\x07\x3e\xaa\x2f\x42\xc8\xd4\xbc\x09\xf0\x3e\xa4\x80\x94\x6f\x61\xd5\x80\xc6\xb8\xfd\xee\xb1\x68\x37\x6b\x87\x51\xe9\x8e\xae\x38\x5f\x07\x52\xd8\x17\x0b\x43\xa4\xa7\xf4\xe8\x2f\x84\xf6\xc9\x2f\x64\x6c\xe4\x9f\x52\x95\xec\x0a\x8e\x68\x98\xf2\x4f\x88\x53\x89\x5e\xdb\x1b\x22\x8c\x26\x83\x4e\x7f\xf1\xa5\x5f\x7c\x5f\xfe\x69\x55\xfa\x85\xcc\x45\xb7\x2e\x8f\xa1\x55\x38\xac\xcd\x50\x78\x01\x83\xf0\x82\xa8\x55\x78\x0b\x93\x43\xbc\x25\x02\xa9\x12\x20\x8c\x78\x2f\x60\x2c\xac\x4d\xc3\x40\x5f\x59\x20\x83\x8c\x37\xdd\x84\x0e\x0f\x2c\x17\xb6\xbd\x06\xde\xc0\xd7\x21\x88\x8d\x7d\x97\x1c\x20\xae\x91\x81\xa6\xab\xcd\xe1\x71\x8d\x02\x2c\x8d\x81\xea\xe3\xaa\x0d\xb7\x45\x44\x60\xae\xbc\x7b\x44\x20\x2d\xdf\x60\xe5\xf1\x52\xee\xae\xbd\x7c\x93\x95\xc7\x5b\xb7\xaf\xec\xe5\x57\x59\x79\x14\x91\xd7\x8b\x08\xc5\x45\x84\xe2\x22\x42\x71\x11\xa1\xb8\x88\x50\x5c\xf8\x6a\xe7\xec\xab\x6d\x34\x67\xf2\xd5\x92\xea\xd3\xfa\x6a\x65\x33\x4f\xde\x57\xdb\x68\x2e\x7c\xb5\xae\x08\xfe\x35\x7c\xb5\x84\x95\x66\xf4\xd5\xaa\x38\x2a\xed\xab\xa5\x5c\x25\xd7\x2e\x70\xe2\x5d\x11\x4b\xe5\x10\xb5\xb8\x56\xf9\x21\xa3\x16\xef\x9a\xf5\xe5\xa7\xe2\xbd\x45\xdf\xb9\x40\x68\xe1\xcb\x7d\xf2\xbe\x5c\x32\x56\x88\x01\xf9\xab\x29\xf3\xbe\x59\x67\x17\x67\x63\xe9\x15\xcd\xd5\xc8\xd3\x5f\xbb\xa4\x21\xe0\xe9\xfe\xf1\xe5\xf1\xde\xde\xd9\xee\xf9\x99\xc9\x17\x7c\x72\x70\x79\xf0\x76\x67\xf7\xfd\xae\xb1\xd4\xe9\xf1\xbb\xb7\x3b\x97\x70\x4d\xd9\xf9\xd6\xdb\x04\xa0\x6e\xaa\xa2\xff\x78\x7b\x36\xff\xb1\x04\x69\x78\x1d\xf3\x3b\x08\x0c\x4e\x69\x8e\x2b\x98\x32\xfd\xb2\x77\x6f\x70\x20\x0a\xab\xf6\x98\x5a\xb5\xc7\x70\x76\xb6\x4e\xfe\x5a\xac\xda\x12\x4d\x2f\xee\xc8\xee\xa2\x41\x16\x8b\x7b\x9a\x0c\x6e\xcc\x73\xff\xe1\xa7\xea\x12\xfa\xf9\xc8\xde\xa9\x69\xde\x33\xe0\xc6\x2f\xbc\x7d\x4f\x60\xdc\x93\xd2\x0d\xdb\x1e\x31\xbc\xfd\x37\x34\x57\x25\x0d\x01\x02\x35\x40\x60\xc9\x52\xf1\x8e\x7a\x90\xde\x1b\x8a\xdc\xd3\x22\xff\x2e\xea\xce\x95\x06\x69\x10\x11\x95\xa5\x13\xde\xd9\x86\x48\xd0\xfe\x8e\xd2\xfe\x8e\xa6\xfd\xbb\xb3\x90\x5e\x54\xa3\xe3\x4a\xfe\x60\xb5\x7b\x07\x3f\x44\xc2\xe2\xe9\x41\xbb\x27\x5f\x4a\x0a\x52\xc2\x28\x9a\xe8\xa9\xbf\x23\xd8\xce\xc9\xe9\x10\x0e\x3d\x13\x1f\xee\x9d\x9d\x42\x47\xef\x2a\xba\x53\xd1\x2a\xcf\x4c\xd5\xc1\x33\x03\x55\x10\x0d\x3e\x3f\x8f\x46\xb7\x86\xa9\xa2\xac\x72\x28\xaa\x38\x0c\xd8\x47\x8a\xdf\x47\x82\xdf\x2a\xf9\xe3\xe8\x38\x42\x02\x60\x1a\x45\x7b\xb2\x7e\x8e\x63\x2b\x1a\x9f\xc4\x23\xbc\x3b\x13\x26\x89\x0f\x56\x80\x8f\x70\xcc\xda\x76\xb2\x9c\x37\x2a\x03\x70\x4e\xb9\x0d\x4f\x8e\x3a\xbf\x13\x19\x04\xcd\x4b\x10\x1d\x70\x48\x9f\x2e\x97\xb1\xf9\xe7\x26\xa2\xe3\x76\xd0\x3c\x87\xd1\x91\x84\x51\x0a\xec\xb2\xc5\xb6\x23\x10\x33\x17\xb1\x3a\x3a\xa4\x29\xd0\x87\x7b\xa1\x61\x74\x0b\xf0\x80\x63\xaa\x6e\xd2\x0a\xc4\x31\x90\xae\x7d\x26\xf2\x28\xee\xdd\xf7\xe3\x9b\x88\x68\x42\xf1\x67\xb2\xa0\xbf\xde\x2b\x55\x97\x36\xbc\xbb\xdf\xd7\xc8\x64\xbf\xfb\xbd\x89\xff\x36\xf0\xdf\x3a\xc8\x6e\x3b\x78\x36\x27\x29\x5a\x34\x1b\xc4\xef\x90\x39\xc0\x31\x6f\x81\x43\x07\x18\xe4\x4d\x2b\xc3\x4e\x1b\x4a\x94\x59\xc7\xa9\xaf\x8b\x69\x28\xfc\xee\xa4\x2c\xe7\x94\x73\xdc\xad\x8d\x38\x50\x58\x58\xdc\x63\x3d\x46\x28\x32\xc6\xe1\x0d\x51\xdf\xe1\x12\x5d\xb4\xe4\x6b\x94\x9b\x73\x9d\xb2\xe2\x14\x99\xa6\x92\x9c\x0e\x99\x67\xcf\x95\xe4\x9a\x0b\x31\x0c\x51\x2b\xb5\xd9\x62\x56\x6a\xa9\x88\x95\x9a\x6b\xbc\x8a\x1a\x23\x8a\x15\xe1\x84\x6e\x74\x35\x19\xd2\xc8\xc4\x78\x80\x77\x15\xab\xd0\xc2\xe2\x5a\x30\xff\x3d\x18\xc6\x64\xb7\x3b\xbe\xf7\xfe\xec\x4f\x6e\x5a\xe1\xf0\xab\x17\x4f\xc6\x44\x46\x1c\xa2\xb9\x55\x5b\xcf\x43\x93\x41\x27\x1c\x91\xfd\x5b\xc7\xa3\x55\xe1\xde\x64\x22\xd7\x46\xfc\x52\x64\x0a\x48\x6b\xdf\xe0\x80\x8e\xfb\x3d\xce\x67\xd2\x35\xd5\xc1\x30\xdc\xf0\xaa\x70\xdd\x5a\x15\x2e\x68\xaf\xad\xc1\xed\x81\x7e\xd5\x04\x68\x27\xec\x06\x93\xde\x78\x03\xca\xe9\x8a\x29\xc6\x08\x9e\x76\xf7\x6a\x43\x58\x4b\xbb\x57\x96\x71\x81\x47\xa6\x92\xa1\xc5\xaf\x4b\x26\x7f\x4b\xb1\x68\x24\xf4\xd7\x8e\xc1\xc9\xc6\x2d\xb3\xfc\xdb\xc5\x07\x07\x15\xa0\xe0\x44\x83\x07\xe1\xd3\x09\x07\xd1\x4f\x7c\xd2\x61\xec\xd3\xd4\x39\x90\x11\x75\x34\xcc\x9f\x45\x7f\xa0\x8b\xd4\x6f\x56\x2a\x10\xf4\x40\x46\x11\x7f\x84\x11\x90\xe9\x0b\x7a\x7c\x4d\x17\xac\xf8\x6d\x22\xac\x2c\xde\x2d\xc5\xb0\x58\x1d\x4d\x9c\x02\x87\x41\x1f\x5d\x1d\x19\xba\xbc\xc4\xf0\x4c\x23\x72\x5b\xad\x51\x3c\x6c\x15\x1b\xfa\x4c\xbb\x0f\x71\x69\xdd\x51\x55\xbe\x75\x0e\x36\x62\x64\x60\x9d\xdc\xf9\xa4\xa6\xaf\xa8\x0a\x9a\x81\x73\xde\x70\x6b\xca\x73\x78\x28\x8a\x8e\x37\xd7\x55\xe5\x2b\xeb\xaa\xb3\xde\x55\x57\x4d\x5d\x52\x57\x9d\xfb\xed\x74\xac\x7b\x7e\x91\xfe\xf9\xa9\x0e\xfa\x33\xf7\xd0\x4f\x77\xd1\x9f\xb6\x8f\xd6\x01\xa7\x33\x40\x58\xd3\xa3\xfe\x38\xa6\x73\xd1\xce\x6b\xbd\x00\x5d\x64\x5c\xc4\x59\x28\x0a\xa5\x57\xae\xa3\xab\x6b\x50\xe1\xa1\x4b\x2e\xe5\x7b\xf1\x2d\x2b\x3e\x43\x74\xc5\x29\xe8\x7d\x86\x59\x27\x26\x39\xdd\xd6\xe2\x44\xa7\x1f\xe9\x56\x14\x3f\xbb\xcd\x71\xb2\xa4\x8f\x03\x73\xa9\x29\x0d\x07\x52\x23\x47\x11\x58\x27\x7a\x93\x9b\x3e\x12\xc9\x22\x4f\xe0\x41\x1b\x11\xdb\x20\x97\x3d\x69\xdf\x6b\xab\x38\x83\xb9\x42\x6e\x3b\xc5\x29\x89\xf1\xc2\x01\x01\x78\xf8\xbe\x4f\x70\x90\x6b\xbd\x43\xa9\x1e\xe1\x24\xb7\x0d\xa2\xd3\x10\x9c\x5b\xd5\xfb\xec\x83\xea\x3e\x0c\xf9\xf9\xc5\x9d\x4b\xc7\xcf\xef\xe8\x64\xd9\xc4\xee\xbb\x55\x80\xd9\xb2\x89\xdd\x9e\x7e\xdf\x05\xcf\xec\x2c\xea\xb8\xd6\xc1\x43\x09\x53\x47\xca\x94\x80\x37\xea\x68\xbd\x72\x0d\xdf\x3c\xbf\xf3\x93\xaa\x7e\xd1\xaa\x74\x4e\x90\x0f\xae\x7c\xc5\xea\x1d\x8a\x7a\x94\xaf\x9c\xe7\xed\x68\x32\x44\x71\x02\x91\xe1\x74\x0e\x17\x9b\xbe\x84\x52\x4c\x88\x92\x85\x82\x75\x40\x64\x74\x64\x88\xb1\xb4\x8e\x2e\x06\x11\x84\x7c\x28\x20\xa3\xb8\xa5\x80\x0f\x33\x80\x8f\x8a\x01\x7e\x12\x82\x23\xb5\xe4\x38\x4e\x23\x51\x8f\x2d\x3d\x0e\xb3\x09\x9e\x39\x58\x96\x4e\xaf\x63\xef\x24\x72\x9c\x99\x80\xe2\x01\xda\xa9\xc1\xca\x22\x7d\xa5\x5b\x13\xf1\xfd\xc1\xa6\x6c\x6a\x64\x44\x73\xae\x53\x0f\x2a\x50\x86\x2e\x22\xd4\x79\xcd\xc3\xa4\xa6\x9b\x58\xc7\xc5\xfd\x3a\x3e\xa6\x3a\xf1\x66\xca\x01\x92\xc2\xdd\x89\x52\xa7\x31\xee\x55\x1c\xd7\x5e\xb0\x00\x26\x6d\x17\x32\xc7\x4a\xf3\xbe\xc4\x29\x46\xe6\xa4\x80\x86\x73\x93\x13\x24\xb9\x2b\x23\xf9\xdd\x65\xa6\x8a\x86\x0e\x93\x86\x0e\x75\x0d\x1d\xcd\xd0\x50\xda\x2a\x9c\x90\xa4\xb0\x4d\x38\x4b\x16\x86\xad\x04\x12\xed\xc1\x79\xa4\x9b\xf5\xb9\x50\xe7\xc8\xd2\xde\xe1\x0c\xed\x39\x2b\x20\xc3\xa0\x3f\x1a\xc4\x23\x67\x36\xc4\x85\xeb\x24\x3a\xa4\xd3\xf6\xfc\x42\xf2\x26\x25\x33\xc0\x49\x31\xa1\x40\x8a\x6a\x27\xac\x56\x11\x15\xc5\x49\x62\x0e\x22\x2f\x18\xa3\xa2\x82\x2b\x8d\x7d\xd7\x72\x5e\xc1\xfe\x5b\x2f\x2e\x15\x46\x89\x8a\x10\x73\xd6\x3a\xe7\x15\x4e\x16\x5a\xd3\x45\xb0\x91\x3a\x94\x28\xac\x8a\x8b\x42\x01\x7e\x88\x6b\xd7\xa5\x62\x3a\x25\x6e\x0e\x0b\x78\xb1\x45\x05\x9e\xec\xca\x26\x56\xfc\x02\x33\x75\xfa\x85\x89\x43\x38\x17\x93\x64\xba\xea\x44\x79\xe2\x00\x4a\x92\x5a\xba\x54\x54\x7d\xa1\xc0\xaa\x19\x60\xd5\x3c\x30\xe7\xe1\x00\x0d\x14\xa2\x69\x8a\xaa\x4f\x9b\x94\x26\x42\xf3\xfc\x0f\xeb\x23\x7d\xf1\x77\x8e\x25\x7e\x75\x95\xaa\xbd\x44\x1c\x9c\x0b\xd1\x20\xc3\x86\xef\x09\x68\xf2\xed\x1b\x79\xf8\x0e\x62\xdb\x16\x3e\xc7\x66\x4e\xc2\x24\xe5\x7e\x02\x25\x24\xe3\xc4\xc2\xdf\x0b\x59\x51\x52\x10\x5d\x64\x8d\xac\xd8\xa6\x2b\xdb\xf5\xa8\x29\x22\x09\xbe\xf7\x83\x12\xa6\x4a\x0f\x7d\x4c\xc2\x54\x57\x98\xb8\x01\x46\xde\xee\xfd\x9c\x9a\xfa\xcd\x86\xef\x79\x1c\xb4\xf0\x67\x3d\x67\x81\x27\x28\xda\x61\xd4\x4b\x1f\x80\xf0\xc1\x5d\x91\xea\x24\xc4\xb2\x64\x5e\x20\x22\x18\x15\x21\x8e\x7d\x58\x5a\x7d\xba\xa7\x22\x1e\xcc\x47\x22\xfb\x80\x78\xd7\x95\x0e\x22\x42\x70\x1b\xd7\xc9\xc5\xb9\xc7\x25\x0f\xff\xa5\xfd\x90\xcf\xd9\xff\x4e\xc2\xf0\x0f\x83\x81\x99\xc7\xbe\xf3\x49\xa9\x74\x91\xf3\x47\xe5\xa2\xc9\xa1\xfa\x10\x4e\x9a\x62\xe6\xef\xe9\x76\xbd\xda\x1d\xef\xfc\x1c\x39\x09\x52\xff\x7f\x7b\x5f\xdb\xe4\xb6\x8d\x24\xfc\xfd\xaa\xee\x3f\x70\xfd\x54\x79\xe4\xf5\x78\x4c\xf0\x15\xf4\xc6\xbb\x97\x4c\xec\x73\xce\xce\x26\x65\x27\x4f\x52\xe5\x72\x12\xbe\x80\x96\x36\x1a\x69\x4e\xd2\x64\xec\x4b\x7c\x75\x7f\xe3\xfe\xde\xf3\x4b\x9e\x6e\x00\x24\x41\x8a\x24\x9a\x9a\x99\x38\xd9\x78\xb6\x36\x96\xa8\x46\x77\xa3\xdf\xd0\x00\x01\x34\xed\x65\x87\x31\x03\xe2\x7b\x53\xac\x83\x5f\x79\x18\x58\xf5\x5b\x0f\x13\xed\x35\xbf\xdc\x69\x24\x4a\xef\xf2\xb3\x6e\x97\x9f\x5d\x47\x97\x9f\xed\x75\xf9\xd9\xe1\x5d\xb6\xdb\x84\x72\x3c\x1d\x47\x76\x6b\xdc\x70\xbf\x59\x88\x9f\x84\xe5\x00\x16\xfe\xd5\xfe\x78\x72\x7e\xb1\x9d\x57\xac\xda\x24\xdd\xd3\xea\xf3\xf1\x56\x37\x58\xfc\xb7\xe7\xfa\x91\x9a\xbf\xe3\xfd\x50\x36\xfd\x42\x92\x9b\x38\x66\x46\x1b\x11\xf4\xa1\xb3\x9e\x01\xa2\xbe\x5f\x65\x62\x08\x3d\x60\x83\x43\xf5\xd1\x38\xe9\x36\xd4\x8a\x72\x51\xd4\x87\x43\x70\xff\xb4\x87\xe0\xfc\xab\x1c\x81\xf3\x0f\x3d\x00\x67\xec\x1a\xdb\x3b\xfe\x36\x70\xd3\xc8\x87\xc3\x6f\xfb\x58\xff\x29\x0e\xbf\xf9\x57\x3c\xfa\xd6\x63\x4b\xad\x83\x6f\xfe\xd5\x8e\xbd\xf9\x2d\x6b\xb4\x5c\x52\x5a\x1f\xb4\x7a\xe0\xf0\xa0\x39\x06\xc7\xfe\xe0\xc7\xe0\xba\x77\xfa\x87\xcc\xfb\xed\x9d\x8d\xab\x6e\x99\x45\xe6\x7e\xf7\xe7\xe4\x6e\xf2\x8c\xdc\xb5\x9e\x50\xd3\xa0\xcd\x89\xba\x0a\xfe\x5a\xea\x01\x80\x2e\x5b\xbb\x95\xe1\xfb\xf4\xed\xd2\xfc\x8a\xf5\x00\x10\x41\x6b\xcb\x34\xd7\xf7\x2a\xe3\x16\xe0\x9b\xbe\xe4\xcf\x94\x2f\xa9\x24\xc0\xde\xde\x58\xf7\x4d\x9e\x65\x59\x52\x84\xc5\xb1\x51\x2f\x00\x7c\xba\x07\x32\xf2\x92\xd4\x4b\xbc\xd4\xac\x26\x70\xc7\x52\x36\xa0\x07\x4d\xc2\xc2\xc4\x65\x61\x6a\x16\x22\xe8\x27\xc8\x42\xaf\x14\xb9\x2a\x5f\x50\x95\x29\x38\x80\x60\x14\xfb\xbe\x17\x45\xaa\xda\x81\x2e\x62\xd0\x4f\x90\x8b\x2c\x08\x52\x1e\x9b\x25\x0e\x0e\x20\x58\x64\x6e\xee\x09\xb7\x30\xab\x23\xf4\x13\x0c\xe2\x2c\x0c\x38\x2b\xcc\xda\x09\x23\x33\xd0\xf7\x52\x3c\x01\x0d\xf9\x3a\x8a\x27\xb0\xfe\x6a\x1d\xd5\xdf\x87\xea\x09\xe3\xe8\x7f\xff\x93\x11\x7e\xa5\xea\x09\xd8\xfc\xd0\x09\x89\x19\x93\xf7\xa7\x24\xfc\x43\xf5\x84\x3f\xd8\xa4\x84\x5f\xb5\x7a\x42\xaf\x45\xb5\x27\x26\xfc\x0a\xd5\x13\xa0\xf1\x94\x89\x89\xce\x6b\x61\x5a\xe2\x8d\x4d\x54\xbc\x3f\xf8\x44\xe5\xb7\x36\x27\xf9\x30\x0f\xb9\xd2\x5d\x1d\xef\xf1\xe6\x8d\x6b\x9a\xd7\xf4\x68\xae\x92\xad\xa6\xf0\x7d\x75\x6a\x74\x28\x5d\xd3\xc1\xa3\x7d\xc8\xf4\x24\x3d\x3f\x5f\xbe\x9d\xe9\x87\xc7\x4e\xba\x79\x7d\x71\x26\x56\xbb\xde\xa5\xf6\xde\x25\x62\xf3\xda\x90\xfe\xde\xc8\xa2\x10\xfd\x3c\x75\x78\x87\x2c\xd7\xe3\xa9\x07\x69\x30\x66\xb9\x45\x0c\x5f\x84\xe7\x81\x93\xee\xc1\xc5\xcc\x8f\x83\x20\x91\x55\xc5\x3c\x5f\x94\x51\x98\x17\x43\xe9\xf7\x5e\xe3\x2c\xcc\xdd\x32\xcb\x4b\x6c\x2c\xf2\xa0\xf0\x33\xaf\xec\x23\x22\x92\x2c\x2c\xb2\x34\x44\x38\xce\x78\x52\x64\x59\x4e\x26\xe2\x27\x61\x94\x7b\x61\x26\xa7\x12\x7e\xc0\xb3\xd0\xe7\x7d\x44\xc2\xa4\x64\x8c\x95\xb2\x27\x59\xe4\x86\x85\xcb\x06\x67\x2e\x7b\x8d\x13\xcf\x2f\xb9\x97\x06\xd8\x38\x2d\x59\x02\x33\x88\xac\x8f\x48\x9a\xb1\x3c\x14\x85\xec\x49\x91\x46\x05\x67\x8c\x93\x89\x14\xdc\x8d\xd3\x54\xe9\x24\xf5\x5d\xdf\xf5\x82\x5e\x9d\x30\x8f\xfb\x61\xe6\xca\x9e\x04\x61\xec\x46\x65\x26\xc8\x44\xbc\xc0\x67\x3c\xcc\x84\x6c\x2c\x44\x90\x79\x3c\xef\x15\x57\xe8\xe6\x71\x91\xfb\xb2\x27\x61\x59\x66\x81\xf0\xc8\x44\x62\x2f\x13\x61\x11\x4b\x71\x95\x5e\x9c\xf1\x24\xea\x55\x3c\x77\x0b\x91\xb1\x52\x32\xe3\x67\x2c\x4a\xa2\x6c\x70\x72\xb7\xaf\x93\xac\xc8\xdd\x48\x15\xb9\xf3\xc2\x3c\x66\x9e\x1f\xf6\x11\xc9\x59\x92\x95\x4c\x31\x93\x97\x51\xe2\x45\x49\x40\x26\x22\x82\x24\x8b\x92\x5c\xca\x3a\x11\x25\x0b\xd2\xa2\x57\x27\x02\x74\x10\xc4\x3c\x92\x3d\xe1\x41\xe9\x85\xc2\x27\x13\x71\x4b\xe0\xb1\xc8\x65\x63\x9e\x71\x98\xc5\x67\xbd\x3d\xf1\x02\x37\x4f\x59\x9e\x23\x5c\x1c\xa7\x79\x92\x47\x21\x5d\xf1\x85\x48\xbc\x3c\x92\xce\x18\x26\x5e\xe6\x7a\x71\x2f\x91\x20\x8d\x03\x1e\xa4\x72\x2d\x20\x12\x69\x24\x02\x4e\xef\x49\x98\x67\x6e\x0a\x5d\x91\x4e\x56\x04\xac\x84\xff\xf4\x86\x95\x08\x62\x0f\x2f\x24\x11\xee\x33\x16\xfa\x19\xbd\x27\x09\xf7\x45\xc8\x42\x4f\x86\x15\x11\x45\x45\x99\xf6\x3b\x23\x60\xce\xe1\x67\x69\x20\x45\x16\xf8\x1e\x73\xe9\xb1\xcb\x75\x7d\x2f\xce\xa5\x33\x26\xbc\xcc\x3c\xe6\xf7\x9a\x70\x56\x86\x49\x5c\xe6\xba\xd4\xa2\x28\x5d\xf0\x29\x32\x91\x3c\x12\xae\x9b\x95\xd2\xc9\xfc\x22\xe5\xbc\xcc\x7b\xad\xab\x08\xd3\x38\x61\x81\x24\x92\xf8\x6e\x9a\xc6\x1e\x5d\x5c\x6e\x94\xa7\x91\x1f\x4a\x13\x06\x72\x3e\xf7\xfa\x9d\x91\x05\x5e\xe2\x25\x6a\x4d\xc6\x4d\x5d\x11\x89\x98\x2e\x2e\x70\xf2\xd8\x4d\xb9\x0c\x7c\x41\x54\x78\x5e\x59\xf6\x86\x15\x4f\x30\x14\xa5\x14\x6b\x98\x7b\x51\x0e\xee\x48\x26\x12\x14\x60\xc0\x45\x29\xad\x2b\x4c\xf3\xc0\x4b\x45\xd1\x1b\xbb\x7c\x1f\x22\x0b\x93\x62\x4d\x8a\x24\xcc\xfc\xa2\x24\x13\x89\x42\x37\x8d\xfd\x30\x50\xce\x98\x96\x91\x5f\x88\x7e\x13\x8e\x52\x37\xcd\xe4\xb8\xe3\xe7\x71\x9c\x79\x29\x3d\xd4\x73\x96\x7b\xd0\x75\x15\x85\x63\x51\xa4\x60\xc7\x7d\x44\x12\x2f\xf6\xbc\x5c\x89\x95\x05\x1c\x22\x9c\x9f\x91\x89\xa4\x5e\x56\x0a\x9e\xaa\x71\x22\x2f\x99\xeb\x47\xbd\xce\x98\x72\x96\x46\x51\x20\x7b\x92\x81\x54\x7d\xd7\xa5\x47\xe1\xdc\x0b\x32\x0e\xba\x97\xe3\x84\x5b\xf2\x04\x0c\xb5\x37\x0a\xc7\x51\x1e\xb2\x54\xea\xc4\x8d\xc2\x20\x13\x3e\xdd\xba\x0a\x06\xb2\xe2\x2c\x91\x44\x22\x51\x86\x1e\xeb\x1d\xe3\x8b\x28\x49\xdc\xc8\x93\xba\x0b\xc3\x28\x4c\x93\x09\x1e\x5f\x06\xae\xf0\x43\x25\xeb\x30\x86\xe1\xc4\xf5\xd2\x5e\x3f\x81\x41\x27\x75\x55\x8f\x7d\x2f\xcb\x0a\x96\xd1\x15\xcf\x92\x34\xc8\x19\x93\xa1\x3e\xe3\x85\x57\xb8\x79\x6f\x4f\x98\xf0\x41\x60\xae\xf2\x13\x16\xb0\x14\xd2\x9a\x09\xce\x18\xf0\x38\x0e\xa4\x9f\x14\x25\x17\x22\x4b\x92\x3e\x22\x7e\x90\xb9\x59\x9e\xc9\x1e\x0b\x18\x25\x03\x3e\xc1\x84\xfd\x84\xe5\x6e\x9e\x49\x85\xe6\x61\x9e\x84\x10\x64\x7a\xc7\x13\x51\xf0\x34\x0d\x64\xa8\x17\x7e\xc0\x78\x9a\xd3\x4d\x38\xcc\x92\x3c\x4f\x83\x52\x8d\x78\x91\x0f\x52\xe9\x25\x12\x71\x4f\x44\xa5\x0a\xa4\x45\x94\x79\x19\x4f\xe9\xe2\x02\x69\x41\x72\x27\xa4\x33\x86\x30\x3f\x84\x2c\xb5\x37\x76\xc5\x3c\x0d\x23\x5f\x8d\xa0\x90\x45\xc5\x5e\x19\xd1\xad\x8b\x07\x39\x8f\x39\x53\x19\x24\x2b\x21\x72\xc4\xbd\xa1\x9e\xe7\x79\xec\x7a\x4a\xf1\xe0\x96\x81\x9f\x08\x7a\x2e\x9c\xb8\x99\x28\xcb\x32\x55\xd9\x7a\xe4\x33\xe1\xf5\x5a\x57\x1a\x84\x30\x2a\x08\xe9\xf1\x85\xe0\x5e\x06\xb9\x01\x7d\x64\x14\x65\x92\xfa\xa5\x1a\xf1\x20\x20\x83\xc3\xf7\xe7\x5d\x51\xcc\x62\x5e\xaa\x61\xda\x87\x11\x0b\x9c\x85\x1e\x56\x52\x2f\xf6\x45\x2e\x75\x22\x52\x2f\x8a\x58\xd2\xab\x93\x82\xf1\x08\x32\x26\x95\x04\xa2\x41\x7a\xc3\x2f\x40\xf6\x93\xb6\xb4\x48\xe3\xa2\x90\xce\x98\x17\xc2\x85\x5c\xb4\x37\xd4\x97\x61\x5c\x04\x65\x5c\xea\x84\x43\x14\xd0\xaf\x09\xc3\x6f\xe9\x46\xb1\xca\xa7\x62\x8f\x41\xe6\x93\xf5\x86\x15\x17\xfc\x07\x12\x6e\xe9\x8c\x29\xc4\xfc\x84\xa7\xf4\x91\x91\x41\x76\x92\x70\x37\xd0\x2f\x2d\x12\x17\x46\x94\xde\xb0\x92\xc5\xcc\xcd\x7c\x35\x9e\x40\x76\x14\xc4\x8c\xae\x13\x8f\x17\x59\x1c\x97\xa1\xb2\x2e\x37\x88\x0b\xde\x3b\x9e\xf8\x5e\x9e\x82\x79\x4b\xeb\x82\x8c\x35\xf6\x82\x84\xee\x8c\x7e\x9e\x88\x4c\xb8\x52\x5c\x0c\xc2\x4a\x26\xb2\x5e\xc5\x83\x03\x16\x51\x9c\xcb\x1e\x43\x7e\xee\xba\x45\x40\xf7\x93\x20\xcf\xc3\x22\x50\x93\x20\x08\x61\x22\xf0\xb2\xde\xe1\x17\x53\x3b\x2f\x49\x64\x20\x2d\x21\xd9\x8e\x05\x0e\x09\xd4\xd8\x55\xe6\x59\x04\xa9\xa9\x1c\x27\xd2\x02\x3e\x8a\xde\x9e\x44\x79\x10\xb0\x84\x4b\x22\x41\x1a\xc4\x21\x67\x71\xcf\x0a\xd2\xe0\x6d\x9d\xdd\x4b\xb8\xfa\x17\x07\xea\x8a\x6b\xdd\x1f\x0f\xbd\x6f\x8b\xbb\xf6\x5d\x72\xdf\xb4\xee\xdb\xaa\x57\x4e\x26\xbc\x4c\x3a\xe4\xbe\x2d\x7c\x09\x7d\xa5\x17\xc8\x3d\x6f\xb1\xa9\xb7\x6e\xfd\x36\x5e\x20\x43\x66\x9a\x88\xea\xf5\xaa\x9f\xe5\x79\xe2\x0e\xbc\x40\xce\x32\x88\x4d\x42\x25\x3d\x30\x44\xc1\xcc\x62\x38\xc1\x1c\x21\xe8\xc3\x4c\xa6\x84\x18\x2f\x09\x8a\x24\x80\xf1\x34\xeb\x27\x98\x86\x41\x59\x86\xbe\xf4\xef\xb0\x64\x05\x8c\xa7\x87\xbc\xcf\x0d\x99\x2b\x42\x4f\x85\xe4\xb4\x10\x30\xfe\x17\x03\x2f\x90\x93\xcc\x0d\x23\xae\xdc\xcb\x03\x5f\x8f\x72\x36\x38\x19\x18\x21\xc8\x4a\xee\x17\x89\xf2\xe6\x32\x0b\x58\x06\x69\x46\x3f\xc1\x10\xc2\x57\x5e\xa8\x64\x95\xf9\x10\x32\x58\x9c\x5c\xfb\x0b\xe4\x5f\xeb\x6e\xaa\xc9\xe5\xff\x64\x23\x3c\x37\x4a\xa8\xe8\xf7\x84\x39\xa4\x92\x7e\x4f\xa4\xfb\xd9\x6b\xfa\x3d\x91\x5b\xd3\xec\x45\xfd\x9e\x04\x0e\xa9\xaa\xdf\x93\xd0\x21\x95\xf5\x7b\x22\xef\x82\xb7\xd7\xf5\x7b\x12\x3b\x84\xc2\x7e\x4a\x82\xb2\x06\xa0\xf5\x14\xad\x82\x5d\x2a\xd8\xf1\x83\x6c\x4a\xe0\x12\xad\xf5\xca\x0f\x05\xbb\x54\xb0\x04\xb4\x9e\x44\xeb\x91\xd0\x7a\x4b\x05\x4b\x40\xeb\x4b\xb4\x3e\x09\xad\xbf\x54\xb0\x04\xb4\x81\x44\x1b\x90\xd0\x06\x4b\x05\x4b\x40\x1b\x4a\xb4\x21\x09\x6d\xb8\x54\xb0\x04\xb4\x91\x44\x1b\x91\xd0\x46\x4b\x05\x4b\x40\x1b\x4b\xb4\x31\x09\x6d\xbc\x54\xb0\xb6\xf3\x37\x07\x94\xfe\x54\x66\x6e\xe3\x20\x55\x16\x3e\x56\xfb\x47\x1e\x0e\x54\xd6\x6d\xc3\x96\x29\xc3\xb6\x61\xcb\x95\x51\xdb\xb0\xe5\xca\x9e\x6d\xd8\x0a\x65\xcb\x36\x6c\x85\x32\x63\x1b\x36\xa1\x4c\xd8\x86\x4d\x28\xeb\xb5\x61\x2b\x95\xe5\xda\xb0\x95\xca\x68\x6d\xd8\x5e\x2b\x83\xb5\x61\x7b\xad\x6c\xd5\x86\x6d\xae\xec\xd4\x86\x6d\xae\x4c\x74\x69\xb3\x4f\xf2\x85\x68\x13\x33\x5d\x8d\xde\x5e\x53\xb7\x62\xf8\x1b\x24\xa0\x0b\x15\x5a\xd1\x3e\x92\x39\x67\xb5\xcb\x64\x1c\x7c\x62\xcd\x56\xc5\x0b\x0a\xf9\x9b\x45\x75\xe2\xdd\x2c\xe0\x0a\x39\xb5\x47\x29\xe2\xda\xe0\x5a\x2a\x5c\xea\x7c\x7b\x17\x95\xbc\x8d\xf1\x5a\x6b\xc2\xe2\xeb\x77\x59\xea\x93\xc6\xdf\x55\xcb\xc7\xbe\x91\x35\x20\xd5\xc7\x29\x97\xf7\xe8\x26\x4b\xa3\x35\xfd\x02\x1f\xd5\x42\x96\x19\x9d\xd5\x6c\xc8\x52\x9a\x66\xc1\xd9\xa5\x2c\xfe\x59\x15\xd7\x6c\xc1\xf1\x3d\x38\x79\x76\xef\x3b\xa7\x0d\x16\x53\x2f\xce\x52\xad\x96\x26\x43\xcb\x7d\x86\xe6\x7d\x0c\x2d\xf7\x19\x9a\x9b\x0c\xb5\xe1\xe2\x7d\x38\x4a\x8d\x50\xfc\xab\x0c\x83\x70\x2f\x78\xdd\xa5\xc3\xab\xe8\x36\x76\xc1\xa6\xdb\x05\x6b\xec\x82\x4d\xb6\x0b\x66\xd8\x05\xab\xec\x22\x31\xeb\xee\x2e\x75\x29\x5e\x43\xbe\x4c\x2b\xa7\x0d\x26\x0f\x75\x26\x86\x61\x54\xf8\xf6\x4a\x19\x8d\x73\xb4\x34\x39\x5a\xf6\x70\x34\xef\xe3\x68\xb9\xc7\xd1\xbc\xc5\x51\x1b\x61\xb4\x87\xcf\x8b\xe8\x96\x31\xa9\x48\x30\x35\xf2\xc5\xb5\xf1\xc4\x54\xe3\x81\x46\x2a\xf6\x5a\x33\xb1\x4e\xab\xa5\x6e\x45\xbd\x84\x4f\x35\x63\x51\x13\xf8\xac\xc5\x8f\xcd\x66\x8a\x49\x66\x4d\x43\xbb\xed\x96\x55\xbb\x69\x7c\x36\x61\x72\x89\x8a\x58\xc4\x63\x69\x42\xbb\x69\x13\x9f\xe7\xaa\xe9\x5c\x16\x09\x41\x9c\x68\x36\xee\x1d\x18\x1b\x67\x15\x6e\xfd\xe4\x6f\x0e\x73\x1e\x38\xa3\x07\x45\xf7\xf9\xc3\xff\x56\xd6\x32\x8d\x3d\xfc\xef\xdd\xda\x79\x07\xb8\x63\xd7\xc1\x9d\x54\xc1\x01\xbc\x29\x95\xef\x73\xa6\x54\xba\xcf\x97\x9d\x42\x93\x5d\x00\x05\x02\x47\x75\x06\x01\x0c\xd8\x72\x05\x5a\x91\x6e\x55\xa5\x1b\xef\xec\x29\xe7\xba\x4e\x37\x7e\xb1\x5f\xdb\xa3\x9a\xcb\x98\x06\x09\x35\x34\x5f\xea\xe6\xf8\xe5\xf5\x92\x58\xe8\x5b\x92\x4f\x91\x62\xa6\xc8\xcb\xcf\xb9\xfa\x9c\xe9\xcf\x34\x54\x92\x95\x14\xa9\x67\x8a\x15\xf9\x39\x57\x9f\x33\xfd\xd9\xaa\x15\x79\x88\x5a\x56\xbf\x56\x03\x48\xaa\xa3\xad\x1a\x9a\xd3\xa5\xaa\xc0\xaa\x4b\x84\xcf\xcd\xc2\xe2\xcb\x76\x65\xf1\x6a\x58\x36\x7e\x8b\xe9\xf5\xc3\x75\x16\x51\x21\xd5\xd4\xe7\x2d\xea\x4b\x93\xfa\xbc\x4d\x7d\x69\x52\x9f\x4f\xa5\xae\x07\x4f\xa1\x87\x4d\x75\x19\x81\x50\x03\x26\xaf\xca\x94\xeb\x1f\xb9\xf9\x63\xd0\xfc\x88\xf4\xfd\xea\x37\x55\xbd\x96\x4e\x5f\xf5\x5e\x37\xac\xe8\xcf\xdb\xf4\x97\x2d\xfa\xf3\x36\xfd\xa5\x49\x7f\x6e\xd0\xb7\xce\x2a\xec\x45\xd4\xed\x9d\x78\x8a\xd3\x98\xa7\xb4\x3b\x40\x9e\xca\x00\xf3\x74\x41\xbd\xfd\xe3\xa9\x0c\x64\x4f\x17\xa4\xb1\x03\x1b\xec\xa4\x34\xe7\xcb\xba\x4b\xb6\xc8\xa1\x1a\x21\x57\xf3\x46\x0e\x2a\xee\xed\x98\x19\xf7\xe6\x53\x83\x71\xc3\x0e\xfe\x17\x25\x4b\xe7\x65\x27\x59\xc8\xe7\x7d\x8c\xe4\x57\xe6\xe4\xa9\x35\x9e\x76\x39\x79\xba\xe8\xe3\xe4\xe9\xe2\xaa\x9c\xd8\x23\x7b\x97\x93\x6f\x7a\x39\xf9\xa6\x97\x13\xbb\xf9\xe3\xaa\xb2\x0a\x41\x80\x14\xc2\x2a\x81\x17\xb9\x78\x59\x85\x2d\xd9\x88\xd4\x05\xb9\x3e\x5a\xc5\xda\xbb\x6a\x34\x90\xfd\xf0\xcc\x7e\x54\x78\x0f\xe8\xcb\xd7\xe7\x05\x5e\x7f\x71\x49\x5f\x7d\xc3\x3f\x39\x24\x5a\x5d\x51\x8e\x7c\xaf\x6d\xfd\x94\x43\x7b\x69\xc3\x25\x17\x7d\x4a\x1b\x2e\xb9\x1e\x25\x6c\xb8\xe4\x72\x94\xb0\xe1\x92\x4b\x60\xb3\x02\xb5\x05\x56\x33\x56\xdc\xbe\x6e\x21\x87\x84\x62\x2e\x5b\x28\x3d\x09\x53\x4d\xc5\x9e\x86\x28\x58\xe5\xfa\x5f\x6e\xeb\x93\x5c\xfe\xcb\x6d\x7d\x92\x2b\x93\x99\x0d\x97\x5c\x98\xcc\x6c\xb8\xe4\x9a\x69\x6a\xc3\x25\x97\x4c\x47\x6b\xaf\xe3\x9f\x5c\xa6\x9d\x29\xe7\x06\xd3\xa6\x88\x45\x2e\x00\xcf\x94\x9c\xd1\x4d\xee\x36\xa9\x80\x92\xf6\x6e\x3f\x0f\xb6\xe1\xb5\xdd\xa3\xf3\x19\x9e\x02\x3b\x13\xc5\x02\x5d\x46\xbe\x79\x95\x37\xd9\x0f\xb7\x31\xdf\xb0\x20\xbb\x4f\xa4\xf3\xa7\xa3\x99\xdf\x93\xfa\x3e\xd4\xd9\x13\xe9\xf4\xa9\xea\xdc\x13\xd7\xec\x5d\xba\xdf\xb9\x31\x9c\xc6\x2b\x19\x89\x58\x4a\x3a\x1b\xe7\x83\x35\x7c\x48\x29\x67\x9a\x8f\x56\x08\xcd\xa6\xf1\x61\xbc\xc3\x91\x88\x3d\x39\xc4\x8d\xf3\xe1\x35\x7c\x78\xf3\x2a\xd7\x98\xc9\xb6\xc6\xf0\x36\x8d\x0f\xe3\xa5\x8f\x44\xec\x23\x1f\xc5\x38\x1f\x7e\xc3\x87\x8f\x2c\x14\x9a\x0f\xdf\xe2\xe3\x63\x38\x8d\xb7\x44\x12\x71\x80\x7c\x88\x71\x3e\x82\x86\x8f\x00\x59\x10\x9a\x8f\xc0\xe4\x43\x4c\xe3\xc3\x78\xad\x24\x11\x87\xc8\x47\x39\xce\x47\xd8\xf0\x11\x22\x0b\xa5\xe6\x23\x34\xf9\x28\xa7\xf1\x61\xbc\x87\x92\x88\x23\x39\x53\x1e\xe7\x23\x6a\xf8\x90\x53\xcf\xd7\x9a\x8f\xc8\xe4\xe3\xf5\x34\x3e\x8c\x17\x57\x12\x71\x8c\x7c\xcc\xc7\xf9\x88\x1b\x3e\xe4\xd2\x81\x4e\xc3\xb0\xed\x58\x3e\xf8\xeb\x1c\x03\x7f\x0f\x37\x81\x8e\x37\xbb\xe9\xbb\x40\x47\x45\x71\x2d\x37\x79\x72\xf7\xaa\x57\x79\xb6\x2f\xf0\xc4\x19\xac\x9c\xae\xa1\xa5\x7c\xa4\xae\x2b\xf6\x5d\x5c\x6c\x94\x57\x7e\x96\xcb\xf5\x7a\x33\x33\xc4\x76\x1f\xb7\x89\xb8\xfa\xef\x1a\x49\x32\x59\x78\xad\xa6\x63\x41\xfc\xdb\xbd\x20\xf4\x74\xbd\xfa\x49\x6c\x54\x25\x40\x3c\x5f\xed\x7b\xf7\xb2\xc5\x0e\x53\x5d\xac\xf3\x87\xa7\xfd\x32\x51\xae\x37\x42\x9f\xbb\x1b\xb5\x05\xe3\x1c\xbb\xb1\xa9\x65\xb7\xfe\xd6\xf7\xec\x8c\x5c\xe1\xfa\xbd\xb1\x1b\x14\x7e\xd5\x2b\xf5\x86\x5a\xeb\x4b\xf4\xf6\x65\xd3\xdc\x67\x67\xed\xe4\xe8\xa5\x75\x83\xbd\xac\xaf\xb6\x7d\x00\xe6\x0b\x4e\x88\xc5\xfa\xf6\x21\x3f\x5c\x2a\xf1\xfb\xb9\x54\x22\x64\xde\x55\x2e\x95\xc0\xe6\x07\x5e\x2a\xd1\xda\xa7\xb9\x77\xa9\x04\xfc\xfa\xe1\x52\x09\x2a\x83\xff\x14\x97\x4a\xa0\x29\x5d\xed\x52\x89\x5e\x8b\x6a\x5d\x2a\xa1\xad\xca\x6c\x4d\xbf\x54\xa2\x7b\x3d\xd6\x61\xb7\xdd\xf9\x7f\xc8\x4b\x24\xc4\x2a\xbf\x97\xa5\x5b\x11\x05\x9d\x1f\xce\x8a\xb0\x0b\xfa\xd3\xf9\x8f\x45\xd9\x79\x98\x2f\xce\x41\xa9\xbf\xad\x7b\x28\x8c\x3e\xc9\xef\xd8\x15\xf5\x83\xea\x81\xfc\x6c\x32\xfe\xe1\xbe\x8a\x2b\xdd\x57\xd1\xbe\x31\x02\xaf\xac\xb0\xdd\x18\x21\x37\x5c\x9f\x4a\x0d\xd4\x6d\x8c\x67\x83\xbc\xd8\xef\x99\x80\x4e\x7f\x29\x36\x67\x32\xa9\x3c\x9d\xaf\x17\xb9\x80\x99\x65\x3e\x7e\xe3\xc3\x97\xa7\xf8\x0a\xe7\x65\x7f\x66\x15\xc6\xc7\x4e\x90\xc0\xff\xd9\xb1\xe3\xfb\x58\x0a\xfb\xd8\x61\xf0\x0c\x1e\xb1\x81\xfd\xef\x21\x3f\x76\x42\x17\x9a\x78\xd0\x04\xab\x67\x47\x00\x0b\xcf\x18\x3c\xf3\x86\xda\x24\x58\x5b\x1b\xda\x00\x09\x3c\x58\xe4\x01\x09\xdc\xfc\xce\x90\xec\x40\x9b\x08\xf0\xe1\xf9\xed\x00\x68\xf8\x40\x23\x82\xb6\x21\xb4\xc5\x13\xce\x7e\x32\xd0\xc8\x07\x84\x1e\x00\x32\x00\x04\xb8\x08\xda\xe3\x29\xdd\x40\x16\xf8\x1e\x6a\x83\x8c\x03\x20\x9e\xbb\x43\x3a\x80\x02\x63\x69\x00\x28\xfc\x78\xa0\x8d\x07\xcc\x7b\x00\x88\xe7\x87\xb1\x3f\xd0\x7f\x0f\xd0\x30\xe4\x77\xbf\xc5\xe0\xb1\x9c\xae\x32\x3d\xbb\x32\xbd\x61\x65\x62\x07\x50\x79\x28\x55\x3c\xdf\x8a\x1d\x19\xea\xb3\xe2\x19\xc5\x14\xe9\x9e\xb8\x43\x5d\xf5\xb5\xae\xb0\x6f\x4a\xdd\x43\x92\xc4\x23\xab\xb1\xd2\xae\x94\x86\x3f\x6c\x11\x68\x70\xa8\x5d\x54\x98\x1f\x2b\xad\x86\x83\xdc\xa2\xb9\xb9\xda\x84\x42\x65\xa9\xc1\x10\x13\x68\x2f\x68\xd2\x3e\xda\x5c\xa4\x6c\x34\x1c\x32\x32\x34\x0c\x34\x64\x34\x68\x34\x32\xd4\x6b\xdf\xdc\x62\x50\x83\xa7\x17\x67\x17\x4b\x48\x60\x7e\x12\x58\x99\xde\xd9\xce\x17\x30\xdd\xb6\x28\xf1\x93\xcf\xbe\xfa\xfe\xc5\x93\xcf\x1e\x7f\xf5\x02\x75\x89\xca\x92\x92\x95\x82\x95\x6e\xc4\xb4\x35\x32\xed\x8d\x4c\x5b\x9b\xa7\x3d\x54\x0a\x98\x0f\xf2\xf4\xe2\x93\x2f\xbe\x85\x49\x7a\xba\x2a\x74\x9d\xde\x73\x69\x66\xa9\x8c\xa6\x16\xde\xb0\xed\xf7\x5f\x0e\xdb\xd8\xc8\xec\xd2\x7d\xe3\x3e\x90\x6b\x25\xdc\x73\x87\x8c\x49\xc1\x55\x4b\x19\x0a\xdc\x02\xec\xb5\x80\x01\x7c\xc8\xa4\x14\xb8\x6f\x80\x8f\x43\x06\x26\xa4\x85\x89\xb0\xcd\x84\x67\x61\x22\x6a\x77\xd0\x06\x1e\x77\xe4\x61\x61\x86\xb7\x18\x1f\x47\x9d\x74\x39\x19\x47\x9d\x9a\xe0\x36\xe0\xac\xab\x99\x71\xf0\xbc\xc3\xca\x28\x70\xd1\x15\xc9\x38\xb8\x30\xc0\xc7\xb9\x28\xdb\x3d\x1c\x47\xcb\xa9\x58\x19\x6f\x89\xc2\x86\xd7\xe3\x13\x98\xf0\x79\x5b\x14\xe3\x46\xdd\x65\xc4\x62\xd7\x06\xb8\x0d\x36\x6a\xa3\xb6\xa9\x24\x36\x51\x8f\x4b\xb9\xdd\x41\x1b\x1f\x49\x5b\x78\x16\x83\xee\x32\x6d\x31\xe9\x2e\x2b\xe3\x9c\xe7\x1d\xd5\xd8\x8c\xba\x83\x7d\xdc\xa4\x5b\xaa\x19\x87\x2d\xbb\x7c\x58\xac\x95\x64\x4a\x3a\x4a\x33\xbb\x02\x3d\x03\x92\x60\x74\x7e\x0b\xdc\xca\x6d\xd0\xc6\x6e\x93\x5b\x68\x82\x5b\x6d\xda\xec\xa3\x05\x36\x6e\xb3\x4d\x8c\x1d\x8c\x18\x12\x92\x6e\x2f\x49\x81\x9a\x11\x2d\x2f\x6b\x0b\xc5\x62\xd5\x2d\xa1\x90\xe2\x34\x23\xfa\xae\xe8\x80\x53\x42\x35\xb3\x87\x5f\x3e\x49\xd4\xac\x0d\x6e\x13\xb5\xc7\x3b\xa2\xb6\x58\x37\x37\xa5\x47\x8a\xd6\x34\x7f\x0c\xbb\x7c\x58\x72\x10\x93\x0f\x4a\xa4\xa6\x19\x07\x6f\x73\x61\x31\xea\x0e\xb0\x05\x3c\x6d\x89\xce\x62\xd1\x5d\x9d\x5b\x92\x8f\x2e\x27\xe3\xe0\x85\x01\x6e\xb1\xe8\xb6\xf0\x2c\xc9\x47\xd7\xf2\x7a\xa6\x1a\xf8\xf7\x6e\x00\x8b\x3d\xfb\x86\x60\xc9\x03\x77\x70\x32\xa7\x20\x75\x64\xd7\xf0\xa4\xf4\xbb\x36\x3a\x4a\x64\xaf\x18\x71\x6d\x8c\x04\x6d\x46\xac\xf0\x61\x1b\xbf\x95\xf9\xa8\x8d\xdf\x0a\x1f\x37\xf0\x16\x4e\xcc\x01\xd7\x8a\x36\xe9\xb2\x61\x41\x9e\x76\xc5\x42\x4b\xc4\x35\x33\x16\xe4\x79\x4b\xa1\x16\xe0\xa2\x01\x26\x05\x76\xc5\xb2\x05\x69\xd9\xb5\x12\xd2\x7c\x87\xac\x44\xc6\x27\x48\xc3\x6b\x80\x2d\x90\x7e\x97\x0d\x9b\x6d\xb7\xe0\xed\x9c\x84\x6d\xfc\x56\xa9\x44\x46\x37\xad\xc0\x71\x9b\x19\xab\xde\x79\x0b\xb9\x05\x38\x69\x80\x49\xc9\x38\x29\xee\x64\x5d\x8e\x69\x01\x9e\x1c\x4a\x8a\x96\x95\xd8\xd2\x96\xae\x2e\x69\x71\x9e\xe4\x0d\xe6\x62\x88\x05\xb2\x1d\xb7\xed\x8a\x61\x5e\xab\x81\xdd\x66\x59\x27\x80\xdb\x7d\x2d\xe8\xb2\x64\x6b\x60\x84\x70\x1b\x33\x46\xf4\x26\x8c\x69\x71\xbb\xaf\xe4\xe9\xba\x1d\xb3\x11\xbe\x09\x1d\x4c\x5b\xd0\x36\xdc\x59\x9b\x6b\x7b\x83\xbc\x25\x14\x1b\x33\x45\x1b\xbd\xbd\x81\xe8\xf2\x63\x6b\xd0\x09\xe6\x04\x73\x9f\x12\x9e\x19\x9b\x12\xe5\x98\x37\x31\x68\x30\x7f\x42\x44\x62\xc1\xd4\x81\x28\x9c\x38\x04\xb0\x88\x3c\x1a\xb1\x78\xe2\x70\xc1\xf8\xc4\x18\xc6\x92\x89\x11\x95\xa5\x5d\xf9\x58\x8d\x7f\xca\x28\xc3\x72\xea\x30\xc3\x8a\x29\xe1\x57\x74\x6d\xc6\xd6\xa0\xec\x8c\x1c\xd7\x9d\xc2\x33\x37\x20\x0c\x18\x76\x31\x78\x2d\x6d\x30\x52\xea\xae\xe8\xdb\x38\x08\x0c\x58\xd7\x02\x1b\x76\x8c\x68\x1c\x3a\x6a\x29\xce\xc6\x47\xdc\x82\xb6\x19\xf4\x9e\xc3\x10\x72\xf6\x86\x13\x4a\xc6\x5e\x49\x8f\x92\xad\x37\x5c\x8f\xf7\x31\xef\x68\x71\x1c\xba\x68\x69\x86\x92\xb2\x4b\xcc\x84\x74\x5d\xe3\xb4\x4b\x98\x2a\x32\xc6\xa7\x88\xc1\xab\xa1\x29\x59\x3a\xd5\xe8\x83\x16\xb4\xd5\x94\x39\x5d\xb8\x51\x0b\xd6\x62\xc6\x06\xec\x38\x24\xe7\x34\x95\x25\x9c\xaa\xb2\xb4\xa3\x05\x4a\x52\x4e\x0b\x12\x79\x0b\x96\x92\x8c\x53\x8d\x5c\x74\xec\xcc\x62\xbe\x1d\x1d\x4f\x58\x1a\x21\x18\x71\x13\x8c\xed\x7a\x66\x5e\x0b\x98\x94\x8a\x53\x2d\x99\xb5\x62\x32\x25\x05\xa7\xca\x8f\x45\x6e\x5b\x80\x16\xf0\x26\x28\x93\xa7\xe9\x14\xa5\xb3\xa4\xc3\x06\x29\x0d\xb7\xbb\x0a\xcb\x0c\xc1\x59\x45\x97\xb7\x44\x67\x65\xc2\x0c\xc7\x16\x36\x44\x8b\x0d\x0b\x70\xd9\x61\xc3\x96\x30\x4c\x73\x01\x46\x8e\x47\xcc\x9b\x16\xf2\x7d\x62\x14\x67\xc1\x84\xf0\xc9\xc2\x49\x31\x9f\x45\xe4\xe0\xc8\xe2\x49\x63\x14\xe3\x13\x06\x08\x96\x4c\x8a\x62\x2c\x9d\x10\x7a\x59\x36\x69\x5c\x63\xf9\xa4\xf0\xcb\x8a\x49\x63\x06\x13\xc4\x41\x8b\x95\xed\x11\xe3\xba\xf3\x6a\xee\x2a\x76\xed\xf1\xbf\x9a\x6d\x82\x24\x08\x29\x76\x25\x08\x0b\xac\xdf\x60\x0e\xec\x4e\x18\xd0\x1c\x25\x6c\x6b\xc2\x9e\x5b\xd7\x13\x69\x1b\xbb\xb1\x69\x12\x16\x58\xde\xc6\x4c\xc9\xac\x1b\xdb\xb4\xe7\xd5\x0a\xd6\x9e\x53\x37\x6a\xa3\xec\x44\x69\x54\x61\xc1\x5d\x18\xd1\x90\x90\x51\x57\xee\x6f\xcf\xa9\x1b\xa3\x24\xc8\x97\x6c\x93\x8c\xd3\x44\xe6\xf1\x29\x42\xf0\xf9\x14\x01\x07\x0d\x0f\x56\xd8\x90\x4f\x11\x45\x54\x41\x13\xd6\xbb\xc9\xce\xc6\x39\x55\x6f\x49\x9b\x59\x4a\x72\x4d\xb4\xf4\x8c\x53\xad\x2c\x37\x55\x61\xc5\x5b\x98\x3c\xd8\xec\xd7\x54\x9b\x05\xb6\x6c\x73\x61\x33\x4a\x77\x42\x38\x61\xcc\x75\xc9\xe6\xc3\xea\x18\x4c\x63\xa4\x0e\xc3\x94\x7c\x9a\xa4\x0f\x16\x36\x1c\x50\x7a\x17\x99\xe0\xf6\xfe\xc5\x66\xff\x68\xde\x4f\x77\x27\x96\xb4\xa5\x47\xca\xaa\xc9\x7e\xc5\xea\xb0\x4c\x61\x25\x6f\x77\x94\x94\x5b\x13\x9d\x8b\x89\x06\x98\x90\x59\x93\x42\x81\xca\xaa\xe9\xb2\x60\x93\xe2\x1c\xf3\x68\x81\x8e\xf9\x13\x42\x2d\x0b\x26\x04\x03\x16\x4e\x8a\x33\x2c\x9a\x36\x4a\xc5\xc4\x61\x8a\xf1\x69\x81\x26\x21\xc7\x72\x96\xb6\x39\xb6\x1a\xf3\xa4\x01\x93\xe5\xe4\x80\xce\x8a\x49\xa3\x0a\x13\x13\x86\x15\x56\xb6\x63\xfa\xf5\x27\xd5\xd6\xb8\x5f\xc9\xc0\xc6\xaa\x67\xf6\xca\x9a\x4a\x3f\x70\x9a\xad\xe1\xd6\x4c\x5a\x03\xcb\xf5\x7d\x6b\x3e\x5d\xcf\xdf\x2c\xb0\x51\x85\x98\x02\x1c\x37\x9d\xb3\x40\x9a\xf6\x6b\xeb\x5b\xd2\xea\x1b\x21\x97\x6e\xa4\x66\xe1\x22\x6b\x3a\x67\x67\x39\x6f\x01\xdb\x12\x12\x52\x5c\x13\xa6\xe1\x58\xc8\x97\x2d\x45\xd8\xd7\xa8\xa9\x78\x19\x9f\xa0\x60\x8f\xbb\x14\x87\xf0\x39\xdd\x21\x02\x4e\x75\x88\x90\x4f\xb0\xf1\x88\x4f\xd0\x56\xcc\x27\xb8\x1a\xe7\x13\xcc\x31\xe1\x14\x3b\x48\xf9\x04\x3b\xcc\x38\xdd\x7b\x73\x3e\xc1\x1b\x0a\x3e\xc1\xc2\x04\xa7\x3b\x70\xc9\xa9\x61\xc1\xc8\x89\xed\xa9\x86\x4b\xb2\x45\x56\x45\x5c\x92\x72\x59\x1d\x75\x29\xe2\x65\x55\xd8\x25\x01\x87\xee\x04\x93\x64\x91\x3b\xc1\xda\x59\xec\xd2\xd5\x51\xe7\x68\x24\x73\x63\x89\x3b\xc1\x2c\x58\xea\xd2\x5d\x9f\x65\xee\x04\x57\x62\x55\x00\x26\xf0\x5c\xb8\x13\xec\x9e\x55\x61\x98\x90\x35\x93\xa3\xaa\x3b\x25\xac\x32\x36\xc1\x9b\x98\x37\x21\xba\x32\x7f\x4a\xd0\x64\xc1\x94\x40\xc8\xc2\x29\xc3\x4c\x44\x1b\x3a\x58\x3c\x25\x70\x33\x4e\x0f\x2d\xc9\x94\x20\xcb\x52\x52\xe0\x66\xd9\x94\x98\xc9\xf2\x09\xa1\x9b\x15\xe4\x81\x91\x89\x29\x43\x12\x2b\x3b\x83\xc2\x75\x67\xcb\x5a\x14\x43\x47\x57\x35\x17\x0d\x0f\xb6\x7c\x59\xe1\xf4\xec\xf2\xf5\x1b\x58\xcf\x46\x3f\x68\x34\x61\xe5\x21\x34\xe5\x65\xcb\x96\x6b\xc8\x71\xfa\x71\xcb\xe1\x49\xd9\x9c\x35\x4b\x36\x3a\x34\x4e\x3c\xad\xd8\xb4\x76\x3d\xd3\x4e\x6b\xcb\x8c\x0d\x1d\x8d\xc3\x16\x06\xe9\x71\x48\x51\xcb\x72\x1c\xae\x6c\x69\xdd\x26\x49\xb2\x31\x31\x4e\x37\x26\x8f\x13\xa4\xe4\x73\xaa\x15\x05\x9c\x2a\xa3\x90\xd3\x3d\x2e\xe2\x74\x8b\x8f\x39\x4d\xf6\x9c\x53\xed\x3d\xe1\x34\x7f\x4f\x39\xdd\x8c\x33\x4e\xb7\xbb\x9c\x13\x7c\xa8\xe0\x74\x53\x12\x9c\xea\x43\x25\xa7\xbb\x7a\xb3\x94\x40\xd0\x12\x63\x13\x1c\x8f\x79\x13\xa2\x23\xf3\x89\xce\xc7\x02\x72\x6c\x64\xe1\x84\x90\xc7\x22\x97\x6e\xd9\x2c\x26\xc7\xb3\x7a\xb6\x4b\x90\x41\x42\xca\x00\x52\x4a\x84\x64\xd9\x84\x61\x8c\xe5\xc4\xb1\x91\x15\x13\x62\x3e\x13\x13\xe2\x24\x2b\xc9\x03\x19\x66\xba\x04\x01\x30\xb2\xbb\x30\x8f\x1c\x28\x99\x3f\x21\xfe\xb1\x60\x42\x00\x64\x21\x31\x02\xb2\x68\xc2\x38\xc1\xe2\x49\x03\xd0\x84\x68\xc4\x92\x09\xb1\x90\xa5\xc4\x60\xcc\x32\xf2\x48\xc4\x72\xf2\x50\xc0\x0a\x4a\x2c\x66\x62\x4a\xd8\x2c\xdb\xc3\xc6\xcd\xa4\xb4\x16\x3f\xa8\xba\x1f\xb8\x43\xb7\x0a\x29\x48\xaf\x4a\xeb\x2d\xc9\xac\x26\x6c\x43\x17\xd4\x13\x10\x1b\x64\x48\x10\x7b\xa4\xc8\x5a\x72\xd7\x66\xca\x33\x0e\xc9\x0d\xa1\xd8\xd2\xd7\x5a\xce\xe3\x28\x53\x23\x38\x8c\x43\x66\x86\x60\x6c\x4b\xbb\xc6\x1c\xce\x92\xbe\x2a\xdd\x8d\x53\x16\x8d\xee\x6c\x8b\xba\xc6\x6c\x7b\x5c\x92\x54\x91\x33\x6e\x57\xb2\xc7\xa9\xb6\xea\x73\xaa\x0c\x03\x4e\x74\x94\x90\x53\x15\x18\x71\xaa\x74\x62\x4e\x51\x0b\xe7\x44\x97\x4a\x38\xd5\x6c\x53\x4e\xb5\x9c\x8c\x13\x0d\x3c\xe7\x94\xf0\x50\x70\xaa\xd7\x0b\x6e\xf7\xe8\x92\x13\x0d\x96\xb9\x64\x8b\x65\x8c\x1c\x3a\x3d\x72\xec\x64\x3e\x39\xf6\xb0\x80\x1a\x52\x58\x48\x0e\x53\x2c\x22\x07\x15\x16\x53\x23\xb8\xce\x8d\xac\x60\x09\x39\xd2\xb3\x94\x1a\x81\x58\x46\x08\xf8\x2c\xa7\x0c\xd8\x05\x39\x8a\x32\x41\x0e\xe2\xac\x24\x8d\x96\x90\x85\x52\x3b\xcc\xc8\xc1\x8f\x79\xf4\xa8\xeb\x13\xbc\x8c\x05\xe4\x80\xc6\x42\x6a\x38\x65\x11\x29\xf6\xb1\x98\x1c\xc9\x19\x27\x47\x68\x96\x90\xe3\x1f\x4b\x09\x03\x13\xcb\xc8\x61\x8d\xe5\xd4\x68\xce\x0a\x52\x44\x65\x82\x1c\xf5\x59\x69\x04\xf3\xeb\xce\x35\xf1\xed\x0c\xf7\x2c\x0c\xd4\x0a\x1a\x1f\xe7\x2b\x7c\x36\x40\x5f\xe1\x1b\x1f\xe0\x35\x4d\x0b\x58\xa8\x68\x7a\xd6\x3e\x44\x35\xa0\x6d\x70\xd7\xbd\xb0\xe7\x47\xdc\xb5\xcb\x24\xa9\x65\x6c\x1b\xda\x55\x7f\x6d\x60\x59\x4d\xd8\xd2\xdf\x5c\x02\x5a\x80\x0a\xbb\x8f\x88\x5a\xa9\x16\x5c\xa5\xee\x80\x05\xac\xda\xf8\x44\x92\xb2\x02\x65\x54\x1d\x6b\x78\x8f\x6a\xd7\x1a\xde\xa7\xda\xad\x86\x09\xa8\xca\xd7\xf0\x21\x4d\xb5\x1a\x3a\x9a\x24\xc7\x98\xe0\x4a\x1a\x94\x53\x35\xa9\xe1\x13\x8a\x05\x69\xd8\x94\xe6\xad\x1a\x3a\xa3\xfa\xa2\x86\xcf\xed\x66\xaa\x21\x0b\xaa\x7b\x68\x78\x41\x75\x4f\x0d\x5f\xd2\x22\x61\x35\x3b\xb7\x06\x56\x46\x95\x04\xf3\x08\x8a\x66\x3e\xc5\xab\x58\x40\xed\x34\x0b\xa9\x26\xc3\x22\xaa\xe0\x59\x4c\x14\x21\x65\xfc\x4e\x68\x66\xc7\x52\xaa\xc7\xb2\x8c\x3c\x26\xe6\xd4\xa8\xc4\x0a\x9a\xff\x33\x41\x71\x38\x56\x4e\x8b\x56\xcd\x42\x11\x09\x9a\x51\x2c\x48\xc3\x7a\xd3\x9c\x8d\xf9\x53\x22\x1b\x0b\xc8\x91\x8d\x85\x54\xf5\x6a\xf8\x68\xa2\x08\x63\xaa\x51\x68\x78\x4e\x35\x0d\x0d\x9f\x50\xdd\x4c\xc3\xa7\xd3\xa2\x96\x32\x6a\x12\x64\x3e\x65\xa8\x52\x86\x4d\xec\xa3\x98\x32\x42\x54\x46\x2e\x39\x19\xc8\x78\xf7\x1f\x0f\x5e\xde\xfc\x79\xba\xfd\x71\xeb\xec\xe6\xe9\xce\xd9\x8a\x25\xd6\x0e\xc1\x6a\x22\x78\x2d\xb3\xb3\x58\x9d\x5f\xec\xf6\x1b\xd5\xd7\x36\x7f\xfe\xf1\x8b\xa7\xc3\x37\x37\x37\xb7\xcf\x1d\x3b\x6e\x7d\x8b\x05\x7e\x76\xf5\x17\xf9\x59\x7f\x19\xe8\x38\x02\x28\x08\x05\xac\xbe\x54\x9f\xf1\xcb\xb1\x21\x18\x72\xb7\x07\x8a\xab\x7c\xfa\xe8\x85\xaa\xfd\xe3\xa8\x7a\x06\x0e\x5e\x8c\xbf\x59\xec\xe6\x67\xbd\xf5\x45\x7a\x8a\x74\xa0\x6c\x10\xcb\x43\x7d\xbd\xfe\x89\xfa\x62\xdc\xc6\x7f\x22\xde\xec\xc4\xaa\x98\x0d\xcc\x3e\xbe\x2f\xd6\xcf\xc5\x56\xec\x6e\xa0\xfa\x1a\xd6\x5e\xa9\xea\x28\xc1\x67\x4b\x11\x34\x80\xa8\x4a\xaf\x61\x95\x16\x7b\xe5\x35\x64\x44\x19\x50\x18\xe1\xdd\xe4\x5b\x27\xcd\x73\x68\x85\xb5\x5f\x77\x6b\x2c\x0b\x60\x25\x88\x85\xc1\xd0\xa0\xc6\x0a\x37\x97\xeb\x8d\x33\x43\x78\x2c\xf2\xec\xfe\x05\xfe\xf9\x08\x08\xc2\xbf\x77\xef\x8e\xca\xa9\x4d\xe6\xcb\x35\x12\x02\x9e\xb0\xae\xf4\x3d\x87\x59\x2a\x82\x6a\xde\x10\xf8\xa1\x33\xab\x44\xf3\xb2\xc1\xa5\x8b\xb7\xe1\x3f\x33\x9f\x01\xc6\xe6\x27\x59\xb5\xed\x8e\x73\x7b\x94\x88\xad\x3c\xe8\xc7\xdb\xad\x38\xcb\x96\xc2\x61\x91\xb3\xbd\xc8\x00\xbb\x45\xd9\x00\xf4\x14\x80\x6a\x85\x37\xdf\x69\xd2\x5d\xbd\x90\x0d\x94\x8c\xab\x2f\x1f\x01\xf9\xfa\x1b\x41\xde\x78\x5f\xbd\x2c\x4f\xa3\x79\xb6\x6b\x67\x5b\x51\xd5\xfc\xbe\xd4\xc4\x5e\x55\x8c\x5b\x09\x56\xce\x60\xa7\x05\x36\xfa\x42\x5e\x9f\xff\xd0\xb8\x21\xbf\x26\x48\xa2\xa5\xec\x3d\xe0\xbd\xf6\x3e\x70\xd7\x66\xf5\xd7\x67\xc8\x5e\x40\x33\xe4\x36\xfd\x72\xb3\x3e\x93\x51\x7b\x89\xb5\xf7\x3c\x2e\x3d\x1d\x39\xb2\x23\x51\x62\x7e\x39\x5b\x38\xf7\x9d\x48\xd6\xb0\x95\x15\x08\x2b\x7b\x9f\xcd\xa0\x1b\xda\x45\xb0\x80\x5f\x25\xb2\x3b\x60\xd5\x1e\x7f\x25\x8b\x14\x4a\x73\x5f\xc0\x83\xc8\x5e\x15\x7a\x80\xef\xcd\xe2\xf5\xfc\x30\xc6\x03\x59\x07\xb3\xe1\xfe\x4e\x8b\x7d\xc0\x88\x3f\xab\x4e\xc0\x47\x2f\x18\xe8\xc9\x9d\xbe\xae\x8c\x91\x1f\xf5\xd7\xaa\x97\x8b\x55\x2e\x1c\x91\xe6\x73\x6d\xfe\xce\x02\x6c\xe4\xfc\x7c\xb9\x10\x05\x5a\x48\xba\xc2\xfa\x42\xe9\xaa\x80\xaf\xba\x80\xa0\x1c\x71\x47\x72\x00\x8d\x19\x65\xa6\x51\xe6\x80\x25\x13\x4e\xb6\x59\xff\x28\x56\x58\xf6\x6b\xed\x70\x55\x3a\x78\xeb\x6c\xf3\x74\xa9\x48\x29\xf4\x5b\x3b\xe6\xcb\xf9\x02\xd8\x4d\x97\xcb\xf5\xe5\x56\x92\x41\x1a\x80\x00\x48\x5c\x6c\x01\xd7\x25\x0c\x86\xeb\x8b\x9d\x62\x7c\x0b\xa3\xd3\x38\x46\xad\x25\x59\x69\x72\xd6\x7c\x01\x59\x33\x54\x97\xf1\x08\x23\xa7\xcf\x6c\x62\x6f\xf9\x0c\x53\x3e\x13\xd3\x5d\x46\x93\x93\x51\xbc\xf9\x2c\x83\x36\xd8\x90\xb4\x8c\x3f\x3b\x68\x52\x3e\x41\xff\x04\x42\xb1\xd9\xef\xf8\x95\xae\x83\xf9\x8b\xf9\x08\x89\x7b\xf1\x18\x39\xdb\xd0\x70\xaa\xaa\x3e\x82\xee\x7f\x12\x9b\xad\xa0\x0d\x0f\x00\xfc\xa2\x33\x42\xb4\x1e\x4d\x1e\x82\x19\x71\x08\x6e\xa8\x98\x6a\xd8\xbe\x64\x21\xba\xde\x18\xd5\x01\x91\x0f\x57\x71\x14\xab\x1c\xab\x31\xc9\xdc\xcb\x4c\xa7\x3e\x3f\x76\xd6\x65\x09\x39\xd6\x28\xb3\xba\x48\xed\xfa\xb4\xc6\xd1\x34\x3c\x6e\x0f\xaa\xd3\xcb\xec\x16\xe2\xa6\x59\x6b\xe4\x7c\x50\x11\xe0\xd3\x71\xf6\x8e\x2b\xbd\xd9\x52\xd3\x7f\x17\xbb\xa1\x69\x44\xbb\x37\x4b\x49\x0c\x0b\xd2\xbe\x54\x24\xc6\x4c\x41\x35\xd9\x74\x9b\x60\xd9\xd9\xf1\x71\x5b\x16\x5a\x5f\xec\x16\xe9\xd2\xac\x51\x33\x0c\x2f\xde\xe4\xf3\x74\xf5\x5a\x3c\x7b\xde\x94\x2c\x95\xb5\x73\x60\xfe\x51\xaa\xff\x8d\xb9\x6e\x7f\x73\xac\x5a\xa4\xe6\x2f\x25\xfc\x51\xda\x3f\x7f\x66\xb6\xf7\xb0\xb9\xaf\xff\xa6\x37\xe7\x8a\x7a\x59\xe2\xff\x0f\xe0\x1e\x9b\x87\xfa\xcf\x5e\x9f\x16\xeb\x01\x8d\xc4\xa2\x3a\x94\xa8\xc2\x41\x32\x9c\xa8\x8f\x2a\xa4\xc8\xcf\xb4\x4c\x93\x30\x0b\xc2\xbf\xde\x2c\x53\x92\x19\xb3\xb8\xaa\x65\x6d\xa6\xa6\xd5\x12\xda\x6d\xda\xed\x36\x55\x3b\x6b\xaf\x1e\x8b\xc5\x76\x27\x96\xb5\x13\xda\x49\x95\x52\x8a\xd3\x73\x4f\x4e\x1f\x47\x4b\x4c\xb2\x54\xe5\x26\x88\xe2\x90\x25\xea\xee\x7d\xd7\x8c\xaa\x38\xe1\xa9\x17\x09\xe4\x77\x59\x9b\xdc\x26\x63\xcb\xb8\xda\x89\x15\x1b\x8a\xf8\x3b\xc1\x62\x59\xb1\x5a\x5e\x61\xcc\xfd\x7a\x55\xac\x9d\xed\x65\x7a\xae\x72\xd8\x65\xba\xdd\x29\x53\x1d\x1f\x75\x77\x74\xc3\xe9\xf4\xb4\x63\x36\xc4\xbe\xee\x6c\xee\x29\x6b\xbd\x5f\x25\x14\xee\x45\x03\x5b\xf3\x2b\xc6\xa2\x2b\x46\xc2\xab\x06\x62\xcb\x38\x60\x59\x1c\xd9\x39\x90\x3a\x8f\x8e\x85\xf5\xe0\x47\x37\x94\xd6\xe0\x47\xb3\x94\xe1\x91\x1f\x12\x47\x55\xf6\x3a\x52\x45\xaf\x07\x01\x17\x3f\xd1\xe0\x8c\x4a\xda\xd1\xd4\x3a\xda\x20\x32\xf4\x30\x3d\xb5\xde\xac\xb7\xdb\x66\x92\x2b\x6b\xcd\xc9\x59\xa3\x5c\x98\xda\x6f\x5d\x27\x2d\x8d\xea\x66\x55\xee\x72\x96\x6e\x7f\x1c\x8c\x73\x95\x9f\xce\x66\x2d\x17\xc4\xe8\x55\xa5\x66\xdf\xb5\x84\x8c\x91\x0e\x31\x0e\x08\xbb\xe5\x92\xdf\x29\x9f\x1c\x86\x5b\x36\x70\x38\x5b\x50\x14\x7b\x5a\xf4\x06\xa8\xbd\x3e\x3f\x7f\x76\x58\x9f\x37\xc3\x7d\x5e\xd2\xfb\xbc\x24\xf6\x79\x73\x95\x3e\x93\xeb\x64\x6f\xab\x42\xd9\x6a\x69\xf7\x6a\xa5\xb2\x0f\xad\x09\xad\x68\xef\xc4\x9b\x9d\x59\x19\xfa\xd3\x47\x2f\x4e\xf4\x64\xa5\x55\x1a\xfa\xd8\xc9\xcb\xd7\x96\x62\xe5\xe7\xcb\x14\x3b\x00\x08\xbb\x18\xf5\x1c\x63\xd6\xd0\xb4\x21\xed\x2d\x3a\xbd\xbf\x80\xdd\x2e\x62\x0e\xbf\x4f\xab\x60\xfe\xd5\x66\x01\xa2\xbb\x77\x3d\x6b\xed\x0a\x59\x6b\xc5\xdd\x7c\xf4\x07\x59\x77\xd7\x8b\xac\xd8\xe7\x85\xac\xb6\x99\x8b\x11\x9e\xf4\xf4\x51\x6c\xb1\x4e\x2f\x5a\x8a\xd2\xe7\x23\x65\x81\xeb\xcd\xac\xae\x37\xac\x7f\xa9\x17\xbc\x4f\xb6\xcb\x45\x2e\x66\x58\xbf\xf6\xce\x5e\x79\xea\x5e\x12\xde\x81\x24\xb0\x42\x29\x91\x84\x7f\x20\x09\xac\x80\x3a\x4c\xe2\x86\x97\x15\x40\xf8\x27\x26\x22\xa3\x35\x49\xac\x27\xe6\x0a\xc2\xc4\xc6\xfe\x44\xca\x37\xbc\x8c\x81\x0c\x5d\xa1\x37\xde\x55\xe4\xc8\x26\x52\x26\x64\x4e\x2c\xf1\xde\x7f\xea\xf4\x4f\x30\x28\xd6\x61\xfc\x9a\x87\xc6\x06\xef\x35\x0d\x90\x43\xe3\x4d\x7b\x98\xac\xa1\xf6\xea\xd5\xcf\xee\x28\x25\xb6\x1f\x6f\xc4\xee\x62\xb3\xea\x61\xbb\xa5\xf0\x77\x75\xfc\x7a\x77\xec\xfc\x7c\xeb\xe4\xbe\xea\xcb\xbd\x7c\xbd\x11\xb7\x1e\xc8\x82\xd7\xf8\x50\x7f\xf3\xe5\x37\x10\xe7\xbd\x2c\xdd\x8a\x28\xc0\x67\x81\x7a\xf6\xd3\xf9\x8f\x45\x89\xdf\x23\xf9\xfd\xac\x08\xe1\x4b\xc4\xde\xbd\xd2\xaf\x0c\x78\xf0\xc0\x79\xd9\x38\xf8\x46\xfc\xe7\xc5\x62\x03\xaa\x38\x5b\x17\x17\x4b\xf8\x57\xbc\x39\x07\xd3\x6a\x2f\x07\xfe\x65\x66\x34\x58\xaf\x41\xba\x60\x50\x10\x99\xdf\xee\x45\x85\x45\xe9\xcc\x76\x6f\xcf\xc5\xba\xac\x10\x39\x0f\x1f\x3e\x74\x6e\x29\x63\xbc\xd5\x1b\x45\xd4\xca\xf7\xd9\x7a\xf5\x1f\x2f\xf6\x7f\x54\x6c\x9d\xd4\xc8\x1a\xb4\x15\x0f\x55\x17\x66\x95\x7c\xf6\x46\x82\x77\x8e\x58\x6e\x85\xc9\x5b\x21\xca\xc5\x4a\x28\xd6\xaa\xae\xdd\x72\x6e\xdf\xd6\x3f\x9c\xa4\x67\xc5\x10\xab\x1f\x7f\xfe\xe9\xfe\x73\xd5\x6c\xf6\xb2\x62\xe1\x55\x23\xa1\x7e\x5e\xfa\x71\xff\xfb\x72\x9d\xc1\x2c\x7e\x96\x6d\xd6\x97\x5b\xb1\xb9\xd3\x33\x31\xa8\xfa\x0c\x5a\x38\xa9\x4c\x6a\x8f\x86\x61\x56\x7a\x82\xdb\xe8\xaf\x6e\x84\x3c\xb4\xdb\x19\x5a\xbe\x58\xa9\x2e\x0d\x8a\x61\x24\x63\x42\x8f\x3d\x35\x1c\xb5\xc7\x01\x25\xc8\xf7\xcb\x45\x86\x60\x27\xf0\xef\x00\xcc\x27\x60\xdd\x32\x1f\x04\x90\x13\xfc\x32\x00\xf7\xad\xef\xd5\x49\x42\x0d\x5f\x3f\x99\x14\x59\xdf\x40\x88\x5e\xa5\x10\xa1\xce\x87\x62\xe6\x40\x02\x7b\xfa\x3d\x36\xc5\xfe\xa8\x7f\x7f\x7e\x37\x89\xee\xc7\x30\x36\xc8\x37\x87\x98\x27\x4e\xa1\xfb\x6d\x14\x60\x4f\x65\xb7\x81\xf2\x89\xfe\x82\xd2\xb2\x64\xca\xfd\xac\x28\x76\xf4\xe2\x3a\x0c\x5a\x30\x65\x77\x56\xe2\x72\xf9\xd6\x51\x61\xb0\xb0\x31\x2a\x11\x0c\x22\xfe\xb7\xf3\x74\x93\x9e\x39\x3f\xaf\x2e\xce\x32\xb1\x79\xe7\xcc\x61\xee\xef\x7c\x05\x03\x97\xfc\xe0\x7b\x72\x95\x60\x10\xed\x5e\xf3\xe5\xfa\x52\xb6\xc6\x7f\x6d\x8d\x87\x91\x0e\x0f\x78\xe3\x0d\xf1\x0f\x75\xf0\xa6\xd1\x41\x15\xe6\x2b\x55\x54\x39\xab\xda\xff\xe4\x7a\xae\x2f\xd7\x9a\x02\x37\x74\x23\x77\xf8\x75\x61\x9f\xb6\xf1\x6f\x01\x7a\x31\x13\x34\x94\xda\x31\xf6\xde\x9e\xa0\x49\x01\x3f\x94\x72\xb6\x65\x54\x28\xcd\x87\x88\x75\x28\x7b\x1a\x4a\x75\xc6\x4c\xea\x93\xc5\xee\x72\x01\xfe\xfc\xf7\x2f\xbe\xda\x4a\x32\x87\x5a\x90\x1e\x51\x7f\xd6\x96\xff\x0e\x7c\x07\x0c\x14\x95\x70\x4f\x6a\x41\x67\x3e\x69\x09\x99\x0f\xfc\xf2\x3a\xdd\x2d\x56\xaf\xdf\x83\x55\x48\xd2\x02\xad\x42\xdb\xc7\xc9\x6a\xbd\x9b\x4d\x56\x39\x44\x5b\x68\x47\x9a\xce\x02\x28\x12\xd6\x9a\xfe\xef\x5a\xed\x03\x24\x35\xb8\xd2\xf6\x7f\x57\x9a\x1f\x9c\xa3\x02\xb8\x16\xbd\x96\x7c\x65\xdb\x8d\x11\x0e\x13\x1a\x4e\xb8\x29\x36\xf3\xf1\xdf\x3f\x35\x6c\x46\x6e\x5e\x90\xd9\xee\x79\xba\x95\x9b\x19\xae\x14\x8a\x6a\x3b\x92\xb8\x31\x9a\xd4\xa6\x04\x49\x35\x90\xae\xe8\xfd\x4a\x26\x0b\x14\xdf\x8f\xc1\xaa\x1d\x2c\x8d\xb9\xc2\xf7\x59\x0a\xb6\x07\x89\xa8\x66\xfc\x10\xe3\x05\x2c\xa6\xf1\xa2\x04\x89\x06\xdc\x84\xad\xdb\x4a\xf0\x54\x5b\xae\x83\x98\x6e\xf7\x1b\xb5\xea\x2f\x9e\xbf\x2f\xa3\xfe\xe2\xf9\xaf\x6c\xd3\x5f\x3c\x7f\x3f\x26\x0d\xd9\xb8\x69\xd1\xeb\xcd\x35\x18\xf4\x7a\x73\x65\x7b\xfe\xe5\x40\x7b\xfe\xe5\x37\x6d\xcf\xdf\xbe\x3f\x83\xfe\xf6\x57\xb7\xe8\x6f\xdf\x97\x49\xbf\xe9\xd8\xf4\x9b\x6b\x31\xea\x37\xd7\x60\xd5\xdf\x1d\x68\xd5\xdf\xfd\x26\xad\x5a\xee\x2a\x35\xed\x79\xa5\xde\x60\xea\x85\x36\x7c\x7b\x79\xf5\xe9\xcf\x4a\x5a\xb2\xfa\xe6\xac\xcb\x9a\xc2\x16\x89\xdf\xb4\x09\x4b\x22\xef\xc7\x88\x25\xe9\x96\x19\xcb\x27\xcf\x66\xde\xf0\xe6\x83\x11\xeb\x55\x8d\x4d\x03\x5e\x8d\x59\x2f\x2e\x05\xad\x9c\x8f\x70\x3f\x3f\xcd\xc6\x67\x8d\x91\x7f\xf4\x91\xb3\x92\x5b\x3f\x6b\xf3\x55\x67\x05\x3c\xe7\x1e\xfc\x30\x62\x85\x3d\x86\x8f\xb8\x46\xcc\x76\x70\xad\x68\x9f\x41\x13\x25\x74\xed\x1e\x76\x8d\xc2\xca\xd0\xfe\x22\x24\xff\xfb\xf1\x45\xb9\x81\xe0\x83\x33\x5e\xb3\x33\x3e\x9f\x4d\x5f\x9f\xa8\x7c\xf1\xf9\x0d\xf8\xa2\xb2\xd8\xb6\xdf\x19\xae\x58\x79\x27\xd1\x15\xf7\x46\x2f\x89\xee\x8a\xde\x68\xf8\x77\x8d\x93\xe6\x8e\x9a\x9d\xdf\x89\x3f\x3e\x5f\xef\x80\xc0\xfb\x1a\x1c\x37\x92\xfa\x4d\x3b\xa4\xa4\xf2\x7e\x1c\x52\x75\xd0\x74\x48\x78\x72\xe8\xd8\x88\x4d\xc9\xde\xa8\x25\x24\x2d\x58\x8f\xc8\xab\x3b\x38\x6d\x6a\x9e\x3c\x9f\x45\x81\xcd\xc1\x6e\xc6\xaa\x6e\x38\xcc\xff\x31\xcd\xea\xc0\x28\x8f\x2d\x0f\xb7\xaa\xe7\x7b\x56\xf5\xec\xc6\xac\xea\xe3\xa2\x78\x5f\xb3\xd2\xb4\x28\x7e\xe5\x59\x29\x50\x7c\x4f\x6b\x87\x45\x67\xed\xb0\xb8\x96\xb5\xc3\x62\xf2\xda\x61\x37\x4b\xb8\x5b\x4f\x2e\xe5\xb9\xc2\xf1\x51\x38\x4f\x37\x1b\x7c\x83\x38\xab\xf2\x0b\xf7\x0e\xa4\x26\xed\x94\x03\x1e\xfd\xcd\x61\xce\x03\x1b\xae\xfd\xa9\xc3\xdd\x66\x7e\x0c\x9f\x25\xad\x8a\xa7\x9b\x18\xd9\xf7\x7f\x99\xb8\xcb\xe4\xe3\x95\x93\xca\x57\xaa\x10\x24\x8d\x97\x7e\xbd\x6f\xd8\x06\xb6\x92\x9c\x6f\xd6\xe7\x62\xb3\x7b\xeb\xfc\x2c\x5f\xc5\x2a\x3f\xd9\x4a\x47\xa9\x51\xef\xbd\x33\xd3\x26\xdd\x4f\xc7\xc4\x59\x45\xf4\xed\xe2\xf5\x27\x6f\x71\xe4\x68\x07\x76\x78\xbc\x5a\x94\x8b\x3c\x5d\xed\x9c\x4c\xfe\xbe\x58\x19\x91\x40\x32\x70\xc0\xab\xd6\xe6\x35\x73\xc5\x70\xf5\xe4\x06\x5f\xba\x8e\x73\x3c\xa4\x81\x4a\x62\x2a\x58\xb5\x54\x30\xfb\xe2\x1c\x9d\x2a\x5d\xde\x69\xe9\x79\x92\x32\xda\xe8\xf7\xb4\x61\x90\x20\x29\x46\x0c\x9b\x97\xa5\x83\x57\x8d\x5e\x97\xa6\x5a\xbb\x12\x68\xed\x3d\x1c\x8e\x62\xd7\x48\xe4\xe5\x28\x2e\xfc\x9b\xfa\x9e\x79\xe8\xc0\x30\x09\x23\xe3\x2c\x61\x29\xcb\xe4\xf5\x1f\x39\x2b\x98\x60\x65\xcf\xe6\x14\x03\xe1\xab\x0f\x62\x22\x89\xe9\xd8\x61\xee\x95\xf7\x00\x48\xa7\x39\xae\xdd\x6e\x74\x27\xc0\xa5\xde\x91\x2c\x07\xa5\xea\x8b\xfa\xf7\x97\x5f\x6c\x17\x33\xe0\x54\xbd\x76\xee\x3f\x3d\x74\xc6\x77\x09\x99\x7f\x2a\xcf\xab\x9a\x3e\xac\x79\x1d\x3b\xb9\x35\x3a\xdf\x1e\x42\xac\x02\xc8\x52\xac\x5e\x43\xd6\xf5\x67\x87\x8f\x1f\x0d\xeb\x7d\x7c\x50\x92\x79\xba\xc6\x43\xdb\xd5\x12\x95\x31\x58\xea\xc8\x8a\xc9\x60\x75\x37\xc0\x95\x42\x79\x95\x0d\xd6\x76\xd8\xda\xea\xf4\x0e\x02\x6d\x6b\x78\x3b\xda\x3a\x45\xba\x4b\x9d\x74\x7b\x4d\xf4\xaf\xfc\xf6\xa2\xbd\x55\xeb\x8d\x31\xa4\x9e\xec\xd6\xdf\xfa\xde\xf4\x0d\x12\xb2\xd9\x0d\x6c\xf7\xd7\xbc\xb5\xdd\xc5\xb2\xe9\xbf\x6a\xf3\x4c\x59\x60\xdd\xc1\xca\x26\xad\x07\x00\x94\x1d\x59\x88\x28\x19\x1e\x74\xbc\xbf\xcd\x20\xfd\xb6\x9d\x66\x8b\x53\x85\xe1\xe5\xe8\x21\x7f\xfc\xab\xf8\x3c\x39\xbf\xd8\xce\x67\xd5\xcc\x00\x73\x57\xdb\xad\x0c\xfd\x2d\x47\xf2\x5d\xfc\x1b\x3d\xeb\x59\xe5\xd1\x86\xf9\x55\x91\xbb\x22\x76\xdc\x8e\x26\xd3\xb7\x8e\x8f\x06\x08\x49\x0b\xbd\x30\x5f\x9f\xcb\x4c\x8b\x90\x88\xca\xa6\xe4\x99\x61\x1d\x01\x84\x93\x2f\xd7\xab\x43\x56\x32\xae\xea\xdd\x92\x6e\xd7\xad\xe5\xc3\xe9\x6e\x2d\x9b\x91\xdd\xda\xa4\x2e\xd3\x70\x25\x82\xfa\x90\xa7\xf5\x54\xe7\xa9\x6c\x7c\x4b\x3a\xf9\x2d\xa5\x92\x71\x6a\xd5\xe8\xa9\x08\xed\x0f\xac\xd5\x19\x1b\x22\x65\x79\xbd\x4c\xb5\x99\x53\xe5\xdc\x04\xfa\x75\x9c\xb9\x6c\x07\x99\xa1\x86\x7d\x21\xe1\x72\x6a\x3c\xb8\xd4\xee\x5f\x91\x85\x8f\x36\x25\x3b\x34\xf7\x94\x58\x86\x37\x1d\xee\x3d\x3a\x6c\x0f\xbe\x65\xe7\x7d\xb5\xc9\xbe\xd9\x33\x1f\x4e\xdf\x33\x7f\xff\xcf\x7f\x72\xe6\xbb\xdd\xf9\xf6\xc1\xfd\xfb\x67\xbb\xf9\xf6\x24\x13\xf7\x2f\x76\x25\xff\xc7\xd6\xf9\xc9\x3b\x61\x27\x1e\x4c\x7c\x9c\x7f\x3b\x4b\xc1\x5e\x60\x6c\x36\x7d\xa0\xbb\xdb\xbe\x67\xa7\x36\x18\xcd\xa7\x62\xa7\x6e\x5e\x12\x02\xad\x61\x91\x66\x4b\x88\x2e\x3f\x68\x56\x7e\x68\xc3\xcb\xdb\x04\x00\xf2\x51\xbd\x79\x7e\x6f\x93\xbe\x73\xa4\x6c\xee\x08\xb7\xc1\xeb\xc7\x7b\x86\x3b\x40\xd7\xf9\x41\x49\x62\x80\xea\xe7\xf2\xc7\x86\xe8\x99\xfe\xde\xa6\xa9\x9f\xde\xbe\xbd\xaf\xe6\xee\x21\x80\x87\xad\xce\xd4\x6d\xe9\xec\xbe\x96\x1b\xed\x7f\x38\x56\x87\xfe\xff\xbe\x2e\xc4\x09\xe8\x05\x5c\xe3\x13\xb5\xf3\x1e\xe6\xa6\x30\xe5\xce\xe1\xf9\xf1\x1e\x46\x3c\xb0\x7c\x81\xc7\x09\x76\x98\x54\xfd\x80\x1a\x1a\xe8\xb7\xde\xce\x5f\xf7\xfb\xb5\xfe\xde\xee\xb7\x7a\xda\xb1\x63\xcc\xb4\x1b\x14\x27\x75\xcb\x87\x26\x62\x48\xd8\x0d\x98\xcb\xc5\xaa\xc0\xa5\xaf\x16\x4c\xaf\x2b\x23\xcb\x8e\x09\xb5\x7f\x7e\xa0\xd3\xeb\x3f\xdf\xbb\xb6\x3f\xb4\xf4\x7d\x71\x6d\x77\x9b\xc5\xea\xf5\x63\xd0\xc6\xe9\x3c\xdd\x9c\x82\xe0\x81\xc1\x17\xf2\xe1\x49\x69\x3c\xed\x53\xf1\x57\x29\x5e\x98\x25\x35\xd9\x75\xb8\xf3\x8b\xd5\x5b\xd4\x62\xbb\x4d\xed\x5e\x17\xf9\xd6\x2b\x04\x02\xcc\x14\x03\xbd\xe2\x92\xdb\xc8\xe4\x71\xff\xa1\x7c\x4b\x8e\x3d\xeb\x0b\x3c\x69\x35\xf0\x0a\x4f\x2e\x4c\x56\xa1\x5a\xd1\x1a\x8e\xd5\x08\x2c\x2f\xfe\x1a\xf8\x4d\xbc\xd9\x6d\xd2\x9e\xdf\x2e\xe7\x0b\x30\xee\x59\xc5\xc9\x47\x9a\xe2\xc8\x61\x71\xa0\xd1\xf0\x93\x6b\x19\x7f\xbc\xab\x50\xc0\x50\x30\x10\x8b\xd1\x3e\x55\xf3\xbf\x42\x87\xdf\x7c\xca\x5d\x17\x6d\x59\x3d\xfa\x48\x3e\xfa\xe4\xf1\x63\x7c\x44\xe6\x06\xff\x40\x9b\x72\x71\x74\x7b\xb1\xd9\xac\x71\xf3\xf4\xb1\x74\x37\x5c\x44\x16\xf2\xce\x35\x67\x85\xa7\xc0\x90\xd3\x34\x07\xac\xc3\x98\xa4\x8c\x0e\xeb\x5b\xd5\xbf\x99\xc2\x71\x1b\x3a\xf3\xf8\xd4\x75\xef\xa0\xe3\x42\xbf\xe4\xc7\x9f\x91\x55\x5c\x10\xae\x39\x1d\x1f\x31\x95\xfd\xa8\x6c\x76\xa6\x25\x87\x88\xfd\xc7\x8f\xef\xc8\x4b\xd4\x5c\xbc\xca\xce\xa0\x28\x7f\xb8\xeb\xe8\x7a\x08\xa3\xc3\x2a\x69\x9e\x0c\xec\x5e\xac\x60\xb4\xc9\xe7\x10\xd8\x6a\xa6\xff\xe2\xac\x57\xcb\xb7\x78\x95\x9d\x90\x62\x06\x11\xa3\x37\x00\xe8\x62\x77\x8c\x6b\x72\x39\x1e\xc0\xc1\x85\x39\x94\xbb\x95\x42\xdd\x16\x75\xb5\xab\xce\x74\xd4\xd4\x30\xe9\x4d\x8d\xaf\xe7\xe9\x62\x44\x83\x5d\xb1\x49\xa1\xd9\xe6\x0d\x5a\xb9\xf7\xee\x1d\x30\xef\xb7\xca\x91\xcc\x4d\x5f\xa6\xd2\x13\x86\x55\x52\xa2\xb0\x5a\x43\xf0\x55\xe3\x9c\x58\xc9\x38\x27\xf3\xda\xc1\x30\x57\x87\x28\x35\x23\x19\x8d\x50\x30\xdc\x88\x37\x00\x7a\xaf\xef\x36\x54\x5b\x08\xab\xe3\xe9\xd1\xd1\x70\x20\xbb\x7b\x57\xd1\x20\x07\x32\xc9\xf5\x4b\xd9\x68\x68\x6a\x6a\x04\x2e\xf4\xeb\xc7\xe8\x66\xa3\xb3\x0a\x04\xbd\xf7\xb0\x72\xc4\x11\xb3\xd2\x5d\xba\xfb\xb0\x67\x30\xab\x48\xfe\xf5\xaf\xe0\xe9\x95\x7f\xe3\x9b\x1f\x19\x39\xc7\x8c\xba\xea\x9a\x0a\x3c\xd0\xa4\x15\x3b\xe8\xf6\x47\xe3\xb0\x8f\x93\xab\x5a\x6e\x6d\x85\x10\x7a\xf2\x1f\x5f\xc0\x84\x2c\xdd\xfc\x5f\x24\x36\x43\x83\xfc\x72\xbd\x58\xf5\x1f\x2e\x47\x4d\xd5\x10\xed\x61\xa6\x79\xac\x86\x9a\x31\x2d\xee\xe6\x90\xd2\x39\x8f\x20\xe6\x6c\x66\xc3\x62\x3e\x7a\x86\x53\xb0\x26\x34\x7d\x7d\xf7\x08\xdf\xd0\x55\x74\x4e\x76\x6b\x95\x8c\xcc\x58\x74\x07\xbe\x7c\x0d\x01\x73\x73\x0a\xb1\x11\xe6\xa4\x77\x47\xb0\x62\x1c\x5c\x41\xb2\x95\xca\x1b\x3c\x2b\x9f\x38\xea\x6f\x41\x10\xfe\xaf\x9c\x99\x35\xaa\x93\x8b\x17\xb8\x2a\xd2\xa8\xe4\x58\x6d\xf4\xea\xcf\x30\x95\x81\xf4\x98\xd9\xcc\xd4\xe9\x5f\x2b\x14\xca\x9c\xe5\xbb\xd0\x37\x7c\xcf\x1f\x86\x4d\x4a\x05\xb5\xd3\x0a\x23\xc1\xa2\x0c\xf2\xb7\x75\x00\x78\xfc\x98\xeb\xc1\x5d\x8f\xeb\xec\x1e\xbe\x93\x72\xb6\x30\xcd\x03\x02\x03\x23\xfb\x70\x17\x1b\x26\x68\xde\x24\xf3\xdf\xb7\x67\xd9\x7a\x39\x14\x0f\x87\x18\xe7\x6e\x9b\x71\x8f\xc2\x78\x4d\xca\xae\x9d\x48\x69\x86\x69\xcd\x9c\xf6\x46\x2a\xe3\x74\x75\x0f\x8f\x32\x7b\x31\x79\xf4\x29\x3c\x8e\x84\x8a\x81\x90\x37\xa1\x57\xcc\x53\xdd\x72\x75\xb7\x1e\x0d\x06\x60\x8d\x14\x02\x66\xbf\x03\xf4\x5e\x2e\x3c\x26\x8f\x47\xee\x9e\x3c\x82\x6b\xd6\x19\xe3\xba\x77\xb1\xea\xdd\xe3\x83\x7b\xc7\x06\xb7\x2c\x1e\x26\x97\xfd\x47\x0d\x9e\xbe\x7e\xb5\x85\x37\x1c\x1e\xf0\xaf\xf2\x46\x89\x90\x1c\x3e\x70\x3d\x46\xe7\x45\x96\xf9\x5f\xcd\x0b\xae\x9d\xec\x4f\x1b\x6d\x33\xbe\xa6\xf9\x15\x73\xaa\x1a\xd1\xc0\xef\x68\x4b\x6a\xa4\xba\xae\xdc\xaa\xd1\x82\xd9\x8d\xf1\x24\xcb\xe0\x02\x74\x3b\x1c\xa4\x27\x65\x1b\x0d\xd2\xf7\xbb\x5c\x51\x5b\x0f\x98\x7e\x71\xba\x5e\xed\x16\xab\x0b\x79\xe3\xa2\xf4\x82\xc1\x81\x07\xd9\xff\x4c\x8a\x1c\x52\x19\xfc\x72\x8a\x53\x15\x52\xda\x72\xf4\xd9\x0a\xf2\x86\x45\x21\x9b\x29\x1b\x39\x1a\x90\xdd\x90\xd5\xb4\xb9\x74\x14\x07\x72\x75\xfe\x65\xcd\xd8\x2b\x1d\xa4\x7a\x30\xd7\x30\x77\xef\xf6\x2e\x66\xeb\x60\xd7\xa1\x72\x5b\x8d\x19\x6a\xde\x8c\x63\xec\x40\x5f\xab\xb5\xdf\xbe\xf6\x7e\x1f\x3b\xfd\xf7\xc7\xdd\x77\x3e\x2b\x9d\x4b\xe1\xe0\x54\xf6\xe2\xdc\xc1\x35\x03\x98\xc3\xee\xfe\xdf\xff\xfc\x6f\x95\x84\x99\x14\x64\x9f\xf6\xb1\xf4\xca\x7d\xaf\xdd\x9e\xf8\x87\x63\x8c\x0a\x14\x2f\x64\x60\xea\xb7\x8e\xca\x6f\x87\x7c\x1e\x7f\xf3\x46\x7e\xf3\x47\x7e\x0b\x08\x71\xc4\x6a\xaf\xbf\xa6\xb9\xb6\x49\x3f\xa4\xb8\x8a\x36\x9f\x32\x5d\xf6\xde\x0f\x32\x64\x2c\xcf\xc1\x7d\x9d\x72\xb1\xd9\xee\x06\x4c\x41\xea\xe4\xda\x5d\x65\x3f\xbf\x74\x66\xab\xf5\xbe\x89\x6d\x7b\x76\x8e\x48\x37\x53\x6c\xdd\xd6\x2e\xa5\x33\x89\x51\xc9\x0c\x19\xd7\x90\x64\xac\x69\x64\x87\x8f\x47\x95\x8f\x9f\x0e\xb3\x22\x8d\x18\x2f\xfa\xed\x0d\x9a\x03\xe3\x88\x39\xf6\x98\x04\x99\x5a\x34\x93\x85\x22\x86\xbc\xa3\xe2\xb3\x33\x85\x1c\x09\x43\x86\xc4\xc6\x86\x59\x29\x39\xeb\x5a\xd1\x81\x71\xa4\x26\x40\xd7\x96\xdf\xb5\xa6\xb3\xf4\x2d\x78\x5d\xbe\xbc\x90\x8b\x71\xb8\xc8\x66\x2e\xf9\x11\xec\xea\x71\xa5\xcf\x47\xd7\xac\x4f\x19\xad\xae\xcb\x08\x5c\xbd\x72\xea\xc9\x13\x40\x8a\x1f\xd3\x28\xfa\xc2\x62\xd5\xd9\x8e\x51\xb8\x72\x22\x35\xa2\xcd\xe9\xf3\x11\xfc\xfb\x1d\x1a\x93\x75\x36\xd2\x35\x15\xae\x4d\xe5\xf1\x6f\xc1\x54\xe4\x88\x77\x6d\xf6\x15\x4b\xfb\xc2\xa2\x94\x6d\x0b\x83\x9f\x4e\xe1\xc9\xb0\xba\x66\x8a\x79\x05\x5a\xdb\x63\xdf\x50\x5c\x49\xb4\x6b\x8f\x72\xad\xb1\x67\xa5\x8b\xb9\xd6\x15\x4b\xaa\xd1\x11\x6d\xa2\xd7\xf4\xbe\xfe\xea\xf1\x3d\x0e\x99\x0d\xbe\x5a\x15\x85\x3d\x19\xaa\xb2\x91\xea\x36\xae\xbe\xdf\xe4\x18\x3f\xf0\x9b\x1c\x4f\xf7\x46\xd3\xd6\x34\x4e\xcf\xc7\x9a\x49\x42\xaf\x90\x6a\x26\xda\xb3\x38\xa3\xd5\xc0\x88\x2e\xb9\x33\x73\x81\xe1\x79\x9c\x91\xbd\x0c\xbe\x0a\x6c\x4d\x27\x07\x5f\x29\xee\xce\xce\x87\x27\x70\x33\xf8\x15\x1a\xb7\xd3\xcb\x3b\xce\x9f\xf0\xf5\x2f\x66\x42\xf6\x09\x9d\xde\x5c\x05\x78\xa6\x4d\xc4\x8c\xb7\x09\x0d\x2e\xab\x0d\xdc\xfc\x3b\x64\x34\x03\xbc\x77\x6d\x9f\xf1\x23\xac\xa7\x03\x96\x72\xf4\xc0\x39\x92\x7b\x2f\x8e\x7a\xf6\xf9\x1e\xa9\x3e\x01\x4c\xb3\x2c\xd0\x07\xa6\x24\xae\xc1\xd4\x97\x4e\xd7\xfb\x5e\x52\xbf\x58\x9f\x09\xbc\x3b\xd0\xc9\x2e\x16\xcb\xc2\x59\x9f\xef\x16\x67\x8b\xff\x02\xb6\x8e\x9d\xe5\xe2\x47\xe1\x6c\x4e\xfe\x01\x1f\xe5\x38\x23\xf7\xe8\x6c\xcf\x45\x8e\x3b\xe4\x31\xcc\x17\x0b\x69\xe6\xe7\xe9\x6e\x27\x36\xab\xed\x1e\x6e\x89\x00\x5f\xb7\x95\x6b\x2c\xf7\x04\x66\xfc\xa0\x0d\x83\x21\xa6\xc7\xb5\x3b\x77\x21\x3a\x47\x95\x47\x1d\xf5\xee\xc2\x68\xc1\xe3\x15\x89\x9d\x9d\x0c\xfb\x2d\x1a\xd0\xf6\x6f\xbd\xb6\xa9\xef\x50\x24\x6c\xf7\xaa\xec\x10\xe4\xdf\x67\xb8\x23\xd7\x3f\x76\xb6\x8d\xfc\xc9\xf8\x7e\xb2\x02\x45\x7e\x05\x5d\x1c\x9c\xc1\x37\x5b\x59\xf4\xd2\xdd\x62\x65\x6e\x1d\xc1\xdb\x40\xd6\xff\xf1\xc2\xf9\xc9\x3d\xe1\x27\xee\xc0\x5b\x81\x06\x87\x71\xa5\xe5\x50\x47\x74\x5e\x50\x91\x4a\x37\x97\xf3\x74\xd9\x21\x15\x9f\xb8\xf7\xfa\x49\xc9\xd7\x6c\xea\x68\x98\xbe\x8c\x70\x08\x6a\x9e\x6e\xbf\xb8\x5c\x7d\x59\x9d\xaa\x79\xa8\x9b\x9d\xb4\x9f\x0f\x20\xa8\x37\x94\xc9\xb2\x68\x4a\x2b\xa3\x03\x54\x1b\xab\xda\xa0\x87\x8d\xe4\x95\xad\x77\x50\x2d\xa6\x9a\xb0\x32\xe3\x2b\x2d\x23\xf9\xf9\xf0\x77\xae\x1d\x79\x3e\x9f\x2f\x60\xde\x05\xdc\xa7\xce\xa5\xc8\x1c\x7d\xe7\x66\x4f\xd4\xc3\xab\x36\x75\x6c\xe9\xd1\x54\x2b\xd0\xa9\xeb\x36\xef\x34\x3b\xfe\x70\x4b\x59\xb3\x8d\x2c\x9a\xbe\x8d\x6c\xef\xfe\xd3\x6f\x3f\x7f\xf6\x64\xb7\x3b\x7f\x8e\x19\xda\x76\xd7\x4f\xe8\x56\xb6\x78\xad\x0e\xdb\x80\x6d\xde\x9a\x4e\xf4\x08\xf7\x37\xe1\xd2\x26\x38\x76\x2b\x98\xed\x71\xf3\xc9\xe2\xf5\xdf\x25\xa1\xbf\xa0\x54\xff\xb1\x9d\x63\xaa\x02\xc4\xd7\x1b\xf1\x60\x09\xee\xdc\x6c\xc5\x6b\x33\x08\x12\xf7\x0f\x60\x0c\xed\xec\x1b\x68\x2a\x73\x3c\x75\xeb\xeb\xd1\xc9\xfd\xe5\x22\xbb\x8f\x08\x8f\x94\xdc\x81\x91\x02\x92\x3f\x67\x0d\x51\x7f\xb3\x80\x49\x90\xde\x3d\x55\x6d\xff\x6a\xd0\x19\x37\xc2\xea\xdd\x53\x38\x7c\x1e\xd5\xc7\x1a\xe4\xfe\xac\x16\xc0\x89\xa2\xde\x86\xda\x33\xf7\x16\xac\x64\xd8\x30\x9a\x77\xa3\x02\xd5\xc0\x8d\xcc\x6e\x35\xfd\x03\x81\x79\xde\xbb\x57\xff\xfa\x2f\x52\x96\xc7\xce\x4b\x25\xc6\x57\x77\xb0\xcf\xff\xc7\xd9\xae\x2f\x36\xb9\xf8\x3c\x3d\x3f\x87\xf0\xf0\xf5\xf3\x67\x0f\xf1\xd7\x7b\x4b\x79\x66\xfa\x1f\xdb\x93\xb3\xf4\xfc\x5f\xff\xe5\xff\x03\x5d\x17\xbe\xea\xd4\x22\x08\x00")
 
 func web3JsBytes() ([]byte, error) {
 	return bindataRead(
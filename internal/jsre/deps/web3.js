@@ -3788,11 +3788,11 @@ require = (function e(t, n, r) {
         };
 
         var isPredefinedBlockNumber = function (blockNumber) {
-            return blockNumber === 'latest' || blockNumber === 'pending' || blockNumber === 'earliest';
+            return blockNumber === 'latest' || blockNumber === 'pending' || blockNumber === 'earliest' || blockNumber === 'finalized' || blockNumber === 'safe';
         };
 
         var isPredefinedNoPendingNumber = function (blockNumber) {
-            return blockNumber === 'latest' || blockNumber === 'earliest';
+            return blockNumber === 'latest' || blockNumber === 'earliest' || blockNumber === 'finalized' || blockNumber === 'safe';
         };
 
         var inputDefaultBlockNumberFormatter = function (blockNumber) {
@@ -34,14 +34,17 @@ import (
 	"git.taiyue.io/pist/go-pist/common/math"
 	"git.taiyue.io/pist/go-pist/core"
 	"git.taiyue.io/pist/go-pist/core/rawdb"
+	"git.taiyue.io/pist/go-pist/core/state"
 	"git.taiyue.io/pist/go-pist/core/types"
 	"git.taiyue.io/pist/go-pist/core/vm"
 	"git.taiyue.io/pist/go-pist/crypto"
+	"git.taiyue.io/pist/go-pist/light"
 	"git.taiyue.io/pist/go-pist/log"
 	"git.taiyue.io/pist/go-pist/p2p"
 	"git.taiyue.io/pist/go-pist/params"
 	"git.taiyue.io/pist/go-pist/rlp"
 	"git.taiyue.io/pist/go-pist/rpc"
+	"git.taiyue.io/pist/go-pist/signer/core/apitypes"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/util"
@@ -49,6 +52,10 @@ import (
 
 const (
 	defaultGasPrice = 10 * params.Shannon
+
+	// maxFinalityProofBlocks is the number of blocks of an epoch walked at most
+	// for the switch infos of a finality proof.
+	maxFinalityProofBlocks = 2048
 )
 
 var (
@@ -624,6 +631,231 @@ func (s *PublicBlockChainAPI) GetStorageAt(ctx context.Context, address common.A
 	return res[:], state.Error()
 }
 
+// AccountResult is the result of GetProof, the proofs are the trie nodes on the
+// path from the state root of the block to the value.
+type AccountResult struct {
+	Address      common.Address  `json:"address"`
+	AccountProof []string        `json:"accountProof"`
+	Balance      *hexutil.Big    `json:"balance"`
+	CodeHash     common.Hash     `json:"codeHash"`
+	Nonce        hexutil.Uint64  `json:"nonce"`
+	StorageHash  common.Hash     `json:"storageHash"`
+	StorageProof []StorageResult `json:"storageProof"`
+
+	// The staking records of the account are proven against the storage root
+	// of the staking address, which is proven by StakingAccountProof.
+	StakingAccountProof []string        `json:"stakingAccountProof"`
+	StakingStorageHash  common.Hash     `json:"stakingStorageHash"`
+	LockedProof         StorageResult   `json:"lockedProof"`
+	StakingProof        []StakingResult `json:"stakingProof"`
+}
+
+// StorageResult is the proof of a storage slot.
+type StorageResult struct {
+	Key   string       `json:"key"`
+	Value *hexutil.Big `json:"value"`
+	Proof []string     `json:"proof"`
+}
+
+// StakingResult is the proof of a POS slot of the staking address, the value is
+// the RLP encoded record.
+type StakingResult struct {
+	Key   common.Hash   `json:"key"`
+	Value hexutil.Bytes `json:"value"`
+	Proof []string      `json:"proof"`
+}
+
+// GetProof returns the Merkle proofs of the account, of the given storage keys
+// and of the staking records of the account against the state root of the
+// block.
+func (s *PublicBlockChainAPI) GetProof(ctx context.Context, address common.Address, storageKeys []string, blockNr rpc.BlockNumber) (*AccountResult, error) {
+	statedb, _, err := s.b.StateAndHeaderByNumber(ctx, blockNr)
+	if statedb == nil || err != nil {
+		return nil, err
+	}
+	accountProof, err := statedb.GetProof(address)
+	if err != nil {
+		return nil, err
+	}
+	storageHash := types.EmptyRootHash
+	if storageTrie := statedb.StorageTrie(address); storageTrie != nil {
+		storageHash = storageTrie.Hash()
+	}
+	storageProof := make([]StorageResult, len(storageKeys))
+	for i, key := range storageKeys {
+		if storageHash == types.EmptyRootHash {
+			storageProof[i] = StorageResult{key, &hexutil.Big{}, []string{}}
+			continue
+		}
+		proof, err := statedb.GetStorageProof(address, common.HexToHash(key))
+		if err != nil {
+			return nil, err
+		}
+		value := statedb.GetState(address, common.HexToHash(key)).Big()
+		storageProof[i] = StorageResult{key, (*hexutil.Big)(value), toHexSlice(proof)}
+	}
+
+	stakingAccountProof, err := statedb.GetProof(types.StakingAddress)
+	if err != nil {
+		return nil, err
+	}
+	stakingHash := types.EmptyRootHash
+	if stakingTrie := statedb.StorageTrie(types.StakingAddress); stakingTrie != nil {
+		stakingHash = stakingTrie.Hash()
+	}
+	result := &AccountResult{
+		Address:             address,
+		AccountProof:        toHexSlice(accountProof),
+		Balance:             (*hexutil.Big)(statedb.GetBalance(address)),
+		CodeHash:            statedb.GetCodeHash(address),
+		Nonce:               hexutil.Uint64(statedb.GetNonce(address)),
+		StorageHash:         storageHash,
+		StorageProof:        storageProof,
+		StakingAccountProof: toHexSlice(stakingAccountProof),
+		StakingStorageHash:  stakingHash,
+		LockedProof:         StorageResult{Value: &hexutil.Big{}, Proof: []string{}},
+		StakingProof:        []StakingResult{},
+	}
+	if stakingHash == types.EmptyRootHash {
+		return result, statedb.Error()
+	}
+	lockedKey := state.LockedKey(address)
+	proof, err := statedb.GetStorageProof(types.StakingAddress, lockedKey)
+	if err != nil {
+		return nil, err
+	}
+	result.LockedProof = StorageResult{lockedKey.Hex(), (*hexutil.Big)(statedb.GetPOSLocked(address)), toHexSlice(proof)}
+
+	keys, err := vm.StakingSlotKeys(statedb, types.StakingAddress, address)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		proof, err := statedb.GetStorageProof(types.StakingAddress, key)
+		if err != nil {
+			return nil, err
+		}
+		result.StakingProof = append(result.StakingProof, StakingResult{key, statedb.GetPOSState(types.StakingAddress, key), toHexSlice(proof)})
+	}
+	return result, statedb.Error()
+}
+
+// GetSignedHeader returns the RLP encoded header of the block, with the signs
+// of the committee agreeing on it and its switch infos, for a light client.
+func (s *PublicBlockChainAPI) GetSignedHeader(ctx context.Context, blockNr rpc.BlockNumber) (hexutil.Bytes, error) {
+	block, err := s.b.BlockByNumber(ctx, blockNr)
+	if block == nil || err != nil {
+		return nil, err
+	}
+	return rlp.EncodeToBytes(light.NewSignedHeader(block))
+}
+
+// GetCommitteeProof returns the trie nodes proving the committee elected for the
+// next epoch and the epoch lengths in the state of the block, which must be the
+// last of its epoch.
+func (s *PublicBlockChainAPI) GetCommitteeProof(ctx context.Context, blockNr rpc.BlockNumber) ([]string, error) {
+	statedb, header, err := s.b.StateAndHeaderByNumber(ctx, blockNr)
+	if statedb == nil || err != nil {
+		return nil, err
+	}
+	epoch := types.GetEpochFromHeight(header.Number.Uint64())
+	if header.Number.Uint64() != epoch.EndHeight {
+		return nil, light.ErrNotEpochEnd
+	}
+	nodes, err := light.ProveHandoff(statedb.Database(), header.Root, epoch.EpochID+1)
+	if err != nil {
+		return nil, err
+	}
+	return toHexSlice(nodes), nil
+}
+
+// GetFinalityProof returns what proves the block final offline: its RLP encoded
+// header, the members of the committee in effect at the block with their flags
+// and the signs of the members agreeing on it. The members are the switch infos
+// of the block given by committeeNumber, whose committeeRoot commits to them,
+// updated by the switch infos of the blocks of the epoch listed in switches,
+// each given with its RLP encoded header. final is the check of the node alone,
+// a client verifies the signs itself. The blocks of the epoch are walked for
+// the switch infos, the blocks more than maxFinalityProofBlocks after the
+// start of their epoch are refused.
+func (s *PublicBlockChainAPI) GetFinalityProof(ctx context.Context, blockNr rpc.BlockNumber) (map[string]interface{}, error) {
+	block, err := s.b.BlockByNumber(ctx, blockNr)
+	if block == nil || err != nil {
+		return nil, err
+	}
+	epoch := types.GetEpochFromHeight(block.NumberU64())
+	if distance := block.NumberU64() - epoch.BeginHeight; distance > maxFinalityProofBlocks {
+		return nil, fmt.Errorf("block #%d is %d blocks into its epoch, the proof is served up to %d", block.NumberU64(), distance, maxFinalityProofBlocks)
+	}
+	switchBlock, err := s.b.BlockByNumber(ctx, rpc.BlockNumber(epoch.BeginHeight))
+	if switchBlock == nil || err != nil {
+		return nil, fmt.Errorf("committee block #%d not found", epoch.BeginHeight)
+	}
+	if len(switchBlock.SwitchInfos()) == 0 && epoch.EpochID == types.GetFirstEpoch().EpochID {
+		// the first committee is the one of the genesis block
+		if switchBlock, err = s.b.BlockByNumber(ctx, rpc.EarliestBlockNumber); switchBlock == nil || err != nil {
+			return nil, fmt.Errorf("genesis block not found")
+		}
+	}
+	members := light.ApplySwitches(nil, switchBlock.SwitchInfos())
+	// the switch infos of a block take effect from the next one
+	var switches []map[string]interface{}
+	for n := epoch.BeginHeight + 1; n < block.NumberU64(); n++ {
+		b, err := s.b.BlockByNumber(ctx, rpc.BlockNumber(n))
+		if b == nil || err != nil {
+			return nil, fmt.Errorf("switch block #%d not found", n)
+		}
+		if len(b.SwitchInfos()) == 0 {
+			continue
+		}
+		enc, err := rlp.EncodeToBytes(b.Header())
+		if err != nil {
+			return nil, err
+		}
+		switches = append(switches, map[string]interface{}{
+			"number":      (*hexutil.Big)(b.Number()),
+			"header":      hexutil.Bytes(enc),
+			"switchInfos": rpcMarshalMembers(b.SwitchInfos()),
+		})
+		members = light.ApplySwitches(members, b.SwitchInfos())
+	}
+	var used []*types.CommitteeMember
+	for _, m := range members {
+		if m.Flag == types.StateUsedFlag {
+			used = append(used, m)
+		}
+	}
+	header, err := rlp.EncodeToBytes(block.Header())
+	if err != nil {
+		return nil, err
+	}
+	switchHeader, err := rlp.EncodeToBytes(switchBlock.Header())
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"number":          (*hexutil.Big)(block.Number()),
+		"hash":            block.Hash(),
+		"epoch":           hexutil.Uint64(epoch.EpochID),
+		"header":          hexutil.Bytes(header),
+		"committeeNumber": (*hexutil.Big)(switchBlock.Number()),
+		"committeeHeader": hexutil.Bytes(switchHeader),
+		"switches":        switches,
+		"members":         rpcMarshalMembers(members),
+		"signs":           rpcMarshalSigns(block.Signs()),
+		"final":           light.VerifySigns(block.Header(), block.Signs(), used) == nil,
+	}, nil
+}
+
+// toHexSlice creates a slice of hex-strings based on []byte.
+func toHexSlice(b [][]byte) []string {
+	r := make([]string, len(b))
+	for i := range b {
+		r[i] = hexutil.Encode(b[i])
+	}
+	return r
+}
+
 func newRevertError(result *core.ExecutionResult) *revertError {
 	reason, errUnpack := abi.UnpackRevert(result.Revert())
 	err := errors.New("execution reverted")
@@ -908,45 +1140,9 @@ func RPCMarshalBlock(b *types.Block, inclTx bool, fullTx bool) (map[string]inter
 		"receiptsRoot":     head.ReceiptHash,
 	}
 
-	formatSign := func(sign *types.PbftSign) (map[string]interface{}, error) {
-		signmap := map[string]interface{}{
-			"fastHeight": (*hexutil.Big)(sign.FastHeight),
-			"fastHash":   sign.FastHash,
-			"sign":       hexutil.Bytes(sign.Sign),
-			"result":     sign.Result,
-		}
-		return signmap, nil
-	}
-
-	ss := b.Signs()
-	signs := make([]interface{}, len(ss))
-	var err error
-	for i, sign := range ss {
-		if signs[i], err = formatSign(sign); err != nil {
-			return nil, err
-		}
-	}
-
-	fields["signs"] = signs
-
-	formatMembers := func(commit *types.CommitteeMember) (map[string]interface{}, error) {
-		members := map[string]interface{}{
-			"coinbase":      commit.Coinbase,
-			"committeeBase": commit.CommitteeBase,
-			"publickey":     commit.Publickey,
-			"flag":          commit.Flag,
-			"mType":         commit.MType,
-		}
-		return members, nil
-	}
-	switchInfos := make([]interface{}, len(b.SwitchInfos()))
-	for i, member := range b.SwitchInfos() {
-		if switchInfos[i], err = formatMembers(member); err != nil {
-			return nil, err
-		}
-	}
-
-	fields["switchInfos"] = switchInfos
+	fields["signs"] = rpcMarshalSigns(b.Signs())
+	fields["switchInfos"] = rpcMarshalMembers(b.SwitchInfos())
+	fields["parentSigns"] = rpcMarshalSigns(b.ParentSigns())
 
 	if inclTx {
 		formatTx := func(tx *types.Transaction) (interface{}, error) {
@@ -971,6 +1167,35 @@ func RPCMarshalBlock(b *types.Block, inclTx bool, fullTx bool) (map[string]inter
 	return fields, nil
 }
 
+// rpcMarshalSigns converts the signs of a block to the RPC output.
+func rpcMarshalSigns(ss []*types.PbftSign) []interface{} {
+	signs := make([]interface{}, len(ss))
+	for i, sign := range ss {
+		signs[i] = map[string]interface{}{
+			"fastHeight": (*hexutil.Big)(sign.FastHeight),
+			"fastHash":   sign.FastHash,
+			"sign":       hexutil.Bytes(sign.Sign),
+			"result":     sign.Result,
+		}
+	}
+	return signs
+}
+
+// rpcMarshalMembers converts committee members to the RPC output.
+func rpcMarshalMembers(infos []*types.CommitteeMember) []interface{} {
+	members := make([]interface{}, len(infos))
+	for i, commit := range infos {
+		members[i] = map[string]interface{}{
+			"coinbase":      commit.Coinbase,
+			"committeeBase": commit.CommitteeBase,
+			"publickey":     commit.Publickey,
+			"flag":          commit.Flag,
+			"mType":         commit.MType,
+		}
+	}
+	return members
+}
+
 // rpcOutputBlock uses the generalized output filler.
 func (s *PublicBlockChainAPI) rpcOutputBlock(b *types.Block, inclTx bool, fullTx bool) (map[string]interface{}, error) {
 	fields, err := RPCMarshalBlock(b, inclTx, fullTx)
@@ -992,6 +1217,9 @@ func (s *PublicBlockChainAPI) GetChainRewardContent(blockNr rpc.BlockNumber, add
 			"Number":          hexutil.Uint64(blockNr),
 			"time":            hexutil.Uint64(content.St),
 			"committeeReward": content.CommitteeBase,
+			"fee":             (*hexutil.Big)(content.Fee),
+			"blockReward":     (*hexutil.Big)(content.BlockReward),
+			"shares":          content.Shares,
 		}
 		return fields
 	} else {
@@ -999,6 +1227,7 @@ func (s *PublicBlockChainAPI) GetChainRewardContent(blockNr rpc.BlockNumber, add
 			"Number":        hexutil.Uint64(blockNr),
 			"time":          hexutil.Uint64(content.St),
 			"stakingReward": types.FetchOne(content.CommitteeBase, addr),
+			"shares":        types.FetchShares(content.Shares, addr),
 		}
 		return fields
 	}
@@ -1491,6 +1720,32 @@ func (s *PublicTransactionPoolAPI) Sign(addr common.Address, data hexutil.Bytes)
 	return signature, err
 }
 
+// SignTypedData calculates an ECDSA signature for the EIP-712 typed data:
+// keccak256("\x19\x01" ‖ domainSeparator ‖ hashStruct(message)).
+//
+// The domain has to be bound to the chain ID of the node, and the produced
+// signature has a V value of 27 or 28 like Sign.
+//
+// The account associated with addr must be unlocked, or be on a hardware wallet
+// which prompts the user.
+func (s *PublicTransactionPoolAPI) SignTypedData(addr common.Address, typedData apitypes.TypedData) (hexutil.Bytes, error) {
+	if err := typedData.Domain.VerifyChainID(s.b.ChainConfig().ChainID); err != nil {
+		return nil, err
+	}
+	// Look up the wallet containing the requested signer
+	account := accounts.Account{Address: addr}
+
+	wallet, err := s.b.AccountManager().Find(account)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := wallet.SignTypedData(account, typedData)
+	if err == nil {
+		signature[64] += 27 // Transform V from 0/1 to 27/28 according to the yellow paper
+	}
+	return signature, err
+}
+
 // SignTransactionResult represents a RLP encoded signed transaction.
 type SignTransactionResult struct {
 	Raw hexutil.Bytes      `json:"raw"`
@@ -1749,7 +2004,7 @@ func NewPublicImpawnAPI(b Backend) *PublicImpawnAPI {
 
 // GetAllStakingAccount returns the pendingFruits contained within the snail pool.
 func (s *PublicImpawnAPI) GetAllStakingAccount(ctx context.Context, blockNr rpc.BlockNumber) (map[string]interface{}, error) {
-	state, _, err := s.b.StateAndHeaderByNumber(ctx, blockNr)
+	state, header, err := s.b.StateAndHeaderByNumber(ctx, blockNr)
 	if state == nil || err != nil {
 		return nil, err
 	}
@@ -1760,7 +2015,7 @@ func (s *PublicImpawnAPI) GetAllStakingAccount(ctx context.Context, blockNr rpc.
 		return nil, err
 	}
 
-	return impawn.GetAllStakingAccountRPC(uint64(blockNr)), nil
+	return impawn.GetAllStakingAccountRPC(header.Number.Uint64()), nil
 }
 
 // GetStakingAsset returns the pendingFruits contained within the snail pool.
@@ -1781,7 +2036,7 @@ func (s *PublicImpawnAPI) GetStakingAsset(ctx context.Context, addr common.Addre
 
 // GetLockedAsset returns the pendingFruits contained within the snail pool.
 func (s *PublicImpawnAPI) GetLockedAsset(ctx context.Context, addr common.Address, blockNr rpc.BlockNumber) ([]vm.LockedAsset, error) {
-	state, _, err := s.b.StateAndHeaderByNumber(ctx, blockNr)
+	state, header, err := s.b.StateAndHeaderByNumber(ctx, blockNr)
 	if state == nil || err != nil {
 		return nil, err
 	}
@@ -1792,7 +2047,7 @@ func (s *PublicImpawnAPI) GetLockedAsset(ctx context.Context, addr common.Addres
 		return nil, err
 	}
 
-	return impawn.GetLockedAssetRPC(addr, uint64(blockNr)), nil
+	return impawn.GetLockedAssetRPC(addr, header.Number.Uint64()), nil
 }
 
 // GetAllCancelableAsset returns the pendingFruits contained within the snail pool.
@@ -1813,6 +2068,24 @@ func (s *PublicImpawnAPI) GetAllCancelableAsset(ctx context.Context, addr common
 
 // GetStakingAccount returns the addr staking account.
 func (s *PublicImpawnAPI) GetStakingAccount(ctx context.Context, addr common.Address, blockNr rpc.BlockNumber) (map[string]interface{}, error) {
+	state, header, err := s.b.StateAndHeaderByNumber(ctx, blockNr)
+	if state == nil || err != nil {
+		return nil, err
+	}
+	impawn := vm.NewImpawnImpl()
+	err = impawn.Load(state, types.StakingAddress)
+	if err != nil {
+		log.Error("Staking load error", "error", err)
+		return nil, err
+	}
+
+	return impawn.GetStakingAccountRPC(header.Number.Uint64(), addr), nil
+}
+
+// GetSlashRecords returns the slashing of the validator addr, or of all the
+// staking validators if addr is omitted. Only the records still jailing a validator or
+// within the age of an evidence are kept.
+func (s *PublicImpawnAPI) GetSlashRecords(ctx context.Context, addr *common.Address, blockNr rpc.BlockNumber) ([]map[string]interface{}, error) {
 	state, _, err := s.b.StateAndHeaderByNumber(ctx, blockNr)
 	if state == nil || err != nil {
 		return nil, err
@@ -1824,7 +2097,39 @@ func (s *PublicImpawnAPI) GetStakingAccount(ctx context.Context, addr common.Add
 		return nil, err
 	}
 
-	return impawn.GetStakingAccountRPC(uint64(blockNr), addr), nil
+	return impawn.GetSlashRecordsRPC(addr), nil
+}
+
+// GetGovernanceParams returns the value of the parameters the validators can
+// change through the governance contract active at the block.
+func (s *PublicImpawnAPI) GetGovernanceParams(ctx context.Context, blockNr rpc.BlockNumber) (map[string]interface{}, error) {
+	state, header, err := s.b.StateAndHeaderByNumber(ctx, blockNr)
+	if state == nil || err != nil {
+		return nil, err
+	}
+	gov, err := vm.LoadGovernance(state)
+	if err != nil {
+		log.Error("Governance load error", "error", err)
+		return nil, err
+	}
+
+	return gov.GetParamsRPC(header.Number.Uint64()), nil
+}
+
+// GetProposals returns the governance proposals open for votes and the ones
+// closed recently.
+func (s *PublicImpawnAPI) GetProposals(ctx context.Context, blockNr rpc.BlockNumber) ([]map[string]interface{}, error) {
+	state, _, err := s.b.StateAndHeaderByNumber(ctx, blockNr)
+	if state == nil || err != nil {
+		return nil, err
+	}
+	gov, err := vm.LoadGovernance(state)
+	if err != nil {
+		log.Error("Governance load error", "error", err)
+		return nil, err
+	}
+
+	return gov.GetProposalsRPC(), nil
 }
 func (s *PublicImpawnAPI) GetImpawnSummay(ctx context.Context, blockNr rpc.BlockNumber) (map[string]interface{}, error) {
 	state, _, err := s.b.StateAndHeaderByNumber(ctx, blockNr)
@@ -0,0 +1,94 @@
+package pistapi
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/common/hexutil"
+	"git.taiyue.io/pist/go-pist/core/types"
+	"git.taiyue.io/pist/go-pist/crypto"
+	"git.taiyue.io/pist/go-pist/rpc"
+)
+
+// finalityBackend serves the blocks of a chain by number, the other methods of
+// Backend aren't implemented.
+type finalityBackend struct {
+	Backend
+	blocks map[uint64]*types.Block
+}
+
+func (b *finalityBackend) BlockByNumber(ctx context.Context, nr rpc.BlockNumber) (*types.Block, error) {
+	return b.blocks[uint64(nr)], nil
+}
+
+// signBlock returns the signs of the keys agreeing on block.
+func signBlock(block *types.Block, keys []*ecdsa.PrivateKey) []*types.PbftSign {
+	var signs []*types.PbftSign
+	for _, key := range keys {
+		sign := &types.PbftSign{FastHeight: block.Number(), FastHash: block.Hash(), Result: types.VoteAgree}
+		sign.Sign, _ = crypto.Sign(sign.HashWithNoSign().Bytes(), key)
+		signs = append(signs, sign)
+	}
+	return signs
+}
+
+func TestGetFinalityProof(t *testing.T) {
+	var (
+		keys    []*ecdsa.PrivateKey
+		members []*types.CommitteeMember
+	)
+	for i := 0; i < 4; i++ {
+		key, _ := crypto.GenerateKey()
+		keys = append(keys, key)
+		members = append(members, &types.CommitteeMember{
+			CommitteeBase: crypto.PubkeyToAddress(key.PublicKey),
+			Publickey:     crypto.FromECDSAPub(&key.PublicKey),
+			Flag:          types.StateUsedFlag,
+		})
+	}
+	// The genesis committee loses its last member at block 2
+	backend := &finalityBackend{blocks: map[uint64]*types.Block{
+		0: types.NewBlock(&types.Header{Number: big.NewInt(0)}, nil, nil, nil, members),
+		1: types.NewBlock(&types.Header{Number: big.NewInt(1)}, nil, nil, nil, nil),
+		2: types.NewBlock(&types.Header{Number: big.NewInt(2)}, nil, nil, nil, []*types.CommitteeMember{
+			{CommitteeBase: members[3].CommitteeBase, Publickey: members[3].Publickey, Flag: types.StateRemovedFlag},
+		}),
+	}}
+	api := NewPublicBlockChainAPI(backend)
+	for _, test := range []struct {
+		signers []*ecdsa.PrivateKey
+		final   bool
+	}{
+		{keys[:3], true},
+		{keys[1:], false}, // the sign of the removed member doesn't count
+	} {
+		block := types.NewBlock(&types.Header{Number: big.NewInt(3)}, nil, nil, nil, nil)
+		backend.blocks[3] = block.WithBody(nil, signBlock(block, test.signers), nil, nil)
+
+		proof, err := api.GetFinalityProof(context.Background(), 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hash := proof["hash"].(common.Hash); hash != block.Hash() {
+			t.Fatalf("block mismatch: have %x, want %x", hash, block.Hash())
+		}
+		if number := proof["committeeNumber"].(*hexutil.Big).ToInt(); number.Sign() != 0 {
+			t.Fatalf("committee block mismatch: have %v, want genesis", number)
+		}
+		if switches := proof["switches"].([]map[string]interface{}); len(switches) != 1 {
+			t.Fatalf("switches mismatch: have %d, want 1", len(switches))
+		}
+		if final := proof["final"].(bool); final != test.final {
+			t.Fatalf("finality mismatch: have %v, want %v", final, test.final)
+		}
+	}
+	// The blocks too far into their epoch are refused
+	far := uint64(1 + maxFinalityProofBlocks + 1)
+	backend.blocks[far] = types.NewBlock(&types.Header{Number: new(big.Int).SetUint64(far)}, nil, nil, nil, nil)
+	if _, err := api.GetFinalityProof(context.Background(), rpc.BlockNumber(far)); err == nil {
+		t.Fatal("proof served past the walk limit")
+	}
+}
@@ -406,6 +406,12 @@ web3._extend({
 			params: 2,
 			inputFormatter: [web3._extend.formatters.inputAddressFormatter, null]
 		}),
+		new web3._extend.Method({
+			name: 'signTypedData',
+			call: 'pist_signTypedData',
+			params: 2,
+			inputFormatter: [web3._extend.formatters.inputAddressFormatter, null]
+		}),
 		new web3._extend.Method({
 			name: 'resend',
 			call: 'pist_resend',
@@ -437,6 +443,30 @@ web3._extend({
 			params: 2,
 			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter, web3._extend.utils.toHex]
 		}),
+		new web3._extend.Method({
+			name: 'getProof',
+			call: 'pist_getProof',
+			params: 3,
+			inputFormatter: [web3._extend.formatters.inputAddressFormatter, null, web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'getSignedHeader',
+			call: 'pist_getSignedHeader',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'getFinalityProof',
+			call: 'pist_getFinalityProof',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'getCommitteeProof',
+			call: 'pist_getCommitteeProof',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+		}),
 	],
 	properties: [
 		new web3._extend.Property({
@@ -771,6 +801,43 @@ web3._extend({
 				return infos;
 			}
 		}),
+		new web3._extend.Method({
+			name: 'getSlashRecords',
+			call: 'impawn_getSlashRecords',
+			params: 2,
+			inputFormatter: [null, web3._extend.formatters.inputDefaultBlockNumberFormatter],
+			outputFormatter: function(records) {
+				for (var i = 0; i < records.length; i++) {
+					records[i].amount = web3._extend.utils.toBigNumber(records[i].amount);
+					records[i].reward = web3._extend.utils.toBigNumber(records[i].reward);
+				}
+				return records;
+			}
+		}),
+		new web3._extend.Method({
+			name: 'getGovernanceParams',
+			call: 'impawn_getGovernanceParams',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputDefaultBlockNumberFormatter],
+			outputFormatter: function(params) {
+				for (var name in params) {
+					params[name] = web3._extend.utils.toBigNumber(params[name]);
+				}
+				return params;
+			}
+		}),
+		new web3._extend.Method({
+			name: 'getProposals',
+			call: 'impawn_getProposals',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputDefaultBlockNumberFormatter],
+			outputFormatter: function(proposals) {
+				for (var i = 0; i < proposals.length; i++) {
+					proposals[i].value = web3._extend.utils.toBigNumber(proposals[i].value);
+				}
+				return proposals;
+			}
+		}),
 	]
 });
 `
@@ -0,0 +1,315 @@
+package light
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/core/state"
+	"git.taiyue.io/pist/go-pist/core/types"
+	"git.taiyue.io/pist/go-pist/core/vm"
+	"git.taiyue.io/pist/go-pist/crypto"
+	"git.taiyue.io/pist/go-pist/log"
+)
+
+var (
+	ErrGenesisMismatch  = errors.New("genesis block mismatch")
+	ErrUnknownCommittee = errors.New("committee of the epoch not known yet")
+	ErrInvalidSign      = errors.New("invalid committee sign")
+	ErrNotEnoughSigns   = errors.New("not enough committee signs")
+	ErrNotEpochEnd      = errors.New("committee handed off from a block not ending its epoch")
+	ErrEmptyCommittee   = errors.New("empty elected committee")
+	ErrSwitchInfos      = errors.New("switch infos mismatch the header")
+	ErrIncompleteProof  = errors.New("incomplete state proof")
+)
+
+// SignedHeader is what a light client takes of a block: its header, the signs
+// of the committee agreeing on it, its switch infos and the signs committing
+// its parent, which the committee root commits to along with the infos.
+type SignedHeader struct {
+	Header      *types.Header
+	Signs       []*types.PbftSign
+	Infos       []*types.CommitteeMember
+	ParentSigns []*types.PbftSign `rlp:"tail"`
+}
+
+// NewSignedHeader returns the signed header of block.
+func NewSignedHeader(block *types.Block) *SignedHeader {
+	return &SignedHeader{Header: block.Header(), Signs: block.Signs(), Infos: block.SwitchInfos(), ParentSigns: block.ParentSigns()}
+}
+
+// CommitteeChain follows the committees of the chain from the genesis one. A
+// header is verified by the signatures of the committee of its epoch, and the
+// committee of the next epoch is read from the staking state of the last block
+// of an epoch, proven against the root of its header. Within an epoch the
+// members are switched out and back in by the switch infos of the verified
+// blocks, as they arrive.
+type CommitteeChain struct {
+	lock       sync.RWMutex
+	genesis    common.Hash
+	committees map[uint64][]*types.CommitteeMember // by epoch id, flagged by the switches applied
+	lengths    []types.EpochLength                 // epoch lengths approved by the governance
+	last       uint64                              // last epoch with a known committee
+	head       *types.Header                       // highest verified header
+}
+
+// NewCommitteeChain starts a committee chain from the genesis block, the members
+// in its switch infos are the committee of the first epoch.
+func NewCommitteeChain(genesis *types.Block) *CommitteeChain {
+	first := types.GetFirstEpoch()
+	return &CommitteeChain{
+		genesis:    genesis.Hash(),
+		committees: map[uint64][]*types.CommitteeMember{first.EpochID: copyMembers(genesis.SwitchInfos())},
+		last:       first.EpochID,
+		head:       genesis.Header(),
+	}
+}
+
+func copyMembers(infos []*types.CommitteeMember) []*types.CommitteeMember {
+	members := make([]*types.CommitteeMember, 0, len(infos))
+	for _, m := range infos {
+		member := *m
+		members = append(members, &member)
+	}
+	return members
+}
+
+// Committee returns the members of the committee of epochid in use after the
+// switches applied so far, nil if it isn't known yet.
+func (c *CommitteeChain) Committee(epochid uint64) []*types.CommitteeMember {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	all, ok := c.committees[epochid]
+	if !ok {
+		return nil
+	}
+	members := make([]*types.CommitteeMember, 0, len(all))
+	for _, m := range all {
+		if m.Flag == types.StateUsedFlag {
+			member := *m
+			members = append(members, &member)
+		}
+	}
+	return members
+}
+
+// EpochFromHeight returns the epoch of the height hh with the epoch lengths
+// handed off so far.
+func (c *CommitteeChain) EpochFromHeight(hh uint64) *types.EpochIDInfo {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return types.EpochFromHeight(c.lengths, hh)
+}
+
+// EpochFromID returns the epoch eid with the epoch lengths handed off so far.
+func (c *CommitteeChain) EpochFromID(eid uint64) *types.EpochIDInfo {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return types.EpochFromID(c.lengths, eid)
+}
+
+// LastEpoch returns the last epoch with a known committee.
+func (c *CommitteeChain) LastEpoch() uint64 {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.last
+}
+
+// CurrentHeader returns the highest verified header.
+func (c *CommitteeChain) CurrentHeader() *types.Header {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return types.CopyHeader(c.head)
+}
+
+// VerifyHeader checks that more than 2/3 of the committee of the epoch of the
+// header agreed on it.
+func (c *CommitteeChain) VerifyHeader(header *types.Header, signs []*types.PbftSign) error {
+	if header.Number.Sign() == 0 {
+		if header.Hash() != c.genesis {
+			return ErrGenesisMismatch
+		}
+		return nil
+	}
+	epoch := c.EpochFromHeight(header.Number.Uint64())
+	members := c.Committee(epoch.EpochID)
+	if members == nil {
+		return ErrUnknownCommittee
+	}
+	if err := VerifySigns(header, signs, members); err != nil {
+		return err
+	}
+	c.lock.Lock()
+	if header.Number.Cmp(c.head.Number) > 0 {
+		c.head = types.CopyHeader(header)
+	}
+	c.lock.Unlock()
+	return nil
+}
+
+// VerifyBlock is VerifyHeader also checking the switch infos of the block
+// against its header.
+func (c *CommitteeChain) VerifyBlock(block *types.Block) error {
+	return c.VerifySignedHeader(NewSignedHeader(block))
+}
+
+// VerifySignedHeader is VerifyHeader also checking the switch infos against
+// the header, which are applied to the committee once the header is verified.
+func (c *CommitteeChain) VerifySignedHeader(sh *SignedHeader) error {
+	if types.CalcCommitteeHash(sh.Infos, sh.ParentSigns) != sh.Header.CommitteeHash {
+		return ErrSwitchInfos
+	}
+	if err := c.VerifyHeader(sh.Header, sh.Signs); err != nil {
+		return err
+	}
+	if sh.Header.Number.Sign() > 0 {
+		c.applySwitches(c.EpochFromHeight(sh.Header.Number.Uint64()).EpochID, sh.Infos)
+	}
+	return nil
+}
+
+// applySwitches updates the committee of epochid with the switch infos of one
+// of its blocks.
+func (c *CommitteeChain) applySwitches(epochid uint64, infos []*types.CommitteeMember) {
+	if len(infos) == 0 {
+		return
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.committees[epochid] = ApplySwitches(c.committees[epochid], infos)
+}
+
+// ApplySwitches returns the members after the switch infos of a block. The
+// first block of an epoch lists the whole committee, the others switch members
+// out and back in by the address of their key alone. The flags of members are
+// updated in place.
+func ApplySwitches(members, infos []*types.CommitteeMember) []*types.CommitteeMember {
+	if len(infos) > 2 {
+		return copyMembers(infos)
+	}
+	for _, info := range infos {
+		for _, m := range members {
+			if m.CommitteeBase != info.CommitteeBase {
+				continue
+			}
+			switch info.Flag {
+			case types.StateRemovedFlag:
+				m.Flag = types.StateRemovedFlag
+			case types.StateAppendFlag:
+				m.Flag = types.StateUsedFlag
+			}
+		}
+	}
+	return members
+}
+
+// Handoff verifies the last header of an epoch and takes the committee of the
+// next epoch from the staking state of the header, read from the nodes of its
+// proof. The epoch lengths changed by the governance are taken too.
+func (c *CommitteeChain) Handoff(header *types.Header, signs []*types.PbftSign, nodes [][]byte) error {
+	epoch := c.EpochFromHeight(header.Number.Uint64())
+	if header.Number.Uint64() != epoch.EndHeight {
+		return ErrNotEpochEnd
+	}
+	if c.Committee(epoch.EpochID+1) != nil {
+		return nil
+	}
+	if err := c.VerifyHeader(header, signs); err != nil {
+		return err
+	}
+	statedb, set, err := ProvenState(header.Root, nodes)
+	if err != nil {
+		return err
+	}
+	members, lengths, err := ReadHandoff(statedb, epoch.EpochID+1)
+	if err != nil {
+		return err
+	}
+	if set.Missing() {
+		return ErrIncompleteProof
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.lengths = lengths
+	c.committees[epoch.EpochID+1] = copyMembers(members)
+	if epoch.EpochID+1 > c.last {
+		c.last = epoch.EpochID + 1
+	}
+	log.Info("Light committee handed off", "epoch", epoch.EpochID+1, "number", header.Number, "members", len(members))
+	return nil
+}
+
+// VerifySigns checks that more than 2/3 of members agreed on header, the
+// agreement of a member is counted once and the signs of other blocks and of
+// signers out of members are left out.
+func VerifySigns(header *types.Header, signs []*types.PbftSign, members []*types.CommitteeMember) error {
+	hash := header.Hash()
+	agreed := make(map[common.Address]bool)
+	for _, sign := range signs {
+		if sign.FastHash != hash || sign.FastHeight == nil || sign.FastHeight.Cmp(header.Number) != 0 || sign.Result != types.VoteAgree {
+			continue
+		}
+		pubkey, err := crypto.SigToPub(sign.HashWithNoSign().Bytes(), sign.Sign)
+		if err != nil {
+			return ErrInvalidSign
+		}
+		if member := memberByPubkey(members, crypto.FromECDSAPub(pubkey)); member != nil {
+			agreed[member.CommitteeBase] = true
+		}
+	}
+	if len(agreed) <= len(members)*2/3 {
+		return ErrNotEnoughSigns
+	}
+	return nil
+}
+
+func memberByPubkey(members []*types.CommitteeMember, pubkey []byte) *types.CommitteeMember {
+	for _, m := range members {
+		if bytes.Equal(m.Publickey, pubkey) {
+			return m
+		}
+	}
+	return nil
+}
+
+// ReadHandoff reads the committee elected for epochid and the epoch lengths from
+// the staking state. The slots the committee is elected from are read apart
+// from the cache, so that a proof recorded by it holds them all.
+func ReadHandoff(statedb *state.StateDB, epochid uint64) ([]*types.CommitteeMember, []types.EpochLength, error) {
+	keys, err := vm.StakingElectionKeys(statedb, types.StakingAddress, epochid)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, key := range keys {
+		statedb.GetPOSState(types.StakingAddress, key)
+	}
+	gov, err := vm.LoadGovernance(statedb)
+	if err != nil {
+		return nil, nil, err
+	}
+	members := vm.GetValidatorsByEpoch(statedb, epochid, gov.EpochFromID(epochid).BeginHeight)
+	if err := statedb.Error(); err != nil {
+		return nil, nil, err
+	}
+	if len(members) == 0 {
+		return nil, nil, ErrEmptyCommittee
+	}
+	return members, gov.Lengths, nil
+}
+
+// ProveHandoff returns the proof of the committee elected for epochid in the
+// state of root, the state of the last block of the epoch before.
+func ProveHandoff(source state.Database, root common.Hash, epochid uint64) ([][]byte, error) {
+	return ProveState(source, root, func(statedb *state.StateDB) error {
+		_, _, err := ReadHandoff(statedb, epochid)
+		return err
+	})
+}
@@ -0,0 +1,159 @@
+package light
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/core/state"
+	"git.taiyue.io/pist/go-pist/core/types"
+	"git.taiyue.io/pist/go-pist/core/vm"
+	"git.taiyue.io/pist/go-pist/crypto"
+	"git.taiyue.io/pist/go-pist/params"
+	"git.taiyue.io/pist/go-pist/pistdb"
+	"git.taiyue.io/pist/go-pist/rlp"
+)
+
+func signHeader(header *types.Header, keys ...*ecdsa.PrivateKey) []*types.PbftSign {
+	var signs []*types.PbftSign
+	for _, key := range keys {
+		sign := &types.PbftSign{FastHeight: header.Number, FastHash: header.Hash(), Result: types.VoteAgree}
+		sign.Sign, _ = crypto.Sign(sign.HashWithNoSign().Bytes(), key)
+		signs = append(signs, sign)
+	}
+	return signs
+}
+
+func newHeader(number uint64, root common.Hash) *types.Header {
+	return &types.Header{Number: new(big.Int).SetUint64(number), Time: big.NewInt(0), Root: root}
+}
+
+func TestCommitteeChain(t *testing.T) {
+	var (
+		keys  []*ecdsa.PrivateKey
+		infos []*types.CommitteeMember
+	)
+	impl := vm.NewImpawnImpl()
+	for n := 0; n < 4; n++ {
+		key, _ := crypto.GenerateKey()
+		keys = append(keys, key)
+		pk := crypto.FromECDSAPub(&key.PublicKey)
+		infos = append(infos, types.NewCommitteeMember(crypto.PubkeyToAddress(key.PublicKey), pk, types.StateUsedFlag, types.TypeWorked))
+		value := new(big.Int).Mul(big.NewInt(20000), big.NewInt(1e18))
+		if err := impl.InsertSAccount2(0, crypto.PubkeyToAddress(key.PublicKey), pk, value, big.NewInt(50), true); err != nil {
+			t.Fatal(err)
+		}
+	}
+	genesis := types.NewBlock(newHeader(0, common.Hash{}), nil, nil, nil, infos)
+	chain := NewCommitteeChain(genesis)
+
+	header := newHeader(5, common.Hash{})
+	if err := chain.VerifyHeader(header, signHeader(header, keys[:3]...)); err != nil {
+		t.Fatal(err)
+	}
+	if chain.CurrentHeader().Hash() != header.Hash() {
+		t.Fatal("head not updated")
+	}
+	if err := chain.VerifyHeader(header, signHeader(header, keys[0], keys[1], keys[1])); err != ErrNotEnoughSigns {
+		t.Fatalf("expected not enough signs error, got %v", err)
+	}
+	stranger, _ := crypto.GenerateKey()
+	if err := chain.VerifyHeader(header, signHeader(header, keys[0], keys[1], stranger)); err != ErrNotEnoughSigns {
+		t.Fatalf("expected not enough signs error, got %v", err)
+	}
+
+	// The switch infos of a verified block switch the members out and back in
+	switchTo := func(number uint64, flag uint32, keys ...*ecdsa.PrivateKey) *SignedHeader {
+		infos := []*types.CommitteeMember{{CommitteeBase: crypto.PubkeyToAddress(keys[0].PublicKey), Flag: flag}}
+		header := newHeader(number, common.Hash{})
+		header.CommitteeHash = types.RlpHash(infos)
+		return &SignedHeader{Header: header, Signs: signHeader(header, keys[1:]...), Infos: infos}
+	}
+	if err := chain.VerifySignedHeader(switchTo(6, types.StateRemovedFlag, keys[3], keys[0], keys[1], keys[2])); err != nil {
+		t.Fatal(err)
+	}
+	if members := chain.Committee(1); len(members) != 3 {
+		t.Fatalf("switched out member still in use: %d members", len(members))
+	}
+	if err := chain.VerifySignedHeader(switchTo(7, types.StateAppendFlag, keys[3], keys[0], keys[1], keys[3])); err != ErrNotEnoughSigns {
+		t.Fatalf("expected not enough signs error, got %v", err)
+	}
+	if err := chain.VerifySignedHeader(switchTo(7, types.StateAppendFlag, keys[3], keys[0], keys[1], keys[2])); err != nil {
+		t.Fatal(err)
+	}
+	if members := chain.Committee(1); len(members) != 4 {
+		t.Fatalf("switched in member not in use: %d members", len(members))
+	}
+
+	// Elect the same validators for the second epoch and hand the committee off
+	first := types.GetEpochFromID(1)
+	if _, err := impl.DoElections(1, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := impl.Shift(1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := impl.DoElections(2, first.EndHeight-params.ElectionPoint); err != nil {
+		t.Fatal(err)
+	}
+	if err := impl.Shift(2); err != nil {
+		t.Fatal(err)
+	}
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(pistdb.NewMemDatabase()))
+	impl.Save(statedb, types.StakingAddress)
+	lengths := []types.EpochLength{{EpochID: 4, Length: 50000}}
+	(&vm.Governance{NextID: 1, Lengths: lengths}).Save(statedb)
+	root, err := statedb.Commit(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nodes, err := ProveHandoff(statedb.Database(), root, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	next := newHeader(types.GetEpochFromID(2).BeginHeight, common.Hash{})
+	if err := chain.VerifyHeader(next, signHeader(next, keys...)); err != ErrUnknownCommittee {
+		t.Fatalf("expected unknown committee error, got %v", err)
+	}
+	end := newHeader(first.EndHeight, root)
+	if err := chain.Handoff(header, signHeader(header, keys...), nodes); err != ErrNotEpochEnd {
+		t.Fatalf("expected epoch end error, got %v", err)
+	}
+	if err := chain.Handoff(end, signHeader(end, keys...), nodes[:len(nodes)-1]); err == nil {
+		t.Fatal("committee handed off on an incomplete proof")
+	}
+	if err := chain.Handoff(end, signHeader(end, keys...), nodes); err != nil {
+		t.Fatal(err)
+	}
+	if members := chain.Committee(2); len(members) != len(keys) || chain.LastEpoch() != 2 {
+		t.Fatalf("handed off committee mismatch: %v", members)
+	}
+	// the epoch lengths handed off are kept by the chain alone
+	if epoch := chain.EpochFromID(4); epoch.EndHeight-epoch.BeginHeight+1 != 50000 {
+		t.Fatalf("epoch length not handed off: %d-%d", epoch.BeginHeight, epoch.EndHeight)
+	}
+	if len(types.GetEpochLengths()) != 0 {
+		t.Fatal("epoch lengths of the node changed")
+	}
+
+	// A signed header survives the RLP round trip of the RPC
+	block := types.NewBlock(next, nil, nil, nil, nil)
+	block = block.WithBody(nil, signHeader(block.Header(), keys...), nil, nil)
+	data, err := rlp.EncodeToBytes(NewSignedHeader(block))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sh := new(SignedHeader)
+	if err := rlp.DecodeBytes(data, sh); err != nil {
+		t.Fatal(err)
+	}
+	if err := chain.VerifySignedHeader(sh); err != nil {
+		t.Fatal(err)
+	}
+	sh.Infos = infos
+	if err := chain.VerifySignedHeader(sh); err != ErrSwitchInfos {
+		t.Fatalf("expected switch infos error, got %v", err)
+	}
+}
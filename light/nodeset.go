@@ -0,0 +1,159 @@
+// Package light implements the verification of the chain by a light client: the
+// headers are checked against the signatures of the committee, which is handed
+// from an epoch to the next through proofs of the staking state, and the state
+// is read from the Merkle proofs given by a full node.
+package light
+
+import (
+	"errors"
+	"sync"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/core/state"
+	"git.taiyue.io/pist/go-pist/crypto"
+	"git.taiyue.io/pist/go-pist/pistdb"
+	"git.taiyue.io/pist/go-pist/trie"
+)
+
+var errNodeMissing = errors.New("trie node not proven")
+
+// NodeSet is a set of trie nodes keyed by their hash, the nodes read from a state
+// are a proof of what was read.
+type NodeSet struct {
+	lock    sync.RWMutex
+	nodes   map[common.Hash][]byte
+	order   []common.Hash
+	missing bool // a node not in the set was asked for
+}
+
+// NewNodeSet creates a node set holding nodes, keyed by the hash of each node.
+func NewNodeSet(nodes [][]byte) *NodeSet {
+	set := &NodeSet{nodes: make(map[common.Hash][]byte)}
+	for _, node := range nodes {
+		set.Put(crypto.Keccak256Hash(node), node)
+	}
+	return set
+}
+
+// Put adds a node to the set.
+func (s *NodeSet) Put(hash common.Hash, node []byte) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.nodes[hash]; ok {
+		return
+	}
+	s.nodes[hash] = common.CopyBytes(node)
+	s.order = append(s.order, hash)
+}
+
+// Get returns the node of hash, nil if it isn't in the set.
+func (s *NodeSet) Get(hash common.Hash) []byte {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.nodes[hash]
+}
+
+// Len returns the number of nodes in the set.
+func (s *NodeSet) Len() int {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return len(s.nodes)
+}
+
+// List returns the nodes in the order they were added.
+func (s *NodeSet) List() [][]byte {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	list := make([][]byte, 0, len(s.order))
+	for _, hash := range s.order {
+		list = append(list, s.nodes[hash])
+	}
+	return list
+}
+
+// Missing reports whether a node not in the set was read from its database.
+func (s *NodeSet) Missing() bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.missing
+}
+
+// Database returns a database reading the nodes of the set, a state opened on
+// it reads what the nodes prove and nothing else.
+func (s *NodeSet) Database() pistdb.Database {
+	return &nodeSetDB{Database: pistdb.NewMemDatabase(), set: s}
+}
+
+// nodeSetDB reads the nodes of a set and marks the set on a missing node.
+type nodeSetDB struct {
+	pistdb.Database // the writes and the iterations go to an unused memory database
+	set             *NodeSet
+}
+
+func (db *nodeSetDB) Get(key []byte) ([]byte, error) {
+	if node := db.set.Get(common.BytesToHash(key)); node != nil {
+		return node, nil
+	}
+	db.set.lock.Lock()
+	db.set.missing = true
+	db.set.lock.Unlock()
+	return nil, errNodeMissing
+}
+
+func (db *nodeSetDB) Has(key []byte) (bool, error) {
+	return db.set.Get(common.BytesToHash(key)) != nil, nil
+}
+
+// recordingDB reads the trie nodes of a state database and adds them to a set.
+// The nodes are taken from the trie database, so that the nodes not flushed to
+// the disk yet are proven too.
+type recordingDB struct {
+	pistdb.Database // the writes and the iterations go to an unused memory database
+	source          *trie.Database
+	set             *NodeSet
+}
+
+func (db *recordingDB) Get(key []byte) ([]byte, error) {
+	node, err := db.source.Node(common.BytesToHash(key))
+	if err != nil {
+		return nil, err
+	}
+	db.set.Put(common.BytesToHash(key), node)
+	return node, nil
+}
+
+func (db *recordingDB) Has(key []byte) (bool, error) {
+	node, err := db.Get(key)
+	return node != nil, err
+}
+
+// ProveState runs read on the state of root in source and returns the trie
+// nodes it read, the proof of the values read.
+func ProveState(source state.Database, root common.Hash, read func(*state.StateDB) error) ([][]byte, error) {
+	set := NewNodeSet(nil)
+	db := &recordingDB{Database: pistdb.NewMemDatabase(), source: source.TrieDB(), set: set}
+	statedb, err := state.New(root, state.NewDatabase(db))
+	if err != nil {
+		return nil, err
+	}
+	if err := read(statedb); err != nil {
+		return nil, err
+	}
+	if err := statedb.Error(); err != nil {
+		return nil, err
+	}
+	return set.List(), nil
+}
+
+// ProvenState opens the state of root on the nodes of a proof. A value that
+// isn't proven reads as empty, the returned set reports it by Missing.
+func ProvenState(root common.Hash, nodes [][]byte) (*state.StateDB, *NodeSet, error) {
+	set := NewNodeSet(nodes)
+	statedb, err := state.New(root, state.NewDatabase(set.Database()))
+	return statedb, set, err
+}
@@ -33,6 +33,7 @@ import (
 	"git.taiyue.io/pist/go-pist/log"
 	"git.taiyue.io/pist/go-pist/p2p"
 	"git.taiyue.io/pist/go-pist/p2p/enode"
+	"git.taiyue.io/pist/go-pist/rpc"
 )
 
 const (
@@ -146,6 +147,11 @@ type Config struct {
 	// private APIs to untrusted users is a major security risk.
 	WSExposeAll bool `toml:",omitempty"`
 
+	// RPCAuth is the authentication of the IPC, HTTP and websocket requests: the
+	// bearer tokens, the modules and methods each may call and its rate, batch
+	// and response size limits. If it is nil, every request is served.
+	RPCAuth *rpc.AuthConfig `toml:",omitempty"`
+
 	// Logger is a custom logger to use with the p2p.Server.
 	Logger log.Logger `toml:",omitempty"`
 }
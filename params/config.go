@@ -19,6 +19,7 @@ package params
 import (
 	"encoding/binary"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
 
@@ -52,6 +53,7 @@ var (
 			MinimumFruitDifficulty: big.NewInt(262144),
 			DurationLimit:          big.NewInt(600),
 		}),
+		YoloV1Block: big.NewInt(0),
 	}
 
 	// MainnetTrustedCheckpoint contains the light client trusted checkpoint for the main network.
@@ -83,6 +85,7 @@ var (
 			MinimumFruitDifficulty: big.NewInt(200),
 			DurationLimit:          big.NewInt(600),
 		}),
+		YoloV1Block: big.NewInt(0),
 	}
 
 	// TestnetTrustedCheckpoint contains the light client trusted checkpoint for the Ropsten test network.
@@ -114,6 +117,7 @@ var (
 			MinimumFruitDifficulty: big.NewInt(100),
 			DurationLimit:          big.NewInt(150),
 		}),
+		YoloV1Block: big.NewInt(0),
 	}
 
 	SingleNodeChainConfig = &ChainConfig{
@@ -123,6 +127,7 @@ var (
 			MinimumFruitDifficulty: big.NewInt(2),
 			DurationLimit:          big.NewInt(120),
 		}),
+		YoloV1Block: big.NewInt(0),
 	}
 
 	// TestnetTrustedCheckpoint contains the light client trusted checkpoint for the Ropsten test network.
@@ -139,12 +144,12 @@ var (
 	chainId = big.NewInt(9223372036854775790)
 	// This configuration is intentionally not using keyed fields to force anyone
 	// adding flags to the config to also have to set these fields.
-	AllMinervaProtocolChanges = &ChainConfig{ChainID: chainId, Minerva: new(MinervaConfig)}
+	AllMinervaProtocolChanges = &ChainConfig{ChainID: chainId, Minerva: new(MinervaConfig), YoloV1Block: big.NewInt(0), SlashingBlock: big.NewInt(0), StakingTrieBlock: big.NewInt(0)}
 
 	// This configuration is intentionally not using keyed fields to force anyone
 	// adding flags to the config to also have to set these fields.
 
-	TestChainConfig = &ChainConfig{ChainID: chainId, Minerva: &MinervaConfig{MinimumDifficulty, MinimumFruitDifficulty, DurationLimit}}
+	TestChainConfig = &ChainConfig{ChainID: chainId, Minerva: &MinervaConfig{MinimumDifficulty, MinimumFruitDifficulty, DurationLimit}, YoloV1Block: big.NewInt(0), SlashingBlock: big.NewInt(0), StakingTrieBlock: big.NewInt(0)}
 )
 
 // TrustedCheckpoint represents a set of post-processed trie roots (CHT and
@@ -205,6 +210,19 @@ type ChainConfig struct {
 	//Clique *CliqueConfig  `json:"clique,omitempty"`
 
 	TIPStake *BlockConfig `json:"tipstake"`
+
+	// Forks of the chain rules by fast block number, nil is never activated.
+	YoloV1Block      *big.Int `json:"yoloV1Block,omitempty"`      // YoloV1 instruction set and precompiles
+	SlashingBlock    *big.Int `json:"slashingBlock,omitempty"`    // slashing and jailing of the validators
+	StakingTrieBlock *big.Int `json:"stakingTrieBlock,omitempty"` // staking state stored slot by slot
+
+	RandomElectionBlock *big.Int `json:"randomElectionBlock,omitempty"` // committee sampled by stake from a seed chained over the committee signs
+	ValidatorInfoBlock  *big.Int `json:"validatorInfoBlock,omitempty"`  // validator descriptions and limited fee changes
+	RewardModeBlock     *big.Int `json:"rewardModeBlock,omitempty"`     // restaked and claimed delegation rewards
+	RedelegationBlock   *big.Int `json:"redelegationBlock,omitempty"`   // delegations moved between validators
+	GovernanceBlock     *big.Int `json:"governanceBlock,omitempty"`     // parameters changed by the votes of the validators
+
+	Reward *RewardConfig `json:"reward,omitempty"` // nil keeps all the fees on the fee address
 }
 
 type BlockConfig struct {
@@ -213,29 +231,78 @@ type BlockConfig struct {
 }
 
 func (c *ChainConfig) UnmarshalJSON(input []byte) error {
-	type ChainConfig struct {
-		ChainID *big.Int `json:"chainId"` // chainId identifies the current chain and is used for replay protection
-
-		Minerva *MinervaConfig `json:"minerva"`
-	}
-	var dec ChainConfig
+	type chainConfig ChainConfig
+	var dec chainConfig
 	if err := json.Unmarshal(input, &dec); err != nil {
 		return err
 	}
-	c.ChainID = dec.ChainID
+	*c = ChainConfig(dec)
 	if dec.Minerva == nil {
 		c.Minerva = &(MinervaConfig{
 			MinimumDifficulty:      MinimumDifficulty,
 			MinimumFruitDifficulty: MinimumFruitDifficulty,
 			DurationLimit:          DurationLimit,
 		})
-	} else {
-		c.Minerva = dec.Minerva
 	}
+	// The networks were launched with the YoloV1 rules before the forks were
+	// configurable, so their stored configs lack the block.
+	if dec.YoloV1Block == nil {
+		c.YoloV1Block = new(big.Int)
+	}
+	return c.CheckConfig()
+}
 
+// CheckConfig checks the forks are backed by their parameters.
+func (c *ChainConfig) CheckConfig() error {
+	if c.Reward != nil {
+		if err := c.Reward.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// RewardRateBase is the whole of the rates of the reward policy.
+const RewardRateBase uint64 = 10000
+
+// RewardConfig is the fee and block reward policy of the chain from Block on.
+// The fees and the minted reward of a block are split by the rates, which are
+// in units of 1/10000 and add up to 10000:
+//   - Proposer goes to the proposer of the block
+//   - Committee is shared by the committee members signing the parent block,
+//     whose signs the block carries
+//   - Delegators is shared by the elected validators and their delegators by stake
+//   - Treasury and the remainders go to TreasuryAddress
+type RewardConfig struct {
+	Block           *big.Int       `json:"block"`       // fork height of the policy
+	BlockReward     *big.Int       `json:"blockReward"` // minted for every block
+	Proposer        uint64         `json:"proposer"`
+	Committee       uint64         `json:"committee"`
+	Delegators      uint64         `json:"delegators"`
+	Treasury        uint64         `json:"treasury"`
+	TreasuryAddress common.Address `json:"treasuryAddress"`
+}
+
+// Validate checks the rates of the policy add up to the whole reward.
+func (c *RewardConfig) Validate() error {
+	if c.Block == nil {
+		return errors.New("missing fork block of the reward policy")
+	}
+	if c.BlockReward != nil && c.BlockReward.Sign() < 0 {
+		return errors.New("negative block reward")
+	}
+	if sum := c.Proposer + c.Committee + c.Delegators + c.Treasury; sum != RewardRateBase {
+		return fmt.Errorf("reward rates add up to %d, want %d", sum, RewardRateBase)
+	}
+	return nil
+}
+
+// String implements the stringer interface.
+func (c *RewardConfig) String() string {
+	return fmt.Sprintf("{Block: %v BlockReward: %v Proposer: %d Committee: %d Delegators: %d Treasury: %d TreasuryAddress: %s}",
+		c.Block, c.BlockReward, c.Proposer, c.Committee, c.Delegators, c.Treasury, c.TreasuryAddress.String())
+}
+
 // MinervaConfig is the consensus engine configs for proof-of-work based sealing.
 type MinervaConfig struct {
 	MinimumDifficulty      *big.Int `json:"minimumDifficulty"`
@@ -307,18 +374,81 @@ func (c *ChainConfig) String() string {
 	default:
 		engine = "unknown"
 	}
-	return fmt.Sprintf("{ChainID: %v Engine: %v}",
+	return fmt.Sprintf("{ChainID: %v YoloV1: %v Slashing: %v Reward: %v StakingTrie: %v RandomElection: %v ValidatorInfo: %v RewardMode: %v Redelegation: %v Governance: %v Engine: %v}",
 		c.ChainID,
+		c.YoloV1Block,
+		c.SlashingBlock,
+		c.rewardBlock(),
+		c.StakingTrieBlock,
+		c.RandomElectionBlock,
+		c.ValidatorInfoBlock,
+		c.RewardModeBlock,
+		c.RedelegationBlock,
+		c.GovernanceBlock,
 		engine,
 	)
 }
 
-// GasTable returns the gas table corresponding to the current phase (homestead or homestead reprice).
-//
-// The returned GasTable's fields shouldn't, under any circumstances, be changed.
-func (c *ChainConfig) GasTable(num *big.Int) GasTable {
-	return GasTableConstantinople
+// IsYoloV1 returns whether num is either equal to the YoloV1 fork block or greater.
+func (c *ChainConfig) IsYoloV1(num *big.Int) bool {
+	return isForked(c.YoloV1Block, num)
+}
+
+// IsSlashing returns whether num is either equal to the slashing fork block or greater.
+func (c *ChainConfig) IsSlashing(num *big.Int) bool {
+	return isForked(c.SlashingBlock, num)
+}
+
+// IsStakingTrie returns whether num is either equal to the staking trie fork block or greater.
+func (c *ChainConfig) IsStakingTrie(num *big.Int) bool {
+	return isForked(c.StakingTrieBlock, num)
+}
+
+// IsReward returns whether num is either equal to the fork block of the reward
+// policy or greater.
+func (c *ChainConfig) IsReward(num *big.Int) bool {
+	return isForked(c.rewardBlock(), num)
+}
 
+// IsParentSigns returns whether the block num must carry the signs committing
+// its parent. The signs are carried from the reward policy fork on, which
+// shares the committee reward among the signers of the parent, from the
+// slashing fork on, which jails the validators missing too many of them, and
+// from the random election fork on, which seeds the elections with them.
+func (c *ChainConfig) IsParentSigns(num *big.Int) bool {
+	return num != nil && num.Cmp(common.Big1) > 0 && (c.IsReward(num) || c.IsSlashing(num) || c.IsRandomElection(num))
+}
+
+func (c *ChainConfig) rewardBlock() *big.Int {
+	if c.Reward == nil {
+		return nil
+	}
+	return c.Reward.Block
+}
+
+// IsRandomElection returns whether num is either equal to the random election fork block or greater.
+func (c *ChainConfig) IsRandomElection(num *big.Int) bool {
+	return isForked(c.RandomElectionBlock, num)
+}
+
+// IsValidatorInfo returns whether num is either equal to the validator info fork block or greater.
+func (c *ChainConfig) IsValidatorInfo(num *big.Int) bool {
+	return isForked(c.ValidatorInfoBlock, num)
+}
+
+// IsRewardMode returns whether num is either equal to the reward mode fork block or greater.
+func (c *ChainConfig) IsRewardMode(num *big.Int) bool {
+	return isForked(c.RewardModeBlock, num)
+}
+
+// IsRedelegation returns whether num is either equal to the redelegation fork block or greater.
+func (c *ChainConfig) IsRedelegation(num *big.Int) bool {
+	return isForked(c.RedelegationBlock, num)
+}
+
+// IsGovernance returns whether num is either equal to the governance fork block or greater.
+func (c *ChainConfig) IsGovernance(num *big.Int) bool {
+	return isForked(c.GovernanceBlock, num)
 }
 
 // CheckCompatible checks whether scheduled fork transitions have been imported
@@ -340,6 +470,33 @@ func (c *ChainConfig) CheckCompatible(newcfg *ChainConfig, height uint64) *Confi
 }
 
 func (c *ChainConfig) checkCompatible(newcfg *ChainConfig, head *big.Int) *ConfigCompatError {
+	if isForkIncompatible(c.YoloV1Block, newcfg.YoloV1Block, head) {
+		return newCompatError("YoloV1 fork block", c.YoloV1Block, newcfg.YoloV1Block)
+	}
+	if isForkIncompatible(c.SlashingBlock, newcfg.SlashingBlock, head) {
+		return newCompatError("Slashing fork block", c.SlashingBlock, newcfg.SlashingBlock)
+	}
+	if isForkIncompatible(c.rewardBlock(), newcfg.rewardBlock(), head) {
+		return newCompatError("Reward fork block", c.rewardBlock(), newcfg.rewardBlock())
+	}
+	if isForkIncompatible(c.StakingTrieBlock, newcfg.StakingTrieBlock, head) {
+		return newCompatError("StakingTrie fork block", c.StakingTrieBlock, newcfg.StakingTrieBlock)
+	}
+	if isForkIncompatible(c.RandomElectionBlock, newcfg.RandomElectionBlock, head) {
+		return newCompatError("RandomElection fork block", c.RandomElectionBlock, newcfg.RandomElectionBlock)
+	}
+	if isForkIncompatible(c.ValidatorInfoBlock, newcfg.ValidatorInfoBlock, head) {
+		return newCompatError("ValidatorInfo fork block", c.ValidatorInfoBlock, newcfg.ValidatorInfoBlock)
+	}
+	if isForkIncompatible(c.RewardModeBlock, newcfg.RewardModeBlock, head) {
+		return newCompatError("RewardMode fork block", c.RewardModeBlock, newcfg.RewardModeBlock)
+	}
+	if isForkIncompatible(c.RedelegationBlock, newcfg.RedelegationBlock, head) {
+		return newCompatError("Redelegation fork block", c.RedelegationBlock, newcfg.RedelegationBlock)
+	}
+	if isForkIncompatible(c.GovernanceBlock, newcfg.GovernanceBlock, head) {
+		return newCompatError("Governance fork block", c.GovernanceBlock, newcfg.GovernanceBlock)
+	}
 	return nil
 }
 
@@ -404,16 +561,25 @@ func (err *ConfigCompatError) Error() string {
 // Rules is a one time interface meaning that it shouldn't be used in between transition
 // phases.
 type Rules struct {
-	ChainID *big.Int
+	ChainID                                         *big.Int
+	IsYoloV1, IsSlashing, IsReward, IsValidatorInfo bool
+	IsRewardMode, IsRedelegation, IsGovernance      bool
 }
 
 // Rules ensures c's ChainID is not nil.
-func (c *ChainConfig) Rules() Rules {
+func (c *ChainConfig) Rules(num *big.Int) Rules {
 	chainID := c.ChainID
 	if chainID == nil {
 		chainID = new(big.Int)
 	}
 	return Rules{
-		ChainID: new(big.Int).Set(chainID),
+		ChainID:         new(big.Int).Set(chainID),
+		IsYoloV1:        c.IsYoloV1(num),
+		IsSlashing:      c.IsSlashing(num),
+		IsReward:        c.IsReward(num),
+		IsValidatorInfo: c.IsValidatorInfo(num),
+		IsRewardMode:    c.IsRewardMode(num),
+		IsRedelegation:  c.IsRedelegation(num),
+		IsGovernance:    c.IsGovernance(num),
 	}
 }
@@ -17,10 +17,13 @@
 package params
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"reflect"
 	"testing"
+
+	"git.taiyue.io/pist/go-pist/common"
 )
 
 func TestCheckCompatible(t *testing.T) {
@@ -48,3 +51,70 @@ func TestFork(t *testing.T) {
 	forked := isForked(Tip, cur)
 	fmt.Println("fork:", forked)
 }
+
+func TestRewardPolicy(t *testing.T) {
+	var config ChainConfig
+	if err := json.Unmarshal([]byte(`{"chainId":1,"reward":{"block":100,"blockReward":5,"proposer":1000,"committee":2000,"delegators":6000,"treasury":1000}}`), &config); err != nil {
+		t.Fatal(err)
+	}
+	if config.IsReward(big.NewInt(99)) || !config.IsReward(big.NewInt(100)) {
+		t.Fatal("reward fork mismatch")
+	}
+	if err := json.Unmarshal([]byte(`{"chainId":1,"reward":{"block":100,"proposer":1000}}`), &config); err == nil {
+		t.Fatal("reward rates not adding up accepted")
+	}
+	if err := json.Unmarshal([]byte(`{"chainId":1,"reward":{"proposer":10000}}`), &config); err == nil {
+		t.Fatal("reward policy without a fork block accepted")
+	}
+	moved := &ChainConfig{ChainID: chainId, Reward: &RewardConfig{Block: big.NewInt(200)}}
+	stored := &ChainConfig{ChainID: chainId, Reward: &RewardConfig{Block: big.NewInt(100)}}
+	if err := stored.CheckCompatible(moved, 50); err != nil {
+		t.Fatalf("unexpected error before the fork: %v", err)
+	}
+	if err := stored.CheckCompatible(moved, 150); err == nil || err.RewindTo != 99 {
+		t.Fatalf("expected rewind to 99, got %v", err)
+	}
+
+	governed := &ChainConfig{ChainID: chainId, GovernanceBlock: big.NewInt(100)}
+	if governed.Rules(big.NewInt(99)).IsGovernance || !governed.Rules(big.NewInt(100)).IsGovernance {
+		t.Fatal("governance rules mismatch")
+	}
+	if err := governed.CheckCompatible(&ChainConfig{ChainID: chainId}, 150); err == nil || err.What != "Governance fork block" {
+		t.Fatalf("expected governance fork error, got %v", err)
+	}
+}
+
+func TestForks(t *testing.T) {
+	// Stored configs predate the forks and run the YoloV1 rules from the genesis
+	var config ChainConfig
+	if err := json.Unmarshal([]byte(`{"chainId":1,"tipstake":{"FastNumber":10,"CID":1}}`), &config); err != nil {
+		t.Fatal(err)
+	}
+	if !config.IsYoloV1(common.Big0) || config.IsSlashing(big.NewInt(1000)) {
+		t.Fatalf("fork defaults mismatch: %v", &config)
+	}
+	if config.TIPStake == nil || config.TIPStake.FastNumber.Uint64() != 10 {
+		t.Fatal("tipstake dropped")
+	}
+	config.SlashingBlock = big.NewInt(100)
+	if rules := config.Rules(big.NewInt(100)); !rules.IsYoloV1 || !rules.IsSlashing || rules.IsReward {
+		t.Fatalf("rules mismatch: %+v", rules)
+	}
+
+	moved := &ChainConfig{ChainID: chainId, SlashingBlock: big.NewInt(200)}
+	stored := &ChainConfig{ChainID: chainId, SlashingBlock: big.NewInt(100)}
+	if err := stored.CheckCompatible(moved, 50); err != nil {
+		t.Fatalf("unexpected error before the fork: %v", err)
+	}
+	if err := stored.CheckCompatible(moved, 150); err == nil || err.RewindTo != 99 {
+		t.Fatalf("expected rewind to 99, got %v", err)
+	}
+
+	governed := &ChainConfig{ChainID: chainId, GovernanceBlock: big.NewInt(100)}
+	if governed.Rules(big.NewInt(99)).IsGovernance || !governed.Rules(big.NewInt(100)).IsGovernance {
+		t.Fatal("governance rules mismatch")
+	}
+	if err := governed.CheckCompatible(&ChainConfig{ChainID: chainId}, 150); err == nil || err.What != "Governance fork block" {
+		t.Fatalf("expected governance fork error, got %v", err)
+	}
+}
@@ -0,0 +1,59 @@
+package params
+
+import (
+	"math/big"
+	"sync"
+)
+
+// Governed are the parameters the governance changes that the node follows its
+// head with. The globals CountInEpoch, ElectionPoint, MinimumCommitteeNumber
+// and ElectionMinLimitForStaking keep their compile-time values, the values of
+// the head are only read through GetGoverned.
+type Governed struct {
+	CountInEpoch               int
+	ElectionPoint              uint64
+	MinimumCommitteeNumber     int
+	ElectionMinLimitForStaking *big.Int
+}
+
+var (
+	governed   *Governed // nil until the governance of a head is applied
+	governedMu sync.RWMutex
+)
+
+// DefaultGoverned returns the compile-time values of the governed parameters.
+func DefaultGoverned() Governed {
+	return Governed{
+		CountInEpoch:               CountInEpoch,
+		ElectionPoint:              ElectionPoint,
+		MinimumCommitteeNumber:     MinimumCommitteeNumber,
+		ElectionMinLimitForStaking: new(big.Int).Set(ElectionMinLimitForStaking),
+	}
+}
+
+// SetGoverned replaces the values of the governed parameters followed by the
+// node, nil goes back to the compile-time values.
+func SetGoverned(g *Governed) {
+	governedMu.Lock()
+	defer governedMu.Unlock()
+	if g == nil {
+		governed = nil
+		return
+	}
+	cpy := *g
+	cpy.ElectionMinLimitForStaking = new(big.Int).Set(g.ElectionMinLimitForStaking)
+	governed = &cpy
+}
+
+// GetGoverned returns the values of the governed parameters followed by the
+// node.
+func GetGoverned() Governed {
+	governedMu.RLock()
+	defer governedMu.RUnlock()
+	if governed == nil {
+		return DefaultGoverned()
+	}
+	cpy := *governed
+	cpy.ElectionMinLimitForStaking = new(big.Int).Set(governed.ElectionMinLimitForStaking)
+	return cpy
+}
@@ -0,0 +1,31 @@
+package params
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestGoverned(t *testing.T) {
+	defer SetGoverned(nil)
+
+	if g := GetGoverned(); g.CountInEpoch != CountInEpoch || g.ElectionPoint != ElectionPoint {
+		t.Fatalf("default mismatch: have %d/%d", g.CountInEpoch, g.ElectionPoint)
+	}
+	g := DefaultGoverned()
+	g.CountInEpoch, g.ElectionMinLimitForStaking = CountInEpoch+1, big.NewInt(1)
+	SetGoverned(&g)
+	g.ElectionMinLimitForStaking.SetInt64(2)
+
+	have := GetGoverned()
+	if have.CountInEpoch != CountInEpoch+1 || have.ElectionMinLimitForStaking.Int64() != 1 {
+		t.Fatalf("governed mismatch: have %d/%v", have.CountInEpoch, have.ElectionMinLimitForStaking)
+	}
+	have.ElectionMinLimitForStaking.SetInt64(3)
+	if limit := GetGoverned().ElectionMinLimitForStaking; limit.Int64() != 1 {
+		t.Fatalf("governed values shared: have %v", limit)
+	}
+	SetGoverned(nil)
+	if count := GetGoverned().CountInEpoch; count != CountInEpoch {
+		t.Fatalf("reset mismatch: have %d", count)
+	}
+}
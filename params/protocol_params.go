@@ -180,3 +180,36 @@ var (
 	DposForkPoint              uint64 = 0
 	ElectionMinLimitForStaking        = new(big.Int).Mul(big.NewInt(10000), big.NewInt(1e18))
 )
+
+// Slashing parameters of the staking module, the rates are in units of 1/10000.
+var (
+	SlashDuplicateVoteRate  uint64 = 500  // share of the stake taken for signing conflicting votes
+	SlashDowntimeRate       uint64 = 10   // share of the stake taken for signing too few blocks of an epoch
+	SlashReporterRate       uint64 = 1000 // share of the slashed amount paid to the reporter, the rest is burned
+	JailDuplicateVoteEpochs uint64 = 10   // epochs a validator is kept out of the elections for double signing
+	JailDowntimeEpochs      uint64 = 1    // epochs a validator has to wait before unjailing after downtime
+	MaxEvidenceAgeEpochs    uint64 = 1    // evidence of an older epoch than this is rejected
+	DowntimeMinSignedRate   uint64 = 5000 // share of the blocks of the epoch a validator has to sign not to be jailed
+)
+
+// MaxRedelegationEntries is the number of redelegations of a delegator from a
+// validator to another one within the redeem period.
+var MaxRedelegationEntries = 7
+
+// Governance parameters of the staking module, the rates are in units of 1/10000.
+var (
+	GovernanceVotingEpochs  uint64 = 2    // epochs a proposal is open for votes
+	GovernanceApproveRate   uint64 = 6667 // share of the elected stake approving a proposal to pass it
+	GovernanceMaxProposals         = 16   // proposals open for votes at the same time
+	GovernanceHistoryEpochs uint64 = 4    // epochs a closed proposal is kept in the state
+)
+
+// Limits of the validator infos, the fee is in units of 1/10000.
+var (
+	MaxFeeChangePerEpoch  uint64 = 100 // fee a validator can add or take off from one epoch to the next
+	MaxFeeHistory                = 32  // fee changes kept by validator
+	MaxDescriptionName           = 70
+	MaxDescriptionWebsite        = 140
+	MaxDescriptionContact        = 140
+	MaxDescriptionDetails        = 280
+)
@@ -1,8 +1,10 @@
 package params
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"time"
 )
 
@@ -217,6 +219,10 @@ type ConsensusConfig struct {
 	WalPath string `mapstructure:"wal_file"`
 	walFile string // overrides WalPath if set
 
+	// Last height/round/step signed by the local validator, so that a restarted
+	// node never signs a conflicting vote or proposal
+	PrivValidatorStatePath string `mapstructure:"priv_validator_state_file"`
+
 	// All timeouts are in milliseconds
 	TimeoutPropose        int `mapstructure:"timeout_propose"`
 	TimeoutProposeDelta   int `mapstructure:"timeout_propose_delta"`
@@ -243,6 +249,7 @@ type ConsensusConfig struct {
 func DefaultConsensusConfig() *ConsensusConfig {
 	return &ConsensusConfig{
 		WalPath:                     filepath.Join(defaultDataDir, "cs.wal", "wal"),
+		PrivValidatorStatePath:      filepath.Join(defaultDataDir, "priv_validator_state.json"),
 		TimeoutPropose:              30000,
 		TimeoutProposeDelta:         5000,
 		TimeoutPrevote:              3000,
@@ -361,6 +368,30 @@ func (cfg *ConsensusConfig) SetWalFile(walFile string) {
 	cfg.walFile = walFile
 }
 
+// CommitteeWalFile returns the full path to the write-ahead log file of the committee with the given id,
+// or an empty string if the write-ahead log is disabled
+func (cfg *ConsensusConfig) CommitteeWalFile(cid uint64) string {
+	if cfg.WalPath == "" && cfg.walFile == "" {
+		return ""
+	}
+	walFile := cfg.WalFile()
+	return filepath.Join(filepath.Dir(walFile), strconv.FormatUint(cid, 10), filepath.Base(walFile))
+}
+
+// CommitteePrivValidatorStateFile returns the full path to the last-signed state file of the committee
+// with the given id, or an empty string if the state is kept in memory only. A path not made absolute by
+// RootDir is refused, the state would follow the working directory of the node.
+func (cfg *ConsensusConfig) CommitteePrivValidatorStateFile(cid uint64) (string, error) {
+	if cfg.PrivValidatorStatePath == "" {
+		return "", nil
+	}
+	stateFile := rootify(cfg.PrivValidatorStatePath, cfg.RootDir)
+	if !filepath.IsAbs(stateFile) {
+		return "", fmt.Errorf("priv validator state file %q is relative, set the root dir", stateFile)
+	}
+	return filepath.Join(filepath.Dir(stateFile), strconv.FormatUint(cid, 10), filepath.Base(stateFile)), nil
+}
+
 //-----------------------------------------------------------------------------
 // Utils
 
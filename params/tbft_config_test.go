@@ -0,0 +1,26 @@
+package params
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestCommitteePrivValidatorStateFile(t *testing.T) {
+	cfg := DefaultConsensusConfig()
+	if _, err := cfg.CommitteePrivValidatorStateFile(1); err == nil {
+		t.Fatal("relative state file accepted without a root dir")
+	}
+	root, _ := filepath.Abs("root")
+	cfg.RootDir = root
+	file, err := cfg.CommitteePrivValidatorStateFile(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := filepath.Join(root, defaultDataDir, "1", "priv_validator_state.json"); file != want {
+		t.Fatalf("state file mismatch: have %s, want %s", file, want)
+	}
+	cfg.PrivValidatorStatePath = ""
+	if file, err := cfg.CommitteePrivValidatorStateFile(1); file != "" || err != nil {
+		t.Fatalf("in memory state: have %q, %v", file, err)
+	}
+}
@@ -40,6 +40,9 @@ import (
 	"git.taiyue.io/pist/go-pist/trie"
 )
 
+// blockStatsChanSize is the size of channel listening to BlockStatsEvent.
+const blockStatsChanSize = 10
+
 // PublicTruechainAPI provides an API to access Pistchain full node-related
 // information.
 type PublicTruechainAPI struct {
@@ -84,6 +87,66 @@ func (api *PublicTruechainAPI) ChainId() hexutil.Uint64 {
 	return (hexutil.Uint64)(chainID.Uint64())
 }
 
+// BlockStats is the processing record of a block inserted in the chain, the
+// times are in nanoseconds. The headers are verified in parallel ahead of the
+// import, verify is the wait on the one of the block and its body validation.
+type BlockStats struct {
+	Number       hexutil.Uint64 `json:"number"`
+	Hash         common.Hash    `json:"hash"`
+	Transactions hexutil.Uint   `json:"transactions"`
+	GasUsed      hexutil.Uint64 `json:"gasUsed"`
+	Verify       hexutil.Uint64 `json:"verify"`
+	Execute      hexutil.Uint64 `json:"execute"`
+	Validate     hexutil.Uint64 `json:"validate"`
+	Commit       hexutil.Uint64 `json:"commit"`
+}
+
+// newBlockStats converts a BlockStatsEvent to its RPC representation.
+func newBlockStats(ev types.BlockStatsEvent) *BlockStats {
+	return &BlockStats{
+		Number:       hexutil.Uint64(ev.Block.NumberU64()),
+		Hash:         ev.Block.Hash(),
+		Transactions: hexutil.Uint(len(ev.Block.Transactions())),
+		GasUsed:      hexutil.Uint64(ev.GasUsed),
+		Verify:       hexutil.Uint64(ev.Verify),
+		Execute:      hexutil.Uint64(ev.Execute),
+		Validate:     hexutil.Uint64(ev.Validate),
+		Commit:       hexutil.Uint64(ev.Commit),
+	}
+}
+
+// BlockProcessing creates a subscription that fires with the processing record
+// of every block inserted in the chain.
+func (api *PublicTruechainAPI) BlockProcessing(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	rpcSub := notifier.CreateSubscription()
+
+	// Subscribed before returning, not to miss the blocks inserted right after
+	stats := make(chan types.BlockStatsEvent, blockStatsChanSize)
+	statsSub := api.e.blockchain.SubscribeBlockStatsEvent(stats)
+
+	go func() {
+		for {
+			select {
+			case ev := <-stats:
+				notifier.Notify(rpcSub.ID, newBlockStats(ev))
+			case <-rpcSub.Err():
+				statsSub.Unsubscribe()
+				return
+			case <-notifier.Closed():
+				statsSub.Unsubscribe()
+				return
+			}
+		}
+	}()
+
+	return rpcSub, nil
+}
+
 // PrivateAdminAPI is the collection of Pistchain full node-related APIs
 // exposed over the private admin endpoint.
 type PrivateAdminAPI struct {
@@ -191,12 +254,7 @@ func NewPublicDebugAPI(pist *Pistchain) *PublicDebugAPI {
 
 // DumpBlock retrieves the entire state of the database at a given block.
 func (api *PublicDebugAPI) DumpBlock(blockNr rpc.BlockNumber) (state.Dump, error) {
-	var block *types.Block
-	if blockNr == rpc.LatestBlockNumber {
-		block = api.pist.blockchain.CurrentBlock()
-	} else {
-		block = api.pist.blockchain.GetBlockByNumber(uint64(blockNr))
-	}
+	block := api.pist.blockchain.GetBlockByNumber(blockNumber(api.pist.blockchain, blockNr))
 	if block == nil {
 		return state.Dump{}, fmt.Errorf("block #%d not found", blockNr)
 	}
@@ -59,14 +59,26 @@ func (b *TrueAPIBackend) SetHead(number uint64) {
 	b.pist.blockchain.SetHead(number)
 }
 
+// headReader is the part of the chain the block number tags are resolved with.
+type headReader interface {
+	CurrentBlock() *types.Block
+}
+
+// blockNumber resolves the tags of nr to the number of a block. "pending",
+// "latest", "finalized" and "safe" are all the head since the committee
+// commits a block before it is inserted.
+func blockNumber(chain headReader, nr rpc.BlockNumber) uint64 {
+	if nr < rpc.EarliestBlockNumber {
+		return chain.CurrentBlock().NumberU64()
+	}
+	return uint64(nr)
+}
+
 // HeaderByNumber returns Header of fast chain by the number
 // rpc.PendingBlockNumber == "pending"; rpc.LatestBlockNumber == "latest" ; rpc.LatestBlockNumber == "earliest"
+// rpc.FinalizedBlockNumber == "finalized"; rpc.SafeBlockNumber == "safe"
 func (b *TrueAPIBackend) HeaderByNumber(ctx context.Context, blockNr rpc.BlockNumber) (*types.Header, error) {
-	// Otherwise resolve and return the block
-	if blockNr == rpc.LatestBlockNumber {
-		return b.pist.blockchain.CurrentBlock().Header(), nil
-	}
-	return b.pist.blockchain.GetHeaderByNumber(uint64(blockNr)), nil
+	return b.pist.blockchain.GetHeaderByNumber(blockNumber(b.pist.blockchain, blockNr)), nil
 }
 
 // HeaderByHash returns header of fast chain by the hash
@@ -76,15 +88,7 @@ func (b *TrueAPIBackend) HeaderByHash(ctx context.Context, hash common.Hash) (*t
 
 // BlockByNumber returns block of fast chain by the number
 func (b *TrueAPIBackend) BlockByNumber(ctx context.Context, blockNr rpc.BlockNumber) (*types.Block, error) {
-	if blockNr == rpc.PendingBlockNumber {
-		block := b.pist.blockchain.CurrentBlock()
-		return block, nil
-	}
-	// Otherwise resolve and return the block
-	if blockNr == rpc.LatestBlockNumber {
-		return b.pist.blockchain.CurrentBlock(), nil
-	}
-	return b.pist.blockchain.GetBlockByNumber(uint64(blockNr)), nil
+	return b.pist.blockchain.GetBlockByNumber(blockNumber(b.pist.blockchain, blockNr)), nil
 }
 
 // StateAndHeaderByNumber returns the state of block by the number
@@ -169,17 +173,16 @@ func (b *TrueAPIBackend) SubscribeLogsEvent(ch chan<- []*types.Log) event.Subscr
 }
 
 func (b *TrueAPIBackend) GetChainRewardContent(blockNr rpc.BlockNumber) *types.ChainReward {
-	sheight := uint64(blockNr)
-	return b.pist.blockchain.GetRewardInfos(sheight)
+	return b.pist.blockchain.GetRewardInfos(blockNumber(b.pist.blockchain, blockNr))
 }
 
 // GetStateChangeByFastNumber returns the Committee info by committee number
 func (b *TrueAPIBackend) GetStateChangeByFastNumber(fastNumber rpc.BlockNumber) *types.BlockBalance {
-	return b.pist.blockchain.GetBalanceInfos(uint64(fastNumber))
+	return b.pist.blockchain.GetBalanceInfos(blockNumber(b.pist.blockchain, fastNumber))
 }
 
 func (b *TrueAPIBackend) GetCommittee(number rpc.BlockNumber) (map[string]interface{}, error) {
-	if number == rpc.LatestBlockNumber {
+	if number < rpc.EarliestBlockNumber {
 		return b.pist.election.GetCommitteeById(new(big.Int).SetUint64(b.pist.agent.CommitteeNumber())), nil
 	}
 	return b.pist.election.GetCommitteeById(big.NewInt(number.Int64())), nil
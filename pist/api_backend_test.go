@@ -0,0 +1,36 @@
+package pist
+
+import (
+	"math/big"
+	"testing"
+
+	"git.taiyue.io/pist/go-pist/core/types"
+	"git.taiyue.io/pist/go-pist/rpc"
+)
+
+// testHead is a chain whose head is the block of a number.
+type testHead uint64
+
+func (h testHead) CurrentBlock() *types.Block {
+	return types.NewBlockWithHeader(&types.Header{Number: new(big.Int).SetUint64(uint64(h))})
+}
+
+func TestBlockNumber(t *testing.T) {
+	head := testHead(100)
+	for _, test := range []struct {
+		nr   rpc.BlockNumber
+		want uint64
+	}{
+		{rpc.EarliestBlockNumber, 0},
+		{rpc.BlockNumber(42), 42},
+		{rpc.LatestBlockNumber, 100},
+		{rpc.PendingBlockNumber, 100},
+		// the committee commits the blocks before they are inserted
+		{rpc.FinalizedBlockNumber, 100},
+		{rpc.SafeBlockNumber, 100},
+	} {
+		if have := blockNumber(head, test.nr); have != test.want {
+			t.Errorf("block number %d mismatch: have %d, want %d", test.nr, have, test.want)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package pist
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"git.taiyue.io/pist/go-pist/consensus/minerva"
+	"git.taiyue.io/pist/go-pist/core"
+	"git.taiyue.io/pist/go-pist/core/vm"
+	"git.taiyue.io/pist/go-pist/params"
+	"git.taiyue.io/pist/go-pist/pistdb"
+	"git.taiyue.io/pist/go-pist/rpc"
+)
+
+// Tests pist_subscribe("blockProcessing") notifies the record of every block
+// inserted in the chain.
+func TestBlockProcessingSubscription(t *testing.T) {
+	engine := minerva.NewFaker()
+	gendb := pistdb.NewMemDatabase()
+	genesis := core.DefaultGenesisBlock().MustFastCommit(gendb)
+	blocks, _ := core.GenerateChain(params.TestChainConfig, genesis, engine, gendb, 3, nil)
+
+	db := pistdb.NewMemDatabase()
+	core.DefaultGenesisBlock().MustFastCommit(db)
+	chain, err := core.NewBlockChain(db, nil, params.AllMinervaProtocolChanges, engine, vm.Config{})
+	if err != nil {
+		t.Fatalf("failed to create tester chain: %v", err)
+	}
+	defer chain.Stop()
+
+	server := rpc.NewServer()
+	if err := server.RegisterName("pist", NewPublicTruechainAPI(&Pistchain{blockchain: chain})); err != nil {
+		t.Fatal(err)
+	}
+	client := rpc.DialInProc(server)
+	defer client.Close()
+
+	stats := make(chan *BlockStats, len(blocks))
+	sub, err := client.Subscribe(context.Background(), "pist", stats, "blockProcessing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sub.Unsubscribe()
+
+	if _, err := chain.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to insert blocks: %v", err)
+	}
+	for i, block := range blocks {
+		select {
+		case s := <-stats:
+			if uint64(s.Number) != block.NumberU64() || s.Hash != block.Hash() {
+				t.Fatalf("stats %d: block mismatch: have #%d [%x], want #%d [%x]", i, s.Number, s.Hash, block.NumberU64(), block.Hash())
+			}
+		case err := <-sub.Err():
+			t.Fatalf("subscription failed: %v", err)
+		case <-time.After(time.Second):
+			t.Fatalf("stats %d not notified", i)
+		}
+	}
+}
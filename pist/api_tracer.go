@@ -19,6 +19,7 @@ package pist
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -36,6 +37,7 @@ import (
 	"git.taiyue.io/pist/go-pist/internal/pistapi"
 	"git.taiyue.io/pist/go-pist/log"
 	"git.taiyue.io/pist/go-pist/pist/tracers"
+	"git.taiyue.io/pist/go-pist/pist/tracers/native"
 	"git.taiyue.io/pist/go-pist/rlp"
 	"git.taiyue.io/pist/go-pist/rpc"
 	"git.taiyue.io/pist/go-pist/trie"
@@ -58,6 +60,9 @@ type TraceConfig struct {
 	Tracer  *string
 	Timeout *string
 	Reexec  *uint64
+	// TracerConfig is the config of a native tracer, the prestateTracer takes
+	// {"diffMode": true} to return the state after the transaction as well.
+	TracerConfig json.RawMessage
 }
 
 // txTraceResult is the result of a single transaction trace.
@@ -94,24 +99,8 @@ type txTraceTask struct {
 // between two blocks (excluding start) and returns them as a JSON object.
 func (api *PrivateDebugAPI) TraceChain(ctx context.Context, start, end rpc.BlockNumber, config *TraceConfig) (*rpc.Subscription, error) {
 	// Fetch the block interval that we want to trace
-	var from, to *types.Block
-
-	switch start {
-	// case rpc.PendingBlockNumber:
-	// 	from = api.pist.miner.PendingBlock()
-	case rpc.LatestBlockNumber:
-		from = api.pist.blockchain.CurrentBlock()
-	default:
-		from = api.pist.blockchain.GetBlockByNumber(uint64(start))
-	}
-	switch end {
-	// case rpc.PendingBlockNumber:
-	// 	to = api.pist.miner.PendingBlock()
-	case rpc.LatestBlockNumber:
-		to = api.pist.blockchain.CurrentBlock()
-	default:
-		to = api.pist.blockchain.GetBlockByNumber(uint64(end))
-	}
+	from := api.pist.blockchain.GetBlockByNumber(blockNumber(api.pist.blockchain, start))
+	to := api.pist.blockchain.GetBlockByNumber(blockNumber(api.pist.blockchain, end))
 	// Trace the chain if we've found all our blocks
 	if from == nil {
 		return nil, fmt.Errorf("starting block #%d not found", start)
@@ -339,16 +328,7 @@ func (api *PrivateDebugAPI) traceChain(ctx context.Context, start, end *types.Bl
 // EVM and returns them as a JSON object.
 func (api *PrivateDebugAPI) TraceBlockByNumber(ctx context.Context, number rpc.BlockNumber, config *TraceConfig) ([]*txTraceResult, error) {
 	// Fetch the block that we want to trace
-	var block *types.Block
-
-	switch number {
-	// case rpc.PendingBlockNumber:
-	// 	block = api.pist.miner.PendingBlock()
-	case rpc.LatestBlockNumber:
-		block = api.pist.blockchain.CurrentBlock()
-	default:
-		block = api.pist.blockchain.GetBlockByNumber(uint64(number))
-	}
+	block := api.pist.blockchain.GetBlockByNumber(blockNumber(api.pist.blockchain, number))
 	// Trace the block if it was found
 	if block == nil {
 		return nil, fmt.Errorf("block #%d not found", number)
@@ -572,15 +552,21 @@ func (api *PrivateDebugAPI) traceTx(ctx context.Context, message core.Message, v
 				return nil, err
 			}
 		}
-		// Constuct the JavaScript tracer to execute with
-		if tracer, err = tracers.New(*config.Tracer); err != nil {
+		// Constuct the native tracer of the name, or else the JavaScript one
+		nativeTracer, ok, err := native.New(*config.Tracer, statedb, config.TracerConfig)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			tracer = nativeTracer
+		} else if tracer, err = tracers.New(*config.Tracer); err != nil {
 			return nil, err
 		}
 		// Handle timeouts and RPC cancellations
 		deadlineCtx, cancel := context.WithTimeout(ctx, timeout)
 		go func() {
 			<-deadlineCtx.Done()
-			tracer.(*tracers.Tracer).Stop(errors.New("execution timeout"))
+			tracer.(native.Tracer).Stop(errors.New("execution timeout"))
 		}()
 		defer cancel()
 
@@ -612,7 +598,7 @@ func (api *PrivateDebugAPI) traceTx(ctx context.Context, message core.Message, v
 			StructLogs:  pistapi.FormatLogs(tracer.StructLogs()),
 		}, nil
 
-	case *tracers.Tracer:
+	case native.Tracer:
 		return tracer.GetResult()
 
 	default:
@@ -73,7 +73,8 @@ type Pistchain struct {
 	protocolManager *ProtocolManager
 	lesServer       LesServer
 	// DB interfaces
-	chainDb pistdb.Database // Block chain database
+	chainDb pistdb.Database     // Block chain database
+	freezer *rawdb.ChainFreezer // Moves the ancient blocks out of leveldb, nil without an ancient store
 
 	eventMux       *event.TypeMux
 	engine         consensus.Engine
@@ -88,6 +89,7 @@ type Pistchain struct {
 	netRPCService *pistapi.PublicNetAPI
 
 	pbftServer *tbft.Node
+	tbftDir    string // Root of the pbft server files, empty for an ephemeral node
 
 	lock sync.RWMutex // Protects the variadic fields (e.g. gas price)
 }
@@ -135,6 +137,7 @@ func New(ctx *node.ServiceContext, config *Config) (*Pistchain, error) {
 		gasPrice:       config.GasPrice,
 		bloomRequests:  make(chan chan *bloombits.Retrieval),
 		bloomIndexer:   NewBloomIndexer(chainDb, params.BloomBitsBlocks, params.BloomConfirms),
+		tbftDir:        ctx.ResolvePath(params.DefaultTBFTDir),
 	}
 
 	log.Info("Initialising Pistchain protocol", "versions", ProtocolVersions, "network", config.NetworkId, "syncmode", config.SyncMode)
@@ -164,6 +167,9 @@ func New(ctx *node.ServiceContext, config *Config) (*Pistchain, error) {
 	}
 
 	pist.bloomIndexer.Start(pist.blockchain)
+	if _, err := chainDb.Ancients(); err == nil {
+		pist.freezer = rawdb.NewChainFreezer(chainDb, params.ImmutabilityThreshold)
+	}
 	consensus.InitDPos(chainConfig)
 	if config.TxPool.Journal != "" {
 		config.TxPool.Journal = ctx.ResolvePath(config.TxPool.Journal)
@@ -212,7 +218,7 @@ func makeExtraData(extra []byte) []byte {
 
 // CreateDB creates the chain database.
 func CreateDB(ctx *node.ServiceContext, config *Config, name string) (pistdb.Database, error) {
-	db, err := ctx.OpenDatabase(name, config.DatabaseCache, config.DatabaseHandles)
+	db, err := ctx.OpenDatabaseWithFreezer(name, config.DatabaseCache, config.DatabaseHandles, config.DatabaseFreezer, "pist/db/chaindata/")
 	if err != nil {
 		return nil, err
 	}
@@ -341,6 +347,11 @@ func (s *Pistchain) Start(srvr *p2p.Server) error {
 	// Start the bloom bits servicing goroutines
 	s.startBloomHandlers()
 
+	// Start moving the ancient blocks into the ancient store
+	if s.freezer != nil {
+		s.freezer.Start()
+	}
+
 	// Start the RPC service
 	s.netRPCService = pistapi.NewPublicNetAPI(srvr, s.NetVersion())
 
@@ -381,6 +392,9 @@ func (s *Pistchain) Stop() error {
 	s.txPool.Stop()
 	s.eventMux.Stop()
 
+	if s.freezer != nil {
+		s.freezer.Stop()
+	}
 	s.chainDb.Close()
 	close(s.shutdownChan)
 
@@ -396,6 +410,13 @@ func (s *Pistchain) startPbftServer() error {
 	cfg := config.DefaultConfig()
 	cfg.P2P.ListenAddress1 = "tcp://0.0.0.0:" + strconv.Itoa(s.config.Port)
 	cfg.P2P.ListenAddress2 = "tcp://0.0.0.0:" + strconv.Itoa(s.config.StandbyPort)
+	if s.tbftDir != "" {
+		cfg.Consensus.RootDir = s.tbftDir
+	} else {
+		// nothing to recover for an ephemeral node
+		cfg.Consensus.WalPath = ""
+		cfg.Consensus.PrivValidatorStatePath = ""
+	}
 
 	n1, err := tbft.NewNode(cfg, "1", priv, s.agent)
 	if err != nil {
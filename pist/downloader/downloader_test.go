@@ -391,15 +391,17 @@ func (dlp *downloadTesterPeer) RequestBodies(hashes []common.Hash) error {
 	transactions := make([][]*types.Transaction, 0, len(hashes))
 	signs := make([][]*types.PbftSign, 0, len(hashes))
 	infos := make([][]*types.CommitteeMember, 0, len(hashes))
+	parentSigns := make([][]*types.PbftSign, 0, len(hashes))
 
 	for _, hash := range hashes {
 		if block, ok := dlp.chain.blockm[hash]; ok {
 			transactions = append(transactions, block.Transactions())
 			signs = append(signs, block.Signs())
 			infos = append(infos, block.SwitchInfos())
+			parentSigns = append(parentSigns, block.ParentSigns())
 		}
 	}
-	go dlp.dl.downloader.DeliverBodies(dlp.id, transactions, signs, infos)
+	go dlp.dl.downloader.DeliverBodies(dlp.id, transactions, signs, infos, parentSigns)
 
 	return nil
 }
@@ -592,7 +594,7 @@ func TestInactiveDownloader62(t *testing.T) {
 	if err := tester.downloader.DeliverHeaders("bad peer", []*types.Header{}); err != errNoSyncActive {
 		t.Errorf("error mismatch: have %v, want %v", err, errNoSyncActive)
 	}
-	if err := tester.downloader.DeliverBodies("bad peer", [][]*types.Transaction{}, [][]*types.PbftSign{}, [][]*types.CommitteeMember{}); err != errNoSyncActive {
+	if err := tester.downloader.DeliverBodies("bad peer", [][]*types.Transaction{}, [][]*types.PbftSign{}, [][]*types.CommitteeMember{}, [][]*types.PbftSign{}); err != errNoSyncActive {
 		t.Errorf("error mismatch: have %v, want  %v", err, errNoSyncActive)
 	}
 }
@@ -609,7 +611,7 @@ func TestInactiveDownloader63(t *testing.T) {
 	if err := tester.downloader.DeliverHeaders("bad peer", []*types.Header{}); err != errNoSyncActive {
 		t.Errorf("error mismatch: have %v, want %v", err, errNoSyncActive)
 	}
-	if err := tester.downloader.DeliverBodies("bad peer", [][]*types.Transaction{}, [][]*types.PbftSign{}, [][]*types.CommitteeMember{}); err != errNoSyncActive {
+	if err := tester.downloader.DeliverBodies("bad peer", [][]*types.Transaction{}, [][]*types.PbftSign{}, [][]*types.CommitteeMember{}, [][]*types.PbftSign{}); err != errNoSyncActive {
 		t.Errorf("error mismatch: have %v, want %v", err, errNoSyncActive)
 	}
 	if err := tester.downloader.DeliverReceipts("bad peer", [][]*types.Receipt{}); err != errNoSyncActive {
@@ -123,18 +123,20 @@ func (p *FakePeer) RequestHeadersByNumber(number uint64, amount int, skip int, r
 // corresponding to the specified block hashes.
 func (p *FakePeer) RequestBodies(hashes []common.Hash) error {
 	var (
-		txs   [][]*types.Transaction
-		signs [][]*types.PbftSign
-		infos [][]*types.CommitteeMember
+		txs         [][]*types.Transaction
+		signs       [][]*types.PbftSign
+		infos       [][]*types.CommitteeMember
+		parentSigns [][]*types.PbftSign
 	)
 	for _, hash := range hashes {
 		block := rawdb.ReadBlock(p.db, hash, *p.hc.GetBlockNumber(hash))
 		signs = append(signs, block.Signs())
 		txs = append(txs, block.Transactions())
 		infos = append(infos, block.SwitchInfos())
+		parentSigns = append(parentSigns, block.ParentSigns())
 	}
 
-	p.dl.DeliverBodies(p.id, txs, signs, infos)
+	p.dl.DeliverBodies(p.id, txs, signs, infos, parentSigns)
 	return nil
 }
 
@@ -40,4 +40,7 @@ var (
 
 	stateInMeter   = metrics.NewRegisteredMeter("pist/downloader/states/in", nil)
 	stateDropMeter = metrics.NewRegisteredMeter("pist/downloader/states/drop", nil)
+
+	snapInMeter   = metrics.NewRegisteredMeter("pist/downloader/snap/in", nil)
+	snapDropMeter = metrics.NewRegisteredMeter("pist/downloader/snap/drop", nil)
 )
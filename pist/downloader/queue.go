@@ -61,6 +61,7 @@ type fetchResult struct {
 	Receipts     types.Receipts
 	Signs        []*types.PbftSign
 	Infos        types.CommitteeMembers
+	ParentSigns  []*types.PbftSign
 }
 
 // queue represents hashes that are either need fetching or are being fetched
@@ -763,7 +764,7 @@ func (q *queue) DeliverHeaders(id string, headers []*types.Header, headerProcCh
 // DeliverBodies injects a block body retrieval response into the results queue.
 // The method returns the number of blocks bodies accepted from the delivery and
 // also wakes any threads waiting for data delivery.
-func (q *queue) DeliverBodies(id string, txLists [][]*types.Transaction, signs [][]*types.PbftSign, infos [][]*types.CommitteeMember) (int, error) {
+func (q *queue) DeliverBodies(id string, txLists [][]*types.Transaction, signs [][]*types.PbftSign, infos [][]*types.CommitteeMember, parentSigns [][]*types.PbftSign) (int, error) {
 	q.lock.Lock()
 	defer q.lock.Unlock()
 
@@ -772,7 +773,7 @@ func (q *queue) DeliverBodies(id string, txLists [][]*types.Transaction, signs [
 			return errInvalidChain
 		}
 
-		if types.RlpHash(infos[index]) != header.CommitteeHash {
+		if types.CalcCommitteeHash(infos[index], parentSigns[index]) != header.CommitteeHash {
 			return errInvalidChain
 		}
 
@@ -785,6 +786,7 @@ func (q *queue) DeliverBodies(id string, txLists [][]*types.Transaction, signs [
 		result.Transactions = txLists[index]
 		result.Signs = signs[index]
 		result.Infos = infos[index]
+		result.ParentSigns = parentSigns[index]
 		return nil
 	}
 	return q.deliver(id, q.blockTaskPool, q.blockTaskQueue, q.blockPendPool, q.blockDonePool, bodyReqTimer, len(txLists), reconstruct)
@@ -0,0 +1,667 @@
+// Copyright 2020 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package downloader
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/core/rawdb"
+	"git.taiyue.io/pist/go-pist/core/state"
+	"git.taiyue.io/pist/go-pist/core/types"
+	"git.taiyue.io/pist/go-pist/crypto"
+	"git.taiyue.io/pist/go-pist/log"
+	"git.taiyue.io/pist/go-pist/pistdb"
+	"git.taiyue.io/pist/go-pist/rlp"
+	"git.taiyue.io/pist/go-pist/trie"
+)
+
+const (
+	snapAccountChunks = 16         // Number of chunks the account range is split into
+	snapStorageBatch  = 16         // Maximum number of storage ranges requested at once
+	snapCodeBatch     = 64         // Maximum number of contract codes requested at once
+	snapResponseBytes = 512 * 1024 // Soft limit of the size of a state range response
+	maxSnapRequests   = 64         // Maximum number of state range responses buffered
+	snapFlushAccounts = 65536      // Accounts imported between two flushes of the account trie
+)
+
+var (
+	emptyCode = crypto.Keccak256Hash(nil)
+	maxHash   = common.HexToHash("0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+
+	errStateRangeUnavailable = errors.New("state range not served")
+)
+
+// accountTask is a chunk of the account range, retrieved from next to last.
+type accountTask struct {
+	next common.Hash // Next account to retrieve
+	last common.Hash // Last account of the chunk
+	busy bool        // Whether the chunk is being retrieved
+	done bool        // Whether the chunk is complete
+}
+
+// storageTask is the storage of an account, large storages are retrieved over
+// several responses into a partial trie.
+type storageTask struct {
+	account common.Hash // Hash of the account owning the storage
+	root    common.Hash // Storage root of the account
+	next    common.Hash // Next storage slot to retrieve
+	trie    *trie.Trie  // Storage trie of the slots retrieved so far
+}
+
+// snapReq is a state range request in flight, it holds one of the kinds of
+// tasks.
+type snapReq struct {
+	peer     *peerConnection
+	timer    *time.Timer
+	account  *accountTask   // Account chunk requested
+	storages []*storageTask // Storages requested, the first may be partial
+	codes    []common.Hash  // Contract codes requested
+}
+
+// snapProgress is the stored progress of the retrieval of the state ranges of
+// Root, the tries are flushed to the database up to it.
+type snapProgress struct {
+	Root     common.Hash           // State root of the sync
+	Trie     common.Hash           // Root of the account trie flushed so far
+	Accounts []snapAccountProgress // Account chunks
+	Storages []snapStorageProgress // Storages waiting for retrieval
+	Codes    []common.Hash         // Contract codes waiting for retrieval
+}
+
+// snapAccountProgress is the stored progress of an account chunk.
+type snapAccountProgress struct {
+	Next common.Hash
+	Last common.Hash
+	Done bool
+}
+
+// snapStorageProgress is the stored progress of a storage, Trie is the root of
+// the partial storage trie retrieved up to Next, or empty if none is.
+type snapStorageProgress struct {
+	Account common.Hash
+	Root    common.Hash
+	Next    common.Hash
+	Trie    common.Hash
+}
+
+// snapSyncer retrieves the state of a root in contiguous ranges, each one proven
+// by the edge proofs against the root. The storage tries and codes are written
+// as soon as they are verified. The account trie and the partial storage tries
+// are flushed to the database as the ranges are imported, with the progress of
+// the tasks, so that a sync of the same root resumes from there.
+type snapSyncer struct {
+	s       *stateSync
+	triedb  *trie.Database
+	accTrie *trie.Trie
+
+	accounts []*accountTask
+	storages []*storageTask       // Storages waiting for retrieval
+	codes    []common.Hash        // Contract codes waiting for retrieval
+	queued   map[common.Hash]bool // Storage roots and code hashes queued already
+	active   map[string]*snapReq  // Requests in flight by peer
+	stale    map[string]struct{}  // Peers not serving the ranges of the root
+
+	accountsDone, slotsDone, codesDone int
+	flushed                            int // Accounts imported at the last flush
+}
+
+// snap retrieves the state in ranges from the eth/66 peers. The trie sync heals
+// whatever it leaves missing, which is the whole state without such peers.
+func (s *stateSync) snap() error {
+	if ok, _ := s.d.stateDB.Has(s.root[:]); ok || s.root == types.EmptyRootHash {
+		rawdb.DeleteSnapSyncProgress(s.d.stateDB)
+		return nil
+	}
+	return newSnapSyncer(s).run()
+}
+
+// newSnapSyncer creates the retrieval of the state ranges of the root of s, it
+// resumes the progress stored for the same root if any.
+func newSnapSyncer(s *stateSync) *snapSyncer {
+	sn := &snapSyncer{
+		s:      s,
+		triedb: trie.NewDatabase(s.d.stateDB),
+		queued: make(map[common.Hash]bool),
+		active: make(map[string]*snapReq),
+		stale:  make(map[string]struct{}),
+	}
+	if sn.resume() {
+		return sn
+	}
+	sn.accTrie, _ = trie.New(common.Hash{}, sn.triedb)
+
+	// Split the account range into chunks to retrieve concurrently
+	step := new(big.Int).Div(new(big.Int).Lsh(common.Big1, 256), big.NewInt(snapAccountChunks))
+	for i := 0; i < snapAccountChunks; i++ {
+		next := new(big.Int).Mul(step, big.NewInt(int64(i)))
+		last := new(big.Int).Sub(new(big.Int).Add(next, step), common.Big1)
+		sn.accounts = append(sn.accounts, &accountTask{next: common.BigToHash(next), last: common.BigToHash(last)})
+	}
+	sn.accounts[snapAccountChunks-1].last = maxHash
+	return sn
+}
+
+// resume restores the tasks and the tries of the progress stored for the root
+// of the sync, it returns false if there is none.
+func (sn *snapSyncer) resume() bool {
+	blob := rawdb.ReadSnapSyncProgress(sn.s.d.stateDB)
+	if len(blob) == 0 {
+		return false
+	}
+	var progress snapProgress
+	if err := rlp.DecodeBytes(blob, &progress); err != nil || progress.Root != sn.s.root {
+		return false
+	}
+	accTrie, err := trie.New(progress.Trie, sn.triedb)
+	if err != nil {
+		log.Warn("Failed to resume state ranges", "root", progress.Root, "err", err)
+		return false
+	}
+	sn.accTrie = accTrie
+	for _, task := range progress.Accounts {
+		sn.accounts = append(sn.accounts, &accountTask{next: task.Next, last: task.Last, done: task.Done})
+	}
+	for _, task := range progress.Storages {
+		st := &storageTask{account: task.Account, root: task.Root}
+		if task.Trie != (common.Hash{}) {
+			// Retrieve the storage from the start if its partial trie is lost
+			if st.trie, err = trie.New(task.Trie, sn.triedb); err != nil {
+				st.trie = nil
+			} else {
+				st.next = task.Next
+			}
+		}
+		sn.storages = append(sn.storages, st)
+		sn.queued[task.Root] = true
+	}
+	for _, hash := range progress.Codes {
+		sn.codes = append(sn.codes, hash)
+		sn.queued[hash] = true
+	}
+	log.Info("Resuming state ranges", "root", progress.Root, "storages", len(sn.storages), "codes", len(sn.codes))
+	return true
+}
+
+// flush writes the account trie retrieved so far to the database and stores
+// the progress of the tasks. The trie is opened again from the flushed root,
+// which drops its nodes from memory. The nodes along the edges of the ranges
+// are written again by the later flushes, the stale ones are left behind.
+func (sn *snapSyncer) flush() error {
+	root, err := sn.accTrie.Commit(nil)
+	if err != nil {
+		return err
+	}
+	if err := sn.triedb.Commit(root, false); err != nil {
+		return err
+	}
+	if sn.accTrie, err = trie.New(root, sn.triedb); err != nil {
+		return err
+	}
+	sn.flushed = sn.accountsDone
+
+	// The tasks in flight are stored as waiting for retrieval
+	progress := &snapProgress{Root: sn.s.root, Trie: root}
+	for _, task := range sn.accounts {
+		progress.Accounts = append(progress.Accounts, snapAccountProgress{Next: task.next, Last: task.last, Done: task.done})
+	}
+	storages := append([]*storageTask(nil), sn.storages...)
+	progress.Codes = append([]common.Hash(nil), sn.codes...)
+	for _, req := range sn.active {
+		storages = append(storages, req.storages...)
+		progress.Codes = append(progress.Codes, req.codes...)
+	}
+	for _, task := range storages {
+		if task == nil {
+			continue
+		}
+		entry := snapStorageProgress{Account: task.account, Root: task.root}
+		if task.trie != nil {
+			entry.Next, entry.Trie = task.next, task.trie.Hash()
+		}
+		progress.Storages = append(progress.Storages, entry)
+	}
+	blob, err := rlp.EncodeToBytes(progress)
+	if err != nil {
+		return err
+	}
+	rawdb.WriteSnapSyncProgress(sn.s.d.stateDB, blob)
+	log.Debug("Flushed state ranges", "root", sn.s.root, "trie", root, "accounts", sn.accountsDone, "storages", len(progress.Storages), "codes", len(progress.Codes))
+	return nil
+}
+
+// finish commits the complete account trie, which must match the root of the
+// sync, and drops the stored progress.
+func (sn *snapSyncer) finish() error {
+	rawdb.DeleteSnapSyncProgress(sn.s.d.stateDB)
+
+	root, err := sn.accTrie.Commit(nil)
+	if err != nil {
+		return err
+	}
+	if root != sn.s.root {
+		return fmt.Errorf("account trie root mismatch: have %x, want %x", root, sn.s.root)
+	}
+	if err := sn.triedb.Commit(root, false); err != nil {
+		return err
+	}
+	log.Info("Imported state ranges", "root", root, "accounts", sn.accountsDone, "slots", sn.slotsDone, "codes", sn.codesDone)
+	return nil
+}
+
+// run assigns the tasks to the idle peers and processes their responses until
+// the state is complete, or no peer serves the ranges any more. The progress
+// of an incomplete sync is flushed for a later sync of the same root.
+func (sn *snapSyncer) run() (err error) {
+	s := sn.s
+
+	newPeer := make(chan *peerConnection, 1024)
+	peerSub := s.d.peers.SubscribeNewPeers(newPeer)
+	defer peerSub.Unsubscribe()
+
+	peerDrop := make(chan *peerConnection, 1024)
+	dropSub := s.d.peers.SubscribePeerDrops(peerDrop)
+	defer dropSub.Unsubscribe()
+
+	timeout := make(chan *snapReq)
+	defer func() {
+		if !sn.done() {
+			if ferr := sn.flush(); err == nil {
+				err = ferr
+			}
+		}
+		for _, req := range sn.active {
+			req.timer.Stop()
+		}
+	}()
+	for !sn.done() {
+		sn.assignTasks(timeout)
+		if len(sn.active) == 0 {
+			log.Info("No peers serving state ranges, healing the state", "root", s.root, "accounts", sn.accountsDone)
+			return nil
+		}
+		select {
+		case <-newPeer:
+			// New peer arrived, try to assign it download tasks
+
+		case <-s.cancel:
+			return errCancelStateFetch
+
+		case <-s.d.cancelCh:
+			return errCanceled
+
+		case p := <-peerDrop:
+			if req := sn.active[p.id]; req != nil {
+				req.timer.Stop()
+				delete(sn.active, p.id)
+				sn.revert(req)
+			}
+
+		case req := <-timeout:
+			// Ignore the stale timeout of a request delivered at the same time
+			if sn.active[req.peer.id] != req {
+				continue
+			}
+			req.peer.log.Debug("State range request timed out")
+			delete(sn.active, req.peer.id)
+			sn.stale[req.peer.id] = struct{}{}
+			sn.revert(req)
+
+		case pack := <-s.snapCh:
+			req := sn.active[pack.PeerId()]
+			if req == nil {
+				log.Debug("Unrequested state range", "peer", pack.PeerId(), "len", pack.Items())
+				continue
+			}
+			req.timer.Stop()
+			delete(sn.active, req.peer.id)
+
+			if err := sn.process(req, pack); err != nil {
+				sn.stale[req.peer.id] = struct{}{}
+				sn.revert(req)
+				if err == errStateRangeUnavailable {
+					req.peer.log.Debug("Peer doesn't serve the state ranges", "root", s.root)
+					continue
+				}
+				req.peer.log.Warn("Invalid state range delivered", "err", err)
+				if s.d.dropPeer != nil {
+					s.d.dropPeer(req.peer.id)
+				}
+			}
+		}
+	}
+	// All the ranges are verified, the account trie must match the root
+	return sn.finish()
+}
+
+// done returns whether all the ranges are retrieved.
+func (sn *snapSyncer) done() bool {
+	if len(sn.active) > 0 || len(sn.storages) > 0 || len(sn.codes) > 0 {
+		return false
+	}
+	for _, task := range sn.accounts {
+		if !task.done {
+			return false
+		}
+	}
+	return true
+}
+
+// assignTasks sends a request to every idle peer serving the ranges.
+func (sn *snapSyncer) assignTasks(timeout chan *snapReq) {
+	s := sn.s
+	for _, p := range s.d.peers.AllPeers() {
+		if _, busy := sn.active[p.id]; busy {
+			continue
+		}
+		if _, stale := sn.stale[p.id]; stale {
+			continue
+		}
+		peer, ok := p.snapPeer()
+		if !ok {
+			continue
+		}
+		req := sn.nextRequest()
+		if req == nil {
+			return
+		}
+		req.peer = p
+		switch {
+		case len(req.codes) > 0:
+			go peer.RequestByteCodes(req.codes, snapResponseBytes)
+
+		case len(req.storages) > 0:
+			accounts := make([]common.Hash, len(req.storages))
+			for i, task := range req.storages {
+				accounts[i] = task.account
+			}
+			go peer.RequestStorageRanges(s.root, accounts, req.storages[0].next, maxHash, snapResponseBytes)
+
+		default:
+			go peer.RequestAccountRange(s.root, req.account.next, req.account.last, snapResponseBytes)
+		}
+		req.timer = time.AfterFunc(s.d.requestTTL(), func() {
+			select {
+			case timeout <- req:
+			case <-s.done:
+			}
+		})
+		sn.active[p.id] = req
+	}
+}
+
+// nextRequest takes the next tasks to request out of the queues, it returns nil
+// if there is none left. The codes and storages are preferred over new accounts
+// to bound the queues.
+func (sn *snapSyncer) nextRequest() *snapReq {
+	req := new(snapReq)
+	switch {
+	case len(sn.codes) > 0:
+		n := len(sn.codes)
+		if n > snapCodeBatch {
+			n = snapCodeBatch
+		}
+		req.codes = append([]common.Hash(nil), sn.codes[:n]...)
+		sn.codes = sn.codes[n:]
+
+	case len(sn.storages) > 0:
+		// A partially retrieved storage is requested alone from where it stopped
+		n := 1
+		if sn.storages[0].trie == nil {
+			for n < len(sn.storages) && n < snapStorageBatch && sn.storages[n].trie == nil {
+				n++
+			}
+		}
+		req.storages = append([]*storageTask(nil), sn.storages[:n]...)
+		sn.storages = sn.storages[n:]
+
+	default:
+		for _, task := range sn.accounts {
+			if !task.busy && !task.done {
+				req.account = task
+				break
+			}
+		}
+		if req.account == nil {
+			return nil
+		}
+		req.account.busy = true
+	}
+	return req
+}
+
+// revert puts the unfinished tasks of a request back into the queues.
+func (sn *snapSyncer) revert(req *snapReq) {
+	if req.account != nil {
+		req.account.busy = false
+	}
+	var storages []*storageTask
+	for _, task := range req.storages {
+		if task != nil {
+			storages = append(storages, task)
+		}
+	}
+	sn.storages = append(storages, sn.storages...)
+	sn.codes = append(sn.codes, req.codes...)
+}
+
+// process verifies a response against the request and imports its ranges.
+func (sn *snapSyncer) process(req *snapReq, pack dataPack) error {
+	switch pack := pack.(type) {
+	case *accountRangePack:
+		if req.account != nil {
+			return sn.processAccounts(req, pack)
+		}
+	case *storageRangesPack:
+		if len(req.storages) > 0 {
+			return sn.processStorages(req, pack)
+		}
+	case *byteCodesPack:
+		if len(req.codes) > 0 {
+			return sn.processCodes(req, pack)
+		}
+	}
+	return errors.New("unexpected state range response")
+}
+
+// processAccounts inserts a proven range of accounts into the account trie and
+// queues the storages and codes missing locally.
+func (sn *snapSyncer) processAccounts(req *snapReq, pack *accountRangePack) error {
+	task := req.account
+	if len(pack.hashes) != len(pack.accounts) {
+		return fmt.Errorf("inconsistent account range, hashes: %d, accounts: %d", len(pack.hashes), len(pack.accounts))
+	}
+	if len(pack.accounts) == 0 && len(pack.proof) == 0 {
+		return errStateRangeUnavailable
+	}
+	keys := make([][]byte, len(pack.hashes))
+	for i := range pack.hashes {
+		keys[i] = pack.hashes[i][:]
+	}
+	last := task.next
+	if len(pack.hashes) > 0 {
+		last = pack.hashes[len(pack.hashes)-1]
+	}
+	more, err := trie.VerifyRangeProof(sn.s.root, task.next[:], last[:], keys, pack.accounts, newProofDb(pack.proof))
+	if err != nil {
+		return err
+	}
+	for i, hash := range pack.hashes {
+		// The first account beyond the chunk belongs to the next one
+		if bytes.Compare(hash[:], task.last[:]) > 0 {
+			break
+		}
+		var account state.Account
+		if err := rlp.DecodeBytes(pack.accounts[i], &account); err != nil {
+			return err
+		}
+		if err := sn.accTrie.TryUpdate(keys[i], pack.accounts[i]); err != nil {
+			return err
+		}
+		if account.Root != types.EmptyRootHash && !sn.queued[account.Root] {
+			if ok, _ := sn.s.d.stateDB.Has(account.Root[:]); !ok {
+				sn.storages = append(sn.storages, &storageTask{account: hash, root: account.Root})
+			}
+			sn.queued[account.Root] = true
+		}
+		if code := common.BytesToHash(account.CodeHash); code != emptyCode && !sn.queued[code] {
+			if ok, _ := sn.s.d.stateDB.Has(code[:]); !ok {
+				sn.codes = append(sn.codes, code)
+			}
+			sn.queued[code] = true
+		}
+		sn.accountsDone++
+	}
+	task.busy = false
+	if !more || bytes.Compare(last[:], task.last[:]) >= 0 {
+		task.done = true
+	} else {
+		task.next = incHash(last)
+	}
+	log.Debug("Imported account range", "accounts", len(pack.accounts), "next", task.next, "done", task.done, "storages", len(sn.storages), "codes", len(sn.codes))
+
+	if task.done || sn.accountsDone-sn.flushed >= snapFlushAccounts {
+		return sn.flush()
+	}
+	return nil
+}
+
+// processStorages fills the storage tries of a batch of accounts, a trie is
+// committed once it's complete and matches the root of the account. Only the
+// last range may be partial, it's proven then by the edge proofs.
+func (sn *snapSyncer) processStorages(req *snapReq, pack *storageRangesPack) error {
+	if len(pack.slots) > len(req.storages) || len(pack.hashes) != len(pack.slots) {
+		return fmt.Errorf("inconsistent storage ranges, requested: %d, hashes: %d, slots: %d", len(req.storages), len(pack.hashes), len(pack.slots))
+	}
+	if len(pack.slots) == 0 {
+		return errStateRangeUnavailable
+	}
+	for i, slots := range pack.slots {
+		task := req.storages[i]
+		if len(pack.hashes[i]) != len(slots) {
+			return fmt.Errorf("inconsistent storage range, hashes: %d, slots: %d", len(pack.hashes[i]), len(slots))
+		}
+		keys := make([][]byte, len(slots))
+		for j := range pack.hashes[i] {
+			keys[j] = pack.hashes[i][j][:]
+		}
+		more := false
+		if i == len(pack.slots)-1 && len(pack.proof) > 0 {
+			last := task.next
+			if len(keys) > 0 {
+				last = pack.hashes[i][len(keys)-1]
+			}
+			var err error
+			if more, err = trie.VerifyRangeProof(task.root, task.next[:], last[:], keys, slots, newProofDb(pack.proof)); err != nil {
+				return err
+			}
+			if more {
+				task.next = incHash(last)
+			}
+		}
+		if task.trie == nil {
+			task.trie, _ = trie.New(common.Hash{}, sn.triedb)
+		}
+		for j, key := range keys {
+			if err := task.trie.TryUpdate(key, slots[j]); err != nil {
+				return err
+			}
+		}
+		sn.slotsDone += len(keys)
+		if more {
+			// Flush the partial trie, the next range continues from its root
+			root, err := task.trie.Commit(nil)
+			if err != nil {
+				return err
+			}
+			if err := sn.triedb.Commit(root, false); err != nil {
+				return err
+			}
+			if task.trie, err = trie.New(root, sn.triedb); err != nil {
+				return err
+			}
+			sn.storages = append([]*storageTask{task}, sn.storages...)
+			req.storages[i] = nil
+			continue
+		}
+		root, err := task.trie.Commit(nil)
+		if err != nil {
+			return err
+		}
+		if root != task.root {
+			// Retrieve the whole storage again from another peer
+			task.trie, task.next = nil, common.Hash{}
+			return fmt.Errorf("storage root mismatch of %x: have %x, want %x", task.account, root, task.root)
+		}
+		if err := sn.triedb.Commit(root, false); err != nil {
+			return err
+		}
+		req.storages[i] = nil
+	}
+	// Put the storages not served back into the queue
+	sn.revert(&snapReq{storages: req.storages[len(pack.slots):]})
+	return nil
+}
+
+// processCodes writes the requested contract codes, the ones not delivered are
+// queued again.
+func (sn *snapSyncer) processCodes(req *snapReq, pack *byteCodesPack) error {
+	if len(pack.codes) == 0 {
+		return errStateRangeUnavailable
+	}
+	want := make(map[common.Hash]bool, len(req.codes))
+	for _, hash := range req.codes {
+		want[hash] = true
+	}
+	batch := sn.s.d.stateDB.NewBatch()
+	for _, code := range pack.codes {
+		hash := crypto.Keccak256Hash(code)
+		if !want[hash] {
+			return fmt.Errorf("unexpected code %x", hash)
+		}
+		batch.Put(hash[:], code)
+		delete(want, hash)
+	}
+	if err := batch.Write(); err != nil {
+		return fmt.Errorf("DB write error: %v", err)
+	}
+	sn.codesDone += len(pack.codes)
+	for _, hash := range req.codes {
+		if want[hash] {
+			sn.codes = append(sn.codes, hash)
+		}
+	}
+	return nil
+}
+
+// newProofDb returns the database of the proof nodes keyed by hash.
+func newProofDb(proof [][]byte) *pistdb.MemDatabase {
+	db := pistdb.NewMemDatabase()
+	for _, node := range proof {
+		db.Put(crypto.Keccak256(node), node)
+	}
+	return db
+}
+
+// incHash returns the hash following h.
+func incHash(h common.Hash) common.Hash {
+	return common.BigToHash(new(big.Int).Add(h.Big(), common.Big1))
+}
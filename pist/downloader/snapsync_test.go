@@ -0,0 +1,335 @@
+// Copyright 2020 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package downloader
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/core/rawdb"
+	"git.taiyue.io/pist/go-pist/core/state"
+	"git.taiyue.io/pist/go-pist/crypto"
+	"git.taiyue.io/pist/go-pist/pistdb"
+	"git.taiyue.io/pist/go-pist/rlp"
+	"git.taiyue.io/pist/go-pist/trie"
+)
+
+// snapTestSource serves the state ranges of a state like the peers do, with at
+// most max items a response instead of a size limit.
+type snapTestSource struct {
+	db     pistdb.Database
+	triedb *trie.Database
+	root   common.Hash
+	max    int
+	addrs  []common.Address
+}
+
+// newSnapTestSource creates a state with storages of several ranges and codes.
+func newSnapTestSource(t *testing.T) *snapTestSource {
+	db := pistdb.NewMemDatabase()
+	sdb := state.NewDatabase(db)
+	statedb, _ := state.New(common.Hash{}, sdb)
+
+	var addrs []common.Address
+	for i := 0; i < 100; i++ {
+		addr := common.BigToAddress(big.NewInt(int64(i + 1)))
+		statedb.AddBalance(addr, big.NewInt(int64(i+1)))
+		if i%10 == 0 {
+			for j := 0; j < 20; j++ {
+				statedb.SetState(addr, common.BigToHash(big.NewInt(int64(j+1))), common.BigToHash(big.NewInt(int64(i*100+j+1))))
+			}
+		}
+		if i%25 == 0 {
+			statedb.SetCode(addr, []byte{0x60, byte(i)})
+		}
+		addrs = append(addrs, addr)
+	}
+	root, err := statedb.Commit(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sdb.TrieDB().Commit(root, false); err != nil {
+		t.Fatal(err)
+	}
+	return &snapTestSource{db: db, triedb: sdb.TrieDB(), root: root, max: 4, addrs: addrs}
+}
+
+func (src *snapTestSource) prove(tr *trie.Trie, origin common.Hash, last []byte) [][]byte {
+	proofDb := pistdb.NewMemDatabase()
+	tr.Prove(origin[:], 0, proofDb)
+	if last != nil {
+		tr.Prove(last, 0, proofDb)
+	}
+	var proof [][]byte
+	for _, key := range proofDb.Keys() {
+		node, _ := proofDb.Get(key)
+		proof = append(proof, node)
+	}
+	return proof
+}
+
+func (src *snapTestSource) accountRange(origin, limit common.Hash) *accountRangePack {
+	tr, _ := trie.New(src.root, src.triedb)
+	pack := &accountRangePack{peerID: "source"}
+	it := trie.NewIterator(tr.NodeIterator(origin[:]))
+	for it.Next() {
+		hash := common.BytesToHash(it.Key)
+		pack.hashes = append(pack.hashes, hash)
+		pack.accounts = append(pack.accounts, common.CopyBytes(it.Value))
+		if bytes.Compare(hash[:], limit[:]) >= 0 || len(pack.hashes) >= src.max {
+			break
+		}
+	}
+	var last []byte
+	if n := len(pack.hashes); n > 0 {
+		last = pack.hashes[n-1][:]
+	}
+	pack.proof = src.prove(tr, origin, last)
+	return pack
+}
+
+func (src *snapTestSource) storageRanges(accounts []common.Hash, origin common.Hash) *storageRangesPack {
+	accTrie, _ := trie.New(src.root, src.triedb)
+	pack := &storageRangesPack{peerID: "source"}
+	count := 0
+	for i, hash := range accounts {
+		if count >= src.max {
+			break
+		}
+		var account state.Account
+		blob, _ := accTrie.TryGet(hash[:])
+		if err := rlp.DecodeBytes(blob, &account); err != nil {
+			break
+		}
+		tr, _ := trie.New(account.Root, src.triedb)
+		start := common.Hash{}
+		if i == 0 {
+			start = origin
+		}
+		var (
+			hashes     []common.Hash
+			slots      [][]byte
+			incomplete bool
+		)
+		it := trie.NewIterator(tr.NodeIterator(start[:]))
+		for it.Next() {
+			hashes = append(hashes, common.BytesToHash(it.Key))
+			slots = append(slots, common.CopyBytes(it.Value))
+			if count++; count >= src.max {
+				incomplete = true
+				break
+			}
+		}
+		pack.hashes = append(pack.hashes, hashes)
+		pack.slots = append(pack.slots, slots)
+		if incomplete || start != (common.Hash{}) {
+			var last []byte
+			if n := len(hashes); n > 0 {
+				last = hashes[n-1][:]
+			}
+			pack.proof = src.prove(tr, start, last)
+			break
+		}
+	}
+	return pack
+}
+
+func (src *snapTestSource) byteCodes(hashes []common.Hash) *byteCodesPack {
+	pack := &byteCodesPack{peerID: "source"}
+	for _, hash := range hashes {
+		if code, err := src.db.Get(hash[:]); err == nil {
+			pack.codes = append(pack.codes, code)
+		}
+	}
+	return pack
+}
+
+// serve answers a request of the syncer.
+func (src *snapTestSource) serve(req *snapReq) dataPack {
+	switch {
+	case len(req.codes) > 0:
+		return src.byteCodes(req.codes)
+	case len(req.storages) > 0:
+		accounts := make([]common.Hash, len(req.storages))
+		for i, task := range req.storages {
+			accounts[i] = task.account
+		}
+		return src.storageRanges(accounts, req.storages[0].next)
+	default:
+		return src.accountRange(req.account.next, req.account.last)
+	}
+}
+
+// newTestSnapSyncer creates the syncer of the state of src into db.
+func newTestSnapSyncer(src *snapTestSource, db pistdb.Database) *snapSyncer {
+	return newSnapSyncer(&stateSync{d: &Downloader{stateDB: db}, root: src.root})
+}
+
+// syncSnapRanges serves the requests of sn from src until no task is left, or
+// until n requests are served if n is positive.
+func syncSnapRanges(t *testing.T, sn *snapSyncer, src *snapTestSource, n int) {
+	for i := 0; n <= 0 || i < n; i++ {
+		req := sn.nextRequest()
+		if req == nil {
+			return
+		}
+		if err := sn.process(req, src.serve(req)); err != nil {
+			t.Fatalf("request %d: %v", i, err)
+		}
+	}
+}
+
+// checkSnapState checks db holds the whole state of src.
+func checkSnapState(t *testing.T, src *snapTestSource, db pistdb.Database) {
+	want, _ := state.New(src.root, state.NewDatabase(src.db))
+	have, err := state.New(src.root, state.NewDatabase(db))
+	if err != nil {
+		t.Fatalf("state missing: %v", err)
+	}
+	it := state.NewNodeIterator(have)
+	for it.Next() {
+	}
+	if it.Error != nil {
+		t.Fatalf("state incomplete: %v", it.Error)
+	}
+	for _, addr := range src.addrs {
+		if have.GetBalance(addr).Cmp(want.GetBalance(addr)) != 0 {
+			t.Fatalf("balance mismatch of %x", addr)
+		}
+		if !bytes.Equal(have.GetCode(addr), want.GetCode(addr)) {
+			t.Fatalf("code mismatch of %x", addr)
+		}
+		for j := 0; j < 20; j++ {
+			key := common.BigToHash(big.NewInt(int64(j + 1)))
+			if have.GetState(addr, key) != want.GetState(addr, key) {
+				t.Fatalf("storage mismatch of %x at %x", addr, key)
+			}
+		}
+	}
+}
+
+// Tests the state is retrieved in proven ranges, with the large storages over
+// several responses.
+func TestSnapSyncRanges(t *testing.T) {
+	src := newSnapTestSource(t)
+	db := pistdb.NewMemDatabase()
+
+	sn := newTestSnapSyncer(src, db)
+	syncSnapRanges(t, sn, src, 0)
+	if !sn.done() {
+		t.Fatal("tasks left after the sync")
+	}
+	if sn.accountsDone != len(src.addrs) || sn.slotsDone != 200 || sn.codesDone != 4 {
+		t.Fatalf("retrieval mismatch: accounts %d, slots %d, codes %d", sn.accountsDone, sn.slotsDone, sn.codesDone)
+	}
+	if err := sn.finish(); err != nil {
+		t.Fatal(err)
+	}
+	checkSnapState(t, src, db)
+	if progress := rawdb.ReadSnapSyncProgress(db); progress != nil {
+		t.Fatal("progress left after the sync")
+	}
+}
+
+// Tests an interrupted sync resumes from the ranges flushed to the database.
+func TestSnapSyncResume(t *testing.T) {
+	src := newSnapTestSource(t)
+	db := pistdb.NewMemDatabase()
+
+	sn := newTestSnapSyncer(src, db)
+	syncSnapRanges(t, sn, src, 30)
+	if sn.done() || rawdb.ReadSnapSyncProgress(db) == nil {
+		t.Fatal("no progress flushed during the sync")
+	}
+	// The ranges imported since the last flush are lost with the syncer
+	resumed := newTestSnapSyncer(src, db)
+	done := 0
+	for _, task := range resumed.accounts {
+		if task.done {
+			done++
+		}
+	}
+	if done == 0 || done == snapAccountChunks {
+		t.Fatalf("account chunks done mismatch: have %d", done)
+	}
+	syncSnapRanges(t, resumed, src, 0)
+	if err := resumed.finish(); err != nil {
+		t.Fatal(err)
+	}
+	checkSnapState(t, src, db)
+
+	// A sync of another root starts over
+	if sn := newSnapSyncer(&stateSync{d: &Downloader{stateDB: db}, root: common.Hash{1}}); sn.accounts[0].next != (common.Hash{}) || sn.accounts[0].done {
+		t.Fatal("progress of another root resumed")
+	}
+}
+
+// Tests the ranges failing their proofs are rejected without any progress.
+func TestSnapSyncBadProof(t *testing.T) {
+	src := newSnapTestSource(t)
+	sn := newTestSnapSyncer(src, pistdb.NewMemDatabase())
+
+	// An account missing in the middle of the range
+	req := sn.nextRequest()
+	pack := src.serve(req).(*accountRangePack)
+	if len(pack.hashes) < 3 {
+		t.Fatalf("range too short: %d", len(pack.hashes))
+	}
+	next := req.account.next
+	pack.hashes = append(pack.hashes[:1], pack.hashes[2:]...)
+	pack.accounts = append(pack.accounts[:1], pack.accounts[2:]...)
+	if err := sn.process(req, pack); err == nil {
+		t.Fatal("account range with a gap accepted")
+	}
+	// A modified account
+	pack = src.serve(req).(*accountRangePack)
+	pack.accounts[0] = append([]byte{}, pack.accounts[1]...)
+	if err := sn.process(req, pack); err == nil {
+		t.Fatal("modified account accepted")
+	}
+	// Missing proof
+	pack = src.serve(req).(*accountRangePack)
+	pack.proof = pack.proof[:len(pack.proof)/2]
+	if err := sn.process(req, pack); err == nil {
+		t.Fatal("account range without its proof accepted")
+	}
+	if req.account.next != next || req.account.done || sn.accountsDone != 0 {
+		t.Fatal("rejected ranges imported")
+	}
+	// A partial storage range with a modified slot
+	statedb, _ := state.New(src.root, state.NewDatabase(src.db))
+	task := &storageTask{account: crypto.Keccak256Hash(src.addrs[0][:]), root: statedb.StorageTrie(src.addrs[0]).Hash()}
+	req = &snapReq{storages: []*storageTask{task}}
+	spack := src.serve(req).(*storageRangesPack)
+	if len(spack.proof) == 0 {
+		t.Fatal("storage range not partial")
+	}
+	spack.slots[0][0] = []byte{0x01}
+	if err := sn.process(req, spack); err == nil {
+		t.Fatal("modified storage slot accepted")
+	}
+	if task.trie != nil || task.next != (common.Hash{}) || sn.slotsDone != 0 {
+		t.Fatal("rejected storage range imported")
+	}
+	// Codes not requested
+	req = &snapReq{codes: []common.Hash{{1}}}
+	if err := sn.process(req, &byteCodesPack{peerID: "source", codes: [][]byte{{0x60}}}); err == nil {
+		t.Fatal("unrequested code accepted")
+	}
+}
@@ -81,6 +81,7 @@ func (d *Downloader) stateFetcher() {
 			}
 		case <-d.stateCh:
 			// Ignore state responses while no sync is running.
+		case <-d.snapCh:
 		case <-d.quitCh:
 			return
 		}
@@ -153,6 +154,14 @@ func (d *Downloader) runStateSync(s *stateSync) *stateSync {
 			finished = append(finished, req)
 			delete(active, pack.PeerId())
 
+		// Hand the state ranges to the snap phase, they're dropped after it:
+		case pack := <-d.snapCh:
+			select {
+			case s.snapCh <- pack:
+			default:
+				log.Debug("Unrequested state range", "peer", pack.PeerId(), "len", pack.Items())
+			}
+
 		// Handle dropped peer connections:
 		case p := <-peerDrop:
 			// Skip if no request is currently pending
@@ -213,7 +222,8 @@ func (d *Downloader) runStateSync(s *stateSync) *stateSync {
 // stateSync schedules requests for downloading a particular state trie defined
 // by a given state root.
 type stateSync struct {
-	d *Downloader // Downloader instance to access and manage current peerset
+	d    *Downloader // Downloader instance to access and manage current peerset
+	root common.Hash // State root of the sync
 
 	sched  *trie.Sync                 // State trie sync scheduler defining the tasks
 	keccak hash.Hash                  // Keccak256 hasher to verify deliveries with
@@ -223,6 +233,7 @@ type stateSync struct {
 	bytesUncommitted int
 
 	deliver    chan *stateReq // Delivery channel multiplexing peer responses
+	snapCh     chan dataPack  // Delivery channel of the state ranges of the snap phase
 	cancel     chan struct{}  // Channel to signal a termination request
 	cancelOnce sync.Once      // Ensures cancel only ever gets called once
 	done       chan struct{}  // Channel to signal termination completion
@@ -240,10 +251,12 @@ type stateTask struct {
 func newStateSync(d *Downloader, root common.Hash) *stateSync {
 	return &stateSync{
 		d:       d,
+		root:    root,
 		sched:   state.NewStateSync(root, d.stateDB),
 		keccak:  sha3.NewLegacyKeccak256(),
 		tasks:   make(map[common.Hash]*stateTask),
 		deliver: make(chan *stateReq),
+		snapCh:  make(chan dataPack, maxSnapRequests),
 		cancel:  make(chan struct{}),
 		done:    make(chan struct{}),
 	}
@@ -287,6 +300,14 @@ func (s *stateSync) loop() (err error) {
 		}
 	}()
 
+	// Retrieve the bulk of the state in ranges first, the trie sync only heals
+	// what's left then
+	if s.d.snapSync {
+		if err = s.snap(); err != nil {
+			return err
+		}
+		s.sched = state.NewStateSync(s.root, s.d.stateDB)
+	}
 	// Keep assigning new tasks until the sync completes or aborts
 	for s.sched.Pending() > 0 {
 		if err = s.commit(false); err != nil {
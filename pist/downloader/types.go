@@ -18,6 +18,8 @@ package downloader
 
 import (
 	"fmt"
+
+	"git.taiyue.io/pist/go-pist/common"
 	"git.taiyue.io/pist/go-pist/core/types"
 )
 
@@ -47,6 +49,7 @@ type bodyPack struct {
 	transactions [][]*types.Transaction
 	signs        [][]*types.PbftSign
 	infos        [][]*types.CommitteeMember
+	parentSigns  [][]*types.PbftSign
 }
 
 func (p *bodyPack) PeerId() string { return p.peerID }
@@ -76,3 +79,39 @@ type statePack struct {
 func (p *statePack) PeerId() string { return p.peerID }
 func (p *statePack) Items() int     { return len(p.states) }
 func (p *statePack) Stats() string  { return fmt.Sprintf("%d", len(p.states)) }
+
+// accountRangePack is a range of accounts returned by a peer, with the edge
+// proofs of the range.
+type accountRangePack struct {
+	peerID   string
+	hashes   []common.Hash
+	accounts [][]byte
+	proof    [][]byte
+}
+
+func (p *accountRangePack) PeerId() string { return p.peerID }
+func (p *accountRangePack) Items() int     { return len(p.accounts) }
+func (p *accountRangePack) Stats() string  { return fmt.Sprintf("%d", len(p.accounts)) }
+
+// storageRangesPack is a batch of storage ranges returned by a peer, the proof
+// only covers the last range if it's incomplete.
+type storageRangesPack struct {
+	peerID string
+	hashes [][]common.Hash
+	slots  [][][]byte
+	proof  [][]byte
+}
+
+func (p *storageRangesPack) PeerId() string { return p.peerID }
+func (p *storageRangesPack) Items() int     { return len(p.slots) }
+func (p *storageRangesPack) Stats() string  { return fmt.Sprintf("%d", len(p.slots)) }
+
+// byteCodesPack is a batch of contract codes returned by a peer.
+type byteCodesPack struct {
+	peerID string
+	codes  [][]byte
+}
+
+func (p *byteCodesPack) PeerId() string { return p.peerID }
+func (p *byteCodesPack) Items() int     { return len(p.codes) }
+func (p *byteCodesPack) Stats() string  { return fmt.Sprintf("%d", len(p.codes)) }
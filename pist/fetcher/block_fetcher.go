@@ -121,6 +121,7 @@ type bodyFilterTask struct {
 	transactions [][]*types.Transaction     // Collection of transactions per block bodies
 	signs        [][]*types.PbftSign        // Collection of sign per block bodies
 	infos        [][]*types.CommitteeMember // committee change
+	parentSigns  [][]*types.PbftSign        // Collection of the signs committing the parents
 	time         time.Time                  // Arrival time of the blocks' contents
 }
 
@@ -273,7 +274,7 @@ func (f *BlockFetcher) FilterHeaders(peer string, headers []*types.Header, time
 
 // FilterBodies extracts all the block bodies that were explicitly requested by
 // the fetcher, returning those that should be handled differently.
-func (f *BlockFetcher) FilterBodies(peer string, transactions [][]*types.Transaction, signs [][]*types.PbftSign, infos [][]*types.CommitteeMember, time time.Time) ([][]*types.Transaction, [][]*types.PbftSign, [][]*types.CommitteeMember) {
+func (f *BlockFetcher) FilterBodies(peer string, transactions [][]*types.Transaction, signs [][]*types.PbftSign, infos [][]*types.CommitteeMember, parentSigns [][]*types.PbftSign, time time.Time) ([][]*types.Transaction, [][]*types.PbftSign, [][]*types.CommitteeMember, [][]*types.PbftSign) {
 	log.Trace("Filtering bodies", "peer", peer, "txs", len(transactions))
 
 	// Send the filter channel to the fetcher
@@ -282,20 +283,20 @@ func (f *BlockFetcher) FilterBodies(peer string, transactions [][]*types.Transac
 	select {
 	case f.bodyFilter <- filter:
 	case <-f.quit:
-		return nil, nil, nil
+		return nil, nil, nil, nil
 	}
 	// Request the filtering of the body list
 	select {
-	case filter <- &bodyFilterTask{peer: peer, transactions: transactions, signs: signs, infos: infos, time: time}:
+	case filter <- &bodyFilterTask{peer: peer, transactions: transactions, signs: signs, infos: infos, parentSigns: parentSigns, time: time}:
 	case <-f.quit:
-		return nil, nil, nil
+		return nil, nil, nil, nil
 	}
 	// Retrieve the bodies remaining after filtering
 	select {
 	case task := <-filter:
-		return task.transactions, task.signs, task.infos
+		return task.transactions, task.signs, task.infos, task.parentSigns
 	case <-f.quit:
-		return nil, nil, nil
+		return nil, nil, nil, nil
 	}
 }
 
@@ -538,7 +539,7 @@ func (f *BlockFetcher) loop() {
 
 							if f.getBlock(hash) == nil {
 								// mecMark
-								block := types.NewBlockWithHeader(announce.header).WithBody(task.transactions[i], task.signs[i], task.infos[i])
+								block := types.NewBlockWithHeader(announce.header).WithBody(task.transactions[i], task.signs[i], task.infos[i], task.parentSigns[i])
 								block.ReceivedAt = task.time
 
 								blocks = append(blocks, block)
@@ -552,6 +553,7 @@ func (f *BlockFetcher) loop() {
 					task.transactions = append(task.transactions[:i], task.transactions[i+1:]...)
 					task.signs = append(task.signs[:i], task.signs[i+1:]...)
 					task.infos = append(task.infos[:i], task.infos[i+1:]...)
+					task.parentSigns = append(task.parentSigns[:i], task.parentSigns[i+1:]...)
 					i--
 					continue
 				}
@@ -205,6 +205,13 @@ func (es *EventSystem) SubscribeLogs(crit pistchain.FilterQuery, logs chan []*ty
 	} else {
 		to = rpc.BlockNumber(crit.ToBlock.Int64())
 	}
+	// blocks are final once inserted, the finalized and safe logs are the mined ones
+	if from == rpc.FinalizedBlockNumber || from == rpc.SafeBlockNumber {
+		from = rpc.LatestBlockNumber
+	}
+	if to == rpc.FinalizedBlockNumber || to == rpc.SafeBlockNumber {
+		to = rpc.LatestBlockNumber
+	}
 
 	// only interested in pending logs
 	if from == rpc.PendingBlockNumber && to == rpc.PendingBlockNumber {
@@ -71,6 +71,7 @@ type ProtocolManager struct {
 	forkFilter forkid.Filter // Fork ID filter, constant across the lifetime of the node
 
 	fastSync  uint32 // Flag whether fast sync is enabled (gets disabled if we already have blocks)
+	snapSync  uint32 // Flag whether the fast sync retrieves the state in ranges
 	acceptTxs uint32 // Flag whether we're considered synchronised (enables transaction processing)
 
 	checkpointNumber uint64      // Block number for the sync progress validator to cross reference
@@ -156,6 +157,9 @@ func NewProtocolManager(config *params.ChainConfig, checkpoint *params.TrustedCh
 		} else {
 			// If fast sync was requested and our database is empty, grant it
 			manager.fastSync = uint32(1)
+			if mode == downloader.SnapSync {
+				manager.snapSync = uint32(1)
+			}
 		}
 	}
 
@@ -638,11 +642,13 @@ func (pm *ProtocolManager) handleMsg(p *peer) error {
 		transactions := make([][]*types.Transaction, len(request))
 		signs := make([][]*types.PbftSign, len(request))
 		infos := make([][]*types.CommitteeMember, len(request))
+		parentSigns := make([][]*types.PbftSign, len(request))
 
 		for i, body := range request {
 			transactions[i] = body.Transactions
 			signs[i] = body.Signs
 			infos[i] = body.Infos
+			parentSigns[i] = body.ParentSigns
 			if len(body.Signs) == 0 {
 				log.Warn("BlockBodiesMsg", "transactions", len(body.Transactions), "signs", len(body.Signs), "infos", len(body.Infos))
 			}
@@ -650,12 +656,12 @@ func (pm *ProtocolManager) handleMsg(p *peer) error {
 		// Filter out any explicitly requested bodies, deliver the rest to the downloader
 		filter := len(transactions) > 0 || len(signs) > 0 || len(infos) > 0
 		if filter {
-			transactions, signs, infos = pm.blockFetcher.FilterBodies(p.id, transactions, signs, infos, time.Now())
+			transactions, signs, infos, parentSigns = pm.blockFetcher.FilterBodies(p.id, transactions, signs, infos, parentSigns, time.Now())
 		}
 
 		if len(transactions) > 0 || len(signs) > 0 || len(infos) > 0 || !filter {
 			log.Debug("BlockBodiesMsg", "transactions", len(transactions), "signs", len(signs), "infos", len(infos), "filter", filter)
-			err := pm.downloader.DeliverBodies(p.id, transactions, signs, infos)
+			err := pm.downloader.DeliverBodies(p.id, transactions, signs, infos, parentSigns)
 			if err != nil {
 				log.Debug("Failed to deliver bodies", "err", err)
 			}
@@ -699,6 +705,73 @@ func (pm *ProtocolManager) handleMsg(p *peer) error {
 			log.Debug("Failed to deliver node state data", "err", err)
 		}
 
+	case p.version >= eth66 && msg.Code == GetAccountRangeMsg:
+		// Decode the account range query and serve it with the edge proofs
+		var query getAccountRangeData
+		if err := msg.Decode(&query); err != nil {
+			return errResp(ErrDecode, "msg %v: %v", msg, err)
+		}
+		accounts, proof := serveAccountRange(pm.blockchain.StateCache(), &query)
+		return p.SendAccountRange(accounts, proof)
+
+	case p.version >= eth66 && msg.Code == AccountRangeMsg:
+		// A range of accounts arrived to one of our previous requests
+		var res accountRangeData
+		if err := msg.Decode(&res); err != nil {
+			return errResp(ErrDecode, "msg %v: %v", msg, err)
+		}
+		hashes, accounts := make([]common.Hash, len(res.Accounts)), make([][]byte, len(res.Accounts))
+		for i, account := range res.Accounts {
+			hashes[i], accounts[i] = account.Hash, account.Body
+		}
+		if err := pm.downloader.DeliverAccountRange(p.id, hashes, accounts, res.Proof); err != nil {
+			log.Debug("Failed to deliver account range", "err", err)
+		}
+
+	case p.version >= eth66 && msg.Code == GetStorageRangesMsg:
+		// Decode the storage ranges query and serve it
+		var query getStorageRangesData
+		if err := msg.Decode(&query); err != nil {
+			return errResp(ErrDecode, "msg %v: %v", msg, err)
+		}
+		slots, proof := serveStorageRanges(pm.blockchain.StateCache(), &query)
+		return p.SendStorageRanges(slots, proof)
+
+	case p.version >= eth66 && msg.Code == StorageRangesMsg:
+		// A batch of storage ranges arrived to one of our previous requests
+		var res storageRangesData
+		if err := msg.Decode(&res); err != nil {
+			return errResp(ErrDecode, "msg %v: %v", msg, err)
+		}
+		hashes, slots := make([][]common.Hash, len(res.Slots)), make([][][]byte, len(res.Slots))
+		for i, storage := range res.Slots {
+			hashes[i], slots[i] = make([]common.Hash, len(storage)), make([][]byte, len(storage))
+			for j, slot := range storage {
+				hashes[i][j], slots[i][j] = slot.Hash, slot.Body
+			}
+		}
+		if err := pm.downloader.DeliverStorageRanges(p.id, hashes, slots, res.Proof); err != nil {
+			log.Debug("Failed to deliver storage ranges", "err", err)
+		}
+
+	case p.version >= eth66 && msg.Code == GetByteCodesMsg:
+		// Decode the contract code query and serve it
+		var query getByteCodesData
+		if err := msg.Decode(&query); err != nil {
+			return errResp(ErrDecode, "msg %v: %v", msg, err)
+		}
+		return p.SendByteCodes(serveByteCodes(pm.blockchain.StateCache(), &query))
+
+	case p.version >= eth66 && msg.Code == ByteCodesMsg:
+		// A batch of contract codes arrived to one of our previous requests
+		var codes [][]byte
+		if err := msg.Decode(&codes); err != nil {
+			return errResp(ErrDecode, "msg %v: %v", msg, err)
+		}
+		if err := pm.downloader.DeliverByteCodes(p.id, codes); err != nil {
+			log.Debug("Failed to deliver byte codes", "err", err)
+		}
+
 	case p.version >= eth63 && msg.Code == GetReceiptsMsg:
 		// Decode the retrieval message
 		msgStream := rlp.NewStream(msg.Payload, uint64(msg.Size))
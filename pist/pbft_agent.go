@@ -601,8 +601,8 @@ func (agent *PbftAgent) loop() {
 				next := num.Uint64() + 1
 				epoch := types.GetEpochFromHeight(next)
 
-				if next == epoch.EndHeight-params.ElectionPoint+1 {
-					epoch := types.GetEpochFromHeight(next + params.NewEpochLength)
+				if next == epoch.EndHeight-params.GetGoverned().ElectionPoint+1 {
+					epoch := types.GetEpochFromID(epoch.EpochID + 1)
 					log.Info("Prepare new epoch", "id", epoch.EpochID, "block", num)
 					committee := &types.CommitteeInfo{
 						Id:          new(big.Int).SetUint64(epoch.EpochID),
@@ -976,8 +976,13 @@ func (agent *PbftAgent) FetchFastBlock(committeeID *big.Int, infos []*types.Comm
 		}
 		txs := types.NewTransactionsByPriceAndNonce(work.signer, pending)
 		work.commitTransactions(agent.mux, txs, agent.fastChain, feeAmount)
+		// carry the signs committing the parent once the fork requires them
+		var parentSigns []*types.PbftSign
+		if agent.config.IsParentSigns(header.Number) {
+			parentSigns = parent.Signs()
+		}
 		//padding Header.Root, TxHash, ReceiptHash.  Create the new block to seal with the consensus engine
-		if fastBlock, _, err = agent.engine.Finalize(agent.fastChain, header, work.state, work.txs, work.receipts, feeAmount); err != nil {
+		if fastBlock, _, err = agent.engine.Finalize(agent.fastChain, header, work.state, work.txs, work.receipts, parentSigns, feeAmount); err != nil {
 			log.Error("Failed to finalize block for sealing", "err", err)
 			return fastBlock, err
 		}
@@ -639,6 +639,23 @@ func (p *peer) SendNodeData(data [][]byte) error {
 	return p2p.Send(p.rw, NodeDataMsg, data)
 }
 
+// SendAccountRange sends a range of accounts with the edge proofs of the range.
+func (p *peer) SendAccountRange(accounts []*accountData, proof [][]byte) error {
+	return p2p.Send(p.rw, AccountRangeMsg, &accountRangeData{Accounts: accounts, Proof: proof})
+}
+
+// SendStorageRanges sends the storage ranges of a batch of accounts, with the
+// edge proofs of the last one if it's incomplete.
+func (p *peer) SendStorageRanges(slots [][]*storageData, proof [][]byte) error {
+	return p2p.Send(p.rw, StorageRangesMsg, &storageRangesData{Slots: slots, Proof: proof})
+}
+
+// SendByteCodes sends a batch of contract codes, corresponding to the hashes
+// requested.
+func (p *peer) SendByteCodes(codes [][]byte) error {
+	return p2p.Send(p.rw, ByteCodesMsg, codes)
+}
+
 // SendReceiptsRLP sends a batch of transaction receipts, corresponding to the
 // ones requested from an already RLP encoded format.
 func (p *peer) SendReceiptsRLP(receipts []rlp.RawValue) error {
@@ -680,6 +697,26 @@ func (p *peer) RequestNodeData(hashes []common.Hash) error {
 	return p2p.Send(p.rw, GetNodeDataMsg, hashes)
 }
 
+// RequestAccountRange fetches a range of accounts of the state trie of root,
+// starting at origin and stopping at limit or the size of bytes.
+func (p *peer) RequestAccountRange(root, origin, limit common.Hash, bytes uint64) error {
+	p.Log().Debug("Fetching range of accounts", "root", root, "origin", origin, "limit", limit)
+	return p2p.Send(p.rw, GetAccountRangeMsg, &getAccountRangeData{Root: root, Origin: origin, Limit: limit, Bytes: bytes})
+}
+
+// RequestStorageRanges fetches the storage slots of a batch of accounts of the
+// state trie of root, the origin and limit only apply to the first account.
+func (p *peer) RequestStorageRanges(root common.Hash, accounts []common.Hash, origin, limit common.Hash, bytes uint64) error {
+	p.Log().Debug("Fetching ranges of storage slots", "root", root, "accounts", len(accounts), "origin", origin)
+	return p2p.Send(p.rw, GetStorageRangesMsg, &getStorageRangesData{Root: root, Accounts: accounts, Origin: origin, Limit: limit, Bytes: bytes})
+}
+
+// RequestByteCodes fetches a batch of contract codes by their hashes.
+func (p *peer) RequestByteCodes(hashes []common.Hash, bytes uint64) error {
+	p.Log().Debug("Fetching batch of byte codes", "count", len(hashes))
+	return p2p.Send(p.rw, GetByteCodesMsg, &getByteCodesData{Hashes: hashes, Bytes: bytes})
+}
+
 // RequestReceipts fetches a batch of transaction receipts from a remote node.
 func (p *peer) RequestReceipts(hashes []common.Hash) error {
 	p.Log().Debug("Fetching batch of receipts", "count", len(hashes))
@@ -33,16 +33,17 @@ const (
 	eth63 = 63
 	eth64 = 64
 	eth65 = 65
+	eth66 = 66
 )
 
 // ProtocolName is the official short name of the protocol used during capability negotiation.
 var ProtocolName = "pist"
 
 // ProtocolVersions are the supported versions of the eth protocol (first is primary).
-var ProtocolVersions = []uint{eth65, eth64, eth63}
+var ProtocolVersions = []uint{eth66, eth65, eth64, eth63}
 
 // protocolLengths are the number of implemented message corresponding to different protocol versions.
-var ProtocolLengths = map[uint]uint64{eth65: 25, eth64: 25, eth63: 25}
+var ProtocolLengths = map[uint]uint64{eth66: 31, eth65: 25, eth64: 25, eth63: 25}
 
 const ProtocolMaxMsgSize = 10 * 1024 * 1024 // Maximum cap on the size of a protocol message
 
@@ -73,6 +74,15 @@ const (
 	TbftNodeInfoMsg     = 0x14
 	TbftNodeInfoHashMsg = 0x15
 	GetTbftNodeInfoMsg  = 0x16
+
+	// New protocol message codes introduced in eth66, serving the state in
+	// contiguous ranges of accounts and storage slots for snap sync
+	GetAccountRangeMsg  = 0x19
+	AccountRangeMsg     = 0x1a
+	GetStorageRangesMsg = 0x1b
+	StorageRangesMsg    = 0x1c
+	GetByteCodesMsg     = 0x1d
+	ByteCodesMsg        = 0x1e
 )
 
 type errCode int
@@ -232,6 +242,57 @@ func (hn *hashOrNumber) DecodeRLP(s *rlp.Stream) error {
 	return err
 }
 
+// getAccountRangeData represents an account range query.
+type getAccountRangeData struct {
+	Root   common.Hash // Root hash of the account trie to serve
+	Origin common.Hash // Hash of the first account to retrieve
+	Limit  common.Hash // Hash of the last account to retrieve
+	Bytes  uint64      // Soft limit at which to stop returning data
+}
+
+// accountData is an account of a range, the body is its RLP in the state trie.
+type accountData struct {
+	Hash common.Hash
+	Body rlp.RawValue
+}
+
+// accountRangeData is the network packet of an account range, the proof holds
+// the edge proofs of the origin and of the last account.
+type accountRangeData struct {
+	Accounts []*accountData
+	Proof    [][]byte
+}
+
+// getStorageRangesData represents a storage slot range query of a batch of
+// accounts, the origin and limit only apply to the first one.
+type getStorageRangesData struct {
+	Root     common.Hash   // Root hash of the account trie to serve
+	Accounts []common.Hash // Hashes of the accounts to retrieve the storage of
+	Origin   common.Hash   // Hash of the first storage slot to retrieve
+	Limit    common.Hash   // Hash of the last storage slot to retrieve
+	Bytes    uint64        // Soft limit at which to stop returning data
+}
+
+// storageData is a storage slot of a range with its value in the storage trie.
+type storageData struct {
+	Hash common.Hash
+	Body []byte
+}
+
+// storageRangesData is the network packet of the storage of a batch of
+// accounts. Only the last range may be incomplete, it is proven by the edge
+// proofs then.
+type storageRangesData struct {
+	Slots [][]*storageData
+	Proof [][]byte
+}
+
+// getByteCodesData represents a contract code query.
+type getByteCodesData struct {
+	Hashes []common.Hash // Code hashes to retrieve the code for
+	Bytes  uint64        // Soft limit at which to stop returning data
+}
+
 // newBlockData is the network packet for the block propagation message.
 type newBlockData struct {
 	Block *types.Block
@@ -243,6 +304,7 @@ type blockBody struct {
 	Transactions []*types.Transaction     // Transactions contained within a block
 	Signs        []*types.PbftSign        // Signs contained within a block
 	Infos        []*types.CommitteeMember //change info
+	ParentSigns  []*types.PbftSign        `rlp:"tail"` // Signs committing the parent block
 }
 
 // blockBodiesData is the network packet for block content distribution.
@@ -0,0 +1,178 @@
+// Copyright 2020 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package pist
+
+import (
+	"bytes"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/core/state"
+	"git.taiyue.io/pist/go-pist/log"
+	"git.taiyue.io/pist/go-pist/pistdb"
+	"git.taiyue.io/pist/go-pist/rlp"
+	"git.taiyue.io/pist/go-pist/trie"
+)
+
+// maxHash is the last hash of the key space, the limit of a whole range.
+var maxHash = common.HexToHash("0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+
+// responseBytes caps the soft limit of the size of a state range response asked
+// by the peer.
+func responseBytes(requested uint64) uint64 {
+	if requested > softResponseLimit {
+		return softResponseLimit
+	}
+	return requested
+}
+
+// proveRange returns the edge proofs of a range of the trie.
+func proveRange(tr *trie.Trie, origin common.Hash, last []byte) [][]byte {
+	proofDb := pistdb.NewMemDatabase()
+	if err := tr.Prove(origin[:], 0, proofDb); err != nil {
+		log.Debug("Failed to prove the range origin", "origin", origin, "err", err)
+		return nil
+	}
+	if last != nil {
+		if err := tr.Prove(last, 0, proofDb); err != nil {
+			log.Debug("Failed to prove the range end", "last", common.BytesToHash(last), "err", err)
+			return nil
+		}
+	}
+	var proof [][]byte
+	for _, key := range proofDb.Keys() {
+		node, _ := proofDb.Get(key)
+		proof = append(proof, node)
+	}
+	return proof
+}
+
+// serveAccountRange gathers the accounts of the state trie of the root in db from
+// the origin on, until the limit or the size requested is reached. The first
+// account beyond the limit is included, the proofs of the origin and of the last
+// account prove the range. An unknown root is answered with an empty range.
+func serveAccountRange(db state.Database, req *getAccountRangeData) ([]*accountData, [][]byte) {
+	tr, err := trie.New(req.Root, db.TrieDB())
+	if err != nil {
+		return nil, nil
+	}
+	var (
+		accounts []*accountData
+		size     uint64
+		limit    = responseBytes(req.Bytes)
+	)
+	it := trie.NewIterator(tr.NodeIterator(req.Origin[:]))
+	for it.Next() {
+		hash := common.BytesToHash(it.Key)
+		accounts = append(accounts, &accountData{Hash: hash, Body: common.CopyBytes(it.Value)})
+		size += uint64(common.HashLength + len(it.Value))
+		if bytes.Compare(hash[:], req.Limit[:]) >= 0 || size >= limit {
+			break
+		}
+	}
+	if it.Err != nil {
+		return nil, nil
+	}
+	var last []byte
+	if len(accounts) > 0 {
+		last = accounts[len(accounts)-1].Hash[:]
+	}
+	return accounts, proveRange(tr, req.Origin, last)
+}
+
+// serveStorageRanges gathers the storage slots of the accounts of the state
+// trie of the root in db, until the size requested is reached. The origin and
+// limit only apply to the first account. The proofs are only returned for the
+// last range if it's incomplete.
+func serveStorageRanges(db state.Database, req *getStorageRangesData) ([][]*storageData, [][]byte) {
+	triedb := db.TrieDB()
+	accTrie, err := trie.New(req.Root, triedb)
+	if err != nil {
+		return nil, nil
+	}
+	var (
+		slots [][]*storageData
+		size  uint64
+		limit = responseBytes(req.Bytes)
+	)
+	for i, hash := range req.Accounts {
+		if size >= limit {
+			break
+		}
+		blob, err := accTrie.TryGet(hash[:])
+		if err != nil || blob == nil {
+			break
+		}
+		var account state.Account
+		if err := rlp.DecodeBytes(blob, &account); err != nil {
+			break
+		}
+		tr, err := trie.New(account.Root, triedb)
+		if err != nil {
+			break
+		}
+		origin, last := common.Hash{}, maxHash
+		if i == 0 {
+			origin, last = req.Origin, req.Limit
+		}
+		var (
+			storage    []*storageData
+			incomplete bool
+		)
+		it := trie.NewIterator(tr.NodeIterator(origin[:]))
+		for it.Next() {
+			key := common.BytesToHash(it.Key)
+			storage = append(storage, &storageData{Hash: key, Body: common.CopyBytes(it.Value)})
+			size += uint64(common.HashLength + len(it.Value))
+			if bytes.Compare(key[:], last[:]) >= 0 || size >= limit {
+				incomplete = true
+				break
+			}
+		}
+		if it.Err != nil {
+			break
+		}
+		slots = append(slots, storage)
+		if incomplete || origin != (common.Hash{}) {
+			var lastKey []byte
+			if len(storage) > 0 {
+				lastKey = storage[len(storage)-1].Hash[:]
+			}
+			return slots, proveRange(tr, origin, lastKey)
+		}
+	}
+	return slots, nil
+}
+
+// serveByteCodes gathers the contract codes of the hashes in db, until the size
+// requested is reached.
+func serveByteCodes(db state.Database, req *getByteCodesData) [][]byte {
+	var (
+		codes [][]byte
+		size  uint64
+		limit = responseBytes(req.Bytes)
+	)
+	for _, hash := range req.Hashes {
+		if size >= limit {
+			break
+		}
+		if code, err := db.ContractCode(common.Hash{}, hash); err == nil {
+			codes = append(codes, code)
+			size += uint64(len(code))
+		}
+	}
+	return codes
+}
@@ -0,0 +1,167 @@
+// Copyright 2020 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package pist
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/core/state"
+	"git.taiyue.io/pist/go-pist/crypto"
+	"git.taiyue.io/pist/go-pist/pistdb"
+	"git.taiyue.io/pist/go-pist/trie"
+)
+
+// newSnapTestState creates a state of 50 accounts, the first one holding 30
+// storage slots and the code 0x6001.
+func newSnapTestState(t *testing.T) (state.Database, common.Hash, common.Address) {
+	db := state.NewDatabase(pistdb.NewMemDatabase())
+	statedb, _ := state.New(common.Hash{}, db)
+	for i := 0; i < 50; i++ {
+		statedb.AddBalance(common.BigToAddress(big.NewInt(int64(i+1))), big.NewInt(int64(i+1)))
+	}
+	contract := common.BigToAddress(big.NewInt(1))
+	for i := 0; i < 30; i++ {
+		statedb.SetState(contract, common.BigToHash(big.NewInt(int64(i+1))), common.Hash{byte(i + 1)})
+	}
+	statedb.SetCode(contract, []byte{0x60, 0x01})
+
+	root, err := statedb.Commit(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.TrieDB().Commit(root, false); err != nil {
+		t.Fatal(err)
+	}
+	return db, root, contract
+}
+
+// newTestProofDb returns the database of the proof nodes keyed by hash.
+func newTestProofDb(proof [][]byte) *pistdb.MemDatabase {
+	db := pistdb.NewMemDatabase()
+	for _, node := range proof {
+		db.Put(crypto.Keccak256(node), node)
+	}
+	return db
+}
+
+// Tests the account ranges are served from the origin on with their proofs,
+// and within the size requested.
+func TestServeAccountRange(t *testing.T) {
+	db, root, _ := newSnapTestState(t)
+
+	var (
+		origin common.Hash
+		served int
+	)
+	for {
+		accounts, proof := serveAccountRange(db, &getAccountRangeData{Root: root, Origin: origin, Limit: maxHash, Bytes: 500})
+		if len(accounts) == 0 || len(proof) == 0 {
+			t.Fatalf("range from %x not served", origin)
+		}
+		keys, values := make([][]byte, len(accounts)), make([][]byte, len(accounts))
+		for i, account := range accounts {
+			keys[i], values[i] = account.Hash[:], account.Body
+		}
+		if size := len(accounts) * (common.HashLength + len(values[0])); size > 500+common.HashLength+len(values[0]) {
+			t.Fatalf("range exceeds the size requested: %d accounts", len(accounts))
+		}
+		more, err := trie.VerifyRangeProof(root, origin[:], keys[len(keys)-1], keys, values, newTestProofDb(proof))
+		if err != nil {
+			t.Fatalf("range from %x: %v", origin, err)
+		}
+		served += len(accounts)
+		if !more {
+			break
+		}
+		origin = common.BigToHash(new(big.Int).Add(accounts[len(accounts)-1].Hash.Big(), common.Big1))
+	}
+	if served != 50 {
+		t.Fatalf("accounts served mismatch: have %d, want 50", served)
+	}
+	// The first account beyond the limit closes the range
+	all, _ := serveAccountRange(db, &getAccountRangeData{Root: root, Limit: maxHash, Bytes: softResponseLimit})
+	accounts, _ := serveAccountRange(db, &getAccountRangeData{Root: root, Limit: all[9].Hash, Bytes: softResponseLimit})
+	if len(accounts) != 10 || accounts[9].Hash != all[9].Hash {
+		t.Fatalf("range up to the limit mismatch: have %d accounts", len(accounts))
+	}
+	// An unknown root is answered with an empty range
+	if accounts, proof := serveAccountRange(db, &getAccountRangeData{Root: common.Hash{1}, Limit: maxHash, Bytes: softResponseLimit}); len(accounts) != 0 || len(proof) != 0 {
+		t.Fatal("range of an unknown root served")
+	}
+}
+
+// Tests the storage ranges are served whole, but the last one if incomplete,
+// which is proven then.
+func TestServeStorageRanges(t *testing.T) {
+	db, root, contract := newSnapTestState(t)
+	statedb, _ := state.New(root, db)
+	storageRoot := statedb.StorageTrie(contract).Hash()
+	accounts := []common.Hash{crypto.Keccak256Hash(contract[:]), crypto.Keccak256Hash(common.BigToAddress(big.NewInt(2)).Bytes())}
+
+	slots, proof := serveStorageRanges(db, &getStorageRangesData{Root: root, Accounts: accounts, Limit: maxHash, Bytes: softResponseLimit})
+	if len(slots) != 2 || len(slots[0]) != 30 || len(slots[1]) != 0 || proof != nil {
+		t.Fatalf("storages mismatch: have %d ranges, proof %d", len(slots), len(proof))
+	}
+	tr, _ := trie.New(common.Hash{}, trie.NewDatabase(pistdb.NewMemDatabase()))
+	for _, slot := range slots[0] {
+		tr.Update(slot.Hash[:], slot.Body)
+	}
+	if tr.Hash() != storageRoot {
+		t.Fatal("storage range incomplete")
+	}
+	// A size limit cuts the storage into proven ranges
+	var (
+		origin common.Hash
+		served int
+	)
+	for {
+		slots, proof := serveStorageRanges(db, &getStorageRangesData{Root: root, Accounts: accounts, Origin: origin, Limit: maxHash, Bytes: 200})
+		if len(slots) != 1 || len(proof) == 0 {
+			t.Fatalf("storage range from %x: have %d ranges, proof %d", origin, len(slots), len(proof))
+		}
+		keys, values := make([][]byte, len(slots[0])), make([][]byte, len(slots[0]))
+		for i, slot := range slots[0] {
+			keys[i], values[i] = slot.Hash[:], slot.Body
+		}
+		more, err := trie.VerifyRangeProof(storageRoot, origin[:], keys[len(keys)-1], keys, values, newTestProofDb(proof))
+		if err != nil {
+			t.Fatalf("storage range from %x: %v", origin, err)
+		}
+		served += len(keys)
+		if !more {
+			break
+		}
+		origin = common.BigToHash(new(big.Int).Add(slots[0][len(keys)-1].Hash.Big(), common.Big1))
+	}
+	if served != 30 {
+		t.Fatalf("slots served mismatch: have %d, want 30", served)
+	}
+}
+
+// Tests the codes known are served.
+func TestServeByteCodes(t *testing.T) {
+	db, _, _ := newSnapTestState(t)
+	code := []byte{0x60, 0x01}
+
+	codes := serveByteCodes(db, &getByteCodesData{Hashes: []common.Hash{{1}, crypto.Keccak256Hash(code)}, Bytes: softResponseLimit})
+	if len(codes) != 1 || !bytes.Equal(codes[0], code) {
+		t.Fatalf("codes mismatch: have %x", codes)
+	}
+}
@@ -0,0 +1,92 @@
+package native
+
+import (
+	"encoding/json"
+	"math/big"
+	"strconv"
+	"time"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/common/hexutil"
+	"git.taiyue.io/pist/go-pist/core/types"
+	"git.taiyue.io/pist/go-pist/core/vm"
+)
+
+// fourByteTracer collects the 4byte method identifiers of the calls along with
+// the size of the supplied data, so a reversed signature can be matched
+// against the size of the data.
+//
+// Example:
+//
+//	> debug.traceTransaction( "0x214e597e35da083692f5386141e69f47e973b2c56e7a8073b1ea08fd7571e9de", {tracer: "4byteTracer"})
+//	{
+//	  0x27dc297e-128: 1,
+//	  0x38cc4831-0: 2,
+//	  0x524f3889-96: 1,
+//	  0xadf59f99-288: 1,
+//	  0xc281d19e-0: 1
+//	}
+type fourByteTracer struct {
+	interrupt
+	ids map[string]int // ids aggregates the 4byte ids found
+}
+
+func newFourByteTracer(db vm.StateDB, cfg json.RawMessage) (Tracer, error) {
+	return &fourByteTracer{ids: make(map[string]int)}, nil
+}
+
+// store saves the given identifier and data size.
+func (t *fourByteTracer) store(id []byte, size int) {
+	t.ids[hexutil.Encode(id)+"-"+strconv.Itoa(size)]++
+}
+
+// CaptureStart implements vm.Tracer, it saves the outer calldata.
+func (t *fourByteTracer) CaptureStart(from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) error {
+	if !create && len(input) >= 4 {
+		t.store(input[:4], len(input)-4)
+	}
+	return nil
+}
+
+// CaptureState implements vm.Tracer, it saves the calldata of the internal calls.
+func (t *fourByteTracer) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, rStack *vm.ReturnStack, rData []byte, contract *vm.Contract, depth int, err error) error {
+	if t.stopped() || err != nil {
+		return nil
+	}
+	var in int // stack position of the input offset
+	switch op {
+	case vm.CALL, vm.CALLCODE:
+		in = 3
+	case vm.DELEGATECALL, vm.STATICCALL:
+		in = 2
+	default:
+		return nil
+	}
+	// Skip the precompiles apart from staking, those are just fancy opcodes
+	if to := common.Address(stack.Back(1).Bytes20()); to != types.StakingAddress && env.IsPrecompile(to) {
+		return nil
+	}
+	if size := stack.Back(in + 1).Uint64(); size >= 4 {
+		off := stack.Back(in).Uint64()
+		t.store(memory.GetCopy(int64(off), 4), int(size-4))
+	}
+	return nil
+}
+
+// CaptureFault implements vm.Tracer.
+func (t *fourByteTracer) CaptureFault(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, rStack *vm.ReturnStack, contract *vm.Contract, depth int, err error) error {
+	return nil
+}
+
+// CaptureEnd implements vm.Tracer.
+func (t *fourByteTracer) CaptureEnd(output []byte, gasUsed uint64, d time.Duration, err error) error {
+	return nil
+}
+
+// GetResult returns the identifiers with their counts.
+func (t *fourByteTracer) GetResult() (json.RawMessage, error) {
+	if t.reason != nil {
+		return nil, t.reason
+	}
+	return json.Marshal(t.ids)
+}
@@ -0,0 +1,256 @@
+package native
+
+import (
+	"encoding/json"
+	"math/big"
+	"time"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/common/hexutil"
+	"git.taiyue.io/pist/go-pist/core/types"
+	"git.taiyue.io/pist/go-pist/core/vm"
+)
+
+// stakingCall is a call to the staking contract decoded by its ABI.
+type stakingCall struct {
+	Method string                 `json:"method"`
+	Args   map[string]interface{} `json:"args,omitempty"`
+}
+
+// callFrame is a call made by the transaction, in the order of the fields of
+// the JavaScript callTracer.
+type callFrame struct {
+	Type    string          `json:"type"`
+	From    common.Address  `json:"from"`
+	To      *common.Address `json:"to,omitempty"`
+	Value   *hexutil.Big    `json:"value,omitempty"`
+	Gas     *hexutil.Uint64 `json:"gas,omitempty"`
+	GasUsed *hexutil.Uint64 `json:"gasUsed,omitempty"`
+	Input   hexutil.Bytes   `json:"input"`
+	Output  hexutil.Bytes   `json:"output,omitempty"`
+	Error   string          `json:"error,omitempty"`
+	Time    string          `json:"time,omitempty"`
+	Staking *stakingCall    `json:"staking,omitempty"`
+	Calls   []*callFrame    `json:"calls,omitempty"`
+
+	gasIn, gasCost uint64
+	outOff, outLen uint64
+}
+
+// callTracer extracts all the internal calls made by a transaction, the calls
+// to the staking contract are decoded into their method and arguments.
+type callTracer struct {
+	interrupt
+	callstack []*callFrame
+	descended bool // just descended from an outer call into an inner one
+}
+
+func newCallTracer(db vm.StateDB, cfg json.RawMessage) (Tracer, error) {
+	return &callTracer{callstack: []*callFrame{{}}}, nil
+}
+
+func uint64Ptr(n uint64) *hexutil.Uint64 {
+	return (*hexutil.Uint64)(&n)
+}
+
+// decodeStaking decodes a call to the staking contract, the arguments are
+// converted to their JSON hex forms.
+func decodeStaking(input []byte) *stakingCall {
+	method, args, err := vm.UnpackStakingInput(input)
+	if method == "" {
+		return nil
+	}
+	call := &stakingCall{Method: method}
+	if err != nil {
+		return call
+	}
+	call.Args = make(map[string]interface{}, len(args))
+	for k, v := range args {
+		switch v := v.(type) {
+		case *big.Int:
+			call.Args[k] = (*hexutil.Big)(v)
+		case []byte:
+			call.Args[k] = hexutil.Bytes(v)
+		default:
+			call.Args[k] = v
+		}
+	}
+	return call
+}
+
+func (t *callTracer) top() *callFrame {
+	return t.callstack[len(t.callstack)-1]
+}
+
+// CaptureStart implements vm.Tracer, it fills the outer call.
+func (t *callTracer) CaptureStart(from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) error {
+	root := t.callstack[0]
+	root.Type = "CALL"
+	if create {
+		root.Type = "CREATE"
+	}
+	root.From, root.To = from, &to
+	root.Input = common.CopyBytes(input)
+	root.Gas = uint64Ptr(gas)
+	root.Value = (*hexutil.Big)(new(big.Int).Set(value))
+	if to == types.StakingAddress {
+		root.Staking = decodeStaking(input)
+	}
+	return nil
+}
+
+// CaptureState implements vm.Tracer, it opens a frame on every call and closes
+// it when the execution returns to the depth of the caller.
+func (t *callTracer) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, rStack *vm.ReturnStack, rData []byte, contract *vm.Contract, depth int, err error) error {
+	if t.stopped() {
+		return nil
+	}
+	if err != nil {
+		t.fault(err)
+		return nil
+	}
+	switch op {
+	case vm.CREATE, vm.CREATE2:
+		off, size := stack.Back(1).Uint64(), stack.Back(2).Uint64()
+		t.callstack = append(t.callstack, &callFrame{
+			Type:    op.String(),
+			From:    contract.Address(),
+			Input:   memory.GetCopy(int64(off), int64(size)),
+			Value:   (*hexutil.Big)(stack.Back(0).ToBig()),
+			gasIn:   gas,
+			gasCost: cost,
+		})
+		t.descended = true
+		return nil
+
+	case vm.SELFDESTRUCT:
+		top := t.top()
+		top.Calls = append(top.Calls, &callFrame{Type: op.String()})
+		return nil
+
+	case vm.CALL, vm.CALLCODE, vm.DELEGATECALL, vm.STATICCALL:
+		to := common.Address(stack.Back(1).Bytes20())
+		// Skip the precompiles apart from staking, those are just fancy opcodes
+		if to != types.StakingAddress && env.IsPrecompile(to) {
+			return nil
+		}
+		off := 1
+		if op == vm.DELEGATECALL || op == vm.STATICCALL {
+			off = 0
+		}
+		inOff, inLen := stack.Back(2+off).Uint64(), stack.Back(3+off).Uint64()
+		call := &callFrame{
+			Type:    op.String(),
+			From:    contract.Address(),
+			To:      &to,
+			Input:   memory.GetCopy(int64(inOff), int64(inLen)),
+			gasIn:   gas,
+			gasCost: cost,
+			outOff:  stack.Back(4 + off).Uint64(),
+			outLen:  stack.Back(5 + off).Uint64(),
+		}
+		if off == 1 {
+			call.Value = (*hexutil.Big)(stack.Back(2).ToBig())
+		}
+		if to == types.StakingAddress {
+			call.Staking = decodeStaking(call.Input)
+		}
+		t.callstack = append(t.callstack, call)
+		t.descended = true
+		return nil
+	}
+	// The true allowance of an inner call is only known within it, as the
+	// requested gas is changed by the stipend and the 63/64 rule.
+	if t.descended {
+		if depth >= len(t.callstack) {
+			t.top().Gas = uint64Ptr(gas)
+		}
+		t.descended = false
+	}
+	if op == vm.REVERT {
+		t.top().Error = "execution reverted"
+		return nil
+	}
+	if depth == len(t.callstack)-1 {
+		call := t.top()
+		t.callstack = t.callstack[:len(t.callstack)-1]
+
+		ret := stack.Back(0)
+		if call.Type == vm.CREATE.String() || call.Type == vm.CREATE2.String() {
+			call.GasUsed = uint64Ptr(call.gasIn - call.gasCost - gas)
+			if !ret.IsZero() {
+				to := common.Address(ret.Bytes20())
+				call.To = &to
+				call.Output = env.StateDB.GetCode(to)
+			} else if call.Error == "" {
+				call.Error = "internal failure"
+			}
+		} else if call.Gas != nil || call.Staking != nil {
+			// The staking contract runs without descending, only its output is known
+			if call.Gas != nil {
+				call.GasUsed = uint64Ptr(call.gasIn - call.gasCost + uint64(*call.Gas) - gas)
+			}
+			if !ret.IsZero() {
+				call.Output = memory.GetCopy(int64(call.outOff), int64(call.outLen))
+			} else if call.Error == "" {
+				call.Error = "internal failure"
+			}
+		}
+		top := t.top()
+		top.Calls = append(top.Calls, call)
+	}
+	return nil
+}
+
+// fault pops the failed call into its parent.
+func (t *callTracer) fault(err error) {
+	// If the topmost call already reverted, don't handle the additional fault again
+	if t.top().Error != "" {
+		return
+	}
+	call := t.top()
+	t.callstack = t.callstack[:len(t.callstack)-1]
+	call.Error = err.Error()
+	// Consume all the available gas
+	if call.Gas != nil {
+		call.GasUsed = call.Gas
+	}
+	if len(t.callstack) > 0 {
+		top := t.top()
+		top.Calls = append(top.Calls, call)
+		return
+	}
+	// The outer call failed too, leave it in the stack
+	t.callstack = append(t.callstack, call)
+}
+
+// CaptureFault implements vm.Tracer.
+func (t *callTracer) CaptureFault(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, rStack *vm.ReturnStack, contract *vm.Contract, depth int, err error) error {
+	if !t.stopped() {
+		t.fault(err)
+	}
+	return nil
+}
+
+// CaptureEnd implements vm.Tracer, it completes the outer call.
+func (t *callTracer) CaptureEnd(output []byte, gasUsed uint64, d time.Duration, err error) error {
+	root := t.callstack[0]
+	root.GasUsed = uint64Ptr(gasUsed)
+	root.Output = common.CopyBytes(output)
+	root.Time = d.String()
+	if err != nil && root.Error == "" {
+		root.Error = err.Error()
+	}
+	if root.Error != "" {
+		root.Output = nil
+	}
+	return nil
+}
+
+// GetResult returns the outer call with all the inner ones.
+func (t *callTracer) GetResult() (json.RawMessage, error) {
+	if t.reason != nil {
+		return nil, t.reason
+	}
+	return json.Marshal(t.callstack[0])
+}
@@ -0,0 +1,196 @@
+package native
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"time"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/common/hexutil"
+	"git.taiyue.io/pist/go-pist/core/vm"
+	"git.taiyue.io/pist/go-pist/crypto"
+)
+
+// account is the state of an account in the prestate trace, in diff mode the
+// post state only holds the fields that changed.
+type account struct {
+	Balance *hexutil.Big                `json:"balance,omitempty"`
+	Nonce   uint64                      `json:"nonce,omitempty"`
+	Code    hexutil.Bytes               `json:"code,omitempty"`
+	Storage map[common.Hash]common.Hash `json:"storage,omitempty"`
+}
+
+type prestateConfig struct {
+	DiffMode bool `json:"diffMode"` // return the state before and after the transaction
+}
+
+type prestateDiff struct {
+	Pre  map[common.Address]*account `json:"pre"`
+	Post map[common.Address]*account `json:"post"`
+}
+
+// prestateTracer outputs sufficient information to create a local execution of
+// the transaction from a custom assembled genesis block.
+type prestateTracer struct {
+	interrupt
+	db     vm.StateDB
+	config prestateConfig
+	pre    map[common.Address]*account
+	to     common.Address
+	create bool
+}
+
+func newPrestateTracer(db vm.StateDB, cfg json.RawMessage) (Tracer, error) {
+	t := &prestateTracer{db: db, pre: make(map[common.Address]*account)}
+	if len(cfg) > 0 {
+		if err := json.Unmarshal(cfg, &t.config); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// lookupAccount injects the account into the prestate.
+func (t *prestateTracer) lookupAccount(addr common.Address) {
+	if _, ok := t.pre[addr]; ok {
+		return
+	}
+	t.pre[addr] = &account{
+		Balance: (*hexutil.Big)(new(big.Int).Set(t.db.GetBalance(addr))),
+		Nonce:   t.db.GetNonce(addr),
+		Code:    common.CopyBytes(t.db.GetCode(addr)),
+		Storage: make(map[common.Hash]common.Hash),
+	}
+}
+
+// lookupStorage injects the storage slot of the account into the prestate.
+func (t *prestateTracer) lookupStorage(addr common.Address, key common.Hash) {
+	t.lookupAccount(addr)
+	if _, ok := t.pre[addr].Storage[key]; ok {
+		return
+	}
+	t.pre[addr].Storage[key] = t.db.GetState(addr, key)
+}
+
+// CaptureStart implements vm.Tracer. The nonce of the sender and the value are
+// already applied, they are taken back from the prestate.
+func (t *prestateTracer) CaptureStart(from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) error {
+	t.to, t.create = to, create
+	t.lookupAccount(from)
+	t.lookupAccount(to)
+
+	fromAcc, toAcc := t.pre[from], t.pre[to]
+	fromAcc.Balance = (*hexutil.Big)(new(big.Int).Add(fromAcc.Balance.ToInt(), value))
+	toAcc.Balance = (*hexutil.Big)(new(big.Int).Sub(toAcc.Balance.ToInt(), value))
+	if fromAcc.Nonce > 0 {
+		fromAcc.Nonce--
+	}
+	return nil
+}
+
+// CaptureState implements vm.Tracer, it adds the state accessed by the opcode.
+func (t *prestateTracer) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, rStack *vm.ReturnStack, rData []byte, contract *vm.Contract, depth int, err error) error {
+	if t.stopped() || err != nil {
+		return nil
+	}
+	switch op {
+	case vm.EXTCODECOPY, vm.EXTCODESIZE, vm.EXTCODEHASH, vm.BALANCE, vm.SELFDESTRUCT:
+		t.lookupAccount(common.Address(stack.Back(0).Bytes20()))
+	case vm.CREATE:
+		from := contract.Address()
+		t.lookupAccount(crypto.CreateAddress(from, t.db.GetNonce(from)))
+	case vm.CREATE2:
+		off, size := stack.Back(1).Uint64(), stack.Back(2).Uint64()
+		code := memory.GetCopy(int64(off), int64(size))
+		t.lookupAccount(crypto.CreateAddress2(contract.Address(), stack.Back(3).Bytes32(), crypto.Keccak256(code)))
+	case vm.CALL, vm.CALLCODE, vm.DELEGATECALL, vm.STATICCALL:
+		t.lookupAccount(common.Address(stack.Back(1).Bytes20()))
+	case vm.SLOAD, vm.SSTORE:
+		t.lookupStorage(contract.Address(), common.Hash(stack.Back(0).Bytes32()))
+	}
+	return nil
+}
+
+// CaptureFault implements vm.Tracer.
+func (t *prestateTracer) CaptureFault(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, rStack *vm.ReturnStack, contract *vm.Contract, depth int, err error) error {
+	return nil
+}
+
+// CaptureEnd implements vm.Tracer.
+func (t *prestateTracer) CaptureEnd(output []byte, gasUsed uint64, d time.Duration, err error) error {
+	return nil
+}
+
+// GetResult returns the prestate, or in diff mode the state changed by the
+// transaction before and after it.
+func (t *prestateTracer) GetResult() (json.RawMessage, error) {
+	if t.reason != nil {
+		return nil, t.reason
+	}
+	if !t.config.DiffMode {
+		// Any existing state would have rejected the creation
+		if t.create {
+			delete(t.pre, t.to)
+		}
+		for _, acc := range t.pre {
+			dropZeroSlots(acc.Storage)
+		}
+		return json.Marshal(t.pre)
+	}
+	return json.Marshal(t.diff())
+}
+
+// diff compares the prestate with the state after the transaction, the
+// accounts and slots that didn't change are left out.
+func (t *prestateTracer) diff() *prestateDiff {
+	res := &prestateDiff{
+		Pre:  make(map[common.Address]*account),
+		Post: make(map[common.Address]*account),
+	}
+	for addr, pre := range t.pre {
+		post, modified := &account{Storage: make(map[common.Hash]common.Hash)}, false
+		if !t.db.Exist(addr) {
+			// The account was destroyed, or it never existed
+			if pre.Balance.ToInt().Sign() != 0 || pre.Nonce != 0 || len(pre.Code) != 0 {
+				dropZeroSlots(pre.Storage)
+				res.Pre[addr] = pre
+			}
+			continue
+		}
+		if balance := t.db.GetBalance(addr); balance.Cmp(pre.Balance.ToInt()) != 0 {
+			post.Balance, modified = (*hexutil.Big)(new(big.Int).Set(balance)), true
+		}
+		if nonce := t.db.GetNonce(addr); nonce != pre.Nonce {
+			post.Nonce, modified = nonce, true
+		}
+		if code := t.db.GetCode(addr); !bytes.Equal(code, pre.Code) {
+			post.Code, modified = common.CopyBytes(code), true
+		}
+		for key, val := range pre.Storage {
+			if newVal := t.db.GetState(addr, key); newVal != val {
+				post.Storage[key], modified = newVal, true
+			} else {
+				delete(pre.Storage, key)
+			}
+		}
+		if !modified {
+			continue
+		}
+		dropZeroSlots(pre.Storage)
+		if t.create && addr == t.to {
+			res.Post[addr] = post
+			continue
+		}
+		res.Pre[addr], res.Post[addr] = pre, post
+	}
+	return res
+}
+
+func dropZeroSlots(storage map[common.Hash]common.Hash) {
+	for key, val := range storage {
+		if val == (common.Hash{}) {
+			delete(storage, key)
+		}
+	}
+}
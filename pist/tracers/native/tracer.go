@@ -0,0 +1,55 @@
+// Package native contains the transaction tracers written in Go. They give the
+// results of the JavaScript tracers of the same name without running duktape,
+// which is far faster on blocks with many calls.
+package native
+
+import (
+	"encoding/json"
+	"sync/atomic"
+
+	"git.taiyue.io/pist/go-pist/core/vm"
+)
+
+// Tracer is a native transaction tracer, GetResult returns the trace as JSON.
+type Tracer interface {
+	vm.Tracer
+	GetResult() (json.RawMessage, error)
+	Stop(err error)
+}
+
+// ctorFn creates a tracer of a transaction executed on db with the config given
+// by the user.
+type ctorFn func(db vm.StateDB, cfg json.RawMessage) (Tracer, error)
+
+// ctors contains the native tracers by name.
+var ctors = map[string]ctorFn{
+	"callTracer":     newCallTracer,
+	"prestateTracer": newPrestateTracer,
+	"4byteTracer":    newFourByteTracer,
+}
+
+// New creates the native tracer of the name, ok is false if there is none.
+func New(name string, db vm.StateDB, cfg json.RawMessage) (tracer Tracer, ok bool, err error) {
+	ctor, ok := ctors[name]
+	if !ok {
+		return nil, false, nil
+	}
+	tracer, err = ctor(db, cfg)
+	return tracer, true, err
+}
+
+// interrupt is embedded by the tracers to stop on a timeout or a cancellation.
+type interrupt struct {
+	flag   uint32
+	reason error
+}
+
+// Stop terminates the tracing, GetResult returns err after it.
+func (i *interrupt) Stop(err error) {
+	i.reason = err
+	atomic.StoreUint32(&i.flag, 1)
+}
+
+func (i *interrupt) stopped() bool {
+	return atomic.LoadUint32(&i.flag) > 0
+}
@@ -0,0 +1,143 @@
+package native
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/core/state"
+	"git.taiyue.io/pist/go-pist/core/types"
+	"git.taiyue.io/pist/go-pist/core/vm"
+	"git.taiyue.io/pist/go-pist/crypto"
+	"git.taiyue.io/pist/go-pist/params"
+	"git.taiyue.io/pist/go-pist/pistdb"
+)
+
+var (
+	testFrom   = common.Address{0x01}
+	testCaller = common.Address{0x02}
+	testCallee = common.Address{0x03}
+	testOwner  = common.Address{0x04}
+)
+
+// testCode calls lockedBalance of testOwner on the staking contract and then
+// testCallee, which stores 1 and returns 0x2a.
+func testCode() (caller, callee []byte, selector []byte) {
+	selector = crypto.Keccak256([]byte("lockedBalance(address)"))[:4]
+	input := append(common.CopyBytes(selector), common.BytesToHash(testOwner[:]).Bytes()...)
+	word := make([]byte, 32)
+	copy(word, input[32:])
+
+	caller = append(caller, byte(vm.PUSH32))
+	caller = append(caller, input[:32]...)
+	caller = append(caller, byte(vm.PUSH1), 0, byte(vm.MSTORE), byte(vm.PUSH32))
+	caller = append(caller, word...)
+	caller = append(caller, byte(vm.PUSH1), 32, byte(vm.MSTORE))
+	// CALL(gas, staking, 0, 0, 36, 0, 32)
+	caller = append(caller, byte(vm.PUSH1), 32, byte(vm.PUSH1), 0, byte(vm.PUSH1), 36, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH20))
+	caller = append(caller, types.StakingAddress[:]...)
+	caller = append(caller, byte(vm.GAS), byte(vm.CALL), byte(vm.POP))
+	// CALL(gas, callee, 0, 0, 0, 0, 32)
+	caller = append(caller, byte(vm.PUSH1), 32, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH20))
+	caller = append(caller, testCallee[:]...)
+	caller = append(caller, byte(vm.GAS), byte(vm.CALL), byte(vm.POP), byte(vm.STOP))
+
+	callee = []byte{
+		byte(vm.PUSH1), 1, byte(vm.PUSH1), 0, byte(vm.SSTORE),
+		byte(vm.PUSH1), 0x2a, byte(vm.PUSH1), 0, byte(vm.MSTORE),
+		byte(vm.PUSH1), 32, byte(vm.PUSH1), 0, byte(vm.RETURN),
+	}
+	return caller, callee, selector
+}
+
+// runTracer executes the test code with the native tracer of the name.
+func runTracer(t *testing.T, name string, cfg json.RawMessage) json.RawMessage {
+	caller, callee, _ := testCode()
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(pistdb.NewMemDatabase()))
+	statedb.SetBalance(testFrom, big.NewInt(1e18))
+	statedb.SetCode(testCaller, caller)
+	statedb.SetCode(testCallee, callee)
+
+	tracer, ok, err := New(name, statedb, cfg)
+	if !ok || err != nil {
+		t.Fatalf("tracer %s not created: %v", name, err)
+	}
+	ctx := vm.Context{
+		CanTransfer: func(db vm.StateDB, addr common.Address, amount *big.Int) bool {
+			return db.GetBalance(addr).Cmp(amount) >= 0
+		},
+		Transfer: func(db vm.StateDB, from, to common.Address, amount *big.Int) {
+			db.SubBalance(from, amount)
+			db.AddBalance(to, amount)
+		},
+		GasPrice:    big.NewInt(1),
+		GasLimit:    10000000,
+		BlockNumber: big.NewInt(1),
+		Time:        big.NewInt(1),
+		Difficulty:  big.NewInt(1),
+	}
+	evm := vm.NewEVM(ctx, statedb, params.TestChainConfig, vm.Config{Debug: true, Tracer: tracer})
+	if _, _, err := evm.Call(vm.AccountRef(testFrom), testCaller, nil, 1000000, big.NewInt(0), nil); err != nil {
+		t.Fatal(err)
+	}
+	res, err := tracer.GetResult()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res
+}
+
+func TestCallTracer(t *testing.T) {
+	var res callFrame
+	if err := json.Unmarshal(runTracer(t, "callTracer", nil), &res); err != nil {
+		t.Fatal(err)
+	}
+	if res.Type != "CALL" || res.From != testFrom || *res.To != testCaller || len(res.Calls) != 2 {
+		t.Fatalf("outer call mismatch: %+v", res)
+	}
+	staking, call := res.Calls[0], res.Calls[1]
+	if *staking.To != types.StakingAddress || staking.Staking == nil || staking.Staking.Method != "lockedBalance" {
+		t.Fatalf("staking call not decoded: %+v", staking)
+	}
+	if owner, _ := staking.Staking.Args["owner"].(string); common.HexToAddress(owner) != testOwner {
+		t.Fatalf("staking argument mismatch: %v", staking.Staking.Args)
+	}
+	if len(staking.Output) != 32 || staking.Error != "" {
+		t.Fatalf("staking output mismatch: %x %s", staking.Output, staking.Error)
+	}
+	if *call.To != testCallee || call.Gas == nil || call.GasUsed == nil || common.BytesToHash(call.Output) != common.BigToHash(big.NewInt(0x2a)) {
+		t.Fatalf("inner call mismatch: %+v", call)
+	}
+}
+
+func TestFourByteTracer(t *testing.T) {
+	var res map[string]int
+	if err := json.Unmarshal(runTracer(t, "4byteTracer", nil), &res); err != nil {
+		t.Fatal(err)
+	}
+	_, _, selector := testCode()
+	if len(res) != 1 || res[common.ToHex(selector)+"-32"] != 1 {
+		t.Fatalf("ids mismatch: %v", res)
+	}
+}
+
+func TestPrestateTracerDiff(t *testing.T) {
+	var res prestateDiff
+	if err := json.Unmarshal(runTracer(t, "prestateTracer", json.RawMessage(`{"diffMode": true}`)), &res); err != nil {
+		t.Fatal(err)
+	}
+	pre, post := res.Pre[testCallee], res.Post[testCallee]
+	if pre == nil || post == nil {
+		t.Fatalf("callee state missing: %+v", res)
+	}
+	if len(pre.Storage) != 0 || post.Storage[common.Hash{}] != common.BigToHash(big.NewInt(1)) {
+		t.Fatalf("callee storage mismatch: pre %v, post %v", pre.Storage, post.Storage)
+	}
+	if post.Balance != nil || post.Code != nil {
+		t.Fatalf("unchanged fields in post: %+v", post)
+	}
+	if _, ok := res.Pre[testCaller]; ok {
+		t.Fatal("unchanged caller in the diff")
+	}
+}
@@ -0,0 +1,240 @@
+package pistclient
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"git.taiyue.io/pist/go-pist"
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/common/hexutil"
+	"git.taiyue.io/pist/go-pist/core/state"
+	"git.taiyue.io/pist/go-pist/core/types"
+	"git.taiyue.io/pist/go-pist/crypto"
+	"git.taiyue.io/pist/go-pist/light"
+	"git.taiyue.io/pist/go-pist/rlp"
+	"git.taiyue.io/pist/go-pist/rpc"
+)
+
+var errCodeMismatch = errors.New("code mismatches the proven code hash")
+
+// LightClient is a client checking what it reads from a full node: a header by
+// the signs of the committee of its epoch, and the state by the Merkle proofs
+// against a checked header. The committees are followed from the genesis one.
+// Only the checked methods of Client are exposed.
+type LightClient struct {
+	client *Client
+	chain  *light.CommitteeChain
+}
+
+// DialLight connects a light client to the given URL, trusting the genesis block
+// of hash.
+func DialLight(ctx context.Context, rawurl string, genesis common.Hash) (*LightClient, error) {
+	c, err := rpc.DialContext(ctx, rawurl)
+	if err != nil {
+		return nil, err
+	}
+	return NewLightClient(ctx, c, genesis)
+}
+
+// NewLightClient creates a light client that uses the given RPC client, trusting
+// the genesis block of hash.
+func NewLightClient(ctx context.Context, c *rpc.Client, genesis common.Hash) (*LightClient, error) {
+	lc := &LightClient{client: NewClient(c)}
+	sh, err := lc.signedHeader(ctx, big.NewInt(0))
+	if err != nil {
+		return nil, err
+	}
+	if sh.Header.Hash() != genesis {
+		return nil, light.ErrGenesisMismatch
+	}
+	if types.CalcCommitteeHash(sh.Infos, sh.ParentSigns) != sh.Header.CommitteeHash {
+		return nil, light.ErrSwitchInfos
+	}
+	lc.chain = light.NewCommitteeChain(types.NewBlockWithHeader(sh.Header).WithBody(nil, sh.Signs, sh.Infos, sh.ParentSigns))
+	return lc, nil
+}
+
+// Close closes the underlying RPC connection.
+func (lc *LightClient) Close() {
+	lc.client.Close()
+}
+
+// Chain returns the committee chain followed by the client.
+func (lc *LightClient) Chain() *light.CommitteeChain {
+	return lc.chain
+}
+
+// SendTransaction injects a signed transaction into the pending pool of the
+// full node. Nothing is read back, the node can only fail to relay it.
+func (lc *LightClient) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	return lc.client.SendTransaction(ctx, tx)
+}
+
+func (lc *LightClient) signedHeader(ctx context.Context, number *big.Int) (*light.SignedHeader, error) {
+	var raw hexutil.Bytes
+	if err := lc.client.c.CallContext(ctx, &raw, "pist_getSignedHeader", toBlockNumArg(number)); err != nil {
+		return nil, err
+	}
+	if len(raw) == 0 {
+		return nil, pistchain.NotFound
+	}
+	sh := new(light.SignedHeader)
+	if err := rlp.DecodeBytes(raw, sh); err != nil {
+		return nil, err
+	}
+	return sh, nil
+}
+
+// Sync hands the committee off up to the epoch of the head of the full node.
+func (lc *LightClient) Sync(ctx context.Context) error {
+	head, err := lc.signedHeader(ctx, nil)
+	if err != nil {
+		return err
+	}
+	return lc.syncTo(ctx, head.Header.Number.Uint64())
+}
+
+// syncTo hands the committee off epoch by epoch until the committee of the
+// epoch of number is known.
+func (lc *LightClient) syncTo(ctx context.Context, number uint64) error {
+	for {
+		epoch := lc.chain.EpochFromID(lc.chain.LastEpoch())
+		if number <= epoch.EndHeight {
+			return nil
+		}
+		end := new(big.Int).SetUint64(epoch.EndHeight)
+		sh, err := lc.signedHeader(ctx, end)
+		if err != nil {
+			return err
+		}
+		var nodes []hexutil.Bytes
+		if err := lc.client.c.CallContext(ctx, &nodes, "pist_getCommitteeProof", toBlockNumArg(end)); err != nil {
+			return err
+		}
+		proof := make([][]byte, len(nodes))
+		for i, node := range nodes {
+			proof[i] = node
+		}
+		if err := lc.chain.Handoff(sh.Header, sh.Signs, proof); err != nil {
+			return err
+		}
+	}
+}
+
+// HeaderByNumber returns a block header from the current canonical chain, checked
+// by the signs of its committee. If number is nil, the latest known header is
+// returned.
+func (lc *LightClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	sh, err := lc.signedHeader(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+	if err := lc.syncTo(ctx, sh.Header.Number.Uint64()); err != nil {
+		return nil, err
+	}
+	if err := lc.chain.VerifySignedHeader(sh); err != nil {
+		return nil, err
+	}
+	return sh.Header, nil
+}
+
+// proofResult is the part of the pist_getProof result a light client reads.
+type proofResult struct {
+	AccountProof []hexutil.Bytes `json:"accountProof"`
+	StorageProof []struct {
+		Proof []hexutil.Bytes `json:"proof"`
+	} `json:"storageProof"`
+}
+
+// readProven runs read on the state of the block of number, opened on the proof
+// of account and of its storage at keys. The block header is checked first.
+func (lc *LightClient) readProven(ctx context.Context, account common.Address, keys []common.Hash, number *big.Int, read func(*state.StateDB)) error {
+	header, err := lc.HeaderByNumber(ctx, number)
+	if err != nil {
+		return err
+	}
+	hexKeys := make([]string, len(keys))
+	for i, key := range keys {
+		hexKeys[i] = key.Hex()
+	}
+	var result proofResult
+	if err := lc.client.c.CallContext(ctx, &result, "pist_getProof", account, hexKeys, hexutil.EncodeBig(header.Number)); err != nil {
+		return err
+	}
+	var nodes [][]byte
+	for _, node := range result.AccountProof {
+		nodes = append(nodes, node)
+	}
+	for _, sp := range result.StorageProof {
+		for _, node := range sp.Proof {
+			nodes = append(nodes, node)
+		}
+	}
+	statedb, set, err := light.ProvenState(header.Root, nodes)
+	if err != nil {
+		return err
+	}
+	read(statedb)
+	if set.Missing() {
+		return light.ErrIncompleteProof
+	}
+	return nil
+}
+
+// BalanceAt returns the wei balance of the given account, proven against the
+// checked header of the block.
+func (lc *LightClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	var balance *big.Int
+	err := lc.readProven(ctx, account, nil, blockNumber, func(statedb *state.StateDB) {
+		balance = statedb.GetBalance(account)
+	})
+	return balance, err
+}
+
+// NonceAt returns the account nonce of the given account, proven against the
+// checked header of the block.
+func (lc *LightClient) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	var nonce uint64
+	err := lc.readProven(ctx, account, nil, blockNumber, func(statedb *state.StateDB) {
+		nonce = statedb.GetNonce(account)
+	})
+	return nonce, err
+}
+
+// StorageAt returns the value of key in the contract storage of the given
+// account, proven against the checked header of the block.
+func (lc *LightClient) StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error) {
+	var value common.Hash
+	err := lc.readProven(ctx, account, []common.Hash{key}, blockNumber, func(statedb *state.StateDB) {
+		value = statedb.GetState(account, key)
+	})
+	return value.Bytes(), err
+}
+
+// CodeAt returns the contract code of the given account, checked against the
+// code hash proven for the block.
+func (lc *LightClient) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	header, err := lc.HeaderByNumber(ctx, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	var codeHash common.Hash
+	err = lc.readProven(ctx, account, nil, header.Number, func(statedb *state.StateDB) {
+		codeHash = statedb.GetCodeHash(account)
+	})
+	if err != nil {
+		return nil, err
+	}
+	var code hexutil.Bytes
+	if err := lc.client.c.CallContext(ctx, &code, "pist_getCode", account, hexutil.EncodeBig(header.Number)); err != nil {
+		return nil, err
+	}
+	if len(code) == 0 && codeHash == (common.Hash{}) {
+		return nil, nil
+	}
+	if crypto.Keccak256Hash(code) != codeHash {
+		return nil, errCodeMismatch
+	}
+	return code, nil
+}
@@ -100,6 +100,7 @@ type rpcBlock struct {
 	Transactions []rpcTransaction         `json:"transactions"`
 	SwitchInfos  []*types.CommitteeMember `json:"switchInfos"`
 	Signs        []*types.PbftSign        `json:"signs"`
+	ParentSigns  []*types.PbftSign        `json:"parentSigns"`
 }
 
 func (ec *Client) getBlock(ctx context.Context, method string, args ...interface{}) (*types.Block, error) {
@@ -142,7 +143,7 @@ func (ec *Client) getBlock(ctx context.Context, method string, args ...interface
 		}
 		txs[i] = tx.tx
 	}
-	return types.NewBlockWithHeader(head).WithBody(txs, body.Signs, body.SwitchInfos), nil
+	return types.NewBlockWithHeader(head).WithBody(txs, body.Signs, body.SwitchInfos, body.ParentSigns), nil
 }
 
 // HeaderByHash returns the block header with the given hash.
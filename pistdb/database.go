@@ -60,7 +60,7 @@ type AncientReader interface {
 type AncientWriter interface {
 	// AppendAncient injects all binary blobs belong to block at the end of the
 	// append-only immutable table files.
-	AppendAncient(number uint64, hash, header, body, receipt, td []byte) error
+	AppendAncient(number uint64, hash, header, body, receipts, committee []byte) error
 
 	// TruncateAncients discards all but the first n ancient data from the ancient store.
 	TruncateAncients(n uint64) error
@@ -82,8 +82,9 @@ type Writer interface {
 	AncientWriter
 }
 type LDBDatabase struct {
-	fn string      // filename for reporting
-	db *leveldb.DB // LevelDB instance
+	fn      string      // filename for reporting
+	db      *leveldb.DB // LevelDB instance
+	ancient *freezer    // Flat files holding the immutable chain segments, nil if disabled
 
 	compTimeMeter    metrics.Meter // Meter for measuring the total time spent in database compaction
 	compReadMeter    metrics.Meter // Meter for measuring the data read during compaction
@@ -128,32 +129,62 @@ func (db *LDBDatabase) Compact(start []byte, limit []byte) error {
 	return db.Compact(start, limit)
 }
 
+// HasAncient returns an indicator whether the specified data exists in the
+// ancient store.
 func (db *LDBDatabase) HasAncient(kind string, number uint64) (bool, error) {
-	return db.HasAncient(kind, number)
+	if db.ancient == nil {
+		return false, errNotSupported
+	}
+	return db.ancient.HasAncient(kind, number)
 }
 
+// Ancient retrieves an ancient binary blob from the append-only immutable files.
 func (db *LDBDatabase) Ancient(kind string, number uint64) ([]byte, error) {
-	return db.Ancient(kind, number)
+	if db.ancient == nil {
+		return nil, errNotSupported
+	}
+	return db.ancient.Ancient(kind, number)
 }
 
+// Ancients returns the number of blocks in the ancient store.
 func (db *LDBDatabase) Ancients() (uint64, error) {
-	return db.Ancients()
+	if db.ancient == nil {
+		return 0, errNotSupported
+	}
+	return db.ancient.Ancients()
 }
 
+// AncientSize returns the ancient size of the specified category.
 func (db *LDBDatabase) AncientSize(kind string) (uint64, error) {
-	return db.AncientSize(kind)
+	if db.ancient == nil {
+		return 0, errNotSupported
+	}
+	return db.ancient.AncientSize(kind)
 }
 
-func (db *LDBDatabase) AppendAncient(number uint64, hash, header, body, receipt, td []byte) error {
-	return db.AppendAncient(number, hash, header, body, receipt, td)
+// AppendAncient injects all binary blobs belong to block at the end of the
+// append-only immutable table files.
+func (db *LDBDatabase) AppendAncient(number uint64, hash, header, body, receipts, committee []byte) error {
+	if db.ancient == nil {
+		return errNotSupported
+	}
+	return db.ancient.AppendAncient(number, hash, header, body, receipts, committee)
 }
 
+// TruncateAncients discards all but the first n ancient data from the ancient store.
 func (db *LDBDatabase) TruncateAncients(n uint64) error {
-	return db.TruncateAncients(n)
+	if db.ancient == nil {
+		return errNotSupported
+	}
+	return db.ancient.TruncateAncients(n)
 }
 
+// Sync flushes all in-memory ancient store data to disk.
 func (db *LDBDatabase) Sync() error {
-	return db.Sync()
+	if db.ancient == nil {
+		return errNotSupported
+	}
+	return db.ancient.Sync()
 }
 
 // NewLDBDatabase returns a LevelDB wrapped object.
@@ -190,6 +221,22 @@ func NewLDBDatabase(file string, cache int, handles int) (*LDBDatabase, error) {
 	}, nil
 }
 
+// NewLDBDatabaseWithFreezer returns a LevelDB wrapped object which keeps the
+// immutable chain segments in flat files under the freezer directory.
+func NewLDBDatabaseWithFreezer(file string, cache int, handles int, freezer string, namespace string) (*LDBDatabase, error) {
+	db, err := NewLDBDatabase(file, cache, handles)
+	if err != nil {
+		return nil, err
+	}
+	frdb, err := newFreezer(freezer, namespace)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	db.ancient = frdb
+	return db, nil
+}
+
 // Path returns the path to the database directory.
 func (db *LDBDatabase) Path() string {
 	return db.fn
@@ -240,6 +287,11 @@ func (db *LDBDatabase) Close() {
 		}
 		db.quitChan = nil
 	}
+	if db.ancient != nil {
+		if err := db.ancient.Close(); err != nil {
+			db.log.Error("Failed to close ancient database", "err", err)
+		}
+	}
 	err := db.db.Close()
 	if err == nil {
 		db.log.Info("Database closed")
@@ -542,31 +594,31 @@ func (dt *table) Compact(start []byte, limit []byte) error {
 }
 
 func (dt *table) HasAncient(kind string, number uint64) (bool, error) {
-	return dt.HasAncient(kind, number)
+	return dt.db.HasAncient(kind, number)
 }
 
 func (dt *table) Ancient(kind string, number uint64) ([]byte, error) {
-	return dt.Ancient(kind, number)
+	return dt.db.Ancient(kind, number)
 }
 
 func (dt *table) Ancients() (uint64, error) {
-	return dt.Ancients()
+	return dt.db.Ancients()
 }
 
 func (dt *table) AncientSize(kind string) (uint64, error) {
-	return dt.AncientSize(kind)
+	return dt.db.AncientSize(kind)
 }
 
-func (dt *table) AppendAncient(number uint64, hash, header, body, receipt, td []byte) error {
-	return dt.AppendAncient(number, hash, header, body, receipt, td)
+func (dt *table) AppendAncient(number uint64, hash, header, body, receipts, committee []byte) error {
+	return dt.db.AppendAncient(number, hash, header, body, receipts, committee)
 }
 
 func (dt *table) TruncateAncients(n uint64) error {
-	return dt.TruncateAncients(n)
+	return dt.db.TruncateAncients(n)
 }
 
 func (dt *table) Sync() error {
-	return dt.Sync()
+	return dt.db.Sync()
 }
 
 func (dt *table) NewIterator() Iterator {
@@ -0,0 +1,153 @@
+package pistdb
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"git.taiyue.io/pist/go-pist/metrics"
+)
+
+func getChunk(size int, b int) []byte {
+	data := make([]byte, size)
+	for i := range data {
+		data[i] = byte(b)
+	}
+	return data
+}
+
+// TestFreezerTableRoundtrip fills a table over several data files, reopens it
+// and checks every item survives.
+func TestFreezerTableRoundtrip(t *testing.T) {
+	dir, err := ioutil.TempDir("", "freezer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	rm, wm, sg := metrics.NewMeter(), metrics.NewMeter(), metrics.NewGauge()
+	for _, noSnappy := range []bool{true, false} {
+		name := fmt.Sprintf("roundtrip-%v", noSnappy)
+		f, err := newCustomTable(dir, name, rm, wm, sg, 50, noSnappy)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < 30; i++ {
+			if err := f.Append(uint64(i), getChunk(15, i)); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := f.Append(40, getChunk(15, 40)); err == nil {
+			t.Fatal("out of order append accepted")
+		}
+		f.Close()
+
+		if f, err = newCustomTable(dir, name, rm, wm, sg, 50, noSnappy); err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < 30; i++ {
+			got, err := f.Retrieve(uint64(i))
+			if err != nil {
+				t.Fatalf("item %d: %v", i, err)
+			}
+			if !bytes.Equal(got, getChunk(15, i)) {
+				t.Fatalf("item %d mismatch: have %x", i, got)
+			}
+		}
+		if _, err := f.Retrieve(30); err != errOutOfBounds {
+			t.Fatalf("expected out of bounds, got %v", err)
+		}
+		f.Close()
+	}
+}
+
+// TestFreezerTableRepair checks that a data file cut short by a crash drops
+// the dangling index entries when the table is reopened.
+func TestFreezerTableRepair(t *testing.T) {
+	dir, err := ioutil.TempDir("", "freezer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	rm, wm, sg := metrics.NewMeter(), metrics.NewMeter(), metrics.NewGauge()
+	f, err := newCustomTable(dir, "repair", rm, wm, sg, 1000, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 10; i++ {
+		if err := f.Append(uint64(i), getChunk(20, i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	f.Close()
+
+	// Lose half of the last item
+	if err := os.Truncate(filepath.Join(dir, "repair.0000.rdat"), 190); err != nil {
+		t.Fatal(err)
+	}
+	if f, err = newCustomTable(dir, "repair", rm, wm, sg, 1000, true); err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if f.items != 9 {
+		t.Fatalf("items mismatch after repair: have %d, want %d", f.items, 9)
+	}
+	if err := f.Append(9, getChunk(20, 0xff)); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := f.Retrieve(9); !bytes.Equal(got, getChunk(20, 0xff)) {
+		t.Fatalf("item mismatch after repair: have %x", got)
+	}
+}
+
+// TestFreezerTruncate checks that all the tables of a freezer are truncated
+// together, and that appending resumes from there.
+func TestFreezerTruncate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "freezer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	f, err := newFreezer(dir, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 5; i++ {
+		b := getChunk(32, i)
+		if err := f.AppendAncient(uint64(i), b, b, b, b, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := f.AppendAncient(7, nil, nil, nil, nil, nil); err != errOutOrderInsertion {
+		t.Fatalf("expected out of order insertion, got %v", err)
+	}
+	if err := f.TruncateAncients(3); err != nil {
+		t.Fatal(err)
+	}
+	if frozen, _ := f.Ancients(); frozen != 3 {
+		t.Fatalf("frozen mismatch: have %d, want %d", frozen, 3)
+	}
+	if has, _ := f.HasAncient(FreezerBodiesTable, 3); has {
+		t.Fatal("truncated item still present")
+	}
+	if err := f.AppendAncient(3, getChunk(32, 9), nil, nil, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	if f, err = newFreezer(dir, ""); err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if frozen, _ := f.Ancients(); frozen != 4 {
+		t.Fatalf("frozen mismatch after reopen: have %d, want %d", frozen, 4)
+	}
+	if hash, _ := f.Ancient(FreezerHashTable, 3); !bytes.Equal(hash, getChunk(32, 9)) {
+		t.Fatalf("hash mismatch: have %x", hash)
+	}
+}
@@ -41,32 +41,39 @@ func (db *MemDatabase) Compact(start []byte, limit []byte) error {
 	return db.Compact(start, limit)
 }
 
+// HasAncient returns an error as we don't have a backing chain freezer.
 func (db *MemDatabase) HasAncient(kind string, number uint64) (bool, error) {
-	return db.HasAncient(kind, number)
+	return false, errNotSupported
 }
 
+// Ancient returns an error as we don't have a backing chain freezer.
 func (db *MemDatabase) Ancient(kind string, number uint64) ([]byte, error) {
-	return db.Ancient(kind, number)
+	return nil, errNotSupported
 }
 
+// Ancients returns an error as we don't have a backing chain freezer.
 func (db *MemDatabase) Ancients() (uint64, error) {
-	return db.Ancients()
+	return 0, errNotSupported
 }
 
+// AncientSize returns an error as we don't have a backing chain freezer.
 func (db *MemDatabase) AncientSize(kind string) (uint64, error) {
-	return db.AncientSize(kind)
+	return 0, errNotSupported
 }
 
-func (db *MemDatabase) AppendAncient(number uint64, hash, header, body, receipt, td []byte) error {
-	return db.AppendAncient(number, hash, header, body, receipt, td)
+// AppendAncient returns an error as we don't have a backing chain freezer.
+func (db *MemDatabase) AppendAncient(number uint64, hash, header, body, receipts, committee []byte) error {
+	return errNotSupported
 }
 
+// TruncateAncients returns an error as we don't have a backing chain freezer.
 func (db *MemDatabase) TruncateAncients(n uint64) error {
-	return db.TruncateAncients(n)
+	return errNotSupported
 }
 
+// Sync returns an error as we don't have a backing chain freezer.
 func (db *MemDatabase) Sync() error {
-	return db.Sync()
+	return errNotSupported
 }
 
 // NewIterator creates a binary-alphabetical iterator over the entire keyspace
@@ -0,0 +1,473 @@
+package rpc
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"git.taiyue.io/pist/go-pist/common/hexutil"
+	"git.taiyue.io/pist/go-pist/metrics"
+)
+
+var (
+	errInvalidToken = errors.New("invalid auth token")
+	errExpiredToken = errors.New("auth token expired or not valid yet")
+	errAuthRequired = errors.New("authentication required")
+	errAuthDisabled = errors.New("authentication not enabled")
+)
+
+var (
+	rejectAuthMeter      = metrics.NewRegisteredMeter("rpc/rejected/auth", nil)
+	rejectForbiddenMeter = metrics.NewRegisteredMeter("rpc/rejected/forbidden", nil)
+	rejectRateMeter      = metrics.NewRegisteredMeter("rpc/rejected/rate", nil)
+	rejectBatchMeter     = metrics.NewRegisteredMeter("rpc/rejected/batch", nil)
+	rejectResponseMeter  = metrics.NewRegisteredMeter("rpc/rejected/response", nil)
+)
+
+// Permission is what the holder of a token may call on the RPC server and the
+// limits it is held to. A zero limit is no limit.
+type Permission struct {
+	Name        string   // name of the holder in the logs and the metrics
+	Secret      string   `toml:",omitempty"` // hex HMAC-SHA256 key the JWT tokens of the holder are signed with
+	Modules     []string `toml:",omitempty"` // API modules the holder may call, "*" for all
+	Methods     []string `toml:",omitempty"` // single methods the holder may call, as module_method
+	Rate        float64  `toml:",omitempty"` // requests per second
+	Burst       int      `toml:",omitempty"` // requests over the rate accepted at once
+	MaxBatch    int      `toml:",omitempty"` // requests in a batch
+	MaxResponse int      `toml:",omitempty"` // bytes of the result of a request
+}
+
+// AuthConfig is the authentication of the RPC server. The tokens are JWTs signed
+// by HS256 with the secret of a permission, sent as a bearer token in the
+// Authorization header over HTTP and WebSocket, or by rpc_authenticate on a
+// connection. The GraphQL endpoint is served to the holders of the graphql
+// module.
+type AuthConfig struct {
+	Tokens []Permission
+
+	// Anonymous is the permission of the requests without a token, nil rejects
+	// them. Its rate is the one of each remote address.
+	Anonymous *Permission `toml:",omitempty"`
+
+	// IPC is the permission of the IPC connections not authenticated, nil for
+	// all the modules with no limit.
+	IPC *Permission `toml:",omitempty"`
+}
+
+// maxAddrLimiters is the number of remote addresses over which the limiters
+// of the idle ones are dropped.
+const maxAddrLimiters = 4096
+
+// permission is a Permission ready to check requests against.
+type permission struct {
+	name        string
+	secret      []byte
+	all         bool
+	modules     map[string]bool
+	methods     map[string]bool
+	rate        float64
+	burst       int
+	limiter     *limiter // shared by all the requests of the holder
+	maxBatch    int
+	maxResponse int
+	rejects     metrics.Meter
+
+	perAddr  bool                // one limiter for each remote address
+	lock     sync.Mutex          // protects limiters
+	limiters map[string]*limiter // limiters by remote address
+}
+
+func newPermission(p *Permission) (*permission, error) {
+	perm := &permission{
+		name:        p.Name,
+		modules:     make(map[string]bool),
+		methods:     make(map[string]bool),
+		rate:        p.Rate,
+		burst:       p.Burst,
+		maxBatch:    p.MaxBatch,
+		maxResponse: p.MaxResponse,
+		rejects:     metrics.GetOrRegisterMeter("rpc/rejected/holder/"+p.Name, nil),
+	}
+	if p.Secret != "" {
+		secret, err := hexutil.Decode(p.Secret)
+		if err != nil {
+			return nil, fmt.Errorf("invalid secret of %s: %v", p.Name, err)
+		}
+		perm.secret = secret
+	}
+	for _, module := range p.Modules {
+		if module == "*" {
+			perm.all = true
+		}
+		perm.modules[module] = true
+	}
+	for _, method := range p.Methods {
+		perm.methods[method] = true
+	}
+	if p.Rate > 0 {
+		perm.limiter = newLimiter(p.Rate, p.Burst)
+	}
+	return perm, nil
+}
+
+// limiterFor returns the limiter of the requests from the remote address, nil
+// if there is no rate limit.
+func (p *permission) limiterFor(remote string) *limiter {
+	if p.rate <= 0 || !p.perAddr {
+		return p.limiter
+	}
+	if host, _, err := net.SplitHostPort(remote); err == nil {
+		remote = host
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if l, ok := p.limiters[remote]; ok {
+		return l
+	}
+	if len(p.limiters) >= maxAddrLimiters {
+		// a full bucket is the same as a new one
+		for addr, l := range p.limiters {
+			if l.full() {
+				delete(p.limiters, addr)
+			}
+		}
+	}
+	l := newLimiter(p.rate, p.burst)
+	p.limiters[remote] = l
+	return l
+}
+
+// allows reports whether the holder may call method of module.
+func (p *permission) allows(module, method string) bool {
+	return p.all || p.modules[module] || p.methods[module+serviceMethodSeparator+method]
+}
+
+// Auth checks the tokens of the requests to a server and the permissions they
+// are given.
+type Auth struct {
+	tokens    []*permission
+	anonymous *permission
+	ipc       *permission
+}
+
+// NewAuth creates the authentication of a server from its configuration.
+func NewAuth(config *AuthConfig) (*Auth, error) {
+	auth := new(Auth)
+	for i := range config.Tokens {
+		perm, err := newPermission(&config.Tokens[i])
+		if err != nil {
+			return nil, err
+		}
+		if len(perm.secret) == 0 {
+			return nil, fmt.Errorf("no secret for the token of %s", perm.name)
+		}
+		auth.tokens = append(auth.tokens, perm)
+	}
+	var err error
+	if config.Anonymous != nil {
+		if auth.anonymous, err = newPermission(config.Anonymous); err != nil {
+			return nil, err
+		}
+		auth.anonymous.perAddr = true
+		auth.anonymous.limiters = make(map[string]*limiter)
+	}
+	ipc := config.IPC
+	if ipc == nil {
+		ipc = &Permission{Name: "ipc", Modules: []string{"*"}}
+	}
+	if auth.ipc, err = newPermission(ipc); err != nil {
+		return nil, err
+	}
+	return auth, nil
+}
+
+// jwtClaims are the claims of a token the server checks.
+type jwtClaims struct {
+	IssuedAt  int64 `json:"iat,omitempty"`
+	NotBefore int64 `json:"nbf,omitempty"`
+	ExpiresAt int64 `json:"exp,omitempty"`
+}
+
+// verify returns the permission of the holder whose secret signed token.
+func (a *Auth) verify(token string) (*permission, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errInvalidToken
+	}
+	var header struct {
+		Alg string `json:"alg"`
+	}
+	if data, err := base64.RawURLEncoding.DecodeString(parts[0]); err != nil || json.Unmarshal(data, &header) != nil || header.Alg != "HS256" {
+		return nil, errInvalidToken
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errInvalidToken
+	}
+	var holder *permission
+	for _, perm := range a.tokens {
+		mac := hmac.New(sha256.New, perm.secret)
+		mac.Write([]byte(parts[0] + "." + parts[1]))
+		if hmac.Equal(sig, mac.Sum(nil)) {
+			holder = perm
+			break
+		}
+	}
+	if holder == nil {
+		return nil, errInvalidToken
+	}
+	var claims jwtClaims
+	if data, err := base64.RawURLEncoding.DecodeString(parts[1]); err != nil || json.Unmarshal(data, &claims) != nil {
+		return nil, errInvalidToken
+	}
+	now := time.Now().Unix()
+	if (claims.ExpiresAt != 0 && now >= claims.ExpiresAt) || (claims.NotBefore != 0 && now < claims.NotBefore) {
+		return nil, errExpiredToken
+	}
+	return holder, nil
+}
+
+// fromRequest returns the permission of the bearer token of r, the anonymous
+// one if it has none.
+func (a *Auth) fromRequest(r *http.Request) (*permission, error) {
+	header := r.Header.Get("Authorization")
+	if header == "" {
+		if a.anonymous == nil {
+			return nil, errAuthRequired
+		}
+		return a.anonymous, nil
+	}
+	if !strings.HasPrefix(header, "Bearer ") {
+		return nil, errInvalidToken
+	}
+	return a.verify(strings.TrimPrefix(header, "Bearer "))
+}
+
+// HTTPHandler returns next behind the checks of the bearer tokens, served to
+// the holders allowed all of module and within their rate. It is for the HTTP
+// endpoints serving something else than RPC requests.
+func (a *Auth) HTTPHandler(module string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		perm, err := a.fromRequest(r)
+		if err != nil {
+			rejectAuthMeter.Mark(1)
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		if !perm.all && !perm.modules[module] {
+			rejectForbiddenMeter.Mark(1)
+			perm.rejects.Mark(1)
+			http.Error(w, fmt.Sprintf("module %s not allowed", module), http.StatusForbidden)
+			return
+		}
+		if l := perm.limiterFor(r.RemoteAddr); l != nil && !l.allow() {
+			rejectRateMeter.Mark(1)
+			perm.rejects.Mark(1)
+			http.Error(w, "request rate exceeded", http.StatusTooManyRequests)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// NewAuthToken returns a JWT signed with secret, valid for ttl from now or with
+// no expiry for a zero ttl.
+func NewAuthToken(secret []byte, ttl time.Duration) string {
+	now := time.Now()
+	claims := jwtClaims{IssuedAt: now.Unix()}
+	if ttl != 0 {
+		claims.ExpiresAt = now.Add(ttl).Unix()
+	}
+	header, _ := json.Marshal(map[string]string{"alg": "HS256", "typ": "JWT"})
+	body, _ := json.Marshal(claims)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(body)
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(signed))
+	return signed + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// connAuth is the permission of the requests of a connection, which
+// rpc_authenticate changes, and the limiter of their rate.
+type connAuth struct {
+	lock    sync.RWMutex
+	remote  string
+	perm    *permission
+	limiter *limiter
+}
+
+type connAuthKey struct{}
+
+func (c *connAuth) permission() *permission {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.perm
+}
+
+func (c *connAuth) rateLimiter() *limiter {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.limiter
+}
+
+func (c *connAuth) set(perm *permission) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.perm = perm
+	c.limiter = nil
+	if perm != nil {
+		c.limiter = perm.limiterFor(c.remote)
+	}
+}
+
+// withAuth returns ctx holding the permission of a connection from the remote
+// address, unless it holds one already.
+func withAuth(ctx context.Context, perm *permission, remote string) context.Context {
+	if _, ok := ctx.Value(connAuthKey{}).(*connAuth); ok {
+		return ctx
+	}
+	c := &connAuth{remote: remote}
+	c.set(perm)
+	return context.WithValue(ctx, connAuthKey{}, c)
+}
+
+// authFromContext returns the permission of the connection of ctx.
+func authFromContext(ctx context.Context) *connAuth {
+	c, _ := ctx.Value(connAuthKey{}).(*connAuth)
+	return c
+}
+
+// authorize checks a request against the permission of its connection.
+func (s *Server) authorize(ctx context.Context, req *serverRequest) Error {
+	if s.auth == nil {
+		return nil
+	}
+	c := authFromContext(ctx)
+	if c == nil {
+		return nil
+	}
+	perm := c.permission()
+	if perm == nil {
+		if req.svcname == MetadataApi {
+			return nil // rpc_authenticate
+		}
+		rejectAuthMeter.Mark(1)
+		return &unauthorizedError{errAuthRequired.Error()}
+	}
+	if !req.isUnsubscribe && req.svcname != MetadataApi {
+		method := subscribeMethodSuffix[1:]
+		if !req.callb.isSubscribe {
+			method = formatName(req.callb.method.Name)
+		}
+		if !perm.allows(req.svcname, method) {
+			rejectForbiddenMeter.Mark(1)
+			perm.rejects.Mark(1)
+			return &unauthorizedError{fmt.Sprintf("method %s%s%s not allowed", req.svcname, serviceMethodSeparator, method)}
+		}
+	}
+	if l := c.rateLimiter(); l != nil && !l.allow() {
+		rejectRateMeter.Mark(1)
+		perm.rejects.Mark(1)
+		return &limitExceededError{"request rate exceeded"}
+	}
+	return nil
+}
+
+// authorizeBatch checks the size of a batch against the permission of its
+// connection.
+func (s *Server) authorizeBatch(ctx context.Context, size int) Error {
+	if s.auth == nil {
+		return nil
+	}
+	c := authFromContext(ctx)
+	if c == nil {
+		return nil
+	}
+	if perm := c.permission(); perm != nil && perm.maxBatch > 0 && size > perm.maxBatch {
+		rejectBatchMeter.Mark(1)
+		perm.rejects.Mark(1)
+		return &limitExceededError{fmt.Sprintf("batch too large (%d>%d)", size, perm.maxBatch)}
+	}
+	return nil
+}
+
+// authorizeResponse checks the size of a result against the permission of the
+// connection.
+func (s *Server) authorizeResponse(ctx context.Context, result interface{}) Error {
+	if s.auth == nil {
+		return nil
+	}
+	c := authFromContext(ctx)
+	if c == nil {
+		return nil
+	}
+	perm := c.permission()
+	if perm == nil || perm.maxResponse <= 0 {
+		return nil
+	}
+	data, err := json.Marshal(result)
+	if err != nil {
+		return nil // the codec reports it
+	}
+	if len(data) > perm.maxResponse {
+		rejectResponseMeter.Mark(1)
+		perm.rejects.Mark(1)
+		return &limitExceededError{fmt.Sprintf("response too large (%d>%d)", len(data), perm.maxResponse)}
+	}
+	return nil
+}
+
+// limiter is a token bucket refilled at rate tokens a second up to burst.
+type limiter struct {
+	lock   sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newLimiter(rate float64, burst int) *limiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &limiter{rate: rate, burst: float64(burst), tokens: float64(burst), last: time.Now()}
+}
+
+// full reports whether the bucket is refilled up to burst.
+func (l *limiter) full() bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return l.tokens+time.Since(l.last).Seconds()*l.rate >= l.burst
+}
+
+// allow takes a token from the bucket, reporting whether there was one.
+func (l *limiter) allow() bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+	if l.tokens < 1 {
+		return false
+	}
+	l.tokens--
+	return true
+}
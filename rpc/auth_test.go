@@ -0,0 +1,191 @@
+package rpc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newAuthServer(t *testing.T, config *AuthConfig) *Server {
+	server := NewServer()
+	if err := server.RegisterName("test", new(Service)); err != nil {
+		t.Fatal(err)
+	}
+	if err := server.RegisterName("calc", new(Service)); err != nil {
+		t.Fatal(err)
+	}
+	auth, err := NewAuth(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server.SetAuth(auth)
+	return server
+}
+
+func errorCode(err error) int {
+	if e, ok := err.(Error); ok {
+		return e.ErrorCode()
+	}
+	return 0
+}
+
+func TestHTTPAuth(t *testing.T) {
+	server := newAuthServer(t, &AuthConfig{Tokens: []Permission{
+		{Name: "team", Secret: "0x0102", Modules: []string{"test"}, Methods: []string{"calc_rets"}, MaxBatch: 2, MaxResponse: 64},
+		{Name: "slow", Secret: "0x0304", Modules: []string{"*"}, Rate: 0.001, Burst: 1},
+	}})
+	hs := httptest.NewServer(server)
+	defer hs.Close()
+
+	client, err := DialHTTP(hs.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	// No token, or a token of an unknown secret, is refused
+	var result Result
+	if err := client.Call(&result, "test_echo", "x", 1, &Args{"y"}); err == nil || !strings.Contains(err.Error(), "401") {
+		t.Fatalf("expected unauthorized error, got %v", err)
+	}
+	client.SetHeader("Authorization", "Bearer "+NewAuthToken([]byte{5}, 0))
+	if err := client.Call(&result, "test_echo", "x", 1, &Args{"y"}); err == nil || !strings.Contains(err.Error(), "401") {
+		t.Fatalf("expected unauthorized error, got %v", err)
+	}
+
+	// The modules and methods of the token are served, the others refused
+	client.SetHeader("Authorization", "Bearer "+NewAuthToken([]byte{1, 2}, 0))
+	if err := client.Call(&result, "test_echo", "x", 1, &Args{"y"}); err != nil {
+		t.Fatal(err)
+	}
+	var ret string
+	if err := client.Call(&ret, "calc_rets"); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Call(nil, "calc_noArgsRets"); errorCode(err) != -32001 {
+		t.Fatalf("expected forbidden error, got %v", err)
+	}
+
+	// The batch and response size limits
+	batch := []BatchElem{{Method: "calc_rets", Result: &ret}, {Method: "calc_rets", Result: &ret}, {Method: "calc_rets", Result: &ret}}
+	if err := client.BatchCall(batch); err != nil {
+		t.Fatal(err)
+	}
+	if errorCode(batch[0].Error) != -32005 {
+		t.Fatalf("expected batch limit error, got %v", batch[0].Error)
+	}
+	if err := client.Call(&result, "test_echo", strings.Repeat("x", 64), 1, &Args{"y"}); errorCode(err) != -32005 {
+		t.Fatalf("expected response limit error, got %v", err)
+	}
+
+	// The rate limit of a token
+	client.SetHeader("Authorization", "Bearer "+NewAuthToken([]byte{3, 4}, 0))
+	if err := client.Call(&ret, "calc_rets"); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Call(&ret, "calc_rets"); errorCode(err) != -32005 {
+		t.Fatalf("expected rate limit error, got %v", err)
+	}
+}
+
+func TestConnAuthenticate(t *testing.T) {
+	server := newAuthServer(t, &AuthConfig{
+		Tokens:    []Permission{{Name: "team", Secret: "0x0102", Modules: []string{"test"}}},
+		Anonymous: &Permission{Name: "anonymous", Methods: []string{"calc_rets"}},
+		IPC:       &Permission{Name: "ipc", Methods: []string{"calc_rets"}},
+	})
+	client := DialInProc(server)
+	defer client.Close()
+
+	var ret string
+	if err := client.Call(&ret, "calc_rets"); err != nil {
+		t.Fatal(err)
+	}
+	var result Result
+	if err := client.Call(&result, "test_echo", "x", 1, &Args{"y"}); errorCode(err) != -32001 {
+		t.Fatalf("expected forbidden error, got %v", err)
+	}
+	var ok bool
+	if err := client.Call(&ok, "rpc_authenticate", NewAuthToken([]byte{1, 2}, -1)); err == nil {
+		t.Fatal("authenticated with an expired token")
+	}
+	if err := client.Call(&ok, "rpc_authenticate", NewAuthToken([]byte{1, 2}, 0)); err != nil || !ok {
+		t.Fatalf("authentication failed: %v", err)
+	}
+	if err := client.Call(&result, "test_echo", "x", 1, &Args{"y"}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAuthDefaultIPC(t *testing.T) {
+	server := newAuthServer(t, &AuthConfig{
+		Tokens: []Permission{{Name: "team", Secret: "0x0102", Modules: []string{"test"}}},
+	})
+	client := DialInProc(server)
+	defer client.Close()
+
+	var ret string
+	if err := client.Call(&ret, "calc_rets"); err != nil {
+		t.Fatal(err)
+	}
+	var result Result
+	if err := client.Call(&result, "test_echo", "x", 1, &Args{"y"}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAnonymousRatePerAddr(t *testing.T) {
+	auth, err := NewAuth(&AuthConfig{
+		Anonymous: &Permission{Name: "anonymous", Modules: []string{"*"}, Rate: 0.001, Burst: 1},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	first := auth.anonymous.limiterFor("10.0.0.1:3000")
+	if auth.anonymous.limiterFor("10.0.0.1:4000") != first {
+		t.Fatal("ports of an address have their own limiter")
+	}
+	second := auth.anonymous.limiterFor("10.0.0.2:3000")
+	if second == first {
+		t.Fatal("addresses share a limiter")
+	}
+	if !first.allow() || first.allow() {
+		t.Fatal("rate of an address not limited")
+	}
+	if !second.allow() {
+		t.Fatal("rate of an address limited by another")
+	}
+}
+
+func TestHTTPHandlerAuth(t *testing.T) {
+	auth, err := NewAuth(&AuthConfig{Tokens: []Permission{
+		{Name: "team", Secret: "0x0102", Modules: []string{"graphql"}, Rate: 0.001, Burst: 1},
+		{Name: "rpc", Secret: "0x0304", Modules: []string{"pist"}, Methods: []string{"graphql_query"}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler := auth.HTTPHandler("graphql", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	for i, test := range []struct {
+		secret []byte
+		code   int
+	}{
+		{nil, http.StatusUnauthorized},
+		{[]byte{5}, http.StatusUnauthorized},
+		{[]byte{3, 4}, http.StatusForbidden}, // a method doesn't allow the whole module
+		{[]byte{1, 2}, http.StatusOK},
+		{[]byte{1, 2}, http.StatusTooManyRequests},
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+		if test.secret != nil {
+			req.Header.Set("Authorization", "Bearer "+NewAuthToken(test.secret, 0))
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		if rec.Code != test.code {
+			t.Errorf("test %d: status mismatch: have %d, want %d", i, rec.Code, test.code)
+		}
+	}
+}
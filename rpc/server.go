@@ -73,6 +73,31 @@ func (s *RPCService) Modules() map[string]string {
 	return modules
 }
 
+// Authenticate sets the permission of the requests of the connection to the
+// one of the holder of token.
+func (s *RPCService) Authenticate(ctx context.Context, token string) (bool, error) {
+	if s.server.auth == nil {
+		return false, errAuthDisabled
+	}
+	c := authFromContext(ctx)
+	if c == nil {
+		return false, errAuthDisabled
+	}
+	perm, err := s.server.auth.verify(token)
+	if err != nil {
+		rejectAuthMeter.Mark(1)
+		return false, err
+	}
+	c.set(perm)
+	return true, nil
+}
+
+// SetAuth makes the server check the requests against auth, it must be set
+// before the server serves.
+func (s *Server) SetAuth(auth *Auth) {
+	s.auth = auth
+}
+
 // RegisterName will create a service for the given rcvr type under the given name. When no methods on the given rcvr
 // match the criteria to be either a RPC method or a subscription an error is returned. Otherwise a new service is
 // created and added to the service collection this server instance serves.
@@ -150,6 +175,10 @@ func (s *Server) serveRequest(ctx context.Context, codec ServerCodec, singleShot
 	if options&OptionSubscriptions == OptionSubscriptions {
 		ctx = context.WithValue(ctx, notifierKey{}, newNotifier(codec))
 	}
+	// the connections not authenticated by their transport are IPC ones
+	if s.auth != nil {
+		ctx = withAuth(ctx, s.auth.ipc, "")
+	}
 	s.codecsMu.Lock()
 	if atomic.LoadInt32(&s.run) != 1 { // server stopped
 		s.codecsMu.Unlock()
@@ -187,6 +216,20 @@ func (s *Server) serveRequest(ctx context.Context, codec ServerCodec, singleShot
 			}
 			return nil
 		}
+		// reject the batches over the limit of the connection
+		if batch {
+			if err := s.authorizeBatch(ctx, len(reqs)); err != nil {
+				resps := make([]interface{}, len(reqs))
+				for i, r := range reqs {
+					resps[i] = codec.CreateErrorResponse(&r.id, err)
+				}
+				codec.Write(resps)
+				if singleShot {
+					return nil
+				}
+				continue
+			}
+		}
 		// If a single shot request is executing, run and return immediately
 		if singleShot {
 			if batch {
@@ -259,6 +302,9 @@ func (s *Server) handle(ctx context.Context, codec ServerCodec, req *serverReque
 	if req.err != nil {
 		return codec.CreateErrorResponse(&req.id, req.err), nil
 	}
+	if err := s.authorize(ctx, req); err != nil {
+		return codec.CreateErrorResponse(&req.id, err), nil
+	}
 
 	if req.isUnsubscribe { // cancel subscription, first param must be the subscription id
 		if len(req.args) >= 1 && req.args[0].Kind() == reflect.String {
@@ -320,6 +366,9 @@ func (s *Server) handle(ctx context.Context, codec ServerCodec, req *serverReque
 			return res, nil
 		}
 	}
+	if err := s.authorizeResponse(ctx, reply[0].Interface()); err != nil {
+		return codec.CreateErrorResponse(&req.id, err), nil
+	}
 	return codec.CreateResponse(req.id, reply[0].Interface()), nil
 }
 
@@ -30,12 +30,17 @@ import (
 	"git.taiyue.io/pist/go-pist/accounts/usbwallet"
 	"git.taiyue.io/pist/go-pist/common"
 	"git.taiyue.io/pist/go-pist/common/hexutil"
+	"git.taiyue.io/pist/go-pist/core/types"
 	"git.taiyue.io/pist/go-pist/crypto"
 	"git.taiyue.io/pist/go-pist/internal/pistapi"
 	"git.taiyue.io/pist/go-pist/log"
 	"git.taiyue.io/pist/go-pist/rlp"
+	"git.taiyue.io/pist/go-pist/signer/core/apitypes"
 )
 
+// ExternalAPIVersion is the version of the external API served by the signer.
+const ExternalAPIVersion = "1.2.0"
+
 // ExternalAPI defines the external API through which signing requests are made.
 type ExternalAPI interface {
 	// List available accounts
@@ -44,20 +49,28 @@ type ExternalAPI interface {
 	New(ctx context.Context) (accounts.Account, error)
 	// SignTransaction request to sign the specified transaction
 	SignTransaction(ctx context.Context, args SendTxArgs, methodSelector *string) (*pistapi.SignTransactionResult, error)
+	// SignPayment request to sign the specified transaction as the payer of its gas
+	SignPayment(ctx context.Context, rawTx hexutil.Bytes, methodSelector *string) (*pistapi.SignTransactionResult, error)
 	// Sign - request to sign the given data (plus prefix)
 	Sign(ctx context.Context, addr common.MixedcaseAddress, data hexutil.Bytes) (hexutil.Bytes, error)
+	// SignTypedData - request to sign the given EIP-712 typed data
+	SignTypedData(ctx context.Context, addr common.MixedcaseAddress, typedData apitypes.TypedData) (hexutil.Bytes, error)
 	// EcRecover - request to perform ecrecover
 	EcRecover(ctx context.Context, data, sig hexutil.Bytes) (common.Address, error)
 	// Export - request to export an account
 	Export(ctx context.Context, addr common.Address) (json.RawMessage, error)
 	// Import - request to import an account
 	Import(ctx context.Context, keyJSON json.RawMessage) (Account, error)
+	// Version - the version of the external API
+	Version(ctx context.Context) (string, error)
 }
 
 // SignerUI specifies what method a UI needs to implement to be able to be used as a UI for the signer
 type SignerUI interface {
 	// ApproveTx prompt the user for confirmation to request to sign Transaction
 	ApproveTx(request *SignTxRequest) (SignTxResponse, error)
+	// ApprovePayment prompt the user for confirmation to request to pay the gas of a Transaction
+	ApprovePayment(request *SignPaymentRequest) (SignPaymentResponse, error)
 	// ApproveSignData prompt the user for confirmation to request to sign data
 	ApproveSignData(request *SignDataRequest) (SignDataResponse, error)
 	// ApproveExport prompt the user for confirmation to export encrypted Account json
@@ -136,6 +149,21 @@ type (
 		Approved    bool       `json:"approved"`
 		Password    string     `json:"password"`
 	}
+	// SignPaymentRequest contains info about a Transaction, signed by its sender,
+	// to sign as the payer of its gas
+	SignPaymentRequest struct {
+		Transaction SendTxArgs       `json:"transaction"`
+		Selector    *hexutil.Bytes   `json:"selector"`
+		GasBudget   hexutil.Big      `json:"gas_budget"`
+		Callinfo    []ValidationInfo `json:"call_info"`
+		Meta        Metadata         `json:"meta"`
+	}
+	// SignPaymentResponse result from SignPaymentRequest, the signed transaction
+	// can't be modified by the UI
+	SignPaymentResponse struct {
+		Approved bool   `json:"approved"`
+		Password string `json:"password"`
+	}
 	// ExportRequest info about query to export accounts
 	ExportRequest struct {
 		Address common.Address `json:"address"`
@@ -160,6 +188,8 @@ type (
 		Message string                  `json:"message"`
 		Hash    hexutil.Bytes           `json:"hash"`
 		Meta    Metadata                `json:"meta"`
+
+		TypedData *apitypes.TypedData `json:"typed_data,omitempty"` // Set when signing EIP-712 typed data
 	}
 	SignDataResponse struct {
 		Approved bool `json:"approved"`
@@ -187,7 +217,10 @@ type (
 	}
 )
 
-var ErrRequestDenied = errors.New("Request denied")
+var (
+	ErrRequestDenied = errors.New("Request denied")
+	ErrNoPayer       = errors.New("Transaction has no payer")
+)
 
 // NewSignerAPI creates a new API that can be used for Account management.
 // ksLocation specifies the directory where to store the password protected private
@@ -308,6 +341,14 @@ func logDiff(original *SignTxRequest, new *SignTxResponse) bool {
 		modified = true
 		log.Info("Nonce changed by UI", "was", n0, "is", n1)
 	}
+	if p0, p1 := original.Transaction.Payment, new.Transaction.Payment; !reflect.DeepEqual(p0, p1) {
+		modified = true
+		log.Info("Payer changed by UI", "was", p0, "is", p1)
+	}
+	if f0, f1 := original.Transaction.Fee, new.Transaction.Fee; !reflect.DeepEqual(f0, f1) {
+		modified = true
+		log.Info("Fee changed by UI", "was", f0, "is", f1)
+	}
 	return modified
 }
 
@@ -366,6 +407,67 @@ func (api *SignerAPI) SignTransaction(ctx context.Context, args SendTxArgs, meth
 
 }
 
+// SignPayment signs the given Transaction, already signed by its sender, as the payer
+// of its gas and returns it both as json and rlp-encoded form
+func (api *SignerAPI) SignPayment(ctx context.Context, rawTx hexutil.Bytes, methodSelector *string) (*pistapi.SignTransactionResult, error) {
+	tx := new(types.Transaction)
+	if err := rlp.DecodeBytes(rawTx, tx); err != nil {
+		return nil, err
+	}
+	payer := tx.Payer()
+	if payer == nil || *payer == (common.Address{}) {
+		return nil, ErrNoPayer
+	}
+	// The sender has to have signed the transaction on this chain
+	from, err := types.Sender(types.NewTIP1Signer(api.chainID), tx)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender signature: %v", err)
+	}
+	args := sendTxArgsFromTransaction(from, tx)
+	msgs, err := api.validator.ValidateTransaction(&args, methodSelector)
+	if err != nil {
+		return nil, err
+	}
+	req := SignPaymentRequest{
+		Transaction: args,
+		GasBudget:   hexutil.Big(*new(big.Int).Mul(tx.GasPrice(), new(big.Int).SetUint64(tx.Gas()))),
+		Callinfo:    msgs.Messages,
+		Meta:        MetadataFromContext(ctx),
+	}
+	if data := tx.Data(); len(data) >= 4 {
+		selector := hexutil.Bytes(data[:4])
+		req.Selector = &selector
+	}
+	// Process approval
+	result, err := api.UI.ApprovePayment(&req)
+	if err != nil {
+		return nil, err
+	}
+	if !result.Approved {
+		return nil, ErrRequestDenied
+	}
+	acc := accounts.Account{Address: *payer}
+	wallet, err := api.am.Find(acc)
+	if err != nil {
+		return nil, err
+	}
+	signedTx, err := wallet.SignTxWithPassphrase_Payment(acc, result.Password, tx, api.chainID)
+	if err != nil {
+		api.UI.ShowError(err.Error())
+		return nil, err
+	}
+	rlpdata, err := rlp.EncodeToBytes(signedTx)
+	if err != nil {
+		return nil, err
+	}
+	response := pistapi.SignTransactionResult{Raw: rlpdata, Tx: signedTx}
+
+	// Finally, send the signed tx to the UI
+	api.UI.OnApprovedTx(response)
+	// ...and to the external caller
+	return &response, nil
+}
+
 // Sign calculates an Ethereum ECDSA signature for:
 // keccack256("\x19Ethereum Signed Message:\n" + len(message) + message))
 //
@@ -404,6 +506,49 @@ func (api *SignerAPI) Sign(ctx context.Context, addr common.MixedcaseAddress, da
 	return signature, nil
 }
 
+// SignTypedData calculates an ECDSA signature for the EIP-712 typed data:
+// keccak256("\x19\x01" ‖ domainSeparator ‖ hashStruct(message))
+//
+// The domain has to be bound to the chain ID of the signer, so the signature
+// can't be replayed on another chain. The produced signature conforms to the
+// secp256k1 curve R, S and V values, where the V value will be 27 or 28.
+func (api *SignerAPI) SignTypedData(ctx context.Context, addr common.MixedcaseAddress, typedData apitypes.TypedData) (hexutil.Bytes, error) {
+	if err := typedData.Domain.VerifyChainID(api.chainID); err != nil {
+		return nil, err
+	}
+	sighash, rawData, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := json.MarshalIndent(typedData.Message, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	// We make the request prior to looking up if we actually have the account, to prevent
+	// account-enumeration via the API
+	req := &SignDataRequest{Address: addr, Rawdata: rawData, Message: string(msg), Hash: sighash, Meta: MetadataFromContext(ctx), TypedData: &typedData}
+	res, err := api.UI.ApproveSignData(req)
+	if err != nil {
+		return nil, err
+	}
+	if !res.Approved {
+		return nil, ErrRequestDenied
+	}
+	// Look up the wallet containing the requested signer
+	account := accounts.Account{Address: addr.Address()}
+	wallet, err := api.am.Find(account)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := wallet.SignTypedDataWithPassphrase(account, res.Password, typedData)
+	if err != nil {
+		api.UI.ShowError(err.Error())
+		return nil, err
+	}
+	signature[64] += 27 // Transform V from 0/1 to 27/28 according to the yellow paper
+	return signature, nil
+}
+
 // EcRecover returns the address for the Account that was used to create the signature.
 // Note, this function is compatible with pist_sign and personal_sign. As such it recovers
 // the address of:
@@ -487,3 +632,8 @@ func (api *SignerAPI) Import(ctx context.Context, keyJSON json.RawMessage) (Acco
 	}
 	return Account{Typ: "Account", URL: acc.URL, Address: acc.Address}, nil
 }
+
+// Version returns the version of the external API.
+func (api *SignerAPI) Version(ctx context.Context) (string, error) {
+	return ExternalAPIVersion, nil
+}
@@ -28,12 +28,14 @@ import (
 	"time"
 
 	"git.taiyue.io/pist/go-pist/accounts/keystore"
-	"git.taiyue.io/pist/go-pist/cmd/utils"
 	"git.taiyue.io/pist/go-pist/common"
 	"git.taiyue.io/pist/go-pist/common/hexutil"
+	"git.taiyue.io/pist/go-pist/common/math"
 	"git.taiyue.io/pist/go-pist/core/types"
+	"git.taiyue.io/pist/go-pist/crypto"
 	"git.taiyue.io/pist/go-pist/internal/pistapi"
 	"git.taiyue.io/pist/go-pist/rlp"
+	"git.taiyue.io/pist/go-pist/signer/core/apitypes"
 )
 
 //Used for testing
@@ -62,6 +64,12 @@ func (ui *HeadlessUI) ApproveTx(request *SignTxRequest) (SignTxResponse, error)
 		return SignTxResponse{request.Transaction, false, ""}, nil
 	}
 }
+func (ui *HeadlessUI) ApprovePayment(request *SignPaymentRequest) (SignPaymentResponse, error) {
+	if "Y" == <-ui.controller {
+		return SignPaymentResponse{true, <-ui.controller}, nil
+	}
+	return SignPaymentResponse{false, ""}, nil
+}
 func (ui *HeadlessUI) ApproveSignData(request *SignDataRequest) (SignDataResponse, error) {
 	if "Y" == <-ui.controller {
 		return SignDataResponse{true, <-ui.controller}, nil
@@ -127,7 +135,7 @@ func setup(t *testing.T) (*SignerAPI, chan string) {
 
 	db, err := NewAbiDBFromFile("../../cmd/clef/4byte.json")
 	if err != nil {
-		utils.Fatalf(err.Error())
+		t.Fatal(err)
 	}
 	var (
 		ui  = &HeadlessUI{controller}
@@ -357,6 +365,105 @@ func TestSignTx(t *testing.T) {
 
 }
 
+func TestSignPayment(t *testing.T) {
+	api, control := setup(t)
+	createAccount(control, api, t)
+	createAccount(control, api, t)
+	control <- "A"
+	list, err := api.List(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	from, payer := common.NewMixedcaseAddress(list[0].Address), common.NewMixedcaseAddress(list[1].Address)
+
+	tx := mkTestTx(from)
+	tx.Payment = &payer
+	control <- "Y"
+	control <- "apassword"
+	res, err := api.SignTransaction(context.Background(), tx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	control <- "No way"
+	if _, err = api.SignPayment(context.Background(), res.Raw, nil); err != ErrRequestDenied {
+		t.Errorf("Expected ErrRequestDenied! %v", err)
+	}
+	control <- "Y"
+	control <- "apassword"
+	paid, err := api.SignPayment(context.Background(), res.Raw, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer := types.NewTIP1Signer(big.NewInt(1))
+	if sender, err := types.Sender(signer, paid.Tx); err != nil || sender != from.Address() {
+		t.Errorf("Sender mismatch: have %x, want %x (%v)", sender, from.Address(), err)
+	}
+	if addr, err := types.Payer(signer, paid.Tx); err != nil || addr != payer.Address() {
+		t.Errorf("Payer mismatch: have %x, want %x (%v)", addr, payer.Address(), err)
+	}
+	// A transaction without payer can't be paid for
+	tx.Payment = nil
+	control <- "Y"
+	control <- "apassword"
+	if res, err = api.SignTransaction(context.Background(), tx, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = api.SignPayment(context.Background(), res.Raw, nil); err != ErrNoPayer {
+		t.Errorf("Expected ErrNoPayer! %v", err)
+	}
+}
+
+func TestSignTypedData(t *testing.T) {
+	api, control := setup(t)
+	createAccount(control, api, t)
+	control <- "A"
+	list, err := api.List(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := common.NewMixedcaseAddress(list[0].Address)
+
+	typedData := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {{Name: "name", Type: "string"}, {Name: "chainId", Type: "uint256"}},
+			"Order":        {{Name: "maker", Type: "address"}, {Name: "amount", Type: "uint256"}},
+		},
+		PrimaryType: "Order",
+		Domain:      apitypes.TypedDataDomain{Name: "Orderbook", ChainId: math.NewHexOrDecimal256(1)},
+		Message:     apitypes.TypedDataMessage{"maker": addr.Address().Hex(), "amount": "1000"},
+	}
+	control <- "No way"
+	if _, err = api.SignTypedData(context.Background(), addr, typedData); err != ErrRequestDenied {
+		t.Errorf("Expected ErrRequestDenied! %v", err)
+	}
+	control <- "Y"
+	control <- "apassword"
+	sig, err := api.SignTypedData(context.Background(), addr, typedData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sig) != 65 || (sig[64] != 27 && sig[64] != 28) {
+		t.Fatalf("Invalid signature %x", sig)
+	}
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig[64] -= 27
+	pubkey, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signer := crypto.PubkeyToAddress(*pubkey); signer != addr.Address() {
+		t.Errorf("Signer mismatch: have %x, want %x", signer, addr.Address())
+	}
+	// Typed data of another chain is rejected without prompting
+	typedData.Domain.ChainId = math.NewHexOrDecimal256(189)
+	if _, err = api.SignTypedData(context.Background(), addr, typedData); err == nil {
+		t.Errorf("Expected chain ID mismatch error")
+	}
+}
+
 /*
 func TestAsyncronousResponses(t *testing.T){
 
@@ -0,0 +1,136 @@
+// Copyright 2018 The go-ethereum Authors
+// This file is part of go-ethereum.
+//
+// go-ethereum is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// go-ethereum is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with go-ethereum. If not, see <http://www.gnu.org/licenses/>.
+
+package apitypes
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"git.taiyue.io/pist/go-pist/common/hexutil"
+)
+
+// mailJSON is the example of the EIP-712 specification.
+const mailJSON = `{
+	"types": {
+		"EIP712Domain": [
+			{"name": "name", "type": "string"},
+			{"name": "version", "type": "string"},
+			{"name": "chainId", "type": "uint256"},
+			{"name": "verifyingContract", "type": "address"}
+		],
+		"Person": [
+			{"name": "name", "type": "string"},
+			{"name": "wallet", "type": "address"}
+		],
+		"Mail": [
+			{"name": "from", "type": "Person"},
+			{"name": "to", "type": "Person"},
+			{"name": "contents", "type": "string"}
+		]
+	},
+	"primaryType": "Mail",
+	"domain": {
+		"name": "Ether Mail",
+		"version": "1",
+		"chainId": 1,
+		"verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"
+	},
+	"message": {
+		"from": {"name": "Cow", "wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"},
+		"to": {"name": "Bob", "wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"},
+		"contents": "Hello, Bob!"
+	}
+}`
+
+func mailTypedData(t *testing.T) TypedData {
+	var typedData TypedData
+	if err := json.Unmarshal([]byte(mailJSON), &typedData); err != nil {
+		t.Fatalf("failed to decode typed data: %v", err)
+	}
+	return typedData
+}
+
+func TestTypedDataHashing(t *testing.T) {
+	typedData := mailTypedData(t)
+
+	if have, want := string(typedData.EncodeType("Mail")), "Mail(Person from,Person to,string contents)Person(string name,address wallet)"; have != want {
+		t.Errorf("type encoding mismatch: have %s, want %s", have, want)
+	}
+	if have, want := typedData.TypeHash("Mail").String(), "0xa0cedeb2dc280ba39b857546d74f5549c3a1d7bdc2dd96bf881f76108e23dac2"; have != want {
+		t.Errorf("type hash mismatch: have %s, want %s", have, want)
+	}
+	domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	if err != nil {
+		t.Fatalf("failed to hash domain: %v", err)
+	}
+	if have, want := domainSeparator.String(), "0xf2cee375fa42b42143804025fc449deafd50cc031ca257e0b194a650a912090f"; have != want {
+		t.Errorf("domain separator mismatch: have %s, want %s", have, want)
+	}
+	messageHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+	if err != nil {
+		t.Fatalf("failed to hash message: %v", err)
+	}
+	if have, want := messageHash.String(), "0xc52c0ee5d84264471806290a3f2c4cecfc5490626bf912d01f240d7a274b371e"; have != want {
+		t.Errorf("message hash mismatch: have %s, want %s", have, want)
+	}
+	sighash, _, err := TypedDataAndHash(typedData)
+	if err != nil {
+		t.Fatalf("failed to hash typed data: %v", err)
+	}
+	if have, want := hexutil.Encode(sighash), "0xbe609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2"; have != want {
+		t.Errorf("signing hash mismatch: have %s, want %s", have, want)
+	}
+}
+
+func TestTypedDataValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(*TypedData)
+	}{
+		{"undefined reference", func(td *TypedData) { td.Types["Mail"][0].Type = "Sender" }},
+		{"unknown primitive", func(td *TypedData) { td.Types["Person"][1].Type = "address20" }},
+		{"invalid integer size", func(td *TypedData) { td.Types["EIP712Domain"][2].Type = "uint7" }},
+		{"self reference", func(td *TypedData) { td.Types["Person"][0].Type = "Person" }},
+		{"empty name", func(td *TypedData) { td.Types["Mail"][2].Name = "" }},
+		{"extra data", func(td *TypedData) { td.Message["cc"] = "Alice" }},
+		{"data mismatch", func(td *TypedData) { td.Message["contents"] = 42.0 }},
+		{"invalid address", func(td *TypedData) { td.Message["to"].(map[string]interface{})["wallet"] = "0xbob" }},
+		{"unknown primary type", func(td *TypedData) { td.PrimaryType = "Letter" }},
+	}
+	for _, tt := range tests {
+		typedData := mailTypedData(t)
+		tt.mutate(&typedData)
+		if _, _, err := TypedDataAndHash(typedData); err == nil {
+			t.Errorf("%s: expected an error", tt.name)
+		}
+	}
+}
+
+func TestVerifyChainID(t *testing.T) {
+	typedData := mailTypedData(t)
+	if err := typedData.Domain.VerifyChainID(big.NewInt(1)); err != nil {
+		t.Errorf("matching chain ID rejected: %v", err)
+	}
+	if err := typedData.Domain.VerifyChainID(big.NewInt(189)); err == nil {
+		t.Errorf("mismatching chain ID accepted")
+	}
+	typedData.Domain.ChainId = nil
+	if err := typedData.Domain.VerifyChainID(big.NewInt(1)); err == nil {
+		t.Errorf("missing chain ID accepted")
+	}
+}
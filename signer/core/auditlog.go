@@ -26,6 +26,7 @@ import (
 	"git.taiyue.io/pist/go-pist/common/hexutil"
 	"git.taiyue.io/pist/go-pist/internal/pistapi"
 	"git.taiyue.io/pist/go-pist/log"
+	"git.taiyue.io/pist/go-pist/signer/core/apitypes"
 )
 
 type AuditLogger struct {
@@ -64,6 +65,24 @@ func (l *AuditLogger) SignTransaction(ctx context.Context, args SendTxArgs, meth
 	return res, e
 }
 
+func (l *AuditLogger) SignPayment(ctx context.Context, rawTx hexutil.Bytes, methodSelector *string) (*pistapi.SignTransactionResult, error) {
+	sel := "<nil>"
+	if methodSelector != nil {
+		sel = *methodSelector
+	}
+	l.log.Info("SignPayment", "type", "request", "metadata", MetadataFromContext(ctx).String(),
+		"tx", common.Bytes2Hex(rawTx),
+		"methodSelector", sel)
+
+	res, e := l.api.SignPayment(ctx, rawTx, methodSelector)
+	if res != nil {
+		l.log.Info("SignPayment", "type", "response", "data", common.Bytes2Hex(res.Raw), "error", e)
+	} else {
+		l.log.Info("SignPayment", "type", "response", "data", res, "error", e)
+	}
+	return res, e
+}
+
 func (l *AuditLogger) Sign(ctx context.Context, addr common.MixedcaseAddress, data hexutil.Bytes) (hexutil.Bytes, error) {
 	l.log.Info("Sign", "type", "request", "metadata", MetadataFromContext(ctx).String(),
 		"addr", addr.String(), "data", common.Bytes2Hex(data))
@@ -72,6 +91,14 @@ func (l *AuditLogger) Sign(ctx context.Context, addr common.MixedcaseAddress, da
 	return b, e
 }
 
+func (l *AuditLogger) SignTypedData(ctx context.Context, addr common.MixedcaseAddress, typedData apitypes.TypedData) (hexutil.Bytes, error) {
+	l.log.Info("SignTypedData", "type", "request", "metadata", MetadataFromContext(ctx).String(),
+		"addr", addr.String(), "primaryType", typedData.PrimaryType, "domain", typedData.Domain.Name)
+	b, e := l.api.SignTypedData(ctx, addr, typedData)
+	l.log.Info("SignTypedData", "type", "response", "data", common.Bytes2Hex(b), "error", e)
+	return b, e
+}
+
 func (l *AuditLogger) EcRecover(ctx context.Context, data, sig hexutil.Bytes) (common.Address, error) {
 	l.log.Info("EcRecover", "type", "request", "metadata", MetadataFromContext(ctx).String(),
 		"data", common.Bytes2Hex(data))
@@ -98,6 +125,13 @@ func (l *AuditLogger) Import(ctx context.Context, keyJSON json.RawMessage) (Acco
 	return a, e
 }
 
+func (l *AuditLogger) Version(ctx context.Context) (string, error) {
+	l.log.Info("Version", "type", "request", "metadata", MetadataFromContext(ctx).String())
+	v, e := l.api.Version(ctx)
+	l.log.Info("Version", "type", "response", "version", v, "error", e)
+	return v, e
+}
+
 func NewAuditLogger(path string, api ExternalAPI) (*AuditLogger, error) {
 	l := log.New("api", "signer")
 	handler, err := log.FileHandler(path, log.LogfmtFormat())
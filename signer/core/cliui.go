@@ -136,6 +136,48 @@ func (ui *CommandlineUI) ApproveTx(request *SignTxRequest) (SignTxResponse, erro
 	return SignTxResponse{request.Transaction, true, ui.readPassword()}, nil
 }
 
+// ApprovePayment prompt the user for confirmation to request to pay the gas of a Transaction
+func (ui *CommandlineUI) ApprovePayment(request *SignPaymentRequest) (SignPaymentResponse, error) {
+	ui.mu.Lock()
+	defer ui.mu.Unlock()
+	fmt.Printf("--------- Payment request-----------------\n")
+	if to := request.Transaction.To; to != nil {
+		fmt.Printf("to:    %v\n", to.Original())
+	} else {
+		fmt.Printf("to:    <contact creation>\n")
+	}
+	fmt.Printf("from:  %v\n", request.Transaction.From.String())
+	if payer := request.Transaction.Payment; payer != nil {
+		fmt.Printf("payer: %v\n", payer.String())
+	}
+	fmt.Printf("value: %v wei\n", request.Transaction.Value.ToInt())
+	if fee := request.Transaction.Fee; fee != nil {
+		fmt.Printf("fee:   %v wei\n", fee.ToInt())
+	}
+	fmt.Printf("gas:   %v * %v wei = %v wei\n", uint64(request.Transaction.Gas), request.Transaction.GasPrice.ToInt(), request.GasBudget.ToInt())
+	if request.Transaction.Data != nil {
+		d := *request.Transaction.Data
+		if len(d) > 0 {
+			fmt.Printf("data:  %v\n", common.Bytes2Hex(d))
+		}
+	}
+	if request.Callinfo != nil {
+		fmt.Printf("\nTransaction validation:\n")
+		for _, m := range request.Callinfo {
+			fmt.Printf("  * %s : %s", m.Typ, m.Message)
+		}
+		fmt.Println()
+
+	}
+	fmt.Printf("\n")
+	showMetadata(request.Meta)
+	fmt.Printf("-------------------------------------------\n")
+	if !ui.confirm() {
+		return SignPaymentResponse{false, ""}, nil
+	}
+	return SignPaymentResponse{true, ui.readPassword()}, nil
+}
+
 // ApproveSignData prompt the user for confirmation to request to sign data
 func (ui *CommandlineUI) ApproveSignData(request *SignDataRequest) (SignDataResponse, error) {
 	ui.mu.Lock()
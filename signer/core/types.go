@@ -64,6 +64,8 @@ type ValidationMessages struct {
 type SendTxArgs struct {
 	From     common.MixedcaseAddress  `json:"from"`
 	To       *common.MixedcaseAddress `json:"to"`
+	Payment  *common.MixedcaseAddress `json:"payment"`
+	Fee      *hexutil.Big             `json:"fee"`
 	Gas      hexutil.Uint64           `json:"gas"`
 	GasPrice hexutil.Big              `json:"gasPrice"`
 	Value    hexutil.Big              `json:"value"`
@@ -88,8 +90,38 @@ func (args *SendTxArgs) toTransaction() *types.Transaction {
 	} else if args.Input != nil {
 		input = *args.Input
 	}
+	var payer common.Address
+	if args.Payment != nil {
+		payer = args.Payment.Address()
+	}
 	if args.To == nil {
-		return types.NewContractCreation(uint64(args.Nonce), (*big.Int)(&args.Value), uint64(args.Gas), (*big.Int)(&args.GasPrice), input)
+		return types.NewContractCreation_Payment(uint64(args.Nonce), (*big.Int)(&args.Value), (*big.Int)(args.Fee), uint64(args.Gas), (*big.Int)(&args.GasPrice), input, payer)
+	}
+	return types.NewTransaction_Payment(uint64(args.Nonce), args.To.Address(), (*big.Int)(&args.Value), (*big.Int)(args.Fee), (uint64)(args.Gas), (*big.Int)(&args.GasPrice), input, payer)
+}
+
+// sendTxArgsFromTransaction converts a transaction signed by from back into the
+// arguments, to be shown to the UI.
+func sendTxArgsFromTransaction(from common.Address, tx *types.Transaction) SendTxArgs {
+	data := hexutil.Bytes(tx.Data())
+	args := SendTxArgs{
+		From:     common.NewMixedcaseAddress(from),
+		Gas:      hexutil.Uint64(tx.Gas()),
+		GasPrice: hexutil.Big(*tx.GasPrice()),
+		Value:    hexutil.Big(*tx.Value()),
+		Nonce:    hexutil.Uint64(tx.Nonce()),
+		Data:     &data,
+	}
+	if to := tx.To(); to != nil {
+		addr := common.NewMixedcaseAddress(*to)
+		args.To = &addr
+	}
+	if payer := tx.Payer(); payer != nil {
+		addr := common.NewMixedcaseAddress(*payer)
+		args.Payment = &addr
+	}
+	if fee := tx.Fee(); fee != nil {
+		args.Fee = (*hexutil.Big)(fee)
 	}
-	return types.NewTransaction(uint64(args.Nonce), args.To.Address(), (*big.Int)(&args.Value), (uint64)(args.Gas), (*big.Int)(&args.GasPrice), input)
+	return args
 }
@@ -158,6 +158,25 @@ func (r *rulesetUI) ApproveTx(request *core.SignTxRequest) (core.SignTxResponse,
 	return core.SignTxResponse{Approved: false}, err
 }
 
+// ApprovePayment evaluates the request to pay the gas of a transaction signed by
+// its sender, the password of the payer is used on approval.
+func (r *rulesetUI) ApprovePayment(request *core.SignPaymentRequest) (core.SignPaymentResponse, error) {
+	jsonreq, err := json.Marshal(request)
+	approved, err := r.checkApproval("ApprovePayment", jsonreq, err)
+	if err != nil {
+		log.Info("Rule-based approval error, going to manual", "error", err)
+		return r.next.ApprovePayment(request)
+	}
+	if approved {
+		return core.SignPaymentResponse{
+				Approved: true,
+				Password: r.lookupPassword(request.Transaction.Payment.Address()),
+			},
+			nil
+	}
+	return core.SignPaymentResponse{Approved: false}, err
+}
+
 func (r *rulesetUI) lookupPassword(address common.Address) string {
 	return r.credentials.Get(strings.ToLower(address.String()))
 }
@@ -81,6 +81,10 @@ func (alwaysDenyUI) ApproveTx(request *core.SignTxRequest) (core.SignTxResponse,
 	return core.SignTxResponse{Transaction: request.Transaction, Approved: false, Password: ""}, nil
 }
 
+func (alwaysDenyUI) ApprovePayment(request *core.SignPaymentRequest) (core.SignPaymentResponse, error) {
+	return core.SignPaymentResponse{Approved: false, Password: ""}, nil
+}
+
 func (alwaysDenyUI) ApproveSignData(request *core.SignDataRequest) (core.SignDataResponse, error) {
 	return core.SignDataResponse{Approved: false, Password: ""}, nil
 }
@@ -196,6 +200,61 @@ func TestSignTxRequest(t *testing.T) {
 	}
 }
 
+func TestSignPaymentRequest(t *testing.T) {
+	js := `
+	function ApprovePayment(r){
+		var tx = r.transaction;
+		if(tx.from.toLowerCase() != "0x0000000000000000000000000000000000001337"){ return "Reject" }
+		if(tx.to.toLowerCase() != "0x000000000000000000000000000000000000dead"){ return "Reject" }
+		if(r.selector != "0xa9059cbb"){ return "Reject" }
+		if(parseInt(r.gas_budget, 16) > 100000){ return "Reject" }
+		return "Approve"
+	}`
+	credentials := storage.NewEphemeralStorage()
+	r, err := NewRuleEvaluator(&dontCallMe{t}, storage.NewEphemeralStorage(), credentials)
+	if err != nil {
+		t.Fatalf("Failed to create js engine: %v", err)
+	}
+	if err = r.Init(js); err != nil {
+		t.Fatalf("Failed to load bootstrap js: %v", err)
+	}
+	from, _ := mixAddr("0x0000000000000000000000000000000000001337")
+	to, _ := mixAddr("0x000000000000000000000000000000000000dead")
+	payer, _ := mixAddr("0x000000000000000000000000000000000000beef")
+	credentials.Put(strings.ToLower(payer.Address().String()), "paypass")
+
+	request := func(selector string, gas uint64) *core.SignPaymentRequest {
+		data := hexutil.Bytes(common.FromHex(selector))
+		sel := data[:4]
+		return &core.SignPaymentRequest{
+			Transaction: core.SendTxArgs{
+				From:     *from,
+				To:       to,
+				Payment:  payer,
+				Gas:      hexutil.Uint64(gas),
+				GasPrice: hexutil.Big(*big.NewInt(1)),
+				Data:     &data,
+			},
+			Selector:  &sel,
+			GasBudget: hexutil.Big(*new(big.Int).SetUint64(gas)),
+			Meta:      core.Metadata{Remote: "remoteip", Local: "localip", Scheme: "inproc"},
+		}
+	}
+	resp, err := r.ApprovePayment(request("0xa9059cbb", 50000))
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if !resp.Approved || resp.Password != "paypass" {
+		t.Errorf("Expected check to resolve to 'Approve' with the payer password, got %v", resp)
+	}
+	if resp, _ = r.ApprovePayment(request("0x095ea7b3", 50000)); resp.Approved {
+		t.Errorf("Expected unknown selector to resolve to 'Reject'")
+	}
+	if resp, _ = r.ApprovePayment(request("0xa9059cbb", 200000)); resp.Approved {
+		t.Errorf("Expected gas over budget to resolve to 'Reject'")
+	}
+}
+
 type dummyUI struct {
 	calls []string
 }
@@ -205,6 +264,11 @@ func (d *dummyUI) ApproveTx(request *core.SignTxRequest) (core.SignTxResponse, e
 	return core.SignTxResponse{}, core.ErrRequestDenied
 }
 
+func (d *dummyUI) ApprovePayment(request *core.SignPaymentRequest) (core.SignPaymentResponse, error) {
+	d.calls = append(d.calls, "ApprovePayment")
+	return core.SignPaymentResponse{}, core.ErrRequestDenied
+}
+
 func (d *dummyUI) ApproveSignData(request *core.SignDataRequest) (core.SignDataResponse, error) {
 	d.calls = append(d.calls, "ApproveSignData")
 	return core.SignDataResponse{}, core.ErrRequestDenied
@@ -260,6 +324,7 @@ func TestForwarding(t *testing.T) {
 	}
 	r.ApproveSignData(nil)
 	r.ApproveTx(nil)
+	r.ApprovePayment(nil)
 	r.ApproveImport(nil)
 	r.ApproveNewAccount(nil)
 	r.ApproveListing(nil)
@@ -270,7 +335,7 @@ func TestForwarding(t *testing.T) {
 	//This one is not forwarded
 	r.OnApprovedTx(pistapi.SignTransactionResult{})
 
-	expCalls := 8
+	expCalls := 9
 	if len(ui.calls) != expCalls {
 
 		t.Errorf("Expected %d forwarded calls, got %d: %s", expCalls, len(ui.calls), strings.Join(ui.calls, ","))
@@ -517,6 +582,11 @@ func (d *dontCallMe) ApproveTx(request *core.SignTxRequest) (core.SignTxResponse
 	return core.SignTxResponse{}, core.ErrRequestDenied
 }
 
+func (d *dontCallMe) ApprovePayment(request *core.SignPaymentRequest) (core.SignPaymentResponse, error) {
+	d.t.Fatalf("Did not expect next-handler to be called")
+	return core.SignPaymentResponse{}, core.ErrRequestDenied
+}
+
 func (d *dontCallMe) ApproveSignData(request *core.SignDataRequest) (core.SignDataResponse, error) {
 	d.t.Fatalf("Did not expect next-handler to be called")
 	return core.SignDataResponse{}, core.ErrRequestDenied
@@ -0,0 +1,42 @@
+package sponsor
+
+import (
+	"context"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/common/hexutil"
+	"git.taiyue.io/pist/go-pist/core/types"
+	"git.taiyue.io/pist/go-pist/rlp"
+)
+
+// PublicSponsorAPI provides an API to get the transactions of the senders
+// sponsored by the node.
+type PublicSponsorAPI struct {
+	s *Service
+}
+
+// NewPublicSponsorAPI creates a new sponsorship API.
+func NewPublicSponsorAPI(s *Service) *PublicSponsorAPI {
+	return &PublicSponsorAPI{s}
+}
+
+// SendRawTransaction pays the gas of the given transaction, signed by its sender
+// with the payer of the node set, and submits it to the transaction pool. It
+// returns the transaction hash.
+func (api *PublicSponsorAPI) SendRawTransaction(ctx context.Context, encodedTx hexutil.Bytes) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := rlp.DecodeBytes(encodedTx, tx); err != nil {
+		return common.Hash{}, err
+	}
+	return api.s.sponsor(tx)
+}
+
+// Payer returns the account paying the gas of the sponsored transactions.
+func (api *PublicSponsorAPI) Payer() common.Address {
+	return api.s.config.Payer
+}
+
+// RemainingQuota returns the gas still sponsored to the sender today.
+func (api *PublicSponsorAPI) RemainingQuota(sender common.Address) hexutil.Uint64 {
+	return hexutil.Uint64(api.s.quota.remaining(sender))
+}
@@ -0,0 +1,33 @@
+// Package sponsor implements the gas sponsorship service, paying the gas of the
+// transactions of other senders which set it as their payer.
+package sponsor
+
+import (
+	"math/big"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/params"
+)
+
+// DefaultConfig contains the default policies of the sponsorship service.
+var DefaultConfig = Config{
+	DailyGasQuota: 1000000,
+	MaxGasPrice:   big.NewInt(100 * params.GWei),
+}
+
+// Config are the policies of the transactions sponsored by the service.
+type Config struct {
+	// Payer is the keystore account paying the gas, it has to be unlocked.
+	Payer common.Address
+
+	// Contracts are the only recipients of the sponsored transactions, any
+	// recipient is allowed if empty.
+	Contracts []common.Address `toml:",omitempty"`
+
+	// DailyGasQuota is the gas limit sponsored to each sender per day (UTC),
+	// unlimited if zero.
+	DailyGasQuota uint64
+
+	// MaxGasPrice is the highest gas price sponsored, unlimited if nil.
+	MaxGasPrice *big.Int `toml:",omitempty"`
+}
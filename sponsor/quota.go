@@ -0,0 +1,104 @@
+package sponsor
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/pistdb"
+	"git.taiyue.io/pist/go-pist/rlp"
+)
+
+// quotaPrefix + sender -> quotaEntry
+var quotaPrefix = []byte("sponsor-quota-")
+
+var errQuotaExceeded = errors.New("daily gas quota of the sender exceeded")
+
+// quotaEntry is the gas sponsored to a sender on a day.
+type quotaEntry struct {
+	Day  uint64
+	Used uint64
+}
+
+// quota accounts the gas sponsored to each sender per day. It's kept in the
+// database to survive restarts, the entry of a sender is reset on a new day.
+type quota struct {
+	db    pistdb.Database
+	limit uint64           // Gas sponsored per sender and day, unlimited if zero
+	now   func() time.Time // Current time, replaced in the tests
+	lock  sync.Mutex
+}
+
+func newQuota(db pistdb.Database, limit uint64) *quota {
+	return &quota{db: db, limit: limit, now: time.Now}
+}
+
+// today returns the number of the current day since the unix epoch, in UTC.
+func (q *quota) today() uint64 {
+	return uint64(q.now().Unix()) / uint64(24*time.Hour/time.Second)
+}
+
+func quotaKey(sender common.Address) []byte {
+	return append(append([]byte{}, quotaPrefix...), sender.Bytes()...)
+}
+
+// used returns the gas sponsored to the sender today.
+func (q *quota) used(sender common.Address) uint64 {
+	blob, err := q.db.Get(quotaKey(sender))
+	if err != nil || len(blob) == 0 {
+		return 0
+	}
+	var entry quotaEntry
+	if err := rlp.DecodeBytes(blob, &entry); err != nil || entry.Day != q.today() {
+		return 0
+	}
+	return entry.Used
+}
+
+// remaining returns the gas still sponsored to the sender today.
+func (q *quota) remaining(sender common.Address) uint64 {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if q.limit == 0 {
+		return ^uint64(0)
+	}
+	if used := q.used(sender); used < q.limit {
+		return q.limit - used
+	}
+	return 0
+}
+
+// charge adds the gas to the quota of the sender, or fails if it's exceeded.
+func (q *quota) charge(sender common.Address, gas uint64) error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	used := q.used(sender)
+	if q.limit != 0 && (used+gas < used || used+gas > q.limit) {
+		return errQuotaExceeded
+	}
+	return q.put(sender, used+gas)
+}
+
+// refund takes back the gas charged to the sender, if the transaction wasn't
+// submitted.
+func (q *quota) refund(sender common.Address, gas uint64) error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	used := q.used(sender)
+	if gas > used {
+		gas = used
+	}
+	return q.put(sender, used-gas)
+}
+
+func (q *quota) put(sender common.Address, used uint64) error {
+	blob, err := rlp.EncodeToBytes(&quotaEntry{Day: q.today(), Used: used})
+	if err != nil {
+		return err
+	}
+	return q.db.Put(quotaKey(sender), blob)
+}
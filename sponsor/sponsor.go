@@ -0,0 +1,156 @@
+package sponsor
+
+import (
+	"errors"
+	"fmt"
+
+	"git.taiyue.io/pist/go-pist/accounts"
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/core/types"
+	"git.taiyue.io/pist/go-pist/log"
+	"git.taiyue.io/pist/go-pist/metrics"
+	"git.taiyue.io/pist/go-pist/p2p"
+	"git.taiyue.io/pist/go-pist/pist"
+	"git.taiyue.io/pist/go-pist/pistdb"
+	"git.taiyue.io/pist/go-pist/rpc"
+)
+
+var (
+	sponsoredMeter = metrics.NewRegisteredMeter("sponsor/txs/sponsored", nil)
+	rejectedMeter  = metrics.NewRegisteredMeter("sponsor/txs/rejected", nil)
+	gasMeter       = metrics.NewRegisteredMeter("sponsor/gas", nil)
+)
+
+var (
+	errNotPayer           = errors.New("transaction payer is not the sponsor")
+	errContractNotAllowed = errors.New("recipient is not a sponsored contract")
+	errGasPriceTooHigh    = errors.New("gas price above the sponsored maximum")
+)
+
+// Service pays the gas of the transactions which set its keystore account as
+// their payer, if they meet its policies.
+type Service struct {
+	config    *Config
+	contracts map[common.Address]struct{}
+	quota     *quota
+
+	db     pistdb.Database // Database of the quota accounting
+	pist   *pist.Pistchain
+	signer types.Signer
+}
+
+// New creates the sponsorship service of the full node, keeping the accounting
+// of the quotas in the database.
+func New(config *Config, pistServ *pist.Pistchain, db pistdb.Database) (*Service, error) {
+	if pistServ == nil {
+		return nil, errors.New("sponsorship requires a full node")
+	}
+	if config.Payer == (common.Address{}) {
+		return nil, errors.New("no payer account configured")
+	}
+	s := &Service{
+		config:    config,
+		contracts: make(map[common.Address]struct{}),
+		quota:     newQuota(db, config.DailyGasQuota),
+		db:        db,
+		pist:      pistServ,
+		signer:    types.NewTIP1Signer(pistServ.BlockChain().Config().ChainID),
+	}
+	for _, contract := range config.Contracts {
+		s.contracts[contract] = struct{}{}
+	}
+	return s, nil
+}
+
+// Protocols implements node.Service, returning the P2P network protocols used
+// by the sponsorship service (nil as it doesn't use the devp2p overlay network).
+func (s *Service) Protocols() []p2p.Protocol { return nil }
+
+// APIs implements node.Service, returning the RPC API endpoints provided by the
+// sponsorship service.
+func (s *Service) APIs() []rpc.API {
+	return []rpc.API{
+		{
+			Namespace: "sponsor",
+			Version:   "1.0",
+			Service:   NewPublicSponsorAPI(s),
+			Public:    true,
+		},
+	}
+}
+
+// Start implements node.Service.
+func (s *Service) Start(server *p2p.Server) error {
+	log.Info("Gas sponsorship started", "payer", s.config.Payer, "contracts", len(s.contracts),
+		"quota", s.config.DailyGasQuota, "maxprice", s.config.MaxGasPrice)
+	return nil
+}
+
+// Stop implements node.Service, closing the quota database.
+func (s *Service) Stop() error {
+	s.db.Close()
+	log.Info("Gas sponsorship stopped")
+	return nil
+}
+
+// check verifies the transaction signed by the sender against the policies,
+// apart from the quota.
+func (s *Service) check(tx *types.Transaction) error {
+	if payer := tx.Payer(); payer == nil || *payer != s.config.Payer {
+		return errNotPayer
+	}
+	if len(s.contracts) > 0 {
+		if to := tx.To(); to == nil {
+			return errContractNotAllowed
+		} else if _, ok := s.contracts[*to]; !ok {
+			return errContractNotAllowed
+		}
+	}
+	if s.config.MaxGasPrice != nil && tx.GasPrice().Cmp(s.config.MaxGasPrice) > 0 {
+		return errGasPriceTooHigh
+	}
+	return nil
+}
+
+// sponsor signs the transaction of the sender as its payer and submits it to the
+// transaction pool, the gas limit is charged to the quota of the sender.
+func (s *Service) sponsor(tx *types.Transaction) (common.Hash, error) {
+	from, err := types.Sender(s.signer, tx)
+	if err != nil {
+		rejectedMeter.Mark(1)
+		return common.Hash{}, fmt.Errorf("invalid sender: %v", err)
+	}
+	if err := s.check(tx); err != nil {
+		rejectedMeter.Mark(1)
+		return common.Hash{}, err
+	}
+	if err := s.quota.charge(from, tx.Gas()); err != nil {
+		rejectedMeter.Mark(1)
+		return common.Hash{}, err
+	}
+	signed, err := s.sign(tx)
+	if err == nil {
+		err = s.pist.TxPool().AddLocal(signed)
+	}
+	if err != nil {
+		if err := s.quota.refund(from, tx.Gas()); err != nil {
+			log.Warn("Failed to refund the sponsored gas", "sender", from, "err", err)
+		}
+		rejectedMeter.Mark(1)
+		return common.Hash{}, err
+	}
+	sponsoredMeter.Mark(1)
+	gasMeter.Mark(int64(tx.Gas()))
+	log.Info("Sponsored transaction", "hash", signed.Hash(), "sender", from, "gas", tx.Gas())
+	return signed.Hash(), nil
+}
+
+// sign signs the transaction as its payer with the keystore account.
+func (s *Service) sign(tx *types.Transaction) (*types.Transaction, error) {
+	account := accounts.Account{Address: s.config.Payer}
+	wallet, err := s.pist.AccountManager().Find(account)
+	if err != nil {
+		return nil, err
+	}
+	return wallet.SignTx_Payment(account, tx, s.pist.BlockChain().Config().ChainID)
+}
@@ -0,0 +1,78 @@
+package sponsor
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"git.taiyue.io/pist/go-pist/common"
+	"git.taiyue.io/pist/go-pist/core/types"
+	"git.taiyue.io/pist/go-pist/pistdb"
+)
+
+func TestQuota(t *testing.T) {
+	var (
+		db     = pistdb.NewMemDatabase()
+		now    = time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+		sender = common.Address{0x01}
+	)
+	q := newQuota(db, 100000)
+	q.now = func() time.Time { return now }
+
+	if err := q.charge(sender, 60000); err != nil {
+		t.Fatalf("failed to charge: %v", err)
+	}
+	if err := q.charge(sender, 60000); err != errQuotaExceeded {
+		t.Fatalf("quota error mismatch: have %v, want %v", err, errQuotaExceeded)
+	}
+	if remaining := q.remaining(sender); remaining != 40000 {
+		t.Fatalf("remaining quota mismatch: have %d, want 40000", remaining)
+	}
+	if err := q.refund(sender, 20000); err != nil {
+		t.Fatalf("failed to refund: %v", err)
+	}
+	// The accounting survives restarts
+	q = newQuota(db, 100000)
+	q.now = func() time.Time { return now }
+	if remaining := q.remaining(sender); remaining != 60000 {
+		t.Fatalf("remaining quota after restart mismatch: have %d, want 60000", remaining)
+	}
+	if remaining := q.remaining(common.Address{0x02}); remaining != 100000 {
+		t.Fatalf("remaining quota of another sender mismatch: have %d, want 100000", remaining)
+	}
+	// The quota is reset on the next day
+	now = now.Add(12 * time.Hour)
+	if remaining := q.remaining(sender); remaining != 100000 {
+		t.Fatalf("remaining quota on the next day mismatch: have %d, want 100000", remaining)
+	}
+	if err := q.charge(sender, 100000); err != nil {
+		t.Fatalf("failed to charge on the next day: %v", err)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	var (
+		payer    = common.Address{0xaa}
+		contract = common.Address{0xcc}
+	)
+	s := &Service{
+		config:    &Config{Payer: payer, MaxGasPrice: big.NewInt(10)},
+		contracts: map[common.Address]struct{}{contract: {}},
+	}
+	tests := []struct {
+		tx  *types.Transaction
+		err error
+	}{
+		{types.NewTransaction_Payment(0, contract, common.Big0, nil, 21000, big.NewInt(10), nil, payer), nil},
+		{types.NewTransaction(0, contract, common.Big0, 21000, big.NewInt(10), nil), errNotPayer},
+		{types.NewTransaction_Payment(0, contract, common.Big0, nil, 21000, big.NewInt(10), nil, common.Address{0xbb}), errNotPayer},
+		{types.NewTransaction_Payment(0, common.Address{0xdd}, common.Big0, nil, 21000, big.NewInt(10), nil, payer), errContractNotAllowed},
+		{types.NewContractCreation_Payment(0, common.Big0, nil, 21000, big.NewInt(10), nil, payer), errContractNotAllowed},
+		{types.NewTransaction_Payment(0, contract, common.Big0, nil, 21000, big.NewInt(11), nil, payer), errGasPriceTooHigh},
+	}
+	for i, tt := range tests {
+		if err := s.check(tt.tx); err != tt.err {
+			t.Errorf("test %d: error mismatch: have %v, want %v", i, err, tt.err)
+		}
+	}
+}